@@ -0,0 +1,165 @@
+// Copyright 2020 Celer Network
+
+// Package accounting builds the double-entry records of the node revenue and costs kept in
+// storage: relay fees received and paid per forwarded pay, gas paid per on-chain tx, and
+// deposits and withdrawals of each channel. Every record moves value between accounts and
+// its postings sum to zero per token. Entry ids are derived from the recorded event so that
+// recording the same event again is a no-op.
+package accounting
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/celer-network/goCeler/common/structs"
+	"github.com/celer-network/goCeler/ctype"
+)
+
+// Accounts, channel accounts are named by ChannelAcct
+const (
+	AcctWallet     = "wallet" // on-chain account of the node
+	AcctGas        = "expense:gas"
+	AcctFeeIncome  = "income:relayfee"
+	AcctFeeExpense = "expense:relayfee"
+
+	channelAcctPrefix = "channel:"
+)
+
+// Entry kinds, used as the entry id prefix
+const (
+	KindFeeIn    = "feein"
+	KindFeeOut   = "feeout"
+	KindGas      = "gas"
+	KindDeposit  = "deposit"
+	KindWithdraw = "withdraw"
+)
+
+// ChannelAcct is the account of my balance in the channel
+func ChannelAcct(cid ctype.CidType) string {
+	return channelAcctPrefix + ctype.Cid2Hex(cid)
+}
+
+// EntryKind returns the kind of the entry
+func EntryKind(entryID string) string {
+	return strings.SplitN(entryID, "-", 2)[0]
+}
+
+func transfer(entryID, memo string, from, to string, token ctype.Addr, amt *big.Int) *structs.AcctRecord {
+	return &structs.AcctRecord{
+		EntryID: entryID,
+		Memo:    memo,
+		Ts:      time.Now().UTC(),
+		Postings: []*structs.AcctPosting{
+			{Account: to, Token: token, Amt: new(big.Int).Set(amt)},
+			{Account: from, Token: token, Amt: new(big.Int).Neg(amt)},
+		},
+	}
+}
+
+// FeeInRecord records the relay fee received when a pay through the ingress channel is paid
+func FeeInRecord(payID ctype.PayIDType, cid ctype.CidType, token ctype.Addr, fee *big.Int) *structs.AcctRecord {
+	return transfer(KindFeeIn+"-"+ctype.PayID2Hex(payID), "relay fee received",
+		AcctFeeIncome, ChannelAcct(cid), token, fee)
+}
+
+// FeeOutRecord records the relay fee sent when a pay through the egress channel is paid
+func FeeOutRecord(payID ctype.PayIDType, cid ctype.CidType, token ctype.Addr, fee *big.Int) *structs.AcctRecord {
+	return transfer(KindFeeOut+"-"+ctype.PayID2Hex(payID), "relay fee sent",
+		ChannelAcct(cid), AcctFeeExpense, token, fee)
+}
+
+// GasRecord records the gas paid for a mined tx, txHash is the id of the tx in the tx manager
+func GasRecord(txHash, description string, chainId uint64, cost *big.Int) *structs.AcctRecord {
+	memo := description
+	if chainId != 0 {
+		memo = fmt.Sprintf("%s on chain %d", description, chainId)
+	}
+	return transfer(KindGas+"-"+txHash, memo, AcctWallet, AcctGas, ctype.ZeroAddr, cost)
+}
+
+// DepositEntryID is the id of the deposit record of an on-chain Deposit event
+func DepositEntryID(txHash ctype.Hash, logIndex uint) string {
+	return fmt.Sprintf("%s-%s-%d", KindDeposit, txHash.Hex(), logIndex)
+}
+
+// DepositRecord records my deposit into the channel
+func DepositRecord(
+	txHash ctype.Hash, logIndex uint, cid ctype.CidType, token ctype.Addr, amt *big.Int) *structs.AcctRecord {
+	return transfer(DepositEntryID(txHash, logIndex), "deposit", AcctWallet, ChannelAcct(cid), token, amt)
+}
+
+// WithdrawEntryID is the id of the withdraw record of an on-chain withdraw event
+func WithdrawEntryID(txHash ctype.Hash, logIndex uint) string {
+	return fmt.Sprintf("%s-%s-%d", KindWithdraw, txHash.Hex(), logIndex)
+}
+
+// WithdrawRecord records my withdrawal from the channel
+func WithdrawRecord(
+	txHash ctype.Hash, logIndex uint, cid ctype.CidType, token ctype.Addr, amt *big.Int) *structs.AcctRecord {
+	return transfer(WithdrawEntryID(txHash, logIndex), "withdraw", ChannelAcct(cid), AcctWallet, token, amt)
+}
+
+// TokenSummary sums up the records of a token over a period
+type TokenSummary struct {
+	Token       ctype.Addr
+	FeeIncome   *big.Int
+	FeeExpense  *big.Int
+	GasCost     *big.Int // only for the native token
+	Deposits    *big.Int
+	Withdrawals *big.Int
+}
+
+// NetRevenue is the fee income minus fees paid and gas cost
+func (s *TokenSummary) NetRevenue() *big.Int {
+	net := new(big.Int).Sub(s.FeeIncome, s.FeeExpense)
+	return net.Sub(net, s.GasCost)
+}
+
+// Summarize sums up the records by token, ordered by token address
+func Summarize(records []*structs.AcctRecord) []*TokenSummary {
+	summaries := make(map[ctype.Addr]*TokenSummary)
+	get := func(token ctype.Addr) *TokenSummary {
+		s, ok := summaries[token]
+		if !ok {
+			s = &TokenSummary{
+				Token:       token,
+				FeeIncome:   new(big.Int),
+				FeeExpense:  new(big.Int),
+				GasCost:     new(big.Int),
+				Deposits:    new(big.Int),
+				Withdrawals: new(big.Int),
+			}
+			summaries[token] = s
+		}
+		return s
+	}
+	for _, record := range records {
+		kind := EntryKind(record.EntryID)
+		for _, p := range record.Postings {
+			s := get(p.Token)
+			switch {
+			case kind == KindFeeIn && p.Account == AcctFeeIncome:
+				s.FeeIncome.Sub(s.FeeIncome, p.Amt)
+			case kind == KindFeeOut && p.Account == AcctFeeExpense:
+				s.FeeExpense.Add(s.FeeExpense, p.Amt)
+			case kind == KindGas && p.Account == AcctGas:
+				s.GasCost.Add(s.GasCost, p.Amt)
+			case kind == KindDeposit && p.Account == AcctWallet:
+				s.Deposits.Sub(s.Deposits, p.Amt)
+			case kind == KindWithdraw && p.Account == AcctWallet:
+				s.Withdrawals.Add(s.Withdrawals, p.Amt)
+			}
+		}
+	}
+	var res []*TokenSummary
+	for _, s := range summaries {
+		res = append(res, s)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return ctype.Addr2Hex(res[i].Token) < ctype.Addr2Hex(res[j].Token)
+	})
+	return res
+}
@@ -0,0 +1,58 @@
+// Copyright 2020 Celer Network
+
+package accounting
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/celer-network/goCeler/common/structs"
+	"github.com/celer-network/goCeler/ctype"
+)
+
+func checkBalanced(t *testing.T, record *structs.AcctRecord) {
+	sum := new(big.Int)
+	for _, p := range record.Postings {
+		sum.Add(sum, p.Amt)
+	}
+	if sum.Sign() != 0 {
+		t.Errorf("record %s not balanced: %s", record.EntryID, sum)
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	token := ctype.Hex2Addr("def001")
+	cid1 := ctype.Hex2Cid("01")
+	cid2 := ctype.Hex2Cid("02")
+	txHash := ctype.Hash{1}
+	records := []*structs.AcctRecord{
+		FeeInRecord(ctype.Bytes2PayID([]byte{1}), cid1, token, big.NewInt(10)),
+		FeeOutRecord(ctype.Bytes2PayID([]byte{1}), cid2, token, big.NewInt(4)),
+		FeeInRecord(ctype.Bytes2PayID([]byte{2}), cid1, ctype.ZeroAddr, big.NewInt(7)),
+		GasRecord("0x01", "deposit", 0, big.NewInt(3)),
+		DepositRecord(txHash, 0, cid1, token, big.NewInt(100)),
+		WithdrawRecord(txHash, 1, cid2, token, big.NewInt(30)),
+	}
+	for _, record := range records {
+		checkBalanced(t, record)
+	}
+	if EntryKind(records[4].EntryID) != KindDeposit {
+		t.Errorf("wrong entry kind of %s", records[4].EntryID)
+	}
+
+	summaries := Summarize(records)
+	if len(summaries) != 2 {
+		t.Fatalf("got %d token summaries, expect 2", len(summaries))
+	}
+	eth, erc := summaries[0], summaries[1]
+	if eth.Token != ctype.ZeroAddr || erc.Token != token {
+		t.Fatalf("wrong summary tokens %x %x", eth.Token, erc.Token)
+	}
+	if eth.FeeIncome.Int64() != 7 || eth.GasCost.Int64() != 3 || eth.NetRevenue().Int64() != 4 {
+		t.Errorf("wrong eth summary %+v", eth)
+	}
+	if erc.FeeIncome.Int64() != 10 || erc.FeeExpense.Int64() != 4 || erc.GasCost.Sign() != 0 ||
+		erc.Deposits.Int64() != 100 || erc.Withdrawals.Int64() != 30 || erc.NetRevenue().Int64() != 6 {
+		t.Errorf("wrong token summary %+v", erc)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 	"sync"
 
 	"github.com/celer-network/goCeler/chain"
@@ -14,6 +15,7 @@ import (
 	"github.com/celer-network/goCeler/common"
 	"github.com/celer-network/goCeler/common/event"
 	"github.com/celer-network/goCeler/common/intfs"
+	"github.com/celer-network/goCeler/common/structs"
 	"github.com/celer-network/goCeler/config"
 	"github.com/celer-network/goCeler/ctype"
 	"github.com/celer-network/goCeler/entity"
@@ -42,12 +44,16 @@ type AppChannel struct {
 	client         *AppClient
 	cid            string
 	callbackID     monitor.CallbackID
+	seqNum         uint64 // seq num of the latest co-signed state
+	stateProof     []byte // latest co-signed app state proof
+	disputePending bool   // dispute caught before the callback is attached, persisted
+	disputeSeq     int    // seq num of the pending dispute
 }
 
 type AppClient struct {
 	nodeConfig     common.GlobalNodeConfig
-	transactor     *eth.Transactor
-	transactorPool *eth.TransactorPool
+	transactor     intfs.Transactor
+	transactorPool intfs.TransactorPool
 	monitorService intfs.MonitorService
 	dal            *storage.DAL
 	signer         eth.Signer
@@ -57,8 +63,8 @@ type AppClient struct {
 
 func NewAppClient(
 	nodeConfig common.GlobalNodeConfig,
-	transactor *eth.Transactor,
-	transactorPool *eth.TransactorPool,
+	transactor intfs.Transactor,
+	transactorPool intfs.TransactorPool,
 	monitorService intfs.MonitorService,
 	dal *storage.DAL,
 	signer eth.Signer,
@@ -79,6 +85,10 @@ func (a *AppChannel) setDeployedAddr(addr ctype.Addr) {
 	a.mu.Lock()
 	defer a.mu.Unlock()
 	a.DeployedAddr = addr
+	err := a.client.dal.UpdateAppSessionDeployedAddr(a.cid, addr)
+	if err != nil {
+		log.Warnln("update app session deployed addr error:", a.cid, err)
+	}
 }
 
 func (a *AppChannel) getDeployedAddr() ctype.Addr {
@@ -87,6 +97,76 @@ func (a *AppChannel) getDeployedAddr() ctype.Addr {
 	return a.DeployedAddr
 }
 
+// setCallback attaches the state callback and delivers the dispute caught before
+func (a *AppChannel) setCallback(sc common.StateCallback) {
+	a.mu.Lock()
+	a.Callback = sc
+	pending := sc != nil && a.disputePending
+	seqNum := a.disputeSeq
+	if pending {
+		a.disputePending = false
+		err := a.client.dal.DeleteAppDispute(a.cid)
+		if err != nil {
+			log.Warnln("delete app dispute error:", a.cid, err)
+		}
+	}
+	a.mu.Unlock()
+	if pending {
+		log.Infoln("deliver pending app channel dispute:", a.cid, seqNum)
+		sc.OnDispute(seqNum)
+	}
+}
+
+// notifyDispute triggers the OnDispute callback if the app has registered one.
+// Channels restored from storage have no callback until the app re-attaches it,
+// the dispute is persisted and delivered when the callback is attached.
+func (a *AppChannel) notifyDispute(seqNum int) {
+	a.mu.Lock()
+	sc := a.Callback
+	if sc == nil {
+		log.Warnln("app channel dispute with no callback, deliver when attached:", a.cid, seqNum)
+		a.disputePending = true
+		a.disputeSeq = seqNum
+		err := a.client.dal.PutAppDispute(a.cid, seqNum)
+		if err != nil {
+			log.Errorln("put app dispute error:", a.cid, err)
+		}
+	}
+	a.mu.Unlock()
+	if sc != nil {
+		sc.OnDispute(seqNum)
+	}
+}
+
+// setStateProof keeps and persists the co-signed state proof if it is not older than the current one
+func (a *AppChannel) setStateProof(seqNum uint64, stateProof []byte) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if seqNum < a.seqNum {
+		log.Debugf("skip stale state proof for app channel %s, seq %d < %d", a.cid, seqNum, a.seqNum)
+		return nil
+	}
+	err := a.client.dal.UpdateAppSessionStateProof(a.cid, seqNum, stateProof)
+	if err != nil {
+		return err
+	}
+	a.seqNum = seqNum
+	a.stateProof = stateProof
+	return nil
+}
+
+// GetID returns the app channel ID (virtual address or session ID)
+func (a *AppChannel) GetID() string {
+	return a.cid
+}
+
+// GetLatestStateProof returns the seq num and the latest co-signed state proof
+func (a *AppChannel) GetLatestStateProof() (uint64, []byte) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.seqNum, a.stateProof
+}
+
 // onVirtualContractDeploy triggers OnDispute callback for an app based on a virtual contract
 // when the contract is deployed
 func (a *AppChannel) onVirtualContractDeploy(eLog *types.Log) (bool, error) {
@@ -98,7 +178,7 @@ func (a *AppChannel) onVirtualContractDeploy(eLog *types.Log) (bool, error) {
 		return false, err
 	}
 	if ctype.Bytes2Hex(e.VirtAddr[:]) == a.cid {
-		a.Callback.OnDispute(0) // seqNum = 0 implies the virtual contract is deployed
+		a.notifyDispute(0) // seqNum = 0 implies the virtual contract is deployed
 		return true, nil
 	}
 	return false, nil
@@ -121,7 +201,7 @@ func (a *AppChannel) onDeployedContractSettle(eLog *types.Log) (bool, error) {
 		return false, err
 	}
 	if bytes.Equal(a.Session[:], e.Session[:]) {
-		a.Callback.OnDispute(int(e.Seq.Int64()))
+		a.notifyDispute(int(e.Seq.Int64()))
 		return true, nil
 	}
 	return false, nil
@@ -146,7 +226,170 @@ func (c *AppClient) DeleteAppChannel(cid string) {
 	if appChannel != nil {
 		c.monitorService.RemoveEvent(appChannel.callbackID)
 		delete(c.appChannels, cid)
+		err := c.dal.DeleteAppSession(cid)
+		if err != nil {
+			log.Warnln("delete app session error:", cid, err)
+		}
+		appChannel.mu.Lock()
+		if appChannel.disputePending {
+			err = c.dal.DeleteAppDispute(cid)
+			if err != nil {
+				log.Warnln("delete app dispute error:", cid, err)
+			}
+		}
+		appChannel.mu.Unlock()
+	}
+}
+
+// GetAllAppChannels returns all app channels sorted by ID
+func (c *AppClient) GetAllAppChannels() []*AppChannel {
+	c.cLock.RLock()
+	defer c.cLock.RUnlock()
+	appChannels := make([]*AppChannel, 0, len(c.appChannels))
+	for _, appChannel := range c.appChannels {
+		appChannels = append(appChannels, appChannel)
+	}
+	sort.Slice(appChannels, func(i, j int) bool { return appChannels[i].cid < appChannels[j].cid })
+	return appChannels
+}
+
+// SetAppChannelCallback attaches the state callback to an existing app channel,
+// e.g., one restored from storage after restart.
+func (c *AppClient) SetAppChannelCallback(cid string, sc common.StateCallback) error {
+	appChannel := c.GetAppChannel(cid)
+	if appChannel == nil {
+		return fmt.Errorf("SetAppChannelCallback error: app channel not found")
+	}
+	appChannel.setCallback(sc)
+	return nil
+}
+
+// UpdateAppChannelStateProof persists the latest co-signed state proof of an app channel,
+// which is needed to settle the app channel on chain after restart.
+func (c *AppClient) UpdateAppChannelStateProof(cid string, seqNum uint64, stateProof []byte) error {
+	appChannel := c.GetAppChannel(cid)
+	if appChannel == nil {
+		return fmt.Errorf("UpdateAppChannelStateProof error: app channel not found")
+	}
+	return appChannel.setStateProof(seqNum, stateProof)
+}
+
+// RestoreAppChannels loads the persisted app channels into memory and resumes their
+// on-chain dispute watches from the blocks where they were started.
+func (c *AppClient) RestoreAppChannels() error {
+	sessions, err := c.dal.GetAllAppSessions()
+	if err != nil {
+		return fmt.Errorf("GetAllAppSessions error: %w", err)
+	}
+	for _, s := range sessions {
+		appChannel := &AppChannel{
+			Type:           entity.ConditionType(s.Type),
+			Nonce:          s.Nonce,
+			ByteCode:       s.ByteCode,
+			Constructor:    s.Constructor,
+			Players:        s.Players,
+			DeployedAddr:   s.DeployedAddr,
+			OnChainTimeout: s.OnChainTimeout,
+			client:         c,
+			cid:            s.ID,
+			seqNum:         s.SeqNum,
+			stateProof:     s.StateProof,
+		}
+		if appChannel.Type == entity.ConditionType_DEPLOYED_CONTRACT {
+			copy(appChannel.Session[:], ctype.Hex2Bytes(s.ID))
+		}
+		appChannel.disputeSeq, appChannel.disputePending, err = c.dal.GetAppDispute(s.ID)
+		if err != nil {
+			log.Errorln("restore app channel dispute error:", s.ID, err)
+		}
+		c.PutAppChannel(s.ID, appChannel)
+		if s.WatchBlock == 0 {
+			continue
+		}
+		startBlock := new(big.Int).SetUint64(s.WatchBlock)
+		if appChannel.Type == entity.ConditionType_VIRTUAL_CONTRACT {
+			err = c.watchVirtualContractDeploy(appChannel, startBlock)
+		} else {
+			err = c.watchDeployedContractSettle(appChannel, startBlock)
+		}
+		if err != nil {
+			log.Errorln("restore app channel watch error:", s.ID, err)
+		}
 	}
+	log.Infof("restored %d app channels", len(sessions))
+	return nil
+}
+
+func (c *AppClient) insertAppSession(appChannel *AppChannel, watchBlock uint64) error {
+	err := c.dal.InsertAppSession(&structs.AppSession{
+		ID:             appChannel.cid,
+		Type:           int(appChannel.Type),
+		Nonce:          appChannel.Nonce,
+		ByteCode:       appChannel.ByteCode,
+		Constructor:    appChannel.Constructor,
+		Players:        appChannel.Players,
+		DeployedAddr:   appChannel.DeployedAddr,
+		OnChainTimeout: appChannel.OnChainTimeout,
+		WatchBlock:     watchBlock,
+	})
+	if err != nil {
+		return fmt.Errorf("InsertAppSession error: %w", err)
+	}
+	return nil
+}
+
+// stopWatch removes the dispute watch of an app channel once the dispute event is caught
+func (c *AppClient) stopWatch(appChannel *AppChannel, id monitor.CallbackID) {
+	c.monitorService.RemoveEvent(id)
+	err := c.dal.UpdateAppSessionWatchBlock(appChannel.cid, 0)
+	if err != nil {
+		log.Warnln("update app session watch block error:", appChannel.cid, err)
+	}
+}
+
+func (c *AppClient) watchVirtualContractDeploy(appChannel *AppChannel, startBlock *big.Int) error {
+	monitorCfg := &monitor.Config{
+		EventName:  event.Deploy,
+		Contract:   c.nodeConfig.GetVirtResolverContract(),
+		StartBlock: startBlock,
+	}
+	if config.QuickCatchBlockDelay < config.BlockDelay {
+		monitorCfg.BlockDelay = config.QuickCatchBlockDelay
+	}
+	callbackID, err := c.monitorService.Monitor(monitorCfg,
+		func(id monitor.CallbackID, eLog types.Log) {
+			hit, _ := appChannel.onVirtualContractDeploy(&eLog)
+			if hit {
+				c.stopWatch(appChannel, id)
+			}
+		})
+	appChannel.callbackID = callbackID
+	return err
+}
+
+func (c *AppClient) watchDeployedContractSettle(appChannel *AppChannel, startBlock *big.Int) error {
+	contract, err := chain.NewBoundContract(
+		c.nodeConfig.GetEthConn(), appChannel.DeployedAddr, IMultiSessionABI)
+	if err != nil {
+		return err
+	}
+	monitorCfg := &monitor.Config{
+		EventName:  event.IntendSettle,
+		Contract:   contract,
+		StartBlock: startBlock,
+	}
+	if config.QuickCatchBlockDelay < config.BlockDelay {
+		monitorCfg.BlockDelay = config.QuickCatchBlockDelay
+	}
+	callbackID, err := c.monitorService.Monitor(monitorCfg,
+		func(id monitor.CallbackID, eLog types.Log) {
+			hit, _ := appChannel.onDeployedContractSettle(&eLog)
+			if hit {
+				c.stopWatch(appChannel, id)
+			}
+		})
+	appChannel.callbackID = callbackID
+	return err
 }
 
 func (c *AppClient) NewAppChannelOnVirtualContract(
@@ -157,6 +400,11 @@ func (c *AppClient) NewAppChannelOnVirtualContract(
 	sc common.StateCallback) (string, error) {
 
 	cid := ctype.Bytes2Hex(GetVirtualAddress(byteCode, constructor, nonce))
+	if appChannel := c.GetAppChannel(cid); appChannel != nil {
+		// app channel already exists, e.g., restored from storage after restart
+		appChannel.setCallback(sc)
+		return cid, nil
+	}
 	appChannel := &AppChannel{
 		Type:           entity.ConditionType_VIRTUAL_CONTRACT,
 		Nonce:          nonce,
@@ -169,21 +417,13 @@ func (c *AppClient) NewAppChannelOnVirtualContract(
 		cid:            cid,
 	}
 	c.PutAppChannel(cid, appChannel)
-	monitorCfg := &monitor.Config{
-		EventName:  event.Deploy,
-		Contract:   c.nodeConfig.GetVirtResolverContract(),
-		StartBlock: c.monitorService.GetCurrentBlockNumber(),
-	}
-	if config.QuickCatchBlockDelay < config.BlockDelay {
-		monitorCfg.BlockDelay = config.QuickCatchBlockDelay
+	startBlock := c.monitorService.GetCurrentBlockNumber()
+	err := c.insertAppSession(appChannel, startBlock.Uint64())
+	if err != nil {
+		log.Error(err)
+		return cid, err
 	}
-	_, err := c.monitorService.Monitor(monitorCfg,
-		func(id monitor.CallbackID, eLog types.Log) {
-			hit, _ := appChannel.onVirtualContractDeploy(&eLog)
-			if hit {
-				c.monitorService.RemoveEvent(id)
-			}
-		})
+	err = c.watchVirtualContractDeploy(appChannel, startBlock)
 	if err != nil {
 		log.Error(err)
 	}
@@ -203,6 +443,11 @@ func (c *AppClient) NewAppChannelOnDeployedContract(
 		return "", err
 	}
 	cid := ctype.Bytes2Hex(session[:])
+	if appChannel := c.GetAppChannel(cid); appChannel != nil {
+		// app channel already exists, e.g., restored from storage after restart
+		appChannel.setCallback(sc)
+		return cid, nil
+	}
 	appChannel := &AppChannel{
 		Type:           entity.ConditionType_DEPLOYED_CONTRACT,
 		Nonce:          nonce,
@@ -215,32 +460,16 @@ func (c *AppClient) NewAppChannelOnDeployedContract(
 		cid:            cid,
 	}
 	c.PutAppChannel(cid, appChannel)
-
-	contract, err := chain.NewBoundContract(
-		c.nodeConfig.GetEthConn(), contractAddr, IMultiSessionABI)
+	startBlock := c.monitorService.GetCurrentBlockNumber()
+	err = c.insertAppSession(appChannel, startBlock.Uint64())
 	if err != nil {
 		log.Error(err)
 		return cid, err
 	}
-	monitorCfg := &monitor.Config{
-		EventName:  event.IntendSettle,
-		Contract:   contract,
-		StartBlock: c.monitorService.GetCurrentBlockNumber(),
-	}
-	if config.QuickCatchBlockDelay < config.BlockDelay {
-		monitorCfg.BlockDelay = config.QuickCatchBlockDelay
-	}
-	callbackID, err := c.monitorService.Monitor(monitorCfg,
-		func(id monitor.CallbackID, eLog types.Log) {
-			hit, _ := appChannel.onDeployedContractSettle(&eLog)
-			if hit {
-				c.monitorService.RemoveEvent(id)
-			}
-		})
+	err = c.watchDeployedContractSettle(appChannel, startBlock)
 	if err != nil {
 		log.Error(err)
 	}
-	appChannel.callbackID = callbackID
 	return cid, err
 }
 
@@ -581,7 +810,7 @@ func (c *AppClient) SignAppState(cid string, seqNum uint64, state []byte) ([]byt
 	if err != nil {
 		return nil, appStateBytes, err
 	}
-	sig, err := c.signer.SignEthMessage(appStateBytes)
+	sig, err := utils.SignEthMessageOfKind(c.signer, intfs.SignKindAppState, appStateBytes)
 	if err != nil {
 		return nil, appStateBytes, err
 	}
@@ -0,0 +1,83 @@
+// Copyright 2020 Celer Network
+
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/celer-network/goCeler/common/structs"
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/entity"
+	"github.com/celer-network/goCeler/storage"
+)
+
+type testStateCallback struct {
+	disputes []int
+}
+
+func (cb *testStateCallback) OnDispute(seqNum int) {
+	cb.disputes = append(cb.disputes, seqNum)
+}
+
+func TestPendingDispute(t *testing.T) {
+	stFile := filepath.Join(os.TempDir(), "app_client_test.db")
+	os.Remove(stFile)
+	st, err := storage.NewKVStoreSQL("sqlite3", stFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(stFile)
+	defer st.Close()
+	dal := storage.NewDAL(st)
+
+	cid := "0xabcdef"
+	err = dal.InsertAppSession(&structs.AppSession{
+		ID:           cid,
+		Type:         int(entity.ConditionType_VIRTUAL_CONTRACT),
+		DeployedAddr: ctype.ZeroAddr,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// dispute caught by the restored watch before the app attaches its callback
+	c := NewAppClient(nil, nil, nil, nil, dal, nil)
+	err = c.RestoreAppChannels()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.GetAppChannel(cid).notifyDispute(0)
+
+	// delivered once the callback is attached after restart
+	c = NewAppClient(nil, nil, nil, nil, dal, nil)
+	err = c.RestoreAppChannels()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cb := &testStateCallback{}
+	for i := 0; i < 2; i++ {
+		err = c.SetAppChannelCallback(cid, cb)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(cb.disputes) != 1 || cb.disputes[0] != 0 {
+		t.Errorf("wrong disputes delivered: %v", cb.disputes)
+	}
+	_, found, err := dal.GetAppDispute(cid)
+	if err != nil || found {
+		t.Errorf("delivered dispute still persisted: %t %v", found, err)
+	}
+
+	// delivered right away once the callback is attached
+	c.GetAppChannel(cid).notifyDispute(3)
+	if len(cb.disputes) != 2 || cb.disputes[1] != 3 {
+		t.Errorf("wrong disputes delivered: %v", cb.disputes)
+	}
+	_, found, err = dal.GetAppDispute(cid)
+	if err != nil || found {
+		t.Errorf("dispute persisted with callback attached: %t %v", found, err)
+	}
+}
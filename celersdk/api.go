@@ -62,7 +62,7 @@ func InitClientWithSigner(addr, cfg, dataPath string, cb ClientCallback, signcb
 			return
 		}
 		// Note RegisterStream will trigger sign so ext signer will get callback before client ready callback
-		err = cc.RegisterStream()
+		err = registerStream(cc)
 		if err != nil {
 			cc.Close()
 			cb.HandleClientInitErr(&celersdkintf.E{Reason: err.Error(), Code: -1})
@@ -316,9 +316,29 @@ func (mc *Client) GetSettleFinalizedTimeForPaymentChannel(tokenInfo *TokenInfo)
 	return time.Int64(), nil
 }
 
-// SignData signs arbitrary data and returns the signature
+// SetExitCallback sets the callback reporting the steps of the exit policy configured by
+// exitPolicy in the profile, step is one of STARTED, PAYS_RESOLVED, INTEND_SETTLED and SETTLED
+func (mc *Client) SetExitCallback(callback ExitCallback) {
+	mc.c.SetExitCallback(callback)
+}
+
+// GetExitState returns the state of the exit job of the channel, 0 if the exit policy
+// has not been triggered for it
+func (mc *Client) GetExitState(tokenInfo *TokenInfo) (int, error) {
+	job, found, err := mc.c.GetExitJob(&entity.TokenInfo{
+		TokenType:    entity.TokenType(int32(tokenInfo.TokenType)),
+		TokenAddress: ctype.Hex2Bytes(tokenInfo.TokenAddress),
+	})
+	if err != nil || !found {
+		return 0, err
+	}
+	return job.State, nil
+}
+
+// SignData signs app session state data and returns the signature.
+// A signer checking message kinds only signs well-formed app states.
 func (mc *Client) SignData(data []byte) ([]byte, error) {
-	return mc.c.SignState(data), nil
+	return mc.c.SignAppStateBytes(data)
 }
 
 func (mc *Client) GetCurrentBlockNumber() int64 {
@@ -363,6 +383,9 @@ func (iter *PayHistoryIterator) NextPage(itemsPerPage int32) (string, error) {
 		iter.smallestPayID = ctype.PayID2Hex(ctype.ZeroPayID)
 	}
 	ts, tsSig := utils.GetTsAndSig(iter.signFunc)
+	if tsSig == nil {
+		return "[]", errors.New("auth ts sig error")
+	}
 	req := &rpc.GetPayHistoryRequest{
 		Peer:          iter.myAddr,
 		BeforeTs:      iter.beforeTs,
@@ -411,7 +434,7 @@ func (mc *Client) GetPayHistoryIterator() (*PayHistoryIterator, error) {
 		myAddr:        ctype.Addr2Hex(mc.c.GetMyEthAddr()),
 		beforeTs:      0,
 		rpcClient:     conn,
-		signFunc:      mc.c.SignState,
+		signFunc:      mc.c.SignAuthTs,
 		hasMoreResult: true,
 	}, nil
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/celer-network/goCeler/client"
 	"github.com/celer-network/goCeler/common"
 	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/entity"
 	"github.com/celer-network/goutils/log"
 	"github.com/ethereum/go-ethereum/crypto"
 	"go.uber.org/atomic"
@@ -125,6 +126,62 @@ func (mc *Client) EndAppSession(sessionid string) error {
 	return mc.c.DeleteAppChannel(sessionid)
 }
 
+// RestoreAppSession returns the app session persisted before restart and attaches
+// the callback to it. The session seqnum resumes from the latest co-signed state.
+func (mc *Client) RestoreAppSession(sessionid string, callback AppCallback) (*AppSession, error) {
+	appChannel := mc.c.GetAppChannel(sessionid)
+	if appChannel == nil {
+		return nil, ErrInvalidSession
+	}
+	err := mc.c.SetAppChannelCallback(sessionid, callback)
+	if err != nil {
+		return nil, err
+	}
+	seqNum, _ := appChannel.GetLatestStateProof()
+	return &AppSession{
+		ID:                     sessionid,
+		MyIdx:                  0, // dummy
+		cc:                     mc.c,
+		seqnum:                 atomic.NewUint64(seqNum),
+		expectNewStateFromPeer: atomic.NewBool(true),
+	}, nil
+}
+
+// ListAppSessions returns info of all app sessions, including the ones persisted before restart.
+// Due to gomobile limitation (no return list), mobile app needs to do following
+// sessionList = ListAppSessions()
+// for i=0; i<sessionList.Length; i++ {
+//     session = sessionList.Get(i)
+// }
+func (mc *Client) ListAppSessions() *AppSessionList {
+	appChannels := mc.c.GetAllAppChannels()
+	sessions := make([]*AppSessionInfo, 0, len(appChannels))
+	for _, appChannel := range appChannels {
+		seqNum, stateProof := appChannel.GetLatestStateProof()
+		var players []string
+		for _, p := range appChannel.Players {
+			players = append(players, ctype.Addr2Hex(p))
+		}
+		info := &AppSessionInfo{
+			ID:                 appChannel.GetID(),
+			OnDeployedContract: appChannel.Type == entity.ConditionType_DEPLOYED_CONTRACT,
+			Nonce:              appChannel.Nonce,
+			OnChainTimeout:     appChannel.OnChainTimeout,
+			Players:            strings.Join(players, ","),
+			SeqNum:             seqNum,
+			StateProof:         stateProof,
+		}
+		if appChannel.DeployedAddr != ctype.ZeroAddr {
+			info.DeployedAddr = ctype.Addr2Hex(appChannel.DeployedAddr)
+		}
+		sessions = append(sessions, info)
+	}
+	return &AppSessionList{
+		Length:   len(sessions),
+		Sessions: sessions,
+	}
+}
+
 // NewAppSession creates app session object for deployed contract
 // deployedAddr is eth address bytes of deployed app contract
 // matchid is the matchid string from nakama server
@@ -213,6 +270,16 @@ func (s *AppSession) HandleMatchData(opcode int, data []byte) (*AppData, error)
 			log.Errorf("%s expect:%x recv:%x", ErrDiffAckState, s.lastSentState, appstate)
 			return nil, ErrDiffAckState
 		}
+		_, seqn, _, _, err := app.DecodeAppState(appstate)
+		if err != nil {
+			return nil, err
+		}
+		// keep the co-signed state proof for on-chain settlement after restart
+		err = s.cc.UpdateAppChannelStateProof(s.ID, seqn, data)
+		if err != nil {
+			log.Error(err)
+			return nil, err
+		}
 		return new(AppData), nil
 	case OPCODE_NEWSTATE:
 		nonce, seqn, recv, timeout, err := app.DecodeAppState(appstate)
@@ -267,6 +334,11 @@ func (s *AppSession) HandleMatchData(opcode int, data []byte) (*AppData, error)
 			log.Error(err)
 			return nil, err
 		}
+		err = s.cc.UpdateAppChannelStateProof(s.ID, seqn, ackMsg)
+		if err != nil {
+			log.Error(err)
+			return nil, err
+		}
 		return &AppData{
 			Received: recv,
 			AckMsg:   ackMsg,
@@ -10,6 +10,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/celer-network/goCeler/common"
 	"github.com/celer-network/goCeler/ctype"
 	"github.com/celer-network/goutils/eth"
 	"github.com/celer-network/goutils/log"
@@ -47,6 +48,13 @@ type ExternalSignerCallback interface {
 	OnSignTransaction(reqid int, rawtx []byte)
 }
 
+// ExternalHashSignerCallback is optionally implemented along with ExternalSignerCallback
+// to sign a 32-byte hash as is without the eth message prefix, eg. the EIP-712 hash of
+// an ERC20 permit. Without it, ERC20 deposits fall back to approve tx.
+type ExternalHashSignerCallback interface {
+	OnSignHash(reqid int, hash []byte)
+}
+
 // SDK API for mobile to call to send back sign result
 // if mobile has error, send nil result so goCeler will know sign failed
 func PublishSignedResult(reqid int, result []byte) error {
@@ -179,6 +187,34 @@ func (es *extSignerManager) SignEthTransaction(rawtx []byte) ([]byte, error) {
 	}
 }
 
+// intfs.HashSigner, blocking till mobile calls result api with matched reqid
+func (es *extSignerManager) SignHash(hash []byte) ([]byte, error) {
+	if es.cb == nil {
+		return nil, ErrNilExtSigner
+	}
+	hcb, ok := es.cb.(ExternalHashSignerCallback)
+	if !ok {
+		return nil, common.ErrHashSignUnsupported
+	}
+	id, c := es.newSeqChan()
+	log.Debugf("sign hash %d %x", id, hash)
+	go hcb.OnSignHash(id, hash) // trigger cb
+	t := time.NewTimer(SignTimeout)
+	select {
+	case <-t.C:
+		log.Debug("sign hash timeout")
+		return nil, ErrResultTimeout
+	case ret := <-c: // received result bytes, m[id]chan will be closed by SendSignResult
+		t.Stop()
+		if ret == nil {
+			log.Debug("sign hash nil result")
+			return nil, ErrNilResult
+		}
+		log.Debugf("sign hash res %x", ret)
+		return ret, nil
+	}
+}
+
 // called by mobile to send back signed result, write to matched chan to unblock SignXXX
 func (es *extSignerManager) SendSignResult(reqid int, result []byte) error {
 	es.mux.RLock()
@@ -6,6 +6,7 @@ package celersdk
 
 import (
 	"errors"
+	"math/big"
 
 	"github.com/celer-network/goCeler/celersdkintf"
 	"github.com/celer-network/goCeler/ctype"
@@ -26,14 +27,24 @@ func (mc *Client) SendETH(receiver string, amtWei string, noteTypeUrl string, no
 
 // SendETH sends ERC20/ETH token to receiver. Caller can optionally add a note in the pay.
 func (mc *Client) SendToken(tk *Token, receiver string, amtWei string, noteTypeUrl string, noteValueByte []byte) (string, error) {
+	return mc.SendTokenWithFee(tk, receiver, amtWei, "", noteTypeUrl, noteValueByte)
+}
 
+// SendTokenWithFee sends ERC20/ETH token to receiver along with the relay fee quoted by GetPayFee.
+// The fee is paid to OSP when the pay is sent, and is not refunded if the pay fails or is canceled.
+func (mc *Client) SendTokenWithFee(
+	tk *Token, receiver string, amtWei string, feeWei string, noteTypeUrl string, noteValueByte []byte) (string, error) {
+	fee, err := parseFee(feeWei)
+	if err != nil {
+		return ctype.ZeroPayIDHex, err
+	}
 	xfer := createXfer(tk, receiver, amtWei)
 	note := &any.Any{
 		TypeUrl: noteTypeUrl,
 		Value:   noteValueByte,
 	}
-	payID, err := mc.c.AddBooleanPay(
-		xfer, []*entity.Condition{}, mc.c.GetCurrentBlockNumberUint64()+cPayTimeout, note, 0)
+	payID, err := mc.c.AddBooleanPayWithFee(
+		xfer, []*entity.Condition{}, mc.c.GetCurrentBlockNumberUint64()+cPayTimeout, note, 0, fee)
 	if err != nil {
 		log.Errorln("SendToken:", err)
 		return ctype.ZeroPayIDHex, err
@@ -70,6 +81,31 @@ func (mc *Client) SendTokenWithCondition(tk *Token, receiver string, amtWei stri
 	return ret, nil
 }
 
+// GetPayFee returns the relay fee in wei that OSP charges for sending amtWei to receiver, if tk is nil, means ETH.
+// Pays sent without the fee are rejected by OSPs that charge relay fees.
+func (mc *Client) GetPayFee(tk *Token, receiver string, amtWei string) (string, error) {
+	amt := utils.Wei2BigInt(amtWei)
+	if amt == nil {
+		return "", errors.New("invalid amount " + amtWei)
+	}
+	fee, err := mc.c.GetPayFee(sdkToken2entityToken(tk), ctype.Hex2Addr(receiver), amt)
+	if err != nil {
+		return "", err
+	}
+	return fee.String(), nil
+}
+
+func parseFee(feeWei string) (*big.Int, error) {
+	if feeWei == "" {
+		return nil, nil
+	}
+	fee := utils.Wei2BigInt(feeWei)
+	if fee == nil || fee.Sign() < 0 {
+		return nil, errors.New("invalid fee " + feeWei)
+	}
+	return fee, nil
+}
+
 // ConfirmPay settles the condpay, ie. actually paid to pay dest
 func (mc *Client) ConfirmPay(payID string) error {
 	return mc.c.ConfirmBooleanPay(ctype.Hex2PayID(payID))
@@ -101,6 +137,18 @@ func (mc *Client) ConfirmOnChainResolvedPays(tk *Token) error {
 	return mc.c.ConfirmOnChainResolvedPays(token)
 }
 
+// StartPayClearer starts a background job that settles pending pays with the OSP once they
+// expire, and falls back to resolving pays on chain and intend settling the channel
+// if the OSP does not cooperate. callback can be nil if the steps are not needed.
+func (mc *Client) StartPayClearer(callback PayClearCallback) {
+	mc.c.StartPayClearer(callback)
+}
+
+// StopPayClearer stops the pay clearer background job
+func (mc *Client) StopPayClearer() {
+	mc.c.StopPayClearer()
+}
+
 // Get incoming payment status code
 func (mc *Client) GetIncomingPaymentStatus(payId string) int {
 	return mc.c.GetIncomingPaymentStatus(ctype.Hex2PayID(payId))
@@ -135,6 +183,24 @@ func (mc *Client) SendConditionalPayment(
 	conditions []*Condition,
 	timeout int64,
 	note *any.Any) (string, error) {
+	return mc.SendConditionalPaymentWithFee(
+		tokenInfo, destination, amount, "", transferLogicType, conditions, timeout, note)
+}
+
+// SendConditionalPaymentWithFee is SendConditionalPayment with the relay fee quoted by GetPayFee
+func (mc *Client) SendConditionalPaymentWithFee(
+	tokenInfo *TokenInfo,
+	destination string,
+	amount string,
+	fee string,
+	transferLogicType TransferLogicType,
+	conditions []*Condition,
+	timeout int64,
+	note *any.Any) (string, error) {
+	feeAmt, err := parseFee(fee)
+	if err != nil {
+		return ctype.ZeroPayIDHex, err
+	}
 	if transferLogicType != transferLogicTypeBooleanAnd {
 		return "", errors.New("Unsupported transfer logic type")
 	}
@@ -153,11 +219,11 @@ func (mc *Client) SendConditionalPayment(
 	for i, condition := range conditions {
 		entityConditions[i] = conditionToEntityCondition(condition)
 	}
-	payID, err := mc.c.AddBooleanPay(
+	payID, err := mc.c.AddBooleanPayWithFee(
 		transfer,
 		entityConditions,
 		mc.c.GetCurrentBlockNumberUint64()+uint64(timeout),
-		note, 0)
+		note, 0, feeAmt)
 	if err != nil {
 		log.Error(err)
 		return ctype.ZeroPayIDHex, err
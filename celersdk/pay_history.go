@@ -0,0 +1,127 @@
+// Copyright 2020 Celer Network
+
+package celersdk
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/celer-network/goCeler/common/structs"
+	"github.com/celer-network/goCeler/ctype"
+)
+
+const (
+	PayHistoryFormatJSON = "json"
+	PayHistoryFormatCSV  = "csv"
+)
+
+// PayHistoryFilter selects pays in the local pay history, zero value fields match all
+type PayHistoryFilter struct {
+	Counterparty string     // hex address of the pay source or destination
+	Token        *TokenInfo // nil for all tokens
+	Status       int        // celersdkintf.PAY_STATUS_*
+	SinceTs      int64      // unix seconds, inclusive
+	UntilTs      int64      // unix seconds, exclusive
+	Limit        int        // max number of pays
+}
+
+// payHistoryRecord is the exported form of a local pay history item,
+// json keys are the same as webapi PayHistoryItem
+type payHistoryRecord struct {
+	PayID    string `json:"pay_id"`
+	Src      string `json:"src"`
+	Dst      string `json:"dst"`
+	Token    string `json:"token"`
+	Amt      string `json:"amt"`
+	Status   int    `json:"status"`
+	CreateTs int64  `json:"create_ts"`
+	UpdateTs int64  `json:"update_ts"`
+}
+
+var payHistoryCSVHeader = []string{"pay_id", "src", "dst", "token", "amt", "status", "create_ts", "update_ts"}
+
+func (f *PayHistoryFilter) toStructs() *structs.PayHistoryFilter {
+	filter := &structs.PayHistoryFilter{}
+	if f == nil {
+		return filter
+	}
+	if f.Counterparty != "" {
+		filter.Counterparty = ctype.Hex2Addr(f.Counterparty)
+	}
+	if f.Token != nil {
+		token := ctype.ZeroAddr
+		if f.Token.TokenType != tokenTypeEth {
+			token = ctype.Hex2Addr(f.Token.TokenAddress)
+		}
+		filter.Token = &token
+	}
+	filter.Status = f.Status
+	if f.SinceTs > 0 {
+		filter.Since = time.Unix(f.SinceTs, 0)
+	}
+	if f.UntilTs > 0 {
+		filter.Until = time.Unix(f.UntilTs, 0)
+	}
+	filter.Limit = f.Limit
+	return filter
+}
+
+func (mc *Client) getPayHistoryRecords(filter *PayHistoryFilter) ([]*payHistoryRecord, error) {
+	items, err := mc.c.GetPayHistory(filter.toStructs())
+	if err != nil {
+		return nil, err
+	}
+	records := make([]*payHistoryRecord, 0, len(items))
+	for _, item := range items {
+		records = append(records, &payHistoryRecord{
+			PayID:    ctype.PayID2Hex(item.PayID),
+			Src:      ctype.Addr2Hex(item.Src),
+			Dst:      ctype.Addr2Hex(item.Dest),
+			Token:    ctype.Addr2Hex(item.Token),
+			Amt:      item.Amt.String(),
+			Status:   item.Status,
+			CreateTs: item.CreateTs.Unix(),
+			UpdateTs: item.UpdateTs.Unix(),
+		})
+	}
+	return records, nil
+}
+
+// ExportPayHistory returns pays in the local pay history matching the filter in reverse-chronological
+// order, encoded in the format of "json" (array of pays) or "csv" (with header line).
+// Unlike PayHistoryIterator, the local pay history is kept by the client and survives switching OSPs.
+func (mc *Client) ExportPayHistory(filter *PayHistoryFilter, format string) (string, error) {
+	records, err := mc.getPayHistoryRecords(filter)
+	if err != nil {
+		return "", err
+	}
+	switch format {
+	case PayHistoryFormatJSON:
+		data, err := json.Marshal(records)
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	case PayHistoryFormatCSV:
+		var buf bytes.Buffer
+		w := csv.NewWriter(&buf)
+		w.Write(payHistoryCSVHeader)
+		for _, r := range records {
+			w.Write([]string{r.PayID, r.Src, r.Dst, r.Token, r.Amt, strconv.Itoa(r.Status),
+				strconv.FormatInt(r.CreateTs, 10), strconv.FormatInt(r.UpdateTs, 10)})
+		}
+		w.Flush()
+		return buf.String(), w.Error()
+	}
+	return "", errors.New("unsupported pay history format " + format)
+}
+
+// SyncPayHistory merges the pay history kept by the OSP into the local pay history.
+// It also runs automatically every time the client (re)connects to the OSP.
+func (mc *Client) SyncPayHistory() error {
+	return mc.c.SyncPayHistoryWithOsp()
+}
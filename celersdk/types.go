@@ -3,6 +3,8 @@
 package celersdk
 
 import (
+	"errors"
+
 	"github.com/celer-network/goCeler/celersdkintf"
 )
 
@@ -35,6 +37,20 @@ type CooperativeWithdrawCallback interface {
 	OnError(withdrawHash string, err string)
 }
 
+// PayClearCallback reports the steps taken by the pay clearer to settle a pending pay,
+// step is one of SETTLE_REQUESTED, EXPIRE_PROOF_SENT, RESOLVED_ON_CHAIN, SETTLE_PROOF_SENT,
+// INTEND_SETTLE and CLEARED
+type PayClearCallback interface {
+	OnPayClearStep(payID string, cid string, step string)
+	OnError(payID string, err string)
+}
+
+// ExitCallback reports the steps taken by the exit policy to settle a channel on chain
+type ExitCallback interface {
+	OnExitStep(cid string, step string)
+	OnError(cid string, err string)
+}
+
 type Account struct {
 	Keystore string
 	Password string
@@ -124,3 +140,28 @@ type UserInfo struct {
 	EmailAddr  string
 	Name       string
 }
+
+// AppSessionInfo describes a persisted app session
+type AppSessionInfo struct {
+	ID                 string
+	OnDeployedContract bool   // false if the session is based on a virtual contract
+	DeployedAddr       string // empty for undeployed virtual contract
+	Nonce              uint64
+	OnChainTimeout     uint64
+	Players            string // comma-separated player addresses, only for deployed contract
+	SeqNum             uint64 // seq num of the latest co-signed state
+	StateProof         []byte // latest co-signed app state proof
+}
+
+// AppSessionList returns an array of app session info
+type AppSessionList struct {
+	Length   int
+	Sessions []*AppSessionInfo // will be skipped by gomobile due to unsupported type
+}
+
+func (l *AppSessionList) Get(idx int) (*AppSessionInfo, error) {
+	if idx >= 0 && idx < len(l.Sessions) {
+		return l.Sessions[idx], nil
+	}
+	return nil, errors.New("invalid index for app session list")
+}
@@ -34,7 +34,7 @@ func newClient(
 	if cclient == nil {
 		return nil, err
 	}
-	err = cclient.RegisterStream()
+	err = registerStream(cclient)
 	if err != nil {
 		cclient.Close()
 		return nil, err
@@ -45,6 +45,18 @@ func newClient(
 	}, nil
 }
 
+// registerStream registers the stream with OSP. If OSP is unreachable and the exit policy
+// may settle the channels, the client is kept running and retries in the background.
+func registerStream(cclient *client.CelerClient) error {
+	err := cclient.RegisterStream()
+	if err != nil && cclient.OfflineExitEnabled() {
+		log.Warnln("OSP unreachable, retry in background for the exit policy:", err)
+		cclient.RegisterStreamInBackground()
+		return nil
+	}
+	return err
+}
+
 func createXfer(tk *Token, receiver, amtWei string) *entity.TokenTransfer {
 	xfer := &entity.TokenTransfer{
 		Token: sdkToken2entityToken(tk),
@@ -0,0 +1,105 @@
+// Copyright 2020 Celer Network
+
+package chain
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ERC20PermitABI is the ABI of the EIP-2612 permit extension of ERC20 tokens
+const ERC20PermitABI = "[{\"inputs\":[],\"name\":\"DOMAIN_SEPARATOR\",\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"nonces\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"name\":\"owner\",\"type\":\"address\"},{\"name\":\"spender\",\"type\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\"},{\"name\":\"deadline\",\"type\":\"uint256\"},{\"name\":\"v\",\"type\":\"uint8\"},{\"name\":\"r\",\"type\":\"bytes32\"},{\"name\":\"s\",\"type\":\"bytes32\"}],\"name\":\"permit\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+
+// permitTypeHash is keccak256("Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)")
+var permitTypeHash = crypto.Keccak256(
+	[]byte("Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)"))
+
+// ERC20PermitCaller is a read-only binding of the EIP-2612 permit extension
+type ERC20PermitCaller struct {
+	contract *bind.BoundContract
+}
+
+// ERC20PermitTransactor is a write-only binding of the EIP-2612 permit extension
+type ERC20PermitTransactor struct {
+	contract *bind.BoundContract
+}
+
+func bindERC20Permit(
+	address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(ERC20PermitABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, nil), nil
+}
+
+// NewERC20PermitCaller creates a read-only binding of the permit extension of a token
+func NewERC20PermitCaller(address common.Address, caller bind.ContractCaller) (*ERC20PermitCaller, error) {
+	contract, err := bindERC20Permit(address, caller, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC20PermitCaller{contract: contract}, nil
+}
+
+// NewERC20PermitTransactor creates a write-only binding of the permit extension of a token
+func NewERC20PermitTransactor(address common.Address, transactor bind.ContractTransactor) (*ERC20PermitTransactor, error) {
+	contract, err := bindERC20Permit(address, nil, transactor)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC20PermitTransactor{contract: contract}, nil
+}
+
+// DomainSeparator returns the EIP-712 domain separator of the token
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_ERC20Permit *ERC20PermitCaller) DomainSeparator(opts *bind.CallOpts) ([32]byte, error) {
+	var (
+		ret0 = new([32]byte)
+	)
+	out := ret0
+	err := _ERC20Permit.contract.Call(opts, out, "DOMAIN_SEPARATOR")
+	return *ret0, err
+}
+
+// Nonces returns the current permit nonce of the owner
+//
+// Solidity: function nonces(address owner) view returns(uint256)
+func (_ERC20Permit *ERC20PermitCaller) Nonces(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _ERC20Permit.contract.Call(opts, out, "nonces", owner)
+	return *ret0, err
+}
+
+// Permit sets the allowance of the spender with a signed permit of the owner
+//
+// Solidity: function permit(address owner, address spender, uint256 value, uint256 deadline, uint8 v, bytes32 r, bytes32 s)
+func (_ERC20Permit *ERC20PermitTransactor) Permit(
+	opts *bind.TransactOpts, owner common.Address, spender common.Address, value *big.Int, deadline *big.Int,
+	v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _ERC20Permit.contract.Transact(opts, "permit", owner, spender, value, deadline, v, r, s)
+}
+
+// PermitDigest returns the EIP-712 hash of a permit to be signed by the owner
+func PermitDigest(
+	domainSeparator [32]byte, owner, spender common.Address, value, nonce, deadline *big.Int) []byte {
+	structHash := crypto.Keccak256(
+		permitTypeHash,
+		common.LeftPadBytes(owner.Bytes(), 32),
+		common.LeftPadBytes(spender.Bytes(), 32),
+		math.U256Bytes(new(big.Int).Set(value)),
+		math.U256Bytes(new(big.Int).Set(nonce)),
+		math.U256Bytes(new(big.Int).Set(deadline)))
+	return crypto.Keccak256([]byte("\x19\x01"), domainSeparator[:], structHash)
+}
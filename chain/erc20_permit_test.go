@@ -0,0 +1,77 @@
+// Copyright 2020 Celer Network
+
+package chain
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core"
+)
+
+func TestPermitDigest(t *testing.T) {
+	owner := common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+	spender := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	value, nonce, deadline := big.NewInt(1000000), big.NewInt(3), big.NewInt(1600000000)
+
+	// EIP-712 typed data of the permit, hashed by the go-ethereum signer
+	typedData := &core.TypedData{
+		Types: core.Types{
+			"EIP712Domain": []core.Type{
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"Permit": []core.Type{
+				{Name: "owner", Type: "address"},
+				{Name: "spender", Type: "address"},
+				{Name: "value", Type: "uint256"},
+				{Name: "nonce", Type: "uint256"},
+				{Name: "deadline", Type: "uint256"},
+			},
+		},
+		PrimaryType: "Permit",
+		Domain: core.TypedDataDomain{
+			Name:              "Test Token",
+			Version:           "1",
+			ChainId:           math.NewHexOrDecimal256(1),
+			VerifyingContract: "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC",
+		},
+		Message: core.TypedDataMessage{
+			"owner":    owner.Hex(),
+			"spender":  spender.Hex(),
+			"value":    value.String(),
+			"nonce":    nonce.String(),
+			"deadline": deadline.String(),
+		},
+	}
+	domainHash, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	if err != nil {
+		t.Fatal(err)
+	}
+	messageHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := crypto.Keccak256([]byte("\x19\x01"), domainHash, messageHash)
+
+	var domainSeparator [32]byte
+	copy(domainSeparator[:], domainHash)
+	digest := PermitDigest(domainSeparator, owner, spender, value, nonce, deadline)
+	if !bytes.Equal(digest, expected) {
+		t.Errorf("wrong permit digest %x, expected %x", digest, expected)
+	}
+	if hex.EncodeToString(digest) != permitDigestVector {
+		t.Errorf("permit digest %x does not match the test vector", digest)
+	}
+}
+
+// permitDigestVector is the EIP-712 digest of the permit in TestPermitDigest, it must not change
+// with the go-ethereum version
+const permitDigestVector = "5ec41687973b4834d78cc11cca287cc9aa73186bfd12ad8bef5f85d26643df37"
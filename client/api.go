@@ -3,10 +3,14 @@
 package client
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"math/big"
 	"time"
 
+	"github.com/celer-network/goCeler/celersdkintf"
+	"github.com/celer-network/goCeler/cnode"
 	"github.com/celer-network/goCeler/common"
 	"github.com/celer-network/goCeler/common/event"
 	"github.com/celer-network/goCeler/config"
@@ -127,6 +131,14 @@ func (c *CelerClient) InstantiateChannelForToken(token *entity.TokenInfo, appcb
 // returns payId or err
 func (c *CelerClient) AddBooleanPay(
 	xfer *entity.TokenTransfer, conds []*entity.Condition, resolveDeadline uint64, note *any.Any, dstNetId uint64) (ctype.PayIDType, error) {
+	return c.AddBooleanPayWithFee(xfer, conds, resolveDeadline, note, dstNetId, nil)
+}
+
+// AddBooleanPayWithFee is AddBooleanPay with the relay fee for the OSPs forwarding the pay, see GetPayFee.
+// The fee is paid to OSP along with the pay and is not refunded if the pay is canceled.
+func (c *CelerClient) AddBooleanPayWithFee(
+	xfer *entity.TokenTransfer, conds []*entity.Condition, resolveDeadline uint64, note *any.Any, dstNetId uint64,
+	fee *big.Int) (ctype.PayIDType, error) {
 
 	if xfer == nil || xfer.Receiver == nil || xfer.Receiver.Account == nil {
 		return ctype.ZeroPayID, common.ErrInvalidArg
@@ -151,16 +163,42 @@ func (c *CelerClient) AddBooleanPay(
 	var payID ctype.PayIDType
 	var cnoderr error
 	for i := 0; i < 10; i++ {
-		payID, cnoderr = c.cNode.AddBooleanPay(pay, note, dstNetId)
+		payID, cnoderr = c.cNode.AddBooleanPayWithFee(pay, note, dstNetId, fee)
 		if cnoderr != common.ErrPendingSimplex {
 			break
 		}
 		log.Warn("pending simplexstate, retry: ", i)
 		time.Sleep(200 * time.Millisecond)
 	}
+	if cnoderr == nil {
+		c.recordPayHistory(payID, pay, celersdkintf.PAY_STATUS_INITIALIZING)
+	}
 	return payID, cnoderr
 }
 
+// GetPayFee asks OSP for the relay fee of sending a pay of amt to dst on the token
+func (c *CelerClient) GetPayFee(token *entity.TokenInfo, dst ctype.Addr, amt *big.Int) (*big.Int, error) {
+	rpcClient, err := c.GetRpcClientToOsp()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 4*time.Second)
+	defer cancel()
+	resp, err := rpcClient.GetPayFee(ctx, &rpc.GetPayFeeRequest{
+		Token: utils.GetTokenAddrStr(token),
+		Dst:   ctype.Addr2Hex(dst),
+		Amt:   amt.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("GetPayFee err: %w", err)
+	}
+	fee := utils.Wei2BigInt(resp.GetFee())
+	if fee == nil {
+		return nil, fmt.Errorf("invalid fee %s", resp.GetFee())
+	}
+	return fee, nil
+}
+
 func (c *CelerClient) ConfirmBooleanPay(payID ctype.PayIDType) error {
 	return c.cNode.ConfirmBooleanPay(payID)
 }
@@ -213,8 +251,14 @@ func (c *CelerClient) getCidFromTokenInfo(token *entity.TokenInfo) (ctype.CidTyp
 	return cid, found
 }
 
-func (c *CelerClient) SignState(in []byte) []byte {
-	return c.cNode.SignState(in)
+// SignAuthTs signs the auth timestamp bytes, returns nil on error
+func (c *CelerClient) SignAuthTs(in []byte) []byte {
+	return c.cNode.SignAuthTs(in)
+}
+
+// SignAppStateBytes signs the app session state bytes
+func (c *CelerClient) SignAppStateBytes(in []byte) ([]byte, error) {
+	return c.cNode.SignAppState(in)
 }
 
 // ResolveCondPayOnChain tries to resolve a payment onchain in the PayRegistry
@@ -222,6 +266,16 @@ func (c *CelerClient) ResolveCondPayOnChain(payID ctype.PayIDType) error {
 	return c.cNode.Disputer.SettleConditionalPay(payID)
 }
 
+// StartPayClearer starts the background job to clear pending pays with the OSP,
+// see cnode.StartPayClearer
+func (c *CelerClient) StartPayClearer(cb cnode.PayClearCallback) {
+	c.cNode.StartPayClearer(cb)
+}
+
+func (c *CelerClient) StopPayClearer() {
+	c.cNode.StopPayClearer()
+}
+
 func (c *CelerClient) GetCondPayInfoFromRegistry(payID ctype.PayIDType) (*big.Int, uint64, error) {
 	return c.cNode.Disputer.GetCondPayInfoFromRegistry(payID)
 }
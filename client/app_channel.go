@@ -32,7 +32,7 @@ func (c *CelerClient) NewAppChannelOnDeployedContract(
 	return c.cNode.AppClient.NewAppChannelOnDeployedContract(contractAddr, nonce, players, onchainTimeout, sc)
 }
 
-// DeleteAppChannel removes the app channel info from the in memory map
+// DeleteAppChannel removes the app channel info from the in memory map and the storage
 func (c *CelerClient) DeleteAppChannel(cid string) error {
 	c.cNode.AppClient.DeleteAppChannel(cid)
 	return nil
@@ -53,6 +53,21 @@ func (c *CelerClient) GetAppChannel(cid string) *app.AppChannel {
 	return c.cNode.AppClient.GetAppChannel(cid)
 }
 
+// GetAllAppChannels returns all app channels, including the ones restored from storage
+func (c *CelerClient) GetAllAppChannels() []*app.AppChannel {
+	return c.cNode.AppClient.GetAllAppChannels()
+}
+
+// SetAppChannelCallback attaches the state callback to an app channel restored from storage
+func (c *CelerClient) SetAppChannelCallback(cid string, sc common.StateCallback) error {
+	return c.cNode.AppClient.SetAppChannelCallback(cid, sc)
+}
+
+// UpdateAppChannelStateProof persists the latest co-signed state proof of an app channel
+func (c *CelerClient) UpdateAppChannelStateProof(cid string, seqNum uint64, stateProof []byte) error {
+	return c.cNode.AppClient.UpdateAppChannelStateProof(cid, seqNum, stateProof)
+}
+
 // SignAppState returns 1: proto serialized app state, 2: signature, 3: error
 func (c *CelerClient) SignAppState(cid string, seqNum uint64, state []byte) ([]byte, []byte, error) {
 	return c.cNode.AppClient.SignAppState(cid, seqNum, state)
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sync"
 	"time"
 
 	"github.com/celer-network/goCeler/celersdkintf"
@@ -47,6 +48,17 @@ type CelerClient struct {
 	svrEth        ctype.Addr           // OSP ETH address
 	dal           *storage.DAL         // database
 	onClientEvent clientCallbackAdapter
+	// serialize status updates of the local pay history
+	payHistoryLock sync.Mutex
+	// exit policy state, see exit_policy.go
+	exitPolicy    *common.ExitPolicy
+	exitCb        ExitCallback
+	streamUp      bool
+	offlineSince  time.Time     // start of the current offline period of the stream to OSP
+	offlineBefore time.Duration // offline time of the stream carried over from previous runs
+	exitLock      sync.Mutex
+	// signal for goroutines to exit
+	quit chan bool
 }
 
 func condPayToPayment(
@@ -85,6 +97,7 @@ func condPayToPayment(
 }
 
 func (c *CelerClient) HandleReceivingStart(payID ctype.PayIDType, pay *entity.ConditionalPay, note *any.Any) {
+	c.recordPayHistory(payID, pay, celersdkintf.PAY_STATUS_PENDING)
 	if c.onClientEvent != nil {
 		c.onClientEvent.HandleRecvStart(condPayToPayment(payID, pay, note, celersdkintf.PAY_STATUS_PENDING))
 	}
@@ -114,8 +127,9 @@ func (c *CelerClient) HandleReceivingDone(
 	pay *entity.ConditionalPay,
 	note *any.Any,
 	reason rpc.PaymentSettleReason) {
+	status := settleReasonToPayStatus(reason)
+	c.recordPayHistory(payID, pay, status)
 	if c.onClientEvent != nil {
-		status := settleReasonToPayStatus(reason)
 		c.onClientEvent.HandleRecvDone(condPayToPayment(payID, pay, note, status))
 	}
 }
@@ -125,13 +139,15 @@ func (r *CelerClient) HandleSendComplete(
 	pay *entity.ConditionalPay,
 	note *any.Any,
 	reason rpc.PaymentSettleReason) {
+	status := settleReasonToPayStatus(reason)
+	r.recordPayHistory(payID, pay, status)
 	if r.onClientEvent != nil {
-		status := settleReasonToPayStatus(reason)
 		r.onClientEvent.HandleSendComplete(condPayToPayment(payID, pay, note, status))
 	}
 }
 
 func (r *CelerClient) HandleDestinationUnreachable(payID ctype.PayIDType, pay *entity.ConditionalPay, note *any.Any) {
+	r.recordPayHistory(payID, pay, celersdkintf.PAY_STATUS_UNPAID_DEST_UNREACHABLE)
 	if r.onClientEvent != nil {
 		r.onClientEvent.HandleSendErr(
 			condPayToPayment(payID, pay, note, celersdkintf.PAY_STATUS_UNPAID_DEST_UNREACHABLE),
@@ -140,6 +156,7 @@ func (r *CelerClient) HandleDestinationUnreachable(payID ctype.PayIDType, pay *e
 }
 
 func (r *CelerClient) HandleSendFail(payID ctype.PayIDType, pay *entity.ConditionalPay, note *any.Any, errMsg string) {
+	r.recordPayHistory(payID, pay, celersdkintf.PAY_STATUS_UNPAID)
 	if r.onClientEvent != nil {
 		r.onClientEvent.HandleSendErr(
 			condPayToPayment(payID, pay, note, celersdkintf.PAY_STATUS_UNPAID),
@@ -149,7 +166,7 @@ func (r *CelerClient) HandleSendFail(payID ctype.PayIDType, pay *entity.Conditio
 
 func NewCelerClient(
 	keyStore string, passPhrase string, profile common.CProfile, clientCallback clientCallbackAdapter) (*CelerClient, error) {
-	c := &CelerClient{}
+	c := &CelerClient{quit: make(chan bool)}
 	var err error
 	masterTxConfig := eth.NewTransactorConfig(keyStore, passPhrase)
 	c.cNode, err = cnode.NewCNode(
@@ -170,7 +187,7 @@ func NewCelerClient(
 func NewCelerClientWithExternalSigner(
 	address ctype.Addr, signer eth.Signer, profile common.CProfile,
 	clientCallback clientCallbackAdapter) (*CelerClient, error) {
-	c := &CelerClient{}
+	c := &CelerClient{quit: make(chan bool)}
 	var err error
 	c.cNode, err = cnode.NewCNodeWithExternalSigner(address, signer, profile)
 	if err != nil {
@@ -188,6 +205,11 @@ func (c *CelerClient) initialize(profile common.CProfile, clientCallback clientC
 	c.svrEth = ctype.Hex2Addr(profile.SvrETHAddr)
 	c.cNode.OnReceivingToken(c)
 	c.cNode.OnSendToken(c)
+	err := c.cNode.AppClient.RestoreAppChannels()
+	if err != nil {
+		log.Errorln("restore app channels error:", err)
+	}
+	c.initExitPolicy(profile.ExitPolicy)
 }
 
 // Close tries to close db and networking then set c.cNode to nil
@@ -196,6 +218,7 @@ func (c *CelerClient) initialize(profile common.CProfile, clientCallback clientC
 // all components must honor and exit cleanly
 func (c *CelerClient) Close() {
 	if c.cNode != nil {
+		close(c.quit)
 		c.cNode.Close()
 		c.cNode = nil
 	}
@@ -232,6 +255,7 @@ func (c *CelerClient) RegisterStream() error {
 		log.Errorln("RegisterStream failed:", c.svrEth.Hex(), c.svr, err)
 		return fmt.Errorf("RegisterStream failed: %w", err)
 	}
+	c.onStreamConnected()
 
 	// Register the callback to handle stream errors and try to reconnect.
 	// TODO: note that such a two-step API has a tiny race-condition window
@@ -239,30 +263,56 @@ func (c *CelerClient) RegisterStream() error {
 	// RegisterStream() and before RegisterStreamErrCallback() is done.
 	// The next design should either allow them both to be done atomically
 	// or allow RegisterStreamErrCallback() before RegisterStream().
-	streamRetryCb := func(addr ctype.Addr, streamErr error) {
-		log.Infoln("streamRetryCb triggered for", addr.Hex(), streamErr)
-		delay := time.Second * 10
-		maxdelay := time.Minute
-
-		for {
-			log.Debugln("streamRetryCb: try to register again", addr.Hex())
-			err := c.cNode.RegisterStream(c.svrEth, c.svr)
-			if err == nil {
-				break
-			}
-			log.Errorln("streamRetryCb: register failed", addr.Hex(), err)
-			time.Sleep(delay)
-			delay += time.Second * 10
-			if delay > maxdelay {
-				delay = maxdelay
-			}
+	c.cNode.RegisterStreamErrCallback(c.svrEth, c.streamRetryCb)
+	c.syncPayHistory()
+	return nil
+}
+
+// RegisterStreamInBackground keeps trying to register the stream with OSP until succeeded,
+// used when the client should keep running while OSP is unreachable.
+func (c *CelerClient) RegisterStreamInBackground() {
+	go func() {
+		if c.reconnectStream() {
+			c.cNode.RegisterStreamErrCallback(c.svrEth, c.streamRetryCb)
 		}
+	}()
+}
 
+func (c *CelerClient) streamRetryCb(addr ctype.Addr, streamErr error) {
+	log.Infoln("streamRetryCb triggered for", addr.Hex(), streamErr)
+	c.onStreamDisconnected()
+	if c.reconnectStream() {
 		log.Infoln("streamRetry:Cb successful re-register", addr.Hex())
 	}
+}
+
+// reconnectStream registers the stream with increasing delays until succeeded or the client
+// is closed. Returns true if the stream is registered.
+func (c *CelerClient) reconnectStream() bool {
+	delay := time.Second * 10
+	maxdelay := time.Minute
+
+	for {
+		log.Debugln("reconnectStream: try to register again", c.svrEth.Hex())
+		err := c.cNode.RegisterStream(c.svrEth, c.svr)
+		if err == nil {
+			break
+		}
+		log.Errorln("reconnectStream: register failed", c.svrEth.Hex(), err)
+		select {
+		case <-c.quit:
+			return false
+		case <-time.After(delay):
+		}
+		delay += time.Second * 10
+		if delay > maxdelay {
+			delay = maxdelay
+		}
+	}
 
-	c.cNode.RegisterStreamErrCallback(c.svrEth, streamRetryCb)
-	return nil
+	c.onStreamConnected()
+	c.syncPayHistory()
+	return true
 }
 
 // IntendSettlePaymentChannel starts payment channel settling process
@@ -0,0 +1,303 @@
+// Copyright 2020 Celer Network
+
+package client
+
+import (
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/celer-network/goCeler/common"
+	"github.com/celer-network/goCeler/common/structs"
+	"github.com/celer-network/goCeler/config"
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/entity"
+	"github.com/celer-network/goCeler/rpc"
+	"github.com/celer-network/goCeler/utils"
+	"github.com/celer-network/goutils/log"
+)
+
+// Steps reported by the exit policy
+const (
+	ExitStep_STARTED        = "STARTED"        // exit job created for the channel
+	ExitStep_PAYS_RESOLVED  = "PAYS_RESOLVED"  // incoming pays with revealed secret resolved on chain
+	ExitStep_INTEND_SETTLED = "INTEND_SETTLED" // intend settle mined, waiting for the dispute window
+	ExitStep_SETTLED        = "SETTLED"        // confirm settle mined, channel closed
+)
+
+const (
+	exitReasonOffline = "offline"
+	exitReasonNacks   = "nacks"
+)
+
+// exitNode is the part of the node used by the exit jobs
+type exitNode interface {
+	GetChannelStatus(cid ctype.CidType) (*rpc.ChannelStatusResponse, error)
+	ResolveIncomingPays(cid ctype.CidType) error
+	GetSettleFinalizedTime(cid ctype.CidType) (*big.Int, error)
+	IntendSettleOnce(cid ctype.CidType) error
+	GetCurrentBlockNumber() *big.Int
+	ConfirmSettlePaymentChannel(cid ctype.CidType) error
+}
+
+// ExitCallback reports the progress of the exit jobs, cid is hex string
+type ExitCallback interface {
+	OnExitStep(cid string, step string)
+	OnError(cid string, err string)
+}
+
+// SetExitCallback sets the callback of the exit jobs, nil to clear
+func (c *CelerClient) SetExitCallback(cb ExitCallback) {
+	c.exitLock.Lock()
+	defer c.exitLock.Unlock()
+	c.exitCb = cb
+}
+
+// OfflineExitEnabled returns true if the exit policy settles the channels when OSP is offline
+func (c *CelerClient) OfflineExitEnabled() bool {
+	return c.exitPolicy != nil && c.exitPolicy.OfflineHours > 0
+}
+
+// GetExitJob returns the exit job of the channel of the token
+func (c *CelerClient) GetExitJob(token *entity.TokenInfo) (*structs.ExitJob, bool, error) {
+	cid, exist := c.getCidFromTokenInfo(token)
+	if !exist {
+		return nil, false, errors.New("PSC_NOT_OPEN_" + utils.GetTokenAddrStr(token))
+	}
+	return c.dal.GetExitJob(cid)
+}
+
+// initExitPolicy loads the offline time of the stream to OSP from previous runs, and starts the
+// routine advancing the exit jobs. Unfinished jobs are always resumed, new jobs are only created
+// if the exit policy is set in the profile.
+func (c *CelerClient) initExitPolicy(policy *common.ExitPolicy) {
+	c.exitPolicy = policy
+	c.loadOfflineTime()
+	if policy != nil {
+		log.Infof("exit policy %+v, osp stream offline for %s before start", *policy, c.offlineBefore)
+	}
+	go c.runExitPolicy(c.cNode)
+}
+
+// loadOfflineTime starts the offline clock of this run. Only the time the stream was down while
+// the client was running is counted, the time the client was not running is not.
+func (c *CelerClient) loadOfflineTime() {
+	offline, _, err := c.dal.GetPeerOfflineTime(c.svrEth)
+	if err != nil {
+		log.Errorln("GetPeerOfflineTime err:", err)
+	}
+	c.exitLock.Lock()
+	defer c.exitLock.Unlock()
+	c.offlineSince = time.Now()
+	if !c.streamUp {
+		c.offlineBefore = offline
+	}
+}
+
+func (c *CelerClient) onStreamConnected() {
+	c.exitLock.Lock()
+	defer c.exitLock.Unlock()
+	c.streamUp = true
+	c.offlineBefore = 0
+	err := c.dal.PutPeerOfflineTime(c.svrEth, 0)
+	if err != nil {
+		log.Errorln("PutPeerOfflineTime err:", err)
+	}
+}
+
+func (c *CelerClient) onStreamDisconnected() {
+	c.exitLock.Lock()
+	defer c.exitLock.Unlock()
+	c.streamUp = false
+	c.offlineSince = time.Now()
+}
+
+// saveOfflineTime persists the offline time so that it is kept across restarts
+func (c *CelerClient) saveOfflineTime() {
+	c.exitLock.Lock()
+	defer c.exitLock.Unlock()
+	if c.streamUp {
+		return
+	}
+	err := c.dal.PutPeerOfflineTime(c.svrEth, c.offlineBefore+time.Since(c.offlineSince))
+	if err != nil {
+		log.Errorln("PutPeerOfflineTime err:", err)
+	}
+}
+
+// offlineTime returns how long the stream to OSP has been disconnected while running
+func (c *CelerClient) offlineTime() time.Duration {
+	c.exitLock.Lock()
+	defer c.exitLock.Unlock()
+	if c.streamUp {
+		return 0
+	}
+	return c.offlineBefore + time.Since(c.offlineSince)
+}
+
+func (c *CelerClient) runExitPolicy(node exitNode) {
+	ticker := time.NewTicker(config.ExitPolicyInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.quit:
+			return
+		case <-ticker.C:
+			c.saveOfflineTime()
+			c.checkExitPolicy(node)
+			c.advanceExitJobs(node)
+		}
+	}
+}
+
+// checkExitPolicy creates exit jobs for the opened channels if OSP is unresponsive
+func (c *CelerClient) checkExitPolicy(node exitNode) {
+	policy := c.exitPolicy
+	if policy == nil || (policy.OfflineHours == 0 && policy.MaxNacks == 0) {
+		return
+	}
+	cids, _, _, err := c.dal.GetCidPeerTokensByState(structs.ChanState_OPENED)
+	if err != nil {
+		log.Errorln("exit policy, GetCidPeerTokensByState err:", err)
+		return
+	}
+	offline := c.offlineTime()
+	for _, cid := range cids {
+		reason := ""
+		if policy.OfflineHours > 0 && offline >= time.Duration(policy.OfflineHours)*time.Hour {
+			reason = exitReasonOffline
+		} else if policy.MaxNacks > 0 {
+			status, err := node.GetChannelStatus(cid)
+			if err != nil {
+				log.Errorln("exit policy, GetChannelStatus err:", err, cid.Hex())
+				continue
+			}
+			if status.GetViolationNacks() >= policy.MaxNacks {
+				reason = exitReasonNacks
+			}
+		}
+		if reason == "" {
+			continue
+		}
+		_, found, err := c.dal.GetExitJob(cid)
+		if err != nil || found {
+			continue
+		}
+		log.Warnln("exit policy triggered by", reason, "offline", offline, "start exit job for channel", cid.Hex())
+		err = c.dal.InsertExitJob(&structs.ExitJob{
+			Cid:    cid,
+			Reason: reason,
+			State:  structs.ExitState_RESOLVING_PAYS,
+		})
+		if err != nil {
+			log.Errorln("InsertExitJob err:", err, cid.Hex())
+			continue
+		}
+		c.reportExitStep(cid, ExitStep_STARTED)
+	}
+}
+
+// advanceExitJobs takes the next step of the unfinished exit jobs. A step that failed
+// is retried in the next round, the error is kept in the job and reported via callback.
+func (c *CelerClient) advanceExitJobs(node exitNode) {
+	jobs, err := c.dal.GetAllExitJobs()
+	if err != nil {
+		log.Errorln("GetAllExitJobs err:", err)
+		return
+	}
+	for _, job := range jobs {
+		if job.State == structs.ExitState_SETTLED {
+			continue
+		}
+		err = c.advanceExitJob(node, job)
+		if err != nil {
+			log.Errorln("exit job", job.Cid.Hex(), "state", job.State, "err:", err)
+			c.reportExitErr(job.Cid, err)
+			err = c.dal.UpdateExitJob(job.Cid, job.State, job.FinalizeBlk, err.Error())
+			if err != nil {
+				log.Errorln("UpdateExitJob err:", err)
+			}
+		}
+	}
+}
+
+func (c *CelerClient) advanceExitJob(node exitNode, job *structs.ExitJob) error {
+	cid := job.Cid
+	chanState, found, err := c.dal.GetChanState(cid)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return common.ErrChannelNotFound
+	}
+	if chanState == structs.ChanState_CLOSED {
+		// channel settled by OSP
+		return c.updateExitJob(cid, structs.ExitState_SETTLED, job.FinalizeBlk, ExitStep_SETTLED)
+	}
+
+	switch job.State {
+	case structs.ExitState_RESOLVING_PAYS:
+		err = node.ResolveIncomingPays(cid)
+		if err != nil {
+			return err
+		}
+		return c.updateExitJob(cid, structs.ExitState_INTEND_SETTLING, 0, ExitStep_PAYS_RESOLVED)
+
+	case structs.ExitState_INTEND_SETTLING:
+		finalizeBlk, err := node.GetSettleFinalizedTime(cid)
+		if err != nil {
+			return err
+		}
+		// skip the tx if intend settle was mined before restart or submitted by OSP
+		if finalizeBlk.Uint64() == 0 {
+			err = node.IntendSettleOnce(cid)
+			if err != nil {
+				return err
+			}
+			finalizeBlk, err = node.GetSettleFinalizedTime(cid)
+			if err != nil {
+				return err
+			}
+		}
+		return c.updateExitJob(cid, structs.ExitState_WAITING_FINALIZE, finalizeBlk.Uint64(), ExitStep_INTEND_SETTLED)
+
+	case structs.ExitState_WAITING_FINALIZE:
+		if node.GetCurrentBlockNumber().Uint64() <= job.FinalizeBlk {
+			return nil
+		}
+		err = node.ConfirmSettlePaymentChannel(cid)
+		if err != nil {
+			return err
+		}
+		return c.updateExitJob(cid, structs.ExitState_SETTLED, job.FinalizeBlk, ExitStep_SETTLED)
+	}
+	return nil
+}
+
+func (c *CelerClient) updateExitJob(cid ctype.CidType, state int, finalizeBlk uint64, step string) error {
+	err := c.dal.UpdateExitJob(cid, state, finalizeBlk, "")
+	if err != nil {
+		return err
+	}
+	log.Infoln("exit job", cid.Hex(), step)
+	c.reportExitStep(cid, step)
+	return nil
+}
+
+func (c *CelerClient) reportExitStep(cid ctype.CidType, step string) {
+	c.exitLock.Lock()
+	cb := c.exitCb
+	c.exitLock.Unlock()
+	if cb != nil {
+		cb.OnExitStep(ctype.Cid2Hex(cid), step)
+	}
+}
+
+func (c *CelerClient) reportExitErr(cid ctype.CidType, err error) {
+	c.exitLock.Lock()
+	cb := c.exitCb
+	c.exitLock.Unlock()
+	if cb != nil {
+		cb.OnError(ctype.Cid2Hex(cid), err.Error())
+	}
+}
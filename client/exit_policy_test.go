@@ -0,0 +1,266 @@
+// Copyright 2020 Celer Network
+
+package client
+
+import (
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/celer-network/goCeler/common"
+	"github.com/celer-network/goCeler/common/structs"
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/rpc"
+	"github.com/celer-network/goCeler/storage"
+	"github.com/celer-network/goCeler/utils"
+)
+
+type testExitNode struct {
+	nacks       uint64
+	violations  uint64
+	resolveErr  error
+	finalizeBlk uint64
+	blkNum      uint64
+	resolved    int
+	intended    int
+	confirmed   int
+}
+
+func (n *testExitNode) GetChannelStatus(cid ctype.CidType) (*rpc.ChannelStatusResponse, error) {
+	return &rpc.ChannelStatusResponse{Nacks: n.nacks, ViolationNacks: n.violations}, nil
+}
+
+func (n *testExitNode) ResolveIncomingPays(cid ctype.CidType) error {
+	if n.resolveErr != nil {
+		return n.resolveErr
+	}
+	n.resolved++
+	return nil
+}
+
+func (n *testExitNode) GetSettleFinalizedTime(cid ctype.CidType) (*big.Int, error) {
+	return new(big.Int).SetUint64(n.finalizeBlk), nil
+}
+
+func (n *testExitNode) IntendSettleOnce(cid ctype.CidType) error {
+	n.intended++
+	n.finalizeBlk = n.blkNum + 10
+	return nil
+}
+
+func (n *testExitNode) GetCurrentBlockNumber() *big.Int {
+	return new(big.Int).SetUint64(n.blkNum)
+}
+
+func (n *testExitNode) ConfirmSettlePaymentChannel(cid ctype.CidType) error {
+	n.confirmed++
+	return nil
+}
+
+type testExitCallback struct {
+	steps []string
+	errs  []string
+}
+
+func (cb *testExitCallback) OnExitStep(cid string, step string) {
+	cb.steps = append(cb.steps, step)
+}
+
+func (cb *testExitCallback) OnError(cid string, err string) {
+	cb.errs = append(cb.errs, err)
+}
+
+func newTestExitClient(t *testing.T, name string, cids ...ctype.CidType) (*CelerClient, func()) {
+	stFile := filepath.Join(os.TempDir(), name+".db")
+	os.Remove(stFile)
+	st, err := storage.NewKVStoreSQL("sqlite3", stFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &CelerClient{
+		dal:    storage.NewDAL(st),
+		svrEth: ctype.Hex2Addr("bcd123"),
+		exitCb: &testExitCallback{},
+	}
+	token := utils.GetTokenInfoFromAddress(ctype.ZeroAddr)
+	for _, cid := range cids {
+		// one channel per peer, OSP is not involved in the exit jobs
+		peer := ctype.Bytes2Addr(cid.Bytes())
+		err = c.dal.InsertChanOnChain(0, cid, peer, token, ctype.ZeroAddr, structs.ChanState_OPENED,
+			nil, &structs.OnChainBalance{}, 0, 0, 0, 0, &rpc.SignedSimplexState{}, &rpc.SignedSimplexState{})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return c, func() {
+		st.Close()
+		os.Remove(stFile)
+	}
+}
+
+func checkExitJob(t *testing.T, c *CelerClient, cid ctype.CidType, expState int, expFinalizeBlk uint64) {
+	t.Helper()
+	job, found, err := c.dal.GetExitJob(cid)
+	if err != nil || !found {
+		t.Fatalf("GetExitJob: %t %v", found, err)
+	}
+	if job.State != expState || job.FinalizeBlk != expFinalizeBlk {
+		t.Fatalf("wrong exit job %+v, expect state %d finalize block %d", job, expState, expFinalizeBlk)
+	}
+}
+
+func TestExitJobStateMachine(t *testing.T) {
+	cid := ctype.Hex2Cid("abcdef")
+	c, cleanup := newTestExitClient(t, "client_exit_test", cid)
+	defer cleanup()
+	cb := c.exitCb.(*testExitCallback)
+	node := &testExitNode{blkNum: 100}
+	c.exitPolicy = &common.ExitPolicy{MaxNacks: 2}
+	c.streamUp = true
+
+	// retryable nacks do not trigger the exit
+	node.nacks, node.violations = 5, 1
+	c.checkExitPolicy(node)
+	_, found, err := c.dal.GetExitJob(cid)
+	if err != nil || found {
+		t.Fatalf("exit job created by retryable nacks: %t %v", found, err)
+	}
+
+	node.violations = 2
+	c.checkExitPolicy(node)
+	checkExitJob(t, c, cid, structs.ExitState_RESOLVING_PAYS, 0)
+
+	// a failed step is kept and retried
+	node.resolveErr = errors.New("resolve failed")
+	c.advanceExitJobs(node)
+	checkExitJob(t, c, cid, structs.ExitState_RESOLVING_PAYS, 0)
+	job, _, _ := c.dal.GetExitJob(cid)
+	if job.ErrMsg != "resolve failed" || len(cb.errs) != 1 {
+		t.Errorf("error not kept in job %+v or reported %v", job, cb.errs)
+	}
+	node.resolveErr = nil
+	c.advanceExitJobs(node)
+	checkExitJob(t, c, cid, structs.ExitState_INTEND_SETTLING, 0)
+
+	c.advanceExitJobs(node)
+	checkExitJob(t, c, cid, structs.ExitState_WAITING_FINALIZE, 110)
+
+	// wait for the dispute window
+	node.blkNum = 110
+	c.advanceExitJobs(node)
+	checkExitJob(t, c, cid, structs.ExitState_WAITING_FINALIZE, 110)
+	node.blkNum = 111
+	c.advanceExitJobs(node)
+	checkExitJob(t, c, cid, structs.ExitState_SETTLED, 110)
+
+	// settled job is done
+	c.advanceExitJobs(node)
+	if node.resolved != 1 || node.intended != 1 || node.confirmed != 1 {
+		t.Errorf("wrong node calls: %+v", node)
+	}
+	expSteps := []string{
+		ExitStep_STARTED, ExitStep_PAYS_RESOLVED, ExitStep_INTEND_SETTLED, ExitStep_SETTLED}
+	if len(cb.steps) != len(expSteps) {
+		t.Fatalf("wrong steps %v", cb.steps)
+	}
+	for i, step := range expSteps {
+		if cb.steps[i] != step {
+			t.Errorf("wrong steps %v", cb.steps)
+		}
+	}
+}
+
+func TestExitJobOfflineAndSettledByPeer(t *testing.T) {
+	cid1 := ctype.Hex2Cid("abcdef")
+	cid2 := ctype.Hex2Cid("abcdf0")
+	c, cleanup := newTestExitClient(t, "client_exit_offline_test", cid1, cid2)
+	defer cleanup()
+	node := &testExitNode{blkNum: 100}
+	c.exitPolicy = &common.ExitPolicy{OfflineHours: 1}
+	c.offlineSince = time.Now().Add(-30 * time.Minute)
+
+	c.checkExitPolicy(node)
+	jobs, err := c.dal.GetAllExitJobs()
+	if err != nil || len(jobs) != 0 {
+		t.Fatalf("exit jobs created before offline hours: %v %v", jobs, err)
+	}
+
+	c.offlineSince = time.Now().Add(-2 * time.Hour)
+	c.checkExitPolicy(node)
+	for _, cid := range []ctype.CidType{cid1, cid2} {
+		job, found, err := c.dal.GetExitJob(cid)
+		if err != nil || !found || job.Reason != exitReasonOffline {
+			t.Fatalf("offline exit job not created: %+v %t %v", job, found, err)
+		}
+	}
+
+	// intend settle already submitted by the peer
+	c.advanceExitJobs(node)
+	node.finalizeBlk = 105
+	c.advanceExitJobs(node)
+	checkExitJob(t, c, cid1, structs.ExitState_WAITING_FINALIZE, 105)
+	if node.intended != 0 {
+		t.Error("intend settle submitted again")
+	}
+
+	// channel settled by the peer
+	err = c.dal.UpdateChanState(cid2, structs.ChanState_CLOSED)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.advanceExitJobs(node)
+	checkExitJob(t, c, cid2, structs.ExitState_SETTLED, 105)
+	checkExitJob(t, c, cid1, structs.ExitState_WAITING_FINALIZE, 105)
+	if node.confirmed != 0 {
+		t.Error("settle confirmed within the dispute window")
+	}
+}
+
+func TestExitPolicyOfflineTimeAcrossRestarts(t *testing.T) {
+	cid := ctype.Hex2Cid("abcdef")
+	c, cleanup := newTestExitClient(t, "client_exit_restart_test", cid)
+	defer cleanup()
+	node := &testExitNode{blkNum: 100}
+	policy := &common.ExitPolicy{OfflineHours: 1}
+	c.exitPolicy = policy
+
+	// offline for 40 minutes before the client is stopped
+	c.loadOfflineTime()
+	c.offlineSince = time.Now().Add(-40 * time.Minute)
+	c.saveOfflineTime()
+
+	// the time the client was not running is not counted after restart
+	restart := func() *CelerClient {
+		rc := &CelerClient{dal: c.dal, svrEth: c.svrEth, exitPolicy: policy}
+		rc.loadOfflineTime()
+		return rc
+	}
+	c = restart()
+	if offline := c.offlineTime(); offline < 40*time.Minute || offline > 41*time.Minute {
+		t.Errorf("wrong offline time after restart: %s", offline)
+	}
+	c.checkExitPolicy(node)
+	_, found, err := c.dal.GetExitJob(cid)
+	if err != nil || found {
+		t.Fatalf("exit job created by restart: %t %v", found, err)
+	}
+
+	// the offline time of both runs adds up
+	c.offlineSince = time.Now().Add(-20 * time.Minute)
+	c.checkExitPolicy(node)
+	checkExitJob(t, c, cid, structs.ExitState_RESOLVING_PAYS, 0)
+
+	// connected stream resets the offline time of later runs
+	c.saveOfflineTime()
+	c.onStreamConnected()
+	if offline := c.offlineTime(); offline != 0 {
+		t.Errorf("wrong offline time of connected stream: %s", offline)
+	}
+	c = restart()
+	if offline := c.offlineTime(); offline > time.Minute {
+		t.Errorf("wrong offline time after restart of connected client: %s", offline)
+	}
+}
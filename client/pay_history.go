@@ -0,0 +1,184 @@
+// Copyright 2020 Celer Network
+
+package client
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/celer-network/goCeler/celersdkintf"
+	"github.com/celer-network/goCeler/common/structs"
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/entity"
+	"github.com/celer-network/goCeler/rpc"
+	"github.com/celer-network/goCeler/utils"
+	"github.com/celer-network/goutils/log"
+	"golang.org/x/net/context"
+)
+
+const payHistorySyncPageSize = 200
+
+// payStatusesNotFinal are the sdk pay statuses that can still change
+var payStatusesNotFinal = []int{
+	celersdkintf.PAY_STATUS_INVALID, celersdkintf.PAY_STATUS_PENDING, celersdkintf.PAY_STATUS_INITIALIZING}
+
+// payStatusFinal returns true if the sdk pay status won't change anymore
+func payStatusFinal(status int) bool {
+	for _, s := range payStatusesNotFinal {
+		if status == s {
+			return false
+		}
+	}
+	return true
+}
+
+// recordPayHistory indexes the pay with the sdk status in the local pay history
+func (c *CelerClient) recordPayHistory(payID ctype.PayIDType, pay *entity.ConditionalPay, status int) {
+	if pay == nil {
+		return
+	}
+	xfer := pay.GetTransferFunc().GetMaxTransfer()
+	item := &structs.PayHistoryItem{
+		PayID:    payID,
+		Src:      ctype.Bytes2Addr(pay.GetSrc()),
+		Dest:     ctype.Bytes2Addr(pay.GetDest()),
+		Token:    ctype.Bytes2Addr(xfer.GetToken().GetTokenAddress()),
+		Amt:      new(big.Int).SetBytes(xfer.GetReceiver().GetAmt()),
+		Status:   status,
+		CreateTs: time.Unix(0, int64(pay.GetPayTimestamp())).UTC(),
+	}
+	_, err := c.putPayHistoryItem(item)
+	if err != nil {
+		log.Errorln("record pay history", payID.Hex(), err)
+	}
+}
+
+// putPayHistoryItem saves the item unless it would replace a final status with a non-final one.
+// Returns true if the item is saved.
+func (c *CelerClient) putPayHistoryItem(item *structs.PayHistoryItem) (bool, error) {
+	c.payHistoryLock.Lock()
+	defer c.payHistoryLock.Unlock()
+	local, found, err := c.dal.GetPayHistoryItem(item.PayID)
+	if err != nil {
+		return false, err
+	}
+	if found && (local.Status == item.Status || (payStatusFinal(local.Status) && !payStatusFinal(item.Status))) {
+		return false, nil
+	}
+	return true, c.dal.PutPayHistoryItem(item)
+}
+
+// GetPayHistory returns pays in the local pay history matching the filter, newest first
+func (c *CelerClient) GetPayHistory(filter *structs.PayHistoryFilter) ([]*structs.PayHistoryItem, error) {
+	return c.dal.GetPayHistoryItems(filter)
+}
+
+// SyncPayHistoryWithOsp merges the pay history kept by the OSP into the local pay history.
+// Pays missing locally are added, and local pays still in progress take the final status known
+// to the OSP. It walks the OSP history from the newest pay until a page has nothing new and is
+// older than the oldest local pay in progress, so pays finished while offline are picked up on
+// reconnect.
+func (c *CelerClient) SyncPayHistoryWithOsp() error {
+	rpcClient, err := c.GetRpcClientToOsp()
+	if err != nil {
+		return err
+	}
+	myAddr := ctype.Addr2Hex(c.GetMyEthAddr())
+	return c.syncPayHistoryPages(func(beforeTs int64, smallestPayID string) ([]*rpc.OneHistoricalPay, error) {
+		ts, tsSig := utils.GetTsAndSig(c.SignAuthTs)
+		if tsSig == nil {
+			return nil, fmt.Errorf("auth ts sig error")
+		}
+		req := &rpc.GetPayHistoryRequest{
+			Peer:          myAddr,
+			BeforeTs:      beforeTs,
+			ItemsPerPage:  payHistorySyncPageSize,
+			SmallestPayId: smallestPayID,
+			Ts:            ts,
+			TsSig:         tsSig,
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 4*time.Second)
+		defer cancel()
+		resp, err := rpcClient.GetPayHistory(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("GetPayHistory err: %w", err)
+		}
+		return resp.GetPays(), nil
+	})
+}
+
+// syncPayHistoryPages merges the pages of the OSP pay history returned by getPage, newest first
+func (c *CelerClient) syncPayHistoryPages(
+	getPage func(beforeTs int64, smallestPayID string) ([]*rpc.OneHistoricalPay, error)) error {
+	oldestPending, hasPending, err := c.dal.GetOldestPayHistoryTs(payStatusesNotFinal)
+	if err != nil {
+		return fmt.Errorf("GetOldestPayHistoryTs err: %w", err)
+	}
+	beforeTs := time.Now().Unix()
+	smallestPayID := ctype.PayID2Hex(ctype.ZeroPayID)
+	total := 0
+	for {
+		pays, err := getPage(beforeTs, smallestPayID)
+		if err != nil {
+			return err
+		}
+		merged := 0
+		for _, pay := range pays {
+			ok, err := c.mergeOspPay(pay)
+			if err != nil {
+				return fmt.Errorf("merge pay %s err: %w", pay.GetPayId(), err)
+			}
+			if ok {
+				merged++
+			}
+		}
+		total += merged
+		if len(pays) < payHistorySyncPageSize {
+			break
+		}
+		beforeTs = pays[len(pays)-1].GetCreateTs()
+		smallestPayID = pays[len(pays)-1].GetPayId()
+		// local pays in progress may be finished in older pages
+		if merged == 0 && (!hasPending || beforeTs < oldestPending.Unix()) {
+			break
+		}
+	}
+	log.Infoln("synced pay history with osp, merged", total, "pays")
+	return nil
+}
+
+// mergeOspPay saves a pay from the OSP history, returns true if the local pay history is changed
+func (c *CelerClient) mergeOspPay(pay *rpc.OneHistoricalPay) (bool, error) {
+	amt, ok := new(big.Int).SetString(pay.GetAmt(), 10)
+	if !ok {
+		return false, fmt.Errorf("invalid amt %s", pay.GetAmt())
+	}
+	item := &structs.PayHistoryItem{
+		PayID:    ctype.Hex2PayID(pay.GetPayId()),
+		Src:      ctype.Hex2Addr(pay.GetSrc()),
+		Dest:     ctype.Hex2Addr(pay.GetDst()),
+		Token:    ctype.Hex2Addr(pay.GetToken()),
+		Amt:      amt,
+		Status:   payFsmStateToSdkStatus(int(pay.GetState())),
+		CreateTs: time.Unix(pay.GetCreateTs(), 0).UTC(),
+	}
+	if !payStatusFinal(item.Status) {
+		// local status is more up to date for pays in progress
+		_, found, err := c.dal.GetPayHistoryItem(item.PayID)
+		if err != nil || found {
+			return false, err
+		}
+	}
+	return c.putPayHistoryItem(item)
+}
+
+// syncPayHistory runs SyncPayHistoryWithOsp in background after (re)connecting to the OSP
+func (c *CelerClient) syncPayHistory() {
+	go func() {
+		err := c.SyncPayHistoryWithOsp()
+		if err != nil {
+			log.Warnln("sync pay history with osp err:", err)
+		}
+	}()
+}
@@ -0,0 +1,94 @@
+// Copyright 2020 Celer Network
+
+package client
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/celer-network/goCeler/celersdkintf"
+	"github.com/celer-network/goCeler/common/structs"
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/rpc"
+	"github.com/celer-network/goCeler/storage"
+)
+
+// testOspHistory serves the pays of the osp history, newest first with one pay per second
+type testOspHistory struct {
+	pays    []*rpc.OneHistoricalPay
+	fetched int
+}
+
+func (h *testOspHistory) getPage(beforeTs int64, smallestPayID string) ([]*rpc.OneHistoricalPay, error) {
+	h.fetched++
+	var page []*rpc.OneHistoricalPay
+	for _, pay := range h.pays {
+		if pay.GetCreateTs() < beforeTs && len(page) < payHistorySyncPageSize {
+			page = append(page, pay)
+		}
+	}
+	return page, nil
+}
+
+func TestSyncPayHistoryPages(t *testing.T) {
+	stFile := filepath.Join(os.TempDir(), "client_pay_history_test.db")
+	os.Remove(stFile)
+	st, err := storage.NewKVStoreSQL("sqlite3", stFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(stFile)
+	defer st.Close()
+	c := &CelerClient{dal: storage.NewDAL(st)}
+
+	// four pages of pays known locally, one of them still pending locally
+	const numPays, pendingIdx = 4 * payHistorySyncPageSize, 2*payHistorySyncPageSize + 50
+	newest := time.Now().Unix() - 10
+	history := &testOspHistory{}
+	for i := 0; i < numPays; i++ {
+		payID := ctype.Bytes2PayID(big.NewInt(int64(i + 1)).Bytes())
+		createTs := newest - int64(i)
+		history.pays = append(history.pays, &rpc.OneHistoricalPay{
+			PayId:    ctype.PayID2Hex(payID),
+			Amt:      "10",
+			State:    int64(structs.PayState_COSIGNED_PAID),
+			CreateTs: createTs,
+		})
+		status := celersdkintf.PAY_STATUS_PAID
+		if i == pendingIdx {
+			status = celersdkintf.PAY_STATUS_PENDING
+		}
+		err = c.dal.PutPayHistoryItem(&structs.PayHistoryItem{
+			PayID: payID, Amt: big.NewInt(10), Status: status, CreateTs: time.Unix(createTs, 0).UTC()})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	pendingID := ctype.Hex2PayID(history.pays[pendingIdx].GetPayId())
+
+	// pages with nothing new are walked until the pending pay is covered
+	err = c.syncPayHistoryPages(history.getPage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	item, _, err := c.dal.GetPayHistoryItem(pendingID)
+	if err != nil || item.Status != celersdkintf.PAY_STATUS_PAID {
+		t.Errorf("pending pay not synced: %+v %v", item, err)
+	}
+	if history.fetched != 4 {
+		t.Errorf("fetched %d pages, expect 4", history.fetched)
+	}
+
+	// stops at the first page with nothing new without local pays in progress
+	history.fetched = 0
+	err = c.syncPayHistoryPages(history.getPage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if history.fetched != 1 {
+		t.Errorf("fetched %d pages, expect 1", history.fetched)
+	}
+}
@@ -0,0 +1,359 @@
+// Copyright 2020 Celer Network
+
+package cnode
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/celer-network/goCeler/common"
+	"github.com/celer-network/goCeler/common/structs"
+	"github.com/celer-network/goCeler/config"
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/entity"
+	"github.com/celer-network/goCeler/fsm"
+	"github.com/celer-network/goCeler/rpc"
+	"github.com/celer-network/goCeler/utils"
+	"github.com/celer-network/goutils/log"
+	"github.com/golang/protobuf/ptypes/any"
+)
+
+// GetChannelBalances returns the balances of opened channels on the given tokens, all tokens if empty
+func (c *CNode) GetChannelBalances(tokenAddrs []ctype.Addr, peerOspsOnly bool) ([]*rpc.ChannelBalanceInfo, error) {
+	cids, peers, tokens, err := c.dal.GetCidPeerTokensByState(structs.ChanState_OPENED)
+	if err != nil {
+		return nil, fmt.Errorf("GetCidPeerTokensByState err: %w", err)
+	}
+	tokenSet := make(map[ctype.Addr]bool)
+	for _, tokenAddr := range tokenAddrs {
+		tokenSet[tokenAddr] = true
+	}
+	peerOsps := c.getPeerOspSet()
+	var channels []*rpc.ChannelBalanceInfo
+	for i, cid := range cids {
+		if len(tokenSet) > 0 && !tokenSet[tokens[i]] {
+			continue
+		}
+		if peerOspsOnly && !peerOsps[peers[i]] {
+			continue
+		}
+		balance, err := c.GetBalance(cid)
+		if err != nil {
+			log.Warnf("cid %x GetBalance err: %s", cid, err)
+			continue
+		}
+		channels = append(channels, &rpc.ChannelBalanceInfo{
+			Cid:            ctype.Cid2Hex(cid),
+			PeerAddress:    ctype.Addr2Hex(peers[i]),
+			TokenAddress:   ctype.Addr2Hex(tokens[i]),
+			MyFreeWei:      balance.MyFree.String(),
+			PeerFreeWei:    balance.PeerFree.String(),
+			MyPendingWei:   balance.MyLocked.String(),
+			PeerPendingWei: balance.PeerLocked.String(),
+		})
+	}
+	return channels, nil
+}
+
+// GetPendingPays returns the pending pays in both directions of the channel, or of all peer osp
+// channels if cid is zero
+func (c *CNode) GetPendingPays(cid ctype.CidType) ([]*rpc.PendingPayInfo, error) {
+	var cids []ctype.CidType
+	if cid != ctype.ZeroCid {
+		cids = append(cids, cid)
+	} else {
+		for _, neighbor := range c.GetPeerOsps() {
+			for _, tkcid := range neighbor.TokenCids {
+				cids = append(cids, tkcid)
+			}
+		}
+	}
+	var pays []*rpc.PendingPayInfo
+	for _, cid := range cids {
+		selfSimplex, _, peerSimplex, _, found, err := c.dal.GetDuplexChannel(cid)
+		if err != nil {
+			return nil, fmt.Errorf("GetDuplexChannel %x err: %w", cid, err)
+		}
+		if !found {
+			return nil, fmt.Errorf("%w: %x", common.ErrChannelNotFound, cid)
+		}
+		payIDs := append(selfSimplex.GetPendingPayIds().GetPayIds(), peerSimplex.GetPendingPayIds().GetPayIds()...)
+		for _, id := range payIDs {
+			payID := ctype.Bytes2PayID(id)
+			pay, _, incid, instate, outcid, outstate, _, found, err := c.dal.GetPaymentInfo(payID)
+			if err != nil {
+				return nil, fmt.Errorf("GetPaymentInfo %x err: %w", payID, err)
+			}
+			if !found {
+				log.Warnf("pending pay %x of cid %x not found", payID, cid)
+				continue
+			}
+			pays = append(pays, &rpc.PendingPayInfo{
+				PayId:           ctype.PayID2Hex(payID),
+				Src:             ctype.Bytes2Hex(pay.GetSrc()),
+				Dest:            ctype.Bytes2Hex(pay.GetDest()),
+				TokenAddress:    utils.GetTokenAddrStr(pay.GetTransferFunc().GetMaxTransfer().GetToken()),
+				AmtWei:          new(big.Int).SetBytes(pay.GetTransferFunc().GetMaxTransfer().GetReceiver().GetAmt()).String(),
+				IngressCid:      ctype.Cid2Hex(incid),
+				IngressState:    fsm.PayStateName(instate),
+				EgressCid:       ctype.Cid2Hex(outcid),
+				EgressState:     fsm.PayStateName(outstate),
+				ResolveDeadline: pay.GetResolveDeadline(),
+			})
+		}
+	}
+	return pays, nil
+}
+
+// GetDepositJobs returns the deposit jobs of the channel, or all running deposit jobs if cid is zero
+func (c *CNode) GetDepositJobs(cid ctype.CidType) ([]*structs.DepositJob, error) {
+	if cid != ctype.ZeroCid {
+		return c.dal.GetAllDepositJobsByCid(cid)
+	}
+	return c.dal.GetAllRunningDepositJobs()
+}
+
+// GetRoutingTable returns the next hop of each destination osp on the given tokens, all tokens if empty
+func (c *CNode) GetRoutingTable(tokenAddrs []ctype.Addr) ([]*rpc.RouteInfo, error) {
+	routes, err := c.dal.GetAllRoutingCids()
+	if err != nil {
+		return nil, fmt.Errorf("GetAllRoutingCids err: %w", err)
+	}
+	tokenSet := make(map[ctype.Addr]bool)
+	for _, tokenAddr := range tokenAddrs {
+		tokenSet[tokenAddr] = true
+	}
+	var infos []*rpc.RouteInfo
+	for tokenAddr, dests := range routes {
+		if len(tokenSet) > 0 && !tokenSet[tokenAddr] {
+			continue
+		}
+		for dest, cid := range dests {
+			info := &rpc.RouteInfo{
+				DestAddress:  ctype.Addr2Hex(dest),
+				TokenAddress: ctype.Addr2Hex(tokenAddr),
+				NextHopCid:   ctype.Cid2Hex(cid),
+			}
+			peer, found, err := c.dal.GetChanPeer(cid)
+			if err != nil {
+				return nil, fmt.Errorf("GetChanPeer %x err: %w", cid, err)
+			}
+			if found {
+				info.NextHopAddress = ctype.Addr2Hex(peer)
+			}
+			infos = append(infos, info)
+		}
+	}
+	return infos, nil
+}
+
+// ListChannels returns a page of channels, filtered by peer, token and state if they are not nil or zero
+func (c *CNode) ListChannels(
+	peer, token *ctype.Addr, state int, offset, limit uint32) ([]*rpc.ChannelInfo, error) {
+	cids, peers, tokens, states, stateTses, openTses, err :=
+		c.dal.GetChansByFilter(peer, token, state, int(offset), adminViewLimit(limit))
+	if err != nil {
+		return nil, fmt.Errorf("GetChansByFilter err: %w", err)
+	}
+	var channels []*rpc.ChannelInfo
+	for i, cid := range cids {
+		channels = append(channels, &rpc.ChannelInfo{
+			Cid:          ctype.Cid2Hex(cid),
+			PeerAddress:  ctype.Addr2Hex(peers[i]),
+			TokenAddress: ctype.Addr2Hex(tokens[i]),
+			State:        fsm.ChanStateName(states[i]),
+			OpenTs:       openTses[i].Unix(),
+			StateTs:      stateTses[i].Unix(),
+		})
+	}
+	return channels, nil
+}
+
+// GetChannelStatus returns the seq nums and the msg queue sliding window of the channel
+func (c *CNode) GetChannelStatus(cid ctype.CidType) (*rpc.ChannelStatusResponse, error) {
+	peer, found, err := c.dal.GetChanPeer(cid)
+	if err != nil {
+		return nil, fmt.Errorf("GetChanPeer err: %w", err)
+	}
+	if !found {
+		return nil, common.ErrChannelNotFound
+	}
+	base, lastUsed, lastAcked, lastNacked, _, err := c.dal.GetChanSeqNums(cid)
+	if err != nil {
+		return nil, fmt.Errorf("GetChanSeqNums err: %w", err)
+	}
+	resp := &rpc.ChannelStatusResponse{
+		Cid:              ctype.Cid2Hex(cid),
+		PeerAddress:      ctype.Addr2Hex(peer),
+		BaseSeqNum:       base,
+		LastUsedSeqNum:   lastUsed,
+		LastAckedSeqNum:  lastAcked,
+		LastNackedSeqNum: lastNacked,
+	}
+	qs, connected := c.messager.GetMsgQueueStatus(cid)
+	if connected {
+		resp.PeerConnected = true
+		resp.SentSeqNum = qs.Sent
+		resp.InFlight = uint32(qs.Sent - qs.Acked)
+		resp.Window = uint32(qs.Window)
+		resp.AckLatencyMs = uint64(qs.AckLatency / time.Millisecond)
+		resp.Nacks = qs.Nacks
+		resp.ViolationNacks = qs.Violations
+		resp.SlowAcks = qs.SlowAcks
+	}
+	return resp, nil
+}
+
+// GetChannelDetail returns the states and balances of the channel
+func (c *CNode) GetChannelDetail(cid ctype.CidType) (*rpc.ChannelDetailResponse, error) {
+	state, stateTs, openTs, _, onchain, selfSimplex, peerSimplex, found, err := c.dal.GetChanViewInfoByID(cid)
+	if err != nil {
+		return nil, fmt.Errorf("GetChanViewInfoByID err: %w", err)
+	}
+	if !found {
+		return c.getClosedChannelDetail(cid)
+	}
+	peer, _, err := c.dal.GetChanPeer(cid)
+	if err != nil {
+		return nil, fmt.Errorf("GetChanPeer err: %w", err)
+	}
+	_, token, _, err := c.dal.GetChanStateToken(cid)
+	if err != nil {
+		return nil, fmt.Errorf("GetChanStateToken err: %w", err)
+	}
+	detail := &rpc.ChannelDetailResponse{
+		Channel: &rpc.ChannelInfo{
+			Cid:          ctype.Cid2Hex(cid),
+			PeerAddress:  ctype.Addr2Hex(peer),
+			TokenAddress: utils.GetTokenAddrStr(token),
+			State:        fsm.ChanStateName(state),
+			OpenTs:       openTs.Unix(),
+			StateTs:      stateTs.Unix(),
+		},
+		SelfSeqNum:  selfSimplex.GetSeqNum(),
+		PeerSeqNum:  peerSimplex.GetSeqNum(),
+		PendingPays: uint32(len(selfSimplex.GetPendingPayIds().GetPayIds()) + len(peerSimplex.GetPendingPayIds().GetPayIds())),
+	}
+	if onchain != nil {
+		detail.MyDepositWei = onchain.MyDeposit.String()
+		detail.MyWithdrawalWei = onchain.MyWithdrawal.String()
+		detail.PeerDepositWei = onchain.PeerDeposit.String()
+		detail.PeerWithdrawalWei = onchain.PeerWithdrawal.String()
+	}
+	if state == structs.ChanState_OPENED {
+		balance, err := c.GetBalance(cid)
+		if err != nil {
+			return nil, fmt.Errorf("GetBalance err: %w", err)
+		}
+		detail.Balance = &rpc.ChannelBalanceInfo{
+			Cid:            detail.Channel.Cid,
+			PeerAddress:    detail.Channel.PeerAddress,
+			TokenAddress:   detail.Channel.TokenAddress,
+			MyFreeWei:      balance.MyFree.String(),
+			PeerFreeWei:    balance.PeerFree.String(),
+			MyPendingWei:   balance.MyLocked.String(),
+			PeerPendingWei: balance.PeerLocked.String(),
+		}
+	}
+	return detail, nil
+}
+
+func (c *CNode) getClosedChannelDetail(cid ctype.CidType) (*rpc.ChannelDetailResponse, error) {
+	peer, token, openTs, closeTs, found, err := c.dal.GetClosedChan(cid)
+	if err != nil {
+		return nil, fmt.Errorf("GetClosedChan err: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("%w: %x", common.ErrChannelNotFound, cid)
+	}
+	return &rpc.ChannelDetailResponse{
+		Channel: &rpc.ChannelInfo{
+			Cid:          ctype.Cid2Hex(cid),
+			PeerAddress:  ctype.Addr2Hex(peer),
+			TokenAddress: utils.GetTokenAddrStr(token),
+			State:        fsm.ChanStateName(structs.ChanState_CLOSED),
+			OpenTs:       openTs.Unix(),
+			StateTs:      closeTs.Unix(),
+		},
+	}, nil
+}
+
+// GetPayment returns the pay with its ingress and egress states
+func (c *CNode) GetPayment(payID ctype.PayIDType) (*rpc.PaymentInfo, error) {
+	pay, note, inCid, inState, outCid, outState, createTs, found, err := c.dal.GetPaymentInfo(payID)
+	if err != nil {
+		return nil, fmt.Errorf("GetPaymentInfo err: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("%w: %x", common.ErrPayNotFound, payID)
+	}
+	return c.toPaymentInfo(payID, pay, note, inCid, inState, outCid, outState, createTs), nil
+}
+
+// ListChannelPays returns a page of pays sent or received through the channel, most recent first,
+// and the total number of pays of the channel
+func (c *CNode) ListChannelPays(cid ctype.CidType, offset, limit uint32) ([]*rpc.PaymentInfo, uint32, error) {
+	total, err := c.dal.CountPaymentsByCid(cid)
+	if err != nil {
+		return nil, 0, fmt.Errorf("CountPaymentsByCid err: %w", err)
+	}
+	payIDs, pays, notes, inCids, inStates, outCids, outStates, createTses, err :=
+		c.dal.GetPaymentInfoByCidPage(cid, int(offset), adminViewLimit(limit))
+	if err != nil {
+		return nil, 0, fmt.Errorf("GetPaymentInfoByCidPage err: %w", err)
+	}
+	var infos []*rpc.PaymentInfo
+	for i, payID := range payIDs {
+		infos = append(infos, c.toPaymentInfo(
+			payID, pays[i], notes[i], inCids[i], inStates[i], outCids[i], outStates[i], createTses[i]))
+	}
+	return infos, uint32(total), nil
+}
+
+func (c *CNode) toPaymentInfo(
+	payID ctype.PayIDType, pay *entity.ConditionalPay, note *any.Any,
+	inCid ctype.CidType, inState int, outCid ctype.CidType, outState int, createTs *time.Time) *rpc.PaymentInfo {
+	info := &rpc.PaymentInfo{
+		PayId:           ctype.PayID2Hex(payID),
+		Src:             ctype.Bytes2Hex(pay.GetSrc()),
+		Dest:            ctype.Bytes2Hex(pay.GetDest()),
+		TokenAddress:    utils.GetTokenAddrStr(pay.GetTransferFunc().GetMaxTransfer().GetToken()),
+		AmtWei:          new(big.Int).SetBytes(pay.GetTransferFunc().GetMaxTransfer().GetReceiver().GetAmt()).String(),
+		ResolveDeadline: pay.GetResolveDeadline(),
+		CreateTs:        createTs.Unix(),
+	}
+	if inCid != ctype.ZeroCid {
+		info.IngressCid = ctype.Cid2Hex(inCid)
+		info.IngressState = fsm.PayStateName(inState)
+		if peer, found, err := c.dal.GetChanPeer(inCid); err == nil && found {
+			info.IngressPeer = ctype.Addr2Hex(peer)
+		}
+	}
+	if outCid != ctype.ZeroCid {
+		info.EgressCid = ctype.Cid2Hex(outCid)
+		info.EgressState = fsm.PayStateName(outState)
+		if peer, found, err := c.dal.GetChanPeer(outCid); err == nil && found {
+			info.EgressPeer = ctype.Addr2Hex(peer)
+		}
+	}
+	if len(note.GetValue()) != 0 {
+		info.Note, _ = utils.PbToJSONString(note)
+	}
+	if feeIn, feeOut, found, err := c.dal.GetPayFees(payID); err == nil && found {
+		info.FeeInWei = feeIn.String()
+		info.FeeOutWei = feeOut.String()
+		info.EarnedFeeWei = new(big.Int).Sub(feeIn, feeOut).String()
+	}
+	return info
+}
+
+func adminViewLimit(limit uint32) int {
+	if limit == 0 {
+		return config.AdminViewDefaultLimit
+	}
+	if limit > config.AdminViewMaxLimit {
+		return config.AdminViewMaxLimit
+	}
+	return int(limit)
+}
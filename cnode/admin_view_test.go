@@ -0,0 +1,473 @@
+// Copyright 2020 Celer Network
+
+package cnode
+
+import (
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/celer-network/goCeler/chain/channel-eth-go/payresolver"
+	"github.com/celer-network/goCeler/common"
+	"github.com/celer-network/goCeler/common/cobj"
+	"github.com/celer-network/goCeler/common/structs"
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/entity"
+	"github.com/celer-network/goCeler/fsm"
+	"github.com/celer-network/goCeler/messager"
+	"github.com/celer-network/goCeler/rpc"
+	"github.com/celer-network/goCeler/storage"
+	"github.com/celer-network/goCeler/utils"
+	"github.com/golang/protobuf/proto"
+)
+
+var testViewMe = ctype.Hex2Addr("ab0")
+
+func newTestViewNode(t *testing.T, name string) *CNode {
+	stFile := filepath.Join(os.TempDir(), name+".db")
+	os.Remove(stFile)
+	st, err := storage.NewKVStoreSQL("sqlite3", stFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		st.Close()
+		os.Remove(stFile)
+	})
+	dal := storage.NewDAL(st)
+	nodeConfig := cobj.NewCelerGlobalNodeConfig(
+		testViewMe, nil, &common.CProfile{}, "", "", "", payresolver.PayResolverABI, "", "", nil)
+	return &CNode{
+		EthAddress:     testViewMe,
+		nodeConfig:     nodeConfig,
+		dal:            dal,
+		monitorService: &testHealthMonitor{blkNum: 100},
+		messager:       messager.NewMessager(nodeConfig, nil, nil, nil, nil, nil, nil, dal, true),
+	}
+}
+
+// testViewChan is a channel with the given on-chain deposits, transfers and pending pays
+type testViewChan struct {
+	cid                      ctype.CidType
+	peer                     ctype.Addr
+	token                    ctype.Addr
+	state                    int
+	myDeposit, peerDeposit   int64
+	toPeer, fromPeer         int64
+	myLocked, peerLocked     int64
+	myPending, peerPending   []ctype.PayIDType
+	myWithdrawal, peerWdrawl int64
+}
+
+func testSimplex(
+	t *testing.T, cid ctype.CidType, from ctype.Addr, token ctype.Addr,
+	transfer, locked int64, pending []ctype.PayIDType) *rpc.SignedSimplexState {
+	var payIDs [][]byte
+	for _, payID := range pending {
+		payIDs = append(payIDs, payID.Bytes())
+	}
+	simplex := &entity.SimplexPaymentChannel{
+		ChannelId: cid.Bytes(),
+		PeerFrom:  from.Bytes(),
+		SeqNum:    uint64(len(pending)) + 1,
+		TransferToPeer: &entity.TokenTransfer{
+			Token:    utils.GetTokenInfoFromAddress(token),
+			Receiver: &entity.AccountAmtPair{Amt: big.NewInt(transfer).Bytes()},
+		},
+		PendingPayIds:      &entity.PayIdList{PayIds: payIDs},
+		TotalPendingAmount: big.NewInt(locked).Bytes(),
+	}
+	simplexBytes, err := proto.Marshal(simplex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &rpc.SignedSimplexState{SimplexState: simplexBytes}
+}
+
+func insertTestViewChan(t *testing.T, dal *storage.DAL, ch *testViewChan) {
+	state := ch.state
+	if state == 0 {
+		state = structs.ChanState_OPENED
+	}
+	err := dal.InsertChan(ch.cid, ch.peer, utils.GetTokenInfoFromAddress(ch.token), ctype.ZeroAddr, state,
+		nil,
+		&structs.OnChainBalance{
+			MyDeposit:         big.NewInt(ch.myDeposit),
+			MyWithdrawal:      big.NewInt(ch.myWithdrawal),
+			PeerDeposit:       big.NewInt(ch.peerDeposit),
+			PeerWithdrawal:    big.NewInt(ch.peerWdrawl),
+			PendingWithdrawal: &structs.PendingWithdrawal{Amount: big.NewInt(0)},
+		},
+		0, 0, 0, 0,
+		testSimplex(t, ch.cid, testViewMe, ch.token, ch.toPeer, ch.myLocked, ch.myPending),
+		testSimplex(t, ch.cid, ch.peer, ch.token, ch.fromPeer, ch.peerLocked, ch.peerPending))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func insertTestViewPay(
+	t *testing.T, dal *storage.DAL, payID ctype.PayIDType, src, dest, token ctype.Addr, amt int64,
+	inCid ctype.CidType, inState int, outCid ctype.CidType, outState int, createTs time.Time) {
+	pay := &entity.ConditionalPay{
+		Src:  src.Bytes(),
+		Dest: dest.Bytes(),
+		TransferFunc: &entity.TransferFunction{
+			MaxTransfer: &entity.TokenTransfer{
+				Token:    utils.GetTokenInfoFromAddress(token),
+				Receiver: &entity.AccountAmtPair{Amt: big.NewInt(amt).Bytes()},
+			},
+		},
+		ResolveDeadline: 200,
+	}
+	payBytes, err := proto.Marshal(pay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = dal.InsertPaymentWithTs(payID, payBytes, pay, nil, inCid, inState, outCid, outState, createTs)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAdminViewEmpty(t *testing.T) {
+	c := newTestViewNode(t, "cnode_admin_view_empty_test")
+
+	balances, err := c.GetChannelBalances(nil, false)
+	if err != nil || len(balances) != 0 {
+		t.Errorf("GetChannelBalances: %v %v", balances, err)
+	}
+	pays, err := c.GetPendingPays(ctype.ZeroCid)
+	if err != nil || len(pays) != 0 {
+		t.Errorf("GetPendingPays: %v %v", pays, err)
+	}
+	_, err = c.GetPendingPays(ctype.Hex2Cid("c1"))
+	if !errors.Is(err, common.ErrChannelNotFound) {
+		t.Errorf("GetPendingPays of unknown channel err: %v", err)
+	}
+	for _, cid := range []ctype.CidType{ctype.ZeroCid, ctype.Hex2Cid("c1")} {
+		jobs, err := c.GetDepositJobs(cid)
+		if err != nil || len(jobs) != 0 {
+			t.Errorf("GetDepositJobs %x: %v %v", cid, jobs, err)
+		}
+	}
+	routes, err := c.GetRoutingTable(nil)
+	if err != nil || len(routes) != 0 {
+		t.Errorf("GetRoutingTable: %v %v", routes, err)
+	}
+}
+
+func TestAdminView(t *testing.T) {
+	c := newTestViewNode(t, "cnode_admin_view_test")
+	dal := c.dal
+
+	token := ctype.Hex2Addr("70")
+	peer1, peer2, dest := ctype.Hex2Addr("ab1"), ctype.Hex2Addr("ab2"), ctype.Hex2Addr("ab3")
+	cid1, cid2, cid3 := ctype.Hex2Cid("c1"), ctype.Hex2Cid("c2"), ctype.Hex2Cid("c3")
+	payOut, payIn, payLost := ctype.Hex2PayID("a1"), ctype.Hex2PayID("a2"), ctype.Hex2PayID("a3")
+	insertTestViewChan(t, dal, &testViewChan{
+		cid: cid1, peer: peer1, myDeposit: 100, peerDeposit: 50, toPeer: 10, fromPeer: 5,
+		myLocked: 7, peerLocked: 3, myPending: []ctype.PayIDType{payOut, payLost}, peerPending: []ctype.PayIDType{payIn},
+	})
+	insertTestViewChan(t, dal, &testViewChan{cid: cid2, peer: peer2, token: token, myDeposit: 1000})
+	insertTestViewChan(t, dal, &testViewChan{cid: cid3, peer: dest, myDeposit: 1, state: structs.ChanState_SETTLING})
+	now := time.Now()
+	insertTestViewPay(t, dal, payOut, testViewMe, dest, ctype.ZeroAddr, 7,
+		ctype.ZeroCid, structs.PayState_NULL, cid1, structs.PayState_ONESIG_PENDING, now)
+	insertTestViewPay(t, dal, payIn, peer1, testViewMe, ctype.ZeroAddr, 3,
+		cid1, structs.PayState_COSIGNED_PENDING, ctype.ZeroCid, structs.PayState_NULL, now)
+
+	// channel balances of opened channels, filtered by token
+	balances, err := c.GetChannelBalances(nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(balances) != 2 {
+		t.Fatalf("got %d channel balances, expect 2", len(balances))
+	}
+	for _, b := range balances {
+		switch b.GetCid() {
+		case ctype.Cid2Hex(cid1):
+			// my free: 100 + 5 - 10 - 7, peer free: 50 + 10 - 5 - 3
+			if b.GetPeerAddress() != ctype.Addr2Hex(peer1) || b.GetTokenAddress() != ctype.Addr2Hex(ctype.ZeroAddr) ||
+				b.GetMyFreeWei() != "88" || b.GetPeerFreeWei() != "52" ||
+				b.GetMyPendingWei() != "7" || b.GetPeerPendingWei() != "3" {
+				t.Errorf("wrong balance %v", b)
+			}
+		case ctype.Cid2Hex(cid2):
+			if b.GetTokenAddress() != ctype.Addr2Hex(token) || b.GetMyFreeWei() != "1000" || b.GetPeerFreeWei() != "0" {
+				t.Errorf("wrong balance %v", b)
+			}
+		default:
+			t.Errorf("unexpected channel balance %v", b)
+		}
+	}
+	balances, err = c.GetChannelBalances([]ctype.Addr{token}, false)
+	if err != nil || len(balances) != 1 || balances[0].GetCid() != ctype.Cid2Hex(cid2) {
+		t.Errorf("GetChannelBalances of token: %v %v", balances, err)
+	}
+	// no peer osps known without the route controller
+	balances, err = c.GetChannelBalances(nil, true)
+	if err != nil || len(balances) != 0 {
+		t.Errorf("GetChannelBalances of peer osps: %v %v", balances, err)
+	}
+
+	// pending pays in both directions, pays missing from the pay table are skipped
+	pays, err := c.GetPendingPays(cid1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pays) != 2 {
+		t.Fatalf("got %d pending pays, expect 2", len(pays))
+	}
+	if pays[0].GetPayId() != ctype.PayID2Hex(payOut) || pays[0].GetAmtWei() != "7" ||
+		pays[0].GetEgressCid() != ctype.Cid2Hex(cid1) ||
+		pays[0].GetEgressState() != fsm.PayStateName(structs.PayState_ONESIG_PENDING) ||
+		pays[0].GetDest() != ctype.Addr2Hex(dest) || pays[0].GetResolveDeadline() != 200 {
+		t.Errorf("wrong pending pay %v", pays[0])
+	}
+	if pays[1].GetPayId() != ctype.PayID2Hex(payIn) || pays[1].GetAmtWei() != "3" ||
+		pays[1].GetIngressCid() != ctype.Cid2Hex(cid1) ||
+		pays[1].GetIngressState() != fsm.PayStateName(structs.PayState_COSIGNED_PENDING) {
+		t.Errorf("wrong pending pay %v", pays[1])
+	}
+	pays, err = c.GetPendingPays(cid2)
+	if err != nil || len(pays) != 0 {
+		t.Errorf("GetPendingPays of channel without pays: %v %v", pays, err)
+	}
+
+	// deposit jobs of a channel, or all running jobs
+	deadline := now.Add(time.Hour)
+	jobStates := map[string]int{
+		"d1": structs.DepositState_TX_SUBMITTED,
+		"d2": structs.DepositState_SUCCEEDED,
+		"d3": structs.DepositState_APPROVING_ERC20,
+	}
+	jobCids := map[string]ctype.CidType{"d1": cid1, "d2": cid1, "d3": cid2}
+	for uuid, state := range jobStates {
+		err = dal.InsertDeposit(uuid, jobCids[uuid], false, big.NewInt(10), false, deadline, state, "", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	jobs, err := c.GetDepositJobs(cid1)
+	if err != nil || len(jobs) != 2 {
+		t.Errorf("GetDepositJobs of channel: %v %v", jobs, err)
+	}
+	jobs, err = c.GetDepositJobs(ctype.ZeroCid)
+	if err != nil || len(jobs) != 2 {
+		t.Errorf("GetDepositJobs running: %v %v", jobs, err)
+	}
+	for _, job := range jobs {
+		if job.UUID == "d2" {
+			t.Errorf("finished job %s listed as running", job.UUID)
+		}
+	}
+
+	// routing table with the next hop peers, filtered by token
+	err = dal.UpsertRouting(dest, utils.GetTokenInfoFromAddress(ctype.ZeroAddr), cid1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = dal.UpsertRouting(dest, utils.GetTokenInfoFromAddress(token), ctype.Hex2Cid("c9"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	routes, err := c.GetRoutingTable(nil)
+	if err != nil || len(routes) != 2 {
+		t.Fatalf("GetRoutingTable: %v %v", routes, err)
+	}
+	routes, err = c.GetRoutingTable([]ctype.Addr{ctype.ZeroAddr})
+	if err != nil || len(routes) != 1 {
+		t.Fatalf("GetRoutingTable of token: %v %v", routes, err)
+	}
+	if routes[0].GetDestAddress() != ctype.Addr2Hex(dest) || routes[0].GetNextHopCid() != ctype.Cid2Hex(cid1) ||
+		routes[0].GetNextHopAddress() != ctype.Addr2Hex(peer1) {
+		t.Errorf("wrong route %v", routes[0])
+	}
+	// next hop channel no longer exists
+	routes, err = c.GetRoutingTable([]ctype.Addr{token})
+	if err != nil || len(routes) != 1 || routes[0].GetNextHopAddress() != "" {
+		t.Errorf("GetRoutingTable of closed next hop: %v %v", routes, err)
+	}
+}
+
+func TestAdminChannelView(t *testing.T) {
+	c := newTestViewNode(t, "cnode_admin_channel_view_test")
+	dal := c.dal
+
+	token := ctype.Hex2Addr("70")
+	peer1, peer2 := ctype.Hex2Addr("ab1"), ctype.Hex2Addr("ab2")
+	cid1, cid2, cid3, closedCid := ctype.Hex2Cid("c1"), ctype.Hex2Cid("c2"), ctype.Hex2Cid("c3"), ctype.Hex2Cid("c4")
+	insertTestViewChan(t, dal, &testViewChan{
+		cid: cid1, peer: peer1, myDeposit: 100, peerDeposit: 50, toPeer: 10, fromPeer: 5, myWithdrawal: 1,
+		myLocked: 7, myPending: []ctype.PayIDType{ctype.Hex2PayID("a1")},
+	})
+	insertTestViewChan(t, dal, &testViewChan{cid: cid2, peer: peer1, token: token, myDeposit: 1000})
+	insertTestViewChan(t, dal, &testViewChan{cid: cid3, peer: peer2, myDeposit: 1, state: structs.ChanState_SETTLING})
+	openTs := time.Unix(1000, 0)
+	err := dal.InsertClosedChan(closedCid, peer2, utils.GetTokenInfoFromAddress(token), openTs, openTs.Add(time.Hour), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// channel list filters and pages
+	listCids := func(peer, token *ctype.Addr, state int, offset, limit uint32) map[string]bool {
+		channels, err := c.ListChannels(peer, token, state, offset, limit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cids := make(map[string]bool)
+		for _, ch := range channels {
+			cids[ch.GetCid()] = true
+		}
+		return cids
+	}
+	zeroAddr := ctype.ZeroAddr
+	testCases := []struct {
+		name   string
+		peer   *ctype.Addr
+		token  *ctype.Addr
+		state  int
+		offset uint32
+		limit  uint32
+		count  int
+	}{
+		{name: "all", count: 3},
+		{name: "peer", peer: &peer1, count: 2},
+		{name: "token", token: &token, count: 1},
+		{name: "eth", token: &zeroAddr, count: 2},
+		{name: "state", state: structs.ChanState_SETTLING, count: 1},
+		{name: "peer and token", peer: &peer2, token: &token, count: 0},
+		{name: "first page", limit: 2, count: 2},
+		{name: "last page", offset: 2, limit: 2, count: 1},
+		{name: "past the end", offset: 3, count: 0},
+	}
+	for _, tc := range testCases {
+		if cids := listCids(tc.peer, tc.token, tc.state, tc.offset, tc.limit); len(cids) != tc.count {
+			t.Errorf("ListChannels %s: got %v, expect %d channels", tc.name, cids, tc.count)
+		}
+	}
+	firstPage, lastPage := listCids(nil, nil, 0, 0, 2), listCids(nil, nil, 0, 2, 2)
+	for cid := range lastPage {
+		if firstPage[cid] {
+			t.Errorf("channel %s in both pages", cid)
+		}
+	}
+	channels, err := c.ListChannels(&peer2, nil, 0, 0, 0)
+	if err != nil || len(channels) != 1 {
+		t.Fatalf("ListChannels of peer: %v %v", channels, err)
+	}
+	if channels[0].GetCid() != ctype.Cid2Hex(cid3) || channels[0].GetPeerAddress() != ctype.Addr2Hex(peer2) ||
+		channels[0].GetTokenAddress() != ctype.Addr2Hex(ctype.ZeroAddr) ||
+		channels[0].GetState() != fsm.ChanStateName(structs.ChanState_SETTLING) {
+		t.Errorf("wrong channel %v", channels[0])
+	}
+
+	// channel detail of opened, settling, closed and unknown channels
+	detail, err := c.GetChannelDetail(cid1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if detail.GetChannel().GetState() != fsm.ChanStateName(structs.ChanState_OPENED) ||
+		detail.GetMyDepositWei() != "100" || detail.GetMyWithdrawalWei() != "1" || detail.GetPeerDepositWei() != "50" ||
+		detail.GetSelfSeqNum() != 2 || detail.GetPeerSeqNum() != 1 || detail.GetPendingPays() != 1 {
+		t.Errorf("wrong channel detail %v", detail)
+	}
+	// my free: 100 + 5 - 1 - 10 - 7, peer free: 50 + 10 - 5
+	if detail.GetBalance().GetMyFreeWei() != "87" || detail.GetBalance().GetPeerFreeWei() != "55" ||
+		detail.GetBalance().GetMyPendingWei() != "7" {
+		t.Errorf("wrong channel balance %v", detail.GetBalance())
+	}
+	detail, err = c.GetChannelDetail(cid3)
+	if err != nil || detail.GetBalance() != nil || detail.GetMyDepositWei() != "1" {
+		t.Errorf("settling channel detail: %v %v", detail, err)
+	}
+	detail, err = c.GetChannelDetail(closedCid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if detail.GetChannel().GetState() != fsm.ChanStateName(structs.ChanState_CLOSED) ||
+		detail.GetChannel().GetPeerAddress() != ctype.Addr2Hex(peer2) ||
+		detail.GetChannel().GetTokenAddress() != ctype.Addr2Hex(token) ||
+		detail.GetChannel().GetOpenTs() != openTs.Unix() || detail.GetChannel().GetStateTs() != openTs.Unix()+3600 {
+		t.Errorf("wrong closed channel detail %v", detail)
+	}
+	_, err = c.GetChannelDetail(ctype.Hex2Cid("c9"))
+	if !errors.Is(err, common.ErrChannelNotFound) {
+		t.Errorf("GetChannelDetail of unknown channel err: %v", err)
+	}
+	status, err := c.GetChannelStatus(cid1)
+	if err != nil || status.GetPeerAddress() != ctype.Addr2Hex(peer1) || status.GetPeerConnected() {
+		t.Errorf("GetChannelStatus: %v %v", status, err)
+	}
+	_, err = c.GetChannelStatus(closedCid)
+	if !errors.Is(err, common.ErrChannelNotFound) {
+		t.Errorf("GetChannelStatus of closed channel err: %v", err)
+	}
+
+	// pays of the channel, most recent first
+	now := time.Now()
+	for i := 0; i < 5; i++ {
+		payID := ctype.Bytes2PayID([]byte{byte(i + 1)})
+		createTs := now.Add(time.Duration(i) * time.Second)
+		if i%2 == 0 {
+			insertTestViewPay(t, dal, payID, peer2, peer1, ctype.ZeroAddr, int64(i+1),
+				cid3, structs.PayState_COSIGNED_PAID, cid1, structs.PayState_COSIGNED_PAID, createTs)
+		} else {
+			insertTestViewPay(t, dal, payID, testViewMe, peer1, ctype.ZeroAddr, int64(i+1),
+				ctype.ZeroCid, structs.PayState_NULL, cid1, structs.PayState_ONESIG_PENDING, createTs)
+		}
+	}
+	insertTestViewPay(t, dal, ctype.Bytes2PayID([]byte{9}), testViewMe, peer1, token, 9,
+		ctype.ZeroCid, structs.PayState_NULL, cid2, structs.PayState_COSIGNED_PAID, now)
+	relayedPay := ctype.Bytes2PayID([]byte{5})
+	if err = dal.PutPayFee(relayedPay, cid3, big.NewInt(3)); err != nil {
+		t.Fatal(err)
+	}
+	if err = dal.PutPayFee(relayedPay, cid1, big.NewInt(1)); err != nil {
+		t.Fatal(err)
+	}
+
+	pays, total, err := c.ListChannelPays(cid1, 0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 5 || len(pays) != 2 {
+		t.Fatalf("got %d of %d pays, expect 2 of 5", len(pays), total)
+	}
+	if pays[0].GetPayId() != ctype.PayID2Hex(relayedPay) || pays[1].GetPayId() != ctype.PayID2Hex(ctype.Bytes2PayID([]byte{4})) {
+		t.Errorf("wrong first page %v", pays)
+	}
+	if pays[0].GetIngressPeer() != ctype.Addr2Hex(peer2) || pays[0].GetEgressPeer() != ctype.Addr2Hex(peer1) ||
+		pays[0].GetAmtWei() != "5" || pays[0].GetFeeInWei() != "3" || pays[0].GetFeeOutWei() != "1" ||
+		pays[0].GetEarnedFeeWei() != "2" || pays[0].GetCreateTs() != now.Add(4*time.Second).Unix() {
+		t.Errorf("wrong relayed pay %v", pays[0])
+	}
+	pays, total, err = c.ListChannelPays(cid1, 4, 2)
+	if err != nil || total != 5 || len(pays) != 1 || pays[0].GetPayId() != ctype.PayID2Hex(ctype.Bytes2PayID([]byte{1})) {
+		t.Errorf("ListChannelPays last page: %v %d %v", pays, total, err)
+	}
+	pays, total, err = c.ListChannelPays(ctype.Hex2Cid("c9"), 0, 0)
+	if err != nil || total != 0 || len(pays) != 0 {
+		t.Errorf("ListChannelPays of unknown channel: %v %d %v", pays, total, err)
+	}
+
+	// pay lookup
+	payment, err := c.GetPayment(ctype.Bytes2PayID([]byte{2}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payment.GetSrc() != ctype.Addr2Hex(testViewMe) || payment.GetIngressCid() != "" ||
+		payment.GetEgressCid() != ctype.Cid2Hex(cid1) || payment.GetEgressState() != fsm.PayStateName(structs.PayState_ONESIG_PENDING) ||
+		payment.GetFeeInWei() != "" {
+		t.Errorf("wrong payment %v", payment)
+	}
+	_, err = c.GetPayment(ctype.Bytes2PayID([]byte{8}))
+	if !errors.Is(err, common.ErrPayNotFound) {
+		t.Errorf("GetPayment of unknown pay err: %v", err)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/celer-network/goCeler/common"
+	"github.com/celer-network/goCeler/common/intfs"
 	"github.com/celer-network/goCeler/common/structs"
 	"github.com/celer-network/goCeler/config"
 	"github.com/celer-network/goCeler/ctype"
@@ -43,14 +44,7 @@ var (
 )
 
 func (c *CNode) getAuthReq(peerAddr ctype.Addr) (*rpc.AuthReq, error) {
-	ts, tsSig := utils.GetTsAndSig(func(b []byte) []byte {
-		sig, err := c.signer.SignEthMessage(b)
-		if err != nil {
-			log.Error(err)
-			return nil
-		}
-		return sig
-	})
+	ts, tsSig := utils.GetTsAndSig(c.SignAuthTs)
 	if tsSig == nil {
 		return nil, fmt.Errorf("auth ts sig error")
 	}
@@ -415,7 +409,7 @@ func (c *CNode) checkSignedSimplex(ss *rpc.SignedSimplexState, expCid ctype.CidT
 	if len(ch.PendingPayIds.GetPayIds()) != 0 || len(ch.PendingPayIds.GetNextListHash()) != 0 {
 		return common.ErrInvalidPendingPays
 	}
-	mysig, err := c.signer.SignEthMessage(ss.GetSimplexState())
+	mysig, err := utils.SignEthMessageOfKind(c.signer, intfs.SignKindSimplexState, ss.GetSimplexState())
 	if err != nil {
 		return err
 	}
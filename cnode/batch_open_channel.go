@@ -0,0 +1,293 @@
+// Copyright 2020 Celer Network
+
+package cnode
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/celer-network/goCeler/common"
+	"github.com/celer-network/goCeler/common/intfs"
+	"github.com/celer-network/goCeler/config"
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/entity"
+	"github.com/celer-network/goCeler/pem"
+	"github.com/celer-network/goCeler/rpc"
+	"github.com/celer-network/goCeler/utils"
+	"github.com/celer-network/goutils/log"
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+)
+
+// BatchOpenChannelItem describes one channel to open in a batch
+type BatchOpenChannelItem struct {
+	Peer      ctype.Addr
+	TokenInfo *entity.TokenInfo
+	AmtSelf   *big.Int
+	AmtPeer   *big.Int
+}
+
+// BatchOpenChannelCallback receives the progress of each channel in a batch open.
+// Calls are serialized, and the progress passed in is owned by the callback.
+type BatchOpenChannelCallback interface {
+	HandleBatchOpenChannelProgress(progress *rpc.BatchOpenChannelProgress)
+}
+
+// batchOpen tracks the progress of all channels in a batch open
+type batchOpen struct {
+	progress []*rpc.BatchOpenChannelProgress
+	cids     []ctype.CidType
+	pending  int
+	done     chan bool // closed when all channels are opened or failed
+	cb       BatchOpenChannelCallback
+	lock     sync.Mutex
+}
+
+func newBatchOpen(items []*BatchOpenChannelItem, cb BatchOpenChannelCallback) *batchOpen {
+	b := &batchOpen{
+		progress: make([]*rpc.BatchOpenChannelProgress, len(items)),
+		cids:     make([]ctype.CidType, len(items)),
+		pending:  len(items),
+		done:     make(chan bool),
+		cb:       cb,
+	}
+	for i, item := range items {
+		b.progress[i] = &rpc.BatchOpenChannelProgress{
+			Index:          uint32(i),
+			PeerEthAddress: item.Peer.Bytes(),
+			TokenAddress:   utils.GetTokenAddr(item.TokenInfo).Bytes(),
+			State:          rpc.BatchOpenChannelState_BatchOpen_PENDING,
+		}
+	}
+	if b.pending == 0 {
+		close(b.done)
+	}
+	return b
+}
+
+func isFinalBatchOpenState(state rpc.BatchOpenChannelState) bool {
+	return state == rpc.BatchOpenChannelState_BatchOpen_OPENED || state == rpc.BatchOpenChannelState_BatchOpen_FAILED
+}
+
+// update moves channel idx to a new state and reports it, updates after a final state are ignored
+func (b *batchOpen) update(idx int, state rpc.BatchOpenChannelState, err error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	progress := b.progress[idx]
+	if isFinalBatchOpenState(progress.State) {
+		return
+	}
+	progress.State = state
+	if err != nil {
+		progress.Error = err.Error()
+	}
+	if b.cids[idx] != ctype.ZeroCid {
+		progress.Cid = ctype.Cid2Hex(b.cids[idx])
+	}
+	if b.cb != nil {
+		b.cb.HandleBatchOpenChannelProgress(proto.Clone(progress).(*rpc.BatchOpenChannelProgress))
+	}
+	if isFinalBatchOpenState(state) {
+		b.pending--
+		if b.pending == 0 {
+			close(b.done)
+		}
+	}
+}
+
+func (b *batchOpen) setCid(idx int, cid ctype.CidType) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.cids[idx] = cid
+}
+
+// batchOpenItemCallback turns the open channel callbacks of one channel into batch progress
+type batchOpenItemCallback struct {
+	b   *batchOpen
+	idx int
+}
+
+func (cb *batchOpenItemCallback) HandleOpenChannelFinish(cid ctype.CidType) {
+	cb.b.update(cb.idx, rpc.BatchOpenChannelState_BatchOpen_OPENED, nil)
+}
+
+func (cb *batchOpenItemCallback) HandleOpenChannelErr(e *common.E) {
+	cb.b.update(cb.idx, rpc.BatchOpenChannelState_BatchOpen_FAILED, errors.New(e.Reason))
+}
+
+// coSignBatchOpenChannel gets the channel initializer co-signed by the peer, and registers the
+// open callback of the channel. Channel opens with the same peer on the same token are serialized.
+func (p *openChannelProcessor) coSignBatchOpenChannel(
+	item *BatchOpenChannelItem,
+	ledgerAddr ctype.Addr,
+	openCallback *batchOpenItemCallback,
+	ocem *pem.OpenChannelEventMessage) (*rpc.OpenChannelResponse, error) {
+	ocem.Peer = ctype.Addr2Hex(item.Peer)
+	ocem.TokenAddr = utils.GetTokenAddrStr(item.TokenInfo)
+	lock := p.getLockPerTokenPerPeer(item.Peer, item.TokenInfo)
+	lock.Lock()
+	defer lock.Unlock()
+	_, exist, err := p.dal.GetCidByPeerTokenOnChain(item.Peer, item.TokenInfo, p.nodeConfig.GetChainId())
+	if err != nil {
+		return nil, err
+	}
+	if exist {
+		return nil, common.ErrChannelExists
+	}
+	initializer, err := p.prepareChannelInitializer(item.Peer, item.AmtSelf, item.AmtPeer, item.TokenInfo)
+	if err != nil {
+		return nil, err
+	}
+	initializer.OpenDeadline = p.monitorService.GetCurrentBlockNumber().Uint64() + config.OpenChannelTimeout
+	ocem.ReadableInitializer = utils.PrintChannelInitializer(initializer)
+	initializerBytes, err := proto.Marshal(initializer)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := utils.SignEthMessageOfKind(p.signer, intfs.SignKindChannelInitializer, initializerBytes)
+	if err != nil {
+		return nil, err
+	}
+	cid, err := p.computePscID(initializerBytes, ledgerAddr, p.nodeConfig.GetWalletContract().GetAddr())
+	if err != nil {
+		return nil, err
+	}
+	ocem.Cid = ctype.Cid2Hex(cid)
+	openBy := rpc.OpenChannelBy_OPEN_CHANNEL_PROPOSER
+	if big.NewInt(0).Cmp(item.AmtSelf) == 0 {
+		openBy = rpc.OpenChannelBy_OPEN_CHANNEL_APPROVER
+	}
+	req := &rpc.OpenChannelRequest{
+		ChannelInitializer: initializerBytes,
+		RequesterSig:       sig,
+		OpenBy:             openBy,
+		OspToOsp:           true,
+		ChainId:            p.nodeConfig.GetChainId(),
+	}
+	rc, err := p.connectionManager.GetClient(item.Peer)
+	if err != nil {
+		return nil, err
+	}
+	// register before the request as the approver may send the open tx right away
+	p.callbacksLock.Lock()
+	p.cidCallbacks[cid] = openCallback
+	p.callbacksLock.Unlock()
+	openCallback.b.setCid(openCallback.idx, cid)
+	resp, err := rc.CelerOpenChannel(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.Status {
+	case rpc.OpenChannelStatus_OPEN_CHANNEL_APPROVED, rpc.OpenChannelStatus_OPEN_CHANNEL_TX_SUBMITTED:
+		return resp, nil
+	default:
+		return nil, fmt.Errorf("unexpected open channel status %s", resp.Status)
+	}
+}
+
+// batchOpenChannel co-signs the channel initializers with all peers concurrently, approves the ERC20
+// allowance of each token once for all its channels, then sends one open tx for each channel as the
+// ledger opens a single channel per tx. It returns when all channels are opened or failed, or timed out.
+func (p *openChannelProcessor) batchOpenChannel(items []*BatchOpenChannelItem, cb BatchOpenChannelCallback) {
+	b := newBatchOpen(items, cb)
+	ledgerAddr := p.nodeConfig.GetLedgerContract().GetAddr()
+	resps := make([]*rpc.OpenChannelResponse, len(items))
+	seen := make(map[string]bool)
+	var wg sync.WaitGroup
+	for i, item := range items {
+		key := ctype.Addr2Hex(item.Peer) + utils.GetTokenAddrStr(item.TokenInfo)
+		if seen[key] {
+			b.update(i, rpc.BatchOpenChannelState_BatchOpen_FAILED, errors.New("duplicate peer and token in batch"))
+			continue
+		}
+		seen[key] = true
+		wg.Add(1)
+		go func(i int, item *BatchOpenChannelItem) {
+			defer wg.Done()
+			ocem := pem.NewOcem(p.nodeConfig.GetRPCAddr())
+			ocem.Type = pem.OpenChannelEventType_OPEN_CHANNEL_API
+			ocem.OspToOsp = true
+			defer pem.CommitOcem(ocem)
+			resp, err := p.coSignBatchOpenChannel(item, ledgerAddr, &batchOpenItemCallback{b: b, idx: i}, ocem)
+			if err != nil {
+				log.Errorf("failed to co-sign channel with %x: %s", item.Peer, err)
+				ocem.Error = append(ocem.Error, err.Error())
+				b.update(i, rpc.BatchOpenChannelState_BatchOpen_FAILED, err)
+				return
+			}
+			resps[i] = resp
+			if resp.Status == rpc.OpenChannelStatus_OPEN_CHANNEL_TX_SUBMITTED {
+				b.update(i, rpc.BatchOpenChannelState_BatchOpen_TX_SUBMITTED, nil)
+			} else {
+				b.update(i, rpc.BatchOpenChannelState_BatchOpen_CO_SIGNED, nil)
+			}
+		}(i, item)
+	}
+	wg.Wait()
+
+	// approve the total self deposit of each ERC20 token in one tx
+	approveAmts := make(map[ctype.Addr]*big.Int)
+	tokenInfos := make(map[ctype.Addr]*entity.TokenInfo)
+	for i, resp := range resps {
+		if resp.GetStatus() != rpc.OpenChannelStatus_OPEN_CHANNEL_APPROVED ||
+			items[i].TokenInfo.GetTokenType() != entity.TokenType_ERC20 {
+			continue
+		}
+		tokenAddr := utils.GetTokenAddr(items[i].TokenInfo)
+		if _, ok := approveAmts[tokenAddr]; !ok {
+			approveAmts[tokenAddr] = big.NewInt(0)
+			tokenInfos[tokenAddr] = items[i].TokenInfo
+		}
+		approveAmts[tokenAddr].Add(approveAmts[tokenAddr], items[i].AmtSelf)
+	}
+	approveErrs := make(map[ctype.Addr]error)
+	for tokenAddr, amt := range approveAmts {
+		approveErrs[tokenAddr] = p.approveErc20Allowance(ledgerAddr, amt, tokenInfos[tokenAddr], nil)
+	}
+
+	selfAddr := p.nodeConfig.GetOnChainAddr()
+	for i, resp := range resps {
+		if resp.GetStatus() != rpc.OpenChannelStatus_OPEN_CHANNEL_APPROVED {
+			continue
+		}
+		item := items[i]
+		txValue := item.AmtSelf
+		if item.TokenInfo.GetTokenType() == entity.TokenType_ERC20 {
+			if err := approveErrs[utils.GetTokenAddr(item.TokenInfo)]; err != nil {
+				b.update(i, rpc.BatchOpenChannelState_BatchOpen_FAILED, err)
+				continue
+			}
+			txValue = ctype.ZeroBigInt
+		}
+		err := p.sendOpenChannelTransaction(
+			ledgerAddr, resp, selfAddr.Bytes(), item.Peer.Bytes(), txValue, &batchOpenItemCallback{b: b, idx: i})
+		if err != nil {
+			b.update(i, rpc.BatchOpenChannelState_BatchOpen_FAILED, err)
+			continue
+		}
+		b.update(i, rpc.BatchOpenChannelState_BatchOpen_TX_SUBMITTED, nil)
+	}
+
+	select {
+	case <-b.done:
+	case <-time.After(time.Duration(maxOpenChannelTimeoutMinutes) * time.Minute):
+		for i := range items {
+			b.update(i, rpc.BatchOpenChannelState_BatchOpen_FAILED, common.ErrOpenChannelTimeout)
+		}
+	}
+	p.callbacksLock.Lock()
+	for _, cid := range b.cids {
+		delete(p.cidCallbacks, cid)
+	}
+	p.callbacksLock.Unlock()
+}
+
+// BatchOpenChannel opens channels with multiple peer OSPs, reporting the progress of each channel
+// through cb. It blocks until all channels are opened or failed.
+func (c *CNode) BatchOpenChannel(items []*BatchOpenChannelItem, cb BatchOpenChannelCallback) {
+	log.Infof("batch opening %d channels", len(items))
+	c.openChannelProcessor.batchOpenChannel(items, cb)
+}
@@ -0,0 +1,181 @@
+// Copyright 2020 Celer Network
+
+package cnode
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/celer-network/goCeler/chain/channel-eth-go/ledger"
+	"github.com/celer-network/goCeler/common"
+	"github.com/celer-network/goCeler/common/cobj"
+	"github.com/celer-network/goCeler/common/event"
+	"github.com/celer-network/goCeler/common/structs"
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/pem"
+	"github.com/celer-network/goCeler/rpc"
+	"github.com/celer-network/goCeler/storage"
+	"github.com/celer-network/goCeler/utils"
+)
+
+// testBatchOpenCallback records the reported progress
+type testBatchOpenCallback struct {
+	progress []*rpc.BatchOpenChannelProgress
+	lock     sync.Mutex
+}
+
+func (cb *testBatchOpenCallback) HandleBatchOpenChannelProgress(progress *rpc.BatchOpenChannelProgress) {
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+	cb.progress = append(cb.progress, progress)
+}
+
+func (cb *testBatchOpenCallback) states(idx uint32) []rpc.BatchOpenChannelState {
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+	var states []rpc.BatchOpenChannelState
+	for _, progress := range cb.progress {
+		if progress.GetIndex() == idx {
+			states = append(states, progress.GetState())
+		}
+	}
+	return states
+}
+
+func isDone(b *batchOpen) bool {
+	select {
+	case <-b.done:
+		return true
+	default:
+		return false
+	}
+}
+
+func TestBatchOpenProgress(t *testing.T) {
+	token := utils.GetTokenInfoFromAddress(ctype.ZeroAddr)
+	items := []*BatchOpenChannelItem{
+		{Peer: ctype.Hex2Addr("abc1"), TokenInfo: token},
+		{Peer: ctype.Hex2Addr("abc2"), TokenInfo: token},
+	}
+	cb := &testBatchOpenCallback{}
+	b := newBatchOpen(items, cb)
+	if isDone(b) {
+		t.Fatal("batch done before any channel opened")
+	}
+
+	cid := ctype.Hex2Cid("c1")
+	b.update(0, rpc.BatchOpenChannelState_BatchOpen_CO_SIGNED, nil)
+	b.setCid(0, cid)
+	b.update(0, rpc.BatchOpenChannelState_BatchOpen_TX_SUBMITTED, nil)
+	b.update(0, rpc.BatchOpenChannelState_BatchOpen_OPENED, nil)
+	// updates after a final state are ignored
+	b.update(0, rpc.BatchOpenChannelState_BatchOpen_FAILED, common.ErrOpenChannelTimeout)
+	if isDone(b) {
+		t.Fatal("batch done with a channel pending")
+	}
+	b.update(1, rpc.BatchOpenChannelState_BatchOpen_FAILED, errors.New("peer offline"))
+	if !isDone(b) {
+		t.Fatal("batch not done after all channels opened or failed")
+	}
+
+	expect := []rpc.BatchOpenChannelState{
+		rpc.BatchOpenChannelState_BatchOpen_CO_SIGNED,
+		rpc.BatchOpenChannelState_BatchOpen_TX_SUBMITTED,
+		rpc.BatchOpenChannelState_BatchOpen_OPENED,
+	}
+	states := cb.states(0)
+	if len(states) != len(expect) {
+		t.Fatalf("channel 0 states %v, expect %v", states, expect)
+	}
+	for i := range expect {
+		if states[i] != expect[i] {
+			t.Errorf("channel 0 states %v, expect %v", states, expect)
+		}
+	}
+	last := cb.progress[2]
+	if last.GetCid() != ctype.Cid2Hex(cid) || last.GetError() != "" {
+		t.Errorf("wrong opened progress %v", last)
+	}
+	failed := cb.progress[3]
+	if failed.GetIndex() != 1 || failed.GetError() != "peer offline" || failed.GetCid() != "" {
+		t.Errorf("wrong failed progress %v", failed)
+	}
+	// the reported progress is owned by the callback
+	last.State = rpc.BatchOpenChannelState_BatchOpen_PENDING
+	if b.progress[0].GetState() != rpc.BatchOpenChannelState_BatchOpen_OPENED {
+		t.Error("batch progress changed by the callback")
+	}
+
+	if !isDone(newBatchOpen(nil, nil)) {
+		t.Error("empty batch not done")
+	}
+}
+
+func TestBatchOpenChannel(t *testing.T) {
+	stFile := filepath.Join(os.TempDir(), "cnode_batch_open_test.db")
+	os.Remove(stFile)
+	st, err := storage.NewKVStoreSQL("sqlite3", stFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(stFile)
+	defer st.Close()
+	dal := storage.NewDAL(st)
+
+	token := utils.GetTokenInfoFromAddress(ctype.ZeroAddr)
+	peer := ctype.Hex2Addr("abc1")
+	err = dal.InsertChanOnChain(0, ctype.Hex2Cid("c1"), peer, token, ctype.ZeroAddr, structs.ChanState_OPENED,
+		nil, &structs.OnChainBalance{}, 0, 0, 0, 0, &rpc.SignedSimplexState{}, &rpc.SignedSimplexState{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &openChannelProcessor{
+		nodeConfig: cobj.NewCelerGlobalNodeConfig(
+			ctype.ZeroAddr, nil, &common.CProfile{}, "", ledger.CelerLedgerABI, "", "", "", "", nil),
+		dal:                 dal,
+		cidCallbacks:        make(map[ctype.CidType]event.OpenChannelCallback),
+		lockPerTokenPerPeer: make(map[string]*sync.Mutex),
+	}
+	if p.getLockPerTokenPerPeer(peer, token) != p.getLockPerTokenPerPeer(peer, token) ||
+		p.getLockPerTokenPerPeer(peer, token) == p.getLockPerTokenPerPeer(ctype.Hex2Addr("abc2"), token) {
+		t.Error("wrong lock per token per peer")
+	}
+
+	// co-sign waits for other opens with the same peer on the same token
+	lock := p.getLockPerTokenPerPeer(peer, token)
+	lock.Lock()
+	errChan := make(chan error, 1)
+	go func() {
+		b := newBatchOpen([]*BatchOpenChannelItem{{Peer: peer, TokenInfo: token}}, nil)
+		_, err2 := p.coSignBatchOpenChannel(&BatchOpenChannelItem{Peer: peer, TokenInfo: token},
+			ctype.ZeroAddr, &batchOpenItemCallback{b: b}, pem.NewOcem(""))
+		errChan <- err2
+	}()
+	select {
+	case err = <-errChan:
+		t.Fatalf("co-sign not blocked by the lock: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+	lock.Unlock()
+	err = <-errChan
+	if !errors.Is(err, common.ErrChannelExists) {
+		t.Errorf("expect ErrChannelExists, got %v", err)
+	}
+
+	// existing and duplicate channels fail without blocking the batch
+	cb := &testBatchOpenCallback{}
+	p.batchOpenChannel([]*BatchOpenChannelItem{
+		{Peer: peer, TokenInfo: token},
+		{Peer: peer, TokenInfo: token},
+	}, cb)
+	for idx := uint32(0); idx < 2; idx++ {
+		states := cb.states(idx)
+		if len(states) != 1 || states[0] != rpc.BatchOpenChannelState_BatchOpen_FAILED {
+			t.Errorf("channel %d states %v, expect failed", idx, states)
+		}
+	}
+}
@@ -0,0 +1,386 @@
+// Copyright 2020 Celer Network
+
+package cnode
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/celer-network/goCeler/chain/channel-eth-go/ledger"
+	"github.com/celer-network/goCeler/chain/channel-eth-go/payregistry"
+	"github.com/celer-network/goCeler/chain/channel-eth-go/payresolver"
+	"github.com/celer-network/goCeler/chain/channel-eth-go/routerregistry"
+	"github.com/celer-network/goCeler/chain/channel-eth-go/virtresolver"
+	"github.com/celer-network/goCeler/chain/channel-eth-go/wallet"
+	"github.com/celer-network/goCeler/common"
+	"github.com/celer-network/goCeler/common/cobj"
+	"github.com/celer-network/goCeler/common/event"
+	"github.com/celer-network/goCeler/config"
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/deposit"
+	"github.com/celer-network/goCeler/dispute"
+	"github.com/celer-network/goCeler/messager"
+	"github.com/celer-network/goCeler/reorg"
+	"github.com/celer-network/goCeler/storage"
+	"github.com/celer-network/goCeler/txmgr"
+	"github.com/celer-network/goutils/eth"
+	"github.com/celer-network/goutils/eth/monitor"
+	"github.com/celer-network/goutils/eth/watcher"
+	"github.com/celer-network/goutils/log"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+// chainContext holds the on-chain services of an extra chain run by a multi-chain OSP.
+// Channels on an extra chain only serve directly connected peers. Multi-hop routing,
+// cross-net pays, cooperative withdraw and channel migration stay on the primary chain.
+// TODO: bridge pays between chains inside the OSP. A pay received on one chain is not
+// forwarded as a new pay on another chain yet, such pays fail with ErrRouteNotFound.
+type chainContext struct {
+	chainId              uint64
+	ethclient            *ethclient.Client
+	ethRPCClient         *ethrpc.Client
+	txManager            *txmgr.TxManager
+	masterTransactor     *txmgr.Transactor
+	transactorPool       *txmgr.TransactorPool
+	watch                *watcher.WatchService
+	watchClient          *pollClient
+	blockDelay           uint64
+	reorgTracker         *reorg.Tracker
+	nodeConfig           *cobj.CelerGlobalNodeConfig
+	depositProcessor     *deposit.Processor
+	disputer             *dispute.Processor
+	openChannelProcessor *openChannelProcessor
+	services             *messager.ChainServices
+}
+
+// chainWatchDAL keeps the watcher progress of an extra chain in the shared monitor table,
+// with event keys prefixed by the chain id
+type chainWatchDAL struct {
+	dal    *storage.DAL
+	prefix string
+}
+
+func newChainWatchDAL(dal *storage.DAL, chainId uint64) *chainWatchDAL {
+	return &chainWatchDAL{dal: dal, prefix: chainMonitorPrefix(chainId)}
+}
+
+func chainMonitorPrefix(chainId uint64) string {
+	return fmt.Sprintf("chain%d-", chainId)
+}
+
+func (d *chainWatchDAL) InsertMonitor(eventName string, blockNum uint64, blockIdx int64, restart bool) error {
+	return d.dal.InsertMonitor(d.prefix+eventName, blockNum, blockIdx, restart)
+}
+
+func (d *chainWatchDAL) GetMonitorBlock(eventName string) (uint64, int64, bool, error) {
+	return d.dal.GetMonitorBlock(d.prefix + eventName)
+}
+
+func (d *chainWatchDAL) UpdateMonitorBlock(eventName string, blockNum uint64, blockIdx int64) error {
+	return d.dal.UpdateMonitorBlock(d.prefix+eventName, blockNum, blockIdx)
+}
+
+func (d *chainWatchDAL) UpsertMonitorBlock(eventName string, blockNum uint64, blockIdx int64, restart bool) error {
+	return d.dal.UpsertMonitorBlock(d.prefix+eventName, blockNum, blockIdx, restart)
+}
+
+// AddChain lets the OSP open and serve channels on another EVM chain described by the profile,
+// using the same account as the primary chain. txConfig is the keystore of the OSP account,
+// which signs transactions with the chain id of the profile.
+// AddChain should be called before the OSP starts serving peers.
+func (c *CNode) AddChain(profile common.CProfile, txConfig *eth.TransactorConfig) error {
+	if !c.isOSP || c.isMultiServer {
+		return errors.New("extra chains are only supported by single-server OSPs")
+	}
+	if c.externalSigner || txConfig == nil {
+		return errors.New("extra chains require the keystore of the OSP account")
+	}
+	chainId := uint64(profile.ChainId)
+	if c.isPrimaryChain(chainId) {
+		return fmt.Errorf("chain %d is the primary chain", chainId)
+	}
+	if c.getChain(chainId) != nil {
+		return fmt.Errorf("chain %d already added", chainId)
+	}
+	addr, privKey, err := eth.GetAddrPrivKeyFromKeystore(txConfig.Keyjson, txConfig.Passphrase)
+	if err != nil {
+		return err
+	}
+	if addr != c.EthAddress {
+		return fmt.Errorf("keystore address %x does not match OSP address %x", addr, c.EthAddress)
+	}
+	signer, err := txmgr.NewKeySigner(privKey, new(big.Int).SetUint64(chainId))
+	if err != nil {
+		return err
+	}
+
+	ch := &chainContext{chainId: chainId}
+	err = ch.setup(c, &profile, signer)
+	if err != nil {
+		ch.close()
+		return fmt.Errorf("setup chain %d err: %w", chainId, err)
+	}
+
+	c.chainsLock.Lock()
+	if c.chains == nil {
+		c.chains = make(map[uint64]*chainContext)
+		c.chainOfCids = make(map[ctype.CidType]uint64)
+	}
+	c.chains[chainId] = ch
+	c.chainsLock.Unlock()
+	c.messager.SetChainSelector(&chainSelector{c})
+	log.Infoln("Added chain", chainId, "ledger", profile.LedgerAddr)
+	return nil
+}
+
+func (ch *chainContext) setup(c *CNode, profile *common.CProfile, signer eth.Signer) error {
+	var err error
+	ch.ethRPCClient, err = dialEth(profile)
+	if err != nil {
+		return err
+	}
+	ch.ethclient = ethclient.NewClient(ch.ethRPCClient)
+
+	ch.nodeConfig = cobj.NewCelerGlobalNodeConfig(
+		c.EthAddress,
+		ch.ethclient,
+		profile,
+		wallet.CelerWalletABI,
+		ledger.CelerLedgerABI,
+		virtresolver.VirtContractResolverABI,
+		payresolver.PayResolverABI,
+		payregistry.PayRegistryABI,
+		routerregistry.RouterRegistryABI,
+		c.dal,
+	)
+	ch.nodeConfig.SetChainId(ch.chainId)
+	// pays are mapped to their chains by the pay resolver
+	payResolver := ch.nodeConfig.GetPayResolverContract().GetAddr()
+	if c.nodeConfig.GetPayResolverContract().GetAddr() == payResolver || c.chainOfPayResolver(payResolver) != nil {
+		return fmt.Errorf("pay resolver %x shared with another chain", payResolver)
+	}
+
+	ch.txManager = txmgr.NewTxManager(ch.chainId, ch.ethRPCClient)
+	ch.masterTransactor = ch.txManager.AddSender(c.EthAddress, signer)
+	err = ch.txManager.Start(c.dal, c.quit, c.listenOnChain)
+	if err != nil {
+		return err
+	}
+	ch.transactorPool, err = ch.txManager.NewTransactorPool([]*txmgr.Transactor{ch.masterTransactor})
+	if err != nil {
+		return err
+	}
+
+	polling := profile.PollingInterval
+	if polling == 0 {
+		polling = config.BlockIntervalSec
+	}
+	ch.watchClient = newPollClient(ch.ethclient)
+	ch.watch = watcher.NewWatchService(ch.watchClient, newChainWatchDAL(c.dal, ch.chainId), polling)
+	if ch.watch == nil {
+		return errors.New("newWatchService failed")
+	}
+	ch.blockDelay = profile.BlockDelayNum
+	monitorService := monitor.NewService(ch.watch, ch.blockDelay, true /*enabled*/)
+	monitorService.Init()
+	ch.reorgTracker = reorg.NewTracker(ch.chainId, monitorService, ch.ethRPCClient, c.dal)
+
+	ch.depositProcessor, err = deposit.StartProcessor(
+		ch.nodeConfig,
+		ch.masterTransactor,
+		c.dal,
+		ch.reorgTracker,
+		true, /*isOSP*/
+		true, /*listenOnChain*/
+		c.quit)
+	if err != nil {
+		return err
+	}
+	ch.disputer = dispute.NewProcessor(
+		ch.nodeConfig,
+		ch.masterTransactor,
+		ch.transactorPool,
+		nil, /*routeController*/
+		ch.reorgTracker,
+		c.dal,
+		true /*isOSP*/)
+	ch.openChannelProcessor, err = startOpenChannelProcessor(
+		ch.nodeConfig,
+		signer,
+		ch.masterTransactor,
+		c.dal,
+		c.connManager,
+		ch.reorgTracker,
+		nil, /*routeController*/
+		ch.depositProcessor,
+		true /*keepMonitor*/)
+	if err != nil {
+		return err
+	}
+
+	ch.reorgTracker.RegisterRollback(event.Deposit, func(eLog *types.Log) error {
+		return ch.depositProcessor.RollbackEvent(eLog)
+	})
+	ch.reorgTracker.RegisterRollback(event.IntendSettle, func(eLog *types.Log) error {
+		return ch.disputer.RollbackIntendSettleEvent(eLog)
+	})
+	go ch.reorgTracker.Start(c.quit)
+
+	ch.services = &messager.ChainServices{
+		ChainId:        ch.chainId,
+		NodeConfig:     ch.nodeConfig,
+		MonitorService: ch.reorgTracker,
+		Disputer:       ch.disputer,
+	}
+	return nil
+}
+
+func (ch *chainContext) close() {
+	if ch.reorgTracker != nil {
+		ch.reorgTracker.Close()
+	}
+	if ch.watch != nil {
+		ch.watch.Close()
+	}
+	if ch.ethclient != nil {
+		ch.ethclient.Close()
+	}
+}
+
+func (c *CNode) closeChains() {
+	c.chainsLock.RLock()
+	defer c.chainsLock.RUnlock()
+	for _, ch := range c.chains {
+		ch.close()
+	}
+}
+
+// isPrimaryChain checks the chain id sent by peers, where 0 stands for the primary chain
+func (c *CNode) isPrimaryChain(chainId uint64) bool {
+	return chainId == 0 || chainId == config.ChainId.Uint64()
+}
+
+func (c *CNode) getChain(chainId uint64) *chainContext {
+	c.chainsLock.RLock()
+	defer c.chainsLock.RUnlock()
+	return c.chains[chainId]
+}
+
+func (c *CNode) openChannelProcessorOf(chainId uint64) (*openChannelProcessor, error) {
+	if c.isPrimaryChain(chainId) {
+		return c.openChannelProcessor, nil
+	}
+	ch := c.getChain(chainId)
+	if ch == nil {
+		return nil, fmt.Errorf("chain %d not supported", chainId)
+	}
+	return ch.openChannelProcessor, nil
+}
+
+// chainOfCid returns the extra chain the channel is on, or nil for the primary chain
+func (c *CNode) chainOfCid(cid ctype.CidType) *chainContext {
+	c.chainsLock.RLock()
+	if len(c.chains) == 0 {
+		c.chainsLock.RUnlock()
+		return nil
+	}
+	chainId, cached := c.chainOfCids[cid]
+	c.chainsLock.RUnlock()
+	if !cached {
+		var found bool
+		var err error
+		chainId, found, err = c.dal.GetChanChainId(cid)
+		if err != nil {
+			log.Errorln("GetChanChainId err:", err, cid.Hex())
+			return nil
+		}
+		if !found {
+			return nil
+		}
+		c.chainsLock.Lock()
+		c.chainOfCids[cid] = chainId
+		c.chainsLock.Unlock()
+	}
+	if chainId == 0 {
+		return nil
+	}
+	return c.getChain(chainId)
+}
+
+func (c *CNode) chainOfPayResolver(resolver ctype.Addr) *chainContext {
+	c.chainsLock.RLock()
+	defer c.chainsLock.RUnlock()
+	for _, ch := range c.chains {
+		if ch.nodeConfig.GetPayResolverContract().GetAddr() == resolver {
+			return ch
+		}
+	}
+	return nil
+}
+
+// chainOfMonitorEvent returns the extra chain of a monitor table entry, or nil for the primary chain
+func (c *CNode) chainOfMonitorEvent(eventKey string) *chainContext {
+	c.chainsLock.RLock()
+	defer c.chainsLock.RUnlock()
+	for chainId, ch := range c.chains {
+		if strings.HasPrefix(eventKey, chainMonitorPrefix(chainId)) {
+			return ch
+		}
+	}
+	return nil
+}
+
+func (c *CNode) disputerOf(cid ctype.CidType) *dispute.Processor {
+	if ch := c.chainOfCid(cid); ch != nil {
+		return ch.disputer
+	}
+	return c.Disputer
+}
+
+func (c *CNode) nodeConfigOf(cid ctype.CidType) common.GlobalNodeConfig {
+	if ch := c.chainOfCid(cid); ch != nil {
+		return ch.nodeConfig
+	}
+	return c.nodeConfig
+}
+
+// blockNumberOf returns the current block number of the chain the channel is on
+func (c *CNode) blockNumberOf(cid ctype.CidType) uint64 {
+	if ch := c.chainOfCid(cid); ch != nil {
+		return ch.reorgTracker.GetCurrentBlockNumber().Uint64()
+	}
+	return c.GetCurrentBlockNumber().Uint64()
+}
+
+// blockNumberOfChain returns the current block number of a chain, or of the primary chain if unknown
+func (c *CNode) blockNumberOfChain(chainId uint64) uint64 {
+	if !c.isPrimaryChain(chainId) {
+		if ch := c.getChain(chainId); ch != nil {
+			return ch.reorgTracker.GetCurrentBlockNumber().Uint64()
+		}
+	}
+	return c.GetCurrentBlockNumber().Uint64()
+}
+
+// chainSelector implements messager.ChainSelector
+type chainSelector struct {
+	c *CNode
+}
+
+func (s *chainSelector) ChainOfCid(cid ctype.CidType) *messager.ChainServices {
+	if ch := s.c.chainOfCid(cid); ch != nil {
+		return ch.services
+	}
+	return nil
+}
+
+func (s *chainSelector) ChainOfPayResolver(resolver ctype.Addr) *messager.ChainServices {
+	if ch := s.c.chainOfPayResolver(resolver); ch != nil {
+		return ch.services
+	}
+	return nil
+}
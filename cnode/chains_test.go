@@ -0,0 +1,124 @@
+// Copyright 2020 Celer Network
+
+package cnode
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/celer-network/goCeler/chain/channel-eth-go/payresolver"
+	"github.com/celer-network/goCeler/common"
+	"github.com/celer-network/goCeler/common/cobj"
+	"github.com/celer-network/goCeler/common/structs"
+	"github.com/celer-network/goCeler/config"
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/dispute"
+	"github.com/celer-network/goCeler/rpc"
+	"github.com/celer-network/goCeler/storage"
+	"github.com/celer-network/goCeler/utils"
+)
+
+func newTestChain(chainId uint64, payResolver string) *chainContext {
+	profile := &common.CProfile{PayResolverAddr: payResolver}
+	return &chainContext{
+		chainId: chainId,
+		nodeConfig: cobj.NewCelerGlobalNodeConfig(
+			ctype.ZeroAddr, nil, profile, "", "", "", payresolver.PayResolverABI, "", "", nil),
+		disputer:             new(dispute.Processor),
+		openChannelProcessor: new(openChannelProcessor),
+	}
+}
+
+func TestChainSelection(t *testing.T) {
+	stFile := filepath.Join(os.TempDir(), "cnode_chains_test.db")
+	os.Remove(stFile)
+	st, err := storage.NewKVStoreSQL("sqlite3", stFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(stFile)
+	defer st.Close()
+
+	config.ChainId = big.NewInt(883)
+	c := &CNode{
+		dal:                  storage.NewDAL(st),
+		Disputer:             new(dispute.Processor),
+		openChannelProcessor: new(openChannelProcessor),
+	}
+	peer := ctype.Hex2Addr("bcd123")
+	token := utils.GetTokenInfoFromAddress(ctype.ZeroAddr)
+	primaryCid := ctype.Hex2Cid("abcdef")
+	extraCid := ctype.Hex2Cid("abcdf0")
+	for chainId, cid := range map[uint64]ctype.CidType{0: primaryCid, 5: extraCid} {
+		err = c.dal.InsertChanOnChain(chainId, cid, peer, token, ctype.ZeroAddr, structs.ChanState_OPENED,
+			nil, &structs.OnChainBalance{}, 0, 0, 0, 0, &rpc.SignedSimplexState{}, &rpc.SignedSimplexState{})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// without extra chains everything is on the primary chain
+	if c.chainOfCid(extraCid) != nil {
+		t.Error("channel selected an extra chain before any is added")
+	}
+
+	extra := newTestChain(5, "0x5555555555555555555555555555555555555555")
+	c.chains = map[uint64]*chainContext{5: extra}
+	c.chainOfCids = make(map[ctype.CidType]uint64)
+
+	for _, chainId := range []uint64{0, 883} {
+		if !c.isPrimaryChain(chainId) {
+			t.Errorf("chain %d should be the primary chain", chainId)
+		}
+	}
+	if c.isPrimaryChain(5) {
+		t.Error("chain 5 should not be the primary chain")
+	}
+
+	if ch := c.chainOfCid(primaryCid); ch != nil {
+		t.Errorf("primary channel on chain %d", ch.chainId)
+	}
+	if ch := c.chainOfCid(extraCid); ch != extra {
+		t.Errorf("wrong chain of extra channel: %v", ch)
+	}
+	if c.chainOfCids[extraCid] != 5 {
+		t.Errorf("chain of extra channel not cached: %v", c.chainOfCids)
+	}
+	if c.chainOfCid(ctype.Hex2Cid("123456")) != nil {
+		t.Error("unknown channel selected an extra chain")
+	}
+	if c.disputerOf(primaryCid) != c.Disputer || c.disputerOf(extraCid) != extra.disputer {
+		t.Error("wrong disputer selected")
+	}
+	if c.nodeConfigOf(extraCid) != extra.nodeConfig {
+		t.Error("wrong node config selected")
+	}
+
+	p, err := c.openChannelProcessorOf(0)
+	if err != nil || p != c.openChannelProcessor {
+		t.Errorf("wrong open channel processor of primary chain: %v", err)
+	}
+	p, err = c.openChannelProcessorOf(5)
+	if err != nil || p != extra.openChannelProcessor {
+		t.Errorf("wrong open channel processor of chain 5: %v", err)
+	}
+	_, err = c.openChannelProcessorOf(6)
+	if err == nil {
+		t.Error("open channel processor of unsupported chain 6")
+	}
+
+	if c.chainOfPayResolver(ctype.Hex2Addr("0x5555555555555555555555555555555555555555")) != extra {
+		t.Error("wrong chain of pay resolver")
+	}
+	if c.chainOfPayResolver(ctype.Hex2Addr("0x6666666666666666666666666666666666666666")) != nil {
+		t.Error("unknown pay resolver selected an extra chain")
+	}
+	if c.chainOfMonitorEvent(chainMonitorPrefix(5)+"Deposit") != extra {
+		t.Error("wrong chain of monitor event")
+	}
+	if c.chainOfMonitorEvent("Deposit") != nil {
+		t.Error("primary monitor event selected an extra chain")
+	}
+}
@@ -36,9 +36,11 @@ import (
 	"github.com/celer-network/goCeler/lrucache"
 	"github.com/celer-network/goCeler/messager"
 	"github.com/celer-network/goCeler/migrate"
+	"github.com/celer-network/goCeler/reorg"
 	"github.com/celer-network/goCeler/route"
 	"github.com/celer-network/goCeler/rpc"
 	"github.com/celer-network/goCeler/storage"
+	"github.com/celer-network/goCeler/txmgr"
 	"github.com/celer-network/goCeler/utils"
 	"github.com/celer-network/goutils/eth"
 	"github.com/celer-network/goutils/eth/monitor"
@@ -56,18 +58,20 @@ const mutexLocked = 1 << iota
 var dropMsg = flag.Bool("dropmsg", false, "add grpc interceptor to test drop msg, only use for tests.")
 
 type CNode struct {
-	transactorPool     *eth.TransactorPool
+	transactorPool     *txmgr.TransactorPool
 	nodeConfig         common.GlobalNodeConfig
 	streamWriter       common.StreamWriter
 	celerMsgDispatcher *dispatchers.CelerMsgDispatcher
 	monitorService     intfs.MonitorService
+	reorgTracker       *reorg.Tracker
 	messager           *messager.Messager
 
 	EthAddress        ctype.Addr // ETH address of the node
 	signer            eth.Signer
 	externalSigner    bool // if the signer is external
-	masterTransactor  *eth.Transactor
-	depositTransactor *eth.Transactor
+	txManager         *txmgr.TxManager
+	masterTransactor  *txmgr.Transactor
+	depositTransactor *txmgr.Transactor
 
 	connManager *rpc.ConnectionManager
 
@@ -78,9 +82,11 @@ type CNode struct {
 	ServerAddr ctype.Addr
 
 	ethclient                    *ethclient.Client
+	ethRPCClient                 *ethrpc.Client
 	kvstore                      storage.KVStore
 	dal                          *storage.DAL
 	watch                        *watcher.WatchService
+	watchClient                  *pollClient
 	openChannelProcessor         *openChannelProcessor
 	cooperativeWithdrawProcessor *cooperativewithdraw.Processor
 	depositProcessor             *deposit.Processor
@@ -108,6 +114,26 @@ type CNode struct {
 
 	sgnGw   string
 	sgnAddr ctype.Addr
+
+	// Extra chains of a multi-chain OSP, see AddChain.
+	chains      map[uint64]*chainContext
+	chainOfCids map[ctype.CidType]uint64 // cache of the chain ids of channels
+	chainsLock  sync.RWMutex
+
+	tokenDecimals map[ctype.Addr]uint8 // cache of token decimals, only used by the liquidity collector
+
+	rebalanceLock sync.Mutex // serializes rebalances, see Rebalance
+
+	// Graceful shutdown state, see Drain.
+	drainState  int32
+	drainReport *DrainReport
+	drainLock   sync.Mutex
+
+	// Client background job to clear pending pays, see StartPayClearer.
+	payClearer     *payClearer
+	payClearerLock sync.Mutex
+	// Serializes the intend settle of pay clearer and client exit jobs, see IntendSettleOnce.
+	intendSettleLock sync.Mutex
 }
 
 func (c *CNode) GetConnManager() *rpc.ConnectionManager {
@@ -216,6 +242,9 @@ func (c *CNode) RegisterStreamErrCallback(peerAddr ctype.Addr, callback rpc.ErrC
 // AddCelerStream is called on server side after authReq passed
 // add the stream to connection manager.
 func (c *CNode) AddCelerStream(celerMsg *rpc.CelerMsg, stream rpc.CelerStream) (context.Context, error) {
+	if c.isDrained() {
+		return nil, common.ErrDraining
+	}
 	authReq := celerMsg.GetAuthReq()
 	src := authReq.GetMyAddr()
 	msgChan := c.celerMsgDispatcher.NewStream(ctype.Bytes2Addr(src))
@@ -259,7 +288,7 @@ func (c *CNode) streamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc
 //------------------------------main logic--------------------------------
 
 func (c *CNode) IntendSettlePaymentChannel(cid ctype.CidType) error {
-	if err := c.Disputer.IntendSettlePaymentChannel(cid, true); err != nil {
+	if err := c.disputerOf(cid).IntendSettlePaymentChannel(cid, true); err != nil {
 		log.Error(err)
 		return err
 	}
@@ -267,7 +296,7 @@ func (c *CNode) IntendSettlePaymentChannel(cid ctype.CidType) error {
 }
 
 func (c *CNode) ConfirmSettlePaymentChannel(cid ctype.CidType) error {
-	if err := c.Disputer.ConfirmSettlePaymentChannel(cid, true); err != nil {
+	if err := c.disputerOf(cid).ConfirmSettlePaymentChannel(cid, true); err != nil {
 		log.Error(err)
 		return err
 	}
@@ -275,7 +304,7 @@ func (c *CNode) ConfirmSettlePaymentChannel(cid ctype.CidType) error {
 }
 
 func (c *CNode) GetSettleFinalizedTime(cid ctype.CidType) (*big.Int, error) {
-	return ledgerview.GetOnChainSettleFinalizedTime(cid, c.nodeConfig)
+	return ledgerview.GetOnChainSettleFinalizedTime(cid, c.nodeConfigOf(cid))
 }
 
 func NewCNode(
@@ -298,6 +327,20 @@ func NewCNodeWithExternalSigner(
 	return newCNode(nil, nil, nil, address, signer, true, profile, route.GateWayPolicy, nil)
 }
 
+// NewOSPWithExternalSigner is used by OSP whose key is held by an external signer,
+// e.g., a remote signer daemon. transactorConfigs are optional local keys that only
+// send on-chain transactions, the external signer is used if none is specified.
+func NewOSPWithExternalSigner(
+	address ctype.Addr,
+	signer eth.Signer,
+	transactorConfigs []*eth.TransactorConfig,
+	profile common.CProfile,
+	routingPolicy route.RoutingPolicy,
+	routingData []byte) (*CNode, error) {
+	return newCNode(
+		nil, nil, transactorConfigs, address, signer, true, profile, routingPolicy, routingData)
+}
+
 func newCNode(
 	masterTxConfig *eth.TransactorConfig,
 	depositTxConfig *eth.TransactorConfig,
@@ -321,7 +364,7 @@ func newCNode(
 	}
 
 	if externalSigner {
-		err = c.setupExternalTransactor(address, signer)
+		err = c.setupExternalTransactor(address, signer, transactorConfigs)
 		if err != nil {
 			log.Errorln("cNode setupExternalTransactor error:", err)
 			return nil, err
@@ -344,6 +387,18 @@ func newCNode(
 }
 
 func (c *CNode) setupEthClient(profile *common.CProfile) error {
+	rpcClient, err := dialEth(profile)
+	if err != nil {
+		c.Close()
+		return err
+	}
+	c.ethclient = ethclient.NewClient(rpcClient)
+	c.ethRPCClient = rpcClient
+	return nil
+}
+
+// dialEth creates an RPC connection to the ETH instance of the profile
+func dialEth(profile *common.CProfile) (*ethrpc.Client, error) {
 	// initialize on-chain transactor
 	// create an IPC based RPC connection to a remote node and an authorized transactor
 	ethCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -365,8 +420,7 @@ func (c *CNode) setupEthClient(profile *common.CProfile) error {
 			rpcClient, err = ethrpc.DialWebsocket(ethCtx, ethInstance, wsOrigin)
 			if err != nil {
 				log.Errorln("Dial ETHInstance WS failed.")
-				c.Close()
-				return fmt.Errorf("DialETH failed: %w", err)
+				return nil, fmt.Errorf("DialETH failed: %w", err)
 			}
 		}
 	} else {
@@ -377,13 +431,11 @@ func (c *CNode) setupEthClient(profile *common.CProfile) error {
 			rpcClient, err = ethrpc.Dial(ethInstance)
 			if err != nil {
 				log.Errorln("Dial ETHInstance HTTP failed.")
-				c.Close()
-				return fmt.Errorf("DialETH failed: %w", err)
+				return nil, fmt.Errorf("DialETH failed: %w", err)
 			}
 		}
 	}
-	c.ethclient = ethclient.NewClient(rpcClient)
-	return nil
+	return rpcClient, nil
 }
 
 func (c *CNode) setupTransactor(
@@ -401,34 +453,16 @@ func (c *CNode) setupTransactor(
 		c.Close()
 		return err
 	}
-	c.signer, err = eth.NewSigner(privKey, config.ChainId)
+	c.signer, err = txmgr.NewKeySigner(privKey, config.ChainId)
 	if err != nil {
 		c.Close()
 		return err
 	}
 
-	// Create transactor pool. If the list of transactor keys isn't specified, use the signing key
-	// as the sole transactor.
-	if len(transactorConfigs) == 0 {
-		transactorConfigs = []*eth.TransactorConfig{
-			eth.NewTransactorConfig(masterTxConfig.Keyjson, masterTxConfig.Passphrase),
-		}
-	}
-
-	c.transactorPool, err = eth.NewTransactorPoolFromConfig(c.ethclient, transactorConfigs, config.ChainId)
-	if err != nil {
-		c.Close()
-		return err
-	}
-	c.masterTransactor, err =
-		eth.NewTransactor(masterTxConfig.Keyjson, masterTxConfig.Passphrase, c.ethclient, config.ChainId)
-	if err != nil {
-		c.Close()
-		return err
-	}
+	c.txManager = txmgr.NewTxManager(0 /*chainId*/, c.ethRPCClient)
+	c.masterTransactor = c.txManager.AddSender(c.EthAddress, c.signer)
 	if depositTxConfig != nil {
-		c.depositTransactor, err =
-			eth.NewTransactor(depositTxConfig.Keyjson, depositTxConfig.Passphrase, c.ethclient, config.ChainId)
+		c.depositTransactor, err = c.txManager.AddKeystoreSender(depositTxConfig)
 		if err != nil {
 			c.Close()
 			return err
@@ -436,17 +470,24 @@ func (c *CNode) setupTransactor(
 	} else {
 		c.depositTransactor = c.masterTransactor
 	}
-
+	// Create transactor pool. If the list of transactor keys isn't specified, use the signing key
+	// as the sole transactor.
+	err = c.setupTransactorPool(transactorConfigs)
+	if err != nil {
+		c.Close()
+		return err
+	}
 	return nil
 }
 
-func (c *CNode) setupExternalTransactor(address ctype.Addr, signer eth.Signer) error {
+func (c *CNode) setupExternalTransactor(
+	address ctype.Addr, signer eth.Signer, transactorConfigs []*eth.TransactorConfig) error {
 	c.EthAddress = address
 	c.signer = signer
-	c.masterTransactor = eth.NewTransactorByExternalSigner(address, signer, c.ethclient)
+	c.txManager = txmgr.NewTxManager(0 /*chainId*/, c.ethRPCClient)
+	c.masterTransactor = c.txManager.AddSender(address, signer)
 	c.depositTransactor = c.masterTransactor
-	var err error
-	c.transactorPool, err = eth.NewTransactorPool([]*eth.Transactor{c.masterTransactor})
+	err := c.setupTransactorPool(transactorConfigs)
 	if err != nil {
 		c.Close()
 		return err
@@ -454,6 +495,23 @@ func (c *CNode) setupExternalTransactor(address ctype.Addr, signer eth.Signer) e
 	return nil
 }
 
+func (c *CNode) setupTransactorPool(transactorConfigs []*eth.TransactorConfig) error {
+	transactors := []*txmgr.Transactor{c.masterTransactor}
+	if len(transactorConfigs) > 0 {
+		transactors = nil
+		for _, txConfig := range transactorConfigs {
+			transactor, err := c.txManager.AddKeystoreSender(txConfig)
+			if err != nil {
+				return err
+			}
+			transactors = append(transactors, transactor)
+		}
+	}
+	var err error
+	c.transactorPool, err = c.txManager.NewTransactorPool(transactors)
+	return err
+}
+
 func (c *CNode) initialize(
 	profile *common.CProfile,
 	routingPolicy route.RoutingPolicy,
@@ -475,6 +533,12 @@ func (c *CNode) initialize(
 		c.Close()
 		return err
 	}
+	// Recover in-flight txs and start rebroadcasting lingering ones.
+	err = c.txManager.Start(c.dal, c.quit, c.listenOnChain)
+	if err != nil {
+		c.Close()
+		return err
+	}
 
 	c.ServerAddr = ctype.Hex2Addr(profile.SvrETHAddr)
 
@@ -487,7 +551,8 @@ func (c *CNode) initialize(
 	c.connManager = rpc.NewConnectionManager(regClient)
 
 	// Initialize the watcher service.
-	c.watch = watcher.NewWatchService(c.ethclient, c.dal, config.BlockIntervalSec)
+	c.watchClient = newPollClient(c.ethclient)
+	c.watch = watcher.NewWatchService(c.watchClient, c.dal, config.BlockIntervalSec)
 	if c.watch == nil {
 		log.Error("Cannot setup watch service")
 		c.Close()
@@ -521,7 +586,10 @@ func (c *CNode) initialize(
 	// Init monitor service
 	monitorService := monitor.NewService(c.watch, config.BlockDelay, !c.isOSP || c.listenOnChain)
 	monitorService.Init()
-	c.monitorService = monitorService
+	// Track processed event logs to roll back state derived from logs removed by chain reorgs.
+	c.reorgTracker = reorg.NewTracker(0 /*chainId*/, monitorService, c.ethRPCClient, c.dal)
+	c.registerReorgRollbacks()
+	c.monitorService = c.reorgTracker
 	c.streamWriter = cobj.NewCelerStreamWriter(c.connManager)
 
 	c.cooperativeWithdrawProcessor, err = cooperativewithdraw.StartProcessor(
@@ -646,8 +714,19 @@ func (c *CNode) initialize(
 		c.monitorService,
 		c.isOSP)
 
+	// Start checking for reorgs after all processors with rollbacks are set up.
+	if !c.isOSP || c.listenOnChain {
+		go c.reorgTracker.Start(c.quit)
+		// pay traces in the shared db are pruned by one server of the osp
+		go c.runPayTracePruner()
+	}
 	if c.isOSP {
 		go c.runOspRoutineJob()
+		go c.runLiquidityCollector()
+		if c.routeController != nil {
+			// peer osp channels are rebalanced by the server running the route controller
+			go c.runRebalancer()
+		}
 	}
 
 	c.sgnGw = profile.SgnGateway
@@ -680,7 +759,7 @@ func (c *CNode) SetDelegation(tokens []ctype.Addr, timeout int64) error {
 	if err != nil {
 		return err
 	}
-	sig, err := c.signer.SignEthMessage(descBytes)
+	sig, err := utils.SignEthMessageOfKind(c.signer, intfs.SignKindDelegation, descBytes)
 	if err != nil {
 		return err
 	}
@@ -760,6 +839,7 @@ func (c *CNode) Close() {
 		// note this should be only close(ws.quit) cause individual watches should have already Close
 		c.watch.Close()
 	}
+	c.closeChains()
 	if c.connManager != nil {
 		c.connManager.CloseNoRetry(c.ServerAddr)
 	}
@@ -6,6 +6,7 @@ import (
 	"errors"
 
 	"github.com/celer-network/goCeler/common"
+	"github.com/celer-network/goCeler/common/intfs"
 	"github.com/celer-network/goCeler/ctype"
 	"github.com/celer-network/goCeler/rpc"
 	"github.com/celer-network/goCeler/utils"
@@ -40,7 +41,7 @@ func (p *Processor) sendResponse(
 		return errors.New("Invalid CooperativeWithdrawRequest signature")
 	}
 
-	approverSig, err := p.signer.SignEthMessage(serializedInfo)
+	approverSig, err := utils.SignEthMessageOfKind(p.signer, intfs.SignKindWithdrawInfo, serializedInfo)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"math/big"
 	"sync"
 
+	"github.com/celer-network/goCeler/accounting"
 	"github.com/celer-network/goCeler/chain"
 	"github.com/celer-network/goCeler/chain/channel-eth-go/ledger"
 	"github.com/celer-network/goCeler/common"
@@ -23,6 +24,7 @@ import (
 	"github.com/celer-network/goCeler/metrics"
 	"github.com/celer-network/goCeler/rpc"
 	"github.com/celer-network/goCeler/storage"
+	"github.com/celer-network/goCeler/utils"
 	"github.com/celer-network/goutils/eth"
 	"github.com/celer-network/goutils/eth/monitor"
 	"github.com/celer-network/goutils/log"
@@ -34,7 +36,7 @@ type Processor struct {
 	nodeConfig        common.GlobalNodeConfig
 	selfAddress       ctype.Addr
 	signer            eth.Signer
-	transactorPool    *eth.TransactorPool
+	transactorPool    intfs.TransactorPool
 	connectionManager *rpc.ConnectionManager
 	monitorService    intfs.MonitorService
 	dal               *storage.DAL
@@ -51,7 +53,7 @@ func StartProcessor(
 	nodeConfig common.GlobalNodeConfig,
 	selfAddress ctype.Addr,
 	signer eth.Signer,
-	transactorPool *eth.TransactorPool,
+	transactorPool intfs.TransactorPool,
 	connectionManager *rpc.ConnectionManager,
 	monitorService intfs.MonitorService,
 	dal *storage.DAL,
@@ -119,8 +121,7 @@ func (p *Processor) maybeHandleEvent(eLog *types.Log) bool {
 	if receiver != self && receiver != peer {
 		return false
 	}
-	txHash := eLog.TxHash.String()
-	log.Debugln("CooperativeWithdraw event txHash", txHash)
+	log.Debugln("CooperativeWithdraw event txHash", eLog.TxHash.String())
 	log.Infoln("Caught new CooperativeWithdraw from channel ID", cid.Hex())
 	metrics.IncCoopWithdrawEventCnt()
 	p.updateOnChainBalance(
@@ -128,10 +129,31 @@ func (p *Processor) maybeHandleEvent(eLog *types.Log) bool {
 		self,
 		peer,
 		e,
-		txHash)
+		eLog)
 	return true
 }
 
+// RollbackEvent reverts the on-chain balance updated by a CooperativeWithdraw event
+// that was removed by a chain reorg
+func (p *Processor) RollbackEvent(eLog *types.Log) error {
+	e := &ledger.CelerLedgerCooperativeWithdraw{}
+	err := p.nodeConfig.GetLedgerContract().ParseEvent(event.CooperativeWithdraw, *eLog, e)
+	if err != nil {
+		return err
+	}
+	cid := ctype.CidType(e.ChannelId)
+	_, found, err := p.dal.GetChanPeer(cid)
+	if err != nil || !found {
+		return err
+	}
+	log.Warnln("CooperativeWithdraw reverted by chain reorg, resync onchain balance of", cid.Hex())
+	err = ledgerview.SyncOnChainBalance(p.dal, cid, p.nodeConfig)
+	if err != nil {
+		return err
+	}
+	return p.dal.DeleteAcctRecord(accounting.WithdrawEntryID(eLog.TxHash, eLog.Index))
+}
+
 func (p *Processor) monitorOnAllLedgers() {
 	ledgers := p.nodeConfig.GetAllLedgerContracts()
 
@@ -186,7 +208,7 @@ func (p *Processor) updateOnChainBalance(
 	self ctype.Addr,
 	peer ctype.Addr,
 	e *ledger.CelerLedgerCooperativeWithdraw,
-	txHash string) {
+	eLog *types.Log) {
 	if len(e.Deposits) != 2 || len(e.Withdrawals) != 2 {
 		log.Error("on chain balances length not match")
 		return
@@ -204,7 +226,21 @@ func (p *Processor) updateOnChainBalance(
 		PeerWithdrawal: e.Withdrawals[1-myIndex],
 		// overwrite pendingWithrawal with empty struct on withdraw event
 	}
-	if err := p.dal.UpdateOnChainBalance(cid, onChainBalance); err != nil {
+	updateBalanceTx := func(tx *storage.DALTx, args ...interface{}) error {
+		balance, found, err := tx.GetOnChainBalance(cid)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return common.ErrChannelNotFound
+		}
+		err = tx.UpdateOnChainBalance(cid, onChainBalance)
+		if err != nil {
+			return err
+		}
+		return recordWithdrawal(tx, cid, balance.MyWithdrawal, onChainBalance.MyWithdrawal, eLog)
+	}
+	if err := p.dal.Transactional(updateBalanceTx); err != nil {
 		log.Error(err)
 	}
 
@@ -241,6 +277,19 @@ func (p *Processor) updateOnChainBalance(
 	p.dispatchJob(job)
 }
 
+// recordWithdrawal adds my withdrawal in the withdraw event to the accounting ledger
+func recordWithdrawal(tx *storage.DALTx, cid ctype.CidType, prev, curr *big.Int, eLog *types.Log) error {
+	amt := new(big.Int).Sub(curr, prev)
+	if amt.Sign() <= 0 {
+		return nil
+	}
+	_, token, _, err := tx.GetChanStateToken(cid)
+	if err != nil {
+		return err
+	}
+	return tx.InsertAcctRecord(accounting.WithdrawRecord(eLog.TxHash, eLog.Index, cid, utils.GetTokenAddr(token), amt))
+}
+
 func (p *Processor) checkWithdrawBalanceTx(tx *storage.DALTx, args ...interface{}) error {
 	cid := args[0].(ctype.CidType)
 	withdrawInfo := args[1].(*entity.CooperativeWithdrawInfo)
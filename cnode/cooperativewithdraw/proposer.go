@@ -11,6 +11,7 @@ import (
 	"github.com/celer-network/goCeler/chain/channel-eth-go/ledger"
 	"github.com/celer-network/goCeler/common"
 	"github.com/celer-network/goCeler/common/event"
+	"github.com/celer-network/goCeler/common/intfs"
 	"github.com/celer-network/goCeler/common/structs"
 	"github.com/celer-network/goCeler/config"
 	"github.com/celer-network/goCeler/ctype"
@@ -111,7 +112,7 @@ func (p *Processor) prepareJob(cid ctype.CidType, amount *big.Int) (*structs.Coo
 	if err != nil {
 		return nil, fmt.Errorf("proto Marshal err: %w", err)
 	}
-	requesterSig, err := p.signer.SignEthMessage(serializedInfo)
+	requesterSig, err := utils.SignEthMessageOfKind(p.signer, intfs.SignKindWithdrawInfo, serializedInfo)
 	if err != nil {
 		return nil, fmt.Errorf("Sign err: %w", err)
 	}
@@ -0,0 +1,120 @@
+// Copyright 2020 Celer Network
+
+package cnode
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	enums "github.com/celer-network/goCeler/common/structs"
+	"github.com/celer-network/goCeler/config"
+	"github.com/celer-network/goutils/log"
+)
+
+const (
+	drainStateNone     int32 = 0
+	drainStateDraining int32 = 1 // new pays rejected, waiting for outstanding work
+	drainStateDrained  int32 = 2 // streams closed, new streams rejected
+)
+
+// Egress pay states waiting for the peer to co-sign, a PaymentSettleRequest or
+// CondPayRequest sent but not yet responded. Only pays to the peers whose streams are
+// held by this node are waited for, other servers sharing the storage serve the rest.
+var drainPendingPayStates = []int{
+	enums.PayState_ONESIG_PENDING,
+	enums.PayState_SECRET_REVEALED,
+	enums.PayState_ONESIG_PAID,
+	enums.PayState_ONESIG_CANCELED,
+}
+
+// DrainReport describes the outstanding work left when a drain finished
+type DrainReport struct {
+	Completed     bool          `json:"completed"` // no outstanding work left before the timeout
+	Elapsed       time.Duration `json:"elapsed"`
+	PendingPays   int           `json:"pendingPays"`   // pays waiting for the peer to co-sign
+	UnackedMsgs   int           `json:"unackedMsgs"`   // msg queue messages not ACKed by the peers
+	UnackedCids   int           `json:"unackedCids"`   // channels with unacked messages
+	ClosedStreams int           `json:"closedStreams"` // celer streams closed at the end of the drain
+}
+
+func (r *DrainReport) String() string {
+	return fmt.Sprintf("completed %t, elapsed %s, pending pays %d, unacked msgs %d in %d channels, closed streams %d",
+		r.Completed, r.Elapsed, r.PendingPays, r.UnackedMsgs, r.UnackedCids, r.ClosedStreams)
+}
+
+// Drain prepares the node for a graceful shutdown. It stops accepting new pays, waits up to
+// the timeout for the outstanding pay settlements and msg queue ACKs, then closes all streams.
+// Requests of other messages are still processed while draining so that pays in flight can
+// complete. Drain can only be done once, later calls return the report of the first one.
+func (c *CNode) Drain(timeout time.Duration) (*DrainReport, error) {
+	c.drainLock.Lock()
+	defer c.drainLock.Unlock()
+	if c.drainReport != nil {
+		return c.drainReport, nil
+	}
+	if c.dal == nil {
+		return nil, fmt.Errorf("node closed")
+	}
+
+	log.Infoln("start draining, timeout", timeout)
+	atomic.StoreInt32(&c.drainState, drainStateDraining)
+	c.celerMsgDispatcher.SetDraining(true)
+
+	start := time.Now()
+	report := &DrainReport{}
+	ticker := time.NewTicker(config.DrainPollInterval)
+	defer ticker.Stop()
+	for {
+		var err error
+		report.PendingPays, err = c.countDrainPendingPays()
+		if err != nil {
+			return nil, err
+		}
+		report.UnackedMsgs, report.UnackedCids = c.messager.GetMsgQueueUnacked()
+		if report.PendingPays == 0 && report.UnackedMsgs == 0 {
+			report.Completed = true
+			break
+		}
+		if time.Since(start) >= timeout {
+			break
+		}
+		log.Debugf("draining: %d pending pays, %d unacked msgs", report.PendingPays, report.UnackedMsgs)
+		<-ticker.C
+	}
+
+	atomic.StoreInt32(&c.drainState, drainStateDrained)
+	report.ClosedStreams = c.connManager.CloseAll()
+	report.Elapsed = time.Since(start)
+	c.drainReport = report
+	if report.Completed {
+		log.Infoln("drained:", report)
+	} else {
+		log.Warnln("drain timed out:", report)
+	}
+	return report, nil
+}
+
+// countDrainPendingPays counts the egress pays waiting for the peers connected to this node
+func (c *CNode) countDrainPendingPays() (int, error) {
+	counts, err := c.dal.CountPaymentsByOutStatesPerPeer(drainPendingPayStates)
+	if err != nil {
+		return 0, fmt.Errorf("CountPaymentsByOutStatesPerPeer err: %w", err)
+	}
+	pending := 0
+	for peer, count := range counts {
+		if c.connManager.HasCelerStream(peer) {
+			pending += count
+		}
+	}
+	return pending, nil
+}
+
+// IsDraining returns true if the node has started draining before shutdown
+func (c *CNode) IsDraining() bool {
+	return atomic.LoadInt32(&c.drainState) != drainStateNone
+}
+
+func (c *CNode) isDrained() bool {
+	return atomic.LoadInt32(&c.drainState) == drainStateDrained
+}
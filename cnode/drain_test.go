@@ -0,0 +1,117 @@
+// Copyright 2020 Celer Network
+
+package cnode
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/celer-network/goCeler/chain/channel-eth-go/payresolver"
+	"github.com/celer-network/goCeler/common"
+	"github.com/celer-network/goCeler/common/cobj"
+	"github.com/celer-network/goCeler/common/structs"
+	"github.com/celer-network/goCeler/config"
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/dispatchers"
+	"github.com/celer-network/goCeler/messager"
+	"github.com/celer-network/goCeler/rpc"
+	"github.com/celer-network/goCeler/storage"
+	"github.com/celer-network/goCeler/utils"
+)
+
+// testCelerStream blocks on Recv until closed
+type testCelerStream struct {
+	done chan bool
+}
+
+func (s *testCelerStream) Send(*rpc.CelerMsg) error {
+	return nil
+}
+
+func (s *testCelerStream) Recv() (*rpc.CelerMsg, error) {
+	<-s.done
+	return nil, os.ErrClosed
+}
+
+func newTestDrainNode(t *testing.T, dal *storage.DAL) *CNode {
+	nodeConfig := cobj.NewCelerGlobalNodeConfig(
+		ctype.ZeroAddr, nil, &common.CProfile{}, "", "", "", payresolver.PayResolverABI, "", "", nil)
+	return &CNode{
+		dal:                dal,
+		celerMsgDispatcher: new(dispatchers.CelerMsgDispatcher),
+		messager:           messager.NewMessager(nodeConfig, nil, nil, nil, nil, nil, nil, dal, true),
+		connManager:        rpc.NewConnectionManager(nil),
+	}
+}
+
+func TestDrain(t *testing.T) {
+	stFile := filepath.Join(os.TempDir(), "cnode_drain_test.db")
+	os.Remove(stFile)
+	st, err := storage.NewKVStoreSQL("sqlite3", stFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(stFile)
+	defer st.Close()
+	dal := storage.NewDAL(st)
+
+	// pending pays to a connected peer and to a peer served by another server
+	token := utils.GetTokenInfoFromAddress(ctype.ZeroAddr)
+	connected, other := ctype.Hex2Addr("abc1"), ctype.Hex2Addr("abc2")
+	connectedCid, otherCid := ctype.Hex2Cid("c1"), ctype.Hex2Cid("c2")
+	connectedPay, otherPay := ctype.Hex2PayID("a1"), ctype.Hex2PayID("a2")
+	for peer, cid := range map[ctype.Addr]ctype.CidType{connected: connectedCid, other: otherCid} {
+		err = dal.InsertChanOnChain(0, cid, peer, token, ctype.ZeroAddr, structs.ChanState_OPENED,
+			nil, &structs.OnChainBalance{}, 0, 0, 0, 0, &rpc.SignedSimplexState{}, &rpc.SignedSimplexState{})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = dal.InsertPayment(connectedPay, []byte{1}, nil, nil,
+		ctype.ZeroCid, structs.PayState_NULL, connectedCid, structs.PayState_ONESIG_PENDING)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = dal.InsertPayment(otherPay, []byte{2}, nil, nil,
+		ctype.ZeroCid, structs.PayState_NULL, otherCid, structs.PayState_SECRET_REVEALED)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stream := &testCelerStream{done: make(chan bool)}
+	defer close(stream.done)
+
+	// drain times out waiting for the pay to the connected peer
+	c := newTestDrainNode(t, dal)
+	c.connManager.AddCelerStream(connected, stream, make(chan *rpc.CelerMsg))
+	report, err := c.Drain(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Completed || report.PendingPays != 1 || report.ClosedStreams != 1 {
+		t.Errorf("wrong drain report: %s", report)
+	}
+	if !c.IsDraining() || !c.isDrained() {
+		t.Error("node not drained")
+	}
+	report2, _ := c.Drain(time.Second)
+	if report2 != report {
+		t.Error("second drain did not return the first report")
+	}
+
+	// drain completes once the pay to the connected peer is settled
+	c = newTestDrainNode(t, dal)
+	c.connManager.AddCelerStream(connected, stream, make(chan *rpc.CelerMsg))
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		dal.DeletePayment(connectedPay)
+	}()
+	report, err = c.Drain(5 * time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Completed || report.PendingPays != 0 || report.Elapsed < config.DrainPollInterval {
+		t.Errorf("wrong drain report: %s", report)
+	}
+}
@@ -0,0 +1,275 @@
+// Copyright 2020 Celer Network
+
+package cnode
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/celer-network/goCeler/config"
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/reorg"
+	"github.com/celer-network/goutils/eth/watcher"
+	"github.com/ethereum/go-ethereum"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	HealthOK   = "ok"   // component works as expected
+	HealthWarn = "warn" // component degraded, node still serves requests
+	HealthFail = "fail" // component broken, node should not serve requests
+)
+
+// HealthCheck is the result of checking one component
+type HealthCheck struct {
+	Status string      `json:"status"`
+	Error  string      `json:"error,omitempty"`
+	Detail interface{} `json:"detail,omitempty"`
+}
+
+// HealthReport is the result of checking a set of components,
+// its status is the worst status of all checks
+type HealthReport struct {
+	Status string                  `json:"status"`
+	Checks map[string]*HealthCheck `json:"checks"`
+}
+
+type chainHealth struct {
+	HeadBlock    uint64 `json:"headBlock"`
+	WatcherBlock uint64 `json:"watcherBlock"`
+	Lag          uint64 `json:"lag"`
+}
+
+type poolHealth struct {
+	Token     string `json:"token"`
+	Balance   string `json:"balance"`
+	Threshold string `json:"threshold"`
+}
+
+type peerOspHealth struct {
+	Peers        int      `json:"peers"`
+	Connected    int      `json:"connected"`
+	Disconnected []string `json:"disconnected,omitempty"`
+}
+
+// CheckLiveness checks the components whose failure can only be fixed by restarting the node.
+// Chain is only checked for readiness, a restart does not help an eth client outage.
+func (c *CNode) CheckLiveness() *HealthReport {
+	return newHealthReport(map[string]*HealthCheck{
+		"db": c.checkDB(),
+	})
+}
+
+// CheckReadiness checks all components needed for the node to serve requests
+func (c *CNode) CheckReadiness() *HealthReport {
+	checks := map[string]*HealthCheck{
+		"db":    c.checkDB(),
+		"chain": c.checkChain(),
+	}
+	if c.listenOnChain {
+		checks["monitor"] = c.checkMonitor()
+	}
+	if c.isOSP {
+		checks["depositPool"] = c.checkDepositPool()
+		checks["peerOsps"] = c.checkPeerOsps()
+	}
+	if c.IsDraining() {
+		// let load balancers stop routing new clients to the node
+		checks["drain"] = &HealthCheck{Status: HealthFail, Error: "node draining"}
+	}
+	return newHealthReport(checks)
+}
+
+func newHealthReport(checks map[string]*HealthCheck) *HealthReport {
+	report := &HealthReport{
+		Status: HealthOK,
+		Checks: checks,
+	}
+	for _, check := range checks {
+		if check.Status == HealthFail {
+			report.Status = HealthFail
+		} else if check.Status == HealthWarn && report.Status == HealthOK {
+			report.Status = HealthWarn
+		}
+	}
+	return report
+}
+
+func healthErr(status string, err error) *HealthCheck {
+	return &HealthCheck{Status: status, Error: err.Error()}
+}
+
+func (c *CNode) checkDB() *HealthCheck {
+	if c.kvstore == nil {
+		return &HealthCheck{Status: HealthFail, Error: "db not initialized"}
+	}
+	if err := c.kvstore.Ping(); err != nil {
+		return healthErr(HealthFail, err)
+	}
+	return &HealthCheck{Status: HealthOK}
+}
+
+func (c *CNode) checkChain() *HealthCheck {
+	if c.ethclient == nil || c.monitorService == nil {
+		return &HealthCheck{Status: HealthFail, Error: "eth client not initialized"}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), config.HealthCheckTimeout)
+	defer cancel()
+	head, err := c.ethclient.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return healthErr(HealthFail, fmt.Errorf("HeaderByNumber err: %w", err))
+	}
+	detail := &chainHealth{
+		HeadBlock:    head.Number.Uint64(),
+		WatcherBlock: c.monitorService.GetCurrentBlockNumber().Uint64(),
+	}
+	if detail.HeadBlock > detail.WatcherBlock {
+		detail.Lag = detail.HeadBlock - detail.WatcherBlock
+	}
+	check := &HealthCheck{Status: HealthOK, Detail: detail}
+	if detail.Lag > config.BlockDelay+config.HealthMaxBlockLag {
+		check.Status = HealthFail
+		check.Error = fmt.Sprintf("chain watcher lags %d blocks behind head", detail.Lag)
+	}
+	return check
+}
+
+// checkMonitor checks how far the log polling of each watched event lags behind the watcher head.
+// The lag is measured from the last block polled for the event logs, not from the last handled
+// event, so rarely emitted events are not reported as lagging.
+func (c *CNode) checkMonitor() *HealthCheck {
+	if c.reorgTracker == nil || c.watchClient == nil {
+		return &HealthCheck{Status: HealthFail, Error: "monitor not initialized"}
+	}
+	lags := make(map[string]uint64)
+	check := &HealthCheck{Status: HealthOK, Detail: lags}
+	checkEventLags(check, lags, "", c.reorgTracker, c.watchClient, config.BlockDelay)
+	c.chainsLock.RLock()
+	defer c.chainsLock.RUnlock()
+	for chainId, ch := range c.chains {
+		checkEventLags(check, lags, chainMonitorPrefix(chainId), ch.reorgTracker, ch.watchClient, ch.blockDelay)
+	}
+	return check
+}
+
+func checkEventLags(
+	check *HealthCheck, lags map[string]uint64, prefix string,
+	tracker *reorg.Tracker, client *pollClient, defaultDelay uint64) {
+	head := tracker.GetCurrentBlockNumber().Uint64()
+	for _, event := range tracker.GetWatchedEvents() {
+		polled := client.getPolledBlock(event.Addr, event.Topic)
+		if polled == 0 {
+			// logs not polled yet
+			continue
+		}
+		var lag uint64
+		if head > polled {
+			lag = head - polled
+		}
+		lags[prefix+event.Name] = lag
+		// the watcher polls up to head minus block delay, once every check interval
+		blockDelay := event.BlockDelay
+		if blockDelay == 0 {
+			blockDelay = defaultDelay
+		}
+		if lag > blockDelay+event.CheckInterval+config.HealthMaxMonitorLag {
+			check.Status = HealthWarn
+			check.Error = fmt.Sprintf("event %s%s lags %d blocks", prefix, event.Name, lag)
+		}
+	}
+}
+
+func (c *CNode) checkDepositPool() *HealthCheck {
+	if c.depositProcessor == nil {
+		return &HealthCheck{Status: HealthOK}
+	}
+	balances, err := c.depositProcessor.GetRefillPoolBalances()
+	if err != nil {
+		return healthErr(HealthWarn, err)
+	}
+	var pools []*poolHealth
+	check := &HealthCheck{Status: HealthOK}
+	for _, b := range balances {
+		pools = append(pools, &poolHealth{
+			Token:     ctype.Addr2Hex(b.Token),
+			Balance:   b.Balance.String(),
+			Threshold: b.Threshold.String(),
+		})
+		if b.Balance.Cmp(b.Threshold) == -1 {
+			check.Status = HealthWarn
+			check.Error = fmt.Sprintf("token %x pool balance below threshold", b.Token)
+		}
+	}
+	check.Detail = pools
+	return check
+}
+
+func (c *CNode) checkPeerOsps() *HealthCheck {
+	if c.routeController == nil {
+		// peer osps are tracked by another server in the multi-server setup
+		return &HealthCheck{Status: HealthOK}
+	}
+	peers := c.routeController.GetAllNeighbors()
+	detail := &peerOspHealth{Peers: len(peers)}
+	for ospAddr := range peers {
+		if c.IsLocalPeer(ospAddr) {
+			detail.Connected++
+		} else {
+			detail.Disconnected = append(detail.Disconnected, ctype.Addr2Hex(ospAddr))
+		}
+	}
+	check := &HealthCheck{Status: HealthOK, Detail: detail}
+	if detail.Connected == 0 && detail.Peers > 0 {
+		check.Status = HealthFail
+		check.Error = "not connected to any peer osp"
+	} else if detail.Connected < detail.Peers {
+		check.Status = HealthWarn
+		check.Error = fmt.Sprintf("%d peer osps not connected", detail.Peers-detail.Connected)
+	}
+	return check
+}
+
+// pollClient wraps the eth client of a watch service to record the last block
+// the logs of each watched event are polled up to, which the watch service does not expose
+type pollClient struct {
+	watcher.WatchClient
+	polled map[pollKey]uint64
+	lock   sync.RWMutex
+}
+
+// pollKey identifies the log filter query of a watched event
+type pollKey struct {
+	addr  ethcommon.Address
+	topic ethcommon.Hash
+}
+
+func newPollClient(client watcher.WatchClient) *pollClient {
+	return &pollClient{
+		WatchClient: client,
+		polled:      make(map[pollKey]uint64),
+	}
+}
+
+// FilterLogs implements watcher.WatchClient
+func (p *pollClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	logs, err := p.WatchClient.FilterLogs(ctx, q)
+	if err != nil || q.ToBlock == nil || len(q.Addresses) != 1 || len(q.Topics) == 0 || len(q.Topics[0]) != 1 {
+		return logs, err
+	}
+	key := pollKey{addr: q.Addresses[0], topic: q.Topics[0][0]}
+	toBlock := q.ToBlock.Uint64()
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if toBlock > p.polled[key] {
+		p.polled[key] = toBlock
+	}
+	return logs, nil
+}
+
+func (p *pollClient) getPolledBlock(addr ethcommon.Address, topic ethcommon.Hash) uint64 {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.polled[pollKey{addr: addr, topic: topic}]
+}
@@ -0,0 +1,264 @@
+// Copyright 2020 Celer Network
+
+package cnode
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/celer-network/goCeler/chain/channel-eth-go/ledger"
+	"github.com/celer-network/goCeler/common/event"
+	"github.com/celer-network/goCeler/config"
+	"github.com/celer-network/goCeler/reorg"
+	"github.com/celer-network/goCeler/storage"
+	"github.com/celer-network/goutils/eth/monitor"
+	"github.com/ethereum/go-ethereum"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+// testHeadService serves eth_getBlockByNumber with a fixed head block
+type testHeadService struct {
+	head uint64
+}
+
+func (s *testHeadService) GetBlockByNumber(number ethrpc.BlockNumber, fullTx bool) (*types.Header, error) {
+	return &types.Header{
+		Number:     new(big.Int).SetUint64(s.head),
+		Difficulty: big.NewInt(0),
+	}, nil
+}
+
+// testHealthMonitor is a monitor service at a fixed watcher block
+type testHealthMonitor struct {
+	blkNum uint64
+	nextID monitor.CallbackID
+}
+
+func (m *testHealthMonitor) GetCurrentBlockNumber() *big.Int {
+	return new(big.Int).SetUint64(m.blkNum)
+}
+
+func (m *testHealthMonitor) RegisterDeadline(deadline monitor.Deadline) monitor.CallbackID { return 0 }
+
+func (m *testHealthMonitor) Monitor(
+	cfg *monitor.Config, callback func(monitor.CallbackID, types.Log)) (monitor.CallbackID, error) {
+	m.nextID++
+	return m.nextID, nil
+}
+
+func (m *testHealthMonitor) RemoveDeadline(id monitor.CallbackID) {}
+
+func (m *testHealthMonitor) RemoveEvent(id monitor.CallbackID) {}
+
+func (m *testHealthMonitor) Close() {}
+
+type testWatchClient struct{}
+
+func (c *testWatchClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return nil, nil
+}
+
+func (c *testWatchClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	return nil, nil
+}
+
+type testContract struct {
+	addr ethcommon.Address
+}
+
+func (c *testContract) GetAddr() ethcommon.Address { return c.addr }
+
+func (c *testContract) GetABI() string { return ledger.CelerLedgerABI }
+
+func newTestHealthNode(t *testing.T, st storage.KVStore, head, watcherBlock uint64) *CNode {
+	server := ethrpc.NewServer()
+	err := server.RegisterName("eth", &testHeadService{head: head})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tracker := reorg.NewTracker(0, &testHealthMonitor{blkNum: watcherBlock}, nil, nil)
+	return &CNode{
+		kvstore:        st,
+		ethclient:      ethclient.NewClient(ethrpc.DialInProc(server)),
+		monitorService: tracker,
+		reorgTracker:   tracker,
+		watchClient:    newPollClient(&testWatchClient{}),
+		listenOnChain:  true,
+	}
+}
+
+// pollEvent makes the watch client poll the logs of a watched event up to the given block
+func pollEvent(t *testing.T, c *CNode, eventName string, toBlock uint64) {
+	_, err := c.reorgTracker.Monitor(&monitor.Config{
+		EventName: eventName,
+		Contract:  &testContract{addr: ethcommon.Address{1}},
+	}, func(monitor.CallbackID, types.Log) {})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range c.reorgTracker.GetWatchedEvents() {
+		if e.Name != monitor.NewEventStr(ethcommon.Address{1}, eventName) {
+			continue
+		}
+		_, err = c.watchClient.FilterLogs(context.Background(), ethereum.FilterQuery{
+			ToBlock:   new(big.Int).SetUint64(toBlock),
+			Addresses: []ethcommon.Address{e.Addr},
+			Topics:    [][]ethcommon.Hash{{e.Topic}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestHealth(t *testing.T) {
+	stFile := filepath.Join(os.TempDir(), "cnode_health_test.db")
+	os.Remove(stFile)
+	st, err := storage.NewKVStoreSQL("sqlite3", stFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(stFile)
+	defer st.Close()
+
+	closedFile := filepath.Join(os.TempDir(), "cnode_health_closed_test.db")
+	os.Remove(closedFile)
+	closedSt, err := storage.NewKVStoreSQL("sqlite3", closedFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(closedFile)
+	closedSt.Close()
+
+	const watcherBlock = 1000
+	maxMonitorLag := config.BlockDelay + config.HealthMaxMonitorLag
+	testCases := []struct {
+		name      string
+		st        storage.KVStore
+		head      uint64
+		polled    uint64 // block the Deposit event logs are polled up to, 0 if not polled
+		draining  bool
+		liveness  string
+		readiness string
+		failed    []string // checks expected to fail or warn
+	}{
+		{
+			name:      "ok",
+			st:        st,
+			head:      watcherBlock,
+			polled:    watcherBlock - config.BlockDelay,
+			liveness:  HealthOK,
+			readiness: HealthOK,
+		},
+		{
+			name:      "event not polled",
+			st:        st,
+			head:      watcherBlock,
+			liveness:  HealthOK,
+			readiness: HealthOK,
+		},
+		{
+			name:      "event lag within bound",
+			st:        st,
+			head:      watcherBlock,
+			polled:    watcherBlock - maxMonitorLag,
+			liveness:  HealthOK,
+			readiness: HealthOK,
+		},
+		{
+			name:      "event lags",
+			st:        st,
+			head:      watcherBlock,
+			polled:    watcherBlock - maxMonitorLag - 1,
+			liveness:  HealthOK,
+			readiness: HealthWarn,
+			failed:    []string{"monitor"},
+		},
+		{
+			name:      "chain watcher lags",
+			st:        st,
+			head:      watcherBlock + config.BlockDelay + config.HealthMaxBlockLag + 1,
+			polled:    watcherBlock - config.BlockDelay,
+			liveness:  HealthOK,
+			readiness: HealthFail,
+			failed:    []string{"chain"},
+		},
+		{
+			name:      "db closed",
+			st:        closedSt,
+			head:      watcherBlock,
+			polled:    watcherBlock - config.BlockDelay,
+			liveness:  HealthFail,
+			readiness: HealthFail,
+			failed:    []string{"db"},
+		},
+		{
+			name:      "draining",
+			st:        st,
+			head:      watcherBlock,
+			polled:    watcherBlock - config.BlockDelay,
+			draining:  true,
+			liveness:  HealthOK,
+			readiness: HealthFail,
+			failed:    []string{"drain"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newTestHealthNode(t, tc.st, tc.head, watcherBlock)
+			if tc.polled != 0 {
+				pollEvent(t, c, event.Deposit, tc.polled)
+			}
+			if tc.draining {
+				c.drainState = drainStateDraining
+			}
+
+			liveness := c.CheckLiveness()
+			if liveness.Status != tc.liveness {
+				t.Errorf("liveness status %s, expected %s", liveness.Status, tc.liveness)
+			}
+			if len(liveness.Checks) != 1 || liveness.Checks["db"] == nil {
+				t.Errorf("wrong liveness checks %v", liveness.Checks)
+			}
+
+			readiness := c.CheckReadiness()
+			if readiness.Status != tc.readiness {
+				t.Errorf("readiness status %s, expected %s", readiness.Status, tc.readiness)
+			}
+			failed := make(map[string]bool)
+			for _, name := range tc.failed {
+				failed[name] = true
+			}
+			for name, check := range readiness.Checks {
+				if failed[name] {
+					if check.Status == HealthOK || check.Error == "" {
+						t.Errorf("check %s should not be ok: %+v", name, check)
+					}
+				} else if check.Status != HealthOK {
+					t.Errorf("check %s should be ok: %+v", name, check)
+				}
+			}
+			for name := range failed {
+				if readiness.Checks[name] == nil {
+					t.Errorf("check %s missing", name)
+				}
+			}
+
+			lags := readiness.Checks["monitor"].Detail.(map[string]uint64)
+			eventName := monitor.NewEventStr(ethcommon.Address{1}, event.Deposit)
+			if tc.polled == 0 {
+				if len(lags) != 0 {
+					t.Errorf("lags of events not polled: %v", lags)
+				}
+			} else if lags[eventName] != watcherBlock-tc.polled {
+				t.Errorf("event lag %d, expected %d", lags[eventName], watcherBlock-tc.polled)
+			}
+		})
+	}
+}
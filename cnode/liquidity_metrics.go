@@ -0,0 +1,171 @@
+// Copyright 2020 Celer Network
+
+package cnode
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/celer-network/goCeler/chain"
+	"github.com/celer-network/goCeler/common"
+	"github.com/celer-network/goCeler/common/structs"
+	"github.com/celer-network/goCeler/config"
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/ledgerview"
+	"github.com/celer-network/goCeler/metrics"
+	"github.com/celer-network/goCeler/rtconfig"
+	"github.com/celer-network/goCeler/utils"
+	"github.com/celer-network/goutils/log"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// runLiquidityCollector periodically exports channel liquidity and ETH pool balances as gauges
+func (c *CNode) runLiquidityCollector() {
+	c.tokenDecimals = make(map[ctype.Addr]uint8)
+	ticker := time.NewTicker(config.LiquidityInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.quit:
+			return
+		case <-ticker.C:
+			c.collectLiquidityMetrics()
+			c.collectEthPoolMetrics()
+		}
+	}
+}
+
+// collectLiquidityMetrics computes the liquidity of all opened channels. Channels with peer OSPs
+// are labeled by peer address, all client channels are aggregated to bound the label cardinality.
+func (c *CNode) collectLiquidityMetrics() {
+	chans, err := c.dal.GetChansForBalanceByState(structs.ChanState_OPENED)
+	if err != nil {
+		log.Errorln("collect liquidity metrics, GetChansForBalanceByState err:", err)
+		return
+	}
+	peerOsps := c.getPeerOspSet()
+	blkNums := make(map[uint64]uint64)                 // chain id -> current block number
+	groups := make(map[string]*metrics.LiquidityStats) // peer label + token -> stats
+	var stats []*metrics.LiquidityStats
+	for _, ch := range chans {
+		blkNum, ok := blkNums[ch.ChainId]
+		if !ok {
+			blkNum = c.blockNumberOfChain(ch.ChainId)
+			blkNums[ch.ChainId] = blkNum
+		}
+		var balance *common.ChannelBalance
+		if ch.BaseSeq > ch.LastAckedSeq {
+			// the base simplex is in the unacked message
+			balance, err = ledgerview.GetBalance(c.dal, ch.Cid, c.EthAddress, blkNum)
+			if err != nil {
+				log.Warnf("collect liquidity metrics, cid %x GetBalance err: %s", ch.Cid, err)
+				continue
+			}
+		} else {
+			balance = ledgerview.ComputeBalance(
+				ch.SelfSimplex, ch.PeerSimplex, ch.OnChainBalance, c.EthAddress, ch.Peer, blkNum)
+		}
+
+		peer := metrics.LiquidityPeerClients
+		if peerOsps[ch.Peer] {
+			peer = ctype.Addr2Hex(ch.Peer)
+		}
+		token := ctype.Addr2Hex(ch.Token)
+		st := groups[peer+token]
+		if st == nil {
+			st = metrics.NewLiquidityStats(peer, token, c.getTokenDecimals(ch.ChainId, ch.Token))
+			groups[peer+token] = st
+			stats = append(stats, st)
+		}
+		st.Channels++
+		st.SendCapacity.Add(st.SendCapacity, balance.MyFree)
+		st.RecvCapacity.Add(st.RecvCapacity, balance.PeerFree)
+		st.PendingAmt[metrics.PendingPayOut].Add(st.PendingAmt[metrics.PendingPayOut], balance.MyLocked)
+		st.PendingAmt[metrics.PendingPayIn].Add(st.PendingAmt[metrics.PendingPayIn], balance.PeerLocked)
+		addPendingPayCnt(st, metrics.PendingPayOut, len(ch.SelfSimplex.GetPendingPayIds().GetPayIds()))
+		addPendingPayCnt(st, metrics.PendingPayIn, len(ch.PeerSimplex.GetPendingPayIds().GetPayIds()))
+	}
+	metrics.SetLiquidityGauges(stats, rtconfig.GetMaxNumPendingPays())
+}
+
+// getTokenDecimals returns the cached decimals of a token, queried from the token contract on
+// first use. ETH and tokens failed to query are taken as 18 decimals, the latter not cached.
+func (c *CNode) getTokenDecimals(chainId uint64, token ctype.Addr) uint8 {
+	if token == ctype.ZeroAddr {
+		return 18
+	}
+	if decimals, ok := c.tokenDecimals[token]; ok {
+		return decimals
+	}
+	var conn bind.ContractCaller = c.nodeConfig.GetEthConn()
+	if !c.isPrimaryChain(chainId) {
+		ch := c.getChain(chainId)
+		if ch == nil {
+			log.Warnf("collect liquidity metrics, token %x of unsupported chain %d", token, chainId)
+			return 18
+		}
+		conn = ch.ethclient
+	}
+	erc20, err := chain.NewERC20Caller(token, conn)
+	if err != nil {
+		log.Warnf("collect liquidity metrics, token %x NewERC20Caller err: %s", token, err)
+		return 18
+	}
+	decimals, err := erc20.Decimals(&bind.CallOpts{})
+	if err != nil {
+		log.Warnf("collect liquidity metrics, token %x Decimals err: %s", token, err)
+		return 18
+	}
+	c.tokenDecimals[token] = decimals
+	return decimals
+}
+
+func addPendingPayCnt(st *metrics.LiquidityStats, direction string, cnt int) {
+	st.PendingCnt[direction] += cnt
+	if cnt > st.PendingPeak[direction] {
+		st.PendingPeak[direction] = cnt
+	}
+}
+
+// collectEthPoolMetrics queries the ETH pool balances of the node accounts
+func (c *CNode) collectEthPoolMetrics() {
+	ethPool, err := chain.NewERC20Caller(c.nodeConfig.GetEthPoolAddr(), c.nodeConfig.GetEthConn())
+	if err != nil {
+		log.Errorln("collect ethpool metrics err:", err)
+		return
+	}
+	accounts := []ctype.Addr{c.EthAddress}
+	if c.depositTransactor != nil && c.depositTransactor.Address() != c.EthAddress {
+		accounts = append(accounts, c.depositTransactor.Address())
+	}
+	balances := make(map[string]*big.Int)
+	for _, account := range accounts {
+		balance, err := ethPool.BalanceOf(&bind.CallOpts{}, account)
+		if err != nil {
+			log.Warnf("collect ethpool metrics, account %x BalanceOf err: %s", account, err)
+			continue
+		}
+		balances[ctype.Addr2Hex(account)] = balance
+	}
+	metrics.SetEthPoolBalanceGauges(balances)
+}
+
+// getPeerOspSet returns all peer OSPs, connected or not
+func (c *CNode) getPeerOspSet() map[ctype.Addr]bool {
+	osps := make(map[ctype.Addr]bool)
+	if c.routeController != nil {
+		for ospAddr := range c.routeController.GetAllNeighbors() {
+			osps[ospAddr] = true
+		}
+	} else if c.isMultiServer && config.EventListenerHttp != "" {
+		res, err := utils.QueryPeerOsps(config.EventListenerHttp)
+		if err != nil {
+			log.Warnln("QueryPeerOsps err:", err)
+			return osps
+		}
+		for _, osp := range res.PeerOsps {
+			osps[ctype.Hex2Addr(osp.GetOspAddress())] = true
+		}
+	}
+	return osps
+}
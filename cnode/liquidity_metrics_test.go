@@ -0,0 +1,147 @@
+// Copyright 2020 Celer Network
+
+package cnode
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/celer-network/goCeler/common/structs"
+	"github.com/celer-network/goCeler/config"
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/metrics"
+)
+
+// gatherLiquidity returns the exported liquidity gauges keyed by metric name and label values,
+// the labels in the order of their names
+func gatherLiquidity(t *testing.T) map[string]float64 {
+	families, err := metrics.GetPromRegistry().Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := make(map[string]float64)
+	for _, family := range families {
+		if !strings.HasPrefix(family.GetName(), "celer_liquidity_") && !strings.HasPrefix(family.GetName(), "celer_pending_pay_") {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			key := []string{family.GetName()}
+			for _, label := range m.GetLabel() {
+				key = append(key, label.GetValue())
+			}
+			values[strings.Join(key, "/")] = m.GetGauge().GetValue()
+		}
+	}
+	return values
+}
+
+func TestCollectLiquidityMetrics(t *testing.T) {
+	c := newTestViewNode(t, "cnode_liquidity_metrics_test")
+	dal := c.dal
+
+	// peer osps queried from the event listener of a multi-server OSP
+	osp := ctype.Hex2Addr("ab1")
+	listener := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/admin/peer/peer_osps" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`{"peer_osps":[{"osp_address":"` + ctype.Addr2Hex(osp) + `"}]}`))
+	}))
+	defer listener.Close()
+	eventListenerHttp := config.EventListenerHttp
+	config.EventListenerHttp = strings.TrimPrefix(listener.URL, "http://")
+	defer func() { config.EventListenerHttp = eventListenerHttp }()
+	c.isMultiServer = true
+
+	token := ctype.Hex2Addr("70")
+	c.tokenDecimals = map[ctype.Addr]uint8{token: 6}
+	const eth = int64(1e18)
+	payIDs := func(n int) []ctype.PayIDType {
+		ids := make([]ctype.PayIDType, n)
+		for i := range ids {
+			ids[i] = ctype.Bytes2PayID([]byte{byte(n), byte(i)})
+		}
+		return ids
+	}
+	chans := []*testViewChan{
+		// my free: 3 - 1 - 0.5, peer free: 1 + 1 - 0.25
+		{cid: ctype.Hex2Cid("c1"), peer: osp, myDeposit: 3 * eth, peerDeposit: eth, toPeer: eth,
+			myLocked: eth / 2, myPending: payIDs(2), peerLocked: eth / 4, peerPending: payIDs(1)},
+		// my free: 2 - 0.25, peer free: 0.5
+		{cid: ctype.Hex2Cid("c2"), peer: ctype.Hex2Addr("ab2"), token: token, myDeposit: 2000000, peerDeposit: 500000,
+			myLocked: 250000, myPending: payIDs(1)},
+		// my free: 1 - 0.25
+		{cid: ctype.Hex2Cid("c3"), peer: ctype.Hex2Addr("ab3"), token: token, myDeposit: 1000000,
+			myLocked: 250000, myPending: payIDs(3)},
+		// not opened
+		{cid: ctype.Hex2Cid("c4"), peer: ctype.Hex2Addr("ab4"), myDeposit: eth, state: structs.ChanState_SETTLING},
+	}
+	for _, ch := range chans {
+		insertTestViewChan(t, dal, ch)
+	}
+
+	c.collectLiquidityMetrics()
+	ospLabel := ctype.Addr2Hex(osp) + "/" + ctype.Addr2Hex(ctype.ZeroAddr)
+	clientsLabel := metrics.LiquidityPeerClients + "/" + ctype.Addr2Hex(token)
+	expected := map[string]float64{
+		"celer_liquidity_channel_count/" + ospLabel:        1,
+		"celer_liquidity_send_capacity/" + ospLabel:        1.5,
+		"celer_liquidity_receive_capacity/" + ospLabel:     1.75,
+		"celer_pending_pay_count/out/" + ospLabel:          2,
+		"celer_pending_pay_count/in/" + ospLabel:           1,
+		"celer_pending_pay_peak/out/" + ospLabel:           2,
+		"celer_pending_pay_peak/in/" + ospLabel:            1,
+		"celer_pending_pay_amount/out/" + ospLabel:         0.5,
+		"celer_pending_pay_amount/in/" + ospLabel:          0.25,
+		"celer_liquidity_channel_count/" + clientsLabel:    2,
+		"celer_liquidity_send_capacity/" + clientsLabel:    2.5,
+		"celer_liquidity_receive_capacity/" + clientsLabel: 0.5,
+		"celer_pending_pay_count/out/" + clientsLabel:      4,
+		"celer_pending_pay_count/in/" + clientsLabel:       0,
+		"celer_pending_pay_peak/out/" + clientsLabel:       3,
+		"celer_pending_pay_peak/in/" + clientsLabel:        0,
+		"celer_pending_pay_amount/out/" + clientsLabel:     0.5,
+		"celer_pending_pay_amount/in/" + clientsLabel:      0,
+	}
+	checkLiquidity := func(expected map[string]float64) {
+		values := gatherLiquidity(t)
+		if values["celer_pending_pay_limit"] != 200 || values["celer_liquidity_collect_timestamp"] == 0 {
+			t.Errorf("wrong pending pay limit or collect time: %v", values)
+		}
+		delete(values, "celer_pending_pay_limit")
+		delete(values, "celer_liquidity_collect_timestamp")
+		for key, v := range expected {
+			if values[key] != v {
+				t.Errorf("%s: got %v, expect %v", key, values[key], v)
+			}
+		}
+		for key, v := range values {
+			if _, ok := expected[key]; !ok {
+				t.Errorf("unexpected gauge %s: %v", key, v)
+			}
+		}
+	}
+	checkLiquidity(expected)
+
+	// series of channels no longer opened are dropped
+	for _, cid := range []ctype.CidType{ctype.Hex2Cid("c1"), ctype.Hex2Cid("c3")} {
+		if err := dal.DeleteChan(cid); err != nil {
+			t.Fatal(err)
+		}
+	}
+	c.collectLiquidityMetrics()
+	checkLiquidity(map[string]float64{
+		"celer_liquidity_channel_count/" + clientsLabel:    1,
+		"celer_liquidity_send_capacity/" + clientsLabel:    1.75,
+		"celer_liquidity_receive_capacity/" + clientsLabel: 0.5,
+		"celer_pending_pay_count/out/" + clientsLabel:      1,
+		"celer_pending_pay_count/in/" + clientsLabel:       0,
+		"celer_pending_pay_peak/out/" + clientsLabel:       1,
+		"celer_pending_pay_peak/in/" + clientsLabel:        0,
+		"celer_pending_pay_amount/out/" + clientsLabel:     0.25,
+		"celer_pending_pay_amount/in/" + clientsLabel:      0,
+	})
+}
@@ -258,6 +258,16 @@ func (c *CNode) ForwardMsgToPeer(req *rpc.FwdReq) error {
 		logEntry.Type = pem.PayMessageType_PAY_SETTLE_REQUEST
 		err = c.messager.ForwardPaySettleRequestMsg(frame)
 
+	case *rpc.CelerMsg_PayTraceRequest:
+		logEntry.Type = pem.PayMessageType_PAY_TRACE_REQUEST
+		logEntry.PayId = ctype.Bytes2Hex(msg.GetPayTraceRequest().GetPayId())
+		err = c.streamWriter.WriteCelerMsg(ctype.Hex2Addr(req.GetDest()), msg)
+
+	case *rpc.CelerMsg_PayTraceResponse:
+		logEntry.Type = pem.PayMessageType_PAY_TRACE_RESPONSE
+		logEntry.PayId = ctype.Bytes2Hex(msg.GetPayTraceResponse().GetPayId())
+		err = c.streamWriter.WriteCelerMsg(ctype.Hex2Addr(req.GetDest()), msg)
+
 	default:
 		err = common.ErrInvalidMsgType
 	}
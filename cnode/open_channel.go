@@ -53,11 +53,12 @@ var zeroAmtBytes = []byte{0}
 type openChannelProcessor struct {
 	nodeConfig          common.GlobalNodeConfig
 	signer              eth.Signer
-	transactor          *eth.Transactor
+	transactor          intfs.Transactor
 	dal                 *storage.DAL
 	connectionManager   *rpc.ConnectionManager
 	monitorService      intfs.MonitorService
 	callbacks           map[string]event.OpenChannelCallback // TokenAddr to OpenChannelEvent callback
+	cidCallbacks        map[ctype.CidType]event.OpenChannelCallback
 	callbacksLock       sync.Mutex
 	masterLock          sync.Mutex
 	lockPerTokenPerPeer map[string]*sync.Mutex
@@ -73,7 +74,7 @@ type openChannelProcessor struct {
 func startOpenChannelProcessor(
 	nodeConfig common.GlobalNodeConfig,
 	signer eth.Signer,
-	transactor *eth.Transactor,
+	transactor intfs.Transactor,
 	dal *storage.DAL,
 	connectionManager *rpc.ConnectionManager,
 	monitorService intfs.MonitorService,
@@ -88,6 +89,7 @@ func startOpenChannelProcessor(
 		connectionManager:   connectionManager,
 		monitorService:      monitorService,
 		callbacks:           make(map[string]event.OpenChannelCallback),
+		cidCallbacks:        make(map[ctype.CidType]event.OpenChannelCallback),
 		routeController:     routeController,
 		depositProcessor:    depositProcessor,
 		lockPerTokenPerPeer: make(map[string]*sync.Mutex),
@@ -120,6 +122,20 @@ func invokeErrorCallback(openCallback event.OpenChannelCallback, err *common.E)
 		}()
 	}
 }
+
+// getLockPerTokenPerPeer returns the lock serializing the channel opens with the peer on the token
+func (p *openChannelProcessor) getLockPerTokenPerPeer(peer ctype.Addr, tokenInfo *entity.TokenInfo) *sync.Mutex {
+	p.masterLock.Lock()
+	defer p.masterLock.Unlock()
+	key := ctype.Addr2Hex(peer) + utils.GetTokenAddrStr(tokenInfo)
+	lock, ok := p.lockPerTokenPerPeer[key]
+	if !ok {
+		lock = &sync.Mutex{}
+		p.lockPerTokenPerPeer[key] = lock
+	}
+	return lock
+}
+
 func (p *openChannelProcessor) prepareChannelInitializer(
 	peer ctype.Addr,
 	amtSelf *big.Int,
@@ -195,7 +211,7 @@ func (p *openChannelProcessor) tcbOpenChannel(
 		openCallback.HandleOpenChannelErr(&common.E{Reason: err.Error()})
 		return err
 	}
-	sig, err := p.signer.SignEthMessage(initializerBytes)
+	sig, err := utils.SignEthMessageOfKind(p.signer, intfs.SignKindChannelInitializer, initializerBytes)
 	if err != nil {
 		openCallback.HandleOpenChannelErr(&common.E{Reason: err.Error()})
 		return err
@@ -288,7 +304,7 @@ func (p *openChannelProcessor) openChannel(
 		p.processOpenError(openCallback, latestLedgerAddr, err)
 		return err
 	}
-	sig, err := p.signer.SignEthMessage(initializerBytes)
+	sig, err := utils.SignEthMessageOfKind(p.signer, intfs.SignKindChannelInitializer, initializerBytes)
 	if err != nil {
 		p.processOpenError(openCallback, latestLedgerAddr, err)
 		return err
@@ -304,6 +320,7 @@ func (p *openChannelProcessor) openChannel(
 		RequesterSig:       sig,
 		OpenBy:             openBy,
 		OspToOsp:           ospToOspOpen,
+		ChainId:            config.ChainId.Uint64(),
 	}
 
 	rc, err := p.connectionManager.GetClient(peer)
@@ -553,7 +570,7 @@ func (p *openChannelProcessor) processTcbRequest(in *rpc.OpenChannelRequest, oce
 		participants: [2]ctype.Addr{addr0, addr1},
 		initDeposits: [2]*big.Int{new(big.Int).SetBytes(dist[0].GetAmt()), new(big.Int).SetBytes(dist[1].GetAmt())},
 	}
-	mySig, signErr := p.signer.SignEthMessage(in.GetChannelInitializer())
+	mySig, signErr := utils.SignEthMessageOfKind(p.signer, intfs.SignKindChannelInitializer, in.GetChannelInitializer())
 	if signErr != nil {
 		revertErr := p.dal.Transactional(p.revertInflightOpenChannelTx, peerAddr, tokenAddr)
 		if revertErr != nil {
@@ -713,7 +730,7 @@ func (p *openChannelProcessor) processOpenChannelRequest(req *rpc.OpenChannelReq
 	ocem.TokenAddr = ctype.Addr2Hex(tokenAddr)
 
 	// Critical section to open channel (for each requester, token pair).
-	existingCid, exist, err := p.dal.GetCidByPeerToken(ctype.Bytes2Addr(requester), tokenInfo)
+	existingCid, exist, err := p.dal.GetCidByPeerTokenOnChain(ctype.Bytes2Addr(requester), tokenInfo, p.nodeConfig.GetChainId())
 	if err != nil {
 		return errResp, status.Error(codes.Internal, err.Error())
 	}
@@ -729,7 +746,7 @@ func (p *openChannelProcessor) processOpenChannelRequest(req *rpc.OpenChannelReq
 		return errResp, status.Error(codes.AlreadyExists, "more than one inflight open channel request.")
 	}
 
-	mySig, err := p.signer.SignEthMessage(req.ChannelInitializer)
+	mySig, err := utils.SignEthMessageOfKind(p.signer, intfs.SignKindChannelInitializer, req.ChannelInitializer)
 	if err != nil {
 		revertErr := p.dal.Transactional(p.revertInflightOpenChannelTx, ctype.Bytes2Addr(requester), tokenAddr)
 		if revertErr != nil {
@@ -859,7 +876,7 @@ func (p *openChannelProcessor) maybeHandleEvent(descriptor *openedChannelDescrip
 			return err2
 		}
 		ledgerAddr := p.nodeConfig.GetLedgerContract().GetAddr()
-		err = tx.InsertChan(cid, peer, token, ledgerAddr, chanState, nil /*openResp*/, onChainBalance, 0 /*baseSeqNum*/, 0 /*lastUsedSeqNum*/, 0 /*lastAckedSeqNum*/, 0 /*lastNackedSeqNum*/, selfSimplex, peerSimplex)
+		err = tx.InsertChanOnChain(p.nodeConfig.GetChainId(), cid, peer, token, ledgerAddr, chanState, nil /*openResp*/, onChainBalance, 0 /*baseSeqNum*/, 0 /*lastUsedSeqNum*/, 0 /*lastAckedSeqNum*/, 0 /*lastNackedSeqNum*/, selfSimplex, peerSimplex)
 		if err != nil {
 			return err
 		}
@@ -891,6 +908,11 @@ func (p *openChannelProcessor) maybeHandleEvent(descriptor *openedChannelDescrip
 		go cb.HandleOpenChannelFinish(cid)
 		delete(p.callbacks, tokenAddr)
 	}
+	cb, ok = p.cidCallbacks[cid]
+	if ok {
+		go cb.HandleOpenChannelFinish(cid)
+		delete(p.cidCallbacks, cid)
+	}
 	p.callbacksLock.Unlock()
 	return true
 }
@@ -917,7 +939,7 @@ func (p *openChannelProcessor) emptySimplex(
 	if err != nil {
 		return nil, err
 	}
-	mySig, err := p.signer.SignEthMessage(emptySimplexByte)
+	mySig, err := utils.SignEthMessageOfKind(p.signer, intfs.SignKindSimplexState, emptySimplexByte)
 	if err != nil {
 		return nil, err
 	}
@@ -1113,7 +1135,11 @@ func (c *CNode) TcbOpenChannel(
 func (c *CNode) ProcessOpenChannelRequest(in *rpc.OpenChannelRequest) (*rpc.OpenChannelResponse, error) {
 	ocem := pem.NewOcem(c.nodeConfig.GetRPCAddr())
 	ocem.Type = pem.OpenChannelEventType_OPEN_CHANNEL_REQUEST
-	response, err := c.openChannelProcessor.processOpenChannelRequest(in, ocem)
+	var response *rpc.OpenChannelResponse
+	p, err := c.openChannelProcessorOf(in.GetChainId())
+	if err == nil {
+		response, err = p.processOpenChannelRequest(in, ocem)
+	}
 	if err != nil {
 		ocem.Error = append(ocem.Error, err.Error())
 		log.Error(err)
@@ -1125,7 +1151,13 @@ func (c *CNode) ProcessOpenChannelRequest(in *rpc.OpenChannelRequest) (*rpc.Open
 func (c *CNode) ProcessTcbRequest(in *rpc.OpenChannelRequest) (*rpc.OpenChannelResponse, error) {
 	ocem := pem.NewOcem(c.nodeConfig.GetRPCAddr())
 	ocem.Type = pem.OpenChannelEventType_TCB_REQUEST
-	response, err := c.openChannelProcessor.processTcbRequest(in, ocem)
+	var response *rpc.OpenChannelResponse
+	var err error
+	if c.isPrimaryChain(in.GetChainId()) {
+		response, err = c.openChannelProcessor.processTcbRequest(in, ocem)
+	} else {
+		err = status.Error(codes.InvalidArgument, "tcb channel only supported on the primary chain")
+	}
 	if err != nil {
 		log.Error(err)
 		ocem.Error = append(ocem.Error, err.Error())
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/big"
 	"math/rand"
+	"sync"
 	"time"
 
 	"github.com/celer-network/goCeler/common"
@@ -19,11 +20,13 @@ import (
 	"github.com/celer-network/goCeler/ledgerview"
 	"github.com/celer-network/goCeler/pem"
 	"github.com/celer-network/goCeler/rpc"
+	"github.com/celer-network/goCeler/rtconfig"
 	"github.com/celer-network/goCeler/utils"
 	"github.com/celer-network/goutils/log"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/any"
+	"github.com/google/uuid"
 )
 
 func newHashLockCond() (*entity.Condition, []byte, []byte) {
@@ -48,6 +51,48 @@ func (c *CNode) OnSendToken(sendCallback event.OnSendingTokenCallback) {
 
 // Similar to EstablishCondPayOnToken. This will add hash lock condition to pay condition and set time stamp.
 func (c *CNode) AddBooleanPay(newPay *entity.ConditionalPay, note *any.Any, dstNetId uint64) (ctype.PayIDType, error) {
+	return c.addBooleanPay(newPay, note, dstNetId, nil, nil)
+}
+
+// AddBooleanPayWithFee sends the pay along with the relay fee for the osps forwarding it
+func (c *CNode) AddBooleanPayWithFee(
+	newPay *entity.ConditionalPay, note *any.Any, dstNetId uint64, fee *big.Int) (ctype.PayIDType, error) {
+	return c.addBooleanPay(newPay, note, dstNetId, nil, fee)
+}
+
+// GetPayFee returns the relay fee to send along with a pay of amt to dst on the token when the pay
+// is sent to me for forwarding, including my fee and the fees advertised by the downstream osps.
+// The fee is charged per attempt and kept by the osps even if the pay is canceled later.
+func (c *CNode) GetPayFee(tokenAddr, dst ctype.Addr, amt *big.Int) (*big.Int, error) {
+	if dst == c.EthAddress {
+		return new(big.Int), nil
+	}
+	fee := rtconfig.GetForwardFee(ctype.Addr2Hex(tokenAddr), amt)
+	_, found, err := c.dal.GetCidByPeerToken(dst, utils.GetTokenInfoFromAddress(tokenAddr))
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		// forwarded directly to the destination
+		return fee, nil
+	}
+	if c.routeController == nil {
+		return nil, fmt.Errorf("route controller not initialized")
+	}
+	routeFee, err := c.routeController.GetRouteFee(tokenAddr, dst, amt)
+	if err != nil {
+		return nil, err
+	}
+	return fee.Add(fee, routeFee), nil
+}
+
+// addBooleanPay sends the pay through the given source route if not empty
+func (c *CNode) addBooleanPay(
+	newPay *entity.ConditionalPay, note *any.Any, dstNetId uint64, route [][]byte, fee *big.Int) (
+	ctype.PayIDType, error) {
+	if c.IsDraining() {
+		return ctype.ZeroPayID, common.ErrDraining
+	}
 	if utils.GetTokenAddr(newPay.TransferFunc.MaxTransfer.Token) == ctype.InvalidTokenAddr {
 		return ctype.ZeroPayID, common.ErrUnknownTokenType
 	}
@@ -105,7 +150,11 @@ func (c *CNode) AddBooleanPay(newPay *entity.ConditionalPay, note *any.Any, dstN
 			logEntry.Xnet.State = pem.CrossNetPayState_XNET_SRC
 		}
 	}
-	err = c.messager.SendCondPayRequest(newPayBytes, note, xnet, logEntry)
+	var traceID string
+	if config.EnablePayTrace {
+		traceID = uuid.New().String()
+	}
+	err = c.messager.SendCondPayRequest(nil, newPayBytes, note, xnet, traceID, route, fee, logEntry)
 	if err != nil {
 		logEntry.Error = append(logEntry.Error, err.Error())
 		payID = ctype.ZeroPayID
@@ -126,7 +175,7 @@ func (c *CNode) ConfirmBooleanPay(payID ctype.PayIDType) error {
 	logEntry := pem.NewPem(c.nodeConfig.GetRPCAddr())
 	logEntry.Type = pem.PayMessageType_CONFIRM_BOOLEAN_PAY_API
 	logEntry.PayId = ctype.PayID2Hex(payID)
-	err = c.messager.SendOnePaySettleRequest(pay, amt, rpc.PaymentSettleReason_PAY_PAID_MAX, logEntry)
+	err = c.messager.SendOnePaySettleRequest(nil, pay, amt, rpc.PaymentSettleReason_PAY_PAID_MAX, logEntry)
 	if err != nil {
 		logEntry.Error = append(logEntry.Error, err.Error())
 	}
@@ -149,7 +198,7 @@ func (c *CNode) RejectBooleanPay(payID ctype.PayIDType) error {
 	logEntry := pem.NewPem(c.nodeConfig.GetRPCAddr())
 	logEntry.Type = pem.PayMessageType_REJECT_BOOLEAN_PAY_API
 	logEntry.PayId = ctype.PayID2Hex(payID)
-	err = c.messager.SendOnePaySettleProof(payID, rpc.PaymentSettleReason_PAY_REJECTED, logEntry)
+	err = c.messager.SendOnePaySettleProof(nil, payID, rpc.PaymentSettleReason_PAY_REJECTED, logEntry)
 	if err != nil {
 		logEntry.Error = append(logEntry.Error, err.Error())
 	}
@@ -171,7 +220,7 @@ func (c *CNode) SettleOnChainResolvedPay(payID ctype.PayIDType) error {
 	}
 	logEntry := pem.NewPem(c.nodeConfig.GetRPCAddr())
 	logEntry.Type = pem.PayMessageType_SETTLE_ON_CHAIN_RESOLVED_PAY_API
-	err = c.messager.SendOnePaySettleProof(payID, rpc.PaymentSettleReason_PAY_RESOLVED_ONCHAIN, logEntry)
+	err = c.messager.SendOnePaySettleProof(nil, payID, rpc.PaymentSettleReason_PAY_RESOLVED_ONCHAIN, logEntry)
 	if err != nil {
 		logEntry.Error = append(logEntry.Error, err.Error())
 	}
@@ -213,7 +262,7 @@ func (c *CNode) SettleExpiredPays(cid ctype.CidType) error {
 func (c *CNode) getExpiredPays(simplex *entity.SimplexPaymentChannel) (
 	[]*entity.ConditionalPay, []ctype.PayIDType, error) {
 
-	currBlock := c.GetCurrentBlockNumber().Uint64()
+	currBlock := c.blockNumberOf(ctype.Bytes2Cid(simplex.GetChannelId()))
 	var expiredPays []*entity.ConditionalPay
 	var payIDs []ctype.PayIDType
 	for _, payID := range simplex.PendingPayIds.PayIds {
@@ -253,7 +302,7 @@ func (c *CNode) ConfirmOnChainResolvedPays(cid ctype.CidType) error {
 		if !found {
 			return fmt.Errorf("%w: %x", common.ErrPayNotFound, payID)
 		}
-		amt, _, err2 := c.Disputer.GetCondPayInfoFromRegistry(payID)
+		amt, _, err2 := c.disputerOf(cid).GetCondPayInfoFromRegistry(payID)
 		if err2 != nil {
 			return err2
 		}
@@ -279,7 +328,7 @@ func (c *CNode) sendSettleRequestForExpiredPays(expiredPays []*entity.Conditiona
 
 	logEntry := pem.NewPem(c.nodeConfig.GetRPCAddr())
 	logEntry.Type = pem.PayMessageType_SRC_SETTLE_EXPIRED_PAY_API
-	_, err := c.messager.SendPaysSettleRequest(expiredPays, amts, rpc.PaymentSettleReason_PAY_EXPIRED, logEntry)
+	_, err := c.messager.SendPaysSettleRequest(nil, expiredPays, amts, rpc.PaymentSettleReason_PAY_EXPIRED, logEntry)
 	if err != nil {
 		logEntry.Error = append(logEntry.Error, err.Error())
 	}
@@ -290,7 +339,7 @@ func (c *CNode) sendSettleRequestForExpiredPays(expiredPays []*entity.Conditiona
 func (c *CNode) sendSettleProofForExpiredPays(expiredPayIDs []ctype.PayIDType) error {
 	logEntry := pem.NewPem(c.nodeConfig.GetRPCAddr())
 	logEntry.Type = pem.PayMessageType_DST_SETTLE_EXPIRED_PAY_API
-	err := c.messager.SendPaysSettleProof(expiredPayIDs, rpc.PaymentSettleReason_PAY_EXPIRED, nil, logEntry)
+	err := c.messager.SendPaysSettleProof(nil, expiredPayIDs, rpc.PaymentSettleReason_PAY_EXPIRED, nil, logEntry)
 	if err != nil {
 		logEntry.Error = append(logEntry.Error, err.Error())
 	}
@@ -303,7 +352,7 @@ func (c *CNode) sendSettleRequestForOnChainResolvedPays(
 	logEntry := pem.NewPem(c.nodeConfig.GetRPCAddr())
 	logEntry.Type = pem.PayMessageType_CONFIRM_ON_CHAIN_PAY_API
 	_, err := c.messager.SendPaysSettleRequest(
-		resolvedPays, resolvedAmts, rpc.PaymentSettleReason_PAY_RESOLVED_ONCHAIN, logEntry)
+		nil, resolvedPays, resolvedAmts, rpc.PaymentSettleReason_PAY_RESOLVED_ONCHAIN, logEntry)
 	pem.CommitPem(logEntry)
 	return err
 }
@@ -333,7 +382,7 @@ func (c *CNode) ClearPaymentsInChannel(cid ctype.CidType) error {
 	for i, pay := range pays {
 		payID := payIDs[i]
 		// first check if any expired pay is resolved on chain
-		amt, _, err2 := c.Disputer.GetCondPayInfoFromRegistry(payID)
+		amt, _, err2 := c.disputerOf(cid).GetCondPayInfoFromRegistry(payID)
 		if err2 != nil {
 			log.Error(err2)
 			continue
@@ -428,14 +477,14 @@ func (c *CNode) SyncOnChainChannelStates(cid ctype.CidType) (int, error) {
 			return localState, err2
 		}
 	} else if onchainStatus == ledgerview.OnChainStatus_CLOSED && localState != enums.ChanState_CLOSED {
-		err2 := c.dal.Transactional(c.Disputer.HandleConfirmSettleEventTx, cid)
+		err2 := c.dal.Transactional(c.disputerOf(cid).HandleConfirmSettleEventTx, cid)
 		if err2 != nil {
 			return localState, err2
 		}
 	}
 	if onchainStatus != ledgerview.OnChainStatus_CLOSED &&
 		onchainStatus != ledgerview.OnChainStatus_UNINITIALIZED {
-		err2 := ledgerview.SyncOnChainBalance(c.dal, cid, c.nodeConfig)
+		err2 := ledgerview.SyncOnChainBalance(c.dal, cid, c.nodeConfigOf(cid))
 		if err2 != nil {
 			return localState, err2
 		}
@@ -444,15 +493,15 @@ func (c *CNode) SyncOnChainChannelStates(cid ctype.CidType) (int, error) {
 }
 
 func (c *CNode) IntendWithdraw(cidFrom ctype.CidType, amount *big.Int, cidTo ctype.CidType) error {
-	return c.Disputer.IntendWithdraw(cidFrom, amount, cidTo)
+	return c.disputerOf(cidFrom).IntendWithdraw(cidFrom, amount, cidTo)
 }
 
 func (c *CNode) ConfirmWithdraw(cid ctype.CidType) error {
-	return c.Disputer.ConfirmWithdraw(cid)
+	return c.disputerOf(cid).ConfirmWithdraw(cid)
 }
 
 func (c *CNode) VetoWithdraw(cid ctype.CidType) error {
-	return c.Disputer.VetoWithdraw(cid)
+	return c.disputerOf(cid).VetoWithdraw(cid)
 }
 
 // GetPaymentState returns the ingress and egress state of a payment
@@ -460,3 +509,62 @@ func (c *CNode) GetPaymentState(payID ctype.PayIDType) (int, int) {
 	inState, outState, _, _ := c.dal.GetPayStates(payID)
 	return inState, outState
 }
+
+// GetPayTrace returns the trace ID and the spans of a traced pay collected from all reachable hops,
+// ordered from pay src to pay dest.
+func (c *CNode) GetPayTrace(payID ctype.PayIDType) (string, []*rpc.PayTraceSpan, error) {
+	span, found, err := c.dal.GetPayTrace(payID)
+	if err != nil {
+		return "", nil, fmt.Errorf("GetPayTrace err: %w", err)
+	}
+	if !found {
+		return "", nil, common.ErrPayTraceNotFound
+	}
+	span.Node = c.EthAddress.Bytes()
+
+	var upstream, downstream []*rpc.PayTraceSpan
+	var wg sync.WaitGroup
+	query := func(hop ctype.Addr, toDest bool, ret *[]*rpc.PayTraceSpan) {
+		defer wg.Done()
+		spans, err2 := c.messager.QueryPayTrace(hop, payID, toDest, config.PayTraceQueryTimeout)
+		if err2 != nil {
+			log.Warnf("query pay %x trace from %x err: %s", payID, hop, err2)
+			return
+		}
+		*ret = spans
+	}
+	if prevHop := ctype.Bytes2Addr(span.GetPrevHop()); prevHop != ctype.ZeroAddr {
+		wg.Add(1)
+		go query(prevHop, false, &upstream)
+	}
+	if nextHop := ctype.Bytes2Addr(span.GetNextHop()); nextHop != ctype.ZeroAddr {
+		wg.Add(1)
+		go query(nextHop, true, &downstream)
+	}
+	wg.Wait()
+
+	spans := make([]*rpc.PayTraceSpan, 0, len(upstream)+len(downstream)+1)
+	for i := len(upstream) - 1; i >= 0; i-- {
+		spans = append(spans, upstream[i])
+	}
+	spans = append(spans, span)
+	spans = append(spans, downstream...)
+	return span.GetTraceId(), spans, nil
+}
+
+// runPayTracePruner periodically deletes the pay trace spans beyond the retention
+func (c *CNode) runPayTracePruner() {
+	ticker := time.NewTicker(config.PayTracePruneInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.quit:
+			return
+		case <-ticker.C:
+			err := c.dal.DeletePayTracesBefore(time.Now().Add(-config.PayTraceRetention))
+			if err != nil {
+				log.Warnln("DeletePayTracesBefore err:", err)
+			}
+		}
+	}
+}
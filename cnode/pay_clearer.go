@@ -0,0 +1,377 @@
+// Copyright 2020 Celer Network
+
+package cnode
+
+import (
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/celer-network/goCeler/common"
+	enums "github.com/celer-network/goCeler/common/structs"
+	"github.com/celer-network/goCeler/config"
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/entity"
+	"github.com/celer-network/goCeler/storage"
+	"github.com/celer-network/goCeler/utils"
+	"github.com/celer-network/goutils/log"
+)
+
+// Steps reported by the pay clearer
+const (
+	// cooperative settle request of an expired outgoing pay sent to the peer
+	PayClearStep_SETTLE_REQUESTED = "SETTLE_REQUESTED"
+	// settle proof of an expired incoming pay sent to the peer
+	PayClearStep_EXPIRE_PROOF_SENT = "EXPIRE_PROOF_SENT"
+	// incoming pay with revealed secret resolved on chain in the PayRegistry
+	PayClearStep_RESOLVED_ON_CHAIN = "RESOLVED_ON_CHAIN"
+	// settle proof of an on-chain resolved incoming pay sent to the peer
+	PayClearStep_SETTLE_PROOF_SENT = "SETTLE_PROOF_SENT"
+	// peer ignored the settlement, intend settle submitted for the channel
+	PayClearStep_INTEND_SETTLE = "INTEND_SETTLE"
+	// pay removed from the channel by the peer
+	PayClearStep_CLEARED = "CLEARED"
+)
+
+// PayClearCallback reports the progress of the pay clearer, ids are hex strings
+type PayClearCallback interface {
+	OnPayClearStep(payID string, cid string, step string)
+	OnError(payID string, err string)
+}
+
+type payClearer struct {
+	cb   PayClearCallback
+	dal  *storage.DAL
+	jobs map[ctype.PayIDType]*enums.PayClearJob // cache of the persisted jobs
+	quit chan bool
+}
+
+// StartPayClearer starts a client background job that watches the resolve deadlines of the
+// pending pays in all opened channels. Expired outgoing pays are settled cooperatively with the
+// peer, and incoming pays with revealed secret not paid by the peer close to the deadline are
+// resolved on chain. If the peer still keeps the pays pending after PayClearEscalateBlocks,
+// the channel is intend settled on chain. Each step is reported via the callback if not nil.
+// Channels with a client exit job are left to the exit job.
+// Progress is persisted so that the waits for the peer carry over restarts.
+func (c *CNode) StartPayClearer(cb PayClearCallback) {
+	c.payClearerLock.Lock()
+	defer c.payClearerLock.Unlock()
+	if c.payClearer != nil {
+		close(c.payClearer.quit)
+	}
+	p, err := newPayClearer(c.dal, cb)
+	if err != nil {
+		log.Errorln("pay clearer not started:", err)
+		return
+	}
+	c.payClearer = p
+	go c.runPayClearer(p)
+}
+
+// StopPayClearer stops the pay clearer job if started
+func (c *CNode) StopPayClearer() {
+	c.payClearerLock.Lock()
+	defer c.payClearerLock.Unlock()
+	if c.payClearer != nil {
+		close(c.payClearer.quit)
+		c.payClearer = nil
+	}
+}
+
+func (c *CNode) runPayClearer(p *payClearer) {
+	log.Infoln("start pay clearer, interval", config.PayClearInterval)
+	ticker := time.NewTicker(config.PayClearInterval)
+	defer ticker.Stop()
+	for {
+		c.clearPendingPays(p)
+		select {
+		case <-c.quit:
+			return
+		case <-p.quit:
+			log.Infoln("pay clearer stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *CNode) clearPendingPays(p *payClearer) {
+	cids, _, _, err := c.dal.GetCidPeerTokensByState(enums.ChanState_OPENED)
+	if err != nil {
+		log.Errorln("pay clearer, GetCidPeerTokensByState err:", err)
+		return
+	}
+	opened := make(map[ctype.CidType]bool)
+	scanned := make(map[ctype.CidType]bool)
+	pending := make(map[ctype.PayIDType]bool)
+	for _, cid := range cids {
+		opened[cid] = true
+		selfSimplex, _, peerSimplex, _, found, err := c.dal.GetDuplexChannel(cid)
+		if err != nil || !found {
+			log.Errorln("pay clearer, GetDuplexChannel", cid.Hex(), found, err)
+			continue
+		}
+		_, exiting, err := c.dal.GetExitJob(cid)
+		if err != nil {
+			log.Errorln("pay clearer, GetExitJob", cid.Hex(), err)
+			continue
+		}
+		if exiting {
+			// pending pays are resolved by the client exit job settling the channel
+			continue
+		}
+		scanned[cid] = true
+		blkNum := c.blockNumberOf(cid)
+		var escalated []ctype.PayIDType
+		for _, id := range selfSimplex.GetPendingPayIds().GetPayIds() {
+			payID := ctype.Bytes2PayID(id)
+			pending[payID] = true
+			if c.clearOutgoingPay(p, cid, payID, blkNum) {
+				escalated = append(escalated, payID)
+			}
+		}
+		for _, id := range peerSimplex.GetPendingPayIds().GetPayIds() {
+			payID := ctype.Bytes2PayID(id)
+			pending[payID] = true
+			if c.clearIncomingPay(p, cid, payID, blkNum) {
+				escalated = append(escalated, payID)
+			}
+		}
+		if len(escalated) > 0 {
+			log.Warnln("pay clearer, peer ignored settlement of", len(escalated), "pays, intend settle channel", cid.Hex())
+			err = c.IntendSettleOnce(cid)
+			if err != nil {
+				p.reportErr(escalated[0], err)
+				continue
+			}
+			for _, payID := range escalated {
+				p.setStep(payID, cid, PayClearStep_INTEND_SETTLE, blkNum)
+			}
+		}
+	}
+
+	p.dropJobs(opened, scanned, pending)
+}
+
+// clearOutgoingPay requests the peer to settle an expired pay in the self simplex.
+// Returns true if the peer ignored the request and the channel should be intend settled.
+func (c *CNode) clearOutgoingPay(p *payClearer, cid ctype.CidType, payID ctype.PayIDType, blkNum uint64) bool {
+	if job := p.jobs[payID]; job != nil {
+		return job.Step == PayClearStep_SETTLE_REQUESTED && blkNum >= job.BlkNum+config.PayClearEscalateBlocks
+	}
+	pay, found := p.getPay(c, payID)
+	if !found || blkNum <= pay.GetResolveDeadline()+config.PaySendTimeoutSafeMargin {
+		return false
+	}
+	// the pay may have been resolved on chain by the destination
+	amt, _, err := c.disputerOf(cid).GetCondPayInfoFromRegistry(payID)
+	if err != nil {
+		p.reportErr(payID, err)
+		return false
+	}
+	if amt.Cmp(utils.BytesToBigInt(pay.GetTransferFunc().GetMaxTransfer().GetReceiver().GetAmt())) == 0 {
+		err = c.sendSettleRequestForOnChainResolvedPays([]*entity.ConditionalPay{pay}, []*big.Int{amt})
+	} else {
+		err = c.sendSettleRequestForExpiredPays([]*entity.ConditionalPay{pay})
+	}
+	if err != nil {
+		p.reportErr(payID, err)
+		return false
+	}
+	p.setStep(payID, cid, PayClearStep_SETTLE_REQUESTED, blkNum)
+	return false
+}
+
+// clearIncomingPay settles a pay in the peer simplex. Pays with revealed secret are resolved
+// on chain if the peer has not paid them close to the resolve deadline, expired pays without
+// revealed secret are canceled. Returns true if the peer ignored the settle proof of an
+// on-chain resolved pay and the channel should be intend settled.
+func (c *CNode) clearIncomingPay(p *payClearer, cid ctype.CidType, payID ctype.PayIDType, blkNum uint64) bool {
+	job := p.jobs[payID]
+	if job != nil {
+		switch job.Step {
+		case PayClearStep_RESOLVED_ON_CHAIN:
+			// retry the settle proof failed to send
+			err := c.SettleOnChainResolvedPay(payID)
+			if err != nil {
+				p.reportErr(payID, err)
+				return false
+			}
+			p.setStep(payID, cid, PayClearStep_SETTLE_PROOF_SENT, blkNum)
+		case PayClearStep_SETTLE_PROOF_SENT:
+			return blkNum >= job.BlkNum+config.PayClearEscalateBlocks
+		}
+		return false
+	}
+
+	pay, found := p.getPay(c, payID)
+	if !found {
+		return false
+	}
+	inState, _ := c.GetPaymentState(payID)
+	if inState != enums.PayState_SECRET_REVEALED {
+		if blkNum > pay.GetResolveDeadline()+config.PaySendTimeoutSafeMargin {
+			err := c.sendSettleProofForExpiredPays([]ctype.PayIDType{payID})
+			if err != nil {
+				p.reportErr(payID, err)
+				return false
+			}
+			p.setStep(payID, cid, PayClearStep_EXPIRE_PROOF_SENT, blkNum)
+		}
+		return false
+	}
+	if blkNum+config.PayClearResolveMargin < pay.GetResolveDeadline() {
+		return false
+	}
+	resolved, err := c.resolveIncomingPay(cid, payID)
+	if err != nil {
+		p.reportErr(payID, err)
+		return false
+	}
+	if !resolved {
+		// too late to resolve on chain, the pay can only be canceled
+		err = c.sendSettleProofForExpiredPays([]ctype.PayIDType{payID})
+		if err != nil {
+			p.reportErr(payID, err)
+			return false
+		}
+		p.setStep(payID, cid, PayClearStep_EXPIRE_PROOF_SENT, blkNum)
+		return false
+	}
+	p.setStep(payID, cid, PayClearStep_RESOLVED_ON_CHAIN, blkNum)
+	err = c.SettleOnChainResolvedPay(payID)
+	if err != nil {
+		p.reportErr(payID, err)
+		return false
+	}
+	p.setStep(payID, cid, PayClearStep_SETTLE_PROOF_SENT, blkNum)
+	return false
+}
+
+// ResolveIncomingPays resolves the incoming pays with revealed secret of the channel on chain
+// so that they are counted in the settled balance. Pays already past the deadline are skipped.
+// Used by the client exit jobs before settling the channel.
+func (c *CNode) ResolveIncomingPays(cid ctype.CidType) error {
+	_, _, peerSimplex, _, found, err := c.dal.GetDuplexChannel(cid)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return common.ErrChannelNotFound
+	}
+	for _, id := range peerSimplex.GetPendingPayIds().GetPayIds() {
+		payID := ctype.Bytes2PayID(id)
+		inState, _ := c.GetPaymentState(payID)
+		if inState != enums.PayState_SECRET_REVEALED {
+			continue
+		}
+		resolved, err := c.resolveIncomingPay(cid, payID)
+		if err != nil {
+			return err
+		}
+		if !resolved {
+			log.Warnln("pay", payID.Hex(), "passed deadline before resolved on chain")
+		}
+	}
+	return nil
+}
+
+// resolveIncomingPay resolves an incoming pay with revealed secret in the PayRegistry,
+// it is a no-op if already resolved. Returns false if the resolve deadline has passed.
+func (c *CNode) resolveIncomingPay(cid ctype.CidType, payID ctype.PayIDType) (bool, error) {
+	err := c.disputerOf(cid).SettleConditionalPay(payID)
+	if errors.Is(err, common.ErrDeadlinePassed) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// IntendSettleOnce submits the intend settle tx of the channel unless it has been
+// submitted by the pay clearer, the client exit job or the peer.
+func (c *CNode) IntendSettleOnce(cid ctype.CidType) error {
+	c.intendSettleLock.Lock()
+	defer c.intendSettleLock.Unlock()
+	finalizeBlk, err := c.GetSettleFinalizedTime(cid)
+	if err != nil {
+		return err
+	}
+	if finalizeBlk.Sign() > 0 {
+		log.Infoln("channel", cid.Hex(), "already intend settled, finalize block", finalizeBlk)
+		return nil
+	}
+	return c.IntendSettlePaymentChannel(cid)
+}
+
+func newPayClearer(dal *storage.DAL, cb PayClearCallback) (*payClearer, error) {
+	jobs, err := dal.GetAllPayClearJobs()
+	if err != nil {
+		return nil, err
+	}
+	p := &payClearer{
+		cb:   cb,
+		dal:  dal,
+		jobs: make(map[ctype.PayIDType]*enums.PayClearJob),
+		quit: make(chan bool),
+	}
+	for _, job := range jobs {
+		p.jobs[job.PayID] = job
+	}
+	return p, nil
+}
+
+// dropJobs removes the jobs of pays no longer pending in the scanned channels, and the
+// jobs of channels no longer opened whose pays are cleared by the on-chain settlement.
+// Jobs of opened channels not scanned this round are kept.
+func (p *payClearer) dropJobs(
+	opened, scanned map[ctype.CidType]bool, pending map[ctype.PayIDType]bool) {
+	for payID, job := range p.jobs {
+		if opened[job.Cid] && (!scanned[job.Cid] || pending[payID]) {
+			continue
+		}
+		err := p.dal.DeletePayClearJob(payID)
+		if err != nil {
+			log.Errorln("pay clearer, DeletePayClearJob", payID.Hex(), err)
+			continue
+		}
+		delete(p.jobs, payID)
+		if scanned[job.Cid] && p.cb != nil {
+			p.cb.OnPayClearStep(ctype.PayID2Hex(payID), ctype.Cid2Hex(job.Cid), PayClearStep_CLEARED)
+		}
+	}
+}
+
+func (p *payClearer) getPay(c *CNode, payID ctype.PayIDType) (*entity.ConditionalPay, bool) {
+	pay, _, found, err := c.dal.GetPayment(payID)
+	if err != nil {
+		p.reportErr(payID, err)
+		return nil, false
+	}
+	if !found {
+		log.Warnln("pay clearer, pending pay not found", payID.Hex())
+	}
+	return pay, found
+}
+
+func (p *payClearer) setStep(payID ctype.PayIDType, cid ctype.CidType, step string, blkNum uint64) {
+	log.Infoln("pay clearer", step, "pay", payID.Hex(), "channel", cid.Hex())
+	job := &enums.PayClearJob{PayID: payID, Cid: cid, Step: step, BlkNum: blkNum}
+	p.jobs[payID] = job
+	err := p.dal.PutPayClearJob(job)
+	if err != nil {
+		// keep going with the cached job, only the wait for the peer restarts after restart
+		log.Errorln("pay clearer, PutPayClearJob", payID.Hex(), err)
+	}
+	if p.cb != nil {
+		p.cb.OnPayClearStep(ctype.PayID2Hex(payID), ctype.Cid2Hex(cid), step)
+	}
+}
+
+func (p *payClearer) reportErr(payID ctype.PayIDType, err error) {
+	log.Errorln("pay clearer, pay", payID.Hex(), err)
+	if p.cb != nil {
+		p.cb.OnError(ctype.PayID2Hex(payID), err.Error())
+	}
+}
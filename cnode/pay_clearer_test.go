@@ -0,0 +1,103 @@
+// Copyright 2020 Celer Network
+
+package cnode
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/celer-network/goCeler/config"
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/storage"
+)
+
+type testPayClearCallback struct {
+	steps map[string]string
+}
+
+func (cb *testPayClearCallback) OnPayClearStep(payID string, cid string, step string) {
+	cb.steps[payID] = step
+}
+
+func (cb *testPayClearCallback) OnError(payID string, err string) {}
+
+func TestPayClearerJobs(t *testing.T) {
+	stFile := filepath.Join(os.TempDir(), "cnode_pay_clearer_test.db")
+	os.Remove(stFile)
+	st, err := storage.NewKVStoreSQL("sqlite3", stFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(stFile)
+	defer st.Close()
+	dal := storage.NewDAL(st)
+	c := &CNode{dal: dal}
+	defer func(blocks uint64) { config.PayClearEscalateBlocks = blocks }(config.PayClearEscalateBlocks)
+	config.PayClearEscalateBlocks = 50
+
+	cid := ctype.Hex2Cid("abcdef")
+	closedCid := ctype.Hex2Cid("abcdf0")
+	outPay, inPay := ctype.Hex2PayID("a01"), ctype.Hex2PayID("a02")
+	clearedPay, closedPay := ctype.Hex2PayID("a03"), ctype.Hex2PayID("a04")
+
+	// steps are persisted, nil callback is allowed
+	p, err := newPayClearer(dal, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.setStep(outPay, cid, PayClearStep_SETTLE_REQUESTED, 100)
+	p.setStep(inPay, cid, PayClearStep_SETTLE_PROOF_SENT, 120)
+	p.setStep(clearedPay, cid, PayClearStep_EXPIRE_PROOF_SENT, 100)
+	p.setStep(closedPay, closedCid, PayClearStep_SETTLE_REQUESTED, 100)
+	p.reportErr(outPay, os.ErrNotExist)
+
+	// wait for the peer carries over restart
+	cb := &testPayClearCallback{steps: make(map[string]string)}
+	p, err = newPayClearer(dal, cb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.jobs) != 4 || p.jobs[inPay].Step != PayClearStep_SETTLE_PROOF_SENT || p.jobs[inPay].BlkNum != 120 {
+		t.Fatalf("wrong jobs loaded: %v", p.jobs)
+	}
+	if c.clearOutgoingPay(p, cid, outPay, 149) {
+		t.Error("outgoing pay escalated before PayClearEscalateBlocks")
+	}
+	if !c.clearOutgoingPay(p, cid, outPay, 150) {
+		t.Error("outgoing pay not escalated after PayClearEscalateBlocks")
+	}
+	if c.clearIncomingPay(p, cid, inPay, 169) {
+		t.Error("incoming pay escalated before PayClearEscalateBlocks")
+	}
+	if !c.clearIncomingPay(p, cid, inPay, 170) {
+		t.Error("incoming pay not escalated after PayClearEscalateBlocks")
+	}
+	if c.clearIncomingPay(p, cid, clearedPay, 500) {
+		t.Error("expired pay escalated")
+	}
+
+	// jobs of channels opened but not scanned are kept
+	opened := map[ctype.CidType]bool{cid: true}
+	pending := map[ctype.PayIDType]bool{outPay: true, inPay: true}
+	p.dropJobs(opened, map[ctype.CidType]bool{}, pending)
+	if len(p.jobs) != 3 || p.jobs[closedPay] != nil {
+		t.Fatalf("wrong jobs after dropping closed channel: %v", p.jobs)
+	}
+	p.dropJobs(opened, opened, pending)
+	if len(p.jobs) != 2 || p.jobs[clearedPay] != nil {
+		t.Fatalf("wrong jobs after dropping cleared pay: %v", p.jobs)
+	}
+	if len(cb.steps) != 1 || cb.steps[ctype.PayID2Hex(clearedPay)] != PayClearStep_CLEARED {
+		t.Errorf("wrong steps reported: %v", cb.steps)
+	}
+	jobs, err := dal.GetAllPayClearJobs()
+	if err != nil || len(jobs) != 2 {
+		t.Errorf("wrong persisted jobs: %v %v", jobs, err)
+	}
+	for _, job := range jobs {
+		if job.Cid != cid || job.Step == PayClearStep_CLEARED {
+			t.Errorf("wrong persisted job: %+v", job)
+		}
+	}
+}
@@ -0,0 +1,244 @@
+// Copyright 2020 Celer Network
+
+package cnode
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/celer-network/goCeler/common/structs"
+	"github.com/celer-network/goCeler/config"
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/entity"
+	"github.com/celer-network/goCeler/rpc"
+	"github.com/celer-network/goCeler/rtconfig"
+	"github.com/celer-network/goCeler/utils"
+	"github.com/celer-network/goutils/log"
+)
+
+// rebalanceChannel is a peer osp channel considered by the rebalancer
+type rebalanceChannel struct {
+	peer   ctype.Addr
+	myFree *big.Int
+	total  *big.Int
+	ratio  float64 // my share of the free balance
+}
+
+// excess returns the amount above or below an even split, negative if drained
+func (ch *rebalanceChannel) excess() *big.Int {
+	half := new(big.Int).Rsh(ch.total, 1)
+	return new(big.Int).Sub(ch.myFree, half)
+}
+
+// runRebalancer periodically rebalances peer osp channels according to the rebalance rtconfig
+func (c *CNode) runRebalancer() {
+	for {
+		wait := config.RebalanceIdleInterval
+		if intervalS := rtconfig.GetRebalanceConfigs().GetIntervalS(); intervalS > 0 {
+			wait = time.Duration(intervalS) * time.Second
+		}
+		select {
+		case <-c.quit:
+			return
+		case <-time.After(wait):
+			if rtconfig.GetRebalanceConfigs().GetIntervalS() == 0 {
+				continue
+			}
+			plans, err := c.Rebalance(nil, rtconfig.GetRebalanceConfigs().GetDryRun())
+			if err != nil {
+				log.Errorln("rebalance err:", err)
+			}
+			for _, plan := range plans {
+				log.Infof("rebalance token %s amt %s fee %s route %v, dry run %t, pay %s, err: %s", plan.GetTokenAddress(),
+					plan.GetAmtWei(), plan.GetFeeWei(), plan.GetRoute(), rtconfig.GetRebalanceConfigs().GetDryRun(),
+					plan.GetPayId(), plan.GetError())
+			}
+		}
+	}
+}
+
+// Rebalance refills drained peer osp channels by sending self pays out through channels with excess
+// balance, around a cycle of peer osps back through the drained channel. Only tokens with rebalance
+// configs are handled, all of them if tokenAddrs is empty. In dry run, the plans are returned without
+// sending pays. Rebalance pays are limited by the max amount and the daily budget of each token, and
+// the relay fees advertised by the osps on the cycle are limited by the daily fee budget. The spent
+// budgets are persisted so that a restart does not reset them.
+func (c *CNode) Rebalance(tokenAddrs []ctype.Addr, dryRun bool) ([]*rpc.RebalancePlan, error) {
+	if c.routeController == nil {
+		return nil, errors.New("route controller not initialized")
+	}
+	c.rebalanceLock.Lock()
+	defer c.rebalanceLock.Unlock()
+
+	configs := rtconfig.GetRebalanceConfigs().GetConfig()
+	if len(tokenAddrs) == 0 {
+		for tokenAddrStr := range configs {
+			tokenAddrs = append(tokenAddrs, ctype.Hex2Addr(tokenAddrStr))
+		}
+	}
+	var plans []*rpc.RebalancePlan
+	for _, tokenAddr := range tokenAddrs {
+		tokenAddrStr := ctype.Addr2Hex(tokenAddr)
+		cfg, ok := configs[tokenAddrStr]
+		if !ok {
+			log.Warnln("no rebalance config for token", tokenAddrStr)
+			continue
+		}
+		tokenPlans, err := c.rebalanceToken(tokenAddr, cfg, dryRun)
+		plans = append(plans, tokenPlans...)
+		if err != nil {
+			return plans, err
+		}
+	}
+	return plans, nil
+}
+
+// getRebalanceBudget returns the persisted budget of the token spent today
+func (c *CNode) getRebalanceBudget(tokenAddr ctype.Addr) (*structs.RebalanceBudget, error) {
+	today := time.Now().UTC().Unix() / 86400
+	budget, found, err := c.dal.GetRebalanceBudget(tokenAddr)
+	if err != nil {
+		return nil, fmt.Errorf("GetRebalanceBudget err: %w", err)
+	}
+	if !found || budget.Day != today {
+		budget = &structs.RebalanceBudget{Token: tokenAddr, Day: today, Spent: big.NewInt(0), FeeSpent: big.NewInt(0)}
+	}
+	return budget, nil
+}
+
+func (c *CNode) rebalanceToken(
+	tokenAddr ctype.Addr, cfg *rtconfig.RebalanceConfig, dryRun bool) ([]*rpc.RebalancePlan, error) {
+	tokenAddrStr := ctype.Addr2Hex(tokenAddr)
+	maxAmount, dailyBudget := rtconfig.GetRebalanceAmountLimits(tokenAddrStr)
+	feeBudget := rtconfig.GetRebalanceFeeBudget(tokenAddrStr)
+	budget, err := c.getRebalanceBudget(tokenAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	var drained, sources []*rebalanceChannel
+	for peer, neighbor := range c.GetPeerOsps() {
+		cid, ok := neighbor.TokenCids[tokenAddr]
+		if !ok {
+			continue
+		}
+		balance, err := c.GetBalance(cid)
+		if err != nil {
+			log.Warnf("rebalance, cid %x GetBalance err: %s", cid, err)
+			continue
+		}
+		total := new(big.Int).Add(balance.MyFree, balance.PeerFree)
+		if total.Sign() == 0 {
+			continue
+		}
+		ratio, _ := new(big.Float).Quo(new(big.Float).SetInt(balance.MyFree), new(big.Float).SetInt(total)).Float64()
+		ch := &rebalanceChannel{peer: peer, myFree: balance.MyFree, total: total, ratio: ratio}
+		if ratio < cfg.GetLowRatio() {
+			drained = append(drained, ch)
+		} else if ratio > cfg.GetHighRatio() {
+			sources = append(sources, ch)
+		}
+	}
+	sort.Slice(drained, func(i, j int) bool { return drained[i].ratio < drained[j].ratio })
+	sort.Slice(sources, func(i, j int) bool { return sources[i].ratio > sources[j].ratio })
+
+	// dry run plans against a copy of the spent amounts
+	spent := new(big.Int).Set(budget.Spent)
+	feeSpent := new(big.Int).Set(budget.FeeSpent)
+	maxHops := int(rtconfig.GetRebalanceMaxHops())
+	var plans []*rpc.RebalancePlan
+	for _, target := range drained {
+		need := new(big.Int).Neg(target.excess())
+		for _, source := range sources {
+			amt := minBigInt(need, source.excess(), maxAmount, new(big.Int).Sub(dailyBudget, spent))
+			if amt.Sign() <= 0 {
+				continue
+			}
+			path := c.routeController.FindRebalancePath(tokenAddr, source.peer, target.peer, amt, maxHops)
+			if path == nil {
+				continue
+			}
+			fee, err := c.routeController.GetRebalancePathFee(tokenAddr, path, amt)
+			if err != nil {
+				log.Warnln("rebalance path fee err:", err)
+				continue
+			}
+			if new(big.Int).Add(feeSpent, fee).Cmp(feeBudget) > 0 {
+				log.Debugf("rebalance fee %s exceeds the remaining fee budget of token %s", fee, tokenAddrStr)
+				continue
+			}
+			plan := &rpc.RebalancePlan{
+				TokenAddress: tokenAddrStr,
+				AmtWei:       amt.String(),
+				DrainedRatio: target.ratio,
+				FeeWei:       fee.String(),
+			}
+			route := make([][]byte, 0, len(path)+1)
+			for _, osp := range path {
+				plan.Route = append(plan.Route, ctype.Addr2Hex(osp))
+				route = append(route, osp.Bytes())
+			}
+			route = append(route, c.EthAddress.Bytes())
+			if !dryRun {
+				payID, err := c.sendRebalancePay(tokenAddr, amt, fee, route)
+				if err != nil {
+					plan.Error = err.Error()
+				} else {
+					plan.PayId = ctype.PayID2Hex(payID)
+				}
+			}
+			plans = append(plans, plan)
+			if plan.Error == "" {
+				source.myFree = new(big.Int).Sub(source.myFree, amt)
+				spent.Add(spent, amt)
+				feeSpent.Add(feeSpent, fee)
+			}
+			break
+		}
+	}
+	if !dryRun && spent.Cmp(budget.Spent) != 0 {
+		budget.Spent = spent
+		budget.FeeSpent = feeSpent
+		err = c.dal.PutRebalanceBudget(budget)
+		if err != nil {
+			return plans, fmt.Errorf("PutRebalanceBudget err: %w", err)
+		}
+	}
+	return plans, nil
+}
+
+// sendRebalancePay sends a self pay with the relay fee through the source route, which starts from
+// the peer of the channel to send the pay and ends with myself
+func (c *CNode) sendRebalancePay(
+	tokenAddr ctype.Addr, amt, fee *big.Int, route [][]byte) (ctype.PayIDType, error) {
+	pay := &entity.ConditionalPay{
+		Src:  c.EthAddress.Bytes(),
+		Dest: c.EthAddress.Bytes(),
+		TransferFunc: &entity.TransferFunction{
+			LogicType: entity.TransferFunctionType_BOOLEAN_AND,
+			MaxTransfer: &entity.TokenTransfer{
+				Token: utils.GetTokenInfoFromAddress(tokenAddr),
+				Receiver: &entity.AccountAmtPair{
+					Account: c.EthAddress.Bytes(),
+					Amt:     amt.Bytes(),
+				},
+			},
+		},
+		ResolveDeadline: c.GetCurrentBlockNumber().Uint64() + config.AdminSendTokenTimeout,
+		ResolveTimeout:  config.PayResolveTimeout,
+	}
+	return c.addBooleanPay(pay, nil, 0, route, fee)
+}
+
+func minBigInt(x *big.Int, ys ...*big.Int) *big.Int {
+	min := x
+	for _, y := range ys {
+		if y.Cmp(min) < 0 {
+			min = y
+		}
+	}
+	return min
+}
@@ -0,0 +1,28 @@
+// Copyright 2020 Celer Network
+
+package cnode
+
+import (
+	"github.com/celer-network/goCeler/common/event"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// registerReorgRollbacks sets how to revert the local state derived from each
+// tracked event when its log is removed by a chain reorg
+func (c *CNode) registerReorgRollbacks() {
+	c.reorgTracker.RegisterRollback(event.Deposit, func(eLog *types.Log) error {
+		return c.depositProcessor.RollbackEvent(eLog)
+	})
+	c.reorgTracker.RegisterRollback(event.CooperativeWithdraw, func(eLog *types.Log) error {
+		return c.cooperativeWithdrawProcessor.RollbackEvent(eLog)
+	})
+	c.reorgTracker.RegisterRollback(event.IntendSettle, func(eLog *types.Log) error {
+		return c.Disputer.RollbackIntendSettleEvent(eLog)
+	})
+	c.reorgTracker.RegisterRollback(event.RouterUpdated, func(eLog *types.Log) error {
+		if c.routeController == nil {
+			return nil
+		}
+		return c.routeController.RollbackRouterUpdatedEvent(eLog)
+	})
+}
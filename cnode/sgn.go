@@ -87,7 +87,10 @@ func (c *CNode) RequestSgnGuardState(cid ctype.CidType) error {
 		return err
 	}
 
-	myPeerSimplexSig := c.SignState(sigSortedPeerSimplexStateBytes)
+	myPeerSimplexSig, err := c.SignState(sigSortedPeerSimplexStateBytes)
+	if err != nil {
+		return err
+	}
 
 	resp, err := grequests.Post(
 		c.sgnGw+"/guard/requestGuard",
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/celer-network/goCeler/common"
+	"github.com/celer-network/goCeler/common/intfs"
 	"github.com/celer-network/goCeler/config"
 	"github.com/celer-network/goCeler/ctype"
 	"github.com/celer-network/goCeler/ledgerview"
@@ -29,7 +30,7 @@ func (c *CNode) GetChannelIdForPeer(peer, tokenAddr ctype.Addr) (ctype.CidType,
 }
 
 func (c *CNode) GetBalance(cid ctype.CidType) (*common.ChannelBalance, error) {
-	blkNum := c.GetCurrentBlockNumber().Uint64()
+	blkNum := c.blockNumberOf(cid)
 	return ledgerview.GetBalance(c.dal, cid, c.nodeConfig.GetOnChainAddr(), blkNum)
 }
 
@@ -83,8 +84,22 @@ func (c *CNode) keepAliveEventListener() {
 	}
 }
 
-// SignState signs the data using cnode crypto and return result
-func (c *CNode) SignState(in []byte) []byte {
-	ret, _ := c.signer.SignEthMessage(in)
-	return ret
+// SignState signs the simplex state bytes using cnode crypto and return result
+func (c *CNode) SignState(in []byte) ([]byte, error) {
+	return utils.SignEthMessageOfKind(c.signer, intfs.SignKindSimplexState, in)
+}
+
+// SignAuthTs signs the auth timestamp bytes using cnode crypto, returns nil on error
+func (c *CNode) SignAuthTs(in []byte) []byte {
+	sig, err := utils.SignEthMessageOfKind(c.signer, intfs.SignKindAuthTs, in)
+	if err != nil {
+		log.Error(err)
+		return nil
+	}
+	return sig
+}
+
+// SignAppState signs the app session state bytes using cnode crypto and return result
+func (c *CNode) SignAppState(in []byte) ([]byte, error) {
+	return utils.SignEthMessageOfKind(c.signer, intfs.SignKindAppState, in)
 }
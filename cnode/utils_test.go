@@ -0,0 +1,46 @@
+// Copyright 2020 Celer Network
+
+package cnode
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/celer-network/goCeler/common/intfs"
+	"github.com/celer-network/goutils/eth"
+)
+
+// testKindSigner records the kinds of signed messages, and refuses the refused kind
+type testKindSigner struct {
+	eth.Signer
+	kinds   []string
+	refused string
+}
+
+func (s *testKindSigner) SignEthMessageOfKind(kind string, data []byte) ([]byte, error) {
+	if kind == s.refused {
+		return nil, errors.New("kind refused")
+	}
+	s.kinds = append(s.kinds, kind)
+	return data, nil
+}
+
+func TestSignKinds(t *testing.T) {
+	signer := &testKindSigner{refused: intfs.SignKindAppState}
+	c := &CNode{signer: signer}
+	_, err := c.SignState([]byte{1})
+	if err != nil {
+		t.Error(err)
+	}
+	if c.SignAuthTs([]byte{2}) == nil {
+		t.Error("auth ts not signed")
+	}
+	_, err = c.SignAppState([]byte{3})
+	if err == nil {
+		t.Error("refused kind signed")
+	}
+	if len(signer.kinds) != 2 ||
+		signer.kinds[0] != intfs.SignKindSimplexState || signer.kinds[1] != intfs.SignKindAuthTs {
+		t.Errorf("wrong signed kinds %v", signer.kinds)
+	}
+}
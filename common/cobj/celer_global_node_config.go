@@ -29,6 +29,7 @@ type CelerGlobalNodeConfig struct {
 	ledgers                map[ctype.Addr]chain.Contract
 	chanDAL                chanLedgerDAL
 	checkInterval          map[string]uint64 // copy from CProfile
+	chainId                uint64            // 0 for the primary chain of the node
 }
 
 func NewCelerGlobalNodeConfig(
@@ -152,3 +153,14 @@ func (config *CelerGlobalNodeConfig) GetLedgerContractOf(cid ctype.CidType) chai
 func (cfg *CelerGlobalNodeConfig) GetCheckInterval(eventName string) uint64 {
 	return cfg.checkInterval[eventName]
 }
+
+// SetChainId sets the chain id of a node config of an extra chain of a multi-chain node
+func (cfg *CelerGlobalNodeConfig) SetChainId(chainId uint64) {
+	cfg.chainId = chainId
+}
+
+// GetChainId returns the chain id keying the channels of this chain in storage,
+// which is 0 for the primary chain of the node.
+func (cfg *CelerGlobalNodeConfig) GetChainId() uint64 {
+	return cfg.chainId
+}
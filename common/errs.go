@@ -20,6 +20,8 @@ var (
 	ErrPaySrcMismatch              = errors.New("pay src and self mismatch")
 	ErrSimplexParse                = errors.New("cannot parse simplex state")
 	ErrRateLimited                 = errors.New("rate limited, please try again later")
+	ErrDraining                    = errors.New("node draining, please try again later")
+	ErrMsgQueueFull                = errors.New("too many unacked messages to the peer")
 	ErrInvalidSig                  = errors.New("invalid signature")
 	ErrInvalidSeqNum               = errors.New("invalid sequence number")
 	ErrInvalidPendingPays          = errors.New("invalid pending pay list")
@@ -47,13 +49,16 @@ var (
 	ErrPayOffChainResolved         = errors.New("pay already offchain resolved")
 	ErrPayAlreadyPending           = errors.New("pay already exists in pending pay list")
 	ErrPayRouteLoop                = errors.New("pay route loop")
+	ErrInsufficientFee             = errors.New("relay fee not enough")
 	ErrInvalidPaySrc               = errors.New("invalid pay source")
 	ErrInvalidPayDst               = errors.New("invalid pay destination")
+	ErrInvalidPayRoute             = errors.New("invalid pay source route")
 	ErrRouteNotFound               = errors.New("no route to destination")
 	ErrPeerNotOnline               = errors.New("peer not online")
 	ErrPeerNotFound                = errors.New("no peer found")
 	ErrSimplexStateNotFound        = errors.New("channel simplex state not found")
 	ErrChannelNotFound             = errors.New("channel not found")
+	ErrHashSignUnsupported         = errors.New("signer does not support hash signing")
 	ErrInvalidChannelState         = errors.New("invalid channel state")
 	ErrNoCelerStream               = errors.New("no celer stream")
 	ErrStreamAleadyExists          = errors.New("celer stream already exists")
@@ -64,6 +69,10 @@ var (
 	ErrLeaseAcquired               = errors.New("lease acquired by others")
 	ErrPendingRefill               = errors.New("pending channel refill job")
 	ErrDepositNotFound             = errors.New("deposit job not found")
+	ErrPayTraceNotFound            = errors.New("pay trace not found")
+	ErrPayTraceTimeout             = errors.New("timeout waiting for pay trace response")
+	ErrChannelExists               = errors.New("channel already exists")
+	ErrOpenChannelTimeout          = errors.New("timeout waiting for channel open")
 )
 
 type E struct {
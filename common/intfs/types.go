@@ -8,7 +8,10 @@ package intfs
 import (
 	"math/big"
 
+	"github.com/celer-network/goutils/eth"
 	"github.com/celer-network/goutils/eth/monitor"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 )
 
@@ -20,3 +23,49 @@ type MonitorService interface {
 	RemoveEvent(id monitor.CallbackID)
 	Close()
 }
+
+// Transactor sends on-chain transactions from a single account
+type Transactor interface {
+	Transact(handler *eth.TransactionStateHandler, method eth.TxMethod, opts ...eth.TxOption) (*types.Transaction, error)
+	TransactWaitMined(description string, method eth.TxMethod, opts ...eth.TxOption) (*types.Receipt, error)
+	WaitMined(txHash string, opts ...eth.TxOption) (*types.Receipt, error)
+	ContractCaller() bind.ContractCaller
+	Address() common.Address
+}
+
+// HashSigner is implemented by signers that can sign a 32-byte hash as is, without the
+// eth message prefix, e.g., the EIP-712 typed data hash of an ERC20 permit.
+// The signature is in the R,S,V format with V of 0 or 1.
+type HashSigner interface {
+	SignHash(hash []byte) ([]byte, error)
+}
+
+// Kinds of messages signed by a celer node, declared to a KindSigner
+const (
+	SignKindSimplexState       = "simplex_state"       // co-signed simplex channel state
+	SignKindChannelInitializer = "channel_initializer" // channel open request
+	SignKindCondPay            = "cond_pay"            // conditional pay receipt
+	SignKindPayHop             = "pay_hop"             // pay path hop
+	SignKindWithdrawInfo       = "withdraw_info"       // cooperative withdraw
+	SignKindMigrationInfo      = "migration_info"      // channel migration
+	SignKindRoutingUpdate      = "routing_update"      // OSP routing broadcast
+	SignKindOspInfo            = "osp_info"            // OSP explorer report
+	SignKindDelegation         = "delegation"          // delegation description
+	SignKindAppState           = "app_state"           // app session state
+	SignKindAuthTs             = "auth_ts"             // peer authentication timestamp
+	SignKindSecret             = "secret"              // hash lock secret reveal
+)
+
+// KindSigner is implemented by signers that only sign messages of the declared kinds,
+// e.g., the remote signer client. The kind is one of the SignKind constants.
+type KindSigner interface {
+	SignEthMessageOfKind(kind string, data []byte) ([]byte, error)
+}
+
+// TransactorPool sends on-chain transactions from a pool of accounts
+type TransactorPool interface {
+	Submit(handler *eth.TransactionStateHandler, method eth.TxMethod, opts ...eth.TxOption) (*types.Transaction, error)
+	SubmitWaitMined(description string, method eth.TxMethod, opts ...eth.TxOption) (*types.Receipt, error)
+	WaitMined(txHash string, opts ...eth.TxOption) (*types.Receipt, error)
+	ContractCaller() bind.ContractCaller
+}
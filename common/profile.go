@@ -20,6 +20,10 @@ type ProfileJSON struct {
 	Ethereum ProfileEthereum
 	Osp      ProfileOsp
 	Sgn      ProfileSgn
+	// attach trace ID to pays sent from this node so all hops record their spans
+	PayTrace bool
+	// blocks the client pay clearer waits for the peer before disputing on chain, 0 for default
+	PayClearEscalateBlocks uint64
 }
 
 type ProfileEthereum struct {
@@ -49,25 +53,27 @@ type ProfileSgn struct {
 
 func (pj *ProfileJSON) ToCProfile() *CProfile {
 	cp := &CProfile{
-		ChainId:            int64(pj.Ethereum.ChainId),
-		ETHInstance:        pj.Ethereum.Gateway,
-		BlockDelayNum:      pj.Ethereum.BlockDelayNum,
-		PollingInterval:    pj.Ethereum.BlockIntervalSec,
-		DisputeTimeout:     pj.Ethereum.DisputeTimeout,
-		WalletAddr:         pj.Ethereum.Contracts.Wallet,
-		LedgerAddr:         pj.Ethereum.Contracts.Ledger,
-		VirtResolverAddr:   pj.Ethereum.Contracts.VirtResolver,
-		EthPoolAddr:        pj.Ethereum.Contracts.EthPool,
-		PayResolverAddr:    pj.Ethereum.Contracts.PayResolver,
-		PayRegistryAddr:    pj.Ethereum.Contracts.PayRegistry,
-		RouterRegistryAddr: pj.Ethereum.Contracts.RouterRegistry,
-		Ledgers:            pj.Ethereum.Contracts.Ledgers,
-		SvrETHAddr:         pj.Osp.Address,
-		SvrRPC:             pj.Osp.Host,
-		ExplorerUrl:        pj.Osp.ExplorerUrl,
-		CheckInterval:      pj.Ethereum.CheckInterval, // json.Unmarshal guarantee non-nil map (could be empty)
-		SgnGateway:         pj.Sgn.Gateway,            // json.Unmarshal guarantee non-nil map (could be empty)
-		SgnContractAddr:    pj.Sgn.SgnContractAddr,    // json.Unmarshal guarantee non-nil map (could be empty)
+		ChainId:                int64(pj.Ethereum.ChainId),
+		ETHInstance:            pj.Ethereum.Gateway,
+		BlockDelayNum:          pj.Ethereum.BlockDelayNum,
+		PollingInterval:        pj.Ethereum.BlockIntervalSec,
+		DisputeTimeout:         pj.Ethereum.DisputeTimeout,
+		WalletAddr:             pj.Ethereum.Contracts.Wallet,
+		LedgerAddr:             pj.Ethereum.Contracts.Ledger,
+		VirtResolverAddr:       pj.Ethereum.Contracts.VirtResolver,
+		EthPoolAddr:            pj.Ethereum.Contracts.EthPool,
+		PayResolverAddr:        pj.Ethereum.Contracts.PayResolver,
+		PayRegistryAddr:        pj.Ethereum.Contracts.PayRegistry,
+		RouterRegistryAddr:     pj.Ethereum.Contracts.RouterRegistry,
+		Ledgers:                pj.Ethereum.Contracts.Ledgers,
+		SvrETHAddr:             pj.Osp.Address,
+		SvrRPC:                 pj.Osp.Host,
+		ExplorerUrl:            pj.Osp.ExplorerUrl,
+		CheckInterval:          pj.Ethereum.CheckInterval, // json.Unmarshal guarantee non-nil map (could be empty)
+		SgnGateway:             pj.Sgn.Gateway,            // json.Unmarshal guarantee non-nil map (could be empty)
+		SgnContractAddr:        pj.Sgn.SgnContractAddr,    // json.Unmarshal guarantee non-nil map (could be empty)
+		PayTrace:               pj.PayTrace,
+		PayClearEscalateBlocks: pj.PayClearEscalateBlocks,
 	}
 	return cp
 }
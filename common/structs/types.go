@@ -71,6 +71,17 @@ const (
 	CrossNetPay_DST     int = 2
 	CrossNetPay_INGRESS int = 3
 	CrossNetPay_EGRESS  int = 4
+
+	TxState_NULL    int = 0
+	TxState_PENDING int = 1
+	TxState_MINED   int = 2
+	TxState_DROPPED int = 3
+
+	ExitState_NULL             int = 0
+	ExitState_RESOLVING_PAYS   int = 1
+	ExitState_INTEND_SETTLING  int = 2
+	ExitState_WAITING_FINALIZE int = 3
+	ExitState_SETTLED          int = 4
 )
 
 type DepositJob struct {
@@ -85,6 +96,113 @@ type DepositJob struct {
 	ErrMsg   string
 }
 
+// TxRecord is an on-chain transaction tracked by the tx manager,
+// replacements broadcast with higher gas price share the same record
+type TxRecord struct {
+	TxHash      string // hash of the first broadcast
+	ChainId     uint64 // 0 for the primary chain of the node
+	Sender      ctype.Addr
+	Nonce       uint64
+	State       int
+	RawTx       []byte   // latest broadcast signed tx
+	Hashes      []string // all broadcast hashes, oldest first
+	Description string
+	CreateTs    time.Time
+	UpdateTs    time.Time // time of the latest broadcast
+}
+
+// EventLogRecord is a processed on-chain event log kept for reorg detection
+// until it is deeper than the reorg tracking window
+type EventLogRecord struct {
+	BlkHash  string // hash of the block that included the log when processed
+	LogIndex uint
+	ChainId  uint64 // 0 for the primary chain of the node
+	BlkNum   uint64
+	Event    string
+	TxHash   string
+	RawLog   []byte // json encoded types.Log
+}
+
+// AppSession is the persisted form of an app channel (generalized state channel)
+type AppSession struct {
+	ID             string
+	Type           int // entity.ConditionType
+	Nonce          uint64
+	ByteCode       []byte       // only for virtual contract
+	Constructor    []byte       // only for virtual contract
+	Players        []ctype.Addr // only for deployed contract
+	DeployedAddr   ctype.Addr
+	OnChainTimeout uint64
+	SeqNum         uint64 // seq num of the latest co-signed state
+	StateProof     []byte // latest co-signed app state proof
+	WatchBlock     uint64 // start block of the on-chain dispute watch, 0 if not watching
+	CreateTs       time.Time
+}
+
+// PayHistoryItem is a pay sent or received by a client, indexed in the client store
+type PayHistoryItem struct {
+	PayID    ctype.PayIDType
+	Src      ctype.Addr
+	Dest     ctype.Addr
+	Token    ctype.Addr
+	Amt      *big.Int
+	Status   int // celersdkintf.PAY_STATUS_*
+	CreateTs time.Time
+	UpdateTs time.Time
+}
+
+// PayHistoryFilter selects pay history items, zero value fields match all
+type PayHistoryFilter struct {
+	Counterparty ctype.Addr  // src or dest of the pay
+	Token        *ctype.Addr // nil for all tokens, zero addr for ETH
+	Status       int
+	Since        time.Time // inclusive
+	Until        time.Time // exclusive
+	Limit        int
+}
+
+// AcctPosting is one side of an accounting entry, debits are positive and credits negative
+type AcctPosting struct {
+	Account string
+	Token   ctype.Addr // zero addr for ETH
+	Amt     *big.Int
+}
+
+// AcctRecord is a double-entry accounting entry, postings of each token sum to zero
+type AcctRecord struct {
+	EntryID  string // deterministic id so that recording the same event again is a no-op
+	Memo     string
+	Ts       time.Time
+	Postings []*AcctPosting
+}
+
+// ExitJob tracks the on-chain settlement of a client channel started by the exit policy
+type ExitJob struct {
+	Cid         ctype.CidType
+	Reason      string
+	State       int
+	FinalizeBlk uint64 // block number after which the settle can be confirmed, 0 if not intended
+	ErrMsg      string // last error of the current step
+	CreateTs    time.Time
+	UpdateTs    time.Time
+}
+
+// PayClearJob is the last step taken by the client pay clearer to clear a pending pay
+type PayClearJob struct {
+	PayID  ctype.PayIDType
+	Cid    ctype.CidType
+	Step   string
+	BlkNum uint64 // block number when the step was taken
+}
+
+// RebalanceBudget is the amount and relay fees of rebalance pays sent on a token in one UTC day
+type RebalanceBudget struct {
+	Token    ctype.Addr
+	Day      int64 // days since epoch
+	Spent    *big.Int
+	FeeSpent *big.Int
+}
+
 type CooperativeWithdrawState int
 
 const (
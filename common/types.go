@@ -10,6 +10,7 @@ import (
 	"github.com/celer-network/goCeler/pem"
 	"github.com/celer-network/goCeler/rpc"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"go.opencensus.io/trace"
 )
 
 // CProfile contains configurations for CelerClient/OSP
@@ -40,6 +41,20 @@ type CProfile struct {
 	CheckInterval      map[string]uint64 `json:"checkInterval,omitempty"`
 	SgnGateway         string            `json:"sgnGateway"`
 	SgnContractAddr    string            `json:"sgnAddr"`
+	PayTrace           bool              `json:"payTrace,omitempty"`
+	ExitPolicy         *ExitPolicy       `json:"exitPolicy,omitempty"`
+	// blocks the client pay clearer waits for the peer before disputing on chain, 0 for default
+	PayClearEscalateBlocks uint64 `json:"payClearEscalateBlocks,omitempty"`
+}
+
+// ExitPolicy of a client to settle its channels on chain when the OSP becomes unresponsive
+type ExitPolicy struct {
+	// exit all channels after no successful RegisterStream for the hours the client was running,
+	// the time the client is not running is not counted, 0 to disable
+	OfflineHours uint64 `json:"offlineHours,omitempty"`
+	// exit a channel after the OSP NACKed its messages as invalid the times since connected,
+	// retryable NACKs such as rate limited or no route are not counted, 0 to disable
+	MaxNacks uint64 `json:"maxNacks,omitempty"`
 }
 
 type GlobalNodeConfig interface {
@@ -63,6 +78,9 @@ type GlobalNodeConfig interface {
 	GetPayRegistryContract() chain.Contract
 	GetRouterRegistryContract() chain.Contract
 	GetCheckInterval(string) uint64
+	// GetChainId returns the chain id keying the channels of this chain in storage,
+	// which is 0 for the primary chain of the node.
+	GetChainId() uint64
 }
 
 type StreamWriter interface {
@@ -93,4 +111,5 @@ type MsgFrame struct {
 	Message  *rpc.CelerMsg
 	PeerAddr ctype.Addr
 	LogEntry *pem.PayEventMessage
+	Span     *trace.Span // span of the message processing, nil if not traced
 }
@@ -24,6 +24,13 @@ var (
 	RouterAliveTimeout    = 900 * time.Second
 	OspClearPaysInterval  = 613 * time.Second
 	OspReportInverval     = 887 * time.Second
+	LiquidityInterval     = 60 * time.Second // interval to collect liquidity metrics
+	RebalanceIdleInterval = 60 * time.Second // interval to recheck config when rebalance is disabled
+	EnablePayTrace        = false            // attach trace ID to pays sent from this node
+	// PayClearEscalateBlocks is how long the pay clearer waits for the peer to settle
+	// a pay cooperatively before escalating to an on-chain dispute, 40 minutes of 10s
+	// blocks by default so that a briefly offline peer does not cost a dispute.
+	PayClearEscalateBlocks = uint64(240)
 )
 
 const (
@@ -54,6 +61,53 @@ const (
 	// used by clients to control onchain query frequency
 	QueryName_OnChainBalance      = "onchainBalance"
 	QueryName_OnChainResolvedPays = "onchainResolvedPays"
+
+	// PayTraceQueryTimeout is how long the admin waits for peers to return pay trace spans
+	PayTraceQueryTimeout = 10 * time.Second
+	// PayTraceHopMargin is deducted from the query timeout at each hop along the pay path
+	PayTraceHopMargin = time.Second
+	// PayTraceRetention is how long pay trace spans are kept after the pay is received or forwarded
+	PayTraceRetention = 7 * 24 * time.Hour
+	// PayTracePruneInterval is the interval to delete pay trace spans beyond the retention
+	PayTracePruneInterval = time.Hour
+
+	// MsgQueueWorkers is the number of goroutines sending queued messages to the peers
+	MsgQueueWorkers = 256
+	// MsgQueueRetryDelay is the wait before retrying a channel whose last message failed to send
+	MsgQueueRetryDelay = time.Second
+	// MsgQueueMetricsInterval is how often the msg queue depth metrics are exported
+	MsgQueueMetricsInterval = 10 * time.Second
+
+	// DrainPollInterval is how often a draining node checks for outstanding pays and msgs
+	DrainPollInterval = time.Second
+	// DefaultDrainTimeout bounds how long a node waits for outstanding work when draining
+	DefaultDrainTimeout = 60 * time.Second
+
+	// PayClearInterval is how often the client pay clearer checks the pending pays
+	PayClearInterval = 60 * time.Second
+	// PayClearResolveMargin is how many blocks before the resolve deadline the pay clearer
+	// resolves an incoming pay with revealed secret on chain if the peer has not paid it
+	PayClearResolveMargin = uint64(20)
+
+	// ExitPolicyInterval is how often the client checks the exit policy and advances the exit jobs
+	ExitPolicyInterval = 60 * time.Second
+
+	// HealthCheckTimeout bounds each on-chain query made by health checks
+	HealthCheckTimeout = 5 * time.Second
+	// HealthMaxBlockLag is the max number of blocks the chain watcher can lag behind
+	// the eth client head beyond BlockDelay before the node is considered unhealthy
+	HealthMaxBlockLag = uint64(20)
+	// HealthMaxMonitorLag is the max number of blocks the log polling of an event can lag
+	// behind the chain watcher beyond the event block delay and check interval before a
+	// warning is reported
+	HealthMaxMonitorLag = uint64(20)
+
+	// TxRecordRetention is how long mined or dropped txs are kept by the tx manager
+	TxRecordRetention = 7 * 24 * time.Hour
+
+	// AdminViewDefaultLimit and AdminViewMaxLimit bound the page size of admin list views
+	AdminViewDefaultLimit = 100
+	AdminViewMaxLimit     = 1000
 )
 
 // KeepAliveClientParams is grpc client side keeyalive parameters
@@ -86,6 +140,10 @@ func SetGlobalConfigFromProfile(profile *common.CProfile) {
 	if profile.DisputeTimeout != 0 {
 		ChannelDisputeTimeout = profile.DisputeTimeout
 	}
+	EnablePayTrace = profile.PayTrace
+	if profile.PayClearEscalateBlocks != 0 {
+		PayClearEscalateBlocks = profile.PayClearEscalateBlocks
+	}
 }
 
 func WaitMinedOptions() []eth.TxOption {
@@ -13,7 +13,6 @@ import (
 	"github.com/celer-network/goCeler/ctype"
 	"github.com/celer-network/goCeler/metrics"
 	"github.com/celer-network/goCeler/storage"
-	"github.com/celer-network/goutils/eth"
 )
 
 type DepositCallback interface {
@@ -23,7 +22,7 @@ type DepositCallback interface {
 
 type Processor struct {
 	nodeConfig     common.GlobalNodeConfig
-	transactor     *eth.Transactor
+	transactor     intfs.Transactor
 	dal            *storage.DAL
 	monitorService intfs.MonitorService
 	isOSP          bool // server mode (true) or client mode (false)
@@ -46,7 +45,7 @@ type Processor struct {
 
 func StartProcessor(
 	nodeConfig common.GlobalNodeConfig,
-	transactor *eth.Transactor,
+	transactor intfs.Transactor,
 	dal *storage.DAL,
 	monitorService intfs.MonitorService,
 	isOSP bool,
@@ -5,10 +5,12 @@ package deposit
 import (
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/celer-network/goCeler/chain"
 	"github.com/celer-network/goCeler/chain/channel-eth-go/ledger"
 	"github.com/celer-network/goCeler/common"
+	"github.com/celer-network/goCeler/common/intfs"
 	"github.com/celer-network/goCeler/common/structs"
 	"github.com/celer-network/goCeler/config"
 	"github.com/celer-network/goCeler/ctype"
@@ -21,6 +23,9 @@ import (
 	"github.com/google/uuid"
 )
 
+// permitDeadline is how long a signed ERC20 permit stays valid
+const permitDeadline = time.Hour
+
 func (p *Processor) DepositWithCallback(amt *big.Int, cid ctype.CidType, cb DepositCallback) (string, error) {
 	if p.isOSP {
 		return "", fmt.Errorf("deposit client mode not supported")
@@ -50,7 +55,9 @@ func (p *Processor) RemoveJob(jobID string) error {
 
 // Depending on whether the deposit is for ETH or ERC20, and whether the account has enough
 // allowance for the ERC20 token, this function sends either a deposit() or an approve()
-// transaction. Upon successfully sending the transaction, a deposit job is initialized and
+// transaction. For ERC20 tokens supporting EIP-2612, a permit() transaction is sent instead of
+// approve(), immediately followed by the deposit() transaction if its gas can be estimated before
+// the permit is mined. Upon successfully sending the transaction, a deposit job is initialized and
 // persisted.
 func (p *Processor) prepareJob(amount *big.Int, cid ctype.CidType) (*structs.DepositJob, error) {
 	log.Infoln("Depositing", amount.String(), "wei into channel", cid.Hex())
@@ -107,6 +114,18 @@ func (p *Processor) prepareJob(amount *big.Int, cid ctype.CidType) (*structs.Dep
 		}
 		return job, nil
 	}
+	txHash, jobState, permitSent, permitErr := p.sendPermitAndDepositTxs(tokenAddr, spender, cid, amount)
+	if permitSent {
+		if permitErr != nil {
+			return nil, permitErr
+		}
+		job, jobErr := p.initJob(amount, cid, jobState, txHash)
+		if jobErr != nil {
+			return nil, jobErr
+		}
+		return job, nil
+	}
+	log.Debugln("ERC20 permit not available, sending approve tx:", permitErr)
 	approveTxHash, approveErr := p.sendApproveTx(tokenAddr, spender, amount)
 	if approveErr != nil {
 		return nil, approveErr
@@ -196,7 +215,7 @@ func (p *Processor) sendApproveTx(
 }
 
 func (p *Processor) sendDepositTx(
-	cid ctype.CidType, txValue *big.Int, amt *big.Int) (string, error) {
+	cid ctype.CidType, txValue *big.Int, amt *big.Int, opts ...eth.TxOption) (string, error) {
 	tx, err := p.transactor.Transact(
 		nil,
 		func(
@@ -216,14 +235,80 @@ func (p *Processor) sendDepositTx(
 			}
 			return contract.Deposit(opts, cid, p.transactor.Address(), amt)
 		},
-		config.TransactOptions(eth.WithEthValue(txValue))...)
+		config.TransactOptions(append(opts, eth.WithEthValue(txValue))...)...)
 	if err != nil {
 		return "", err
 	}
 	return tx.Hash().Hex(), nil
 }
 
-// Wait for the ERC20 approve() tx and send the deposit() tx. If successful, transition the job into
+// sendPermitAndDepositTxs sends an EIP-2612 permit() tx signed by the account and the deposit() tx
+// right after it, without waiting for the permit tx to be mined. The deposit gas is estimated on the
+// pending state including the permit tx. If that fails, e.g., the eth node estimates on the latest
+// block, the deposit tx is sent after the permit tx is mined, like after an approve() tx.
+// It returns the hash and job state of the last sent tx, and whether the permit tx is sent.
+// Nothing is sent if the token or the signer has no permit support.
+func (p *Processor) sendPermitAndDepositTxs(
+	tokenAddr ctype.Addr, spender ctype.Addr, cid ctype.CidType, amount *big.Int) (string, int, bool, error) {
+	hashSigner, ok := p.transactor.(intfs.HashSigner)
+	if !ok {
+		return "", structs.DepositState_NULL, false, common.ErrHashSignUnsupported
+	}
+	permitCaller, err := chain.NewERC20PermitCaller(tokenAddr, p.transactor.ContractCaller())
+	if err != nil {
+		return "", structs.DepositState_NULL, false, err
+	}
+	// calls fail on tokens without EIP-2612 support
+	domainSeparator, err := permitCaller.DomainSeparator(&bind.CallOpts{})
+	if err != nil {
+		return "", structs.DepositState_NULL, false, fmt.Errorf("DOMAIN_SEPARATOR err: %w", err)
+	}
+	owner := p.transactor.Address()
+	nonce, err := permitCaller.Nonces(&bind.CallOpts{}, owner)
+	if err != nil {
+		return "", structs.DepositState_NULL, false, fmt.Errorf("nonces err: %w", err)
+	}
+	deadline := big.NewInt(now().Add(permitDeadline).Unix())
+	sig, err := hashSigner.SignHash(chain.PermitDigest(domainSeparator, owner, spender, amount, nonce, deadline))
+	if err != nil {
+		return "", structs.DepositState_NULL, false, err
+	}
+	if len(sig) != 65 {
+		return "", structs.DepositState_NULL, false, fmt.Errorf("invalid permit signature length %d", len(sig))
+	}
+	var r, s [32]byte
+	copy(r[:], sig[:32])
+	copy(s[:], sig[32:64])
+	v := sig[64]
+	if v < 27 {
+		v += 27
+	}
+	// a failed gas estimation, e.g., on a token with a different permit, also falls back to approve
+	permitTx, err := p.transactor.Transact(
+		nil,
+		func(
+			transactor bind.ContractTransactor,
+			opts *bind.TransactOpts) (*types.Transaction, error) {
+			contract, contractErr := chain.NewERC20PermitTransactor(tokenAddr, transactor)
+			if contractErr != nil {
+				return nil, contractErr
+			}
+			return contract.Permit(opts, owner, spender, amount, deadline, v, r, s)
+		},
+		config.TransactOptions()...)
+	if err != nil {
+		return "", structs.DepositState_NULL, false, fmt.Errorf("permit tx err: %w", err)
+	}
+	log.Infof("sent erc20 permit tx %x for deposit into channel %x", permitTx.Hash(), cid)
+	depositTxHash, err := p.sendDepositTx(cid, big.NewInt(0), amount)
+	if err != nil {
+		log.Warnf("deposit tx into channel %x not sent before permit tx mined: %s", cid, err)
+		return permitTx.Hash().Hex(), structs.DepositState_APPROVING_ERC20, true, nil
+	}
+	return depositTxHash, structs.DepositState_TX_SUBMITTED, true, nil
+}
+
+// Wait for the ERC20 approve() or permit() tx and send the deposit() tx. If successful, transition the job into
 // the "DepositState_TX_SUBMITTED" state and re-dispatch it.
 func (p *Processor) waitApproveAndSendDepositTx(job *structs.DepositJob) {
 	approveTxHash := job.TxHash
@@ -0,0 +1,128 @@
+// Copyright 2020 Celer Network
+
+package deposit
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/celer-network/goCeler/common"
+	"github.com/celer-network/goCeler/common/structs"
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goutils/eth"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// testCaller returns a 32-byte word to all calls of a permit token, or fails calls of other tokens
+type testCaller struct {
+	permit bool
+}
+
+func (c *testCaller) CodeAt(ctx context.Context, contract ethcommon.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{1}, nil
+}
+
+func (c *testCaller) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if !c.permit {
+		return nil, errors.New("execution reverted")
+	}
+	return make([]byte, 32), nil
+}
+
+// testTransactor records the sent txs, the i-th tx fails if errs[i] is set
+type testTransactor struct {
+	caller *testCaller
+	errs   map[int]error
+	sent   int
+}
+
+func (tr *testTransactor) Transact(
+	handler *eth.TransactionStateHandler, method eth.TxMethod, opts ...eth.TxOption) (*types.Transaction, error) {
+	i := tr.sent
+	tr.sent++
+	if err := tr.errs[i]; err != nil {
+		return nil, err
+	}
+	return types.NewTransaction(uint64(i), ethcommon.Address{}, nil, 0, nil, nil), nil
+}
+
+func (tr *testTransactor) TransactWaitMined(
+	description string, method eth.TxMethod, opts ...eth.TxOption) (*types.Receipt, error) {
+	return nil, errors.New("not supported")
+}
+
+func (tr *testTransactor) WaitMined(txHash string, opts ...eth.TxOption) (*types.Receipt, error) {
+	return nil, errors.New("not supported")
+}
+
+func (tr *testTransactor) ContractCaller() bind.ContractCaller {
+	return tr.caller
+}
+
+func (tr *testTransactor) Address() ethcommon.Address {
+	return ctype.Hex2Addr("abc1")
+}
+
+type testHashSignTransactor struct {
+	testTransactor
+}
+
+func (tr *testHashSignTransactor) SignHash(hash []byte) ([]byte, error) {
+	return make([]byte, 65), nil
+}
+
+func TestSendPermitAndDepositTxs(t *testing.T) {
+	tokenAddr, spender := ctype.Hex2Addr("abc2"), ctype.Hex2Addr("abc3")
+	cid := ctype.Hex2Cid("c1")
+	amt := big.NewInt(100)
+	txHash := func(nonce uint64) string {
+		return types.NewTransaction(nonce, ethcommon.Address{}, nil, 0, nil, nil).Hash().Hex()
+	}
+
+	// signer without hash signing falls back to approve
+	tr := &testTransactor{caller: &testCaller{permit: true}}
+	p := &Processor{transactor: tr}
+	_, _, sent, err := p.sendPermitAndDepositTxs(tokenAddr, spender, cid, amt)
+	if sent || !errors.Is(err, common.ErrHashSignUnsupported) || tr.sent != 0 {
+		t.Errorf("permit sent without hash signer: %t %v %d", sent, err, tr.sent)
+	}
+
+	// token without permit falls back to approve
+	htr := &testHashSignTransactor{testTransactor{caller: &testCaller{permit: false}}}
+	p = &Processor{transactor: htr}
+	_, _, sent, err = p.sendPermitAndDepositTxs(tokenAddr, spender, cid, amt)
+	if sent || err == nil || htr.sent != 0 {
+		t.Errorf("permit sent for token without permit: %t %v %d", sent, err, htr.sent)
+	}
+
+	// failed permit tx, e.g., gas estimation of a different permit, falls back to approve
+	htr = &testHashSignTransactor{testTransactor{
+		caller: &testCaller{permit: true}, errs: map[int]error{0: errors.New("gas required exceeds allowance")}}}
+	p = &Processor{transactor: htr}
+	_, _, sent, err = p.sendPermitAndDepositTxs(tokenAddr, spender, cid, amt)
+	if sent || err == nil || htr.sent != 1 {
+		t.Errorf("failed permit tx not fallen back: %t %v %d", sent, err, htr.sent)
+	}
+
+	// deposit tx sent right after the permit tx
+	htr = &testHashSignTransactor{testTransactor{caller: &testCaller{permit: true}}}
+	p = &Processor{transactor: htr}
+	hash, state, sent, err := p.sendPermitAndDepositTxs(tokenAddr, spender, cid, amt)
+	if !sent || err != nil || htr.sent != 2 || state != structs.DepositState_TX_SUBMITTED || hash != txHash(1) {
+		t.Errorf("wrong permit and deposit: %s %d %t %v %d", hash, state, sent, err, htr.sent)
+	}
+
+	// deposit gas not estimated before the permit tx is mined, wait for the permit tx
+	htr = &testHashSignTransactor{testTransactor{
+		caller: &testCaller{permit: true}, errs: map[int]error{1: errors.New("execution reverted")}}}
+	p = &Processor{transactor: htr}
+	hash, state, sent, err = p.sendPermitAndDepositTxs(tokenAddr, spender, cid, amt)
+	if !sent || err != nil || htr.sent != 2 || state != structs.DepositState_APPROVING_ERC20 || hash != txHash(0) {
+		t.Errorf("wrong permit waiting for deposit: %s %d %t %v %d", hash, state, sent, err, htr.sent)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"sort"
 	"time"
 
+	"github.com/celer-network/goCeler/accounting"
 	"github.com/celer-network/goCeler/chain"
 	"github.com/celer-network/goCeler/chain/channel-eth-go/ledger"
 	"github.com/celer-network/goCeler/common"
@@ -267,7 +268,7 @@ func (p *Processor) waitDepositTxMined(txHash string) {
 
 func (p *Processor) resumeServerJobs() error {
 	// resume submitted jobs
-	txHashes, err := p.dal.GetAllSubmittedDepositTxHashes()
+	txHashes, err := p.dal.GetAllSubmittedDepositTxHashes(p.nodeConfig.GetChainId())
 	if err != nil {
 		metrics.IncDepositErrCnt()
 		log.Error(err)
@@ -278,7 +279,7 @@ func (p *Processor) resumeServerJobs() error {
 	}
 
 	// load jobs that were submitting but not recorded before last shutdown (rare cases)
-	jobs, err := p.dal.GetAllDepositJobsByState(structs.DepositState_TX_SUBMITTING)
+	jobs, err := p.dal.GetAllDepositJobsByState(p.nodeConfig.GetChainId(), structs.DepositState_TX_SUBMITTING)
 	if err != nil {
 		metrics.IncDepositErrCnt()
 		log.Error(err)
@@ -308,7 +309,7 @@ func (p *Processor) resumeServerJobs() error {
 }
 
 func (p *Processor) getQueuedJobs() (map[ctype.Addr][]*channelDeposit, error) {
-	jobs, err := p.dal.GetAllDepositJobsByState(structs.DepositState_QUEUED)
+	jobs, err := p.dal.GetAllDepositJobsByState(p.nodeConfig.GetChainId(), structs.DepositState_QUEUED)
 	if err != nil {
 		metrics.IncDepositErrCnt()
 		log.Error(err)
@@ -441,6 +442,43 @@ func (p *Processor) checkRefillPool(ledgerAddr ctype.Addr, tokenSet map[ctype.Ad
 	}
 }
 
+// PoolBalance is the refiller's balance of a token that has refill configured
+type PoolBalance struct {
+	Token     ctype.Addr
+	Balance   *big.Int
+	Threshold *big.Int
+}
+
+// GetRefillPoolBalances returns the refiller's balances of all tokens that have refill configured
+func (p *Processor) GetRefillPoolBalances() ([]*PoolBalance, error) {
+	var balances []*PoolBalance
+	for tokenStr := range rtconfig.GetRefillConfigs().GetConfig() {
+		poolThreshold := rtconfig.GetRefillPoolThreshold(tokenStr)
+		if poolThreshold.Cmp(big.NewInt(0)) == 0 {
+			continue
+		}
+		tokenAddr := ctype.Hex2Addr(tokenStr)
+		poolAddr := tokenAddr
+		if tokenAddr == ctype.EthTokenAddr {
+			poolAddr = p.nodeConfig.GetEthPoolAddr()
+		}
+		erc20, err := chain.NewERC20Caller(poolAddr, p.nodeConfig.GetEthConn())
+		if err != nil {
+			return nil, err
+		}
+		balance, err := erc20.BalanceOf(&bind.CallOpts{}, p.transactor.Address())
+		if err != nil {
+			return nil, fmt.Errorf("token %x BalanceOf err: %w", tokenAddr, err)
+		}
+		balances = append(balances, &PoolBalance{
+			Token:     tokenAddr,
+			Balance:   balance,
+			Threshold: poolThreshold,
+		})
+	}
+	return balances, nil
+}
+
 func (p *Processor) monitorOnAllLedgers() {
 	ledgers := p.nodeConfig.GetAllLedgerContracts()
 
@@ -471,16 +509,16 @@ func (p *Processor) monitorEvent(ledgerContract chain.Contract) {
 			if e.PeerAddrs[0] != self && e.PeerAddrs[1] != self {
 				return
 			}
-			txHash := eLog.TxHash
-			log.Infoln("Caught new deposit made to channel", ctype.CidType(e.ChannelId).Hex(), "tx", txHash.Hex())
-			p.handleEvent(e, txHash)
+			log.Infoln("Caught new deposit made to channel", ctype.CidType(e.ChannelId).Hex(), "tx", eLog.TxHash.Hex())
+			p.handleEvent(e, &eLog)
 		})
 }
 
 // Update balance and deposit jobs according to an on-chain Deposit event.
-func (p *Processor) handleEvent(event *ledger.CelerLedgerDeposit, txHash ctype.Hash) {
+func (p *Processor) handleEvent(event *ledger.CelerLedgerDeposit, eLog *types.Log) {
 	metrics.IncDepositEventCnt()
 	cid := ctype.CidType(event.ChannelId)
+	txHash := eLog.TxHash
 	updateOnChainBalanceTx := func(tx *storage.DALTx, args ...interface{}) error {
 		balance, found, err := tx.GetOnChainBalance(cid)
 		if err != nil {
@@ -489,6 +527,7 @@ func (p *Processor) handleEvent(event *ledger.CelerLedgerDeposit, txHash ctype.H
 		if !found {
 			return common.ErrChannelNotFound
 		}
+		myDeposit := balance.MyDeposit
 		if event.PeerAddrs[0] == p.nodeConfig.GetOnChainAddr() {
 			balance.MyDeposit = event.Deposits[0]
 			balance.PeerDeposit = event.Deposits[1]
@@ -502,6 +541,17 @@ func (p *Processor) handleEvent(event *ledger.CelerLedgerDeposit, txHash ctype.H
 		if err != nil {
 			return err
 		}
+		if amt := new(big.Int).Sub(balance.MyDeposit, myDeposit); amt.Sign() > 0 {
+			_, token, _, err2 := tx.GetChanStateToken(cid)
+			if err2 != nil {
+				return err2
+			}
+			err = tx.InsertAcctRecord(accounting.DepositRecord(
+				txHash, eLog.Index, cid, utils.GetTokenAddr(token), amt))
+			if err != nil {
+				return err
+			}
+		}
 		return tx.UpdateDepositStatesByTxHashAndCid(txHash.Hex(), cid, structs.DepositState_SUCCEEDED)
 	}
 	if err := p.dal.Transactional(updateOnChainBalanceTx); err != nil {
@@ -512,6 +562,45 @@ func (p *Processor) handleEvent(event *ledger.CelerLedgerDeposit, txHash ctype.H
 	p.handleBatchJobEvent(txHash)
 }
 
+// RollbackEvent reverts the on-chain balance and deposit jobs updated by a Deposit event
+// that was removed by a chain reorg
+func (p *Processor) RollbackEvent(eLog *types.Log) error {
+	ledgerContract := p.nodeConfig.GetLedgerContractOn(eLog.Address)
+	if ledgerContract == nil {
+		return fmt.Errorf("unknown ledger %x", eLog.Address)
+	}
+	e := &ledger.CelerLedgerDeposit{}
+	err := ledgerContract.ParseEvent(event.Deposit, *eLog, e)
+	if err != nil {
+		return err
+	}
+	self := p.nodeConfig.GetOnChainAddr()
+	if e.PeerAddrs[0] != self && e.PeerAddrs[1] != self {
+		return nil
+	}
+	cid := ctype.CidType(e.ChannelId)
+	err = ledgerview.SyncOnChainBalance(p.dal, cid, p.nodeConfig)
+	if err != nil {
+		return err
+	}
+	err = p.dal.DeleteAcctRecord(accounting.DepositEntryID(eLog.TxHash, eLog.Index))
+	if err != nil {
+		return err
+	}
+	txHash := eLog.TxHash.Hex()
+	found, err := p.dal.HasDepositTxHash(txHash)
+	if err != nil || !found {
+		return err
+	}
+	// wait for the tx to be mined again, the event handler marks the jobs succeeded
+	err = p.dal.UpdateDepositStatesByTxHashAndCid(txHash, cid, structs.DepositState_TX_SUBMITTED)
+	if err != nil {
+		return err
+	}
+	go p.waitDepositTxMined(txHash)
+	return nil
+}
+
 // handleBatchJobEvent currently only handle cases when server was down
 // while deposit jobs were being submitted but not recorded
 func (p *Processor) handleBatchJobEvent(txHash ctype.Hash) {
@@ -4,6 +4,7 @@ package dispatchers
 
 import (
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/celer-network/goCeler/common"
@@ -16,11 +17,14 @@ import (
 	"github.com/celer-network/goCeler/messager"
 	"github.com/celer-network/goCeler/metrics"
 	"github.com/celer-network/goCeler/pem"
+	"github.com/celer-network/goCeler/ratelimit"
 	"github.com/celer-network/goCeler/route"
 	"github.com/celer-network/goCeler/rpc"
+	"github.com/celer-network/goCeler/rtconfig"
 	"github.com/celer-network/goCeler/storage"
 	"github.com/celer-network/goutils/eth"
 	"github.com/celer-network/goutils/log"
+	"go.opencensus.io/trace"
 )
 
 type CooperativeWithdraw interface {
@@ -46,6 +50,8 @@ type CelerMsgDispatcher struct {
 	routeController     *route.Controller
 	messager            *messager.Messager
 	isOSP               bool
+	rateLimiter         *ratelimit.KeyedLimiter // per peer and message type
+	draining            int32                   // reject new cond pay requests if set
 }
 
 func NewCelerMsgDispatcher(
@@ -78,6 +84,7 @@ func NewCelerMsgDispatcher(
 		routeController:     routeController,
 		messager:            messager,
 		isOSP:               isOSP,
+		rateLimiter:         ratelimit.NewKeyedLimiter(rtconfig.GetRateLimit),
 	}
 	return d
 }
@@ -115,16 +122,28 @@ func (d *CelerMsgDispatcher) Start(input chan *rpc.CelerMsg, peerAddr ctype.Addr
 		var handler handlers.CelerMsgHandler
 		logEntry := pem.NewPem(d.nodeConfig.GetRPCAddr())
 		logEntry.MsgFrom = ctype.Addr2Hex(peerAddr)
+		span := metrics.StartSpan(nil, "CelerMsgDispatcher")
 		msgFrame := &common.MsgFrame{
 			Message:  msg,
 			PeerAddr: peerAddr,
 			LogEntry: logEntry,
+			Span:     span,
 		}
 
 		handler = d.NewMsgHandler()
 
 		start := time.Now()
-		err := handler.Run(msgFrame)
+		var err error
+		limitedName := msghdl.GetRateLimitedMsgName(msg)
+		if msg.GetCondPayRequest() != nil && d.IsDraining() {
+			err = handler.RejectDraining(msgFrame)
+		} else if limitedName != "" && !d.rateLimiter.Allow(ctype.Addr2Hex(peerAddr), limitedName) {
+			log.Warnln("rate limited", limitedName, "from", ctype.Addr2Hex(peerAddr))
+			metrics.IncRateLimitedCnt(limitedName)
+			err = handler.RejectRateLimited(msgFrame)
+		} else {
+			err = handler.Run(msgFrame)
+		}
 		msgname := handler.GetMsgName()
 		metrics.IncDispatcherMsgCnt(msgname)
 		metrics.IncDispatcherMsgProcDur(start, msgname)
@@ -132,6 +151,8 @@ func (d *CelerMsgDispatcher) Start(input chan *rpc.CelerMsg, peerAddr ctype.Addr
 			logEntry.Error = append(logEntry.Error, err.Error())
 			metrics.IncDispatcherErrCnt(msgname)
 		}
+		addSpanAttributes(span, logEntry)
+		metrics.EndSpan(span, err)
 		pem.CommitPem(logEntry)
 	}
 }
@@ -140,6 +161,20 @@ func (d *CelerMsgDispatcher) Stop() {
 	d.stop = true
 }
 
+// SetDraining starts or stops rejecting new CondPayRequests, all other messages
+// are still processed so that pays in flight can complete.
+func (d *CelerMsgDispatcher) SetDraining(draining bool) {
+	var v int32
+	if draining {
+		v = 1
+	}
+	atomic.StoreInt32(&d.draining, v)
+}
+
+func (d *CelerMsgDispatcher) IsDraining() bool {
+	return atomic.LoadInt32(&d.draining) == 1
+}
+
 func (d *CelerMsgDispatcher) NewMsgHandler() *msghdl.CelerMsgHandler {
 	return msghdl.NewCelerMsgHandler(
 		d.nodeConfig,
@@ -160,3 +195,33 @@ func (d *CelerMsgDispatcher) NewMsgHandler() *msghdl.CelerMsgHandler {
 		d.isOSP,
 	)
 }
+
+// addSpanAttributes annotates the message span with the ids recorded in the log entry
+func addSpanAttributes(span *trace.Span, logEntry *pem.PayEventMessage) {
+	if !span.IsRecordingEvents() {
+		return
+	}
+	attrs := []trace.Attribute{
+		trace.StringAttribute(metrics.AkMsgType, logEntry.GetType().String()),
+		trace.StringAttribute(metrics.AkMsgFrom, logEntry.GetMsgFrom()),
+	}
+	if logEntry.GetMsgTo() != "" {
+		attrs = append(attrs, trace.StringAttribute(metrics.AkMsgTo, logEntry.GetMsgTo()))
+	}
+	if logEntry.GetPayId() != "" {
+		attrs = append(attrs, trace.StringAttribute(metrics.AkPayID, logEntry.GetPayId()))
+	}
+	if logEntry.GetFromCid() != "" {
+		attrs = append(attrs, trace.StringAttribute(metrics.AkFromCid, logEntry.GetFromCid()))
+	}
+	if logEntry.GetToCid() != "" {
+		attrs = append(attrs, trace.StringAttribute(metrics.AkToCid, logEntry.GetToCid()))
+	}
+	if seqNums := logEntry.GetSeqNums(); seqNums != nil {
+		attrs = append(attrs,
+			trace.Int64Attribute(metrics.AkSeqIn, int64(seqNums.GetIn())),
+			trace.Int64Attribute(metrics.AkSeqOut, int64(seqNums.GetOut())),
+			trace.Int64Attribute(metrics.AkSeqAck, int64(seqNums.GetAck())))
+	}
+	span.AddAttributes(attrs...)
+}
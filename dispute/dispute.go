@@ -7,14 +7,13 @@ import (
 	"github.com/celer-network/goCeler/common/intfs"
 	"github.com/celer-network/goCeler/route"
 	"github.com/celer-network/goCeler/storage"
-	"github.com/celer-network/goutils/eth"
 )
 
 // Processor struct implements the actual disputing logic
 type Processor struct {
 	nodeConfig      common.GlobalNodeConfig
-	transactor      *eth.Transactor
-	transactorPool  *eth.TransactorPool
+	transactor      intfs.Transactor
+	transactorPool  intfs.TransactorPool
 	routeController *route.Controller
 	monitorService  intfs.MonitorService
 	dal             *storage.DAL
@@ -24,8 +23,8 @@ type Processor struct {
 // NewProcessor creates a new Disputer struct
 func NewProcessor(
 	nodeConfig common.GlobalNodeConfig,
-	transactor *eth.Transactor,
-	transactorPool *eth.TransactorPool,
+	transactor intfs.Transactor,
+	transactorPool intfs.TransactorPool,
 	routeController *route.Controller,
 	monitorService intfs.MonitorService,
 	dal *storage.DAL,
@@ -227,7 +227,7 @@ func (p *Processor) HandleConfirmSettleEventTx(tx *storage.DALTx, args ...interf
 		log.Errorln("fsm OnChannelConfirmSettle err:", err)
 		return err
 	}
-	peer, token, opents, found, err := tx.GetChanForClose(cid)
+	peer, token, opents, chainId, found, err := tx.GetChanForClose(cid)
 	if err != nil {
 		log.Errorln("GetChanPeerToken:", err, "cid:", cid.Hex())
 		return err
@@ -241,7 +241,7 @@ func (p *Processor) HandleConfirmSettleEventTx(tx *storage.DALTx, args ...interf
 		log.Errorln(err, cid.Hex())
 		return err
 	}
-	err = tx.InsertClosedChan(cid, peer, token, opents, time.Now().UTC())
+	err = tx.InsertClosedChan(cid, peer, token, opents, time.Now().UTC(), chainId)
 	if err != nil {
 		log.Errorln(err, cid.Hex())
 		return err
@@ -299,6 +299,42 @@ func (p *Processor) handleIntendSettleEventTx(tx *storage.DALTx, args ...interfa
 	return nil
 }
 
+// RollbackIntendSettleEvent reopens the channel moved to settling by an IntendSettle event
+// that was removed by a chain reorg, if the channel is still operable on chain
+func (p *Processor) RollbackIntendSettleEvent(eLog *types.Log) error {
+	e := &ledger.CelerLedgerIntendSettle{}
+	err := p.nodeConfig.GetLedgerContract().ParseEvent(event.IntendSettle, *eLog, e)
+	if err != nil {
+		return err
+	}
+	cid := ctype.CidType(e.ChannelId)
+	state, token, found, err := p.dal.GetChanStateToken(cid)
+	if err != nil || !found || state != enums.ChanState_SETTLING {
+		return err
+	}
+	status, err := ledgerview.GetOnChainChannelStatus(cid, p.nodeConfig)
+	if err != nil {
+		return err
+	}
+	if status != ledgerview.OnChainStatus_OPERABLE {
+		return nil
+	}
+	log.Warnln("IntendSettle reverted by chain reorg, reopen channel", cid.Hex())
+	err = p.dal.UpdateChanState(cid, enums.ChanState_OPENED)
+	if err != nil {
+		return err
+	}
+	if p.routeController != nil {
+		peer, _, err := p.dal.GetChanPeer(cid)
+		if err != nil {
+			return err
+		}
+		p.routeController.AddEdge(
+			p.nodeConfig.GetOnChainAddr(), peer, cid, ctype.Bytes2Addr(token.GetTokenAddress()))
+	}
+	return nil
+}
+
 func (p *Processor) monitorPaymentChannelSettleEvent(ledgerContract chain.Contract) {
 	monitorCfg := &monitor.Config{
 		EventName:     event.IntendSettle,
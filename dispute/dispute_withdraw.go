@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/celer-network/goCeler/accounting"
 	"github.com/celer-network/goCeler/chain"
 	"github.com/celer-network/goCeler/chain/channel-eth-go/ledger"
 	"github.com/celer-network/goCeler/common"
@@ -18,6 +19,7 @@ import (
 	"github.com/celer-network/goCeler/ledgerview"
 	"github.com/celer-network/goCeler/metrics"
 	"github.com/celer-network/goCeler/storage"
+	"github.com/celer-network/goCeler/utils"
 	"github.com/celer-network/goutils/eth/monitor"
 	"github.com/celer-network/goutils/log"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -250,7 +252,20 @@ func (p *Processor) monitorNoncooperativeWithdrawEvent(ledgerContract chain.Cont
 						return fmt.Errorf("GetOnChainBalance err %w", common.ErrChannelNotFound)
 					}
 					onChainBalance.PendingWithdrawal = balance.PendingWithdrawal
-					return tx.UpdateOnChainBalance(cid, onChainBalance)
+					err2 = tx.UpdateOnChainBalance(cid, onChainBalance)
+					if err2 != nil {
+						return fmt.Errorf("UpdateOnChainBalance err %w", err2)
+					}
+					amt := new(big.Int).Sub(onChainBalance.MyWithdrawal, balance.MyWithdrawal)
+					if amt.Sign() <= 0 {
+						return nil
+					}
+					_, token, _, err2 := tx.GetChanStateToken(cid)
+					if err2 != nil {
+						return fmt.Errorf("GetChanStateToken err %w", err2)
+					}
+					return tx.InsertAcctRecord(accounting.WithdrawRecord(
+						eLog.TxHash, eLog.Index, cid, utils.GetTokenAddr(token), amt))
 				}
 				if err := p.dal.Transactional(updateBalanceTx); err != nil {
 					log.Error(err)
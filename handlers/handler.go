@@ -11,6 +11,8 @@ type ForwardToServerCallback func(dest ctype.Addr, retry bool, msg interface{})
 
 type CelerMsgHandler interface {
 	GetMsgName() string
+	RejectRateLimited(msg *common.MsgFrame) error
+	RejectDraining(msg *common.MsgFrame) error
 	CelerMsgRunnable
 }
 
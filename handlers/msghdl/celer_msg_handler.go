@@ -15,13 +15,16 @@ import (
 	"github.com/celer-network/goCeler/entity"
 	"github.com/celer-network/goCeler/handlers"
 	"github.com/celer-network/goCeler/messager"
+	"github.com/celer-network/goCeler/metrics"
 	"github.com/celer-network/goCeler/pem"
 	"github.com/celer-network/goCeler/route"
 	"github.com/celer-network/goCeler/rpc"
 	"github.com/celer-network/goCeler/storage"
+	"github.com/celer-network/goCeler/utils"
 	"github.com/celer-network/goutils/eth"
 	"github.com/celer-network/goutils/log"
 	"github.com/golang/protobuf/proto"
+	"go.opencensus.io/trace"
 )
 
 type CooperativeWithdraw interface {
@@ -41,6 +44,8 @@ const (
 	WithdrawRequestMsgName  = "WithdrawRequestMessage"
 	WithdrawResponseMsgName = "WithdrawResponseMessage"
 	RoutingRequestMsgName   = "RoutingRequestMessage"
+	PayTraceRequestMsgName  = "PayTraceRequestMessage"
+	PayTraceResponseMsgName = "PayTraceResponseMessage"
 	UnkownMsgName           = "UnkownMessage"
 )
 
@@ -62,6 +67,7 @@ type CelerMsgHandler struct {
 	dal                 *storage.DAL
 	isOSP               bool
 	msgName             string
+	span                *trace.Span
 }
 
 func NewCelerMsgHandler(
@@ -105,6 +111,8 @@ func NewCelerMsgHandler(
 
 func (h *CelerMsgHandler) Run(frame *common.MsgFrame) error {
 	var err error
+	h.span = metrics.StartSpan(frame.Span, "CelerMsgHandler")
+	frame.Span = h.span
 	switch frame.Message.GetMessage().(type) {
 	case *rpc.CelerMsg_CondPayRequest:
 		h.msgName = CondPayRequestMsgName
@@ -150,11 +158,21 @@ func (h *CelerMsgHandler) Run(frame *common.MsgFrame) error {
 		h.msgName = RoutingRequestMsgName
 		frame.LogEntry.Type = pem.PayMessageType_ROUTING_REQUEST
 		err = h.HandleRoutingRequest(frame)
+	case *rpc.CelerMsg_PayTraceRequest:
+		h.msgName = PayTraceRequestMsgName
+		frame.LogEntry.Type = pem.PayMessageType_PAY_TRACE_REQUEST
+		err = h.HandlePayTraceRequest(frame)
+	case *rpc.CelerMsg_PayTraceResponse:
+		h.msgName = PayTraceResponseMsgName
+		frame.LogEntry.Type = pem.PayMessageType_PAY_TRACE_RESPONSE
+		err = h.HandlePayTraceResponse(frame)
 	default:
 		h.msgName = UnkownMsgName
 		log.Errorln("Can't find hop handler for", frame.Message, ctype.Addr2Hex(frame.PeerAddr))
 		err = common.ErrInvalidMsgType
 	}
+	h.span.SetName(h.msgName)
+	metrics.EndSpan(h.span, err)
 	return err
 }
 
@@ -162,8 +180,107 @@ func (h *CelerMsgHandler) GetMsgName() string {
 	return h.msgName
 }
 
+// GetRateLimitedMsgName returns the name of msg if it is a request initiated by the peer that
+// is subject to rate limits, or empty string otherwise. Responses and acks are never limited
+// so that the channel states with the peer keep in sync.
+func GetRateLimitedMsgName(msg *rpc.CelerMsg) string {
+	switch msg.GetMessage().(type) {
+	case *rpc.CelerMsg_CondPayRequest:
+		return CondPayRequestMsgName
+	case *rpc.CelerMsg_RoutingRequest:
+		return RoutingRequestMsgName
+	case *rpc.CelerMsg_PayTraceRequest:
+		return PayTraceRequestMsgName
+	}
+	return ""
+}
+
+// RejectRateLimited drops a message over the rate limit of the peer instead of running it.
+// A CondPayRequest is nacked with RATE_LIMITED so the peer fails the pay and can back off.
+func (h *CelerMsgHandler) RejectRateLimited(frame *common.MsgFrame) error {
+	h.msgName = GetRateLimitedMsgName(frame.Message)
+	return h.reject(frame, rpc.ErrCode_RATE_LIMITED, common.ErrRateLimited)
+}
+
+// RejectDraining drops a new CondPayRequest received while the node is draining before shutdown.
+// The request is nacked with DRAINING so the peer fails the pay and can retry elsewhere.
+func (h *CelerMsgHandler) RejectDraining(frame *common.MsgFrame) error {
+	h.msgName = CondPayRequestMsgName
+	return h.reject(frame, rpc.ErrCode_DRAINING, common.ErrDraining)
+}
+
+func (h *CelerMsgHandler) reject(frame *common.MsgFrame, code rpc.ErrCode, reason error) error {
+	h.span = metrics.StartSpan(frame.Span, h.msgName)
+	frame.Span = h.span
+	err := h.rejectCondPayRequest(frame, code, reason)
+	metrics.EndSpan(h.span, err)
+	return err
+}
+
+func (h *CelerMsgHandler) rejectCondPayRequest(frame *common.MsgFrame, code rpc.ErrCode, reason error) error {
+	request := frame.Message.GetCondPayRequest()
+	if request == nil {
+		return reason
+	}
+	frame.LogEntry.Type = pem.PayMessageType_COND_PAY_REQUEST
+	frame.LogEntry.PayId = ctype.PayID2Hex(ctype.PayBytes2PayID(request.GetCondPay()))
+	var recvdSimplex entity.SimplexPaymentChannel
+	err := proto.Unmarshal(request.GetStateOnlyPeerFromSig().GetSimplexState(), &recvdSimplex)
+	if err != nil {
+		return common.ErrSimplexParse
+	}
+	cid := ctype.Bytes2Cid(recvdSimplex.GetChannelId())
+	frame.LogEntry.FromCid = ctype.Cid2Hex(cid)
+	peerSimplex, stateCosigned, found, err := h.dal.GetPeerSimplex(cid)
+	if err != nil {
+		return fmt.Errorf("GetPeerSimplex err %w", err)
+	}
+	if !found || ctype.Bytes2Addr(peerSimplex.GetPeerFrom()) != frame.PeerAddr {
+		return reason
+	}
+	celerMsg := &rpc.CelerMsg{
+		Message: &rpc.CelerMsg_CondPayResponse{
+			CondPayResponse: &rpc.CondPayResponse{
+				StateCosigned: stateCosigned,
+				Error: &rpc.Error{
+					Code:   code,
+					Reason: reason.Error(),
+					Seq:    recvdSimplex.GetSeqNum(),
+				},
+			},
+		},
+	}
+	err = h.writeCelerMsg(frame.PeerAddr, celerMsg)
+	if err != nil {
+		frame.LogEntry.Error = append(frame.LogEntry.Error, err.Error())
+	}
+	return reason
+}
+
 // -------------------------- Helper util functions ---------------------------
 
+// writeCelerMsg writes msg to the direct peer within a child span of the message
+func (h *CelerMsgHandler) writeCelerMsg(peer ctype.Addr, msg *rpc.CelerMsg) error {
+	span := h.startSendSpan("StreamWriter.WriteCelerMsg", peer)
+	err := h.streamWriter.WriteCelerMsg(peer, msg)
+	metrics.EndSpan(span, err)
+	return err
+}
+
+// forwardCelerMsg forwards msg to peer within a child span of the message
+func (h *CelerMsgHandler) forwardCelerMsg(peer ctype.Addr, msg *rpc.CelerMsg) error {
+	span := h.startSendSpan("Messager.ForwardCelerMsg", peer)
+	err := h.messager.ForwardCelerMsg(peer, msg)
+	metrics.EndSpan(span, err)
+	return err
+}
+
+func (h *CelerMsgHandler) startSendSpan(name string, peer ctype.Addr) *trace.Span {
+	span := metrics.StartSpan(h.span, name)
+	span.AddAttributes(trace.StringAttribute(metrics.AkMsgTo, ctype.Addr2Hex(peer)))
+	return span
+}
+
 func validRecvdSeqNum(stored, recvd, base uint64) bool {
 	return stored == base && recvd > stored
 }
@@ -172,12 +289,33 @@ func (h *CelerMsgHandler) payFromSelf(pay *entity.ConditionalPay) bool {
 	return bytes.Compare(pay.GetSrc(), h.nodeConfig.GetOnChainAddr().Bytes()) == 0
 }
 
+// chainOf returns the services of the chain the channel is on
+func (h *CelerMsgHandler) chainOf(cid ctype.CidType) *messager.ChainServices {
+	if chain := h.messager.ExtraChainOfCid(cid); chain != nil {
+		return chain
+	}
+	return &messager.ChainServices{
+		ChainId:        h.nodeConfig.GetChainId(),
+		NodeConfig:     h.nodeConfig,
+		MonitorService: h.monitorService,
+		Disputer:       h.disputer,
+	}
+}
+
+// disputerOf returns the dispute processor of the chain the pay resolves on
+func (h *CelerMsgHandler) disputerOf(pay *entity.ConditionalPay) *dispute.Processor {
+	if chain := h.messager.ExtraChainOfPay(pay); chain != nil {
+		return chain.Disputer
+	}
+	return h.disputer
+}
+
 func (h *CelerMsgHandler) prependPayPath(payPath *rpc.PayPath, payHop *rpc.PayHop) error {
 	payHopBytes, err := proto.Marshal(payHop)
 	if err != nil {
 		return fmt.Errorf("marshal payHop err: %w", err)
 	}
-	sig, err := h.signer.SignEthMessage(payHopBytes)
+	sig, err := utils.SignEthMessageOfKind(h.signer, intfs.SignKindPayHop, payHopBytes)
 	if err != nil {
 		return fmt.Errorf("sign payHop err: %w", err)
 	}
@@ -28,6 +28,10 @@ func (h *CelerMsgHandler) HandleCondPayReceipt(frame *common.MsgFrame) error {
 	logEntry.Dst = ctype.Addr2Hex(dst)
 	payID := ctype.Bytes2PayID(receipt.PayId)
 	logEntry.PayId = ctype.PayID2Hex(payID)
+	if receipt.GetTraceId() != "" {
+		logEntry.TraceId = receipt.GetTraceId()
+		h.recordPayTraceReceipt(payID, receipt.GetTraceId())
+	}
 
 	// Forward Msg if not destination
 	if dst != h.nodeConfig.GetOnChainAddr() {
@@ -46,7 +50,7 @@ func (h *CelerMsgHandler) HandleCondPayReceipt(frame *common.MsgFrame) error {
 		}
 		logEntry.MsgTo = ctype.Addr2Hex(peer)
 		log.Debugf("Forwarding cond pay receipt to %x, next hop %x", dst, peer)
-		return h.messager.ForwardCelerMsg(peer, frame.Message)
+		return h.forwardCelerMsg(peer, frame.Message)
 	}
 
 	pay, payBytes, egstate, found, err := h.dal.GetPayAndEgressState(payID)
@@ -122,7 +126,7 @@ func (h *CelerMsgHandler) HandleCondPayReceipt(frame *common.MsgFrame) error {
 			RevealSecret: secretMsg,
 		},
 	}
-	err = h.streamWriter.WriteCelerMsg(frame.PeerAddr, celerMsg)
+	err = h.writeCelerMsg(frame.PeerAddr, celerMsg)
 	if err != nil {
 		return fmt.Errorf("WriteCelerMsg err %w", err)
 	}
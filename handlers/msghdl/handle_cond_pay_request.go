@@ -9,12 +9,14 @@ import (
 	"math/big"
 
 	"github.com/celer-network/goCeler/common"
+	"github.com/celer-network/goCeler/common/intfs"
 	"github.com/celer-network/goCeler/common/structs"
 	"github.com/celer-network/goCeler/config"
 	"github.com/celer-network/goCeler/ctype"
 	"github.com/celer-network/goCeler/entity"
 	"github.com/celer-network/goCeler/fsm"
 	"github.com/celer-network/goCeler/ledgerview"
+	"github.com/celer-network/goCeler/metrics"
 	"github.com/celer-network/goCeler/pem"
 	"github.com/celer-network/goCeler/rpc"
 	"github.com/celer-network/goCeler/rtconfig"
@@ -24,6 +26,7 @@ import (
 	"github.com/celer-network/goutils/eth"
 	"github.com/celer-network/goutils/log"
 	"github.com/golang/protobuf/proto"
+	"go.opencensus.io/trace"
 )
 
 const onchainCheckInterval = 5
@@ -65,7 +68,7 @@ func (h *CelerMsgHandler) condPayRequestInbound(frame *common.MsgFrame) error {
 	}
 
 	// Sign the state in advance, verify request later
-	mySig, err := h.signer.SignEthMessage(request.GetStateOnlyPeerFromSig().GetSimplexState())
+	mySig, err := utils.SignEthMessageOfKind(h.signer, intfs.SignKindSimplexState, request.GetStateOnlyPeerFromSig().GetSimplexState())
 	if err != nil {
 		return fmt.Errorf("failed to sign: %w", err)
 	}
@@ -89,6 +92,10 @@ func (h *CelerMsgHandler) condPayRequestInbound(frame *common.MsgFrame) error {
 
 	requestErr := h.processCondPayRequest(
 		request, cid, peerFrom, payID, &pay, &recvdState, &recvdSimplex, logEntry)
+	if request.GetTraceId() != "" {
+		logEntry.TraceId = request.GetTraceId()
+		h.recordPayTraceRecv(payID, request.GetTraceId(), peerFrom, requestErr)
+	}
 
 	var response *rpc.CondPayResponse
 	if requestErr != nil {
@@ -98,6 +105,8 @@ func (h *CelerMsgHandler) condPayRequestInbound(frame *common.MsgFrame) error {
 		}
 		if errors.Is(requestErr, common.ErrInvalidSeqNum) {
 			errMsg.Code = rpc.ErrCode_INVALID_SEQ_NUM
+		} else if errors.Is(requestErr, common.ErrInsufficientFee) {
+			errMsg.Code = rpc.ErrCode_INSUFFICIENT_FEE
 		}
 		if errors.Is(requestErr, common.ErrPayRouteLoop) {
 			errMsg.Code = rpc.ErrCode_PAY_ROUTE_LOOP
@@ -138,7 +147,7 @@ func (h *CelerMsgHandler) condPayRequestInbound(frame *common.MsgFrame) error {
 			CondPayResponse: response,
 		},
 	}
-	err = h.streamWriter.WriteCelerMsg(peerFrom, celerMsg)
+	err = h.writeCelerMsg(peerFrom, celerMsg)
 	if err != nil {
 		if requestErr != nil {
 			logEntry.Error = append(logEntry.Error, err.Error())
@@ -165,16 +174,17 @@ func (h *CelerMsgHandler) processCondPayRequest(
 		return common.ErrInvalidSig // corrupted peer
 	}
 
-	// verify pay source
-	if ctype.Bytes2Addr(pay.GetSrc()) == h.nodeConfig.GetOnChainAddr() {
+	// verify source route and pay source
+	selfPay, err := checkPayRoute(h.nodeConfig.GetOnChainAddr(), pay, request.GetRoute())
+	if err != nil {
 		if seqErr := h.checkSeqNum(request, cid, recvdSimplex, logEntry); seqErr != nil {
 			return seqErr
 		}
-		return common.ErrInvalidPaySrc // pay src is myself
+		return err
 	}
 
 	// verify payment deadline is within limit
-	blknum := h.monitorService.GetCurrentBlockNumber().Uint64()
+	blknum := h.chainOf(cid).MonitorService.GetCurrentBlockNumber().Uint64()
 	if pay.GetResolveDeadline() > blknum+rtconfig.GetMaxPaymentTimeout() {
 		if seqErr := h.checkSeqNum(request, cid, recvdSimplex, logEntry); seqErr != nil {
 			return seqErr
@@ -183,8 +193,8 @@ func (h *CelerMsgHandler) processCondPayRequest(
 		return fmt.Errorf("%w, deadline %d current %d", common.ErrInvalidPayDeadline, pay.GetResolveDeadline(), blknum)
 	}
 	var routeLoop bool
-	err := h.dal.Transactional(
-		h.processCondPayRequestTx, request, cid, payID, pay, recvdState, recvdSimplex, logEntry, &routeLoop)
+	err = h.dal.TracedTransactional(h.span, "processCondPayRequest",
+		h.processCondPayRequestTx, request, cid, payID, pay, recvdState, recvdSimplex, selfPay, logEntry, &routeLoop)
 	if err != nil {
 		return err
 	}
@@ -194,6 +204,19 @@ func (h *CelerMsgHandler) processCondPayRequest(
 	return nil
 }
 
+// checkPayRoute verifies that I am the next hop of a source routed pay, and that a pay sent by
+// myself only comes back to me as a source routed self pay. Returns true for a returned self pay.
+func checkPayRoute(myAddr ctype.Addr, pay *entity.ConditionalPay, route [][]byte) (bool, error) {
+	if len(route) > 0 && ctype.Bytes2Addr(route[0]) != myAddr {
+		return false, fmt.Errorf("%w, next hop %x", common.ErrInvalidPayRoute, route[0])
+	}
+	selfPay := len(route) > 0 && ctype.Bytes2Addr(pay.GetDest()) == myAddr
+	if ctype.Bytes2Addr(pay.GetSrc()) == myAddr && !selfPay {
+		return false, common.ErrInvalidPaySrc // pay src is myself
+	}
+	return selfPay, nil
+}
+
 // checkSeqNum is used to to give ErrInvalidSeqNum higher priority over other errors.
 // It is only called when another error has already been found
 func (h *CelerMsgHandler) checkSeqNum(
@@ -223,8 +246,9 @@ func (h *CelerMsgHandler) processCondPayRequestTx(tx *storage.DALTx, args ...int
 	pay := args[3].(*entity.ConditionalPay)
 	recvdState := args[4].(*rpc.SignedSimplexState)
 	recvdSimplex := args[5].(*entity.SimplexPaymentChannel)
-	logEntry := args[6].(*pem.PayEventMessage)
-	retRouteLoop := args[7].(*bool)
+	selfPay := args[6].(bool)
+	logEntry := args[7].(*pem.PayEventMessage)
+	retRouteLoop := args[8].(*bool)
 
 	peer, chanState, onChainBalance, baseSeq, lastAckedSeq,
 		selfSimplex, storedSimplex, found, err := tx.GetChanForRecvPayRequest(cid)
@@ -263,25 +287,51 @@ func (h *CelerMsgHandler) processCondPayRequestTx(tx *storage.DALTx, args ...int
 
 	} else {
 		// verify request
-		err = h.verifyCondPayRequest(storedSimplex, payID, pay, recvdSimplex)
+		fee := new(big.Int).SetBytes(request.GetFee())
+		err = h.verifyCondPayRequest(storedSimplex, payID, pay, fee, recvdSimplex)
 		if err != nil {
 			return err
 		}
 
+		// verify relay fee if I need to forward the pay
+		if !selfPay && ctype.Bytes2Addr(pay.GetDest()) != h.nodeConfig.GetOnChainAddr() {
+			myFee := h.getForwardFee(pay)
+			if fee.Cmp(myFee) < 0 {
+				return fmt.Errorf("%w, need %s recvd %s", common.ErrInsufficientFee, myFee, fee)
+			}
+		}
+
 		// TODO(xli): no need for this read, use sql write err message to tell if key already exists
 		_, _, found, err2 := tx.GetPayEgress(payID)
 		if err2 != nil {
 			return fmt.Errorf("GetPayEgress err %w", err)
 		}
-		// routeLoop detected if pay info already exist
-		*retRouteLoop = found
-		if !found {
+		if selfPay {
+			// self pay sent by myself has returned through the ingress channel
+			if !found {
+				return common.ErrPayNotFound
+			}
+			err = tx.UpdatePayIngress(payID, cid, structs.PayState_COSIGNED_PENDING)
+			if err != nil {
+				return fmt.Errorf("UpdatePayIngress err %w", err)
+			}
+		} else if found {
+			// routeLoop detected if pay info already exist
+			*retRouteLoop = true
+		} else {
 			err = tx.InsertPayment(
 				payID, request.GetCondPay(), pay, request.GetNote(), cid, structs.PayState_COSIGNED_PENDING, ctype.ZeroCid, structs.PayState_NULL)
 			if err != nil {
 				return fmt.Errorf("InsertPayment err %w", err)
 			}
 		}
+		// keep the fee locked in this channel, including the one of a route loop pay, to settle it later
+		if fee.Sign() > 0 {
+			err = tx.PutPayFee(payID, cid, fee)
+			if err != nil {
+				return fmt.Errorf("PutPayFee err %w", err)
+			}
+		}
 	}
 
 	// record
@@ -298,6 +348,7 @@ func (h *CelerMsgHandler) verifyCondPayRequest(
 	storedSimplex *entity.SimplexPaymentChannel,
 	payID ctype.PayIDType,
 	pay *entity.ConditionalPay,
+	fee *big.Int,
 	recvdSimplex *entity.SimplexPaymentChannel) error {
 
 	// verify unconditional transfer
@@ -338,15 +389,17 @@ func (h *CelerMsgHandler) verifyCondPayRequest(
 
 	// verify pay resolver address
 	payResolver := ctype.Bytes2Addr(pay.GetPayResolver())
-	if payResolver != h.nodeConfig.GetPayResolverContract().GetAddr() {
+	chain := h.chainOf(ctype.Bytes2Cid(recvdSimplex.GetChannelId()))
+	if payResolver != chain.NodeConfig.GetPayResolverContract().GetAddr() {
 		log.Errorln(common.ErrInvalidPayResolver, payResolver.Hex())
 		return common.ErrInvalidPayResolver // should not happen if peer has the same config
 	}
 
-	// verify total pending amount
+	// verify total pending amount, which locks the relay fee along with the pay
 	storedPendingAmt := new(big.Int).SetBytes(storedSimplex.TotalPendingAmount)
 	recvdPendingAmt := new(big.Int).SetBytes(recvdSimplex.TotalPendingAmount)
 	recvdAmt := new(big.Int).SetBytes(pay.GetTransferFunc().GetMaxTransfer().GetReceiver().GetAmt())
+	recvdAmt.Add(recvdAmt, fee)
 	if new(big.Int).Add(storedPendingAmt, recvdAmt).Cmp(recvdPendingAmt) != 0 {
 		log.Errorln(common.ErrInvalidPendingAmt, storedPendingAmt, recvdAmt, recvdPendingAmt)
 		return common.ErrInvalidPendingAmt // corrupted peer
@@ -402,10 +455,11 @@ func (h *CelerMsgHandler) verifyCommonPayRequest(
 	}
 
 	// verify balance
-	blkNum := h.monitorService.GetCurrentBlockNumber().Uint64()
+	blkNum := h.chainOf(cid).MonitorService.GetCurrentBlockNumber().Uint64()
 	balance := ledgerview.ComputeBalance(
 		selfSimplex, storedSimplex, onChainBalance, h.nodeConfig.GetOnChainAddr(), peer, blkNum)
 	recvdAmt := new(big.Int).SetBytes(pay.GetTransferFunc().GetMaxTransfer().GetReceiver().GetAmt())
+	recvdAmt.Add(recvdAmt, new(big.Int).SetBytes(request.GetFee()))
 	if recvdAmt.Cmp(balance.PeerFree) == 1 {
 		if !h.isOSP {
 			lastSyncBlk, _ := tx.GetQueryTime(config.QueryName_OnChainBalance)
@@ -489,6 +543,13 @@ func (h *CelerMsgHandler) condPayRequestOutbound(frame *common.MsgFrame) error {
 		}
 	}
 
+	if isRecipient && ctype.Bytes2Addr(pay.GetSrc()) == dest {
+		// self pay completed the cycle, I have the secret so settle the egress directly
+		log.Debugln("Self pay returned, settle egress", payID.Hex())
+		amt := new(big.Int).SetBytes(pay.GetTransferFunc().GetMaxTransfer().GetReceiver().GetAmt())
+		return h.messager.SendOnePaySettleRequest(h.span, &pay, amt, rpc.PaymentSettleReason_PAY_PAID_MAX, logEntry)
+	}
+
 	if isRecipient {
 		// reply conPay receipt
 		log.Debugln("Reply pay receipt", payID.Hex())
@@ -500,13 +561,14 @@ func (h *CelerMsgHandler) condPayRequestOutbound(frame *common.MsgFrame) error {
 			}
 			signedPayBytes = request.GetCrossNet().GetOriginalPay()
 		}
-		sigOfCondPay, err2 := h.signer.SignEthMessage(signedPayBytes)
+		sigOfCondPay, err2 := utils.SignEthMessageOfKind(h.signer, intfs.SignKindCondPay, signedPayBytes)
 		if err2 != nil {
 			return err2
 		}
 		receipt := &rpc.CondPayReceipt{
 			PayId:      payID.Bytes(),
 			PayDestSig: sigOfCondPay,
+			TraceId:    request.GetTraceId(),
 		}
 		if originalPayID != ctype.ZeroPayID {
 			receipt.OriginalPayId = originalPayID.Bytes()
@@ -517,22 +579,40 @@ func (h *CelerMsgHandler) condPayRequestOutbound(frame *common.MsgFrame) error {
 				CondPayReceipt: receipt,
 			},
 		}
-		err2 = h.streamWriter.WriteCelerMsg(peerFrom, celerMsg)
+		err2 = h.writeCelerMsg(peerFrom, celerMsg)
 		if err2 != nil {
+			h.recordPayTraceError(payID, request.GetTraceId(), err2.Error())
 			return fmt.Errorf(err2.Error() + ", FAIL_SEND_RECEIPT")
 		}
+		h.recordPayTraceReceipt(payID, request.GetTraceId())
 		return nil
 	}
 
 	// Forward condPay to next hop if I am not the destination
 	log.Debugln("Forward", payID.Hex())
 	delegable, proof, description := h.checkPayDelegable(&pay, ctype.Bytes2Addr(pay.GetDest()), logEntry)
-	peerTo, err := h.messager.ForwardCondPayRequest(payBytes, request.GetNote(), delegable, request.GetCrossNet(), logEntry)
+	span := metrics.StartSpan(h.span, "Messager.ForwardCondPayRequest")
+	var route [][]byte
+	if len(request.GetRoute()) > 0 {
+		route = request.GetRoute()[1:]
+	}
+	// keep my fee and pass the rest to the downstream hops
+	fwdFee := new(big.Int).Sub(new(big.Int).SetBytes(request.GetFee()), h.getForwardFee(&pay))
+	if fwdFee.Sign() < 0 {
+		fwdFee.SetUint64(0)
+	}
+	peerTo, err := h.messager.ForwardCondPayRequest(span,
+		payBytes, request.GetNote(), delegable, request.GetCrossNet(), request.GetTraceId(), route, fwdFee, logEntry)
+	span.AddAttributes(
+		trace.StringAttribute(metrics.AkMsgTo, ctype.Addr2Hex(peerTo)),
+		trace.StringAttribute(metrics.AkToCid, logEntry.GetToCid()))
+	metrics.EndSpan(span, err)
 	if err != nil {
 		if delegable && errors.Is(err, common.ErrPeerNotOnline) {
 			return h.delegatePay(payID, &pay, payBytes, description, proof, peerFrom, dest, logEntry)
 		}
 		logEntry.Error = append(logEntry.Error, err.Error()+", DST_UNREACHABLE")
+		h.recordPayTraceError(payID, request.GetTraceId(), err.Error())
 		errmsg := &rpc.Error{
 			Reason: err.Error(),
 		}
@@ -558,12 +638,18 @@ func (h *CelerMsgHandler) condPayRequestOutbound(frame *common.MsgFrame) error {
 		}
 
 		// Cancel the payment upfront
-		return h.messager.SendPayUnreachableSettleProof(payID, payPath, logEntry)
+		return h.messager.SendPayUnreachableSettleProof(h.span, payID, payPath, logEntry)
 	}
 
 	return nil
 }
 
+// getForwardFee returns the relay fee I charge for forwarding the pay
+func (h *CelerMsgHandler) getForwardFee(pay *entity.ConditionalPay) *big.Int {
+	amt := new(big.Int).SetBytes(pay.GetTransferFunc().GetMaxTransfer().GetReceiver().GetAmt())
+	return rtconfig.GetForwardFee(utils.GetTokenAddrStr(pay.GetTransferFunc().GetMaxTransfer().GetToken()), amt)
+}
+
 func (h *CelerMsgHandler) delegatePay(
 	payID ctype.PayIDType,
 	pay *entity.ConditionalPay,
@@ -577,7 +663,7 @@ func (h *CelerMsgHandler) delegatePay(
 	log.Debugf("Delegating pay %x", payID)
 	// Unable to send to dest but I'm authorized to delegate receiving the payment.
 	logEntry.DelegationDescription = description
-	sigOfCondPay, err := h.signer.SignEthMessage(payBytes)
+	sigOfCondPay, err := utils.SignEthMessageOfKind(h.signer, intfs.SignKindCondPay, payBytes)
 	if err != nil {
 		return fmt.Errorf("sign delegate pay err %w", err)
 	}
@@ -592,7 +678,7 @@ func (h *CelerMsgHandler) delegatePay(
 			CondPayReceipt: receipt,
 		},
 	}
-	err = h.streamWriter.WriteCelerMsg(peerFrom, celerMsg)
+	err = h.writeCelerMsg(peerFrom, celerMsg)
 	if err != nil {
 		return fmt.Errorf("send delegation receipt err %w", err)
 	}
@@ -705,6 +791,7 @@ func (h *CelerMsgHandler) crossNetPayInbound(
 				CondPay:  newPayBytes,
 				Note:     request.GetNote(),
 				CrossNet: xnet,
+				TraceId:  request.GetTraceId(),
 			},
 		},
 	}
@@ -0,0 +1,47 @@
+// Copyright 2020 Celer Network
+
+package msghdl
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/celer-network/goCeler/common"
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/entity"
+)
+
+func TestCheckPayRoute(t *testing.T) {
+	me, peer, other := ctype.Hex2Addr("abc1"), ctype.Hex2Addr("abc2"), ctype.Hex2Addr("abc3")
+	pay := func(src, dest ctype.Addr) *entity.ConditionalPay {
+		return &entity.ConditionalPay{Src: src.Bytes(), Dest: dest.Bytes()}
+	}
+	tests := []struct {
+		name    string
+		pay     *entity.ConditionalPay
+		route   []ctype.Addr
+		selfPay bool
+		err     error
+	}{
+		{"no route", pay(peer, other), nil, false, nil},
+		{"no route to me", pay(peer, me), nil, false, nil},
+		{"no route from me", pay(me, other), nil, false, common.ErrInvalidPaySrc},
+		{"no route self pay", pay(me, me), nil, false, common.ErrInvalidPaySrc},
+		{"routed through me", pay(peer, other), []ctype.Addr{me, other}, false, nil},
+		{"routed through other", pay(peer, other), []ctype.Addr{other, me}, false, common.ErrInvalidPayRoute},
+		{"routed from me", pay(me, other), []ctype.Addr{me, other}, false, common.ErrInvalidPaySrc},
+		{"self pay returned", pay(me, me), []ctype.Addr{me}, true, nil},
+		{"self pay of other", pay(peer, peer), []ctype.Addr{me, peer}, false, nil},
+		{"self pay through other", pay(me, me), []ctype.Addr{other, me}, false, common.ErrInvalidPayRoute},
+	}
+	for _, tc := range tests {
+		var route [][]byte
+		for _, hop := range tc.route {
+			route = append(route, hop.Bytes())
+		}
+		selfPay, err := checkPayRoute(me, tc.pay, route)
+		if selfPay != tc.selfPay || !errors.Is(err, tc.err) || (err == nil) != (tc.err == nil) {
+			t.Errorf("%s: got self pay %t err %v, expect %t %v", tc.name, selfPay, err, tc.selfPay, tc.err)
+		}
+	}
+}
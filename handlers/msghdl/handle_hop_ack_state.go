@@ -70,13 +70,13 @@ func (h *CelerMsgHandler) HandleHopAckState(frame *common.MsgFrame) error {
 	var nackedInflightMsgs []*rpc.CelerMsg
 	var routeLoopPayMsg *rpc.CelerMsg
 	var lastNackSeqNum uint64
-	err = h.dal.Transactional(
+	err = h.dal.TracedTransactional(h.span, "handleHopAck",
 		h.handleHopAckTx, ackState, &ackSimplex, ackErr, cid,
 		&ackedMsgs, &nackedErrMsg, &nackedInflightMsgs, &lastNackSeqNum, &routeLoopPayMsg)
 	if err != nil {
 		log.Error(err)
 	} else {
-		err = h.messager.AckMsgQueue(cid, ackSeqNum, lastNackSeqNum)
+		err = h.messager.AckMsgQueue(cid, ackSeqNum, lastNackSeqNum, ackErr.GetCode())
 		if err != nil {
 			log.Error(err)
 		}
@@ -110,6 +110,7 @@ func (h *CelerMsgHandler) HandleHopAckState(frame *common.MsgFrame) error {
 				log.Error(err)
 				return err
 			}
+			h.recordPayTraceError(payID, request.GetTraceId(), "nack: "+ackErr.GetReason())
 			h.notifyPayError(payID, &pay, ackErr.GetReason())
 		} else if nackedErrMsg.GetPaymentSettleRequest() != nil {
 			for _, settledPay := range nackedErrMsg.GetPaymentSettleRequest().GetSettledPays() {
@@ -150,7 +151,9 @@ func (h *CelerMsgHandler) HandleHopAckState(frame *common.MsgFrame) error {
 			resendLogEntry.PayId = ctype.PayID2Hex(payID)
 			resendLogEntry.Dst = ctype.Bytes2Hex(pay.GetDest())
 			resendLogEntry.DirectPay = directPay
-			err = h.messager.SendCondPayRequest(req.GetCondPay(), req.GetNote(), req.GetCrossNet(), resendLogEntry)
+			err = h.messager.SendCondPayRequest(
+				h.span, req.GetCondPay(), req.GetNote(), req.GetCrossNet(), req.GetTraceId(), req.GetRoute(),
+				new(big.Int).SetBytes(req.GetFee()), resendLogEntry)
 			if err != nil {
 				log.Error(err)
 				resendLogEntry.Error = append(resendLogEntry.Error, err.Error())
@@ -179,7 +182,7 @@ func (h *CelerMsgHandler) HandleHopAckState(frame *common.MsgFrame) error {
 				reason = settledPay.GetReason()
 			}
 			resendLogEntry.Type = pem.PayMessageType_PAY_SETTLE_REQUEST
-			_, err = h.messager.SendPaysSettleRequest(pays, amts, reason, resendLogEntry)
+			_, err = h.messager.SendPaysSettleRequest(h.span, pays, amts, reason, resendLogEntry)
 			if err != nil {
 				log.Error(err)
 				resendLogEntry.Error = append(resendLogEntry.Error, err.Error())
@@ -227,7 +230,7 @@ func (h *CelerMsgHandler) HandleHopAckState(frame *common.MsgFrame) error {
 			}
 		}
 		return h.messager.SendOnePaySettleRequest(
-			&pay, new(big.Int).SetUint64(0), rpc.PaymentSettleReason_PAY_DEST_UNREACHABLE, logEntry)
+			h.span, &pay, new(big.Int).SetUint64(0), rpc.PaymentSettleReason_PAY_DEST_UNREACHABLE, logEntry)
 	}
 
 	return nil
@@ -362,7 +365,7 @@ func (h *CelerMsgHandler) forwardToUpstream(
 	if settledPay.GetReason() == rpc.PaymentSettleReason_PAY_REJECTED ||
 		settledPay.GetReason() == rpc.PaymentSettleReason_PAY_RESOLVED_ONCHAIN {
 		log.Debugln("forward pay to upstream", payID.Hex(), settledPay.GetReason())
-		err := h.messager.SendOnePaySettleProof(payID, settledPay.GetReason(), logEntry)
+		err := h.messager.SendOnePaySettleProof(h.span, payID, settledPay.GetReason(), logEntry)
 		if err != nil {
 			logEntry.Error = append(logEntry.Error, "SendOnePaySettleProof err: "+err.Error())
 			return
@@ -378,7 +381,7 @@ func (h *CelerMsgHandler) forwardToUpstream(
 				logEntry.Error = append(logEntry.Error, "DeletePayPath err: "+err.Error())
 			}
 		}
-		err = h.messager.SendPayUnreachableSettleProof(payID, payPath, logEntry)
+		err = h.messager.SendPayUnreachableSettleProof(h.span, payID, payPath, logEntry)
 		if err != nil {
 			logEntry.Error = append(logEntry.Error, "SendPayUnreachableSettleProof err: "+err.Error())
 			return
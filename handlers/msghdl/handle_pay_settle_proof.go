@@ -68,7 +68,7 @@ func (h *CelerMsgHandler) HandlePaySettleProof(frame *common.MsgFrame) error {
 		if len(expiredPays) == 0 {
 			return fmt.Errorf("no valid expired pays to settle")
 		}
-		_, err = h.messager.SendPaysSettleRequest(expiredPays, payAmts, reason, logEntry)
+		_, err = h.messager.SendPaysSettleRequest(h.span, expiredPays, payAmts, reason, logEntry)
 		if err != nil {
 			err = fmt.Errorf("SendPaysSettleRequest err: %w", err)
 		}
@@ -99,7 +99,7 @@ func (h *CelerMsgHandler) HandlePaySettleProof(frame *common.MsgFrame) error {
 	switch reason {
 	case rpc.PaymentSettleReason_PAY_REJECTED:
 	case rpc.PaymentSettleReason_PAY_RESOLVED_ONCHAIN:
-		payAmt, _, err = h.disputer.GetCondPayInfoFromRegistry(payID)
+		payAmt, _, err = h.disputerOf(pay).GetCondPayInfoFromRegistry(payID)
 		if err != nil {
 			return fmt.Errorf("GetCondPayInfoFromRegistry err: %w", err)
 		}
@@ -134,7 +134,7 @@ func (h *CelerMsgHandler) HandlePaySettleProof(frame *common.MsgFrame) error {
 		return fmt.Errorf("Unsupported payment settle type")
 	}
 
-	err = h.messager.SendOnePaySettleRequest(pay, payAmt, reason, logEntry)
+	err = h.messager.SendOnePaySettleRequest(h.span, pay, payAmt, reason, logEntry)
 	if err != nil {
 		return fmt.Errorf("SendOnePaySettleRequest err: %w", err)
 	}
@@ -8,7 +8,9 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/celer-network/goCeler/accounting"
 	"github.com/celer-network/goCeler/common"
+	"github.com/celer-network/goCeler/common/intfs"
 	"github.com/celer-network/goCeler/common/structs"
 	enums "github.com/celer-network/goCeler/common/structs"
 	"github.com/celer-network/goCeler/config"
@@ -33,6 +35,7 @@ type settledPayInfo struct {
 	igcid     ctype.CidType
 	igstate   int
 	egstate   int
+	fee       *big.Int // relay fee locked with the pay in this channel
 	routeLoop bool
 	delegated bool
 }
@@ -67,7 +70,7 @@ func (h *CelerMsgHandler) paySettleRequestInbound(
 	}
 
 	// Sign the state in advance, verify request later
-	mySig, err := h.signer.SignEthMessage(request.GetStateOnlyPeerFromSig().GetSimplexState())
+	mySig, err := utils.SignEthMessageOfKind(h.signer, intfs.SignKindSimplexState, request.GetStateOnlyPeerFromSig().GetSimplexState())
 	if err != nil {
 		return nil, fmt.Errorf("failed to sign: %w", err)
 	}
@@ -130,7 +133,7 @@ func (h *CelerMsgHandler) paySettleRequestInbound(
 			PaymentSettleResponse: response,
 		},
 	}
-	err = h.streamWriter.WriteCelerMsg(peerFrom, celerMsg)
+	err = h.writeCelerMsg(peerFrom, celerMsg)
 	if err != nil {
 		return nil, err
 	}
@@ -160,7 +163,7 @@ func (h *CelerMsgHandler) processPaySettleRequest(
 	}
 
 	var payInfos []*settledPayInfo
-	err = h.dal.Transactional(
+	err = h.dal.TracedTransactional(h.span, "processPaySettleRequest",
 		h.processPaySettleRequestTx, request, cid, recvdState, recvdSimplex, &payInfos, logEntry)
 	if err != nil {
 		return nil, err
@@ -250,7 +253,7 @@ func (h *CelerMsgHandler) processPaySettleRequestTx(tx *storage.DALTx, args ...i
 		return common.ErrInvalidPendingPays // corrupted peer
 	}
 
-	// get resolved pays
+	// get resolved pays, whose locked relay fees are resolved along with them
 	resolvedAmt := new(big.Int).SetUint64(0)
 	for _, pi := range payInfos {
 		payID := ctype.Bytes2PayID(pi.req.GetSettledPayId())
@@ -263,6 +266,13 @@ func (h *CelerMsgHandler) processPaySettleRequestTx(tx *storage.DALTx, args ...i
 		}
 		amt := new(big.Int).SetBytes(pi.pay.GetTransferFunc().GetMaxTransfer().GetReceiver().GetAmt())
 		resolvedAmt = resolvedAmt.Add(resolvedAmt, amt)
+		pi.fee, found, err = tx.GetPayFee(payID, cid)
+		if err != nil {
+			return fmt.Errorf("GetPayFee %x err %w", payID, err)
+		}
+		if found {
+			resolvedAmt = resolvedAmt.Add(resolvedAmt, pi.fee)
+		}
 
 		err = tx.DeleteSecretByPayID(payID)
 		if err != nil {
@@ -330,7 +340,7 @@ func (h *CelerMsgHandler) processPaySettleRequestTx(tx *storage.DALTx, args ...i
 		}
 
 	case rpc.PaymentSettleReason_PAY_EXPIRED:
-		curblkNum := h.monitorService.GetCurrentBlockNumber().Uint64()
+		curblkNum := h.chainOf(cid).MonitorService.GetCurrentBlockNumber().Uint64()
 		for _, pi := range payInfos {
 			h.checkPayRouteLoop(cid, pi)
 			if pi.routeLoop {
@@ -360,7 +370,7 @@ func (h *CelerMsgHandler) processPaySettleRequestTx(tx *storage.DALTx, args ...i
 			// verify pay canceled downstream or not resolved on chain
 			// TODO: pay dest can query db about the onchain resolve history instead of onchain view
 			if pi.egstate != enums.PayState_COSIGNED_CANCELED {
-				amt, _, err2 := h.disputer.GetCondPayInfoFromRegistry(payID)
+				amt, _, err2 := h.disputerOf(pi.pay).GetCondPayInfoFromRegistry(payID)
 				if err2 != nil {
 					return fmt.Errorf("GetCondPayInfoFromRegistry %x err %w", payID, err2)
 				}
@@ -400,6 +410,14 @@ func (h *CelerMsgHandler) processPaySettleRequestTx(tx *storage.DALTx, args ...i
 			if err != nil {
 				return fmt.Errorf("pay %x fsm err %w, paid %t", payID, err, paid)
 			}
+			// the relay fee is earned only when the pay is paid
+			if paid && pi.fee != nil && pi.fee.Sign() > 0 {
+				token := ctype.Bytes2Addr(pi.pay.GetTransferFunc().GetMaxTransfer().GetToken().GetTokenAddress())
+				err = tx.InsertAcctRecord(accounting.FeeInRecord(payID, cid, token, pi.fee))
+				if err != nil {
+					return fmt.Errorf("InsertAcctRecord err %w", err)
+				}
+			}
 		}
 	}
 
@@ -461,7 +479,7 @@ func (h *CelerMsgHandler) paySettleRequestOutbound(payInfos []*settledPayInfo, l
 			// This ensures that PAID_MAX settle request would be eventually forwarded.
 			if !(isLocalPeer == false && err == nil) {
 				amt := new(big.Int).SetBytes(pay.GetTransferFunc().GetMaxTransfer().GetReceiver().GetAmt())
-				return h.messager.SendOnePaySettleRequest(pay, amt, rpc.PaymentSettleReason_PAY_PAID_MAX, logEntry)
+				return h.messager.SendOnePaySettleRequest(h.span, pay, amt, rpc.PaymentSettleReason_PAY_PAID_MAX, logEntry)
 			}
 		}
 	}
@@ -0,0 +1,156 @@
+// Copyright 2020 Celer Network
+
+package msghdl
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/celer-network/goCeler/common"
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/rpc"
+	"github.com/celer-network/goutils/log"
+)
+
+func (h *CelerMsgHandler) HandlePayTraceRequest(frame *common.MsgFrame) error {
+	request := frame.Message.GetPayTraceRequest()
+	if request == nil {
+		return common.ErrInvalidMsgType
+	}
+	peer := frame.PeerAddr
+	payID := ctype.Bytes2PayID(request.GetPayId())
+	frame.LogEntry.PayId = ctype.PayID2Hex(payID)
+
+	span, found, err := h.dal.GetPayTrace(payID)
+	if err != nil {
+		h.replyPayTrace(peer, request, nil)
+		return fmt.Errorf("GetPayTrace err: %w", err)
+	}
+	if !found {
+		h.replyPayTrace(peer, request, nil)
+		return nil
+	}
+	frame.LogEntry.TraceId = span.GetTraceId()
+
+	// only share the span with peers on the pay path, and keep following the path away from the requester
+	var expectedPeer, nextHop ctype.Addr
+	if request.GetDownstream() {
+		expectedPeer, nextHop = ctype.Bytes2Addr(span.GetPrevHop()), ctype.Bytes2Addr(span.GetNextHop())
+	} else {
+		expectedPeer, nextHop = ctype.Bytes2Addr(span.GetNextHop()), ctype.Bytes2Addr(span.GetPrevHop())
+	}
+	if peer != expectedPeer {
+		h.replyPayTrace(peer, request, nil)
+		return fmt.Errorf("pay trace requester %x not on pay path", peer)
+	}
+	span.Node = h.nodeConfig.GetOnChainAddr().Bytes()
+
+	timeout := time.Duration(request.GetTimeoutMs()) * time.Millisecond
+	if nextHop == ctype.ZeroAddr || timeout == 0 {
+		h.replyPayTrace(peer, request, []*rpc.PayTraceSpan{span})
+		return nil
+	}
+	// do not block the message stream while waiting for further hops
+	go func() {
+		spans := []*rpc.PayTraceSpan{span}
+		hopSpans, err2 := h.messager.QueryPayTrace(nextHop, payID, request.GetDownstream(), timeout)
+		if err2 != nil {
+			log.Warnf("query pay %x trace from %x err: %s", payID, nextHop, err2)
+		}
+		h.replyPayTrace(peer, request, append(spans, hopSpans...))
+	}()
+	return nil
+}
+
+func (h *CelerMsgHandler) HandlePayTraceResponse(frame *common.MsgFrame) error {
+	response := frame.Message.GetPayTraceResponse()
+	if response == nil {
+		return common.ErrInvalidMsgType
+	}
+	frame.LogEntry.PayId = ctype.Bytes2Hex(response.GetPayId())
+	// TODO: in the multi-server setup the response arrives at the server holding the peer stream,
+	// it is dropped here if the query was sent by another server, and that query times out.
+	if !h.messager.DeliverPayTraceResponse(frame.PeerAddr, response) {
+		log.Warnf("no pending pay %x trace query to %x", response.GetPayId(), frame.PeerAddr)
+	}
+	return nil
+}
+
+func (h *CelerMsgHandler) replyPayTrace(peer ctype.Addr, request *rpc.PayTraceRequest, spans []*rpc.PayTraceSpan) {
+	celerMsg := &rpc.CelerMsg{
+		Message: &rpc.CelerMsg_PayTraceResponse{
+			PayTraceResponse: &rpc.PayTraceResponse{
+				PayId:      request.GetPayId(),
+				Downstream: request.GetDownstream(),
+				Spans:      spans,
+			},
+		},
+	}
+	err := h.forwardCelerMsg(peer, celerMsg)
+	if err != nil {
+		log.Errorf("reply pay %x trace to %x err: %s", request.GetPayId(), peer, err)
+	}
+}
+
+// recordPayTraceRecv records the span of a traced pay received from peer
+func (h *CelerMsgHandler) recordPayTraceRecv(
+	payID ctype.PayIDType, traceID string, peerFrom ctype.Addr, recvErr error) {
+	if traceID == "" {
+		return
+	}
+	if recvErr != nil {
+		_, found, err := h.dal.GetPayTrace(payID)
+		if err != nil {
+			log.Errorln("GetPayTrace err:", err, payID.Hex())
+			return
+		}
+		if found { // route loop or resent request
+			h.recordPayTraceError(payID, traceID, recvErr.Error())
+			return
+		}
+	}
+	span := &rpc.PayTraceSpan{
+		PayId:   payID.Bytes(),
+		TraceId: traceID,
+		PrevHop: peerFrom.Bytes(),
+		RecvTs:  time.Now().UnixNano(),
+	}
+	if recvErr != nil {
+		span.Errors = []string{recvErr.Error()}
+	}
+	err := h.dal.InsertPayTrace(span)
+	if err != nil {
+		log.Errorln("InsertPayTrace err:", err, payID.Hex())
+	}
+}
+
+// recordPayTraceReceipt records the time the cond pay receipt of a traced pay passes through
+func (h *CelerMsgHandler) recordPayTraceReceipt(payID ctype.PayIDType, traceID string) {
+	if traceID == "" {
+		return
+	}
+	err := h.dal.UpdatePayTraceReceipt(payID, time.Now().UnixNano())
+	if err != nil {
+		log.Warnln("UpdatePayTraceReceipt err:", err, payID.Hex())
+	}
+}
+
+// recordPayTraceError appends an error to the span of a traced pay
+func (h *CelerMsgHandler) recordPayTraceError(payID ctype.PayIDType, traceID string, errMsg string) {
+	if traceID == "" {
+		return
+	}
+	span, found, err := h.dal.GetPayTrace(payID)
+	if err != nil {
+		log.Errorln("GetPayTrace err:", err, payID.Hex())
+		return
+	}
+	if !found {
+		log.Warnln("pay trace not found", payID.Hex())
+		return
+	}
+	err = h.dal.UpdatePayTraceErrors(payID, append(span.GetErrors(), errMsg))
+	if err != nil {
+		log.Errorln("UpdatePayTraceErrors err:", err, payID.Hex())
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright 2020 Celer Network
+
+package msghdl
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/celer-network/goCeler/chain/channel-eth-go/payresolver"
+	"github.com/celer-network/goCeler/common"
+	"github.com/celer-network/goCeler/common/cobj"
+	"github.com/celer-network/goCeler/config"
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/messager"
+	"github.com/celer-network/goCeler/pem"
+	"github.com/celer-network/goCeler/rpc"
+	"github.com/celer-network/goCeler/storage"
+)
+
+// testTraceNet passes celer msgs between the nodes of a pay path,
+// each message is handled by a new handler of the receiving node
+type testTraceNet struct {
+	t      *testing.T
+	nodes  map[ctype.Addr]*testTraceNode
+	errors chan error
+}
+
+type testTraceNode struct {
+	addr       ctype.Addr
+	nodeConfig common.GlobalNodeConfig
+	messager   *messager.Messager
+	dal        *storage.DAL
+	writer     *testTraceWriter
+}
+
+type testTraceWriter struct {
+	net  *testTraceNet
+	from ctype.Addr
+}
+
+func (w *testTraceWriter) WriteCelerMsg(peer ctype.Addr, msg *rpc.CelerMsg) error {
+	node, ok := w.net.nodes[peer]
+	if !ok {
+		return common.ErrNoCelerStream
+	}
+	go w.net.deliver(w.from, node, msg)
+	return nil
+}
+
+func (n *testTraceNet) deliver(from ctype.Addr, node *testTraceNode, msg *rpc.CelerMsg) {
+	h := NewCelerMsgHandler(
+		node.nodeConfig, node.writer, nil, nil, localPeerForwarder, nil, nil, nil, nil, nil, nil, nil, nil,
+		node.messager, node.dal, true)
+	err := h.Run(&common.MsgFrame{
+		Message:  msg,
+		PeerAddr: from,
+		LogEntry: pem.NewPem(""),
+	})
+	if err != nil {
+		n.errors <- fmt.Errorf("%x handle msg from %x err: %w", node.addr, from, err)
+	}
+}
+
+func localPeerForwarder(dest ctype.Addr, retry bool, msg interface{}) (bool, error) {
+	return true, nil
+}
+
+func (n *testTraceNet) addNode(name string) *testTraceNode {
+	addr := ctype.Hex2Addr(name)
+	stFile := filepath.Join(os.TempDir(), fmt.Sprintf("msghdl_pay_trace_%s_test.db", name))
+	os.Remove(stFile)
+	st, err := storage.NewKVStoreSQL("sqlite3", stFile)
+	if err != nil {
+		n.t.Fatal(err)
+	}
+	n.t.Cleanup(func() {
+		st.Close()
+		os.Remove(stFile)
+	})
+	dal := storage.NewDAL(st)
+	nodeConfig := cobj.NewCelerGlobalNodeConfig(
+		addr, nil, &common.CProfile{}, "", "", "", payresolver.PayResolverABI, "", "", nil)
+	writer := &testTraceWriter{net: n, from: addr}
+	node := &testTraceNode{
+		addr:       addr,
+		nodeConfig: nodeConfig,
+		messager:   messager.NewMessager(nodeConfig, nil, writer, nil, nil, localPeerForwarder, nil, dal, true),
+		dal:        dal,
+		writer:     writer,
+	}
+	n.nodes[addr] = node
+	return node
+}
+
+// addSpan records the span of the pay at the node
+func (n *testTraceNet) addSpan(node *testTraceNode, payID ctype.PayIDType, prevHop, nextHop ctype.Addr) {
+	err := node.dal.InsertPayTrace(&rpc.PayTraceSpan{
+		PayId:   payID.Bytes(),
+		TraceId: "trace",
+		PrevHop: prevHop.Bytes(),
+		NextHop: nextHop.Bytes(),
+		RecvTs:  time.Now().UnixNano(),
+	})
+	if err != nil {
+		n.t.Fatal(err)
+	}
+}
+
+func checkSpanNodes(t *testing.T, name string, spans []*rpc.PayTraceSpan, nodes ...*testTraceNode) {
+	if len(spans) != len(nodes) {
+		t.Errorf("%s: got %d spans, expect %d", name, len(spans), len(nodes))
+		return
+	}
+	for i, span := range spans {
+		if ctype.Bytes2Addr(span.GetNode()) != nodes[i].addr || span.GetTraceId() != "trace" {
+			t.Errorf("%s: span %d from %x, expect %x", name, i, span.GetNode(), nodes[i].addr)
+		}
+	}
+}
+
+func TestPayTrace(t *testing.T) {
+	net := &testTraceNet{t: t, nodes: make(map[ctype.Addr]*testTraceNode), errors: make(chan error, 10)}
+	src, osp1, osp2, osp3, dest := net.addNode("a1"), net.addNode("a2"), net.addNode("a3"), net.addNode("a4"), net.addNode("a5")
+	other := net.addNode("a6")
+
+	// pay path: src -> osp1 -> osp2 -> osp3 -> dest
+	payID := ctype.Bytes2PayID([]byte{1})
+	net.addSpan(src, payID, ctype.ZeroAddr, osp1.addr)
+	net.addSpan(osp1, payID, src.addr, osp2.addr)
+	net.addSpan(osp2, payID, osp1.addr, osp3.addr)
+	net.addSpan(osp3, payID, osp2.addr, dest.addr)
+	net.addSpan(dest, payID, osp3.addr, ctype.ZeroAddr)
+
+	// a pay with a route loop between osp1 and osp2
+	loopPayID := ctype.Bytes2PayID([]byte{2})
+	net.addSpan(src, loopPayID, ctype.ZeroAddr, osp1.addr)
+	net.addSpan(osp1, loopPayID, src.addr, osp2.addr)
+	net.addSpan(osp2, loopPayID, osp1.addr, osp1.addr)
+
+	// a pay whose next hop is not connected
+	lostPayID := ctype.Bytes2PayID([]byte{3})
+	net.addSpan(osp1, lostPayID, src.addr, osp2.addr)
+	net.addSpan(osp2, lostPayID, osp1.addr, ctype.Hex2Addr("a7"))
+
+	timeout := 5 * config.PayTraceHopMargin
+	tests := []struct {
+		name       string
+		from       *testTraceNode
+		to         *testTraceNode
+		payID      ctype.PayIDType
+		downstream bool
+		timeout    time.Duration
+		expected   []*testTraceNode
+		handleErr  bool // if the queried node fails to handle the request
+	}{
+		{"downstream hops", osp1, osp2, payID, true, timeout, []*testTraceNode{osp2, osp3, dest}, false},
+		{"upstream hops", osp3, osp2, payID, false, timeout, []*testTraceNode{osp2, osp1, src}, false},
+		{"path end", osp3, dest, payID, true, timeout, []*testTraceNode{dest}, false},
+		{"no time for further hops", osp1, osp2, payID, true, config.PayTraceHopMargin, []*testTraceNode{osp2}, false},
+		{"requester not on path", other, osp2, payID, true, timeout, nil, true},
+		{"requester on the other side", osp3, osp2, payID, true, timeout, nil, true},
+		{"unknown pay", osp1, osp2, ctype.Bytes2PayID([]byte{4}), true, timeout, nil, false},
+		{"route loop", src, osp1, loopPayID, true, timeout, []*testTraceNode{osp1, osp2}, true},
+		{"next hop not connected", osp1, osp2, lostPayID, true, timeout, []*testTraceNode{osp2}, false},
+	}
+	for _, tc := range tests {
+		spans, err := tc.from.messager.QueryPayTrace(tc.to.addr, tc.payID, tc.downstream, tc.timeout)
+		if err != nil {
+			t.Errorf("%s: QueryPayTrace err: %s", tc.name, err)
+			continue
+		}
+		checkSpanNodes(t, tc.name, spans, tc.expected...)
+		if tc.handleErr {
+			// the handler may return after its reply is delivered
+			select {
+			case <-net.errors:
+			case <-time.After(time.Second):
+				t.Errorf("%s: expect handle err", tc.name)
+			}
+		}
+		select {
+		case err = <-net.errors:
+			t.Errorf("%s: %s", tc.name, err)
+		default:
+		}
+	}
+
+	// the queried node is not connected
+	_, err := osp1.messager.QueryPayTrace(ctype.Hex2Addr("a7"), payID, true, timeout)
+	if err != common.ErrNoCelerStream {
+		t.Errorf("query disconnected peer err: %v", err)
+	}
+}
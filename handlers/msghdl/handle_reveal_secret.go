@@ -9,12 +9,14 @@ import (
 	"math/big"
 
 	"github.com/celer-network/goCeler/common"
+	"github.com/celer-network/goCeler/common/intfs"
 	"github.com/celer-network/goCeler/common/structs"
 	"github.com/celer-network/goCeler/ctype"
 	"github.com/celer-network/goCeler/entity"
 	"github.com/celer-network/goCeler/fsm"
 	"github.com/celer-network/goCeler/rpc"
 	"github.com/celer-network/goCeler/storage"
+	"github.com/celer-network/goCeler/utils"
 	"github.com/celer-network/goutils/eth"
 	"github.com/celer-network/goutils/log"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -49,13 +51,13 @@ func (h *CelerMsgHandler) HandleRevealSecret(frame *common.MsgFrame) error {
 			}
 		}
 		log.Debugf("Forwarding reveal secret to %x, next hop %x", dst, peer)
-		return h.messager.ForwardCelerMsg(peer, frame.Message)
+		return h.forwardCelerMsg(peer, frame.Message)
 	}
 
 	secret := msg.GetSecret()
 	var pay *entity.ConditionalPay
 	var note *any.Any
-	err := h.dal.Transactional(h.recvSecretTx, payID, secret, &pay, &note)
+	err := h.dal.TracedTransactional(h.span, "recvSecret", h.recvSecretTx, payID, secret, &pay, &note)
 	if err != nil {
 		if errors.Is(err, common.ErrPayOffChainResolved) {
 			log.Warnln(err, payID.Hex())
@@ -65,7 +67,7 @@ func (h *CelerMsgHandler) HandleRevealSecret(frame *common.MsgFrame) error {
 	}
 
 	// send RevealSecretAck
-	secretSig, err := h.signer.SignEthMessage(secret)
+	secretSig, err := utils.SignEthMessageOfKind(h.signer, intfs.SignKindSecret, secret)
 	if err != nil {
 		return fmt.Errorf("failed to sign: %w", err)
 	}
@@ -80,7 +82,7 @@ func (h *CelerMsgHandler) HandleRevealSecret(frame *common.MsgFrame) error {
 			RevealSecretAck: ack,
 		},
 	}
-	err = h.streamWriter.WriteCelerMsg(frame.PeerAddr, celerMsg)
+	err = h.writeCelerMsg(frame.PeerAddr, celerMsg)
 	if err != nil {
 		log.Error(err)
 	}
@@ -169,11 +171,11 @@ func (h *CelerMsgHandler) HandleRevealSecretAck(frame *common.MsgFrame) error {
 			}
 		}
 		log.Debugf("Forwarding reveal secret ack to %x, next hop %x", dst, peer)
-		return h.messager.ForwardCelerMsg(peer, frame.Message)
+		return h.forwardCelerMsg(peer, frame.Message)
 	}
 
 	var pay *entity.ConditionalPay
-	err := h.dal.Transactional(h.recvSecretAckTx, payID, ack, &pay)
+	err := h.dal.TracedTransactional(h.span, "recvSecretAck", h.recvSecretAckTx, payID, ack, &pay)
 	if err != nil {
 		if errors.Is(err, common.ErrPayOffChainResolved) {
 			log.Warnln(err, payID.Hex())
@@ -186,7 +188,7 @@ func (h *CelerMsgHandler) HandleRevealSecretAck(frame *common.MsgFrame) error {
 	if len(pay.GetConditions()) == 1 &&
 		pay.Conditions[0].ConditionType == entity.ConditionType_HASH_LOCK {
 		amt := new(big.Int).SetBytes(pay.TransferFunc.MaxTransfer.Receiver.Amt)
-		return h.messager.SendOnePaySettleRequest(pay, amt, rpc.PaymentSettleReason_PAY_PAID_MAX, frame.LogEntry)
+		return h.messager.SendOnePaySettleRequest(h.span, pay, amt, rpc.PaymentSettleReason_PAY_PAID_MAX, frame.LogEntry)
 	}
 	// TODO: notify client on pay state change
 
@@ -4,11 +4,13 @@ package messager
 
 import (
 	"bytes"
+	"sync"
 
 	"github.com/celer-network/goCeler/common"
 	"github.com/celer-network/goCeler/common/intfs"
 	"github.com/celer-network/goCeler/ctype"
 	"github.com/celer-network/goCeler/deposit"
+	"github.com/celer-network/goCeler/dispute"
 	"github.com/celer-network/goCeler/entity"
 	"github.com/celer-network/goCeler/handlers"
 	"github.com/celer-network/goCeler/route"
@@ -31,6 +33,24 @@ type Messager struct {
 	dal              *storage.DAL
 	msgQueue         *MsgQueue
 	isOSP            bool
+	traceQueries     map[string]chan *rpc.PayTraceResponse // pending pay trace queries to peers
+	traceQueriesLock sync.Mutex
+	chainSelector    ChainSelector // nil if the node only runs on its primary chain
+}
+
+// ChainServices are the chain specific services of an extra chain run by a multi-chain OSP
+type ChainServices struct {
+	ChainId        uint64
+	NodeConfig     common.GlobalNodeConfig
+	MonitorService intfs.MonitorService
+	Disputer       *dispute.Processor
+}
+
+// ChainSelector finds the extra chain of a channel or a pay,
+// it returns nil if the channel or pay is on the primary chain of the node.
+type ChainSelector interface {
+	ChainOfCid(cid ctype.CidType) *ChainServices
+	ChainOfPayResolver(resolver ctype.Addr) *ChainServices
 }
 
 func NewMessager(
@@ -55,7 +75,40 @@ func NewMessager(
 		dal:              dal,
 		isOSP:            isOSP,
 		msgQueue:         NewMsqQueue(dal, streamWriter, nodeConfig.GetOnChainAddr()),
+		traceQueries:     make(map[string]chan *rpc.PayTraceResponse),
+	}
+}
+
+// SetChainSelector enables pays on the extra chains of the node.
+// It should be called before the node starts serving peers.
+func (m *Messager) SetChainSelector(selector ChainSelector) {
+	m.chainSelector = selector
+}
+
+// ExtraChainOfCid returns the services of the extra chain the channel is on,
+// or nil if the channel is on the primary chain
+func (m *Messager) ExtraChainOfCid(cid ctype.CidType) *ChainServices {
+	if m.chainSelector == nil {
+		return nil
 	}
+	return m.chainSelector.ChainOfCid(cid)
+}
+
+// ExtraChainOfPay returns the services of the extra chain the pay resolves on,
+// or nil if the pay resolves on the primary chain
+func (m *Messager) ExtraChainOfPay(pay *entity.ConditionalPay) *ChainServices {
+	if m.chainSelector == nil {
+		return nil
+	}
+	return m.chainSelector.ChainOfPayResolver(ctype.Bytes2Addr(pay.GetPayResolver()))
+}
+
+// blockNumberOf returns the current block number of the chain the channel is on
+func (m *Messager) blockNumberOf(cid ctype.CidType) uint64 {
+	if chain := m.ExtraChainOfCid(cid); chain != nil {
+		return chain.MonitorService.GetCurrentBlockNumber().Uint64()
+	}
+	return m.monitorService.GetCurrentBlockNumber().Uint64()
 }
 
 func (m *Messager) ForwardCelerMsg(peerTo ctype.Addr, msg *rpc.CelerMsg) error {
@@ -77,9 +130,9 @@ func (m *Messager) DisableMsgQueue(peer ctype.Addr) error {
 	return m.msgQueue.RemovePeer(peer)
 }
 
-// ACK a message in a channel queue.
-func (m *Messager) AckMsgQueue(cid ctype.CidType, ack, nack uint64) error {
-	return m.msgQueue.AckMsg(cid, ack, nack)
+// ACK a message in a channel queue, code is the error code of the NACK if any.
+func (m *Messager) AckMsgQueue(cid ctype.CidType, ack, nack uint64, code rpc.ErrCode) error {
+	return m.msgQueue.AckMsg(cid, ack, nack, code)
 }
 
 // Resend a message in a channel queue.
@@ -92,6 +145,16 @@ func (m *Messager) GetMsgQueue(cid ctype.CidType, seqnum uint64) (*rpc.CelerMsg,
 	return m.msgQueue.GetMsg(cid, seqnum)
 }
 
+// Get the number of unacked messages and the number of channels they are queued for.
+func (m *Messager) GetMsgQueueUnacked() (int, int) {
+	return m.msgQueue.Unacked()
+}
+
+// Get the message queue status of a channel, false if the channel peer is not connected.
+func (m *Messager) GetMsgQueueStatus(cid ctype.CidType) (*QueueStatus, bool) {
+	return m.msgQueue.GetStatus(cid)
+}
+
 // Is this a direct payment from me to this peer?  The peer is an optional
 // parameter, if it is not given (an empty string), the next hop peer is
 // looked up.  For now only consider unconditional payments where I am the
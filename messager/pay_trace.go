@@ -0,0 +1,111 @@
+// Copyright 2020 Celer Network
+
+package messager
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/celer-network/goCeler/common"
+	"github.com/celer-network/goCeler/config"
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/rpc"
+	"github.com/celer-network/goutils/log"
+)
+
+// recordPayTraceFwd records the time a traced pay is sent to the next hop.
+// The span is created here if the pay is originated by myself.
+func (m *Messager) recordPayTraceFwd(payID ctype.PayIDType, traceID string, peerTo ctype.Addr) {
+	if traceID == "" {
+		return
+	}
+	ts := time.Now().UnixNano()
+	_, found, err := m.dal.GetPayTrace(payID)
+	if err != nil {
+		log.Errorln("GetPayTrace err:", err, payID.Hex())
+		return
+	}
+	if found {
+		err = m.dal.UpdatePayTraceFwd(payID, peerTo, ts)
+	} else {
+		err = m.dal.InsertPayTrace(&rpc.PayTraceSpan{
+			PayId:   payID.Bytes(),
+			TraceId: traceID,
+			NextHop: peerTo.Bytes(),
+			FwdTs:   ts,
+		})
+	}
+	if err != nil {
+		log.Errorln("record pay trace err:", err, payID.Hex())
+	}
+}
+
+// QueryPayTrace asks the peer for its span of the pay, and the spans the peer collects
+// from further hops along the given direction within the timeout.
+// Spans are returned in the order of hops along the query direction.
+// The query is a celer msg on the stream to the peer, handed over FwdMsg to the server
+// holding the stream in the multi-server setup like other msgs forwarded to peers.
+func (m *Messager) QueryPayTrace(
+	peer ctype.Addr, payID ctype.PayIDType, downstream bool, timeout time.Duration) ([]*rpc.PayTraceSpan, error) {
+	key := payTraceQueryKey(peer, payID, downstream)
+	respChan := make(chan *rpc.PayTraceResponse, 1)
+	m.traceQueriesLock.Lock()
+	if _, ok := m.traceQueries[key]; ok {
+		m.traceQueriesLock.Unlock()
+		return nil, fmt.Errorf("pay trace query to %x already in progress", peer)
+	}
+	m.traceQueries[key] = respChan
+	m.traceQueriesLock.Unlock()
+	defer func() {
+		m.traceQueriesLock.Lock()
+		delete(m.traceQueries, key)
+		m.traceQueriesLock.Unlock()
+	}()
+
+	// leave the peer some margin to reply before the timeout
+	var peerTimeout time.Duration
+	if timeout > config.PayTraceHopMargin {
+		peerTimeout = timeout - config.PayTraceHopMargin
+	}
+	msg := &rpc.CelerMsg{
+		Message: &rpc.CelerMsg_PayTraceRequest{
+			PayTraceRequest: &rpc.PayTraceRequest{
+				PayId:      payID.Bytes(),
+				Downstream: downstream,
+				TimeoutMs:  uint64(peerTimeout / time.Millisecond),
+			},
+		},
+	}
+	err := m.ForwardCelerMsg(peer, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case resp := <-respChan:
+		return resp.GetSpans(), nil
+	case <-time.After(timeout):
+		return nil, common.ErrPayTraceTimeout
+	}
+}
+
+// DeliverPayTraceResponse passes the pay trace response from peer to the pending query.
+// It returns false if no query is waiting for the response.
+func (m *Messager) DeliverPayTraceResponse(peer ctype.Addr, resp *rpc.PayTraceResponse) bool {
+	key := payTraceQueryKey(peer, ctype.Bytes2PayID(resp.GetPayId()), resp.GetDownstream())
+	m.traceQueriesLock.Lock()
+	defer m.traceQueriesLock.Unlock()
+	respChan, ok := m.traceQueries[key]
+	if !ok {
+		return false
+	}
+	select {
+	case respChan <- resp:
+	default: // duplicate response
+	}
+	return true
+}
+
+func payTraceQueryKey(peer ctype.Addr, payID ctype.PayIDType, downstream bool) string {
+	return fmt.Sprintf("%x-%x-%t", peer, payID, downstream)
+}
@@ -10,24 +10,33 @@
 //   peers change when clients connect & disconnect from a server.
 // * Per queue it tracks the last-ACKed, last-sent, and last-added
 //   messages. The 3 numbers represent the state of a queue.
-// * One goroutine handles all payment channel queues, sending one message
-//   from each non-empty queue in a round-robin manner.
+// * A bounded pool of worker goroutines handles all payment channel queues.
+//   Channels with messages to send wait in a FIFO ready list, a worker takes
+//   one channel at a time and sends its next message. A channel is never held
+//   by two workers at once, so messages of a channel are sent in order, and
+//   one slow stream only holds up its own worker.
+// * New pays are rejected once a channel has too many unacked messages (see
+//   IsFull), so that a peer not keeping up does not grow its queue unbounded.
+// * The number of sent but unacked messages of a channel is limited by its
+//   sliding window. The window adapts in AIMD style: it grows by one message
+//   per window of timely ACKs, and halves on a NACK or a slow ACK.
 
 package messager
 
 import (
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/celer-network/goCeler/common"
+	"github.com/celer-network/goCeler/config"
 	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/metrics"
 	"github.com/celer-network/goCeler/rpc"
+	"github.com/celer-network/goCeler/rtconfig"
 	"github.com/celer-network/goCeler/storage"
 	"github.com/celer-network/goutils/log"
-)
-
-const (
-	workChunk = 64 // limit of # active peers to fetch
+	"go.opencensus.io/trace"
 )
 
 type MsgQueue struct {
@@ -36,7 +45,9 @@ type MsgQueue struct {
 	myAddr       ctype.Addr
 	mu           sync.Mutex
 	workCond     *sync.Cond                     // has work to do (cond var)
-	work         map[ctype.CidType]bool         // set of cids to work on
+	ready        []ctype.CidType                // FIFO of cids to work on, may have stale entries
+	work         map[ctype.CidType]bool         // set of cids to work on, all in the ready list
+	busy         map[ctype.CidType]bool         // set of cids being sent by the workers
 	queues       map[ctype.CidType]*Queue       // one queue per cid
 	peerCids     map[ctype.Addr][]ctype.CidType // set of cids per peer
 }
@@ -47,91 +58,219 @@ type Queue struct {
 	sent  uint64                   // last sent message
 	added uint64                   // last added message
 	msgs  map[uint64]*rpc.CelerMsg // messages to be sent
+	spans map[uint64]*trace.Span   // spans the messages were added in, to trace their sends
+
+	window     uint64               // max number of sent but unacked messages
+	ackCredit  uint64               // timely ACKs since the window last changed
+	sentTs     map[uint64]time.Time // send time of the unacked messages
+	nacked     uint64               // last NACKed message
+	ackLatency time.Duration        // smoothed ACK latency
+	nacks      uint64               // number of NACKs since the queue was created
+	violations uint64               // number of NACKs of invalid msgs since the queue was created
+	slowAcks   uint64               // number of ACKs slower than the latency target
+}
+
+// QueueStatus is a snapshot of the message queue of a channel
+type QueueStatus struct {
+	Acked      uint64
+	Sent       uint64
+	Added      uint64
+	Window     uint64
+	AckLatency time.Duration
+	Nacks      uint64
+	Violations uint64
+	SlowAcks   uint64
+}
+
+func newQueue(peer ctype.Addr, acked, sent, added uint64) *Queue {
+	initWin, _, _, _ := rtconfig.GetSlidingWindow()
+	return &Queue{
+		peer:   peer,
+		acked:  acked,
+		sent:   sent,
+		added:  added,
+		msgs:   make(map[uint64]*rpc.CelerMsg),
+		spans:  make(map[uint64]*trace.Span),
+		window: initWin,
+		sentTs: make(map[uint64]time.Time),
+	}
+}
+
+// Return true if one more message can be sent within the window. NACKed messages
+// and the in-flight ones before them are not counted, they will not be ACKed.
+func (q *Queue) inWindow() bool {
+	base := q.acked
+	if q.nacked > base && q.nacked <= q.sent {
+		base = q.nacked
+	}
+	return q.sent-base < q.window
+}
+
+// Return true if the NACK error code means the peer could not process a valid msg
+// for now, e.g. no route or rate limited, rather than the msg being invalid.
+func retryableNack(code rpc.ErrCode) bool {
+	switch code {
+	case rpc.ErrCode_PAY_ROUTE_LOOP,
+		rpc.ErrCode_NO_ROUTE_TO_DST,
+		rpc.ErrCode_NOT_ENOUGH_BALANCE,
+		rpc.ErrCode_PEER_NOT_ONLINE,
+		rpc.ErrCode_INSUFFICIENT_FEE,
+		rpc.ErrCode_RATE_LIMITED,
+		rpc.ErrCode_DRAINING:
+		return true
+	}
+	return false
+}
+
+// Return true if the queue has messages to send within the window.
+func (q *Queue) canSend() bool {
+	return q.sent < q.added && q.inWindow()
+}
+
+// Adapt the window to the newly ACKed messages and the NACK, must be called
+// before the acked seq num is updated.
+func (q *Queue) adaptWindow(ack, nack uint64, code rpc.ErrCode, now time.Time) {
+	_, minWin, maxWin, target := rtconfig.GetSlidingWindow()
+	decrease := false
+	if nack > q.nacked {
+		q.nacked = nack
+		q.nacks++
+		if !retryableNack(code) {
+			q.violations++
+		}
+		decrease = true
+	}
+	for seq := q.acked + 1; seq <= ack; seq++ {
+		ts, ok := q.sentTs[seq]
+		if !ok {
+			continue // sent before the queue was created
+		}
+		delete(q.sentTs, seq)
+		latency := now.Sub(ts)
+		if q.ackLatency == 0 {
+			q.ackLatency = latency
+		} else {
+			q.ackLatency += (latency - q.ackLatency) / 8
+		}
+		if latency > target {
+			q.slowAcks++
+			decrease = true
+		} else {
+			q.ackCredit++
+		}
+	}
+	// at most one decrease per ACK, as the messages acked together were in the same window
+	if decrease {
+		q.window /= 2
+		q.ackCredit = 0
+	} else if q.ackCredit >= q.window {
+		q.window++
+		q.ackCredit = 0
+	}
+	if q.window < minWin {
+		q.window = minWin
+	} else if q.window > maxWin {
+		q.window = maxWin
+	}
 }
 
 func NewMsqQueue(dal *storage.DAL, streamWriter common.StreamWriter, myAddr ctype.Addr) *MsgQueue {
+	m := newMsgQueue(dal, streamWriter, myAddr, config.MsgQueueWorkers)
+	go m.reportMetrics()
+	return m
+}
+
+func newMsgQueue(dal *storage.DAL, streamWriter common.StreamWriter, myAddr ctype.Addr, workers int) *MsgQueue {
 	m := &MsgQueue{
 		dal:          dal,
 		streamWriter: streamWriter,
 		myAddr:       myAddr,
 		queues:       make(map[ctype.CidType]*Queue),
 		work:         make(map[ctype.CidType]bool),
+		busy:         make(map[ctype.CidType]bool),
 		peerCids:     make(map[ctype.Addr][]ctype.CidType),
 	}
 	m.workCond = sync.NewCond(&m.mu)
 
-	go m.run()
+	for i := 0; i < workers; i++ {
+		go m.run()
+	}
 	return m
 }
 
-// Message queue runner goroutine that sends messages to all peers,
-// one message per active payment channel.
+// Message queue worker goroutine that sends messages to all peers,
+// one message of one active payment channel at a time.
 func (m *MsgQueue) run() {
 	for {
-		cids := m.waitForWork()
+		cid := m.waitForWork()
+		sent := m.sendNextMessage(cid)
+		m.doneWork(cid, sent)
+	}
+}
 
-		var wg sync.WaitGroup
-		wg.Add(len(cids))
+// Wait for an active channel (i.e. non-empty message queue) and mark it busy.
+func (m *MsgQueue) waitForWork() ctype.CidType {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 
-		for _, cid := range cids {
-			go m.sendNextMessage(cid, &wg)
+	for {
+		for len(m.ready) == 0 {
+			m.workCond.Wait()
+		}
+		cid := m.ready[0]
+		m.ready = m.ready[1:]
+		// Skip the stale entries of channels removed from the work set.
+		if m.work[cid] {
+			delete(m.work, cid)
+			m.busy[cid] = true
+			return cid
 		}
-
-		wg.Wait()
 	}
 }
 
-// Wait for some active channels (i.e. non-empty message queues).
-func (m *MsgQueue) waitForWork() []ctype.CidType {
-	log.Tracef("MsgQueue: waiting for work")
-
+// Release the channel after one message was sent (or failed to be sent) to it,
+// and put it back to the end of the ready list if it has more to send.
+func (m *MsgQueue) doneWork(cid ctype.CidType, sent bool) {
 	m.mu.Lock()
-	for len(m.work) == 0 {
-		m.workCond.Wait()
-	}
+	defer m.mu.Unlock()
 
-	// Return a chunk of the active channels to avoid making this call
-	// too slow when the number of active channels is too large.  This
-	// makes it less fair than strict round-robin across all channels,
-	// but it is mitigated by Golang's randomization of the traversal
-	// of a map, so each waitForWork() call picks a different chunk
-	// from the map, even if the map did not change.
-	size := len(m.work)
-	if size > workChunk {
-		size = workChunk
+	delete(m.busy, cid)
+	q := m.queues[cid]
+	if q == nil || !q.canSend() {
+		return // an ACK will resume the channel if its window is full
 	}
-
-	cids := make([]ctype.CidType, 0, size)
-	for cid := range m.work {
-		if size == 0 {
-			break
-		}
-		cids = append(cids, cid)
-		size--
+	if sent {
+		m.hasWork(cid)
+		return
 	}
-
-	m.mu.Unlock()
-
-	log.Tracef("MsgQueue: got work for %d channels", len(cids))
-	return cids
+	// Back off before retrying the peer, a new message or ACK of the channel
+	// may trigger the retry earlier.
+	time.AfterFunc(config.MsgQueueRetryDelay, func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if q := m.queues[cid]; q != nil && q.canSend() {
+			m.hasWork(cid)
+		}
+	})
 }
 
 // Send to this channel its next queued message (by sequence number).
-func (m *MsgQueue) sendNextMessage(cid ctype.CidType, wg *sync.WaitGroup) {
-	defer wg.Done()
+// Return false if the message could not be sent.
+func (m *MsgQueue) sendNextMessage(cid ctype.CidType) bool {
 	log.Tracef("MsgQueue: sending next message to %x", cid)
 
 	m.mu.Lock()
 	q := m.queues[cid]
-	if q == nil || q.sent == q.added {
+	if q == nil || !q.canSend() {
 		log.Tracef("MsgQueue: nothing to do for %x", cid)
-		delete(m.work, cid)
 		m.mu.Unlock()
-		return
+		return true
 	}
 
+	peer := q.peer
 	seqnum := q.sent + 1
 	msg := q.msgs[seqnum]
+	parent := q.spans[seqnum]
 	m.mu.Unlock()
 
 	// After a restart, messages are fetched here on-demand.
@@ -141,26 +280,33 @@ func (m *MsgQueue) sendNextMessage(cid ctype.CidType, wg *sync.WaitGroup) {
 		msg, found, err = m.dal.GetChanMessage(cid, seqnum)
 		if err != nil || !found {
 			m.updateQueueSent(cid, seqnum)
-			log.Errorf("MsgQueue: cannot get msg %d from storage to send to %x: %w", seqnum, cid, err)
-			return
+			log.Errorf("MsgQueue: cannot get msg %d from storage to send to %x: %v", seqnum, cid, err)
+			return true
 		}
 	}
 
 	log.Tracef("MsgQueue: sending msg %d to %x", seqnum, cid)
 	// Send the message
-	err := m.streamWriter.WriteCelerMsg(q.peer, msg)
+	span := metrics.StartSpan(parent, "MsgQueue.WriteCelerMsg")
+	span.AddAttributes(
+		trace.StringAttribute(metrics.AkMsgTo, ctype.Addr2Hex(peer)),
+		trace.StringAttribute(metrics.AkToCid, ctype.Cid2Hex(cid)),
+		trace.Int64Attribute(metrics.AkSeqOut, int64(seqnum)))
+	err := m.streamWriter.WriteCelerMsg(peer, msg)
+	metrics.EndSpan(span, err)
 	if err != nil {
-		log.Warnf("MsgQueue: cannot send msg %d to %x,%x: %s", seqnum, q.peer, cid, err)
-		return
+		log.Warnf("MsgQueue: cannot send msg %d to %x,%x: %s", seqnum, peer, cid, err)
+		return false
 	}
 
 	// Peer queue may have been removed in between locks.
 	recorded := m.updateQueueSent(cid, seqnum)
 	if recorded {
-		log.Tracef("MsgQueue: msg %d sent to %x, %x", seqnum, q.peer, cid)
+		log.Tracef("MsgQueue: msg %d sent to %x, %x", seqnum, peer, cid)
 	} else {
-		log.Tracef("MsgQueue: msg %d sent to %x, %x but not recorded", seqnum, q.peer, cid)
+		log.Tracef("MsgQueue: msg %d sent to %x, %x but not recorded", seqnum, peer, cid)
 	}
+	return true
 }
 
 func (m *MsgQueue) updateQueueSent(cid ctype.CidType, seqnum uint64) bool {
@@ -171,26 +317,70 @@ func (m *MsgQueue) updateQueueSent(cid ctype.CidType, seqnum uint64) bool {
 	q := m.queues[cid]
 	if q != nil {
 		q.sent = seqnum
+		q.sentTs[seqnum] = time.Now()
 		recorded = true
 	}
 	return recorded
 }
 
-// Indicate that this channel has pending work and notify the runner if needed.
+// Indicate that this channel has pending work and notify a worker if needed.
+// A busy channel is checked again by its worker when the current send is done.
 // The caller must hold the mutex when calling this function.
 func (m *MsgQueue) hasWork(cid ctype.CidType) {
-	prevLen := len(m.work)
+	if m.work[cid] || m.busy[cid] {
+		return
+	}
 	m.work[cid] = true
-	if prevLen == 0 {
-		m.workCond.Signal() // wakeup the runner goroutine
+	m.ready = append(m.ready, cid)
+	m.workCond.Signal() // wakeup a worker goroutine
+}
+
+// IsFull returns true if the channel has reached the max number of unacked
+// messages, new pays should not be sent through it until the peer catches up.
+func (m *MsgQueue) IsFull(cid ctype.CidType) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	q := m.queues[cid]
+	if q == nil {
+		return false // peer not connected, msgs are kept in storage only
+	}
+	return q.added-q.acked >= rtconfig.GetMsgQueueMaxDepth()
+}
+
+// Periodically export the queue depth metrics.
+func (m *MsgQueue) reportMetrics() {
+	ticker := time.NewTicker(config.MsgQueueMetricsInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		m.mu.Lock()
+		var unacked, cids, peak, unsent uint64
+		for _, q := range m.queues {
+			depth := q.added - q.acked
+			if depth > 0 {
+				unacked += depth
+				cids++
+				if depth > peak {
+					peak = depth
+				}
+			}
+			if q.added > q.sent {
+				unsent += q.added - q.sent
+			}
+		}
+		busy := len(m.busy)
+		m.mu.Unlock()
+		metrics.SetMsgQueueGauges(unacked, unsent, cids, peak, busy)
 	}
 }
 
 // Add a message for a channel. The message itself must have been saved
 // to storage before calling this function.  This is typically done
 // atomically inside a store transaction along with other updates, and
-// if successful, AddMsg() is called to notify the message queue.
-func (m *MsgQueue) AddMsg(peer ctype.Addr, cid ctype.CidType, seqnum uint64, msg *rpc.CelerMsg) error {
+// if successful, AddMsg() is called to notify the message queue. Sends of the
+// message are traced as child spans of parent if it is not nil.
+func (m *MsgQueue) AddMsg(
+	parent *trace.Span, peer ctype.Addr, cid ctype.CidType, seqnum uint64, msg *rpc.CelerMsg) error {
 	log.Tracef("MsgQueue: add msg %d to cid %x", seqnum, cid)
 
 	m.mu.Lock()
@@ -203,10 +393,7 @@ func (m *MsgQueue) AddMsg(peer ctype.Addr, cid ctype.CidType, seqnum uint64, msg
 	q := m.queues[cid]
 	if q == nil {
 		if seqnum == 1 { // new channel added after peer connected
-			m.queues[cid] = &Queue{
-				peer: peer,
-				msgs: make(map[uint64]*rpc.CelerMsg),
-			}
+			m.queues[cid] = newQueue(peer, 0, 0, 0)
 			m.addPeerCid(peer, cid)
 			q = m.queues[cid]
 		} else {
@@ -218,7 +405,12 @@ func (m *MsgQueue) AddMsg(peer ctype.Addr, cid ctype.CidType, seqnum uint64, msg
 		q.added = seqnum
 	}
 	q.msgs[seqnum] = msg
-	m.hasWork(cid)
+	if parent != nil {
+		q.spans[seqnum] = parent
+	}
+	if q.canSend() {
+		m.hasWork(cid)
+	}
 	return nil
 }
 
@@ -231,7 +423,7 @@ func (m *MsgQueue) AddMsg(peer ctype.Addr, cid ctype.CidType, seqnum uint64, msg
 // message to the queue separately from it being written to storage before.
 // This flows from the different requirements in how messages are created
 // compared to how they are ACKed and deleted.
-func (m *MsgQueue) AckMsg(cid ctype.CidType, ack, nack uint64) error {
+func (m *MsgQueue) AckMsg(cid ctype.CidType, ack, nack uint64, code rpc.ErrCode) error {
 	if ack == nack {
 		// log err and let continue as it won't trigger worse consequence
 		log.Errorf("MsgQueue: ACK and NACK should not have the same seq %d, cid %x", ack, cid)
@@ -251,6 +443,8 @@ func (m *MsgQueue) AckMsg(cid ctype.CidType, ack, nack uint64) error {
 		return fmt.Errorf("MsgQueue: cannot ACK msg %d, unknown cid %x", ack, cid)
 	}
 
+	q.adaptWindow(ack, nack, code, time.Now())
+
 	if nack > q.sent {
 		// messages with seqnum smaller than nack do not need to be sent,
 		// because they are all based on the unaccepted (nacked) state.
@@ -274,10 +468,13 @@ func (m *MsgQueue) AckMsg(cid ctype.CidType, ack, nack uint64) error {
 
 	for i := from; i <= to; i++ {
 		delete(q.msgs, i)
+		delete(q.spans, i)
 	}
 
 	if q.acked == q.added {
 		delete(m.work, cid) // empty queue
+	} else if q.canSend() {
+		m.hasWork(cid) // window moved
 	}
 
 	return nil
@@ -319,31 +516,68 @@ func (m *MsgQueue) GetMsg(cid ctype.CidType, seqnum uint64) (*rpc.CelerMsg, bool
 	return msg, ok
 }
 
+// Unacked returns the number of messages added but not yet ACKed by the peers,
+// and the number of channels these messages are queued for.
+func (m *MsgQueue) Unacked() (int, int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var msgs, cids int
+	for _, q := range m.queues {
+		if q.added > q.acked {
+			msgs += int(q.added - q.acked)
+			cids++
+		}
+	}
+	return msgs, cids
+}
+
+// GetStatus returns the status of the channel queue, false if the channel peer is not connected.
+func (m *MsgQueue) GetStatus(cid ctype.CidType) (*QueueStatus, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	q := m.queues[cid]
+	if q == nil {
+		return nil, false
+	}
+	return &QueueStatus{
+		Acked:      q.acked,
+		Sent:       q.sent,
+		Added:      q.added,
+		Window:     q.window,
+		AckLatency: q.ackLatency,
+		Nacks:      q.nacks,
+		Violations: q.violations,
+		SlowAcks:   q.slowAcks,
+	}, true
+}
+
 // Fetch the message queue status from storage for this channel.
-// Return the (acked, sent, added) sequence numbers. The returned value sent = acked
+// Return the (acked, sent, added, nacked) sequence numbers. The returned value sent = acked
 // because node always resends from the first unacked msg on peer reconnect.
-func (m *MsgQueue) getChannelQueueStatus(cid ctype.CidType) (uint64, uint64, uint64, error) {
+func (m *MsgQueue) getChannelQueueStatus(cid ctype.CidType) (uint64, uint64, uint64, uint64, error) {
 	_, lastUsed, lastAcked, lastNacked, found, err := m.dal.GetChanSeqNums(cid)
 	if err != nil {
-		return 0, 0, 0, err
+		return 0, 0, 0, 0, err
 	}
 	if !found {
-		return 0, 0, 0, common.ErrChannelNotFound
+		return 0, 0, 0, 0, common.ErrChannelNotFound
 	}
 
 	if lastAcked > lastUsed {
 		err = fmt.Errorf("invalid queue status: (acked %d, added %d)", lastAcked, lastUsed)
-		return 0, 0, 0, err
+		return 0, 0, 0, 0, err
 	}
 	sent := lastAcked
 	if lastNacked > lastAcked {
 		sent = lastNacked
 	}
-	return lastAcked, sent, lastUsed, nil
+	return lastAcked, sent, lastUsed, lastNacked, nil
 }
 
 func (m *MsgQueue) addQueue(peer ctype.Addr, cid ctype.CidType) error {
-	acked, sent, added, err := m.getChannelQueueStatus(cid)
+	acked, sent, added, nacked, err := m.getChannelQueueStatus(cid)
 	if err != nil {
 		return fmt.Errorf("MsgQueue: cannot init queue status for %x: %w", cid, err)
 	}
@@ -355,15 +589,11 @@ func (m *MsgQueue) addQueue(peer ctype.Addr, cid ctype.CidType) error {
 		return fmt.Errorf("MsgQueue: cannot add channel %x, already exists", cid)
 	}
 
-	m.queues[cid] = &Queue{
-		peer:  peer,
-		acked: acked,
-		sent:  sent,
-		added: added,
-		msgs:  make(map[uint64]*rpc.CelerMsg),
-	}
+	q := newQueue(peer, acked, sent, added)
+	q.nacked = nacked
+	m.queues[cid] = q
 
-	if sent < added {
+	if q.canSend() {
 		m.hasWork(cid)
 	}
 
@@ -393,7 +623,7 @@ func (m *MsgQueue) AddPeer(peer ctype.Addr) error {
 	// Continue running even if no cids were found because the "peerCids"
 	// map entry must be initialized.
 	if !found {
-		log.Tracef("MsgQueue: no active channels for peer %x: %w", peer, err)
+		log.Tracef("MsgQueue: no active channels for peer %x", peer)
 	}
 	m.peerCids[peer] = make([]ctype.CidType, 0, len(cids))
 	m.mu.Unlock()
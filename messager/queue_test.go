@@ -0,0 +1,338 @@
+// Copyright 2020 Celer Network
+
+package messager
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/celer-network/goCeler/config"
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/rpc"
+	"github.com/celer-network/goCeler/rtconfig"
+	"go.opencensus.io/trace"
+)
+
+// testStreamWriter records the seq nums (carried in msg flag) sent to each peer,
+// sends to slow peers take the given delay. Msgs are dropped once closed.
+type testStreamWriter struct {
+	mu     sync.Mutex
+	delay  map[ctype.Addr]time.Duration
+	sent   map[ctype.Addr][]uint64
+	fast   int // number of msgs sent to peers without delay
+	closed bool
+	acker  *MsgQueue // ack each msg right after it is sent if set
+}
+
+func newTestStreamWriter() *testStreamWriter {
+	return &testStreamWriter{
+		delay: make(map[ctype.Addr]time.Duration),
+		sent:  make(map[ctype.Addr][]uint64),
+	}
+}
+
+func (w *testStreamWriter) WriteCelerMsg(peer ctype.Addr, msg *rpc.CelerMsg) error {
+	w.mu.Lock()
+	delay, closed := w.delay[peer], w.closed
+	w.mu.Unlock()
+	if closed {
+		return nil // drop the backlog left after the test
+	}
+	time.Sleep(delay)
+
+	w.mu.Lock()
+	w.sent[peer] = append(w.sent[peer], msg.GetFlag())
+	if delay == 0 {
+		w.fast++
+	}
+	acker := w.acker
+	w.mu.Unlock()
+	if acker != nil {
+		go acker.AckMsg(testCid(peer), msg.GetFlag(), 0, rpc.ErrCode_OK)
+	}
+	return nil
+}
+
+func (w *testStreamWriter) setDelay(peer ctype.Addr, delay time.Duration) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.delay[peer] = delay
+}
+
+func (w *testStreamWriter) numSent(peer ctype.Addr) int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return len(w.sent[peer])
+}
+
+func (w *testStreamWriter) numFastSent() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.fast
+}
+
+func (w *testStreamWriter) close() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.closed = true
+}
+
+func testPeerCid(i int) (ctype.Addr, ctype.CidType) {
+	peer := ctype.Hex2Addr(fmt.Sprintf("%040x", i+1))
+	return peer, testCid(peer)
+}
+
+func testCid(peer ctype.Addr) ctype.CidType {
+	return ctype.Hex2Cid(ctype.Addr2Hex(peer))
+}
+
+// addTestPeers connects peers with one channel each without storage.
+func addTestPeers(m *MsgQueue, num int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := 0; i < num; i++ {
+		peer, _ := testPeerCid(i)
+		m.peerCids[peer] = []ctype.CidType{}
+	}
+}
+
+func addTestMsgs(t testing.TB, m *MsgQueue, i int, from, to uint64) {
+	peer, cid := testPeerCid(i)
+	for seq := from; seq <= to; seq++ {
+		err := m.AddMsg(nil, peer, cid, seq, &rpc.CelerMsg{Flag: seq})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestMsgQueueOrder(t *testing.T) {
+	w := newTestStreamWriter()
+	defer w.close()
+	m := newMsgQueue(nil, w, ctype.ZeroAddr, 4)
+	w.acker = m
+	numPeers, numMsgs := 8, 20
+	addTestPeers(m, numPeers)
+	slowPeer, _ := testPeerCid(0)
+	w.setDelay(slowPeer, 50*time.Millisecond)
+	for i := 0; i < numPeers; i++ {
+		addTestMsgs(t, m, i, 1, uint64(numMsgs))
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for w.numFastSent() < (numPeers-1)*numMsgs {
+		if time.Now().After(deadline) {
+			t.Fatalf("sent %d msgs to fast peers", w.numFastSent())
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	if w.numSent(slowPeer) >= numMsgs {
+		t.Error("fast peers blocked by the slow peer")
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for peer, seqs := range w.sent {
+		for i, seq := range seqs {
+			if seq != uint64(i+1) {
+				t.Fatalf("peer %x msg %d out of order: %v", peer, i, seqs)
+			}
+		}
+	}
+}
+
+// testSpanExporter records the names and parents of the exported spans
+type testSpanExporter struct {
+	mu      sync.Mutex
+	parents map[string]trace.SpanID
+}
+
+func (e *testSpanExporter) ExportSpan(sd *trace.SpanData) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.parents[sd.Name] = sd.ParentSpanID
+}
+
+func (e *testSpanExporter) parentOf(name string) (trace.SpanID, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	parent, ok := e.parents[name]
+	return parent, ok
+}
+
+func TestMsgQueueSendSpan(t *testing.T) {
+	e := &testSpanExporter{parents: make(map[string]trace.SpanID)}
+	trace.RegisterExporter(e)
+	defer trace.UnregisterExporter(e)
+	w := newTestStreamWriter()
+	defer w.close()
+	m := newMsgQueue(nil, w, ctype.ZeroAddr, 1)
+	w.acker = m
+	addTestPeers(m, 1)
+	peer, cid := testPeerCid(0)
+
+	// the send is traced as a child of the span the msg is added in
+	_, parent := trace.StartSpan(context.Background(), "parent", trace.WithSampler(trace.AlwaysSample()))
+	err := m.AddMsg(parent, peer, cid, 1, &rpc.CelerMsg{Flag: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		status, _ := m.GetStatus(cid)
+		_, exported := e.parentOf("MsgQueue.WriteCelerMsg")
+		if status.Acked == 1 && exported {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("msg acked %d, send span exported %t", status.Acked, exported)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	parentID, _ := e.parentOf("MsgQueue.WriteCelerMsg")
+	if parentID != parent.SpanContext().SpanID {
+		t.Errorf("wrong parent of the send span: %x", parentID)
+	}
+	m.mu.Lock()
+	if len(m.queues[cid].spans) != 0 {
+		t.Error("span of the acked msg not removed")
+	}
+	m.mu.Unlock()
+}
+
+func TestMsgQueueFull(t *testing.T) {
+	w := newTestStreamWriter()
+	defer w.close()
+	m := newMsgQueue(nil, w, ctype.ZeroAddr, 1)
+	addTestPeers(m, 1)
+	_, cid := testPeerCid(0)
+
+	maxDepth := rtconfig.GetMsgQueueMaxDepth()
+	addTestMsgs(t, m, 0, 1, maxDepth-1)
+	if m.IsFull(cid) {
+		t.Error("queue full below max depth")
+	}
+	addTestMsgs(t, m, 0, maxDepth, maxDepth)
+	if !m.IsFull(cid) {
+		t.Error("queue not full at max depth")
+	}
+	// only unacked msgs count towards the depth
+	err := m.AckMsg(cid, 10, 0, rpc.ErrCode_OK)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.IsFull(cid) {
+		t.Error("queue full after ack")
+	}
+	msgs, cids := m.Unacked()
+	if msgs != int(maxDepth)-10 || cids != 1 {
+		t.Errorf("wrong unacked: %d msgs %d cids", msgs, cids)
+	}
+}
+
+func TestMsgQueueWindow(t *testing.T) {
+	w := newTestStreamWriter()
+	defer w.close()
+	m := newMsgQueue(nil, w, ctype.ZeroAddr, 1)
+	addTestPeers(m, 1)
+	peer, cid := testPeerCid(0)
+	initWin, minWin, _, _ := rtconfig.GetSlidingWindow()
+
+	waitSent := func(num int) {
+		deadline := time.Now().Add(5 * time.Second)
+		for w.numSent(peer) < num {
+			if time.Now().After(deadline) {
+				t.Fatalf("sent %d msgs, expect %d", w.numSent(peer), num)
+			}
+			time.Sleep(time.Millisecond)
+		}
+		time.Sleep(10 * time.Millisecond)
+		if w.numSent(peer) != num {
+			t.Fatalf("sent %d msgs beyond the window, expect %d", w.numSent(peer), num)
+		}
+	}
+
+	addTestMsgs(t, m, 0, 1, 100)
+	waitSent(int(initWin))
+
+	// a window of timely acks grows the window by one
+	err := m.AckMsg(cid, initWin, 0, rpc.ErrCode_OK)
+	if err != nil {
+		t.Fatal(err)
+	}
+	status, _ := m.GetStatus(cid)
+	if status.Window != initWin+1 || status.Acked != initWin {
+		t.Errorf("wrong status after acks: %+v", status)
+	}
+	waitSent(int(2*initWin + 1))
+
+	// a nack halves the window
+	err = m.AckMsg(cid, initWin+1, initWin+2, rpc.ErrCode_MISC_ERROR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	status, _ = m.GetStatus(cid)
+	expWin := (initWin + 1) / 2
+	if expWin < minWin {
+		expWin = minWin
+	}
+	if status.Window != expWin || status.Nacks != 1 || status.Violations != 1 {
+		t.Errorf("wrong status after nack: %+v", status)
+	}
+
+	// a retryable nack is not counted as violation
+	err = m.AckMsg(cid, initWin+3, initWin+4, rpc.ErrCode_RATE_LIMITED)
+	if err != nil {
+		t.Fatal(err)
+	}
+	status, _ = m.GetStatus(cid)
+	if status.Nacks != 2 || status.Violations != 1 {
+		t.Errorf("wrong status after retryable nack: %+v", status)
+	}
+}
+
+// benchmarkMsgQueue measures the throughput of msgs sent to fast peers, while slow peers
+// with a backlog of msgs take 10ms per send.
+func benchmarkMsgQueue(b *testing.B, workers, numPeers, numSlow int) {
+	w := newTestStreamWriter()
+	defer w.close()
+	m := newMsgQueue(nil, w, ctype.ZeroAddr, workers)
+	w.acker = m
+	addTestPeers(m, numPeers)
+	for i := 0; i < numSlow; i++ {
+		peer, _ := testPeerCid(i)
+		w.setDelay(peer, 10*time.Millisecond)
+		addTestMsgs(b, m, i, 1, 1000)
+	}
+
+	numFast := numPeers - numSlow
+	seqs := make([]uint64, numFast)
+	b.ResetTimer()
+	start := time.Now()
+	for n := 0; n < b.N; n++ {
+		i := n % numFast
+		seqs[i]++
+		addTestMsgs(b, m, numSlow+i, seqs[i], seqs[i])
+	}
+	for w.numFastSent() < b.N {
+		time.Sleep(time.Millisecond)
+	}
+	b.StopTimer()
+	b.ReportMetric(float64(b.N)/time.Since(start).Seconds(), "msgs/s")
+}
+
+func BenchmarkMsgQueue(b *testing.B) {
+	benchmarkMsgQueue(b, config.MsgQueueWorkers, 1000, 0)
+}
+
+func BenchmarkMsgQueueSlowPeers(b *testing.B) {
+	benchmarkMsgQueue(b, config.MsgQueueWorkers, 1000, 20)
+}
+
+// Single worker, similar to the previous single goroutine runner
+func BenchmarkMsgQueueSlowPeersOneWorker(b *testing.B) {
+	benchmarkMsgQueue(b, 1, 1000, 20)
+}
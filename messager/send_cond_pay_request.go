@@ -8,12 +8,14 @@ import (
 	"math/big"
 
 	"github.com/celer-network/goCeler/common"
+	"github.com/celer-network/goCeler/common/intfs"
 	enums "github.com/celer-network/goCeler/common/structs"
 	"github.com/celer-network/goCeler/ctype"
 	"github.com/celer-network/goCeler/delegate"
 	"github.com/celer-network/goCeler/entity"
 	"github.com/celer-network/goCeler/fsm"
 	"github.com/celer-network/goCeler/ledgerview"
+	"github.com/celer-network/goCeler/metrics"
 	"github.com/celer-network/goCeler/pem"
 	"github.com/celer-network/goCeler/rpc"
 	"github.com/celer-network/goCeler/rtconfig"
@@ -24,10 +26,19 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
+	"go.opencensus.io/trace"
 )
 
-func (m *Messager) SendCondPayRequest(payBytes []byte, note *any.Any, xnet *rpc.CrossNetPay, logEntry *pem.PayEventMessage) error {
-	pay, cid, peer, celerMsg, directPay, err := m.getPayNextHopAndCelerMsg(payBytes, note, xnet, logEntry)
+// SendCondPayRequest sends the pay to the next hop towards its destination. If route is not empty,
+// the pay is source routed through the given hops, starting from the next hop. The relay fee, if not
+// nil, is locked along with the pay and paid to the next hop only if the pay is paid, to cover the
+// fees of the downstream osps.
+// The db transaction and the queued sends are traced as child spans of parent if it is not nil.
+func (m *Messager) SendCondPayRequest(
+	parent *trace.Span, payBytes []byte, note *any.Any, xnet *rpc.CrossNetPay, traceID string, route [][]byte,
+	fee *big.Int, logEntry *pem.PayEventMessage) error {
+	pay, cid, peer, celerMsg, directPay, err :=
+		m.getPayNextHopAndCelerMsg(payBytes, note, xnet, traceID, route, fee, logEntry)
 	if err != nil {
 		return err
 	}
@@ -43,12 +54,14 @@ func (m *Messager) SendCondPayRequest(payBytes []byte, note *any.Any, xnet *rpc.
 	// It's either meant to a local peer or it's a failed forwarding
 	// of a direct-pay.  In both cases handle it locally which puts
 	// the message in the queue for delivery (now or later).
-	return m.sendCondPayRequest(payBytes, pay, note, cid, peer, xnet, logEntry)
+	return m.sendCondPayRequest(parent, payBytes, pay, note, cid, peer, xnet, traceID, route, fee, logEntry)
 }
 
 func (m *Messager) ForwardCondPayRequest(
-	payBytes []byte, note *any.Any, delegable bool, xnet *rpc.CrossNetPay, logEntry *pem.PayEventMessage) (ctype.Addr, error) {
-	pay, cid, peer, celerMsg, _, err := m.getPayNextHopAndCelerMsg(payBytes, note, xnet, logEntry)
+	parent *trace.Span, payBytes []byte, note *any.Any, delegable bool, xnet *rpc.CrossNetPay, traceID string,
+	route [][]byte, fee *big.Int, logEntry *pem.PayEventMessage) (ctype.Addr, error) {
+	pay, cid, peer, celerMsg, _, err :=
+		m.getPayNextHopAndCelerMsg(payBytes, note, xnet, traceID, route, fee, logEntry)
 	if err != nil {
 		return peer, err
 	}
@@ -58,7 +71,7 @@ func (m *Messager) ForwardCondPayRequest(
 		return peer, err
 	}
 	if isLocalPeer {
-		return peer, m.sendCondPayRequest(payBytes, pay, note, cid, peer, xnet, logEntry)
+		return peer, m.sendCondPayRequest(parent, payBytes, pay, note, cid, peer, xnet, traceID, route, fee, logEntry)
 	}
 
 	return peer, nil
@@ -69,8 +82,10 @@ func (m *Messager) ForwardCondPayRequestMsg(frame *common.MsgFrame) error {
 	logEntry := frame.LogEntry
 	payBytes := msg.GetCondPayRequest().GetCondPay()
 	xnet := msg.GetCondPayRequest().GetCrossNet()
+	route := msg.GetCondPayRequest().GetRoute()
+	fee := new(big.Int).SetBytes(msg.GetCondPayRequest().GetFee())
 
-	pay, cid, peer, _, err := m.getPayNextHop(payBytes, xnet, logEntry)
+	pay, cid, peer, _, err := m.getPayNextHop(payBytes, xnet, route, logEntry)
 	if err != nil {
 		return err
 	}
@@ -78,10 +93,13 @@ func (m *Messager) ForwardCondPayRequestMsg(frame *common.MsgFrame) error {
 	logEntry.PayId = ctype.PayID2Hex(ctype.Pay2PayID(pay))
 	logEntry.Dst = ctype.Bytes2Hex(pay.GetDest())
 
-	return m.sendCondPayRequest(payBytes, pay, msg.GetCondPayRequest().GetNote(), cid, peer, xnet, logEntry)
+	return m.sendCondPayRequest(
+		frame.Span, payBytes, pay, msg.GetCondPayRequest().GetNote(), cid, peer, xnet, msg.GetCondPayRequest().GetTraceId(),
+		route, fee, logEntry)
 }
 
-func (m *Messager) getPayNextHop(payBytes []byte, xnet *rpc.CrossNetPay, logEntry *pem.PayEventMessage) (
+func (m *Messager) getPayNextHop(
+	payBytes []byte, xnet *rpc.CrossNetPay, route [][]byte, logEntry *pem.PayEventMessage) (
 	*entity.ConditionalPay, ctype.CidType, ctype.Addr, bool, error) {
 
 	var pay entity.ConditionalPay
@@ -94,6 +112,50 @@ func (m *Messager) getPayNextHop(payBytes []byte, xnet *rpc.CrossNetPay, logEntr
 	var cid ctype.CidType
 	var peer ctype.Addr
 
+	if len(route) > 0 {
+		// source routed pay, the next hop must be a direct peer on the pay token
+		if xnet.GetDstNetId() != 0 || m.ExtraChainOfPay(&pay) != nil {
+			return nil, ctype.ZeroCid, ctype.ZeroAddr, false, fmt.Errorf("source route not supported for this pay")
+		}
+		peer = ctype.Bytes2Addr(route[0])
+		var found bool
+		cid, found, err = m.dal.GetCidByPeerToken(peer, token)
+		if err != nil {
+			return nil, ctype.ZeroCid, ctype.ZeroAddr, false, fmt.Errorf("GetCidByPeerToken err: %w", err)
+		}
+		if !found {
+			return nil, ctype.ZeroCid, ctype.ZeroAddr, false, common.ErrRouteNotFound
+		}
+		directPay := m.IsDirectPay(&pay, peer, 0)
+		logEntry.MsgTo = ctype.Addr2Hex(peer)
+		logEntry.ToCid = ctype.Cid2Hex(cid)
+		logEntry.DirectPay = directPay
+		return &pay, cid, peer, directPay, nil
+	}
+
+	if chain := m.ExtraChainOfPay(&pay); chain != nil {
+		// pays on extra chains are only forwarded to directly connected destinations on the same chain,
+		// TODO: bridge the pay to a destination on another chain of this OSP
+		if xnet.GetDstNetId() != 0 {
+			return nil, ctype.ZeroCid, ctype.ZeroAddr, false,
+				fmt.Errorf("cross net pay not supported on chain %d", chain.ChainId)
+		}
+		var found bool
+		cid, found, err = m.dal.GetCidByPeerTokenOnChain(dst, token, chain.ChainId)
+		if err != nil {
+			return nil, ctype.ZeroCid, ctype.ZeroAddr, false, fmt.Errorf("GetCidByPeerTokenOnChain err: %w", err)
+		}
+		if !found {
+			return nil, ctype.ZeroCid, ctype.ZeroAddr, false, common.ErrRouteNotFound
+		}
+		peer = dst
+		directPay := m.IsDirectPay(&pay, peer, 0)
+		logEntry.MsgTo = ctype.Addr2Hex(peer)
+		logEntry.ToCid = ctype.Cid2Hex(cid)
+		logEntry.DirectPay = directPay
+		return &pay, cid, peer, directPay, nil
+	}
+
 	if xnet.GetDstNetId() != 0 {
 		logEntry.Xnet.SrcNetId = xnet.GetSrcNetId()
 		logEntry.Xnet.DstNetId = xnet.GetDstNetId()
@@ -141,9 +203,10 @@ func (m *Messager) getPayNextHop(payBytes []byte, xnet *rpc.CrossNetPay, logEntr
 	return &pay, cid, peer, directPay, nil
 }
 
-func (m *Messager) getPayNextHopAndCelerMsg(payBytes []byte, note *any.Any, xnet *rpc.CrossNetPay, logEntry *pem.PayEventMessage) (
-	*entity.ConditionalPay, ctype.CidType, ctype.Addr, *rpc.CelerMsg, bool, error) {
-	pay, cid, peer, directPay, err := m.getPayNextHop(payBytes, xnet, logEntry)
+func (m *Messager) getPayNextHopAndCelerMsg(
+	payBytes []byte, note *any.Any, xnet *rpc.CrossNetPay, traceID string, route [][]byte, fee *big.Int,
+	logEntry *pem.PayEventMessage) (*entity.ConditionalPay, ctype.CidType, ctype.Addr, *rpc.CelerMsg, bool, error) {
+	pay, cid, peer, directPay, err := m.getPayNextHop(payBytes, xnet, route, logEntry)
 	if err != nil {
 		return nil, ctype.ZeroCid, ctype.ZeroAddr, nil, false, err
 	}
@@ -154,6 +217,9 @@ func (m *Messager) getPayNextHopAndCelerMsg(payBytes []byte, note *any.Any, xnet
 				Note:      note,
 				DirectPay: directPay,
 				CrossNet:  xnet,
+				TraceId:   traceID,
+				Route:     route,
+				Fee:       feeBytes(fee),
 			},
 		},
 	}
@@ -161,35 +227,53 @@ func (m *Messager) getPayNextHopAndCelerMsg(payBytes []byte, note *any.Any, xnet
 }
 
 func (m *Messager) sendCondPayRequest(
-	payBytes []byte, pay *entity.ConditionalPay, note *any.Any,
+	parent *trace.Span, payBytes []byte, pay *entity.ConditionalPay, note *any.Any,
 	cid ctype.CidType, peerTo ctype.Addr,
-	xnet *rpc.CrossNetPay, logEntry *pem.PayEventMessage) error {
+	xnet *rpc.CrossNetPay, traceID string, route [][]byte, fee *big.Int, logEntry *pem.PayEventMessage) error {
 
 	payID := ctype.Pay2PayID(pay)
+	logEntry.TraceId = traceID
 	if xnet.GetCrossing() {
-		return m.sendCrossNetPay(payID, payBytes, pay, note, peerTo, xnet, logEntry)
+		err := m.sendCrossNetPay(payID, payBytes, pay, note, peerTo, xnet, traceID, logEntry)
+		if err == nil {
+			m.recordPayTraceFwd(payID, traceID, peerTo)
+		}
+		return err
 	}
 	directPay := m.IsDirectPay(pay, peerTo, xnet.GetDstNetId())
 	log.Debugf("Send pay request %x, src %x, dst %x, direct %t", payID, pay.GetSrc(), pay.GetDest(), directPay)
 
-	// verify pay destination
-	if ctype.Bytes2Addr(pay.GetDest()) == m.nodeConfig.GetOnChainAddr() {
+	// verify pay destination, only source routed pays can return to myself
+	if ctype.Bytes2Addr(pay.GetDest()) == m.nodeConfig.GetOnChainAddr() && len(route) == 0 {
 		return common.ErrInvalidPayDst
 	}
 
+	// no relay fee to the pay destination
+	if directPay || ctype.Bytes2Addr(pay.GetDest()) == peerTo || fee == nil {
+		fee = new(big.Int)
+	}
+
 	// verify payment deadline is within limit
-	blknum := m.monitorService.GetCurrentBlockNumber().Uint64()
+	blknum := m.blockNumberOf(cid)
 	if pay.GetResolveDeadline() > blknum+rtconfig.GetMaxPaymentTimeout() {
 		return fmt.Errorf("%w, deadline %d current %d", common.ErrInvalidPayDeadline, pay.GetResolveDeadline(), blknum)
 	}
 
+	// backpressure on a peer not keeping up with the messages
+	if m.msgQueue.IsFull(cid) {
+		metrics.IncMsgQueueFullCnt()
+		return fmt.Errorf("%w, cid %x", common.ErrMsgQueueFull, cid)
+	}
+
 	var seqnum uint64
 	var celerMsg *rpc.CelerMsg
-	err := m.dal.Transactional(m.runCondPayTx, cid, payID, pay, payBytes, note, directPay, xnet, &seqnum, &celerMsg)
+	err := m.dal.TracedTransactional(parent, "runCondPayTx",
+		m.runCondPayTx, cid, payID, pay, payBytes, note, directPay, xnet, traceID, route, fee, &seqnum, &celerMsg)
 	if err != nil {
 		return err
 	}
-	err = m.msgQueue.AddMsg(peerTo, cid, seqnum, celerMsg)
+	m.recordPayTraceFwd(payID, traceID, peerTo)
+	err = m.msgQueue.AddMsg(parent, peerTo, cid, seqnum, celerMsg)
 	if err != nil {
 		// This can only happen when peer got disconnected after sendCondPayRequest() is called.
 		// We do not return AddMsg error, as db has been updated and rolling back is complicated.
@@ -217,8 +301,11 @@ func (m *Messager) runCondPayTx(tx *storage.DALTx, args ...interface{}) error {
 	note := args[4].(*any.Any)
 	directPay := args[5].(bool)
 	xnet := args[6].(*rpc.CrossNetPay)
-	retSeqNum := args[7].(*uint64)
-	retCelerMgr := args[8].(**rpc.CelerMsg)
+	traceID := args[7].(string)
+	route := args[8].([][]byte)
+	fee := args[9].(*big.Int)
+	retSeqNum := args[10].(*uint64)
+	retCelerMgr := args[11].(**rpc.CelerMsg)
 
 	peer, chanState, onChainBalance, baseSeq, lastUsedSeq, lastAckedSeq,
 		selfSimplex, peerSimplex, found, err := tx.GetChanForSendCondPayRequest(cid)
@@ -238,10 +325,11 @@ func (m *Messager) runCondPayTx(tx *storage.DALTx, args ...interface{}) error {
 		return fmt.Errorf("GetBaseSimplex err %w", err)
 	}
 
-	blkNum := m.monitorService.GetCurrentBlockNumber().Uint64()
+	blkNum := m.blockNumberOf(cid)
 	balance := ledgerview.ComputeBalance(
 		workingSimplex, peerSimplex, onChainBalance, m.nodeConfig.GetOnChainAddr(), peer, blkNum)
-	sendAmt := new(big.Int).SetBytes(pay.GetTransferFunc().GetMaxTransfer().GetReceiver().GetAmt())
+	payAmt := new(big.Int).SetBytes(pay.GetTransferFunc().GetMaxTransfer().GetReceiver().GetAmt())
+	sendAmt := new(big.Int).Add(payAmt, fee)
 	// OSP refill if free balance is below threshold
 	if m.isOSP && chanState == enums.ChanState_OPENED {
 		tokenAddr := utils.GetTokenAddrStr(pay.TransferFunc.MaxTransfer.Token)
@@ -271,7 +359,7 @@ func (m *Messager) runCondPayTx(tx *storage.DALTx, args ...interface{}) error {
 
 	if directPay {
 		amt := new(big.Int).SetBytes(workingSimplex.TransferToPeer.Receiver.Amt)
-		workingSimplex.TransferToPeer.Receiver.Amt = amt.Add(amt, sendAmt).Bytes()
+		workingSimplex.TransferToPeer.Receiver.Amt = amt.Add(amt, payAmt).Bytes()
 	} else {
 		if hashlist.Exist(workingSimplex.PendingPayIds.PayIds, payID[:]) {
 			return common.ErrPayAlreadyPending
@@ -283,6 +371,7 @@ func (m *Messager) runCondPayTx(tx *storage.DALTx, args ...interface{}) error {
 			return fmt.Errorf("%w: %d", common.ErrTooManyPendingPays, len(workingSimplex.PendingPayIds.PayIds))
 		}
 
+		// the relay fee is locked along with the pay and only transferred when the pay is paid
 		totalPendingAmt := new(big.Int).SetBytes(workingSimplex.TotalPendingAmount)
 		workingSimplex.TotalPendingAmount = totalPendingAmt.Add(totalPendingAmt, sendAmt).Bytes()
 
@@ -296,7 +385,7 @@ func (m *Messager) runCondPayTx(tx *storage.DALTx, args ...interface{}) error {
 	if err != nil {
 		return fmt.Errorf("marshal simplex state err %w", err)
 	}
-	workingSimplexState.SigOfPeerFrom, err = m.signer.SignEthMessage(workingSimplexState.SimplexState)
+	workingSimplexState.SigOfPeerFrom, err = utils.SignEthMessageOfKind(m.signer, intfs.SignKindSimplexState, workingSimplexState.SimplexState)
 	if err != nil {
 		return fmt.Errorf("sign simplex state err %w", err)
 	}
@@ -308,6 +397,9 @@ func (m *Messager) runCondPayTx(tx *storage.DALTx, args ...interface{}) error {
 		BaseSeq:              baseSeq,
 		DirectPay:            directPay,
 		CrossNet:             xnet,
+		TraceId:              traceID,
+		Route:                route,
+		Fee:                  feeBytes(fee),
 	}
 	celerMsg := &rpc.CelerMsg{
 		Message: &rpc.CelerMsg_CondPayRequest{
@@ -342,10 +434,24 @@ func (m *Messager) runCondPayTx(tx *storage.DALTx, args ...interface{}) error {
 			return fmt.Errorf("InsertPayment err %w", err)
 		}
 	}
+	if fee.Sign() > 0 {
+		err = tx.PutPayFee(payID, cid, fee)
+		if err != nil {
+			return fmt.Errorf("PutPayFee err %w", err)
+		}
+	}
 
 	return nil
 }
 
+// feeBytes returns nil for zero fee to keep the request unchanged
+func feeBytes(fee *big.Int) []byte {
+	if fee == nil || fee.Sign() == 0 {
+		return nil
+	}
+	return fee.Bytes()
+}
+
 func (m *Messager) updateDelegatedPay(tx *storage.DALTx, payID ctype.PayIDType, pay *entity.ConditionalPay, note *any.Any) error {
 	dnote := &delegate.PayOriginNote{}
 	if ptypes.Is(note, dnote) && ctype.Bytes2Addr(pay.GetSrc()) == m.nodeConfig.GetOnChainAddr() {
@@ -369,7 +475,7 @@ func (m *Messager) updateDelegatedPay(tx *storage.DALTx, payID ctype.PayIDType,
 
 func (m *Messager) sendCrossNetPay(
 	payID ctype.PayIDType, payBytes []byte, pay *entity.ConditionalPay, note *any.Any,
-	peerTo ctype.Addr, xnet *rpc.CrossNetPay, logEntry *pem.PayEventMessage) error {
+	peerTo ctype.Addr, xnet *rpc.CrossNetPay, traceID string, logEntry *pem.PayEventMessage) error {
 	if !xnet.GetCrossing() {
 		return fmt.Errorf("not crossing net payment")
 	}
@@ -399,6 +505,7 @@ func (m *Messager) sendCrossNetPay(
 		CondPay:  payBytes,
 		Note:     note,
 		CrossNet: xnet,
+		TraceId:  traceID,
 	}
 	celerMsg := &rpc.CelerMsg{
 		Message: &rpc.CelerMsg_CondPayRequest{
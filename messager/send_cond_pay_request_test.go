@@ -0,0 +1,102 @@
+// Copyright 2020 Celer Network
+
+package messager
+
+import (
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/celer-network/goCeler/chain/channel-eth-go/payresolver"
+	"github.com/celer-network/goCeler/common"
+	"github.com/celer-network/goCeler/common/cobj"
+	"github.com/celer-network/goCeler/common/intfs"
+	"github.com/celer-network/goCeler/common/structs"
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/entity"
+	"github.com/celer-network/goCeler/pem"
+	"github.com/celer-network/goCeler/rpc"
+	"github.com/celer-network/goCeler/storage"
+	"github.com/celer-network/goCeler/utils"
+	"github.com/golang/protobuf/proto"
+)
+
+type testMonitorService struct {
+	intfs.MonitorService
+	blkNum uint64
+}
+
+func (s *testMonitorService) GetCurrentBlockNumber() *big.Int {
+	return new(big.Int).SetUint64(s.blkNum)
+}
+
+func TestSourceRoutedSelfPay(t *testing.T) {
+	stFile := filepath.Join(os.TempDir(), "messager_self_pay_test.db")
+	os.Remove(stFile)
+	st, err := storage.NewKVStoreSQL("sqlite3", stFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(stFile)
+	defer st.Close()
+	dal := storage.NewDAL(st)
+
+	me, peer, other := ctype.Hex2Addr("abc1"), ctype.Hex2Addr("abc2"), ctype.Hex2Addr("abc3")
+	cid := ctype.Hex2Cid("c1")
+	token := utils.GetTokenInfoFromAddress(ctype.ZeroAddr)
+	err = dal.InsertChanOnChain(0, cid, peer, token, ctype.ZeroAddr, structs.ChanState_OPENED,
+		nil, &structs.OnChainBalance{}, 0, 0, 0, 0, &rpc.SignedSimplexState{}, &rpc.SignedSimplexState{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	nodeConfig := cobj.NewCelerGlobalNodeConfig(
+		me, nil, &common.CProfile{}, "", "", "", payresolver.PayResolverABI, "", "", nil)
+	m := NewMessager(nodeConfig, nil, nil, nil, &testMonitorService{blkNum: 100}, nil, nil, dal, true)
+
+	pay := &entity.ConditionalPay{
+		Src:  me.Bytes(),
+		Dest: me.Bytes(),
+		TransferFunc: &entity.TransferFunction{
+			MaxTransfer: &entity.TokenTransfer{
+				Token:    token,
+				Receiver: &entity.AccountAmtPair{Account: me.Bytes(), Amt: big.NewInt(10).Bytes()},
+			},
+		},
+		ResolveDeadline: 1000000, // beyond max payment timeout to stop before the db tx
+	}
+	payBytes, err := proto.Marshal(pay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	route := [][]byte{peer.Bytes(), other.Bytes(), me.Bytes()}
+
+	// next hop is the first hop of the route
+	logEntry := &pem.PayEventMessage{}
+	_, nextCid, nextPeer, directPay, err := m.getPayNextHop(payBytes, nil, route, logEntry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nextCid != cid || nextPeer != peer || directPay || logEntry.GetToCid() != ctype.Cid2Hex(cid) {
+		t.Errorf("wrong next hop cid %x peer %x direct %t", nextCid, nextPeer, directPay)
+	}
+	_, _, _, _, err = m.getPayNextHop(payBytes, nil, [][]byte{other.Bytes(), me.Bytes()}, logEntry)
+	if !errors.Is(err, common.ErrRouteNotFound) {
+		t.Errorf("wrong error routing through non-peer: %v", err)
+	}
+	_, _, _, _, err = m.getPayNextHop(payBytes, &rpc.CrossNetPay{DstNetId: 2}, route, logEntry)
+	if err == nil {
+		t.Error("source routed cross net pay accepted")
+	}
+
+	// only source routed pays can be sent to myself
+	err = m.sendCondPayRequest(nil, payBytes, pay, nil, cid, peer, nil, "", nil, nil, &pem.PayEventMessage{})
+	if !errors.Is(err, common.ErrInvalidPayDst) {
+		t.Errorf("wrong error sending self pay without route: %v", err)
+	}
+	err = m.sendCondPayRequest(nil, payBytes, pay, nil, cid, peer, nil, "", route, nil, &pem.PayEventMessage{})
+	if !errors.Is(err, common.ErrInvalidPayDeadline) {
+		t.Errorf("wrong error sending self pay with route: %v", err)
+	}
+}
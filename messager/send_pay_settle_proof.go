@@ -12,24 +12,30 @@ import (
 	"github.com/celer-network/goCeler/rpc"
 	"github.com/celer-network/goCeler/storage"
 	"github.com/celer-network/goutils/log"
+	"go.opencensus.io/trace"
 )
 
 func (m *Messager) SendOnePaySettleProof(
+	parent *trace.Span,
 	payID ctype.PayIDType,
 	reason rpc.PaymentSettleReason,
 	logEntry *pem.PayEventMessage) error {
-	return m.SendPaysSettleProof([]ctype.PayIDType{payID}, reason, nil, logEntry)
+	return m.SendPaysSettleProof(parent, []ctype.PayIDType{payID}, reason, nil, logEntry)
 }
 
 func (m *Messager) SendPayUnreachableSettleProof(
+	parent *trace.Span,
 	payID ctype.PayIDType,
 	path *rpc.PayPath,
 	logEntry *pem.PayEventMessage) error {
 	return m.SendPaysSettleProof(
-		[]ctype.PayIDType{payID}, rpc.PaymentSettleReason_PAY_DEST_UNREACHABLE, []*rpc.PayPath{path}, logEntry)
+		parent, []ctype.PayIDType{payID}, rpc.PaymentSettleReason_PAY_DEST_UNREACHABLE, []*rpc.PayPath{path}, logEntry)
 }
 
+// SendPaysSettleProof sends the settle proof of the pays to the upstream peer, the db transaction
+// is traced as a child span of parent if it is not nil.
 func (m *Messager) SendPaysSettleProof(
+	parent *trace.Span,
 	payIDs []ctype.PayIDType,
 	reason rpc.PaymentSettleReason,
 	payPaths []*rpc.PayPath,
@@ -41,7 +47,8 @@ func (m *Messager) SendPaysSettleProof(
 
 	var peer ctype.Addr
 	request := &rpc.PaymentSettleProof{}
-	err := m.dal.Transactional(m.runPaySettleProofTx, payIDs, reason, payPaths, logEntry, &peer, &request)
+	err := m.dal.TracedTransactional(parent, "runPaySettleProofTx", m.runPaySettleProofTx,
+		payIDs, reason, payPaths, logEntry, &peer, &request)
 	if err != nil {
 		return err
 	}
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/celer-network/goCeler/accounting"
 	"github.com/celer-network/goCeler/common"
+	"github.com/celer-network/goCeler/common/intfs"
 	enums "github.com/celer-network/goCeler/common/structs"
 	"github.com/celer-network/goCeler/ctype"
 	"github.com/celer-network/goCeler/entity"
@@ -15,23 +17,29 @@ import (
 	"github.com/celer-network/goCeler/pem"
 	"github.com/celer-network/goCeler/rpc"
 	"github.com/celer-network/goCeler/storage"
+	"github.com/celer-network/goCeler/utils"
 	"github.com/celer-network/goCeler/utils/hashlist"
 	"github.com/celer-network/goutils/log"
 	"github.com/golang/protobuf/proto"
+	"go.opencensus.io/trace"
 )
 
 func (m *Messager) SendOnePaySettleRequest(
+	parent *trace.Span,
 	pay *entity.ConditionalPay,
 	payAmt *big.Int,
 	reason rpc.PaymentSettleReason,
 	logEntry *pem.PayEventMessage) error {
 	pays := []*entity.ConditionalPay{pay}
 	payAmts := []*big.Int{payAmt}
-	_, err := m.SendPaysSettleRequest(pays, payAmts, reason, logEntry)
+	_, err := m.SendPaysSettleRequest(parent, pays, payAmts, reason, logEntry)
 	return err
 }
 
+// SendPaysSettleRequest settles the pays with the peer, the db transaction and the queued sends
+// are traced as child spans of parent if it is not nil.
 func (m *Messager) SendPaysSettleRequest(
+	parent *trace.Span,
 	pays []*entity.ConditionalPay,
 	payAmts []*big.Int, // total amount for all setted payments
 	reason rpc.PaymentSettleReason,
@@ -51,7 +59,8 @@ func (m *Messager) SendPaysSettleRequest(
 	var skippedPays []*entity.ConditionalPay
 	var cid ctype.CidType
 	var peerTo ctype.Addr
-	err := m.dal.Transactional(m.runPaySettleTx, pays, payAmts, reason, &seqnum, &celerMsg, &skippedPays, &cid, &peerTo)
+	err := m.dal.TracedTransactional(parent, "runPaySettleTx", m.runPaySettleTx,
+		pays, payAmts, reason, &seqnum, &celerMsg, &skippedPays, &cid, &peerTo)
 	if err == common.ErrPayNoEgress && len(pays) == 1 && reason == rpc.PaymentSettleReason_PAY_PAID_MAX {
 		return nil, m.sendCrossNetPaySettleRequest(pays[0], payAmts[0], logEntry)
 	}
@@ -63,7 +72,7 @@ func (m *Messager) SendPaysSettleRequest(
 	logEntry.SeqNums.Out = seqnum
 	logEntry.SeqNums.OutBase = celerMsg.GetPaymentSettleRequest().GetBaseSeq()
 	log.Debugln("Send payment settle request to", peerTo.Hex(), "reason", reason)
-	return skippedPays, m.msgQueue.AddMsg(peerTo, cid, seqnum, celerMsg)
+	return skippedPays, m.msgQueue.AddMsg(parent, peerTo, cid, seqnum, celerMsg)
 }
 
 func (m *Messager) runPaySettleTx(tx *storage.DALTx, args ...interface{}) error {
@@ -116,7 +125,6 @@ func (m *Messager) runPaySettleTx(tx *storage.DALTx, args ...interface{}) error
 	for _, amt := range payAmts {
 		payAmt = payAmt.Add(payAmt, amt)
 	}
-	workingSimplex.TransferToPeer.Receiver.Amt = sendAmt.Add(sendAmt, payAmt).Bytes()
 	totalPendingAmt := new(big.Int).SetBytes(workingSimplex.TotalPendingAmount)
 
 	var settledPays []*rpc.SettledPayment
@@ -138,6 +146,14 @@ func (m *Messager) runPaySettleTx(tx *storage.DALTx, args ...interface{}) error
 		}
 		amt := new(big.Int).SetBytes(pay.GetTransferFunc().GetMaxTransfer().GetReceiver().GetAmt())
 		totalPendingAmt = totalPendingAmt.Sub(totalPendingAmt, amt)
+		// the relay fee locked with the pay is paid along with it, or returned if the pay is canceled
+		fee, found, err2 := tx.GetPayFee(payID, cid)
+		if err2 != nil {
+			return fmt.Errorf("GetPayFee %x err %w", payID, err2)
+		}
+		if found {
+			totalPendingAmt = totalPendingAmt.Sub(totalPendingAmt, fee)
+		}
 		// payment state machine
 		if paid {
 			err = fsm.OnPayEgressOneSigPaid(tx, payID, egstate)
@@ -152,6 +168,14 @@ func (m *Messager) runPaySettleTx(tx *storage.DALTx, args ...interface{}) error
 			}
 			return fmt.Errorf("pay %x fsm err %w, paid %t", payID, err, paid)
 		}
+		if paid && found && fee.Sign() > 0 {
+			payAmt = payAmt.Add(payAmt, fee)
+			token := ctype.Bytes2Addr(pay.GetTransferFunc().GetMaxTransfer().GetToken().GetTokenAddress())
+			err = tx.InsertAcctRecord(accounting.FeeOutRecord(payID, cid, token, fee))
+			if err != nil {
+				return fmt.Errorf("InsertAcctRecord err %w", err)
+			}
+		}
 		settledPay := &rpc.SettledPayment{
 			SettledPayId: payID[:],
 			Reason:       reason,
@@ -162,6 +186,7 @@ func (m *Messager) runPaySettleTx(tx *storage.DALTx, args ...interface{}) error
 	if len(settledPays) == 0 {
 		return fmt.Errorf("invalid payment settle request")
 	}
+	workingSimplex.TransferToPeer.Receiver.Amt = sendAmt.Add(sendAmt, payAmt).Bytes()
 	workingSimplex.TotalPendingAmount = totalPendingAmt.Bytes()
 
 	var workingSimplexState rpc.SignedSimplexState
@@ -169,7 +194,7 @@ func (m *Messager) runPaySettleTx(tx *storage.DALTx, args ...interface{}) error
 	if err != nil {
 		return fmt.Errorf("marshal simplex state err %w", err)
 	}
-	workingSimplexState.SigOfPeerFrom, err = m.signer.SignEthMessage(workingSimplexState.SimplexState)
+	workingSimplexState.SigOfPeerFrom, err = utils.SignEthMessageOfKind(m.signer, intfs.SignKindSimplexState, workingSimplexState.SimplexState)
 	if err != nil {
 		return fmt.Errorf("sign simplex state err %w", err)
 	}
@@ -226,7 +251,7 @@ func (m *Messager) ForwardPaySettleRequestMsg(frame *common.MsgFrame) error {
 		return fmt.Errorf("GetPayment err %w", common.ErrPayNotFound)
 	}
 	amt := new(big.Int).SetBytes(pay.TransferFunc.MaxTransfer.Receiver.Amt)
-	return m.SendOnePaySettleRequest(pay, amt, rpc.PaymentSettleReason_PAY_PAID_MAX, logEntry)
+	return m.SendOnePaySettleRequest(frame.Span, pay, amt, rpc.PaymentSettleReason_PAY_PAID_MAX, logEntry)
 }
 
 func (m *Messager) sendCrossNetPaySettleRequest(
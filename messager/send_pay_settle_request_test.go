@@ -0,0 +1,167 @@
+// Copyright 2020 Celer Network
+
+package messager
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/celer-network/goCeler/accounting"
+	"github.com/celer-network/goCeler/chain/channel-eth-go/payresolver"
+	"github.com/celer-network/goCeler/common"
+	"github.com/celer-network/goCeler/common/cobj"
+	"github.com/celer-network/goCeler/common/structs"
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/entity"
+	"github.com/celer-network/goCeler/rpc"
+	"github.com/celer-network/goCeler/storage"
+	"github.com/celer-network/goCeler/utils"
+	"github.com/celer-network/goutils/eth"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+)
+
+func TestPayFeeLockedUntilPaid(t *testing.T) {
+	stFile := filepath.Join(os.TempDir(), "messager_pay_fee_test.db")
+	os.Remove(stFile)
+	st, err := storage.NewKVStoreSQL("sqlite3", stFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(stFile)
+	defer st.Close()
+	dal := storage.NewDAL(st)
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := eth.NewSigner(ctype.Bytes2Hex(crypto.FromECDSA(key)), big.NewInt(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	me, peer, dest := crypto.PubkeyToAddress(key.PublicKey), ctype.Hex2Addr("abc2"), ctype.Hex2Addr("abc3")
+	cid := ctype.Hex2Cid("c1")
+	token := utils.GetTokenInfoFromAddress(ctype.ZeroAddr)
+	simplex := &entity.SimplexPaymentChannel{
+		ChannelId:          cid.Bytes(),
+		PeerFrom:           me.Bytes(),
+		TransferToPeer:     &entity.TokenTransfer{Token: token, Receiver: &entity.AccountAmtPair{}},
+		PendingPayIds:      &entity.PayIdList{},
+		TotalPendingAmount: big.NewInt(0).Bytes(),
+	}
+	simplexBytes, err := proto.Marshal(simplex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	onChainBalance := &structs.OnChainBalance{
+		MyDeposit:      big.NewInt(100),
+		MyWithdrawal:   big.NewInt(0),
+		PeerDeposit:    big.NewInt(0),
+		PeerWithdrawal: big.NewInt(0),
+	}
+	err = dal.InsertChanOnChain(0, cid, peer, token, ctype.ZeroAddr, structs.ChanState_OPENED, nil, onChainBalance,
+		0, 0, 0, 0, &rpc.SignedSimplexState{SimplexState: simplexBytes}, &rpc.SignedSimplexState{SimplexState: simplexBytes})
+	if err != nil {
+		t.Fatal(err)
+	}
+	nodeConfig := cobj.NewCelerGlobalNodeConfig(
+		me, nil, &common.CProfile{}, "", "", "", payresolver.PayResolverABI, "", "", nil)
+	m := NewMessager(nodeConfig, signer, nil, nil, &testMonitorService{blkNum: 100}, nil, nil, dal, false)
+
+	// lastSimplex returns the simplex state of the last message sent through the channel
+	lastSimplex := func(msg *rpc.CelerMsg) *entity.SimplexPaymentChannel {
+		state := msg.GetCondPayRequest().GetStateOnlyPeerFromSig()
+		if msg.GetPaymentSettleRequest() != nil {
+			state = msg.GetPaymentSettleRequest().GetStateOnlyPeerFromSig()
+		}
+		var s entity.SimplexPaymentChannel
+		err2 := proto.Unmarshal(state.GetSimplexState(), &s)
+		if err2 != nil {
+			t.Fatal(err2)
+		}
+		return &s
+	}
+	checkAmts := func(name string, s *entity.SimplexPaymentChannel, transfer, pending int64) {
+		gotTransfer := new(big.Int).SetBytes(s.GetTransferToPeer().GetReceiver().GetAmt())
+		gotPending := new(big.Int).SetBytes(s.GetTotalPendingAmount())
+		if gotTransfer.Int64() != transfer || gotPending.Int64() != pending {
+			t.Errorf("%s: got transfer %s pending %s, expect %d %d", name, gotTransfer, gotPending, transfer, pending)
+		}
+	}
+
+	payAmt, fee := int64(10), big.NewInt(2)
+	tests := []struct {
+		name     string
+		reason   rpc.PaymentSettleReason
+		paid     bool
+		transfer int64
+	}{
+		{"expired", rpc.PaymentSettleReason_PAY_EXPIRED, false, 0},
+		{"rejected", rpc.PaymentSettleReason_PAY_REJECTED, false, 0},
+		{"paid", rpc.PaymentSettleReason_PAY_PAID_MAX, true, payAmt + fee.Int64()},
+	}
+	for i, tc := range tests {
+		pay := &entity.ConditionalPay{
+			PayTimestamp: uint64(i),
+			Src:          me.Bytes(),
+			Dest:         dest.Bytes(),
+			TransferFunc: &entity.TransferFunction{
+				MaxTransfer: &entity.TokenTransfer{
+					Token:    token,
+					Receiver: &entity.AccountAmtPair{Account: dest.Bytes(), Amt: big.NewInt(payAmt).Bytes()},
+				},
+			},
+			ResolveDeadline: 200,
+		}
+		payBytes, err2 := proto.Marshal(pay)
+		if err2 != nil {
+			t.Fatal(err2)
+		}
+		payID := ctype.Pay2PayID(pay)
+
+		// the fee is locked along with the pay
+		var seqNum uint64
+		var msg *rpc.CelerMsg
+		err = dal.Transactional(m.runCondPayTx,
+			cid, payID, pay, payBytes, (*any.Any)(nil), false, (*rpc.CrossNetPay)(nil), "", [][]byte(nil), fee, &seqNum, &msg)
+		if err != nil {
+			t.Fatalf("%s: runCondPayTx err %v", tc.name, err)
+		}
+		checkAmts(tc.name+" pending", lastSimplex(msg), 0, payAmt+fee.Int64())
+		if new(big.Int).SetBytes(msg.GetCondPayRequest().GetFee()).Cmp(fee) != 0 {
+			t.Errorf("%s: wrong fee in request %x", tc.name, msg.GetCondPayRequest().GetFee())
+		}
+
+		// the fee is transferred only if the pay is paid
+		amt := big.NewInt(0)
+		if tc.paid {
+			amt = big.NewInt(payAmt)
+		}
+		var skipped []*entity.ConditionalPay
+		var settleCid ctype.CidType
+		var peerTo ctype.Addr
+		err = dal.Transactional(m.runPaySettleTx, []*entity.ConditionalPay{pay}, []*big.Int{amt}, tc.reason,
+			&seqNum, &msg, &skipped, &settleCid, &peerTo)
+		if err != nil {
+			t.Fatalf("%s: runPaySettleTx err %v", tc.name, err)
+		}
+		checkAmts(tc.name+" settled", lastSimplex(msg), tc.transfer, 0)
+		if settleCid != cid || peerTo != peer || len(skipped) != 0 {
+			t.Errorf("%s: wrong settle cid %x peer %x skipped %d", tc.name, settleCid, peerTo, len(skipped))
+		}
+	}
+
+	// only the fee of the paid pay is accounted
+	records, err := dal.GetAcctRecords(time.Time{}, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || accounting.EntryKind(records[0].EntryID) != accounting.KindFeeOut {
+		t.Errorf("wrong fee records %v", records)
+	}
+}
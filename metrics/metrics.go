@@ -4,6 +4,7 @@ package metrics
 
 import (
 	"context"
+	"math/big"
 	"strconv"
 	"time"
 
@@ -33,6 +34,12 @@ var (
 	mDispatcherMsgProcDur = stats.Float64("celer/dispatchers/message_processing_duration", "Duration of message processing with various handlers", stats.UnitMilliseconds)
 	mDispatcherErrCnt     = stats.Int64("celer/dispatchers/error_handling_count", "Number of errors happened after handling messages", stats.UnitDimensionless)
 
+	// Metrics for ratelimit
+	mRateLimitedCnt = stats.Int64("celer/ratelimit/limited_count", "Number of messages and rpc calls rejected by rate limits", stats.UnitDimensionless)
+
+	// Metrics for messager
+	mMsgQueueFullCnt = stats.Int64("celer/messager/queue_full_count", "Number of pays rejected because the channel msg queue is full", stats.UnitDimensionless)
+
 	// Metrics for cooperativewithdraw
 	mCoopWithdrawEventCnt = stats.Int64("celer/cooperativewithdraw/event_count", "Number of cooperative withdraw events handled", stats.UnitDimensionless)
 
@@ -70,6 +77,9 @@ var (
 	// tag key for dispatchers
 	tkDispatcherMsgType, _ = tag.NewKey("type") // label to indicate the message type in mDispatcherMsgCnt
 
+	// tag key for ratelimit
+	tkRateLimitedType, _ = tag.NewKey("type") // label to indicate the message or rpc name in mRateLimitedCnt
+
 	// tag key for dispute
 	tkDisputeEventState, _ = tag.NewKey("state") // label to indicate the event state in mDisputeSettleEventCnt
 
@@ -140,6 +150,23 @@ var (
 		Aggregation: view.Count(),
 	}
 
+	// view for ratelimit
+	viewRateLimitedCnt = &view.View{
+		Name:        "ratelimit/limited_count",
+		Description: "Number of messages and rpc calls rejected by rate limits",
+		TagKeys:     []tag.Key{tkRateLimitedType},
+		Measure:     mRateLimitedCnt,
+		Aggregation: view.Count(),
+	}
+
+	// view for messager
+	viewMsgQueueFullCnt = &view.View{
+		Name:        "messager/queue_full_count",
+		Description: "Number of pays rejected because the channel msg queue is full",
+		Measure:     mMsgQueueFullCnt,
+		Aggregation: view.Count(),
+	}
+
 	// view for cooperativewithdraw
 	viewCoopWithdrawEventCnt = &view.View{
 		Name:        "cooperativewithdraw/event_count",
@@ -230,14 +257,73 @@ const (
 	CNodeOpenChanOK  = "OK"
 	CNodeOpenChanErr = "ERROR"
 
-	// one ether in wei
-	ether = "1000000000000000000"
+	// decimals of ether, e.g., in ETH pool balances
+	ethDecimals = 18
 )
 
 // exporter for outputing metrics, opencensus supports various exporters
 var promExporter *prometheus.Exporter
 var promRegistry *prom.Registry
 
+// Gauges for channel liquidity, prefix g. These are prometheus gauges instead of opencensus
+// views so that series of closed channels or disconnected peers can be dropped on reset.
+var (
+	gLiquidityLabels    = []string{"peer", "token"}
+	gPendingPayLabels   = []string{"peer", "token", "direction"}
+	gLiquidityChanCnt   = newGauge("liquidity_channel_count", "Number of opened channels", gLiquidityLabels)
+	gLiquiditySendCap   = newGauge("liquidity_send_capacity", "Free balance to send in opened channels, in token units", gLiquidityLabels)
+	gLiquidityRecvCap   = newGauge("liquidity_receive_capacity", "Free balance to receive in opened channels, in token units", gLiquidityLabels)
+	gPendingPayCnt      = newGauge("pending_pay_count", "Number of pending pays in opened channels", gPendingPayLabels)
+	gPendingPayPeak     = newGauge("pending_pay_peak", "Max number of pending pays in a single channel, to compare with the limit", gPendingPayLabels)
+	gPendingPayAmt      = newGauge("pending_pay_amount", "Value of pending pays in opened channels, in token units", gPendingPayLabels)
+	gPendingPayLimit    = newGauge("pending_pay_limit", "Max number of pending pays allowed in a single channel (max_num_pending_pays)", nil)
+	gEthPoolBalance     = newGauge("ethpool_balance", "ETH pool balance of the node accounts, in ETH", []string{"account"})
+	gLiquidityCollectTs = newGauge("liquidity_collect_timestamp", "Unix time of the last liquidity collection", nil)
+
+	// Gauges for the msg queue, aggregated over all channels to keep the number of series small
+	gMsgQueueUnacked  = newGauge("msgqueue_unacked_count", "Number of queued messages not yet acked by the peers", nil)
+	gMsgQueueUnsent   = newGauge("msgqueue_unsent_count", "Number of queued messages not yet sent to the peers", nil)
+	gMsgQueueChanCnt  = newGauge("msgqueue_channel_count", "Number of channels with unacked messages", nil)
+	gMsgQueuePeak     = newGauge("msgqueue_peak_depth", "Max number of unacked messages in a single channel, to compare with msg_queue_max_depth", nil)
+	gMsgQueueBusyWork = newGauge("msgqueue_busy_workers", "Number of msg queue workers sending messages", nil)
+)
+
+const (
+	// For pending pay gauges, direction of the pays
+	PendingPayOut = "out"
+	PendingPayIn  = "in"
+
+	// For liquidity gauges, peer label of all client channels aggregated together
+	LiquidityPeerClients = "clients"
+)
+
+// LiquidityStats is the aggregated liquidity of channels with the same peer label and token
+type LiquidityStats struct {
+	Peer         string
+	Token        string
+	Decimals     uint8 // decimals of the token, to export amounts in token units
+	Channels     int
+	SendCapacity *big.Int
+	RecvCapacity *big.Int
+	PendingCnt   map[string]int      // direction -> number of pending pays
+	PendingPeak  map[string]int      // direction -> max number of pending pays in a single channel
+	PendingAmt   map[string]*big.Int // direction -> value of pending pays
+}
+
+// NewLiquidityStats returns zero stats with the given labels and token decimals
+func NewLiquidityStats(peer, token string, decimals uint8) *LiquidityStats {
+	return &LiquidityStats{
+		Peer:         peer,
+		Token:        token,
+		Decimals:     decimals,
+		SendCapacity: new(big.Int),
+		RecvCapacity: new(big.Int),
+		PendingCnt:   make(map[string]int),
+		PendingPeak:  make(map[string]int),
+		PendingAmt:   map[string]*big.Int{PendingPayOut: new(big.Int), PendingPayIn: new(big.Int)},
+	}
+}
+
 // Init setup metrics and return http handler for prometheus scraping
 func init() {
 	// register view, more to be added. ignore errs
@@ -248,6 +334,8 @@ func init() {
 		viewDispatcherMsgCnt,
 		viewDispatcherMsgProcDur,
 		viewDispatcherErrCnt,
+		viewRateLimitedCnt,
+		viewMsgQueueFullCnt,
 		viewCoopWithdrawEventCnt,
 		viewDisputeSettleEventCnt,
 		viewDisputeWithdrawEventCnt,
@@ -262,6 +350,22 @@ func init() {
 	})
 	// Register the Prometheus exporter.
 	view.RegisterExporter(promExporter)
+	promRegistry.MustRegister(
+		gLiquidityChanCnt,
+		gLiquiditySendCap,
+		gLiquidityRecvCap,
+		gPendingPayCnt,
+		gPendingPayPeak,
+		gPendingPayAmt,
+		gPendingPayLimit,
+		gEthPoolBalance,
+		gLiquidityCollectTs,
+		gMsgQueueUnacked,
+		gMsgQueueUnsent,
+		gMsgQueueChanCnt,
+		gMsgQueuePeak,
+		gMsgQueueBusyWork,
+	)
 }
 
 // GetPromExporter would return the prometheus exporter
@@ -274,6 +378,45 @@ func GetPromRegistry() *prom.Registry {
 	return promRegistry
 }
 
+// SetLiquidityGauges replaces all liquidity and pending pay gauges with the given stats
+func SetLiquidityGauges(stats []*LiquidityStats, pendingPayLimit uint64) {
+	gLiquidityChanCnt.Reset()
+	gLiquiditySendCap.Reset()
+	gLiquidityRecvCap.Reset()
+	gPendingPayCnt.Reset()
+	gPendingPayPeak.Reset()
+	gPendingPayAmt.Reset()
+	for _, st := range stats {
+		gLiquidityChanCnt.WithLabelValues(st.Peer, st.Token).Set(float64(st.Channels))
+		gLiquiditySendCap.WithLabelValues(st.Peer, st.Token).Set(toTokenUnits(st.SendCapacity, st.Decimals))
+		gLiquidityRecvCap.WithLabelValues(st.Peer, st.Token).Set(toTokenUnits(st.RecvCapacity, st.Decimals))
+		for _, dir := range []string{PendingPayOut, PendingPayIn} {
+			gPendingPayCnt.WithLabelValues(st.Peer, st.Token, dir).Set(float64(st.PendingCnt[dir]))
+			gPendingPayPeak.WithLabelValues(st.Peer, st.Token, dir).Set(float64(st.PendingPeak[dir]))
+			gPendingPayAmt.WithLabelValues(st.Peer, st.Token, dir).Set(toTokenUnits(st.PendingAmt[dir], st.Decimals))
+		}
+	}
+	gPendingPayLimit.WithLabelValues().Set(float64(pendingPayLimit))
+	gLiquidityCollectTs.WithLabelValues().Set(float64(time.Now().Unix()))
+}
+
+// SetMsgQueueGauges sets the msg queue depth gauges
+func SetMsgQueueGauges(unacked, unsent, channels, peak uint64, busyWorkers int) {
+	gMsgQueueUnacked.WithLabelValues().Set(float64(unacked))
+	gMsgQueueUnsent.WithLabelValues().Set(float64(unsent))
+	gMsgQueueChanCnt.WithLabelValues().Set(float64(channels))
+	gMsgQueuePeak.WithLabelValues().Set(float64(peak))
+	gMsgQueueBusyWork.WithLabelValues().Set(float64(busyWorkers))
+}
+
+// SetEthPoolBalanceGauges replaces the ETH pool balance gauges with the given account balances
+func SetEthPoolBalanceGauges(balances map[string]*big.Int) {
+	gEthPoolBalance.Reset()
+	for account, balance := range balances {
+		gEthPoolBalance.WithLabelValues(account).Set(toTokenUnits(balance, ethDecimals))
+	}
+}
+
 // IncSvrAdminSendTokenCnt records one for mAdminSendTokenCnt
 func IncSvrAdminSendTokenCnt(sendstat, notetype string) {
 	ctx, err := tag.New(context.Background(),
@@ -348,6 +491,21 @@ func IncDispatcherErrCnt(msgtype string) {
 	stats.Record(ctx, mDispatcherErrCnt.M(1))
 }
 
+// IncRateLimitedCnt records one for mRateLimitedCnt
+func IncRateLimitedCnt(msgtype string) {
+	ctx, err := tag.New(context.Background(), tag.Insert(tkRateLimitedType, msgtype))
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	stats.Record(ctx, mRateLimitedCnt.M(1))
+}
+
+// IncMsgQueueFullCnt records one for mMsgQueueFullCnt
+func IncMsgQueueFullCnt() {
+	stats.Record(context.Background(), mMsgQueueFullCnt.M(1))
+}
+
 // IncCoopWithdrawEventCnt records one for mCoopWithdrawEventCnt
 func IncCoopWithdrawEventCnt() {
 	stats.Record(context.Background(), mCoopWithdrawEventCnt.M(1))
@@ -416,6 +574,24 @@ func PushMetricsToGateway(url, job string) {
 	push.New(url, job).Gatherer(GetPromRegistry()).Grouping("time", time.Now().String()).Add()
 }
 
+func newGauge(name, help string, labels []string) *prom.GaugeVec {
+	return prom.NewGaugeVec(prom.GaugeOpts{
+		Namespace: "celer",
+		Name:      name,
+		Help:      help,
+	}, labels)
+}
+
+// toTokenUnits converts amount in the smallest unit of a token to float in token units
+func toTokenUnits(amt *big.Int, decimals uint8) float64 {
+	if amt == nil {
+		return 0
+	}
+	unit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	units, _ := new(big.Float).Quo(new(big.Float).SetInt(amt), new(big.Float).SetInt(unit)).Float64()
+	return units
+}
+
 // sinceInMilliseconds calculate time duration in milliseconds from start time
 func sinceInMilliseconds(startTime time.Time) float64 {
 	return float64(time.Since(startTime).Nanoseconds()) / 1e6
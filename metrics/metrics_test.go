@@ -3,11 +3,18 @@
 package metrics
 
 import (
+	"encoding/json"
 	"errors"
+	"io/ioutil"
+	"math/big"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
+
+	"go.opencensus.io/trace"
 )
 
 func TestMetrics(t *testing.T) {
@@ -58,6 +65,10 @@ func mockRecordMetrics() {
 	IncDispatcherErrCnt("msg2")
 	IncDispatcherErrCnt("msg1")
 
+	IncRateLimitedCnt("msg1")
+	IncRateLimitedCnt("rpc1")
+	IncRateLimitedCnt("msg1")
+
 	IncCoopWithdrawEventCnt()
 	IncCoopWithdrawEventCnt()
 
@@ -94,6 +105,8 @@ func checkExportedMetrics(s string) bool {
 		strings.Index(s, `celer_dispatchers_message_processing_duration_count{type="msg1"} 2`) < 0 ||
 		strings.Index(s, `celer_dispatchers_error_handling_count{type="msg1"} 2`) < 0 ||
 		strings.Index(s, `celer_dispatchers_error_handling_count{type="msg2"} 1`) < 0 ||
+		strings.Index(s, `celer_ratelimit_limited_count{type="msg1"} 2`) < 0 ||
+		strings.Index(s, `celer_ratelimit_limited_count{type="rpc1"} 1`) < 0 ||
 		strings.Index(s, `celer_cooperativewithdraw_event_count 2`) < 0 ||
 		strings.Index(s, `celer_dispute_settle_event_count{state="state1"} 1`) < 0 ||
 		strings.Index(s, `celer_dispute_settle_event_count{state="state2"} 1`) < 0 ||
@@ -113,3 +126,81 @@ func TestPush(t *testing.T) {
 	// Test passed
 	PushMetricsToGateway("localhost:9091", "test")
 }
+
+func TestTraceExport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "otlp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "traces.json")
+	err = StartTraceExport(file, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	root := StartSpan(nil, "root")
+	root.AddAttributes(trace.StringAttribute(AkPayID, "abcd"), trace.Int64Attribute(AkSeqIn, 5))
+	child := StartSpan(root, "child")
+	EndSpan(child, errors.New("child failed"))
+	EndSpan(root, nil)
+	time.Sleep(2 * traceFlushInterval)
+
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var traces otlpTraces
+	err = json.Unmarshal(data, &traces)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spans := traces.ResourceSpans[0].ScopeSpans[0].Spans
+	if len(spans) != 2 {
+		t.Fatalf("exported %d spans, expect 2", len(spans))
+	}
+	exported := make(map[string]*otlpSpan)
+	for _, span := range spans {
+		exported[span.Name] = span
+	}
+	if exported["child"].TraceID != exported["root"].TraceID ||
+		exported["child"].ParentSpanID != exported["root"].SpanID ||
+		exported["root"].ParentSpanID != "" {
+		t.Error("wrong span relation", string(data))
+	}
+	if exported["child"].Status.Code != otlpStatusError || exported["child"].Status.Message != "child failed" ||
+		exported["root"].Status != nil {
+		t.Error("wrong span status", string(data))
+	}
+	if len(exported["root"].Attributes) != 2 {
+		t.Error("wrong span attributes", string(data))
+	}
+}
+
+func TestLiquidityGauges(t *testing.T) {
+	st := NewLiquidityStats(LiquidityPeerClients, "abc1", 6)
+	st.Channels = 2
+	st.SendCapacity.SetInt64(2500000)
+	SetLiquidityGauges([]*LiquidityStats{st}, 10)
+	eth := new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+	SetEthPoolBalanceGauges(map[string]*big.Int{"abc2": eth, "abc3": eth})
+	// series of removed accounts are dropped
+	SetEthPoolBalanceGauges(map[string]*big.Int{"abc2": new(big.Int).Mul(eth, big.NewInt(3))})
+
+	values := make(map[string][]float64)
+	families, err := GetPromRegistry().Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, family := range families {
+		for _, m := range family.GetMetric() {
+			values[family.GetName()] = append(values[family.GetName()], m.GetGauge().GetValue())
+		}
+	}
+	if v := values["celer_liquidity_send_capacity"]; len(v) != 1 || v[0] != 2.5 {
+		t.Errorf("wrong send capacity in token units: %v", v)
+	}
+	if v := values["celer_ethpool_balance"]; len(v) != 1 || v[0] != 3 {
+		t.Errorf("wrong ethpool balances: %v", v)
+	}
+}
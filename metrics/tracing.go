@@ -0,0 +1,254 @@
+// Copyright 2020 Celer Network
+
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/celer-network/goutils/log"
+	"go.opencensus.io/trace"
+)
+
+// Span attribute keys, prefix ak
+const (
+	AkMsgType    = "celer.msg_type"
+	AkMsgFrom    = "celer.msg_from"
+	AkMsgTo      = "celer.msg_to"
+	AkPayID      = "celer.pay_id"
+	AkFromCid    = "celer.from_cid"
+	AkToCid      = "celer.to_cid"
+	AkSeqIn      = "celer.seq_in"
+	AkSeqOut     = "celer.seq_out"
+	AkSeqAck     = "celer.seq_ack"
+	AkTxAttempts = "celer.tx_attempts"
+)
+
+const (
+	traceFlushInterval = time.Second
+	traceMaxBatch      = 512
+	traceHttpTimeout   = 3 * time.Second
+
+	// OTLP status code of failed spans, the status of other spans is left unset
+	otlpStatusError = 2
+)
+
+func init() {
+	// spans are only recorded once an exporter is started
+	trace.ApplyConfig(trace.Config{DefaultSampler: trace.NeverSample()})
+}
+
+// StartSpan starts a child span of parent, or a root span if parent is nil.
+func StartSpan(parent *trace.Span, name string) *trace.Span {
+	_, span := trace.StartSpan(trace.NewContext(context.Background(), parent), name)
+	return span
+}
+
+// EndSpan sets the span status according to err and ends the span.
+func EndSpan(span *trace.Span, err error) {
+	if err != nil {
+		span.SetStatus(trace.Status{Code: trace.StatusCodeUnknown, Message: err.Error()})
+	}
+	span.End()
+}
+
+// StartTraceExport starts exporting all spans in OTLP JSON format to target.
+// If target is an http(s) URL, spans are posted to it as an OTLP/HTTP collector
+// (e.g., http://localhost:4318/v1/traces). Otherwise target is treated as a
+// file path and each batch of spans is appended as one JSON line.
+func StartTraceExport(target, service string) error {
+	e := &otlpExporter{
+		service: service,
+		queue:   make(chan *trace.SpanData, traceMaxBatch*4),
+	}
+	if strings.HasPrefix(target, "http://") || strings.HasPrefix(target, "https://") {
+		e.url = target
+		e.client = &http.Client{Timeout: traceHttpTimeout}
+	} else {
+		f, err := os.OpenFile(target, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			return fmt.Errorf("open trace export file err: %w", err)
+		}
+		e.file = f
+	}
+	trace.RegisterExporter(e)
+	trace.ApplyConfig(trace.Config{DefaultSampler: trace.AlwaysSample()})
+	go e.run()
+	log.Infoln("exporting OTLP traces to", target)
+	return nil
+}
+
+type otlpExporter struct {
+	service string
+	queue   chan *trace.SpanData
+	url     string
+	client  *http.Client
+	file    io.Writer
+}
+
+// ExportSpan implements trace.Exporter, spans are dropped if the export queue is full
+func (e *otlpExporter) ExportSpan(sd *trace.SpanData) {
+	select {
+	case e.queue <- sd:
+	default:
+		log.Warnln("trace export queue full, drop span", sd.Name)
+	}
+}
+
+func (e *otlpExporter) run() {
+	ticker := time.NewTicker(traceFlushInterval)
+	defer ticker.Stop()
+	var batch []*trace.SpanData
+	for {
+		select {
+		case sd := <-e.queue:
+			batch = append(batch, sd)
+			if len(batch) < traceMaxBatch {
+				continue
+			}
+		case <-ticker.C:
+			if len(batch) == 0 {
+				continue
+			}
+		}
+		err := e.flush(batch)
+		if err != nil {
+			log.Warnln("export traces err:", err)
+		}
+		batch = nil
+	}
+}
+
+func (e *otlpExporter) flush(batch []*trace.SpanData) error {
+	data, err := json.Marshal(e.toOTLP(batch))
+	if err != nil {
+		return err
+	}
+	if e.file != nil {
+		_, err = e.file.Write(append(data, '\n'))
+		return err
+	}
+	resp, err := e.client.Post(e.url, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("collector returned %s", resp.Status)
+	}
+	return nil
+}
+
+// OTLP JSON encoding of ExportTraceServiceRequest, only the fields set by this exporter
+type otlpTraces struct {
+	ResourceSpans []*otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   *otlpResource     `json:"resource"`
+	ScopeSpans []*otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []*otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope *otlpScope  `json:"scope"`
+	Spans []*otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string          `json:"traceId"`
+	SpanID            string          `json:"spanId"`
+	ParentSpanID      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              int             `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []*otlpKeyValue `json:"attributes,omitempty"`
+	Status            *otlpStatus     `json:"status,omitempty"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string     `json:"key"`
+	Value *otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	IntValue    *string `json:"intValue,omitempty"`
+	BoolValue   *bool   `json:"boolValue,omitempty"`
+}
+
+func (e *otlpExporter) toOTLP(batch []*trace.SpanData) *otlpTraces {
+	spans := make([]*otlpSpan, 0, len(batch))
+	for _, sd := range batch {
+		spans = append(spans, toOTLPSpan(sd))
+	}
+	return &otlpTraces{
+		ResourceSpans: []*otlpResourceSpans{{
+			Resource: &otlpResource{
+				Attributes: []*otlpKeyValue{toOTLPKeyValue("service.name", e.service)},
+			},
+			ScopeSpans: []*otlpScopeSpans{{
+				Scope: &otlpScope{Name: "github.com/celer-network/goCeler"},
+				Spans: spans,
+			}},
+		}},
+	}
+}
+
+func toOTLPSpan(sd *trace.SpanData) *otlpSpan {
+	span := &otlpSpan{
+		TraceID:           hex.EncodeToString(sd.TraceID[:]),
+		SpanID:            hex.EncodeToString(sd.SpanID[:]),
+		Name:              sd.Name,
+		Kind:              sd.SpanKind + 1, // OTLP kind is opencensus kind plus one
+		StartTimeUnixNano: strconv.FormatInt(sd.StartTime.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(sd.EndTime.UnixNano(), 10),
+	}
+	if sd.ParentSpanID != (trace.SpanID{}) {
+		span.ParentSpanID = hex.EncodeToString(sd.ParentSpanID[:])
+	}
+	if sd.Code != trace.StatusCodeOK {
+		span.Status = &otlpStatus{Code: otlpStatusError, Message: sd.Message}
+	}
+	for k, v := range sd.Attributes {
+		span.Attributes = append(span.Attributes, toOTLPKeyValue(k, v))
+	}
+	return span
+}
+
+func toOTLPKeyValue(key string, value interface{}) *otlpKeyValue {
+	v := &otlpValue{}
+	switch val := value.(type) {
+	case int64:
+		s := strconv.FormatInt(val, 10)
+		v.IntValue = &s
+	case bool:
+		v.BoolValue = &val
+	default:
+		s := fmt.Sprint(val)
+		v.StringValue = &s
+	}
+	return &otlpKeyValue{Key: key, Value: v}
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/celer-network/goCeler/entity"
 	"github.com/celer-network/goCeler/rpc"
 	"github.com/celer-network/goCeler/storage"
+	"github.com/celer-network/goCeler/utils"
 	"github.com/celer-network/goutils/eth"
 	"github.com/celer-network/goutils/eth/monitor"
 	"github.com/celer-network/goutils/log"
@@ -135,7 +136,7 @@ func (p *MigrateChannelProcessor) checkChannelMigration(peer ctype.Addr, cid cty
 		return fmt.Errorf("Fail to marshal migration info: %w", err)
 	}
 
-	sig, err := p.signer.SignEthMessage(migrationInfoBytes)
+	sig, err := utils.SignEthMessageOfKind(p.signer, intfs.SignKindMigrationInfo, migrationInfoBytes)
 	if err != nil {
 		return fmt.Errorf("Fail to sign migration info: %w", err)
 	}
@@ -237,7 +238,7 @@ func (p *MigrateChannelProcessor) ProcessMigrateChannelRequest(req *rpc.MigrateC
 	}
 
 	// after all checks, sign the migration info and prepare response
-	sig, err := p.signer.SignEthMessage(migrationInfoBytes)
+	sig, err := utils.SignEthMessageOfKind(p.signer, intfs.SignKindMigrationInfo, migrationInfoBytes)
 	if err != nil {
 		log.Errorln("Fail to sign migration info:", err)
 		return nil, err
@@ -21,7 +21,7 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
-// Next tag: 19
+// Next tag: 21
 type PayMessageType int32
 
 const (
@@ -44,6 +44,8 @@ const (
 	PayMessageType_WITHDRAW_RESPONSE                PayMessageType = 16
 	PayMessageType_CONFIRM_BOOLEAN_PAY_API          PayMessageType = 17
 	PayMessageType_ROUTING_REQUEST                  PayMessageType = 18
+	PayMessageType_PAY_TRACE_REQUEST                PayMessageType = 19
+	PayMessageType_PAY_TRACE_RESPONSE               PayMessageType = 20
 )
 
 var PayMessageType_name = map[int32]string{
@@ -66,6 +68,8 @@ var PayMessageType_name = map[int32]string{
 	16: "WITHDRAW_RESPONSE",
 	17: "CONFIRM_BOOLEAN_PAY_API",
 	18: "ROUTING_REQUEST",
+	19: "PAY_TRACE_REQUEST",
+	20: "PAY_TRACE_RESPONSE",
 }
 
 var PayMessageType_value = map[string]int32{
@@ -88,6 +92,8 @@ var PayMessageType_value = map[string]int32{
 	"WITHDRAW_RESPONSE":                16,
 	"CONFIRM_BOOLEAN_PAY_API":          17,
 	"ROUTING_REQUEST":                  18,
+	"PAY_TRACE_REQUEST":                19,
+	"PAY_TRACE_RESPONSE":               20,
 }
 
 func (x PayMessageType) String() string {
@@ -132,7 +138,7 @@ func (CrossNetPayState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_bd0bc1b1d60b57a8, []int{1}
 }
 
-// Next tag: 30
+// Next tag: 31
 type PayEventMessage struct {
 	Type PayMessageType `protobuf:"varint,1,opt,name=type,proto3,enum=pem.PayMessageType" json:"type,omitempty"`
 	// pay_id this message is about.
@@ -184,10 +190,12 @@ type PayEventMessage struct {
 	// pay routing path
 	PayPath string `protobuf:"bytes,28,opt,name=pay_path,json=payPath,proto3" json:"pay_path,omitempty"`
 	// cross net payment info
-	Xnet                 *CrossNetInfo `protobuf:"bytes,29,opt,name=xnet,proto3" json:"xnet,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Xnet *CrossNetInfo `protobuf:"bytes,29,opt,name=xnet,proto3" json:"xnet,omitempty"`
+	// opt-in pay trace ID
+	TraceId              string   `protobuf:"bytes,30,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *PayEventMessage) Reset()         { *m = PayEventMessage{} }
@@ -397,6 +405,13 @@ func (m *PayEventMessage) GetXnet() *CrossNetInfo {
 	return nil
 }
 
+func (m *PayEventMessage) GetTraceId() string {
+	if m != nil {
+		return m.TraceId
+	}
+	return ""
+}
+
 type SimplexSeqNums struct {
 	// sequence number sent in outgoing message.
 	Out uint64 `protobuf:"varint,1,opt,name=out,proto3" json:"out,omitempty"`
@@ -597,78 +612,79 @@ func init() {
 func init() { proto.RegisterFile("pem.proto", fileDescriptor_bd0bc1b1d60b57a8) }
 
 var fileDescriptor_bd0bc1b1d60b57a8 = []byte{
-	// 1160 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x56, 0xd9, 0x72, 0x1b, 0xb7,
-	0x12, 0x35, 0x17, 0x71, 0x69, 0x71, 0x19, 0x41, 0x1b, 0x2c, 0x2f, 0xc5, 0xf2, 0xd5, 0xb5, 0x59,
-	0xbe, 0x37, 0x52, 0x95, 0xf3, 0x9c, 0x07, 0x8a, 0x84, 0x6c, 0xc6, 0xf2, 0x90, 0xc6, 0x8c, 0xbc,
-	0xe4, 0x05, 0x19, 0xcd, 0x40, 0xd4, 0x94, 0x39, 0x8b, 0x01, 0x30, 0x16, 0xff, 0x22, 0x1f, 0x93,
-	0x7c, 0x55, 0x7e, 0x22, 0x05, 0x60, 0x48, 0x51, 0xae, 0xe4, 0x6d, 0xfa, 0x9c, 0x03, 0xf4, 0x69,
-	0xa0, 0x1b, 0x35, 0xd0, 0xcc, 0x79, 0x72, 0x92, 0x8b, 0x4c, 0x65, 0xa8, 0x92, 0xf3, 0xe4, 0xa8,
-	0x9d, 0x70, 0x29, 0x83, 0x19, 0xb7, 0xd8, 0xb3, 0xdf, 0xeb, 0xd0, 0x9d, 0x06, 0x4b, 0xf2, 0x1b,
-	0x4f, 0xd5, 0x3b, 0xcb, 0xa0, 0x17, 0x50, 0x55, 0xcb, 0x9c, 0xe3, 0x52, 0xaf, 0xd4, 0xef, 0xbc,
-	0xda, 0x3d, 0xd1, 0x3b, 0x4c, 0x83, 0x65, 0x41, 0xfb, 0xcb, 0x9c, 0x53, 0x23, 0x40, 0xfb, 0x50,
-	0xcb, 0x83, 0x25, 0x8b, 0x23, 0x5c, 0xee, 0x95, 0xfa, 0x4d, 0xba, 0x95, 0x07, 0xcb, 0x71, 0x84,
-	0xf6, 0x60, 0x4b, 0x65, 0x5f, 0x78, 0x8a, 0x2b, 0x16, 0x35, 0x01, 0x72, 0xa0, 0x22, 0x45, 0x88,
-	0xb7, 0x0c, 0xa6, 0x3f, 0x35, 0x12, 0x49, 0x85, 0x6b, 0x16, 0x89, 0xa4, 0x42, 0x0f, 0xa1, 0x91,
-	0xc8, 0x19, 0xbb, 0x16, 0x59, 0x82, 0xeb, 0x06, 0xae, 0x27, 0x72, 0x76, 0x2e, 0xb2, 0x04, 0x1d,
-	0x40, 0x4d, 0xf2, 0x50, 0x70, 0x85, 0x1b, 0x86, 0x28, 0x22, 0xf4, 0x13, 0xb4, 0x25, 0x57, 0x6a,
-	0xce, 0x99, 0xe0, 0x81, 0xcc, 0x52, 0xdc, 0x34, 0xae, 0xf1, 0x89, 0xc8, 0x43, 0xed, 0x3a, 0xe1,
-	0xa9, 0xf2, 0x8c, 0x80, 0x1a, 0x9e, 0xb6, 0xe4, 0x46, 0xa4, 0x4b, 0xd0, 0x19, 0x55, 0x86, 0xc1,
-	0x9a, 0x4d, 0xe4, 0xcc, 0xcf, 0x10, 0x86, 0x7a, 0x12, 0x84, 0x37, 0x71, 0xca, 0xf1, 0x76, 0xe1,
-	0xc3, 0x86, 0xda, 0xa2, 0xb6, 0xc7, 0xc2, 0x38, 0xc2, 0x2d, 0x4b, 0xe9, 0x78, 0x18, 0x47, 0x7a,
-	0x2f, 0x95, 0x19, 0xa2, 0xbd, 0x2a, 0x5c, 0xc3, 0x7b, 0xb0, 0xc5, 0x85, 0xc8, 0x04, 0xee, 0xf4,
-	0x2a, 0x1a, 0x35, 0x01, 0xea, 0x83, 0x23, 0x55, 0x20, 0x14, 0x53, 0x71, 0xc2, 0x99, 0x54, 0x41,
-	0x92, 0xe3, 0x6e, 0xaf, 0xd4, 0xaf, 0xd0, 0x8e, 0xc1, 0xfd, 0x38, 0xe1, 0x9e, 0x46, 0xd1, 0x31,
-	0x74, 0x78, 0x1a, 0x6d, 0xea, 0x1c, 0xa3, 0x6b, 0xf1, 0x34, 0xba, 0x53, 0xbd, 0x84, 0x1d, 0x7e,
-	0xcb, 0xc3, 0x85, 0x8a, 0xb3, 0xd4, 0x6a, 0x13, 0x89, 0x77, 0x7a, 0xa5, 0x7e, 0x99, 0x76, 0xd7,
-	0x84, 0x96, 0xbf, 0x93, 0xe8, 0x10, 0xea, 0xf6, 0xde, 0x24, 0xde, 0x33, 0x9e, 0x6a, 0xe6, 0xe2,
-	0x24, 0xea, 0x41, 0x2b, 0x0d, 0xc2, 0x2f, 0x6c, 0xc5, 0xee, 0x1b, 0x16, 0x34, 0x36, 0xb5, 0x8a,
-	0x53, 0xd8, 0xbd, 0xce, 0xc4, 0xb7, 0x40, 0x44, 0x71, 0x3a, 0x63, 0xfc, 0x56, 0x71, 0x91, 0x06,
-	0x73, 0x7c, 0xd0, 0x2b, 0xf5, 0x1b, 0x14, 0xdd, 0x51, 0xa4, 0x60, 0xd0, 0x09, 0x34, 0x24, 0xff,
-	0xca, 0xd2, 0x45, 0x22, 0xf1, 0x61, 0xaf, 0xd4, 0xdf, 0x2e, 0x1a, 0xca, 0x8b, 0x93, 0x7c, 0xce,
-	0x6f, 0x3d, 0xfe, 0xd5, 0x5d, 0x24, 0x92, 0xd6, 0xa5, 0xfd, 0x40, 0x4f, 0xa1, 0xaa, 0xd3, 0x61,
-	0x6c, 0xb4, 0x60, 0xae, 0x91, 0xe8, 0x13, 0xa3, 0x06, 0x47, 0xff, 0x87, 0x9a, 0xe0, 0x92, 0xa7,
-	0x11, 0x7e, 0xd8, 0xab, 0xf4, 0xb7, 0x5f, 0xed, 0xad, 0xda, 0x73, 0xb3, 0x85, 0x69, 0xa1, 0x41,
-	0x4f, 0x00, 0xa2, 0x58, 0xf0, 0x50, 0xe9, 0x92, 0xf0, 0x91, 0x71, 0xd9, 0xb4, 0xc8, 0x34, 0x58,
-	0xa2, 0xf7, 0x70, 0x10, 0xf1, 0x39, 0x9f, 0x05, 0xe6, 0xd4, 0x22, 0x2e, 0x43, 0x11, 0xe7, 0xfa,
-	0x1b, 0x3f, 0x32, 0xe9, 0x8f, 0x4c, 0xfa, 0xd1, 0x5a, 0x32, 0xba, 0x53, 0xd0, 0xfd, 0xe8, 0x9f,
-	0x60, 0xdd, 0x1f, 0xfa, 0xf4, 0xf2, 0x40, 0xdd, 0xe0, 0xc7, 0xb6, 0x3f, 0xf2, 0x60, 0x39, 0x0d,
-	0xd4, 0x0d, 0xfa, 0x2f, 0x54, 0x6f, 0x53, 0xae, 0xf0, 0x13, 0xb3, 0xf7, 0x8e, 0x31, 0x3e, 0x14,
-	0x99, 0x94, 0x2e, 0x57, 0xe3, 0xf4, 0x3a, 0xa3, 0x86, 0x7e, 0xf6, 0x67, 0x09, 0x3a, 0xf7, 0x4f,
-	0x47, 0x4f, 0x4a, 0xb6, 0x50, 0x66, 0x20, 0xab, 0x54, 0x7f, 0xea, 0x34, 0xd9, 0x42, 0xb1, 0xab,
-	0x40, 0x72, 0x33, 0x7c, 0x55, 0x5a, 0xcf, 0x16, 0xea, 0x2c, 0x90, 0x1c, 0x75, 0xa0, 0x1c, 0xdb,
-	0xd9, 0xab, 0xd2, 0x72, 0x9c, 0xea, 0xdb, 0x8e, 0x53, 0xab, 0xac, 0x1a, 0xb0, 0x16, 0xa7, 0x46,
-	0xa8, 0x47, 0x4a, 0x65, 0x82, 0x47, 0x66, 0x28, 0xab, 0xb4, 0x88, 0x74, 0x36, 0x7d, 0x03, 0x35,
-	0x9b, 0x4d, 0x1f, 0xfa, 0x7f, 0xa0, 0x3d, 0x0f, 0xa4, 0x62, 0x71, 0x7a, 0x3d, 0x8f, 0x67, 0x37,
-	0xca, 0x0c, 0x67, 0x95, 0xb6, 0x34, 0x38, 0x2e, 0xb0, 0x67, 0x7f, 0x94, 0xa1, 0xb5, 0x59, 0x0e,
-	0x7a, 0x0c, 0x20, 0x45, 0xc8, 0x52, 0xae, 0xf4, 0x13, 0x61, 0xcd, 0x37, 0xa4, 0x08, 0x35, 0x1f,
-	0x69, 0x36, 0x92, 0x6a, 0xc5, 0xda, 0x1a, 0x1a, 0x91, 0x54, 0x96, 0x7d, 0x0e, 0xdd, 0x4c, 0xc4,
-	0xb3, 0x38, 0x0d, 0xe6, 0x45, 0x37, 0x16, 0xaf, 0x49, 0x7b, 0x05, 0x9b, 0x86, 0x44, 0x4f, 0x61,
-	0xdb, 0x8c, 0x63, 0xa1, 0xa9, 0x1a, 0x4d, 0x53, 0x43, 0x96, 0x3f, 0x86, 0x8e, 0xca, 0xd8, 0x95,
-	0x88, 0xa3, 0x19, 0x67, 0x41, 0x14, 0x89, 0xe2, 0x01, 0x6a, 0xa9, 0xec, 0xcc, 0x80, 0x83, 0x28,
-	0x12, 0xe8, 0x05, 0x38, 0x77, 0xaa, 0xc2, 0x91, 0x2d, 0xbf, 0xbd, 0xd2, 0x59, 0x5b, 0xc7, 0xd0,
-	0x31, 0xe9, 0x0a, 0x69, 0x1c, 0xad, 0x4e, 0x42, 0xa3, 0x56, 0x38, 0x8e, 0xd0, 0xff, 0x60, 0x4b,
-	0xaa, 0x40, 0x71, 0xf3, 0x54, 0x75, 0x5e, 0xed, 0xdf, 0xbb, 0xe9, 0x69, 0xb0, 0xf4, 0x34, 0x49,
-	0xad, 0xe6, 0xe5, 0x5f, 0x15, 0xe8, 0xdc, 0x7f, 0x5d, 0x11, 0x82, 0xce, 0xa5, 0x3b, 0x22, 0xe7,
-	0x63, 0x97, 0x8c, 0x98, 0xff, 0x79, 0x4a, 0x9c, 0x07, 0x68, 0x0f, 0x9c, 0xe1, 0xc4, 0x1d, 0xb1,
-	0xe9, 0xe0, 0x33, 0xa3, 0xe4, 0xfd, 0x25, 0xf1, 0x7c, 0xa7, 0x84, 0xf6, 0x61, 0x67, 0x03, 0xf5,
-	0xa6, 0x13, 0xd7, 0x23, 0x4e, 0xf9, 0x3b, 0xf1, 0x90, 0x8c, 0xa7, 0xbe, 0x53, 0x41, 0x3b, 0xd0,
-	0xa6, 0xe4, 0x03, 0x19, 0x5c, 0x30, 0x8f, 0x0c, 0x29, 0xf1, 0x9d, 0xaa, 0x5e, 0x7f, 0x0f, 0x62,
-	0x83, 0xe1, 0x5b, 0x67, 0x4b, 0xaf, 0xd7, 0x4b, 0x3d, 0xe2, 0xfb, 0x17, 0x84, 0x4d, 0xe9, 0x64,
-	0x72, 0xee, 0xd4, 0xd0, 0x01, 0xa0, 0x0d, 0x74, 0x65, 0xa2, 0x8e, 0x0e, 0x61, 0xf7, 0x1e, 0x5e,
-	0xd8, 0x68, 0xe8, 0x3a, 0x3c, 0xe2, 0x8e, 0x98, 0x3f, 0x79, 0x4b, 0x5c, 0x36, 0x98, 0x8e, 0x9d,
-	0x26, 0x7a, 0x0a, 0x47, 0x1e, 0x1d, 0xae, 0xc4, 0xe4, 0xd3, 0x74, 0x4c, 0x89, 0x35, 0xaa, 0x79,
-	0xd0, 0xfc, 0xc8, 0xf3, 0xff, 0x8d, 0xdf, 0x46, 0x8f, 0x01, 0x0f, 0x27, 0xee, 0xf9, 0x98, 0xbe,
-	0x63, 0x13, 0x97, 0x0d, 0xdf, 0x0c, 0xc6, 0xee, 0x9a, 0x6d, 0xa1, 0x23, 0x38, 0xa0, 0xe4, 0x67,
-	0x32, 0xf4, 0xd9, 0xd9, 0x64, 0x72, 0x41, 0x06, 0x77, 0x5c, 0x1b, 0x1d, 0x43, 0xaf, 0xd8, 0x75,
-	0xbd, 0x90, 0x12, 0x6f, 0x72, 0xf1, 0x61, 0x63, 0xff, 0x8e, 0x2e, 0xfd, 0xe3, 0xd8, 0x7f, 0x33,
-	0xa2, 0x83, 0x8f, 0xeb, 0x12, 0xbb, 0xfa, 0x9c, 0x36, 0xd0, 0xa2, 0x40, 0x07, 0x3d, 0x82, 0xc3,
-	0x95, 0x99, 0xef, 0xf3, 0xed, 0xa0, 0x5d, 0xe8, 0xd2, 0xc9, 0xa5, 0x3f, 0x76, 0x5f, 0xaf, 0x37,
-	0x42, 0x2f, 0x7f, 0x05, 0xe7, 0xfb, 0x46, 0x40, 0x6d, 0x68, 0x7e, 0x72, 0x89, 0xcf, 0xdc, 0xcb,
-	0x8b, 0x0b, 0xe7, 0x01, 0x6a, 0x41, 0xc3, 0x84, 0x1e, 0x1d, 0x3a, 0xa5, 0x75, 0x34, 0xf2, 0x7c,
-	0xa7, 0x8c, 0x1c, 0x68, 0x99, 0x68, 0xec, 0xbe, 0xa6, 0xc4, 0xf3, 0x9c, 0x0a, 0xea, 0xc2, 0xb6,
-	0x41, 0x88, 0x05, 0xaa, 0x67, 0xcf, 0x7f, 0x39, 0x9e, 0xc5, 0xea, 0x66, 0x71, 0x75, 0x12, 0x66,
-	0xc9, 0x69, 0xc8, 0xe7, 0x5c, 0xfc, 0x90, 0x72, 0xf5, 0x2d, 0x13, 0x5f, 0x4e, 0x67, 0xd9, 0x50,
-	0xc7, 0xa7, 0x39, 0x4f, 0xae, 0x6a, 0xe6, 0x07, 0xe0, 0xc7, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0x55, 0xaa, 0x09, 0xec, 0x21, 0x08, 0x00, 0x00,
+	// 1183 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x56, 0xd9, 0x72, 0xdb, 0x36,
+	0x14, 0x8d, 0x2c, 0x59, 0xcb, 0xb5, 0x16, 0x1a, 0xde, 0x10, 0x27, 0xf1, 0x68, 0x52, 0x37, 0xd1,
+	0xa4, 0xad, 0x3d, 0x93, 0x3e, 0xf7, 0x41, 0x96, 0xe0, 0x84, 0x8d, 0x43, 0x31, 0x20, 0x9d, 0xa5,
+	0x2f, 0x2c, 0x4d, 0xc2, 0x32, 0x27, 0xe2, 0x12, 0x00, 0x6a, 0xac, 0x5f, 0xe9, 0x2f, 0xf4, 0xb5,
+	0xfd, 0xbf, 0x0e, 0x00, 0x4a, 0x96, 0x33, 0xed, 0x1b, 0xef, 0x39, 0x07, 0x77, 0xc3, 0xbd, 0x18,
+	0x42, 0xab, 0x60, 0xe9, 0x49, 0xc1, 0x73, 0x99, 0xa3, 0x6a, 0xc1, 0xd2, 0xc3, 0x4e, 0xca, 0x84,
+	0x08, 0xa7, 0xcc, 0x60, 0x4f, 0xff, 0x6a, 0x40, 0xcf, 0x0d, 0x17, 0xe4, 0x0f, 0x96, 0xc9, 0xb7,
+	0x86, 0x41, 0xcf, 0xa1, 0x26, 0x17, 0x05, 0xc3, 0x95, 0x7e, 0x65, 0xd0, 0x7d, 0xb9, 0x73, 0xa2,
+	0x3c, 0xb8, 0xe1, 0xa2, 0xa4, 0xfd, 0x45, 0xc1, 0xa8, 0x16, 0xa0, 0x3d, 0xa8, 0x17, 0xe1, 0x22,
+	0x48, 0x62, 0xbc, 0xd1, 0xaf, 0x0c, 0x5a, 0x74, 0xb3, 0x08, 0x17, 0x76, 0x8c, 0x76, 0x61, 0x53,
+	0xe6, 0x9f, 0x59, 0x86, 0xab, 0x06, 0xd5, 0x06, 0xb2, 0xa0, 0x2a, 0x78, 0x84, 0x37, 0x35, 0xa6,
+	0x3e, 0x15, 0x12, 0x0b, 0x89, 0xeb, 0x06, 0x89, 0x85, 0x44, 0x0f, 0xa1, 0x99, 0x8a, 0x69, 0x70,
+	0xcd, 0xf3, 0x14, 0x37, 0x34, 0xdc, 0x48, 0xc5, 0xf4, 0x9c, 0xe7, 0x29, 0xda, 0x87, 0xba, 0x60,
+	0x11, 0x67, 0x12, 0x37, 0x35, 0x51, 0x5a, 0xe8, 0x17, 0xe8, 0x08, 0x26, 0xe5, 0x8c, 0x05, 0x9c,
+	0x85, 0x22, 0xcf, 0x70, 0x4b, 0x67, 0x8d, 0x4f, 0x78, 0x11, 0xa9, 0xac, 0x53, 0x96, 0x49, 0x4f,
+	0x0b, 0xa8, 0xe6, 0x69, 0x5b, 0xac, 0x59, 0xaa, 0x04, 0x15, 0x51, 0xe6, 0x18, 0x4c, 0xb2, 0xa9,
+	0x98, 0xfa, 0x39, 0xc2, 0xd0, 0x48, 0xc3, 0xe8, 0x26, 0xc9, 0x18, 0xde, 0x2a, 0xf3, 0x30, 0xa6,
+	0x4a, 0x51, 0xa5, 0x17, 0x44, 0x49, 0x8c, 0xdb, 0x86, 0x52, 0xf6, 0x28, 0x89, 0x95, 0x2f, 0x99,
+	0x6b, 0xa2, 0xb3, 0x2c, 0x5c, 0xc1, 0xbb, 0xb0, 0xc9, 0x38, 0xcf, 0x39, 0xee, 0xf6, 0xab, 0x0a,
+	0xd5, 0x06, 0x1a, 0x80, 0x25, 0x64, 0xc8, 0x65, 0x20, 0x93, 0x94, 0x05, 0x42, 0x86, 0x69, 0x81,
+	0x7b, 0xfd, 0xca, 0xa0, 0x4a, 0xbb, 0x1a, 0xf7, 0x93, 0x94, 0x79, 0x0a, 0x45, 0xc7, 0xd0, 0x65,
+	0x59, 0xbc, 0xae, 0xb3, 0xb4, 0xae, 0xcd, 0xb2, 0xf8, 0x4e, 0xf5, 0x02, 0xb6, 0xd9, 0x2d, 0x8b,
+	0xe6, 0x32, 0xc9, 0x33, 0xa3, 0x4d, 0x05, 0xde, 0xee, 0x57, 0x06, 0x1b, 0xb4, 0xb7, 0x22, 0x94,
+	0xfc, 0xad, 0x40, 0x07, 0xd0, 0x30, 0xf7, 0x26, 0xf0, 0xae, 0xce, 0xa9, 0xae, 0x2f, 0x4e, 0xa0,
+	0x3e, 0xb4, 0xb3, 0x30, 0xfa, 0x1c, 0x2c, 0xd9, 0x3d, 0xcd, 0x82, 0xc2, 0x5c, 0xa3, 0x38, 0x85,
+	0x9d, 0xeb, 0x9c, 0x7f, 0x0d, 0x79, 0x9c, 0x64, 0xd3, 0x80, 0xdd, 0x4a, 0xc6, 0xb3, 0x70, 0x86,
+	0xf7, 0xfb, 0x95, 0x41, 0x93, 0xa2, 0x3b, 0x8a, 0x94, 0x0c, 0x3a, 0x81, 0xa6, 0x60, 0x5f, 0x82,
+	0x6c, 0x9e, 0x0a, 0x7c, 0xd0, 0xaf, 0x0c, 0xb6, 0xca, 0x81, 0xf2, 0x92, 0xb4, 0x98, 0xb1, 0x5b,
+	0x8f, 0x7d, 0x71, 0xe6, 0xa9, 0xa0, 0x0d, 0x61, 0x3e, 0xd0, 0x11, 0xd4, 0x54, 0x38, 0x8c, 0xb5,
+	0x16, 0xf4, 0x35, 0x12, 0xd5, 0x31, 0xaa, 0x71, 0xf4, 0x23, 0xd4, 0x39, 0x13, 0x2c, 0x8b, 0xf1,
+	0xc3, 0x7e, 0x75, 0xb0, 0xf5, 0x72, 0x77, 0x39, 0x9e, 0xeb, 0x23, 0x4c, 0x4b, 0x0d, 0x7a, 0x02,
+	0x10, 0x27, 0x9c, 0x45, 0x52, 0x95, 0x84, 0x0f, 0x75, 0x96, 0x2d, 0x83, 0xb8, 0xe1, 0x02, 0xbd,
+	0x83, 0xfd, 0x98, 0xcd, 0xd8, 0x34, 0xd4, 0x5d, 0x8b, 0x99, 0x88, 0x78, 0x52, 0xa8, 0x6f, 0xfc,
+	0x48, 0x87, 0x3f, 0xd4, 0xe1, 0xc7, 0x2b, 0xc9, 0xf8, 0x4e, 0x41, 0xf7, 0xe2, 0xff, 0x82, 0xd5,
+	0x7c, 0xa8, 0xee, 0x15, 0xa1, 0xbc, 0xc1, 0x8f, 0xcd, 0x7c, 0x14, 0xe1, 0xc2, 0x0d, 0xe5, 0x0d,
+	0xfa, 0x1e, 0x6a, 0xb7, 0x19, 0x93, 0xf8, 0x89, 0xf6, 0xbd, 0xad, 0x13, 0x1f, 0xf1, 0x5c, 0x08,
+	0x87, 0x49, 0x3b, 0xbb, 0xce, 0xa9, 0xa6, 0x95, 0x07, 0xc9, 0xc3, 0x88, 0xa9, 0xbd, 0x3a, 0x32,
+	0x1e, 0xb4, 0x6d, 0xc7, 0x4f, 0xff, 0xa9, 0x40, 0xf7, 0x7e, 0xe3, 0xd4, 0x12, 0xe5, 0x73, 0xa9,
+	0x77, 0xb5, 0x46, 0xd5, 0xa7, 0x3a, 0x9f, 0xcf, 0x65, 0x70, 0x15, 0x0a, 0xa6, 0xf7, 0xb2, 0x46,
+	0x1b, 0xf9, 0x5c, 0x9e, 0x85, 0x82, 0xa1, 0x2e, 0x6c, 0x24, 0x66, 0x2d, 0x6b, 0x74, 0x23, 0xc9,
+	0xd4, 0x20, 0x24, 0x99, 0x51, 0xd6, 0x34, 0x58, 0x4f, 0x32, 0x2d, 0x54, 0xdb, 0x26, 0x73, 0xce,
+	0x62, 0xbd, 0xaf, 0x35, 0x5a, 0x5a, 0x2a, 0x9a, 0xba, 0x9c, 0xba, 0x89, 0xa6, 0xee, 0xe3, 0x3b,
+	0xe8, 0xcc, 0x42, 0x21, 0x83, 0x24, 0xbb, 0x9e, 0x25, 0xd3, 0x1b, 0xa9, 0xf7, 0xb6, 0x46, 0xdb,
+	0x0a, 0xb4, 0x4b, 0xec, 0xe9, 0xdf, 0x1b, 0xd0, 0x5e, 0xaf, 0x14, 0x3d, 0x06, 0x10, 0x3c, 0x0a,
+	0x32, 0x26, 0x55, 0x95, 0x26, 0xf9, 0xa6, 0xe0, 0x91, 0xe2, 0x63, 0xc5, 0xc6, 0x42, 0x2e, 0x59,
+	0x53, 0x43, 0x33, 0x16, 0xd2, 0xb0, 0xcf, 0xa0, 0x97, 0xf3, 0x64, 0x9a, 0x64, 0xe1, 0xac, 0x1c,
+	0xd4, 0xf2, 0xa1, 0xe9, 0x2c, 0x61, 0x3d, 0xab, 0xe8, 0x08, 0xb6, 0xf4, 0xa6, 0x96, 0x9a, 0x9a,
+	0xd6, 0xb4, 0x14, 0x64, 0xf8, 0x63, 0xe8, 0xca, 0x3c, 0xb8, 0xe2, 0x49, 0x3c, 0x65, 0x41, 0x18,
+	0xc7, 0xbc, 0x7c, 0x9b, 0xda, 0x32, 0x3f, 0xd3, 0xe0, 0x30, 0x8e, 0x39, 0x7a, 0x0e, 0xd6, 0x9d,
+	0xaa, 0xcc, 0xc8, 0x94, 0xdf, 0x59, 0xea, 0x4c, 0x5a, 0xc7, 0xd0, 0xd5, 0xe1, 0x4a, 0x69, 0x12,
+	0x2f, 0x3b, 0xa1, 0x50, 0x23, 0xb4, 0x63, 0xf4, 0x03, 0x6c, 0x0a, 0x19, 0x4a, 0xa6, 0x5f, 0xb1,
+	0xee, 0xcb, 0xbd, 0x7b, 0x43, 0xe0, 0x86, 0x0b, 0x4f, 0x91, 0xd4, 0x68, 0x5e, 0xfc, 0x59, 0x83,
+	0xee, 0xfd, 0x87, 0x17, 0x21, 0xe8, 0x5e, 0x3a, 0x63, 0x72, 0x6e, 0x3b, 0x64, 0x1c, 0xf8, 0x9f,
+	0x5c, 0x62, 0x3d, 0x40, 0xbb, 0x60, 0x8d, 0x26, 0xce, 0x38, 0x70, 0x87, 0x9f, 0x02, 0x4a, 0xde,
+	0x5d, 0x12, 0xcf, 0xb7, 0x2a, 0x68, 0x0f, 0xb6, 0xd7, 0x50, 0xcf, 0x9d, 0x38, 0x1e, 0xb1, 0x36,
+	0xbe, 0x11, 0x8f, 0x88, 0xed, 0xfa, 0x56, 0x15, 0x6d, 0x43, 0x87, 0x92, 0xf7, 0x64, 0x78, 0x11,
+	0x78, 0x64, 0x44, 0x89, 0x6f, 0xd5, 0xd4, 0xf9, 0x7b, 0x50, 0x30, 0x1c, 0xbd, 0xb1, 0x36, 0xd5,
+	0x79, 0x75, 0xd4, 0x23, 0xbe, 0x7f, 0x41, 0x02, 0x97, 0x4e, 0x26, 0xe7, 0x56, 0x1d, 0xed, 0x03,
+	0x5a, 0x43, 0x97, 0x49, 0x34, 0xd0, 0x01, 0xec, 0xdc, 0xc3, 0xcb, 0x34, 0x9a, 0xaa, 0x0e, 0x8f,
+	0x38, 0xe3, 0xc0, 0x9f, 0xbc, 0x21, 0x4e, 0x30, 0x74, 0x6d, 0xab, 0x85, 0x8e, 0xe0, 0xd0, 0xa3,
+	0xa3, 0xa5, 0x98, 0x7c, 0x74, 0x6d, 0x4a, 0x4c, 0xa2, 0x8a, 0x07, 0xc5, 0x8f, 0x3d, 0xff, 0xff,
+	0xf8, 0x2d, 0xf4, 0x18, 0xf0, 0x68, 0xe2, 0x9c, 0xdb, 0xf4, 0x6d, 0x30, 0x71, 0x82, 0xd1, 0xeb,
+	0xa1, 0xed, 0xac, 0xd8, 0x36, 0x3a, 0x84, 0x7d, 0x4a, 0x7e, 0x25, 0x23, 0x3f, 0x38, 0x9b, 0x4c,
+	0x2e, 0xc8, 0xf0, 0x8e, 0xeb, 0xa0, 0x63, 0xe8, 0x97, 0x5e, 0x57, 0x07, 0x29, 0xf1, 0x26, 0x17,
+	0xef, 0xd7, 0xfc, 0x77, 0x55, 0xe9, 0x1f, 0x6c, 0xff, 0xf5, 0x98, 0x0e, 0x3f, 0xac, 0x4a, 0xec,
+	0xa9, 0x3e, 0xad, 0xa1, 0x65, 0x81, 0x16, 0x7a, 0x04, 0x07, 0xcb, 0x64, 0xbe, 0x8d, 0xb7, 0x8d,
+	0x76, 0xa0, 0x47, 0x27, 0x97, 0xbe, 0xed, 0xbc, 0x5a, 0x39, 0x42, 0xca, 0x91, 0x52, 0xf8, 0x74,
+	0x38, 0xba, 0x6b, 0xe1, 0xce, 0xb2, 0xb5, 0x4b, 0xb8, 0x0c, 0xb0, 0xfb, 0xe2, 0x77, 0xb0, 0xbe,
+	0x9d, 0x1b, 0xd4, 0x81, 0xd6, 0x47, 0x87, 0xf8, 0x81, 0x73, 0x79, 0x71, 0x61, 0x3d, 0x40, 0x6d,
+	0x68, 0x6a, 0xd3, 0xa3, 0x23, 0xab, 0xb2, 0xb2, 0xc6, 0x9e, 0x6f, 0x6d, 0x20, 0x0b, 0xda, 0xda,
+	0xb2, 0x9d, 0x57, 0x94, 0x78, 0x9e, 0x55, 0x45, 0x3d, 0xd8, 0xd2, 0x08, 0x31, 0x40, 0xed, 0xec,
+	0xd9, 0x6f, 0xc7, 0xd3, 0x44, 0xde, 0xcc, 0xaf, 0x4e, 0xa2, 0x3c, 0x3d, 0x8d, 0xd8, 0x8c, 0xf1,
+	0x9f, 0x32, 0x26, 0xbf, 0xe6, 0xfc, 0xf3, 0xe9, 0x34, 0x1f, 0x29, 0xfb, 0xb4, 0x60, 0xe9, 0x55,
+	0x5d, 0xff, 0x4a, 0xfc, 0xfc, 0xef, 0x00, 0x8b, 0x6c, 0xd5, 0x9c, 0x6b, 0x08, 0x00, 0x00,
 }
@@ -0,0 +1,186 @@
+// Copyright 2020 Celer Network
+//
+// Token bucket rate limiters. A Limiter guards a single caller, a KeyedLimiter
+// keeps one bucket per (key, kind) pair, eg. per peer address and message type,
+// with limits looked up on every call so they can be changed at runtime. The
+// number of buckets is capped, the least recently used ones are evicted first.
+
+package ratelimit
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+const (
+	// idle buckets are swept once the number of buckets reaches this, and the
+	// least recently used ones are evicted if there are still too many
+	defaultMaxBuckets = 10000
+	pruneInterval     = time.Minute
+)
+
+// Limiter is a token bucket refilled at rate tokens per second up to burst
+type Limiter struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	lock   sync.Mutex
+}
+
+// NewLimiter returns a full token bucket. Returns nil (no limit) if rate is not positive.
+func NewLimiter(rate float64, burst int) *Limiter {
+	if rate <= 0 {
+		return nil
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return &Limiter{rate: rate, burst: float64(burst), tokens: float64(burst), last: time.Now()}
+}
+
+// Allow takes a token if available, nil limiter always allows
+func (l *Limiter) Allow() bool {
+	if l == nil {
+		return true
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.refill(time.Now())
+	if l.tokens < 1 {
+		return false
+	}
+	l.tokens--
+	return true
+}
+
+// SetLimit updates rate and burst, tokens already in the bucket are kept up to the new burst
+func (l *Limiter) SetLimit(rate float64, burst int) {
+	if burst < 1 {
+		burst = 1
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.refill(time.Now())
+	l.rate = rate
+	l.burst = float64(burst)
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+}
+
+// idle returns true if the bucket is full at now, ie. dropping it changes nothing
+func (l *Limiter) idle(now time.Time) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.refill(now)
+	return l.tokens >= l.burst
+}
+
+// refill must be called with lock held
+func (l *Limiter) refill(now time.Time) {
+	if now.After(l.last) {
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		l.last = now
+	}
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+}
+
+// LimitFunc returns the rate (per second) and burst of a kind, rate 0 means no limit
+type LimitFunc func(kind string) (rate float64, burst int)
+
+type bucketKey struct {
+	key  string
+	kind string
+}
+
+type bucket struct {
+	key     bucketKey
+	limiter *Limiter
+}
+
+// KeyedLimiter keeps one token bucket per (key, kind), at most maxBuckets of them
+type KeyedLimiter struct {
+	getLimit   LimitFunc
+	maxBuckets int
+	buckets    map[bucketKey]*list.Element // element value is *bucket
+	lru        *list.List                  // front is the most recently used bucket
+	lastPrune  time.Time
+	lock       sync.Mutex
+}
+
+func NewKeyedLimiter(getLimit LimitFunc) *KeyedLimiter {
+	return &KeyedLimiter{
+		getLimit:   getLimit,
+		maxBuckets: defaultMaxBuckets,
+		buckets:    make(map[bucketKey]*list.Element),
+		lru:        list.New(),
+		lastPrune:  time.Now(),
+	}
+}
+
+// Allow takes a token from the bucket of (key, kind). Kinds without a limit are always allowed.
+func (k *KeyedLimiter) Allow(key, kind string) bool {
+	if k == nil {
+		return true
+	}
+	rate, burst := k.getLimit(kind)
+	bk := bucketKey{key: key, kind: kind}
+	k.lock.Lock()
+	e, ok := k.buckets[bk]
+	if rate <= 0 {
+		if ok {
+			k.remove(e)
+		}
+		k.lock.Unlock()
+		return true
+	}
+	var l *Limiter
+	if ok {
+		l = e.Value.(*bucket).limiter
+		l.SetLimit(rate, burst)
+		k.lru.MoveToFront(e)
+	} else {
+		k.prune()
+		for len(k.buckets) >= k.maxBuckets {
+			k.remove(k.lru.Back())
+		}
+		l = NewLimiter(rate, burst)
+		k.buckets[bk] = k.lru.PushFront(&bucket{key: bk, limiter: l})
+	}
+	k.lock.Unlock()
+	return l.Allow()
+}
+
+// Len returns the number of buckets being tracked
+func (k *KeyedLimiter) Len() int {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	return len(k.buckets)
+}
+
+// prune drops full buckets if there are too many, must be called with lock held
+func (k *KeyedLimiter) prune() {
+	if len(k.buckets) < k.maxBuckets {
+		return
+	}
+	now := time.Now()
+	if now.Sub(k.lastPrune) < pruneInterval {
+		return
+	}
+	k.lastPrune = now
+	for _, e := range k.buckets {
+		if e.Value.(*bucket).limiter.idle(now) {
+			k.remove(e)
+		}
+	}
+}
+
+// remove drops a bucket, must be called with lock held
+func (k *KeyedLimiter) remove(e *list.Element) {
+	delete(k.buckets, e.Value.(*bucket).key)
+	k.lru.Remove(e)
+}
@@ -0,0 +1,118 @@
+// Copyright 2020 Celer Network
+
+package ratelimit
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestLimiter(t *testing.T) {
+	var nilLimiter *Limiter
+	if !nilLimiter.Allow() {
+		t.Error("nil limiter should always allow")
+	}
+	if NewLimiter(0, 10) != nil {
+		t.Error("zero rate should return nil limiter")
+	}
+
+	l := NewLimiter(1, 3)
+	for i := 0; i < 3; i++ {
+		if !l.Allow() {
+			t.Errorf("burst request %d not allowed", i)
+		}
+	}
+	if l.Allow() {
+		t.Error("request over burst allowed")
+	}
+	// one token refilled after one second
+	l.last = l.last.Add(-time.Second)
+	if !l.Allow() {
+		t.Error("request after refill not allowed")
+	}
+	if l.Allow() {
+		t.Error("request over refill allowed")
+	}
+
+	l.SetLimit(1, 1)
+	l.last = l.last.Add(-10 * time.Second)
+	if !l.Allow() || l.Allow() {
+		t.Error("refill not capped by new burst")
+	}
+}
+
+func TestKeyedLimiter(t *testing.T) {
+	limits := map[string]int{"req": 2}
+	k := NewKeyedLimiter(func(kind string) (float64, int) {
+		burst, ok := limits[kind]
+		if !ok {
+			return 0, 0
+		}
+		return 1, burst
+	})
+
+	for i := 0; i < 2; i++ {
+		if !k.Allow("peer1", "req") {
+			t.Errorf("peer1 request %d not allowed", i)
+		}
+	}
+	if k.Allow("peer1", "req") {
+		t.Error("peer1 request over burst allowed")
+	}
+	if !k.Allow("peer2", "req") {
+		t.Error("peer2 limited by peer1 bucket")
+	}
+	for i := 0; i < 10; i++ {
+		if !k.Allow("peer1", "other") {
+			t.Error("kind without limit not allowed")
+		}
+	}
+	if k.Len() != 2 {
+		t.Errorf("tracking %d buckets, expect 2", k.Len())
+	}
+
+	// remove the limit at runtime
+	delete(limits, "req")
+	if !k.Allow("peer1", "req") {
+		t.Error("request not allowed after limit removed")
+	}
+	if k.Len() != 1 {
+		t.Errorf("tracking %d buckets, expect 1", k.Len())
+	}
+
+	// idle buckets are pruned when there are too many
+	limits["req"] = 1
+	k.maxBuckets = 5
+	for i := 0; i < 5; i++ {
+		k.Allow(fmt.Sprintf("peer-%d", i), "req")
+	}
+	for _, e := range k.buckets {
+		l := e.Value.(*bucket).limiter
+		l.last = l.last.Add(-time.Second)
+	}
+	k.lastPrune = k.lastPrune.Add(-pruneInterval)
+	k.Allow("peer-new", "req")
+	if k.Len() != 1 {
+		t.Errorf("tracking %d buckets after prune, expect 1", k.Len())
+	}
+
+	// busy buckets are evicted least recently used first, the bucket count never exceeds the cap
+	for i := 0; i < 4; i++ {
+		k.Allow(fmt.Sprintf("busy-%d", i), "req")
+	}
+	k.Allow("peer-new", "req")
+	for i := 0; i < 20; i++ {
+		k.Allow(fmt.Sprintf("flood-%d", i), "req")
+		if k.Len() > k.maxBuckets {
+			t.Fatalf("tracking %d buckets, over the cap %d", k.Len(), k.maxBuckets)
+		}
+	}
+	k.Allow("flood-19", "req")
+	if k.Len() != 5 || k.Allow("flood-19", "req") {
+		t.Errorf("wrong buckets after eviction: %d", k.Len())
+	}
+	if !k.Allow("peer-new", "req") {
+		t.Error("evicted bucket not recreated full")
+	}
+}
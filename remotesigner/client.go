@@ -0,0 +1,95 @@
+// Copyright 2020 Celer Network
+
+// Package remotesigner implements an eth.Signer backed by a signer daemon that
+// holds the node key, and the daemon side of the signer protocol.
+package remotesigner
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"time"
+
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/rpc"
+	"github.com/celer-network/goutils/eth"
+	"github.com/celer-network/goutils/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	dialTimeout = 10 * time.Second
+	signTimeout = 5 * time.Second
+)
+
+// Client implements eth.Signer by forwarding sign requests to a remote signer daemon
+type Client struct {
+	conn    *grpc.ClientConn
+	signer  rpc.RemoteSignerClient
+	address ctype.Addr
+}
+
+// NewClient connects to the signer daemon at target and fetches the signing address
+func NewClient(target string, tlsConfig *tls.Config) (*Client, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), dialTimeout)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, target,
+		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)), grpc.WithBlock())
+	if err != nil {
+		return nil, fmt.Errorf("dial remote signer %s err: %w", target, err)
+	}
+	c := &Client{
+		conn:   conn,
+		signer: rpc.NewRemoteSignerClient(conn),
+	}
+	res, err := c.signer.GetAddress(ctx, &rpc.GetAddressRequest{})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("get remote signer address err: %w", err)
+	}
+	c.address = ctype.Bytes2Addr(res.GetAddress())
+	log.Infoln("connected to remote signer", target, "address", ctype.Addr2Hex(c.address))
+	return c, nil
+}
+
+// Address returns the eth address of the remote signing key
+func (c *Client) Address() ctype.Addr {
+	return c.address
+}
+
+// SignEthMessage implements eth.Signer, the message kind is not declared so the daemon
+// denies it unless the unknown kind is allowed
+func (c *Client) SignEthMessage(data []byte) ([]byte, error) {
+	return c.SignEthMessageOfKind("", data)
+}
+
+// SignEthMessageOfKind implements intfs.KindSigner
+func (c *Client) SignEthMessageOfKind(kind string, data []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), signTimeout)
+	defer cancel()
+	res, err := c.signer.SignMessage(ctx, &rpc.SignMessageRequest{Msg: data, Kind: kind})
+	if err != nil {
+		return nil, fmt.Errorf("remote SignMessage err: %w", err)
+	}
+	// guard against a daemon signing with an unexpected key
+	if !eth.IsSignatureValid(c.address, data, res.GetSig()) {
+		return nil, fmt.Errorf("remote signer returned invalid %s signature", res.GetKind())
+	}
+	return res.GetSig(), nil
+}
+
+// SignEthTransaction implements eth.Signer
+func (c *Client) SignEthTransaction(rawTx []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), signTimeout)
+	defer cancel()
+	res, err := c.signer.SignTransaction(ctx, &rpc.SignTransactionRequest{RawTx: rawTx})
+	if err != nil {
+		return nil, fmt.Errorf("remote SignTransaction err: %w", err)
+	}
+	return res.GetSignedTx(), nil
+}
+
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,292 @@
+// Copyright 2020 Celer Network
+
+package remotesigner
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/celer-network/goCeler/app"
+	"github.com/celer-network/goCeler/common/intfs"
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/entity"
+	"github.com/celer-network/goCeler/route/ospreport"
+	"github.com/celer-network/goCeler/rpc"
+	ec "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/golang/protobuf/proto"
+)
+
+// Kinds of messages signed by a celer node, declared by the node in each sign request
+const (
+	KindSimplexState       = intfs.SignKindSimplexState
+	KindChannelInitializer = intfs.SignKindChannelInitializer
+	KindCondPay            = intfs.SignKindCondPay
+	KindPayHop             = intfs.SignKindPayHop
+	KindWithdrawInfo       = intfs.SignKindWithdrawInfo
+	KindMigrationInfo      = intfs.SignKindMigrationInfo
+	KindRoutingUpdate      = intfs.SignKindRoutingUpdate
+	KindOspInfo            = intfs.SignKindOspInfo
+	KindDelegation         = intfs.SignKindDelegation
+	KindAppState           = intfs.SignKindAppState
+	KindAuthTs             = intfs.SignKindAuthTs
+	KindSecret             = intfs.SignKindSecret
+	KindUnknown            = "unknown" // kind not declared by the node
+)
+
+const (
+	authTsLen = 8
+	secretLen = 32
+	addrLen   = 20
+	idLen     = 32
+)
+
+type msgClassifier struct {
+	kind  string
+	msg   func() proto.Message
+	valid func(proto.Message) bool
+}
+
+// A message of a proto kind must decode without unrecognized fields, re-encode to the
+// same bytes, and have well-formed ids and addresses.
+var classifiers = []*msgClassifier{
+	{KindSimplexState, func() proto.Message { return &entity.SimplexPaymentChannel{} },
+		func(m proto.Message) bool {
+			s := m.(*entity.SimplexPaymentChannel)
+			return len(s.ChannelId) == idLen && len(s.PeerFrom) == addrLen
+		}},
+	{KindChannelInitializer, func() proto.Message { return &entity.PaymentChannelInitializer{} },
+		func(m proto.Message) bool {
+			i := m.(*entity.PaymentChannelInitializer)
+			return i.InitDistribution.GetToken() != nil && len(i.InitDistribution.GetDistribution()) == 2 &&
+				i.OpenDeadline > 0
+		}},
+	{KindCondPay, func() proto.Message { return &entity.ConditionalPay{} },
+		func(m proto.Message) bool {
+			p := m.(*entity.ConditionalPay)
+			return p.PayTimestamp > 0 && len(p.Src) == addrLen && len(p.Dest) == addrLen &&
+				len(p.PayResolver) == addrLen && p.TransferFunc != nil
+		}},
+	{KindPayHop, func() proto.Message { return &rpc.PayHop{} },
+		func(m proto.Message) bool {
+			h := m.(*rpc.PayHop)
+			return len(h.PayId) == idLen && validOptAddr(h.PrevHopAddr) && validOptAddr(h.NextHopAddr) &&
+				len(h.PrevHopAddr)+len(h.NextHopAddr) > 0
+		}},
+	{KindWithdrawInfo, func() proto.Message { return &entity.CooperativeWithdrawInfo{} },
+		func(m proto.Message) bool {
+			w := m.(*entity.CooperativeWithdrawInfo)
+			return len(w.ChannelId) == idLen && w.Withdraw != nil && w.WithdrawDeadline > 0
+		}},
+	{KindMigrationInfo, func() proto.Message { return &entity.ChannelMigrationInfo{} },
+		func(m proto.Message) bool {
+			i := m.(*entity.ChannelMigrationInfo)
+			return len(i.ChannelId) == idLen && len(i.FromLedgerAddress) == addrLen &&
+				len(i.ToLedgerAddress) == addrLen && i.MigrationDeadline > 0
+		}},
+	{KindDelegation, func() proto.Message { return &rpc.DelegationDescription{} },
+		func(m proto.Message) bool {
+			d := m.(*rpc.DelegationDescription)
+			return len(d.Delegator) == addrLen && len(d.Delegatee) == addrLen
+		}},
+	{KindRoutingUpdate, func() proto.Message { return &rpc.RoutingUpdate{} },
+		func(m proto.Message) bool {
+			u := m.(*rpc.RoutingUpdate)
+			return u.Origin != "" && u.Ts > 0
+		}},
+	{KindOspInfo, func() proto.Message { return &ospreport.OspInfo{} },
+		func(m proto.Message) bool {
+			i := m.(*ospreport.OspInfo)
+			return i.EthAddr != "" && i.Timestamp > 0
+		}},
+	{KindAppState, func() proto.Message { return &app.AppState{} },
+		func(m proto.Message) bool {
+			s := m.(*app.AppState)
+			return s.SeqNum > 0 && s.Timeout > 0
+		}},
+}
+
+func validOptAddr(addr []byte) bool {
+	return len(addr) == 0 || len(addr) == addrLen
+}
+
+func (c *msgClassifier) match(data []byte) bool {
+	msg := c.msg()
+	if proto.Unmarshal(data, msg) != nil || hasUnrecognized(msg) {
+		return false
+	}
+	encoded, err := proto.Marshal(msg)
+	if err != nil || string(encoded) != string(data) {
+		return false
+	}
+	return c.valid(msg)
+}
+
+func knownKind(kind string) bool {
+	switch kind {
+	case KindUnknown, KindAuthTs, KindSecret:
+		return true
+	}
+	for _, c := range classifiers {
+		if c.kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckMessageKind returns an error if the message is not a well-formed message of the kind
+func CheckMessageKind(kind string, data []byte) error {
+	switch kind {
+	case KindUnknown:
+		return nil
+	case KindAuthTs:
+		// big-endian unix timestamp in seconds, the leading zero byte is not a valid proto tag
+		if len(data) != authTsLen || data[0] != 0 {
+			return fmt.Errorf("invalid %s message", kind)
+		}
+		return nil
+	case KindSecret:
+		if len(data) != secretLen {
+			return fmt.Errorf("invalid %s message", kind)
+		}
+		// a secret must not be mistaken for a signed state
+		for _, c := range classifiers {
+			if c.match(data) {
+				return fmt.Errorf("%s message is a %s", kind, c.kind)
+			}
+		}
+		return nil
+	}
+	for _, c := range classifiers {
+		if c.kind == kind {
+			if !c.match(data) {
+				return fmt.Errorf("invalid %s message", kind)
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown message kind %s", kind)
+}
+
+func hasUnrecognized(msg proto.Message) bool {
+	f := reflect.ValueOf(msg).Elem().FieldByName("XXX_unrecognized")
+	return f.IsValid() && f.Len() > 0
+}
+
+// Policy decides which sign requests the signer daemon accepts
+type Policy struct {
+	// message kinds allowed to be signed, empty allows all known kinds
+	AllowedKinds []string `json:"allowedKinds"`
+	// transaction recipients allowed, empty allows any recipient
+	AllowedTxTo []string `json:"allowedTxTo"`
+	// max transaction value in wei, empty means no limit
+	MaxTxValue string `json:"maxTxValue"`
+	// whether contract creation transactions are allowed
+	AllowDeploy bool `json:"allowDeploy"`
+
+	kinds    map[string]bool
+	txTo     map[ctype.Addr]bool
+	maxTxWei *big.Int
+}
+
+// LoadPolicy loads the policy from a json file, or returns the default policy
+// if path is empty
+func LoadPolicy(path string) (*Policy, error) {
+	p := &Policy{}
+	if path != "" {
+		raw, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(raw, p)
+		if err != nil {
+			return nil, fmt.Errorf("parse policy err: %w", err)
+		}
+	}
+	err := p.init()
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *Policy) init() error {
+	p.kinds = make(map[string]bool)
+	for _, kind := range p.AllowedKinds {
+		if !knownKind(kind) {
+			return fmt.Errorf("invalid message kind %s", kind)
+		}
+		p.kinds[kind] = true
+	}
+	if len(p.kinds) == 0 {
+		for _, c := range classifiers {
+			p.kinds[c.kind] = true
+		}
+		p.kinds[KindAuthTs] = true
+		p.kinds[KindSecret] = true
+	}
+	p.txTo = make(map[ctype.Addr]bool)
+	for _, to := range p.AllowedTxTo {
+		if !ec.IsHexAddress(to) {
+			return fmt.Errorf("invalid tx recipient %s", to)
+		}
+		p.txTo[ctype.Hex2Addr(to)] = true
+	}
+	if p.MaxTxValue != "" {
+		var ok bool
+		p.maxTxWei, ok = new(big.Int).SetString(p.MaxTxValue, 10)
+		if !ok {
+			return fmt.Errorf("invalid max tx value %s", p.MaxTxValue)
+		}
+	}
+	return nil
+}
+
+// CheckMessage returns the message kind and an error if the policy rejects the message
+// of the kind declared by the node, messages without a declared kind are of KindUnknown
+func (p *Policy) CheckMessage(kind string, data []byte) (string, error) {
+	if kind == "" {
+		kind = KindUnknown
+	}
+	if !p.kinds[kind] {
+		return kind, fmt.Errorf("message kind %s not allowed", kind)
+	}
+	return kind, CheckMessageKind(kind, data)
+}
+
+// CheckTransaction decodes the unsigned raw tx and returns an error if the policy rejects it
+func (p *Policy) CheckTransaction(rawTx []byte) (*types.Transaction, error) {
+	tx := new(types.Transaction)
+	err := rlp.DecodeBytes(rawTx, tx)
+	if err != nil {
+		return nil, fmt.Errorf("decode tx err: %w", err)
+	}
+	if tx.To() == nil {
+		if !p.AllowDeploy {
+			return tx, fmt.Errorf("contract creation not allowed")
+		}
+	} else if len(p.txTo) > 0 && !p.txTo[*tx.To()] {
+		return tx, fmt.Errorf("tx recipient %x not allowed", *tx.To())
+	}
+	if p.maxTxWei != nil && tx.Value().Cmp(p.maxTxWei) == 1 {
+		return tx, fmt.Errorf("tx value %s exceeds limit %s", tx.Value(), p.maxTxWei)
+	}
+	return tx, nil
+}
+
+func (p *Policy) String() string {
+	var kinds []string
+	for kind := range p.kinds {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	return fmt.Sprintf("kinds: [%s], txTo: %d addrs, maxTxValue: %s, allowDeploy: %t",
+		strings.Join(kinds, " "), len(p.txTo), p.MaxTxValue, p.AllowDeploy)
+}
@@ -0,0 +1,199 @@
+// Copyright 2020 Celer Network
+
+package remotesigner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/celer-network/goCeler/app"
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/entity"
+	"github.com/celer-network/goCeler/rpc"
+	"github.com/celer-network/goCeler/utils"
+	"github.com/celer-network/goutils/eth"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/golang/protobuf/proto"
+)
+
+var (
+	testCid   = bytes.Repeat([]byte{1}, 32)
+	testAddr1 = bytes.Repeat([]byte{2}, 20)
+	testAddr2 = bytes.Repeat([]byte{3}, 20)
+)
+
+func mustMarshal(t *testing.T, msg proto.Message) []byte {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestCheckMessageKind(t *testing.T) {
+	token := &entity.TokenInfo{TokenType: entity.TokenType_ETH}
+	cases := map[string][]byte{
+		KindSimplexState: mustMarshal(t, &entity.SimplexPaymentChannel{
+			ChannelId: testCid,
+			PeerFrom:  testAddr1,
+			SeqNum:    5,
+			TransferToPeer: &entity.TokenTransfer{
+				Token:    token,
+				Receiver: &entity.AccountAmtPair{Account: testAddr2, Amt: []byte{1}},
+			},
+			PendingPayIds: &entity.PayIdList{},
+		}),
+		KindChannelInitializer: mustMarshal(t, &entity.PaymentChannelInitializer{
+			InitDistribution: &entity.TokenDistribution{
+				Token: token,
+				Distribution: []*entity.AccountAmtPair{
+					{Account: testAddr1, Amt: []byte{1}},
+					{Account: testAddr2, Amt: []byte{2}},
+				},
+			},
+			OpenDeadline:   100,
+			DisputeTimeout: 10,
+		}),
+		KindCondPay: mustMarshal(t, &entity.ConditionalPay{
+			PayTimestamp: 1,
+			Src:          testAddr1,
+			Dest:         testAddr2,
+			TransferFunc: &entity.TransferFunction{},
+			PayResolver:  testAddr1,
+		}),
+		KindPayHop: mustMarshal(t, &rpc.PayHop{PayId: testCid, NextHopAddr: testAddr2}),
+		KindWithdrawInfo: mustMarshal(t, &entity.CooperativeWithdrawInfo{
+			ChannelId:        testCid,
+			SeqNum:           1,
+			Withdraw:         &entity.AccountAmtPair{Account: testAddr1, Amt: []byte{1}},
+			WithdrawDeadline: 100,
+		}),
+		KindMigrationInfo: mustMarshal(t, &entity.ChannelMigrationInfo{
+			ChannelId:         testCid,
+			FromLedgerAddress: testAddr1,
+			ToLedgerAddress:   testAddr2,
+			MigrationDeadline: 100,
+		}),
+		KindDelegation: mustMarshal(t, &rpc.DelegationDescription{
+			Delegator:         testAddr1,
+			Delegatee:         testAddr2,
+			ExpiresAfterBlock: 100,
+		}),
+		KindRoutingUpdate: mustMarshal(t, &rpc.RoutingUpdate{Origin: ctype.Bytes2Hex(testAddr1), Ts: 1}),
+		KindAppState:      mustMarshal(t, &app.AppState{Nonce: 1, SeqNum: 2, State: []byte{1}, Timeout: 10}),
+		KindAuthTs:        utils.Uint64ToBytes(1600000000),
+		KindSecret:        crypto.Keccak256([]byte("secret")),
+	}
+	for kind, data := range cases {
+		if err := CheckMessageKind(kind, data); err != nil {
+			t.Errorf("%s message err: %v", kind, err)
+		}
+		if err := CheckMessageKind(KindUnknown, data); err != nil {
+			t.Errorf("%s message as unknown err: %v", kind, err)
+		}
+		// proto kinds may share the wire format, but none can pass as a secret or auth ts
+		for _, other := range []string{KindSecret, KindAuthTs} {
+			if other != kind && CheckMessageKind(other, data) == nil {
+				t.Errorf("%s message accepted as %s", kind, other)
+			}
+		}
+	}
+	if CheckMessageKind(KindSimplexState, cases[KindSecret]) == nil {
+		t.Error("secret accepted as simplex state")
+	}
+	if CheckMessageKind("hello", []byte("hello")) == nil {
+		t.Error("undefined message kind accepted")
+	}
+}
+
+func TestPolicy(t *testing.T) {
+	p := &Policy{
+		AllowedKinds: []string{KindSimplexState},
+		AllowedTxTo:  []string{ctype.Bytes2Hex(testAddr1)},
+		MaxTxValue:   "100",
+	}
+	err := p.init()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = p.CheckMessage(KindAuthTs, utils.Uint64ToBytes(1600000000))
+	if err == nil {
+		t.Error("auth ts should not be allowed")
+	}
+	kind, err := p.CheckMessage("", []byte("hello"))
+	if err == nil || kind != KindUnknown {
+		t.Errorf("message without kind should be denied as unknown: %s %v", kind, err)
+	}
+	err = (&Policy{AllowedKinds: []string{"hello"}}).init()
+	if err == nil {
+		t.Error("undefined message kind allowed by policy")
+	}
+
+	checkTx := func(tx *types.Transaction, allowed bool) {
+		rawTx, err2 := rlp.EncodeToBytes(tx)
+		if err2 != nil {
+			t.Fatal(err2)
+		}
+		_, err2 = p.CheckTransaction(rawTx)
+		if allowed != (err2 == nil) {
+			t.Errorf("tx to %x value %s, expect allowed %t, err %v", tx.To(), tx.Value(), allowed, err2)
+		}
+	}
+	checkTx(types.NewTransaction(0, ctype.Bytes2Addr(testAddr1), big.NewInt(100), 21000, big.NewInt(1), nil), true)
+	checkTx(types.NewTransaction(0, ctype.Bytes2Addr(testAddr1), big.NewInt(101), 21000, big.NewInt(1), nil), false)
+	checkTx(types.NewTransaction(0, ctype.Bytes2Addr(testAddr2), big.NewInt(1), 21000, big.NewInt(1), nil), false)
+	checkTx(types.NewContractCreation(0, big.NewInt(0), 21000, big.NewInt(1), nil), false)
+}
+
+func TestServerAudit(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	signer, err := eth.NewSigner(ctype.Bytes2Hex(crypto.FromECDSA(key)), big.NewInt(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	policy, err := LoadPolicy("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var audit bytes.Buffer
+	s := NewServer(signer, addr, policy, &audit)
+
+	secret := crypto.Keccak256([]byte("secret"))
+	res, err := s.SignMessage(context.Background(), &rpc.SignMessageRequest{Msg: secret, Kind: KindSecret})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !eth.IsSignatureValid(addr, secret, res.GetSig()) {
+		t.Error("invalid signature")
+	}
+	_, err = s.SignMessage(context.Background(), &rpc.SignMessageRequest{Msg: secret})
+	if err == nil {
+		t.Error("message without kind should be denied")
+	}
+	_, err = s.SignMessage(context.Background(), &rpc.SignMessageRequest{Msg: secret, Kind: KindSimplexState})
+	if err == nil {
+		t.Error("message of the wrong kind should be denied")
+	}
+
+	dec := json.NewDecoder(&audit)
+	for _, expect := range []*AuditRecord{
+		{Method: "SignMessage", Kind: KindSecret, Allowed: true},
+		{Method: "SignMessage", Kind: KindUnknown, Allowed: false},
+		{Method: "SignMessage", Kind: KindSimplexState, Allowed: false},
+	} {
+		record := &AuditRecord{}
+		err = dec.Decode(record)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if record.Method != expect.Method || record.Kind != expect.Kind || record.Allowed != expect.Allowed {
+			t.Errorf("unexpected audit record %+v", record)
+		}
+	}
+}
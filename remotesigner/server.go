@@ -0,0 +1,137 @@
+// Copyright 2020 Celer Network
+
+package remotesigner
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/rpc"
+	"github.com/celer-network/goutils/eth"
+	"github.com/celer-network/goutils/log"
+	"github.com/ethereum/go-ethereum/crypto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Server implements rpc.RemoteSignerServer, it checks every request against
+// the policy and writes an audit record for it before returning.
+type Server struct {
+	signer  eth.Signer
+	address ctype.Addr
+	policy  *Policy
+	audit   io.Writer
+	lock    sync.Mutex // protects audit writes
+}
+
+// AuditRecord is written as one json line per sign request
+type AuditRecord struct {
+	Time    string `json:"time"`
+	Client  string `json:"client"`
+	Method  string `json:"method"`
+	Kind    string `json:"kind,omitempty"`
+	Hash    string `json:"hash"`
+	To      string `json:"to,omitempty"`
+	Value   string `json:"value,omitempty"`
+	Nonce   uint64 `json:"nonce,omitempty"`
+	Allowed bool   `json:"allowed"`
+	Error   string `json:"error,omitempty"`
+}
+
+func NewServer(signer eth.Signer, address ctype.Addr, policy *Policy, audit io.Writer) *Server {
+	return &Server{
+		signer:  signer,
+		address: address,
+		policy:  policy,
+		audit:   audit,
+	}
+}
+
+func (s *Server) GetAddress(ctx context.Context, in *rpc.GetAddressRequest) (*rpc.GetAddressResponse, error) {
+	return &rpc.GetAddressResponse{Address: s.address.Bytes()}, nil
+}
+
+func (s *Server) SignMessage(ctx context.Context, in *rpc.SignMessageRequest) (*rpc.SignMessageResponse, error) {
+	record := &AuditRecord{
+		Method: "SignMessage",
+		Hash:   ctype.Bytes2Hex(crypto.Keccak256(in.GetMsg())),
+	}
+	kind, err := s.policy.CheckMessage(in.GetKind(), in.GetMsg())
+	record.Kind = kind
+	if err != nil {
+		s.writeAudit(ctx, record, err)
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	sig, err := s.signer.SignEthMessage(in.GetMsg())
+	s.writeAudit(ctx, record, err)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &rpc.SignMessageResponse{Sig: sig, Kind: kind}, nil
+}
+
+func (s *Server) SignTransaction(
+	ctx context.Context, in *rpc.SignTransactionRequest) (*rpc.SignTransactionResponse, error) {
+	record := &AuditRecord{
+		Method: "SignTransaction",
+		Hash:   ctype.Bytes2Hex(crypto.Keccak256(in.GetRawTx())),
+	}
+	tx, err := s.policy.CheckTransaction(in.GetRawTx())
+	if tx != nil {
+		if tx.To() != nil {
+			record.To = ctype.Addr2Hex(*tx.To())
+		}
+		record.Value = tx.Value().String()
+		record.Nonce = tx.Nonce()
+	}
+	if err != nil {
+		s.writeAudit(ctx, record, err)
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	signedTx, err := s.signer.SignEthTransaction(in.GetRawTx())
+	s.writeAudit(ctx, record, err)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &rpc.SignTransactionResponse{SignedTx: signedTx}, nil
+}
+
+func (s *Server) writeAudit(ctx context.Context, record *AuditRecord, err error) {
+	record.Time = time.Now().UTC().Format(time.RFC3339Nano)
+	record.Client = clientName(ctx)
+	record.Allowed = err == nil
+	if err != nil {
+		record.Error = err.Error()
+		log.Warnf("%s from %s denied or failed: %s", record.Method, record.Client, err)
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		log.Errorln("marshal audit record err:", err)
+		return
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, err = s.audit.Write(append(data, '\n'))
+	if err != nil {
+		log.Errorln("write audit record err:", err)
+	}
+}
+
+// clientName returns the common name of the verified client cert
+func clientName(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return p.Addr.String()
+	}
+	return tlsInfo.State.VerifiedChains[0][0].Subject.CommonName + "@" + p.Addr.String()
+}
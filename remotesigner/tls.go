@@ -0,0 +1,41 @@
+// Copyright 2020 Celer Network
+
+package remotesigner
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+)
+
+// LoadTLSConfig returns the mutual TLS config for either side of the signer connection.
+// certFile and keyFile are the local cert and key, caFile is the CA that signs the peer cert.
+func LoadTLSConfig(certFile, keyFile, caFile string, isServer bool) (*tls.Config, error) {
+	if certFile == "" || keyFile == "" || caFile == "" {
+		return nil, fmt.Errorf("remote signer requires cert, key and ca files")
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load cert err: %w", err)
+	}
+	caPem, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("read ca err: %w", err)
+	}
+	cpool := x509.NewCertPool()
+	if !cpool.AppendCertsFromPEM(caPem) {
+		return nil, fmt.Errorf("no valid ca cert in %s", caFile)
+	}
+	cfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if isServer {
+		cfg.ClientCAs = cpool
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	} else {
+		cfg.RootCAs = cpool
+	}
+	return cfg, nil
+}
@@ -0,0 +1,295 @@
+// Copyright 2020 Celer Network
+
+// Package reorg detects chain reorgs that remove processed on-chain event logs,
+// and rolls back the local state derived from the removed logs.
+package reorg
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/celer-network/goCeler/common/intfs"
+	"github.com/celer-network/goCeler/common/structs"
+	"github.com/celer-network/goCeler/config"
+	"github.com/celer-network/goCeler/rtconfig"
+	"github.com/celer-network/goCeler/storage"
+	"github.com/celer-network/goutils/eth/monitor"
+	"github.com/celer-network/goutils/log"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+const queryTimeout = 10 * time.Second
+
+// RollbackFunc reverts the local state derived from an event log removed by a chain reorg
+type RollbackFunc func(eLog *types.Log) error
+
+// Tracker wraps the monitor service to apply the per-event confirmation depth in rtconfig,
+// and records the block hashes of processed logs of events that have a rollback function.
+// Recorded logs within the reorg tracking window are periodically checked against the chain.
+// Tracker implements intfs.MonitorService.
+type Tracker struct {
+	intfs.MonitorService
+	chainId   uint64 // chain id keying the recorded logs in storage, 0 for the primary chain
+	rpc       *ethrpc.Client
+	dal       *storage.DAL
+	rollbacks map[string]RollbackFunc // key: event name
+	watched   map[monitor.CallbackID]*WatchedEvent
+	lock      sync.RWMutex // protects rollbacks and watched
+}
+
+// WatchedEvent is an event monitored through the tracker, identified in the
+// log filter queries of the watch service by its contract address and topic
+type WatchedEvent struct {
+	Name          string // monitor event name, <contract addr>-<event name>
+	Addr          common.Address
+	Topic         common.Hash
+	BlockDelay    uint64 // zero if the monitor service default is used
+	CheckInterval uint64
+}
+
+func NewTracker(
+	chainId uint64, monitorService intfs.MonitorService, rpcClient *ethrpc.Client, dal *storage.DAL) *Tracker {
+	return &Tracker{
+		MonitorService: monitorService,
+		chainId:        chainId,
+		rpc:            rpcClient,
+		dal:            dal,
+		rollbacks:      make(map[string]RollbackFunc),
+		watched:        make(map[monitor.CallbackID]*WatchedEvent),
+	}
+}
+
+// RegisterRollback sets the rollback function of an event.
+// Only logs of events with a rollback function are tracked.
+func (t *Tracker) RegisterRollback(eventName string, rollback RollbackFunc) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.rollbacks[eventName] = rollback
+}
+
+func (t *Tracker) getRollback(eventName string) RollbackFunc {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.rollbacks[eventName]
+}
+
+// Monitor implements intfs.MonitorService
+func (t *Tracker) Monitor(
+	cfg *monitor.Config, callback func(monitor.CallbackID, types.Log)) (monitor.CallbackID, error) {
+	eventName := cfg.EventName
+	if cfg.BlockDelay == 0 {
+		if confirmBlocks := rtconfig.GetEventConfirmBlocks(eventName); confirmBlocks != 0 {
+			cfgCopy := *cfg
+			cfgCopy.BlockDelay = confirmBlocks
+			cfg = &cfgCopy
+		}
+	}
+	id, err := t.MonitorService.Monitor(cfg, func(id monitor.CallbackID, eLog types.Log) {
+		callback(id, eLog)
+		t.record(eventName, &eLog)
+	})
+	if err != nil {
+		return id, err
+	}
+	parsedABI, err := abi.JSON(strings.NewReader(cfg.Contract.GetABI()))
+	if err != nil {
+		// not reachable, the monitor service fails on the same abi
+		return id, err
+	}
+	addr := cfg.Contract.GetAddr()
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.watched[id] = &WatchedEvent{
+		Name:          monitor.NewEventStr(addr, eventName),
+		Addr:          addr,
+		Topic:         parsedABI.Events[eventName].ID,
+		BlockDelay:    cfg.BlockDelay,
+		CheckInterval: cfg.CheckInterval,
+	}
+	return id, nil
+}
+
+// RemoveEvent implements intfs.MonitorService
+func (t *Tracker) RemoveEvent(id monitor.CallbackID) {
+	t.MonitorService.RemoveEvent(id)
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	delete(t.watched, id)
+}
+
+// GetWatchedEvents returns the events currently monitored through the tracker
+func (t *Tracker) GetWatchedEvents() []*WatchedEvent {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	var events []*WatchedEvent
+	for _, e := range t.watched {
+		events = append(events, e)
+	}
+	return events
+}
+
+func (t *Tracker) record(eventName string, eLog *types.Log) {
+	if t.getRollback(eventName) == nil {
+		return
+	}
+	rawLog, err := json.Marshal(eLog)
+	if err != nil {
+		log.Errorln("marshal event log err:", err, eventName, eLog.TxHash.Hex())
+		return
+	}
+	err = t.dal.InsertEventLog(&structs.EventLogRecord{
+		BlkHash:  eLog.BlockHash.Hex(),
+		LogIndex: eLog.Index,
+		ChainId:  t.chainId,
+		BlkNum:   eLog.BlockNumber,
+		Event:    eventName,
+		TxHash:   eLog.TxHash.Hex(),
+		RawLog:   rawLog,
+	})
+	if err != nil {
+		log.Errorln("InsertEventLog err:", err, eventName, eLog.TxHash.Hex())
+	}
+}
+
+// Start checks the recorded logs for chain reorgs every block interval until quit is closed
+func (t *Tracker) Start(quit chan bool) {
+	ticker := time.NewTicker(time.Duration(config.BlockIntervalSec) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-quit:
+			return
+		case <-ticker.C:
+			t.check()
+		}
+	}
+}
+
+func (t *Tracker) check() {
+	current := t.GetCurrentBlockNumber().Uint64()
+	window := rtconfig.GetReorgTrackBlocks()
+	var since uint64
+	if current > window {
+		since = current - window
+	}
+	records, err := t.dal.GetEventLogsSince(t.chainId, since)
+	if err != nil {
+		log.Warnln("GetEventLogsSince err:", err)
+		return
+	}
+	blkHashes := make(map[uint64]string)
+	for _, record := range records {
+		blkHash, ok := blkHashes[record.BlkNum]
+		if !ok {
+			blkHash, err = t.getBlockHash(record.BlkNum)
+			if err != nil {
+				log.Warnln("get block hash err:", err, record.BlkNum)
+				continue
+			}
+			blkHashes[record.BlkNum] = blkHash
+		}
+		if blkHash != record.BlkHash {
+			t.handleReorgedLog(record)
+		}
+	}
+	err = t.dal.DeleteEventLogsBefore(t.chainId, since)
+	if err != nil {
+		log.Warnln("DeleteEventLogsBefore err:", err)
+	}
+}
+
+// handleReorgedLog checks whether the log was included again in another block,
+// and rolls back the state derived from it if not
+func (t *Tracker) handleReorgedLog(record *structs.EventLogRecord) {
+	eLog := new(types.Log)
+	err := json.Unmarshal(record.RawLog, eLog)
+	if err != nil {
+		log.Errorln("unmarshal event log err:", err, record.Event, record.TxHash)
+		return
+	}
+	receipt, err := t.getReceipt(record.TxHash)
+	if err != nil {
+		log.Warnln("get receipt err:", err, record.TxHash)
+		return
+	}
+	if receipt != nil {
+		for _, l := range receipt.Logs {
+			if isSameLog(l, eLog) {
+				log.Infof("%s event log of tx %s moved from block %d to %d by chain reorg",
+					record.Event, record.TxHash, record.BlkNum, l.BlockNumber)
+				err = t.dal.UpdateEventLogBlock(record.BlkHash, record.LogIndex, l.BlockHash.Hex(), l.Index, l.BlockNumber)
+				if err != nil {
+					log.Errorln("UpdateEventLogBlock err:", err, record.TxHash)
+				}
+				return
+			}
+		}
+	}
+
+	log.Warnf("%s event log of tx %s in block %d removed by chain reorg, rolling back",
+		record.Event, record.TxHash, record.BlkNum)
+	if rollback := t.getRollback(record.Event); rollback != nil {
+		err = rollback(eLog)
+		if err != nil {
+			// keep the record to retry in the next check
+			log.Errorf("rollback %s event log of tx %s err: %s", record.Event, record.TxHash, err)
+			return
+		}
+	}
+	err = t.dal.DeleteEventLog(record.BlkHash, record.LogIndex)
+	if err != nil {
+		log.Errorln("DeleteEventLog err:", err, record.TxHash)
+	}
+}
+
+func isSameLog(a, b *types.Log) bool {
+	if a.Address != b.Address || len(a.Topics) != len(b.Topics) || !bytes.Equal(a.Data, b.Data) {
+		return false
+	}
+	for i := range a.Topics {
+		if a.Topics[i] != b.Topics[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// getBlockHash returns the hash of the canonical block at blkNum, or empty string if the
+// block does not exist. Use the raw RPC call as the header type of the pinned go-ethereum
+// does not hash blocks with newer header fields (e.g., base fee) correctly.
+func (t *Tracker) getBlockHash(blkNum uint64) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), queryTimeout)
+	defer cancel()
+	var block *struct {
+		Hash common.Hash `json:"hash"`
+	}
+	err := t.rpc.CallContext(ctx, &block, "eth_getBlockByNumber", hexutil.EncodeUint64(blkNum), false)
+	if err != nil {
+		return "", err
+	}
+	if block == nil {
+		return "", nil
+	}
+	return block.Hash.Hex(), nil
+}
+
+type receipt struct {
+	Logs []*types.Log `json:"logs"`
+}
+
+// getReceipt returns the receipt of the tx, or nil if the tx is not mined
+func (t *Tracker) getReceipt(txHash string) (*receipt, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), queryTimeout)
+	defer cancel()
+	var r *receipt
+	err := t.rpc.CallContext(ctx, &r, "eth_getTransactionReceipt", common.HexToHash(txHash))
+	return r, err
+}
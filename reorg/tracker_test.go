@@ -0,0 +1,175 @@
+// Copyright 2020 Celer Network
+
+package reorg
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/celer-network/goCeler/chain/channel-eth-go/ledger"
+	"github.com/celer-network/goCeler/storage"
+	"github.com/celer-network/goutils/eth/monitor"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+type fakeMonitor struct {
+	callbacks map[string]func(monitor.CallbackID, types.Log)
+	nextID    monitor.CallbackID
+}
+
+func (m *fakeMonitor) GetCurrentBlockNumber() *big.Int { return big.NewInt(20) }
+
+func (m *fakeMonitor) RegisterDeadline(deadline monitor.Deadline) monitor.CallbackID { return 0 }
+
+func (m *fakeMonitor) Monitor(
+	cfg *monitor.Config, callback func(monitor.CallbackID, types.Log)) (monitor.CallbackID, error) {
+	m.callbacks[cfg.EventName] = callback
+	m.nextID++
+	return m.nextID, nil
+}
+
+func (m *fakeMonitor) RemoveDeadline(id monitor.CallbackID) {}
+
+func (m *fakeMonitor) RemoveEvent(id monitor.CallbackID) {}
+
+func (m *fakeMonitor) Close() {}
+
+type fakeContract struct{}
+
+func (c *fakeContract) GetAddr() common.Address { return common.Address{1} }
+
+func (c *fakeContract) GetABI() string { return ledger.CelerLedgerABI }
+
+type fakeEthService struct {
+	blocks   map[uint64]common.Hash
+	receipts map[common.Hash]*receipt
+}
+
+func (s *fakeEthService) GetBlockByNumber(blkNum hexutil.Uint64, fullTx bool) (map[string]interface{}, error) {
+	hash, ok := s.blocks[uint64(blkNum)]
+	if !ok {
+		return nil, nil
+	}
+	return map[string]interface{}{"hash": hash}, nil
+}
+
+func (s *fakeEthService) GetTransactionReceipt(txHash common.Hash) (*receipt, error) {
+	return s.receipts[txHash], nil
+}
+
+func newLog(blkNum uint64, blkHash, txHash byte, data byte) types.Log {
+	return types.Log{
+		Address:     common.Address{1},
+		Topics:      []common.Hash{{2}},
+		Data:        []byte{data},
+		BlockNumber: blkNum,
+		BlockHash:   common.Hash{blkHash},
+		TxHash:      common.Hash{txHash},
+	}
+}
+
+func TestTracker(t *testing.T) {
+	stFile := filepath.Join(os.TempDir(), "reorg_tracker_test.db")
+	os.Remove(stFile)
+	st, err := storage.NewKVStoreSQL("sqlite3", stFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(stFile)
+	defer st.Close()
+
+	kept := newLog(10, 0xa, 0x1, 1)
+	removed := newLog(11, 0xb, 0x2, 2)
+	moved := newLog(12, 0xc, 0x3, 3)
+	movedTo := newLog(13, 0xd, 0x3, 3)
+	untracked := newLog(11, 0xb, 0x4, 4)
+
+	eth := &fakeEthService{
+		blocks: map[uint64]common.Hash{
+			10: {0xa},
+			11: {0xe},
+			12: {0xf},
+			13: {0xd},
+		},
+		receipts: map[common.Hash]*receipt{
+			{0x3}: {Logs: []*types.Log{&movedTo}},
+			{0x4}: {Logs: []*types.Log{&untracked}},
+		},
+	}
+	server := ethrpc.NewServer()
+	err = server.RegisterName("eth", eth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mon := &fakeMonitor{callbacks: make(map[string]func(monitor.CallbackID, types.Log))}
+	tracker := NewTracker(0, mon, ethrpc.DialInProc(server), storage.NewDAL(st))
+	var rolledBack []*types.Log
+	tracker.RegisterRollback("Deposit", func(eLog *types.Log) error {
+		rolledBack = append(rolledBack, eLog)
+		return nil
+	})
+
+	processed := 0
+	var openChannelID monitor.CallbackID
+	for _, name := range []string{"Deposit", "OpenChannel"} {
+		id, err := tracker.Monitor(
+			&monitor.Config{EventName: name, Contract: &fakeContract{}}, func(monitor.CallbackID, types.Log) {
+				processed++
+			})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if name == "OpenChannel" {
+			openChannelID = id
+		}
+	}
+	if len(tracker.GetWatchedEvents()) != 2 {
+		t.Errorf("expect 2 watched events, got %d", len(tracker.GetWatchedEvents()))
+	}
+	tracker.RemoveEvent(openChannelID)
+	watched := tracker.GetWatchedEvents()
+	parsedABI, err := abi.JSON(strings.NewReader(ledger.CelerLedgerABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(watched) != 1 || watched[0].Name != monitor.NewEventStr(common.Address{1}, "Deposit") ||
+		watched[0].Addr != (common.Address{1}) || watched[0].Topic != parsedABI.Events["Deposit"].ID {
+		t.Errorf("wrong watched events %+v", watched)
+	}
+	for _, eLog := range []types.Log{kept, removed, moved} {
+		mon.callbacks["Deposit"](0, eLog)
+	}
+	mon.callbacks["OpenChannel"](0, untracked)
+	if processed != 4 {
+		t.Errorf("expect 4 processed logs, got %d", processed)
+	}
+
+	tracker.check()
+	if len(rolledBack) != 1 || rolledBack[0].TxHash != removed.TxHash {
+		t.Errorf("expect rollback of tx %x, got %v", removed.TxHash, rolledBack)
+	}
+	records, err := tracker.dal.GetEventLogsSince(0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("expect 2 tracked logs, got %d", len(records))
+	}
+	if records[0].BlkHash != kept.BlockHash.Hex() || records[1].BlkHash != movedTo.BlockHash.Hex() ||
+		records[1].BlkNum != movedTo.BlockNumber {
+		t.Errorf("wrong tracked logs %+v %+v", records[0], records[1])
+	}
+
+	// nothing changes on the next check
+	tracker.check()
+	if len(rolledBack) != 1 {
+		t.Errorf("unexpected rollback %v", rolledBack)
+	}
+}
@@ -33,7 +33,7 @@ type BcastSendCallback func(info *rpc.RoutingRequest, ospAddrs []string)
 // Controller configs to handle onchain router-related event
 type Controller struct {
 	nodeConfig        common.GlobalNodeConfig
-	transactor        *eth.Transactor
+	transactor        intfs.Transactor
 	monitorService    intfs.MonitorService
 	dal               *storage.DAL
 	signer            eth.Signer
@@ -71,7 +71,7 @@ const (
 // NewController creates a new process for router controller
 func NewController(
 	nodeConfig common.GlobalNodeConfig,
-	transactor *eth.Transactor,
+	transactor intfs.Transactor,
 	monitorService intfs.MonitorService,
 	dal *storage.DAL,
 	signer eth.Signer,
@@ -171,6 +171,32 @@ func (c *Controller) processRouterUpdatedEvent(e *rt.RouterRegistryRouterUpdated
 	}
 }
 
+// RollbackRouterUpdatedEvent restores the router of a RouterUpdated event that was removed
+// by a chain reorg to the state in the router registry
+func (c *Controller) RollbackRouterUpdatedEvent(eLog *types.Log) error {
+	e := &rt.RouterRegistryRouterUpdated{}
+	err := c.nodeConfig.GetRouterRegistryContract().ParseEvent(event.RouterUpdated, *eLog, e)
+	if err != nil {
+		return err
+	}
+	routerRegistryAddr := c.nodeConfig.GetRouterRegistryContract().GetAddr()
+	caller, err := rt.NewRouterRegistryCaller(routerRegistryAddr, c.transactor.ContractCaller())
+	if err != nil {
+		return err
+	}
+	blknum, err := caller.RouterInfo(&bind.CallOpts{}, e.RouterAddress)
+	if err != nil {
+		return err
+	}
+	log.Warnln("RouterUpdated reverted by chain reorg, router", ctype.Addr2Hex(e.RouterAddress), "registry block", blknum)
+	if blknum.Uint64() == 0 {
+		c.removeRouter(e.RouterAddress)
+	} else {
+		c.rtBuilder.markOsp(e.RouterAddress, blknum.Uint64())
+	}
+	return nil
+}
+
 // adds router node and record the block number
 func (c *Controller) addRouter(routerAddr ctype.Addr, blkNum uint64) {
 	c.rtBuilder.markOsp(routerAddr, blkNum)
@@ -321,7 +347,7 @@ func (c *Controller) bcastRouterInfo() {
 		log.Errorln("proto marshal signedUpdate err", err, update)
 		return
 	}
-	sig, err := c.signer.SignEthMessage(updateBytes)
+	sig, err := utils.SignEthMessageOfKind(c.signer, intfs.SignKindRoutingUpdate, updateBytes)
 
 	signedUpdate := &rpc.SignedRoutingUpdate{
 		Update: updateBytes,
@@ -342,15 +368,18 @@ func (c *Controller) gatherChannelInfo() []*rpc.ChannelRoutingInfo {
 	var channels []*rpc.ChannelRoutingInfo
 	blkNum := c.monitorService.GetCurrentBlockNumber().Uint64()
 	for _, neighbor := range c.rtBuilder.getAliveNeighbors() {
-		for _, cid := range neighbor.TokenCids {
+		for token, cid := range neighbor.TokenCids {
 			bal, err := ledgerview.GetBalance(c.dal, cid, c.nodeConfig.GetOnChainAddr(), blkNum)
 			if err != nil {
 				log.Error(err)
 				continue
 			}
+			baseFee, ratePpm := rtconfig.GetFeePolicy(ctype.Addr2Hex(token))
 			channel := &rpc.ChannelRoutingInfo{
-				Cid:     ctype.Cid2Hex(cid),
-				Balance: bal.MyFree.String(),
+				Cid:        ctype.Cid2Hex(cid),
+				Balance:    bal.MyFree.String(),
+				BaseFee:    baseFee.String(),
+				FeeRatePpm: ratePpm,
 			}
 			channels = append(channels, channel)
 		}
@@ -401,7 +430,15 @@ func (c *Controller) enqueueRouterInfo(update *rpc.RoutingUpdate, ttl uint64) bo
 				log.Errorln("invalid balance report", ch.GetBalance())
 				continue
 			}
-			c.rtBuilder.updateOspEdge(ctype.Hex2Cid(ch.GetCid()), balance, origin, timestamp)
+			fee := &ospFee{baseFee: big.NewInt(0), ratePpm: ch.GetFeeRatePpm()}
+			if ch.GetBaseFee() != "" {
+				fee.baseFee = utils.Wei2BigInt(ch.GetBaseFee())
+				if fee.baseFee == nil {
+					log.Errorln("invalid base fee report", ch.GetBaseFee())
+					continue
+				}
+			}
+			c.rtBuilder.updateOspEdge(ctype.Hex2Cid(ch.GetCid()), balance, fee, origin, timestamp)
 		}
 	}
 
@@ -535,7 +572,7 @@ func (c *Controller) reportOspInfoToExplorer() {
 		log.Errorln("proto marshal OSP report err:", err, c.explorerReport)
 		return
 	}
-	sig, err := c.signer.SignEthMessage(reportBytes)
+	sig, err := utils.SignEthMessageOfKind(c.signer, intfs.SignKindOspInfo, reportBytes)
 	if err != nil {
 		log.Error(err)
 		return
@@ -567,6 +604,22 @@ func (c *Controller) GetAllNeighbors() map[ctype.Addr]*NeighborInfo {
 	return c.rtBuilder.getAllNeighbors()
 }
 
+// FindRebalancePath returns the osps on a path from peer osp from to peer osp to that can relay amt
+// of token without going through myself, or nil if there is none within maxHops.
+func (c *Controller) FindRebalancePath(tokenAddr, from, to ctype.Addr, amt *big.Int, maxHops int) []ctype.Addr {
+	return c.rtBuilder.findRebalancePath(tokenAddr, from, to, amt, maxHops)
+}
+
+// GetRebalancePathFee returns the total relay fee charged by the osps on a rebalance path for a pay of amt
+func (c *Controller) GetRebalancePathFee(tokenAddr ctype.Addr, path []ctype.Addr, amt *big.Int) (*big.Int, error) {
+	return c.rtBuilder.getPathFee(tokenAddr, path, amt)
+}
+
+// GetRouteFee returns the total relay fee charged by the downstream osps for forwarding a pay of amt to dst
+func (c *Controller) GetRouteFee(tokenAddr, dst ctype.Addr, amt *big.Int) (*big.Int, error) {
+	return c.rtBuilder.getRouteFee(tokenAddr, dst, amt)
+}
+
 func now() time.Time {
 	return time.Now().UTC()
 }
@@ -8,9 +8,11 @@ import (
 	"sync"
 	"time"
 
+	"github.com/celer-network/goCeler/common"
 	"github.com/celer-network/goCeler/common/structs"
 	"github.com/celer-network/goCeler/config"
 	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/rtconfig"
 	"github.com/celer-network/goCeler/storage"
 	"github.com/celer-network/goCeler/utils"
 	"github.com/celer-network/goutils/log"
@@ -33,12 +35,22 @@ type NeighborInfo struct {
 	TokenCids map[ctype.Addr]ctype.CidType
 }
 
+// ospFee is the relay fee policy advertised by an osp on a channel
+type ospFee struct {
+	baseFee *big.Int
+	ratePpm uint64
+}
+
 type OspEdge struct {
 	edge *Edge
 	// balance reported by edge.P1
 	balance1 *big.Int
 	// balance reported by edge.P2
 	balance2 *big.Int
+	// fee charged by edge.P1 for forwarding pays to edge.P2
+	fee1 *ospFee
+	// fee charged by edge.P2 for forwarding pays to edge.P1
+	fee2 *ospFee
 	// latest report of P1 or P2
 	// since stream is bidirectional, we assume
 	// the edge is alive as long as one peer reports
@@ -189,7 +201,7 @@ func (b *routingTableBuilder) isMyEdge(e *Edge) (bool, ctype.Addr) {
 }
 
 func (b *routingTableBuilder) updateOspEdge(
-	cid ctype.CidType, balance *big.Int, peerFrom ctype.Addr, timestamp time.Time) {
+	cid ctype.CidType, balance *big.Int, fee *ospFee, peerFrom ctype.Addr, timestamp time.Time) {
 	b.graphLock.Lock()
 	defer b.graphLock.Unlock()
 	ospEdge, ok := b.ospEdges[cid]
@@ -210,8 +222,10 @@ func (b *routingTableBuilder) updateOspEdge(
 	if timestamp.After(ospEdge.updateTime) {
 		if peerFrom == ospEdge.edge.P1 {
 			ospEdge.balance1 = balance
+			ospEdge.fee1 = fee
 		} else if peerFrom == ospEdge.edge.P2 {
 			ospEdge.balance2 = balance
+			ospEdge.fee2 = fee
 		} else {
 			log.Warnf("OSP edge %x peer not match %x", cid, peerFrom)
 			return
@@ -401,15 +415,8 @@ func (b *routingTableBuilder) computeRoutes(tokenAddr ctype.Addr) (
 	b.graphLock.RLock()
 	defer b.graphLock.RUnlock()
 	// set of active osps
-	liveOspSet := make(map[ctype.Addr]bool)
 	now := now()
-	for ospAddr, osp := range b.osps {
-		// osp needs to be alive
-		if osp.UpdateTime.Add(config.RouterAliveTimeout).After(now) {
-			liveOspSet[ospAddr] = true
-		}
-	}
-	liveOspSet[b.myAddr] = true
+	liveOspSet := b.getLiveOspSet(now)
 
 	// client addr -> access osps
 	accessOsps := make(map[ctype.Addr]accessOspSet)
@@ -491,6 +498,179 @@ func (b *routingTableBuilder) computeRoutes(tokenAddr ctype.Addr) (
 	return accessOsps, nextHopCids, nextHopAddrs
 }
 
+// findRebalancePath finds the shortest path from one peer osp to another on the token without going
+// through myself, where each osp on the path has reported enough free balance to send amt to the next hop.
+func (b *routingTableBuilder) findRebalancePath(
+	tokenAddr, from, to ctype.Addr, amt *big.Int, maxHops int) []ctype.Addr {
+	b.graphLock.RLock()
+	defer b.graphLock.RUnlock()
+	now := now()
+	graph := NewGraph()
+	for _, edge := range b.edges[tokenAddr] {
+		if edge.P1 == b.myAddr || edge.P2 == b.myAddr {
+			continue
+		}
+		ospEdge := b.ospEdges[edge.Cid]
+		if ospEdge == nil || !ospEdge.updateTime.Add(config.RouterAliveTimeout).After(now) {
+			continue
+		}
+		p1Str := ctype.Addr2Hex(edge.P1)
+		p2Str := ctype.Addr2Hex(edge.P2)
+		if ospEdge.balance1 != nil && ospEdge.balance1.Cmp(amt) >= 0 {
+			graph.addEdge(p1Str, p2Str, 1)
+		}
+		if ospEdge.balance2 != nil && ospEdge.balance2.Cmp(amt) >= 0 {
+			graph.addEdge(p2Str, p1Str, 1)
+		}
+	}
+	_, paths := graph.dijkstra(ctype.Addr2Hex(from))
+	path := paths[ctype.Addr2Hex(to)]
+	if len(path) < 2 || len(path)-1 > maxHops {
+		return nil
+	}
+	log.Debugln("rebalance path:", printPath(path))
+	addrs := make([]ctype.Addr, 0, len(path))
+	for _, v := range path {
+		addrs = append(addrs, ctype.Hex2Addr(v))
+	}
+	return addrs
+}
+
+// getPathFee returns the total relay fee advertised by the osps on the path for forwarding a pay of amt,
+// where the last osp on the path forwards the pay to myself.
+func (b *routingTableBuilder) getPathFee(tokenAddr ctype.Addr, path []ctype.Addr, amt *big.Int) (*big.Int, error) {
+	b.graphLock.RLock()
+	defer b.graphLock.RUnlock()
+	total := new(big.Int)
+	for i, osp := range path {
+		next := b.myAddr
+		if i+1 < len(path) {
+			next = path[i+1]
+		}
+		fee := b.getOspFee(tokenAddr, osp, next)
+		if fee == nil {
+			return nil, fmt.Errorf("no fee info of osp %x to %x", osp, next)
+		}
+		total.Add(total, rtconfig.ComputeFee(fee.baseFee, fee.ratePpm, amt))
+	}
+	return total, nil
+}
+
+// getOspFee returns the fee advertised by osp for forwarding pays to next on the token, or nil if unknown
+func (b *routingTableBuilder) getOspFee(tokenAddr, osp, next ctype.Addr) *ospFee {
+	for cid, edge := range b.edges[tokenAddr] {
+		ospEdge := b.ospEdges[cid]
+		if ospEdge == nil {
+			continue
+		}
+		if edge.P1 == osp && edge.P2 == next {
+			return ospEdge.fee1
+		}
+		if edge.P2 == osp && edge.P1 == next {
+			return ospEdge.fee2
+		}
+	}
+	return nil
+}
+
+// getOspTokenFee returns the fee advertised by osp for forwarding pays on the token, or nil if unknown.
+// The fee policy of an osp is per token, so any of its osp edges on the token tells the fee.
+func (b *routingTableBuilder) getOspTokenFee(tokenAddr, osp ctype.Addr) *ospFee {
+	for cid, edge := range b.edges[tokenAddr] {
+		ospEdge := b.ospEdges[cid]
+		if ospEdge == nil {
+			continue
+		}
+		if edge.P1 == osp && ospEdge.fee1 != nil {
+			return ospEdge.fee1
+		}
+		if edge.P2 == osp && ospEdge.fee2 != nil {
+			return ospEdge.fee2
+		}
+	}
+	return nil
+}
+
+// getRouteFee returns the total relay fee charged by the downstream osps on the shortest osp path
+// from myself to dst for forwarding a pay of amt. dst is either an osp or a client served by access
+// osps. My own fee is not included.
+func (b *routingTableBuilder) getRouteFee(tokenAddr, dst ctype.Addr, amt *big.Int) (*big.Int, error) {
+	b.routeLock.RLock()
+	var accessOsps []ctype.Addr
+	for osp := range b.accessOsps[tokenAddr][dst] {
+		accessOsps = append(accessOsps, osp)
+	}
+	b.routeLock.RUnlock()
+
+	b.graphLock.RLock()
+	defer b.graphLock.RUnlock()
+	now := now()
+	liveOspSet := b.getLiveOspSet(now)
+	graph := NewGraph()
+	for _, edge := range b.edges[tokenAddr] {
+		if !liveOspSet[edge.P1] || !liveOspSet[edge.P2] {
+			continue
+		}
+		ospEdge := b.ospEdges[edge.Cid]
+		if ospEdge == nil || !ospEdge.updateTime.Add(config.RouterAliveTimeout).After(now) {
+			continue
+		}
+		p1Str := ctype.Addr2Hex(edge.P1)
+		p2Str := ctype.Addr2Hex(edge.P2)
+		graph.addEdge(p1Str, p2Str, 1)
+		graph.addEdge(p2Str, p1Str, 1)
+	}
+	_, paths := graph.dijkstra(ctype.Addr2Hex(b.myAddr))
+
+	var path []string
+	if b.osps[dst] != nil {
+		path = paths[ctype.Addr2Hex(dst)]
+	} else {
+		for _, osp := range accessOsps {
+			if osp == b.myAddr {
+				// dst is my own client
+				return new(big.Int), nil
+			}
+			p := paths[ctype.Addr2Hex(osp)]
+			if len(p) >= 2 && (path == nil || len(p) < len(path)) {
+				path = p
+			}
+		}
+	}
+	if len(path) < 2 {
+		return nil, common.ErrRouteNotFound
+	}
+	log.Debugln("fee path:", printPath(path))
+
+	total := new(big.Int)
+	for _, v := range path[1:] {
+		osp := ctype.Hex2Addr(v)
+		if osp == dst {
+			// no fee charged by the pay destination
+			break
+		}
+		fee := b.getOspTokenFee(tokenAddr, osp)
+		if fee == nil {
+			return nil, fmt.Errorf("no fee info of osp %x", osp)
+		}
+		total.Add(total, rtconfig.ComputeFee(fee.baseFee, fee.ratePpm, amt))
+	}
+	return total, nil
+}
+
+// getLiveOspSet returns the osps reported alive, including myself
+func (b *routingTableBuilder) getLiveOspSet(now time.Time) map[ctype.Addr]bool {
+	liveOspSet := make(map[ctype.Addr]bool)
+	for ospAddr, osp := range b.osps {
+		// osp needs to be alive
+		if osp.UpdateTime.Add(config.RouterAliveTimeout).After(now) {
+			liveOspSet[ospAddr] = true
+		}
+	}
+	liveOspSet[b.myAddr] = true
+	return liveOspSet
+}
+
 func (b *routingTableBuilder) updateRouteDB(
 	tokenAddr ctype.Addr, accessOsps map[ctype.Addr]accessOspSet,
 	nextHopCids map[ctype.Addr]ctype.CidType, nextHopAddrs map[ctype.Addr]ctype.Addr) {
@@ -0,0 +1,132 @@
+// Copyright 2020 Celer Network
+
+package route
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/celer-network/goCeler/ctype"
+)
+
+func TestFindRebalancePath(t *testing.T) {
+	me, a, b, c, d := ctype.Hex2Addr("0a"), ctype.Hex2Addr("0b"), ctype.Hex2Addr("0c"), ctype.Hex2Addr("0d"), ctype.Hex2Addr("0e")
+	token := ctype.EthTokenAddr
+	builder := &routingTableBuilder{
+		myAddr:   me,
+		edges:    make(map[ctype.Addr]edgeMap),
+		ospEdges: make(map[ctype.CidType]*OspEdge),
+	}
+	builder.edges[token] = make(edgeMap)
+	addEdge := func(cid string, p1, p2 ctype.Addr, bal1, bal2 int64) {
+		e := &Edge{P1: p1, P2: p2, Cid: ctype.Hex2Cid(cid), Token: token}
+		builder.edges[token][e.Cid] = e
+		builder.ospEdges[e.Cid] = &OspEdge{
+			edge: e, balance1: big.NewInt(bal1), balance2: big.NewInt(bal2), updateTime: now()}
+	}
+	addEdge("01", me, a, 100, 100)
+	addEdge("02", me, d, 100, 100)
+	addEdge("03", a, me, 100, 100)
+	addEdge("04", a, b, 100, 5)
+	addEdge("05", b, d, 100, 100)
+	addEdge("06", a, c, 50, 50)
+	addEdge("07", c, d, 50, 50)
+
+	path := builder.findRebalancePath(token, a, d, big.NewInt(80), 4)
+	if len(path) != 3 || path[0] != a || path[1] != b || path[2] != d {
+		t.Errorf("wrong path %v", path)
+	}
+	path = builder.findRebalancePath(token, a, d, big.NewInt(80), 1)
+	if path != nil {
+		t.Errorf("path exceeds max hops %v", path)
+	}
+	// b only has 5 free to send back to a
+	path = builder.findRebalancePath(token, d, a, big.NewInt(10), 4)
+	if len(path) != 3 || path[0] != d || path[1] != c || path[2] != a {
+		t.Errorf("wrong path %v", path)
+	}
+	path = builder.findRebalancePath(token, d, a, big.NewInt(60), 4)
+	if path != nil {
+		t.Errorf("path without enough balance %v", path)
+	}
+}
+
+func TestGetPathFee(t *testing.T) {
+	me, a, b := ctype.Hex2Addr("0a"), ctype.Hex2Addr("0b"), ctype.Hex2Addr("0c")
+	token := ctype.EthTokenAddr
+	builder := &routingTableBuilder{
+		myAddr:   me,
+		edges:    make(map[ctype.Addr]edgeMap),
+		ospEdges: make(map[ctype.CidType]*OspEdge),
+	}
+	builder.edges[token] = make(edgeMap)
+	addEdge := func(cid string, p1, p2 ctype.Addr, fee1, fee2 *ospFee) {
+		e := &Edge{P1: p1, P2: p2, Cid: ctype.Hex2Cid(cid), Token: token}
+		builder.edges[token][e.Cid] = e
+		builder.ospEdges[e.Cid] = &OspEdge{edge: e, fee1: fee1, fee2: fee2, updateTime: now()}
+	}
+	addEdge("01", a, b, &ospFee{baseFee: big.NewInt(10), ratePpm: 1000}, nil)
+	addEdge("02", me, b, nil, &ospFee{baseFee: big.NewInt(5), ratePpm: 0})
+
+	// a charges 10 + 1000 * 1000 / 1e6, b charges 5
+	fee, err := builder.getPathFee(token, []ctype.Addr{a, b}, big.NewInt(1000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fee.Int64() != 16 {
+		t.Errorf("wrong path fee %s", fee)
+	}
+	// b has not reported the fee of forwarding to a
+	_, err = builder.getPathFee(token, []ctype.Addr{b, a}, big.NewInt(1000))
+	if err == nil {
+		t.Error("path fee without fee info did not fail")
+	}
+}
+
+func TestGetRouteFee(t *testing.T) {
+	me, a, b, c := ctype.Hex2Addr("0a"), ctype.Hex2Addr("0b"), ctype.Hex2Addr("0c"), ctype.Hex2Addr("0d")
+	client, myClient := ctype.Hex2Addr("1a"), ctype.Hex2Addr("1b")
+	token := ctype.EthTokenAddr
+	builder := &routingTableBuilder{
+		myAddr:     me,
+		edges:      make(map[ctype.Addr]edgeMap),
+		ospEdges:   make(map[ctype.CidType]*OspEdge),
+		osps:       make(map[ctype.Addr]*OspInfo),
+		accessOsps: make(map[ctype.Addr]map[ctype.Addr]accessOspSet),
+	}
+	builder.edges[token] = make(edgeMap)
+	for _, osp := range []ctype.Addr{me, a, b, c} {
+		builder.osps[osp] = &OspInfo{UpdateTime: now()}
+	}
+	addEdge := func(cid string, p1, p2 ctype.Addr, fee1, fee2 *ospFee) {
+		e := &Edge{P1: p1, P2: p2, Cid: ctype.Hex2Cid(cid), Token: token}
+		builder.edges[token][e.Cid] = e
+		builder.ospEdges[e.Cid] = &OspEdge{edge: e, fee1: fee1, fee2: fee2, updateTime: now()}
+	}
+	// me---a---b   c
+	addEdge("01", me, a, &ospFee{baseFee: big.NewInt(1)}, &ospFee{baseFee: big.NewInt(10), ratePpm: 1000})
+	addEdge("02", a, b, &ospFee{baseFee: big.NewInt(10), ratePpm: 1000}, &ospFee{baseFee: big.NewInt(5)})
+	builder.accessOsps[token] = map[ctype.Addr]accessOspSet{
+		client:   {b: true},
+		myClient: {me: true},
+	}
+
+	// a charges 10 + 1000 * 1000 / 1e6, b forwards to its client and charges 5
+	fee, err := builder.getRouteFee(token, client, big.NewInt(1000))
+	if err != nil || fee.Int64() != 16 {
+		t.Errorf("wrong route fee to client: %s %v", fee, err)
+	}
+	// b is the pay destination and charges nothing
+	fee, err = builder.getRouteFee(token, b, big.NewInt(1000))
+	if err != nil || fee.Int64() != 11 {
+		t.Errorf("wrong route fee to osp: %s %v", fee, err)
+	}
+	fee, err = builder.getRouteFee(token, myClient, big.NewInt(1000))
+	if err != nil || fee.Sign() != 0 {
+		t.Errorf("wrong route fee to my client: %s %v", fee, err)
+	}
+	_, err = builder.getRouteFee(token, c, big.NewInt(1000))
+	if err == nil {
+		t.Error("route fee to unreachable osp did not fail")
+	}
+}
@@ -48,6 +48,12 @@ const (
 	ErrCode_PEER_NOT_ONLINE ErrCode = 9
 	// no specified error code
 	ErrCode_MISC_ERROR ErrCode = 10
+	// relay fee less than required by the forwarding osp
+	ErrCode_INSUFFICIENT_FEE ErrCode = 11
+	// too many requests from the peer, try again later
+	ErrCode_RATE_LIMITED ErrCode = 12
+	// node is draining before shutdown, try again later or through another osp
+	ErrCode_DRAINING ErrCode = 13
 )
 
 var ErrCode_name = map[int32]string{
@@ -62,6 +68,9 @@ var ErrCode_name = map[int32]string{
 	8:  "NOT_ENOUGH_BALANCE",
 	9:  "PEER_NOT_ONLINE",
 	10: "MISC_ERROR",
+	11: "INSUFFICIENT_FEE",
+	12: "RATE_LIMITED",
+	13: "DRAINING",
 }
 
 var ErrCode_value = map[string]int32{
@@ -76,6 +85,9 @@ var ErrCode_value = map[string]int32{
 	"NOT_ENOUGH_BALANCE": 8,
 	"PEER_NOT_ONLINE":    9,
 	"MISC_ERROR":         10,
+	"INSUFFICIENT_FEE":   11,
+	"RATE_LIMITED":       12,
+	"DRAINING":           13,
 }
 
 func (x ErrCode) String() string {
@@ -305,6 +317,8 @@ type CelerMsg struct {
 	//	*CelerMsg_WithdrawRequest
 	//	*CelerMsg_WithdrawResponse
 	//	*CelerMsg_RoutingRequest
+	//	*CelerMsg_PayTraceRequest
+	//	*CelerMsg_PayTraceResponse
 	//	*CelerMsg_CondPayReceipt
 	//	*CelerMsg_RevealSecret
 	//	*CelerMsg_RevealSecretAck
@@ -417,6 +431,14 @@ type CelerMsg_RoutingRequest struct {
 	RoutingRequest *RoutingRequest `protobuf:"bytes,16,opt,name=routing_request,json=routingRequest,proto3,oneof"`
 }
 
+type CelerMsg_PayTraceRequest struct {
+	PayTraceRequest *PayTraceRequest `protobuf:"bytes,17,opt,name=pay_trace_request,json=payTraceRequest,proto3,oneof"`
+}
+
+type CelerMsg_PayTraceResponse struct {
+	PayTraceResponse *PayTraceResponse `protobuf:"bytes,18,opt,name=pay_trace_response,json=payTraceResponse,proto3,oneof"`
+}
+
 type CelerMsg_CondPayReceipt struct {
 	CondPayReceipt *CondPayReceipt `protobuf:"bytes,31,opt,name=cond_pay_receipt,json=condPayReceipt,proto3,oneof"`
 }
@@ -459,6 +481,10 @@ func (*CelerMsg_WithdrawResponse) isCelerMsg_Message() {}
 
 func (*CelerMsg_RoutingRequest) isCelerMsg_Message() {}
 
+func (*CelerMsg_PayTraceRequest) isCelerMsg_Message() {}
+
+func (*CelerMsg_PayTraceResponse) isCelerMsg_Message() {}
+
 func (*CelerMsg_CondPayReceipt) isCelerMsg_Message() {}
 
 func (*CelerMsg_RevealSecret) isCelerMsg_Message() {}
@@ -553,6 +579,20 @@ func (m *CelerMsg) GetRoutingRequest() *RoutingRequest {
 	return nil
 }
 
+func (m *CelerMsg) GetPayTraceRequest() *PayTraceRequest {
+	if x, ok := m.GetMessage().(*CelerMsg_PayTraceRequest); ok {
+		return x.PayTraceRequest
+	}
+	return nil
+}
+
+func (m *CelerMsg) GetPayTraceResponse() *PayTraceResponse {
+	if x, ok := m.GetMessage().(*CelerMsg_PayTraceResponse); ok {
+		return x.PayTraceResponse
+	}
+	return nil
+}
+
 func (m *CelerMsg) GetCondPayReceipt() *CondPayReceipt {
 	if x, ok := m.GetMessage().(*CelerMsg_CondPayReceipt); ok {
 		return x.CondPayReceipt
@@ -602,6 +642,8 @@ func (*CelerMsg) XXX_OneofWrappers() []interface{} {
 		(*CelerMsg_WithdrawRequest)(nil),
 		(*CelerMsg_WithdrawResponse)(nil),
 		(*CelerMsg_RoutingRequest)(nil),
+		(*CelerMsg_PayTraceRequest)(nil),
+		(*CelerMsg_PayTraceResponse)(nil),
 		(*CelerMsg_CondPayReceipt)(nil),
 		(*CelerMsg_RevealSecret)(nil),
 		(*CelerMsg_RevealSecretAck)(nil),
@@ -1040,10 +1082,18 @@ type CondPayRequest struct {
 	// and the payment is unconditional.
 	DirectPay bool `protobuf:"varint,5,opt,name=direct_pay,json=directPay,proto3" json:"direct_pay,omitempty"`
 	// used for cross network payment
-	CrossNet             *CrossNetPay `protobuf:"bytes,6,opt,name=cross_net,json=crossNet,proto3" json:"cross_net,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	CrossNet *CrossNetPay `protobuf:"bytes,6,opt,name=cross_net,json=crossNet,proto3" json:"cross_net,omitempty"`
+	// opt-in trace ID set by pay src, each hop records its span if not empty
+	TraceId string `protobuf:"bytes,7,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	// optional source route, the remaining hops starting from the receiver of this request
+	Route [][]byte `protobuf:"bytes,8,rep,name=route,proto3" json:"route,omitempty"`
+	// relay fee locked with the pay in the total pending amount of the simplex state, covering the
+	// fees of the receiver and the downstream hops. The fee is transferred only when the pay is paid,
+	// and returned along with the pay if it is canceled, expired or rejected.
+	Fee                  []byte   `protobuf:"bytes,9,opt,name=fee,proto3" json:"fee,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CondPayRequest) Reset()         { *m = CondPayRequest{} }
@@ -1113,6 +1163,27 @@ func (m *CondPayRequest) GetCrossNet() *CrossNetPay {
 	return nil
 }
 
+func (m *CondPayRequest) GetTraceId() string {
+	if m != nil {
+		return m.TraceId
+	}
+	return ""
+}
+
+func (m *CondPayRequest) GetRoute() [][]byte {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
+func (m *CondPayRequest) GetFee() []byte {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
 // CondPayResponse is returning the signature of the other side in the channel.
 type CondPayResponse struct {
 	StateCosigned        *SignedSimplexState `protobuf:"bytes,1,opt,name=state_cosigned,json=stateCosigned,proto3" json:"state_cosigned,omitempty"`
@@ -1548,14 +1619,16 @@ func (m *PaymentSettleResponse) GetError() *Error {
 }
 
 // OpenChannelRequest when one wants to open a channel with peer.
-// Next Tag: 5
+// Next Tag: 6
 type OpenChannelRequest struct {
 	// serialized entity.PaymentChannelInitializer
 	ChannelInitializer []byte        `protobuf:"bytes,1,opt,name=channel_initializer,json=channelInitializer,proto3" json:"channel_initializer,omitempty"`
 	RequesterSig       []byte        `protobuf:"bytes,2,opt,name=requester_sig,json=requesterSig,proto3" json:"requester_sig,omitempty"`
 	OpenBy             OpenChannelBy `protobuf:"varint,3,opt,name=open_by,json=openBy,proto3,enum=rpc.OpenChannelBy" json:"open_by,omitempty"`
 	// osp_to_osp set to true to indicate the channel is an OSP-OSP channel.
-	OspToOsp             bool     `protobuf:"varint,4,opt,name=osp_to_osp,json=ospToOsp,proto3" json:"osp_to_osp,omitempty"`
+	OspToOsp bool `protobuf:"varint,4,opt,name=osp_to_osp,json=ospToOsp,proto3" json:"osp_to_osp,omitempty"`
+	// chain_id is the chain of the channel ledger. 0 selects the primary chain of the OSP.
+	ChainId              uint64   `protobuf:"varint,5,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1614,6 +1687,13 @@ func (m *OpenChannelRequest) GetOspToOsp() bool {
 	return false
 }
 
+func (m *OpenChannelRequest) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
 // Next Tag: 6
 type OpenChannelResponse struct {
 	// serialized entity.PaymentChannelInitializer
@@ -1800,7 +1880,9 @@ type CondPayReceipt struct {
 	PayDelegatorSig []byte           `protobuf:"bytes,3,opt,name=pay_delegator_sig,json=payDelegatorSig,proto3" json:"pay_delegator_sig,omitempty"`
 	DelegationProof *DelegationProof `protobuf:"bytes,4,opt,name=delegation_proof,json=delegationProof,proto3" json:"delegation_proof,omitempty"`
 	// used for cross net pay
-	OriginalPayId        []byte   `protobuf:"bytes,5,opt,name=original_pay_id,json=originalPayId,proto3" json:"original_pay_id,omitempty"`
+	OriginalPayId []byte `protobuf:"bytes,5,opt,name=original_pay_id,json=originalPayId,proto3" json:"original_pay_id,omitempty"`
+	// trace ID copied from the cond pay request
+	TraceId              string   `protobuf:"bytes,6,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1866,6 +1948,13 @@ func (m *CondPayReceipt) GetOriginalPayId() []byte {
 	return nil
 }
 
+func (m *CondPayReceipt) GetTraceId() string {
+	if m != nil {
+		return m.TraceId
+	}
+	return ""
+}
+
 // Next Tag: 4
 type SignedSimplexState struct {
 	// serialized simplexPaymentChannel message
@@ -2885,12 +2974,116 @@ func (m *GetPayHistoryResponse) GetPays() []*OneHistoricalPay {
 	return nil
 }
 
-// Next tag: 3
+// Next tag: 4
+type GetPayFeeRequest struct {
+	// token address of the pay
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// pay destination
+	Dst string `protobuf:"bytes,2,opt,name=dst,proto3" json:"dst,omitempty"`
+	// pay amount in wei
+	Amt                  string   `protobuf:"bytes,3,opt,name=amt,proto3" json:"amt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPayFeeRequest) Reset()         { *m = GetPayFeeRequest{} }
+func (m *GetPayFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPayFeeRequest) ProtoMessage()    {}
+func (*GetPayFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{40}
+}
+
+func (m *GetPayFeeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPayFeeRequest.Unmarshal(m, b)
+}
+func (m *GetPayFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPayFeeRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPayFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPayFeeRequest.Merge(m, src)
+}
+func (m *GetPayFeeRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPayFeeRequest.Size(m)
+}
+func (m *GetPayFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPayFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPayFeeRequest proto.InternalMessageInfo
+
+func (m *GetPayFeeRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *GetPayFeeRequest) GetDst() string {
+	if m != nil {
+		return m.Dst
+	}
+	return ""
+}
+
+func (m *GetPayFeeRequest) GetAmt() string {
+	if m != nil {
+		return m.Amt
+	}
+	return ""
+}
+
+// Next tag: 2
+type GetPayFeeResponse struct {
+	// total relay fee in wei to send along with the pay
+	Fee                  string   `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPayFeeResponse) Reset()         { *m = GetPayFeeResponse{} }
+func (m *GetPayFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPayFeeResponse) ProtoMessage()    {}
+func (*GetPayFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{41}
+}
+
+func (m *GetPayFeeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPayFeeResponse.Unmarshal(m, b)
+}
+func (m *GetPayFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPayFeeResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPayFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPayFeeResponse.Merge(m, src)
+}
+func (m *GetPayFeeResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPayFeeResponse.Size(m)
+}
+func (m *GetPayFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPayFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPayFeeResponse proto.InternalMessageInfo
+
+func (m *GetPayFeeResponse) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+// Next tag: 5
 type ChannelRoutingInfo struct {
 	// channel identifier
 	Cid string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
 	// channel liquidity amount
-	Balance              string   `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Balance string `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	// flat fee charged for forwarding a pay through the channel
+	BaseFee string `protobuf:"bytes,3,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`
+	// proportional fee in parts per million of the pay amount
+	FeeRatePpm           uint64   `protobuf:"varint,4,opt,name=fee_rate_ppm,json=feeRatePpm,proto3" json:"fee_rate_ppm,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2900,7 +3093,7 @@ func (m *ChannelRoutingInfo) Reset()         { *m = ChannelRoutingInfo{} }
 func (m *ChannelRoutingInfo) String() string { return proto.CompactTextString(m) }
 func (*ChannelRoutingInfo) ProtoMessage()    {}
 func (*ChannelRoutingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33c57e4bae7b9afd, []int{40}
+	return fileDescriptor_33c57e4bae7b9afd, []int{42}
 }
 
 func (m *ChannelRoutingInfo) XXX_Unmarshal(b []byte) error {
@@ -2935,6 +3128,20 @@ func (m *ChannelRoutingInfo) GetBalance() string {
 	return ""
 }
 
+func (m *ChannelRoutingInfo) GetBaseFee() string {
+	if m != nil {
+		return m.BaseFee
+	}
+	return ""
+}
+
+func (m *ChannelRoutingInfo) GetFeeRatePpm() uint64 {
+	if m != nil {
+		return m.FeeRatePpm
+	}
+	return 0
+}
+
 // Next tag: 5
 type RoutingUpdate struct {
 	// origin source OSP for this information.
@@ -2954,7 +3161,7 @@ func (m *RoutingUpdate) Reset()         { *m = RoutingUpdate{} }
 func (m *RoutingUpdate) String() string { return proto.CompactTextString(m) }
 func (*RoutingUpdate) ProtoMessage()    {}
 func (*RoutingUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33c57e4bae7b9afd, []int{41}
+	return fileDescriptor_33c57e4bae7b9afd, []int{43}
 }
 
 func (m *RoutingUpdate) XXX_Unmarshal(b []byte) error {
@@ -3020,7 +3227,7 @@ func (m *SignedRoutingUpdate) Reset()         { *m = SignedRoutingUpdate{} }
 func (m *SignedRoutingUpdate) String() string { return proto.CompactTextString(m) }
 func (*SignedRoutingUpdate) ProtoMessage()    {}
 func (*SignedRoutingUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33c57e4bae7b9afd, []int{42}
+	return fileDescriptor_33c57e4bae7b9afd, []int{44}
 }
 
 func (m *SignedRoutingUpdate) XXX_Unmarshal(b []byte) error {
@@ -3077,7 +3284,7 @@ func (m *RoutingRequest) Reset()         { *m = RoutingRequest{} }
 func (m *RoutingRequest) String() string { return proto.CompactTextString(m) }
 func (*RoutingRequest) ProtoMessage()    {}
 func (*RoutingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33c57e4bae7b9afd, []int{43}
+	return fileDescriptor_33c57e4bae7b9afd, []int{45}
 }
 
 func (m *RoutingRequest) XXX_Unmarshal(b []byte) error {
@@ -3112,6 +3319,230 @@ func (m *RoutingRequest) GetSender() string {
 	return ""
 }
 
+// Next tag: 10
+type PayTraceSpan struct {
+	PayId   []byte `protobuf:"bytes,1,opt,name=pay_id,json=payId,proto3" json:"pay_id,omitempty"`
+	TraceId string `protobuf:"bytes,2,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	// node that recorded this span
+	Node []byte `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	// peer the cond pay request came from, empty at pay src
+	PrevHop []byte `protobuf:"bytes,4,opt,name=prev_hop,json=prevHop,proto3" json:"prev_hop,omitempty"`
+	// peer the cond pay request went to, empty at pay dest
+	NextHop []byte `protobuf:"bytes,5,opt,name=next_hop,json=nextHop,proto3" json:"next_hop,omitempty"`
+	// unix nanosecond timestamps, 0 if not reached
+	RecvTs    int64 `protobuf:"varint,6,opt,name=recv_ts,json=recvTs,proto3" json:"recv_ts,omitempty"`
+	FwdTs     int64 `protobuf:"varint,7,opt,name=fwd_ts,json=fwdTs,proto3" json:"fwd_ts,omitempty"`
+	ReceiptTs int64 `protobuf:"varint,8,opt,name=receipt_ts,json=receiptTs,proto3" json:"receipt_ts,omitempty"`
+	// processing errors and nacks seen at this hop
+	Errors               []string `protobuf:"bytes,9,rep,name=errors,proto3" json:"errors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PayTraceSpan) Reset()         { *m = PayTraceSpan{} }
+func (m *PayTraceSpan) String() string { return proto.CompactTextString(m) }
+func (*PayTraceSpan) ProtoMessage()    {}
+func (*PayTraceSpan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{46}
+}
+
+func (m *PayTraceSpan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayTraceSpan.Unmarshal(m, b)
+}
+func (m *PayTraceSpan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayTraceSpan.Marshal(b, m, deterministic)
+}
+func (m *PayTraceSpan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayTraceSpan.Merge(m, src)
+}
+func (m *PayTraceSpan) XXX_Size() int {
+	return xxx_messageInfo_PayTraceSpan.Size(m)
+}
+func (m *PayTraceSpan) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayTraceSpan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayTraceSpan proto.InternalMessageInfo
+
+func (m *PayTraceSpan) GetPayId() []byte {
+	if m != nil {
+		return m.PayId
+	}
+	return nil
+}
+
+func (m *PayTraceSpan) GetTraceId() string {
+	if m != nil {
+		return m.TraceId
+	}
+	return ""
+}
+
+func (m *PayTraceSpan) GetNode() []byte {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
+func (m *PayTraceSpan) GetPrevHop() []byte {
+	if m != nil {
+		return m.PrevHop
+	}
+	return nil
+}
+
+func (m *PayTraceSpan) GetNextHop() []byte {
+	if m != nil {
+		return m.NextHop
+	}
+	return nil
+}
+
+func (m *PayTraceSpan) GetRecvTs() int64 {
+	if m != nil {
+		return m.RecvTs
+	}
+	return 0
+}
+
+func (m *PayTraceSpan) GetFwdTs() int64 {
+	if m != nil {
+		return m.FwdTs
+	}
+	return 0
+}
+
+func (m *PayTraceSpan) GetReceiptTs() int64 {
+	if m != nil {
+		return m.ReceiptTs
+	}
+	return 0
+}
+
+func (m *PayTraceSpan) GetErrors() []string {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+// Next tag: 4
+type PayTraceRequest struct {
+	PayId []byte `protobuf:"bytes,1,opt,name=pay_id,json=payId,proto3" json:"pay_id,omitempty"`
+	// follow the pay path towards pay dest if true, towards pay src otherwise
+	Downstream bool `protobuf:"varint,2,opt,name=downstream,proto3" json:"downstream,omitempty"`
+	// time allowed for the receiver to collect spans from further hops
+	TimeoutMs            uint64   `protobuf:"varint,3,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PayTraceRequest) Reset()         { *m = PayTraceRequest{} }
+func (m *PayTraceRequest) String() string { return proto.CompactTextString(m) }
+func (*PayTraceRequest) ProtoMessage()    {}
+func (*PayTraceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{47}
+}
+
+func (m *PayTraceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayTraceRequest.Unmarshal(m, b)
+}
+func (m *PayTraceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayTraceRequest.Marshal(b, m, deterministic)
+}
+func (m *PayTraceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayTraceRequest.Merge(m, src)
+}
+func (m *PayTraceRequest) XXX_Size() int {
+	return xxx_messageInfo_PayTraceRequest.Size(m)
+}
+func (m *PayTraceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayTraceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayTraceRequest proto.InternalMessageInfo
+
+func (m *PayTraceRequest) GetPayId() []byte {
+	if m != nil {
+		return m.PayId
+	}
+	return nil
+}
+
+func (m *PayTraceRequest) GetDownstream() bool {
+	if m != nil {
+		return m.Downstream
+	}
+	return false
+}
+
+func (m *PayTraceRequest) GetTimeoutMs() uint64 {
+	if m != nil {
+		return m.TimeoutMs
+	}
+	return 0
+}
+
+// Next tag: 4
+type PayTraceResponse struct {
+	PayId      []byte `protobuf:"bytes,1,opt,name=pay_id,json=payId,proto3" json:"pay_id,omitempty"`
+	Downstream bool   `protobuf:"varint,2,opt,name=downstream,proto3" json:"downstream,omitempty"`
+	// spans ordered from the responding hop along the query direction
+	Spans                []*PayTraceSpan `protobuf:"bytes,3,rep,name=spans,proto3" json:"spans,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *PayTraceResponse) Reset()         { *m = PayTraceResponse{} }
+func (m *PayTraceResponse) String() string { return proto.CompactTextString(m) }
+func (*PayTraceResponse) ProtoMessage()    {}
+func (*PayTraceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{48}
+}
+
+func (m *PayTraceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayTraceResponse.Unmarshal(m, b)
+}
+func (m *PayTraceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayTraceResponse.Marshal(b, m, deterministic)
+}
+func (m *PayTraceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayTraceResponse.Merge(m, src)
+}
+func (m *PayTraceResponse) XXX_Size() int {
+	return xxx_messageInfo_PayTraceResponse.Size(m)
+}
+func (m *PayTraceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayTraceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayTraceResponse proto.InternalMessageInfo
+
+func (m *PayTraceResponse) GetPayId() []byte {
+	if m != nil {
+		return m.PayId
+	}
+	return nil
+}
+
+func (m *PayTraceResponse) GetDownstream() bool {
+	if m != nil {
+		return m.Downstream
+	}
+	return false
+}
+
+func (m *PayTraceResponse) GetSpans() []*PayTraceSpan {
+	if m != nil {
+		return m.Spans
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("rpc.ErrCode", ErrCode_name, ErrCode_value)
 	proto.RegisterEnum("rpc.PaymentSettleReason", PaymentSettleReason_name, PaymentSettleReason_value)
@@ -3158,210 +3589,237 @@ func init() {
 	proto.RegisterType((*GetPayHistoryRequest)(nil), "rpc.GetPayHistoryRequest")
 	proto.RegisterType((*OneHistoricalPay)(nil), "rpc.OneHistoricalPay")
 	proto.RegisterType((*GetPayHistoryResponse)(nil), "rpc.GetPayHistoryResponse")
+	proto.RegisterType((*GetPayFeeRequest)(nil), "rpc.GetPayFeeRequest")
+	proto.RegisterType((*GetPayFeeResponse)(nil), "rpc.GetPayFeeResponse")
 	proto.RegisterType((*ChannelRoutingInfo)(nil), "rpc.ChannelRoutingInfo")
 	proto.RegisterType((*RoutingUpdate)(nil), "rpc.RoutingUpdate")
 	proto.RegisterType((*SignedRoutingUpdate)(nil), "rpc.SignedRoutingUpdate")
 	proto.RegisterType((*RoutingRequest)(nil), "rpc.RoutingRequest")
+	proto.RegisterType((*PayTraceSpan)(nil), "rpc.PayTraceSpan")
+	proto.RegisterType((*PayTraceRequest)(nil), "rpc.PayTraceRequest")
+	proto.RegisterType((*PayTraceResponse)(nil), "rpc.PayTraceResponse")
 }
 
 func init() { proto.RegisterFile("message.proto", fileDescriptor_33c57e4bae7b9afd) }
 
 var fileDescriptor_33c57e4bae7b9afd = []byte{
-	// 3130 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x39, 0x4d, 0x6f, 0x23, 0x47,
-	0x76, 0x6a, 0x7e, 0xf3, 0x89, 0x22, 0x5b, 0xa5, 0x8f, 0xe1, 0x7c, 0x18, 0xa3, 0x69, 0xdb, 0xc9,
-	0x8c, 0x12, 0x6b, 0x8c, 0xb1, 0xe3, 0x04, 0x48, 0x60, 0x9b, 0x22, 0xdb, 0x23, 0xda, 0x12, 0x9b,
-	0x53, 0xa4, 0xc6, 0x1f, 0x30, 0xd0, 0x69, 0x75, 0x97, 0xa8, 0xce, 0x90, 0xdd, 0xad, 0xee, 0xa2,
-	0x66, 0x98, 0x4b, 0x82, 0x00, 0x81, 0x91, 0x43, 0x90, 0xe4, 0x98, 0x73, 0x10, 0x20, 0x40, 0x0e,
-	0x7b, 0xda, 0xcb, 0x62, 0x7f, 0xc0, 0x62, 0xcf, 0x7b, 0xde, 0xeb, 0x5e, 0xf6, 0x47, 0x2c, 0xea,
-	0xab, 0xd9, 0xa4, 0xa8, 0x59, 0x2d, 0xe0, 0xdd, 0x13, 0xbb, 0xde, 0x7b, 0xf5, 0xde, 0xab, 0x57,
-	0xef, 0xb3, 0x08, 0x1b, 0x13, 0x92, 0x24, 0xce, 0x88, 0x1c, 0x44, 0x71, 0x48, 0x43, 0x94, 0x8f,
-	0x23, 0xf7, 0x5e, 0x8d, 0x04, 0xd4, 0xa7, 0x33, 0x01, 0xba, 0x77, 0x77, 0x14, 0x86, 0xa3, 0x31,
-	0x79, 0xca, 0x57, 0x67, 0xd3, 0xf3, 0xa7, 0x4e, 0x20, 0x51, 0xc6, 0x13, 0xc8, 0x9f, 0x74, 0x3b,
-	0x48, 0x87, 0x3c, 0x75, 0x46, 0x4d, 0x6d, 0x4f, 0x7b, 0x5c, 0xc5, 0xec, 0x93, 0x41, 0x12, 0x72,
-	0xd9, 0xcc, 0xed, 0x69, 0x8f, 0x0b, 0x98, 0x7d, 0x1a, 0xbf, 0xa8, 0x42, 0xa5, 0x4d, 0xc6, 0x24,
-	0x3e, 0x49, 0x46, 0xe8, 0x1e, 0xe4, 0x27, 0xbe, 0xc7, 0x37, 0xac, 0x3f, 0xab, 0x1c, 0xc4, 0x91,
-	0x7b, 0x70, 0xd2, 0xed, 0x60, 0x06, 0x44, 0x8f, 0xa0, 0x1c, 0x13, 0x6a, 0x33, 0x7c, 0x6e, 0x09,
-	0x5f, 0x8a, 0x09, 0x3d, 0xf1, 0x3d, 0x84, 0xa0, 0x70, 0x3e, 0x76, 0x46, 0xcd, 0x3c, 0x67, 0xcf,
-	0xbf, 0xd1, 0x1d, 0x28, 0xd3, 0xd0, 0x76, 0x3c, 0x2f, 0x6e, 0x16, 0xf6, 0xb4, 0xc7, 0x35, 0x5c,
-	0xa2, 0x61, 0xcb, 0xf3, 0x62, 0x64, 0x40, 0x91, 0xc4, 0x71, 0x18, 0x37, 0x4b, 0x9c, 0x1b, 0x70,
-	0x6e, 0x26, 0x83, 0x1c, 0xad, 0x61, 0x81, 0x42, 0x4f, 0xa0, 0xe2, 0x4c, 0xe9, 0x85, 0x1d, 0x93,
-	0xcb, 0x66, 0x99, 0x93, 0xd5, 0x38, 0x59, 0x6b, 0x4a, 0x2f, 0x30, 0xb9, 0x3c, 0x5a, 0xc3, 0x65,
-	0x47, 0x7c, 0xa6, 0xa4, 0x8e, 0xfb, 0xaa, 0x59, 0x59, 0x22, 0x6d, 0xb9, 0xaf, 0x14, 0x69, 0xcb,
-	0x7d, 0x85, 0x3e, 0x03, 0xdd, 0x0d, 0x03, 0xcf, 0x8e, 0x9c, 0x19, 0xe3, 0x3c, 0x25, 0x09, 0x6d,
-	0x56, 0xf9, 0x96, 0x2d, 0xbe, 0xa5, 0x1d, 0x06, 0x5e, 0xdf, 0x99, 0x61, 0x81, 0x3a, 0x5a, 0xc3,
-	0x75, 0x77, 0x01, 0x82, 0x0e, 0x61, 0x33, 0xc3, 0x20, 0x89, 0xc2, 0x20, 0x21, 0x4d, 0xe0, 0x1c,
-	0xb6, 0x17, 0x39, 0x08, 0xdc, 0xd1, 0x1a, 0x6e, 0xb8, 0x8b, 0x20, 0xf4, 0x15, 0x6c, 0x47, 0xce,
-	0x6c, 0x42, 0x02, 0x6a, 0x27, 0x84, 0xd2, 0x31, 0xb1, 0xa3, 0x38, 0x0c, 0xcf, 0x9b, 0xeb, 0x9c,
-	0xcd, 0x1d, 0xce, 0xa6, 0x2f, 0x08, 0x06, 0x1c, 0xdf, 0x67, 0xe8, 0xa3, 0x35, 0x8c, 0xa2, 0x6b,
-	0x50, 0xf4, 0x02, 0x76, 0x97, 0x98, 0xa9, 0x73, 0xd5, 0x38, 0xbb, 0xbb, 0xd7, 0xd9, 0xcd, 0x4f,
-	0xb7, 0x1d, 0xad, 0x80, 0xa3, 0x21, 0xdc, 0xb9, 0xc6, 0x52, 0x9e, 0x74, 0x83, 0xf3, 0xbc, 0xb7,
-	0x8a, 0x67, 0x7a, 0xde, 0x9d, 0x68, 0x15, 0x02, 0x1d, 0x83, 0xfe, 0xda, 0xa7, 0x17, 0x5e, 0xec,
-	0xbc, 0x4e, 0x55, 0xac, 0x73, 0x76, 0x0f, 0xa5, 0xe1, 0xc2, 0x88, 0xc4, 0x0e, 0xf5, 0xaf, 0xc8,
-	0xd7, 0x92, 0x6e, 0xae, 0x68, 0xe3, 0xf5, 0x22, 0x08, 0x59, 0xb0, 0x99, 0xe1, 0x26, 0xb5, 0x6b,
-	0x70, 0x76, 0x7b, 0x37, 0xb3, 0x4b, 0x75, 0xd4, 0x5f, 0x2f, 0xc1, 0xd0, 0xa7, 0xd0, 0x88, 0xc3,
-	0x29, 0xf5, 0x83, 0x51, 0xaa, 0x9d, 0x9e, 0x71, 0x0c, 0x2c, 0x70, 0x19, 0xc7, 0x88, 0x17, 0x20,
-	0x4b, 0x9e, 0xe5, 0x12, 0x3f, 0xa2, 0xcd, 0x87, 0xab, 0x3c, 0x8b, 0xa3, 0x16, 0x3c, 0x8b, 0x43,
-	0xd0, 0xdf, 0xc0, 0x46, 0x4c, 0xae, 0x88, 0x33, 0xb6, 0x13, 0xe2, 0xc6, 0x84, 0x36, 0xf7, 0xf8,
-	0xee, 0x4d, 0x21, 0x9e, 0x63, 0x06, 0x1c, 0x71, 0xb4, 0x86, 0x6b, 0x71, 0x66, 0xcd, 0x7c, 0x72,
-	0x61, 0x27, 0x0f, 0x84, 0x47, 0x19, 0x9f, 0xcc, 0xee, 0x16, 0x01, 0xd1, 0x88, 0x17, 0x41, 0xe8,
-	0x6b, 0x68, 0x4a, 0x97, 0x9e, 0x8e, 0xa9, 0x7d, 0x15, 0x4e, 0xdd, 0x8b, 0xd4, 0x0e, 0x06, 0x67,
-	0xf5, 0xe0, 0x40, 0xa6, 0xa0, 0x97, 0x0c, 0x49, 0xbc, 0xb9, 0xa3, 0x4f, 0xc7, 0x54, 0x5e, 0xbb,
-	0x58, 0x70, 0x02, 0x65, 0x97, 0x6f, 0xe1, 0xee, 0x0a, 0xc6, 0xf2, 0xc2, 0xde, 0xbd, 0x15, 0xe7,
-	0xdd, 0x65, 0xce, 0x62, 0xf7, 0x61, 0x15, 0xca, 0x32, 0x53, 0x1a, 0x03, 0x28, 0xf2, 0xfc, 0x81,
-	0xf6, 0xa0, 0xe0, 0x86, 0x1e, 0xe1, 0x79, 0xac, 0x2e, 0xf3, 0x80, 0x19, 0xc7, 0xed, 0xd0, 0x23,
-	0x98, 0x63, 0xd0, 0x2e, 0x94, 0x62, 0xe2, 0x24, 0x61, 0xc0, 0x73, 0x59, 0x15, 0xcb, 0x95, 0xca,
-	0x8f, 0xf9, 0x79, 0x7e, 0xfc, 0x97, 0x1c, 0x94, 0x65, 0xba, 0x61, 0xb9, 0x6c, 0x32, 0x13, 0xb9,
-	0x4c, 0x13, 0xb9, 0x6c, 0x32, 0xe3, 0xb9, 0xec, 0x01, 0x54, 0xa9, 0x3f, 0x21, 0x09, 0x75, 0x26,
-	0x91, 0x4c, 0xae, 0x73, 0x00, 0xda, 0x81, 0xd2, 0x64, 0x66, 0x27, 0xbe, 0x48, 0x8c, 0x35, 0x5c,
-	0x9c, 0xcc, 0x06, 0xfe, 0x08, 0x3d, 0x84, 0x75, 0xf2, 0x26, 0x22, 0x2e, 0xb5, 0x23, 0x42, 0x54,
-	0x76, 0x04, 0x01, 0xea, 0x13, 0x12, 0x33, 0x82, 0xc9, 0x94, 0x4e, 0x9d, 0xb1, 0xcd, 0x32, 0x57,
-	0xb3, 0xb8, 0xa7, 0x3d, 0xae, 0x60, 0x10, 0x20, 0xa6, 0x12, 0x7a, 0x02, 0x3a, 0xcf, 0xf7, 0x6e,
-	0x38, 0xb6, 0xaf, 0x48, 0x9c, 0xf8, 0x61, 0xc0, 0xb3, 0x69, 0x01, 0x37, 0x14, 0xfc, 0xa5, 0x00,
-	0xa3, 0xbf, 0x83, 0x46, 0x18, 0x91, 0x80, 0x78, 0xb6, 0x7b, 0xe1, 0x04, 0x01, 0x19, 0x27, 0xcd,
-	0xf2, 0x5e, 0x7e, 0xee, 0x98, 0x02, 0x38, 0x98, 0x4e, 0x26, 0x4e, 0x3c, 0xc3, 0x75, 0x41, 0x2b,
-	0xa1, 0x89, 0xf1, 0xcf, 0x9a, 0x30, 0x02, 0x73, 0x92, 0xf7, 0xa1, 0x9a, 0x50, 0x27, 0x16, 0x95,
-	0x60, 0xb9, 0x52, 0x54, 0x38, 0x8a, 0xd5, 0x82, 0xf9, 0xa1, 0x73, 0xd9, 0x43, 0xff, 0x35, 0x6c,
-	0x24, 0xb3, 0xc0, 0x9d, 0x6b, 0x91, 0xe7, 0x5a, 0xa0, 0xac, 0x16, 0xdd, 0x80, 0x1b, 0xbc, 0xc6,
-	0x08, 0x53, 0x15, 0x08, 0xd4, 0xb2, 0x1e, 0xcc, 0xf8, 0x33, 0x97, 0x92, 0x3a, 0xd4, 0x70, 0x31,
-	0x72, 0x66, 0x5d, 0x8f, 0x5d, 0xac, 0x8c, 0x1c, 0x21, 0x56, 0xae, 0xd0, 0x9f, 0x41, 0x23, 0x8c,
-	0xfd, 0x91, 0x1f, 0x38, 0x63, 0x5b, 0xee, 0x13, 0x97, 0xb1, 0xa1, 0xc0, 0x7d, 0xb6, 0xdf, 0xf8,
-	0x27, 0x68, 0x2c, 0x05, 0xca, 0x4d, 0x92, 0x3e, 0x80, 0x2d, 0x06, 0xf6, 0x48, 0x42, 0x55, 0xc8,
-	0xcd, 0x4f, 0xab, 0x47, 0xce, 0xac, 0x43, 0x12, 0x2a, 0xb8, 0xb0, 0x83, 0xdf, 0x56, 0x81, 0xdf,
-	0x68, 0xb0, 0xde, 0x8e, 0xc3, 0x24, 0xe9, 0x11, 0xda, 0x77, 0x66, 0xe8, 0x01, 0x40, 0x12, 0xbb,
-	0x76, 0x40, 0xa8, 0xd2, 0xa0, 0x80, 0x2b, 0x49, 0xec, 0xf6, 0x08, 0xed, 0x7a, 0x0c, 0xeb, 0x25,
-	0x54, 0x61, 0x85, 0xe7, 0x55, 0xbc, 0x84, 0x0a, 0xec, 0x23, 0xa8, 0x65, 0x65, 0x4a, 0x81, 0xeb,
-	0x19, 0x81, 0xe8, 0x1e, 0x54, 0x5c, 0x26, 0xcd, 0x0f, 0x46, 0xdc, 0x03, 0x2b, 0x38, 0x5d, 0x33,
-	0xff, 0x3b, 0x8b, 0x7d, 0x6f, 0x44, 0x84, 0xcb, 0x17, 0x85, 0x83, 0x0a, 0x90, 0x2c, 0xe1, 0x1b,
-	0x92, 0x40, 0x2a, 0x20, 0x9c, 0x4f, 0xee, 0x12, 0x3a, 0x34, 0xa1, 0xcc, 0x22, 0x21, 0x9c, 0x52,
-	0x5e, 0xc1, 0x0b, 0x58, 0x2d, 0x8d, 0xff, 0xc8, 0x41, 0x7d, 0xb1, 0xd4, 0xa2, 0xbb, 0x50, 0x51,
-	0xf9, 0x53, 0x1a, 0xbb, 0x2c, 0x13, 0x24, 0xb2, 0xa0, 0x99, 0x50, 0x87, 0x12, 0x3b, 0x0c, 0xc6,
-	0x33, 0x1e, 0x31, 0xf6, 0x79, 0x1c, 0x4e, 0x52, 0x9b, 0xab, 0x9a, 0x39, 0xf0, 0x47, 0x01, 0xf1,
-	0x06, 0xfe, 0x24, 0x1a, 0x93, 0x37, 0x03, 0xb6, 0x03, 0x6f, 0xf3, 0x8d, 0x56, 0x30, 0x9e, 0xb1,
-	0xb0, 0xfa, 0x22, 0x0e, 0x27, 0xec, 0x42, 0x1e, 0x43, 0x21, 0x08, 0x29, 0xe1, 0x46, 0x61, 0x39,
-	0x52, 0x74, 0x53, 0x07, 0xaa, 0x9b, 0x3a, 0x68, 0x05, 0x33, 0xcc, 0x29, 0x98, 0x56, 0x67, 0x4e,
-	0x42, 0x6c, 0x96, 0x19, 0x0a, 0xe2, 0x0c, 0x6c, 0x3d, 0x20, 0x97, 0xe8, 0x1d, 0x00, 0xcf, 0x8f,
-	0x79, 0x0c, 0x3b, 0x33, 0x19, 0xa1, 0x55, 0x01, 0x61, 0x4a, 0x7f, 0x00, 0x55, 0x6e, 0x4d, 0x66,
-	0x1f, 0xd9, 0xe7, 0xe8, 0xc2, 0xd3, 0xe7, 0x37, 0x2c, 0x0d, 0xde, 0x23, 0xd4, 0x48, 0xa0, 0xb1,
-	0xd4, 0x39, 0xa0, 0x4f, 0xa1, 0x2e, 0x8e, 0xed, 0x86, 0x09, 0x3f, 0x99, 0x0c, 0xb9, 0x1b, 0x0f,
-	0xbb, 0xc1, 0xc9, 0xdb, 0x92, 0x1a, 0xed, 0xa9, 0x2e, 0x2b, 0xb7, 0xdc, 0x65, 0xc9, 0x1e, 0xcb,
-	0xf8, 0x57, 0x0d, 0x4a, 0x7d, 0x67, 0x76, 0x14, 0x46, 0x37, 0x79, 0xba, 0x01, 0x1b, 0x51, 0x4c,
-	0xae, 0xec, 0x8b, 0x30, 0x12, 0x9e, 0x20, 0x7c, 0x7c, 0x9d, 0x01, 0x8f, 0xc2, 0x48, 0xb9, 0x42,
-	0x40, 0xde, 0xd0, 0x39, 0x8d, 0xf4, 0x35, 0x06, 0x54, 0x34, 0x0f, 0x20, 0x4f, 0x62, 0x91, 0xe8,
-	0x16, 0x35, 0x61, 0x60, 0xa3, 0x03, 0x35, 0x71, 0x1c, 0xa9, 0x0c, 0x93, 0xea, 0xcc, 0x38, 0xc3,
-	0xb3, 0x19, 0x25, 0x89, 0xd4, 0x69, 0x3d, 0xe2, 0xe8, 0x43, 0x06, 0xe2, 0xe9, 0x3a, 0x8d, 0x39,
-	0xf6, 0x69, 0x7c, 0x08, 0xe5, 0xbe, 0x33, 0xeb, 0x3b, 0xf4, 0x02, 0xbd, 0x0f, 0x85, 0x8b, 0x30,
-	0x62, 0xfb, 0xf2, 0x69, 0x09, 0xcd, 0x4a, 0xc0, 0x1c, 0x6d, 0xfc, 0x52, 0x83, 0xba, 0xe8, 0x52,
-	0x3c, 0xd9, 0xcc, 0xa0, 0xf7, 0xa0, 0x2e, 0x7a, 0x1e, 0xcf, 0x5e, 0xb0, 0x47, 0x2d, 0x49, 0xe9,
-	0xba, 0x1e, 0xfa, 0x70, 0xa1, 0x86, 0xd4, 0x9f, 0x35, 0x57, 0x35, 0x44, 0x0c, 0x9f, 0x56, 0x97,
-	0x5d, 0x28, 0x39, 0x93, 0x70, 0x1a, 0x50, 0x69, 0x1d, 0xb9, 0x62, 0xf5, 0x2a, 0x72, 0xe8, 0x85,
-	0xb4, 0x4c, 0x4d, 0xf1, 0x61, 0xa7, 0xc0, 0x1c, 0xb3, 0x2a, 0x7b, 0x14, 0x57, 0x65, 0x8f, 0xff,
-	0xd1, 0x00, 0x5d, 0xef, 0x1a, 0xd1, 0x29, 0x34, 0xaf, 0x44, 0x59, 0xb5, 0xb3, 0x8d, 0xeb, 0x74,
-	0x4c, 0x95, 0x79, 0xde, 0x5a, 0x7e, 0xf1, 0xce, 0xd5, 0x0a, 0x68, 0x82, 0x3e, 0x81, 0x5a, 0xc6,
-	0x4e, 0x49, 0x33, 0x97, 0xa9, 0x28, 0x8b, 0x26, 0xc5, 0xeb, 0x73, 0xd3, 0x25, 0xc6, 0xcf, 0x34,
-	0xd8, 0x5e, 0xd5, 0x8c, 0x5e, 0x63, 0xa8, 0xdd, 0x8e, 0xe1, 0x8f, 0x9f, 0x1c, 0xb2, 0x21, 0x9f,
-	0x5f, 0x08, 0x79, 0x63, 0x06, 0x3b, 0x2b, 0x9b, 0xde, 0x1f, 0x2f, 0x54, 0xf3, 0x37, 0x85, 0xea,
-	0x4f, 0x35, 0x40, 0x56, 0x44, 0x02, 0x59, 0x14, 0x95, 0xd5, 0x9e, 0xc2, 0x96, 0x2c, 0xa7, 0xb6,
-	0x1f, 0xf8, 0xd4, 0x77, 0xc6, 0xfe, 0x3f, 0x12, 0xd5, 0xa2, 0x20, 0x57, 0x15, 0xd5, 0x14, 0x83,
-	0xde, 0x65, 0x5d, 0x26, 0xdf, 0x4b, 0xe2, 0x4c, 0xd1, 0xaa, 0xa5, 0x40, 0x66, 0x82, 0xbf, 0x80,
-	0x32, 0xeb, 0x02, 0xec, 0x33, 0x51, 0x37, 0xea, 0xb2, 0x46, 0x67, 0xe4, 0x1f, 0xce, 0x70, 0x89,
-	0x91, 0x1c, 0xf2, 0x2a, 0x15, 0x26, 0x91, 0x4d, 0x43, 0x3b, 0x4c, 0x22, 0x55, 0x48, 0xc2, 0x24,
-	0x1a, 0x86, 0x56, 0x12, 0x19, 0xbf, 0xd5, 0x60, 0x6b, 0x41, 0x6f, 0x69, 0xb1, 0x3f, 0x8e, 0xe2,
-	0x8f, 0xa0, 0xe6, 0x44, 0x51, 0x1c, 0x5e, 0x49, 0x1a, 0x99, 0x89, 0x14, 0x8c, 0x91, 0x1c, 0x40,
-	0x89, 0xd9, 0x7e, 0x9a, 0x70, 0x55, 0xeb, 0xcf, 0x76, 0x97, 0x8f, 0x36, 0xe0, 0x58, 0x2c, 0xa9,
-	0xd0, 0x5f, 0x82, 0x9a, 0xba, 0xec, 0x54, 0x61, 0x15, 0x81, 0xba, 0xc4, 0xa8, 0xe6, 0xc5, 0x33,
-	0x7e, 0xd0, 0xe0, 0xde, 0xcd, 0x83, 0x0c, 0xea, 0xc0, 0x46, 0x3a, 0xb5, 0xf8, 0xc1, 0x79, 0x28,
-	0xdd, 0xe4, 0xa1, 0x8a, 0xc0, 0x15, 0x5b, 0xbb, 0xc1, 0x79, 0x88, 0x6b, 0xaf, 0x33, 0xab, 0x5b,
-	0x99, 0xc2, 0xf8, 0x7f, 0x0d, 0xee, 0xbf, 0x65, 0x06, 0xfa, 0x13, 0xaa, 0x72, 0x8b, 0x5b, 0x31,
-	0x7e, 0xad, 0x65, 0x1a, 0x02, 0x31, 0x0f, 0xdd, 0x50, 0x91, 0xf6, 0xa0, 0x36, 0xef, 0xbd, 0x52,
-	0x81, 0xa0, 0x9a, 0x2e, 0x7f, 0x84, 0xf6, 0x61, 0x53, 0x50, 0x8c, 0xc9, 0xc8, 0xa1, 0x61, 0x56,
-	0x66, 0x83, 0x93, 0x49, 0x38, 0xa3, 0xfd, 0x0c, 0x74, 0x49, 0xe7, 0x87, 0x81, 0x1c, 0xc3, 0x0b,
-	0x99, 0xc9, 0xa9, 0x93, 0x22, 0x79, 0x36, 0xc5, 0x0d, 0x6f, 0x11, 0x70, 0xeb, 0xec, 0xfc, 0x83,
-	0x06, 0xe8, 0x7a, 0x1e, 0x60, 0xf6, 0x4b, 0xc4, 0xda, 0xe6, 0x19, 0x21, 0xad, 0x36, 0x59, 0xa2,
-	0x3f, 0x07, 0x3d, 0xf1, 0x47, 0x76, 0x78, 0x3e, 0x4f, 0x6f, 0xf2, 0xd8, 0x1b, 0x89, 0x3f, 0xb2,
-	0xce, 0x55, 0xf6, 0x42, 0xef, 0x42, 0x3d, 0x4b, 0x48, 0x43, 0x65, 0xea, 0x94, 0x6c, 0x18, 0x1a,
-	0x03, 0xd8, 0x14, 0x8a, 0x74, 0xa6, 0x73, 0x11, 0x2c, 0x81, 0x65, 0xf5, 0x50, 0xf9, 0xf7, 0x2d,
-	0x09, 0x2c, 0xb3, 0x4a, 0x8c, 0x2f, 0x60, 0x9d, 0xb1, 0x67, 0xb5, 0x9e, 0x24, 0x09, 0xeb, 0xfc,
-	0x1c, 0xf1, 0x29, 0x5f, 0xa0, 0xd4, 0x92, 0x75, 0x4d, 0x34, 0x7c, 0x45, 0x82, 0x79, 0x37, 0x51,
-	0xc5, 0x55, 0x0e, 0x61, 0x7b, 0x8d, 0x73, 0x00, 0xc6, 0x47, 0xc4, 0x20, 0x73, 0x9c, 0xf3, 0x98,
-	0x10, 0xfb, 0xcc, 0x19, 0x3b, 0x81, 0x4b, 0x24, 0xaf, 0x75, 0x06, 0x3b, 0x14, 0x20, 0xf4, 0x57,
-	0xb0, 0xfe, 0x0f, 0xa1, 0x1f, 0xd8, 0x32, 0xa6, 0x45, 0x39, 0x16, 0x77, 0xf7, 0x65, 0xe8, 0x07,
-	0xfc, 0x79, 0x4b, 0x46, 0x34, 0x30, 0x42, 0xf1, 0x6d, 0xfc, 0x27, 0xf3, 0xb7, 0x85, 0xc1, 0x87,
-	0x69, 0x96, 0x09, 0x70, 0x71, 0x0f, 0x55, 0x95, 0x88, 0x78, 0xbb, 0xcd, 0x86, 0x1a, 0x72, 0x69,
-	0x07, 0xd3, 0x89, 0x6a, 0xb7, 0x27, 0xb3, 0x01, 0xb9, 0xec, 0x4d, 0x27, 0xdc, 0x2b, 0x99, 0xc9,
-	0x15, 0x5e, 0x14, 0x0e, 0x60, 0x30, 0x49, 0xf1, 0x10, 0xd6, 0xc7, 0xc4, 0x1b, 0x91, 0x38, 0xfb,
-	0x20, 0x06, 0x02, 0xc4, 0x8f, 0xfe, 0xbf, 0x79, 0xd8, 0x58, 0x98, 0x82, 0x58, 0x8b, 0xe3, 0xa6,
-	0xaa, 0xb0, 0x4f, 0xe6, 0x2e, 0x4a, 0x47, 0xe1, 0x2e, 0x4c, 0x8f, 0x3c, 0xae, 0xb9, 0xf3, 0xd4,
-	0x45, 0xd0, 0x31, 0xec, 0xf0, 0xec, 0xad, 0x28, 0xd3, 0x69, 0x5b, 0x14, 0x97, 0xe6, 0x72, 0xc2,
-	0x53, 0x29, 0x01, 0x6f, 0x85, 0x2b, 0x12, 0xf5, 0xe7, 0xd0, 0x60, 0x23, 0xa8, 0xe3, 0xbe, 0xb2,
-	0xe5, 0x95, 0xcb, 0x00, 0xb9, 0xd1, 0x35, 0xea, 0x92, 0x5e, 0x02, 0xd1, 0xc7, 0x50, 0x53, 0x1c,
-	0x78, 0x65, 0x2f, 0x66, 0x9a, 0x32, 0x16, 0x1c, 0x81, 0x1c, 0x2f, 0xf1, 0xba, 0x24, 0xe3, 0x75,
-	0x5d, 0xca, 0x8d, 0xc9, 0x65, 0x2a, 0xb7, 0x74, 0x0b, 0xb9, 0x31, 0xb9, 0x5c, 0x92, 0xcb, 0x38,
-	0x70, 0xb9, 0xe5, 0xb7, 0xca, 0x8d, 0xc9, 0x25, 0x97, 0xbb, 0x74, 0x4f, 0x95, 0x6b, 0xf7, 0xf4,
-	0xf7, 0x50, 0xcb, 0xee, 0x66, 0xb7, 0x34, 0x9f, 0x59, 0xd8, 0x67, 0x3a, 0x5e, 0xe4, 0x7e, 0xef,
-	0x78, 0xb1, 0x0d, 0x45, 0x71, 0x8f, 0x79, 0x7e, 0x8f, 0x62, 0x61, 0xfc, 0x44, 0x83, 0x9d, 0x79,
-	0xe2, 0xe9, 0x90, 0xc4, 0x8d, 0xfd, 0x88, 0x7d, 0xa2, 0x07, 0x50, 0x4d, 0xd3, 0x9a, 0x72, 0xd1,
-	0x14, 0x90, 0xc1, 0x12, 0x22, 0x13, 0xc4, 0x1c, 0x80, 0x0e, 0x60, 0x8b, 0xbc, 0x89, 0xfc, 0x98,
-	0x24, 0xb6, 0x73, 0xce, 0xd2, 0xf5, 0xd9, 0x38, 0x74, 0x5f, 0x49, 0xc9, 0x9b, 0x12, 0xd5, 0x62,
-	0x98, 0x43, 0x86, 0x60, 0x69, 0x54, 0x44, 0x2a, 0x0d, 0x55, 0x2e, 0x25, 0xcd, 0xc2, 0x5e, 0x9e,
-	0xa5, 0x51, 0x8e, 0x18, 0x86, 0x52, 0x49, 0x62, 0xfc, 0x9b, 0x06, 0x8d, 0xa5, 0x54, 0x89, 0x3e,
-	0x87, 0x07, 0x99, 0xd4, 0xea, 0xcd, 0x4f, 0xb1, 0xd0, 0xd3, 0xdf, 0xf3, 0x56, 0x1d, 0x54, 0xb4,
-	0xf8, 0x0f, 0xa0, 0xca, 0xfa, 0x23, 0x87, 0x4e, 0xe3, 0xf4, 0x3c, 0x29, 0x80, 0x8f, 0xfb, 0xcc,
-	0x09, 0xd4, 0xbc, 0x21, 0x57, 0xc6, 0x67, 0xb0, 0x39, 0x57, 0x45, 0x15, 0xde, 0x7d, 0x28, 0x8a,
-	0xe4, 0xae, 0xbd, 0x25, 0xb9, 0x0b, 0x12, 0x63, 0x1f, 0x50, 0x96, 0x81, 0x8c, 0x83, 0x6d, 0xd5,
-	0xa2, 0x89, 0x24, 0x24, 0xdb, 0xb2, 0x4f, 0x60, 0xf7, 0xc5, 0x94, 0xc4, 0xb3, 0xeb, 0x12, 0x17,
-	0x2e, 0x43, 0x5b, 0xba, 0x0c, 0xc3, 0x84, 0x3b, 0xd7, 0xf6, 0x49, 0x41, 0x7f, 0x88, 0xaa, 0x31,
-	0xec, 0x9c, 0xf8, 0xa3, 0x98, 0xb5, 0x92, 0x8b, 0x7d, 0xe1, 0xc7, 0xb0, 0xab, 0xc2, 0x7f, 0xc2,
-	0x09, 0x98, 0xdd, 0xd3, 0x32, 0x5f, 0xc3, 0xdb, 0x12, 0x7b, 0xa2, 0x90, 0xb7, 0x6f, 0x2c, 0xfe,
-	0x16, 0x76, 0x97, 0x65, 0x4a, 0xcd, 0x97, 0xeb, 0xbc, 0x76, 0xbd, 0xce, 0xff, 0x5c, 0x83, 0xed,
-	0xe7, 0x7c, 0xf6, 0x3d, 0xf2, 0x13, 0x1a, 0xc6, 0xe9, 0xf8, 0x8f, 0xa0, 0xc0, 0x9f, 0xc2, 0x84,
-	0x75, 0xf9, 0x37, 0xba, 0x0f, 0xd5, 0x33, 0x72, 0x1e, 0xc6, 0xc4, 0xa6, 0x89, 0xcc, 0x74, 0x15,
-	0x01, 0x18, 0x26, 0x6c, 0x50, 0xf3, 0x29, 0x99, 0x24, 0x76, 0x44, 0x62, 0x3b, 0x72, 0x46, 0x22,
-	0x86, 0x8a, 0xb8, 0xc6, 0xa1, 0x7d, 0x12, 0xf7, 0x9d, 0x11, 0x61, 0x4d, 0x04, 0x4d, 0xb8, 0x32,
-	0x22, 0xe1, 0x16, 0x69, 0xc2, 0xca, 0x7e, 0x1d, 0x72, 0x34, 0xe1, 0x85, 0xba, 0x80, 0x73, 0x34,
-	0x61, 0x55, 0x3c, 0x99, 0x38, 0xe3, 0x31, 0x6b, 0x2a, 0x64, 0x15, 0x2f, 0x71, 0x45, 0x36, 0x14,
-	0x58, 0x54, 0xf1, 0xff, 0xd3, 0x40, 0xb7, 0x02, 0x22, 0x74, 0xf7, 0x5d, 0xf1, 0x8e, 0xa2, 0x43,
-	0xde, 0x4b, 0xa8, 0xfa, 0xab, 0xc5, 0x4b, 0x28, 0xf3, 0x15, 0x1e, 0x21, 0xb2, 0xbe, 0x89, 0x05,
-	0xa3, 0x73, 0x26, 0x62, 0xfe, 0xab, 0x62, 0xf6, 0x39, 0x0f, 0xff, 0x42, 0x26, 0xfc, 0x33, 0x8d,
-	0x4f, 0x51, 0x6c, 0x17, 0x8d, 0xcf, 0x7d, 0xa8, 0xba, 0x31, 0x61, 0x43, 0x06, 0x4d, 0xb8, 0x76,
-	0x79, 0x5c, 0x11, 0x80, 0xa1, 0x98, 0x86, 0x63, 0x97, 0x3f, 0xb3, 0x54, 0x31, 0xfb, 0x34, 0x0e,
-	0x61, 0x67, 0xc9, 0xd0, 0xf2, 0x96, 0x9e, 0xb0, 0x89, 0x33, 0x1d, 0xb0, 0x76, 0x44, 0x35, 0x58,
-	0x3a, 0x13, 0xe6, 0x24, 0xc6, 0xe7, 0x80, 0xd4, 0x1d, 0x8b, 0xc7, 0x6e, 0xee, 0x25, 0x99, 0xb2,
-	0x54, 0x15, 0x65, 0xa9, 0x09, 0x65, 0x55, 0xa2, 0xc5, 0x89, 0xd5, 0xd2, 0xf8, 0x2f, 0x0d, 0x36,
-	0xe4, 0xde, 0xd3, 0xc8, 0x63, 0xa7, 0xdb, 0x85, 0x92, 0xe8, 0x8c, 0x24, 0x03, 0xb9, 0x92, 0x57,
-	0x92, 0x4b, 0xaf, 0xe4, 0x23, 0xa8, 0x2c, 0x3d, 0x14, 0xde, 0xc9, 0x3e, 0x14, 0x66, 0x14, 0xc2,
-	0x29, 0x21, 0x73, 0x60, 0x9e, 0x65, 0xd3, 0x27, 0x51, 0xf1, 0x66, 0x53, 0xe3, 0x40, 0xf9, 0x1e,
-	0x6a, 0xbc, 0x80, 0x2d, 0x51, 0x3d, 0xae, 0x29, 0x36, 0xe5, 0x5f, 0xea, 0x81, 0x57, 0xac, 0xae,
-	0x3f, 0x34, 0xf0, 0xff, 0xd6, 0xe8, 0x58, 0xbd, 0x14, 0x53, 0x3a, 0x36, 0xbe, 0x87, 0xfa, 0xe2,
-	0x1f, 0x04, 0xe8, 0x19, 0x94, 0xc5, 0x7e, 0x65, 0xe8, 0x66, 0xa6, 0x6c, 0x2d, 0x08, 0xc6, 0x8a,
-	0x50, 0x3c, 0x60, 0x06, 0x1e, 0x51, 0x7d, 0x91, 0x5c, 0xed, 0xff, 0x4a, 0x83, 0xb2, 0x7c, 0xc3,
-	0x46, 0x25, 0xc8, 0x59, 0x5f, 0xe9, 0x6b, 0x48, 0x87, 0xda, 0x69, 0xaf, 0x75, 0x3a, 0x3c, 0xb2,
-	0x70, 0xf7, 0x3b, 0xb3, 0xa3, 0x6b, 0xa8, 0x01, 0xeb, 0xdd, 0xde, 0xcb, 0xd6, 0x71, 0xb7, 0x63,
-	0x0f, 0xba, 0xcf, 0xf5, 0x1c, 0xda, 0x82, 0x46, 0xb7, 0xd7, 0xb6, 0x30, 0x36, 0xdb, 0x43, 0xbb,
-	0x7d, 0x6c, 0xb5, 0xbf, 0xd2, 0xf3, 0xa8, 0x0e, 0xf0, 0x35, 0xb6, 0x7a, 0xcf, 0xed, 0xbe, 0x69,
-	0x62, 0xbd, 0x20, 0x88, 0xe4, 0x2e, 0xf3, 0x85, 0xdd, 0x3b, 0x3d, 0xd1, 0x8b, 0x08, 0x41, 0xbd,
-	0xdf, 0xfa, 0xd6, 0xc6, 0xd6, 0xe9, 0xd0, 0xb4, 0x8f, 0x2d, 0xab, 0xaf, 0x97, 0x18, 0x61, 0xcf,
-	0x92, 0xa0, 0xa1, 0x65, 0x77, 0x06, 0x43, 0xbd, 0x8c, 0x76, 0x01, 0xf5, 0xac, 0xa1, 0x6d, 0xf6,
-	0xac, 0xd3, 0xe7, 0x47, 0xf6, 0x61, 0xeb, 0xb8, 0xd5, 0x6b, 0x9b, 0x7a, 0x85, 0x11, 0x33, 0xfe,
-	0x36, 0x43, 0x5a, 0xbd, 0xe3, 0x6e, 0xcf, 0xd4, 0xab, 0x4c, 0xf4, 0x49, 0x77, 0xd0, 0xb6, 0x4d,
-	0x8c, 0x2d, 0xac, 0xc3, 0xfe, 0x7f, 0x6b, 0xb0, 0xb5, 0xe2, 0xc9, 0x04, 0x55, 0xa0, 0xd0, 0xb3,
-	0x7a, 0xa6, 0xbe, 0xc6, 0x8e, 0xc4, 0xf4, 0x30, 0xbf, 0xe9, 0x77, 0x31, 0x3f, 0xa3, 0x0e, 0x35,
-	0xae, 0x98, 0xf9, 0xa5, 0xd9, 0x1e, 0x9a, 0x1d, 0x3d, 0x87, 0x9a, 0xb0, 0x2d, 0x20, 0x03, 0xeb,
-	0xf8, 0xa5, 0xd9, 0xb1, 0xad, 0x5e, 0xfb, 0xa8, 0xd5, 0xed, 0xe9, 0x79, 0x45, 0xdb, 0x6f, 0x75,
-	0x3b, 0xf6, 0x49, 0xeb, 0x1b, 0xbd, 0xa0, 0x68, 0x3b, 0xe6, 0x60, 0x68, 0x9f, 0xf6, 0xb0, 0xd9,
-	0x6a, 0x1f, 0xb5, 0x0e, 0x8f, 0x4d, 0xbd, 0xa8, 0x04, 0xbd, 0xb4, 0x4e, 0xdb, 0x47, 0x66, 0x47,
-	0x2f, 0xed, 0x7f, 0x0f, 0x1b, 0x0b, 0xd3, 0x2e, 0xda, 0x81, 0xcd, 0xd3, 0x5e, 0xc7, 0xfc, 0xa2,
-	0xdb, 0x63, 0x42, 0xfa, 0x66, 0xcf, 0x3e, 0xfc, 0x56, 0x5f, 0x43, 0x77, 0x61, 0x87, 0x2f, 0xda,
-	0x47, 0xad, 0x5e, 0xcf, 0x3c, 0xb6, 0xfb, 0xd8, 0xea, 0x5b, 0x03, 0x13, 0xeb, 0xda, 0x35, 0x54,
-	0xab, 0xdf, 0xc7, 0xd6, 0x4b, 0x13, 0xeb, 0xb9, 0xfd, 0x7f, 0xd7, 0x60, 0xf3, 0xda, 0xc4, 0x89,
-	0x1e, 0xc1, 0x3b, 0x4b, 0x22, 0xd4, 0xd6, 0xc1, 0xb0, 0x35, 0x3c, 0x1d, 0xac, 0x10, 0x27, 0x79,
-	0x32, 0xd3, 0xbc, 0x03, 0x77, 0x17, 0x50, 0xc3, 0x6f, 0xec, 0xc1, 0xe9, 0xe1, 0x49, 0x77, 0x28,
-	0xec, 0x74, 0x1f, 0xee, 0x2c, 0xa2, 0xdb, 0x87, 0x5c, 0x86, 0xd9, 0xd1, 0xf3, 0xfb, 0x9f, 0x40,
-	0x63, 0xa9, 0x59, 0x46, 0x35, 0xa8, 0xb0, 0xcb, 0xfb, 0xd2, 0xea, 0xf6, 0xf4, 0x35, 0x54, 0x85,
-	0xe2, 0xb1, 0xd5, 0x6e, 0x1d, 0xeb, 0x1a, 0x02, 0x28, 0x61, 0xf3, 0xc4, 0x1a, 0x9a, 0x7a, 0xee,
-	0xf0, 0x05, 0xdc, 0x0d, 0x08, 0x7d, 0x1d, 0xc6, 0xaf, 0x0e, 0x5c, 0xb6, 0xf7, 0x60, 0x14, 0x8a,
-	0xdf, 0x49, 0x32, 0x3a, 0xac, 0x9d, 0x88, 0xff, 0x66, 0xfa, 0x2c, 0xf6, 0xfa, 0xda, 0x77, 0xef,
-	0x8d, 0x7c, 0x7a, 0x31, 0x3d, 0x3b, 0x70, 0xc3, 0xc9, 0x53, 0x4e, 0xf5, 0x81, 0xdc, 0xfb, 0x74,
-	0x14, 0x72, 0xc9, 0x4f, 0xe3, 0xc8, 0x3d, 0x2b, 0xf1, 0x50, 0xfd, 0xe8, 0x77, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x91, 0x67, 0xe9, 0x9f, 0x04, 0x1f, 0x00, 0x00,
+	// 3488 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x39, 0x5d, 0x6f, 0x23, 0xc9,
+	0x56, 0x69, 0x7f, 0xfb, 0xc4, 0x89, 0x3b, 0x95, 0x8f, 0xf1, 0xcc, 0xce, 0x32, 0x99, 0xde, 0xbb,
+	0xdc, 0xd9, 0x81, 0xcd, 0x5c, 0xed, 0xbd, 0x2c, 0x48, 0xa0, 0xbb, 0xd7, 0xb1, 0x7b, 0x26, 0xde,
+	0x4d, 0x6c, 0x4f, 0xd9, 0x99, 0xdd, 0xbd, 0xba, 0x52, 0xd3, 0x71, 0x97, 0x9d, 0x66, 0xec, 0xee,
+	0x4e, 0x57, 0x39, 0x59, 0x23, 0x21, 0x10, 0x12, 0x42, 0x20, 0x21, 0xc1, 0x23, 0xcf, 0x08, 0x09,
+	0x89, 0x07, 0xde, 0x11, 0xbf, 0x80, 0x27, 0x04, 0x3f, 0x82, 0x17, 0x5e, 0x78, 0xe4, 0x0d, 0x9d,
+	0xaa, 0xea, 0x76, 0xdb, 0x49, 0x86, 0x01, 0x2d, 0xf7, 0xc9, 0x5d, 0xe7, 0x9c, 0x3a, 0xe7, 0xd4,
+	0xa9, 0xf3, 0x55, 0xc7, 0xb0, 0x35, 0x63, 0x9c, 0xbb, 0x13, 0x76, 0x14, 0xc5, 0xa1, 0x08, 0x49,
+	0x3e, 0x8e, 0x46, 0x8f, 0x6a, 0x2c, 0x10, 0xbe, 0x58, 0x28, 0xd0, 0xa3, 0x87, 0x93, 0x30, 0x9c,
+	0x4c, 0xd9, 0x0b, 0xb9, 0xba, 0x98, 0x8f, 0x5f, 0xb8, 0x81, 0x46, 0x59, 0x9f, 0x40, 0xfe, 0xac,
+	0xd3, 0x26, 0x26, 0xe4, 0x85, 0x3b, 0x69, 0x18, 0x87, 0xc6, 0xb3, 0x2a, 0xc5, 0x4f, 0x84, 0x70,
+	0x76, 0xd5, 0xc8, 0x1d, 0x1a, 0xcf, 0x0a, 0x14, 0x3f, 0xad, 0x7f, 0x03, 0xa8, 0xb4, 0xd8, 0x94,
+	0xc5, 0x67, 0x7c, 0x42, 0x1e, 0x41, 0x7e, 0xe6, 0x7b, 0x72, 0xc3, 0xe6, 0x67, 0x95, 0xa3, 0x38,
+	0x1a, 0x1d, 0x9d, 0x75, 0xda, 0x14, 0x81, 0xe4, 0x29, 0x94, 0x63, 0x26, 0x1c, 0xc4, 0xe7, 0xd6,
+	0xf0, 0xa5, 0x98, 0x89, 0x33, 0xdf, 0x23, 0x04, 0x0a, 0xe3, 0xa9, 0x3b, 0x69, 0xe4, 0x25, 0x7b,
+	0xf9, 0x4d, 0x1e, 0x40, 0x59, 0x84, 0x8e, 0xeb, 0x79, 0x71, 0xa3, 0x70, 0x68, 0x3c, 0xab, 0xd1,
+	0x92, 0x08, 0x9b, 0x9e, 0x17, 0x13, 0x0b, 0x8a, 0x2c, 0x8e, 0xc3, 0xb8, 0x51, 0x92, 0xdc, 0x40,
+	0x72, 0xb3, 0x11, 0x72, 0xb2, 0x41, 0x15, 0x8a, 0x7c, 0x02, 0x15, 0x77, 0x2e, 0x2e, 0x9d, 0x98,
+	0x5d, 0x35, 0xca, 0x92, 0xac, 0x26, 0xc9, 0x9a, 0x73, 0x71, 0x49, 0xd9, 0xd5, 0xc9, 0x06, 0x2d,
+	0xbb, 0xea, 0x33, 0x25, 0x75, 0x47, 0x6f, 0x1b, 0x95, 0x35, 0xd2, 0xe6, 0xe8, 0x6d, 0x42, 0xda,
+	0x1c, 0xbd, 0x25, 0x5f, 0x80, 0x39, 0x0a, 0x03, 0xcf, 0x89, 0xdc, 0x05, 0x72, 0x9e, 0x33, 0x2e,
+	0x1a, 0x55, 0xb9, 0x65, 0x57, 0x6e, 0x69, 0x85, 0x81, 0xd7, 0x77, 0x17, 0x54, 0xa1, 0x4e, 0x36,
+	0xe8, 0xf6, 0x68, 0x05, 0x42, 0x8e, 0x61, 0x27, 0xc3, 0x80, 0x47, 0x61, 0xc0, 0x59, 0x03, 0x24,
+	0x87, 0xbd, 0x55, 0x0e, 0x0a, 0x77, 0xb2, 0x41, 0xeb, 0xa3, 0x55, 0x10, 0xf9, 0x0a, 0xf6, 0x22,
+	0x77, 0x31, 0x63, 0x81, 0x70, 0x38, 0x13, 0x62, 0xca, 0x9c, 0x28, 0x0e, 0xc3, 0x71, 0x63, 0x53,
+	0xb2, 0x79, 0x20, 0xd9, 0xf4, 0x15, 0xc1, 0x40, 0xe2, 0xfb, 0x88, 0x3e, 0xd9, 0xa0, 0x24, 0xba,
+	0x05, 0x25, 0xaf, 0xe1, 0x60, 0x8d, 0x59, 0x72, 0xae, 0x9a, 0x64, 0xf7, 0xf0, 0x36, 0xbb, 0xe5,
+	0xe9, 0xf6, 0xa2, 0x3b, 0xe0, 0x64, 0x08, 0x0f, 0x6e, 0xb1, 0xd4, 0x27, 0xdd, 0x92, 0x3c, 0x1f,
+	0xdd, 0xc5, 0x33, 0x3d, 0xef, 0x7e, 0x74, 0x17, 0x82, 0x9c, 0x82, 0x79, 0xe3, 0x8b, 0x4b, 0x2f,
+	0x76, 0x6f, 0x52, 0x15, 0xb7, 0x25, 0xbb, 0x27, 0xda, 0x70, 0x61, 0xc4, 0x62, 0x57, 0xf8, 0xd7,
+	0xec, 0x6b, 0x4d, 0xb7, 0x54, 0xb4, 0x7e, 0xb3, 0x0a, 0x22, 0x3d, 0xd8, 0xc9, 0x70, 0xd3, 0xda,
+	0xd5, 0x25, 0xbb, 0xc3, 0xfb, 0xd9, 0xa5, 0x3a, 0x9a, 0x37, 0x6b, 0x30, 0xf2, 0x53, 0xa8, 0xc7,
+	0xe1, 0x5c, 0xf8, 0xc1, 0x24, 0xd5, 0xce, 0xcc, 0x38, 0x06, 0x55, 0xb8, 0x8c, 0x63, 0xc4, 0x2b,
+	0x10, 0x74, 0x0c, 0xf4, 0x09, 0x11, 0xbb, 0xa3, 0xe5, 0x15, 0xec, 0x64, 0x1c, 0xa3, 0xef, 0x2e,
+	0x86, 0x88, 0xcc, 0x1c, 0x2a, 0x5a, 0x05, 0x11, 0x1b, 0x48, 0x96, 0x87, 0x3e, 0x15, 0x91, 0x4c,
+	0xf6, 0xd7, 0x98, 0x2c, 0x8f, 0x12, 0xad, 0xc1, 0xd6, 0x9c, 0x7c, 0xc4, 0xfc, 0x48, 0x34, 0x9e,
+	0xdc, 0xe5, 0xe4, 0x12, 0xb5, 0xe2, 0xe4, 0x12, 0x42, 0x7e, 0x0b, 0xb6, 0x62, 0x76, 0xcd, 0xdc,
+	0xa9, 0xc3, 0xd9, 0x28, 0x66, 0xa2, 0x71, 0x28, 0x77, 0xef, 0x28, 0x4b, 0x48, 0xcc, 0x40, 0x22,
+	0x4e, 0x36, 0x68, 0x2d, 0xce, 0xac, 0xd1, 0x0a, 0x2b, 0x3b, 0x65, 0x4c, 0x3e, 0xcd, 0x58, 0x21,
+	0xbb, 0x5b, 0xc5, 0x66, 0x3d, 0x5e, 0x05, 0x91, 0xaf, 0xa1, 0xa1, 0xa3, 0x6b, 0x3e, 0x15, 0xce,
+	0x75, 0x38, 0x1f, 0x5d, 0xa6, 0x06, 0xb5, 0x24, 0xab, 0xc7, 0x47, 0x3a, 0x1b, 0xbe, 0x41, 0x24,
+	0xf3, 0x96, 0x31, 0x37, 0x9f, 0x0a, 0xed, 0x81, 0x6a, 0x21, 0x09, 0x12, 0xf3, 0x7e, 0x0b, 0x0f,
+	0xef, 0x60, 0xac, 0xad, 0xfc, 0xd1, 0x7b, 0x71, 0x3e, 0x58, 0xe7, 0xac, 0x76, 0x1f, 0x57, 0xa1,
+	0xac, 0x93, 0xb6, 0x35, 0x80, 0xa2, 0x4c, 0x65, 0xe4, 0x10, 0x0a, 0xa3, 0xd0, 0x63, 0x32, 0xa5,
+	0x6e, 0xeb, 0x94, 0x64, 0xc7, 0x71, 0x2b, 0xf4, 0x18, 0x95, 0x18, 0x72, 0x00, 0xa5, 0x98, 0xb9,
+	0x3c, 0x0c, 0x64, 0x5a, 0xad, 0x52, 0xbd, 0x4a, 0x52, 0x75, 0x7e, 0x99, 0xaa, 0xff, 0x38, 0x07,
+	0x65, 0x9d, 0xf9, 0x30, 0xad, 0xce, 0x16, 0x2a, 0xad, 0x1a, 0x2a, 0xad, 0xce, 0x16, 0x32, 0xad,
+	0x3e, 0x86, 0xaa, 0xf0, 0x67, 0x8c, 0x0b, 0x77, 0x16, 0xe9, 0x3c, 0xbf, 0x04, 0x90, 0x7d, 0x28,
+	0xcd, 0x16, 0x0e, 0xf7, 0x55, 0x8e, 0xae, 0xd1, 0xe2, 0x6c, 0x31, 0xf0, 0x27, 0xe4, 0x09, 0x6c,
+	0xb2, 0xef, 0x22, 0x36, 0x12, 0x4e, 0xc4, 0x58, 0x92, 0xa8, 0x41, 0x81, 0xfa, 0x8c, 0xc5, 0x48,
+	0x30, 0x9b, 0x8b, 0xb9, 0x3b, 0x75, 0x30, 0x89, 0x36, 0x8a, 0x87, 0xc6, 0xb3, 0x0a, 0x05, 0x05,
+	0x42, 0x95, 0xc8, 0x27, 0x60, 0xca, 0xd2, 0x33, 0x0a, 0xa7, 0xce, 0x35, 0x8b, 0xb9, 0x1f, 0x06,
+	0x32, 0xb1, 0x17, 0x68, 0x3d, 0x81, 0xbf, 0x51, 0x60, 0xf2, 0x3b, 0x50, 0x0f, 0x23, 0x16, 0x30,
+	0xcf, 0x19, 0x5d, 0xba, 0x41, 0xc0, 0xa6, 0xbc, 0x51, 0x3e, 0xcc, 0x2f, 0x1d, 0x53, 0x01, 0x07,
+	0xf3, 0xd9, 0xcc, 0x8d, 0x17, 0x74, 0x5b, 0xd1, 0x6a, 0x28, 0xb7, 0xfe, 0xc8, 0x50, 0x46, 0x40,
+	0x27, 0xf9, 0x18, 0xaa, 0x5c, 0xb8, 0xb1, 0x2a, 0x4a, 0xeb, 0x45, 0xab, 0x22, 0x51, 0x58, 0x96,
+	0x96, 0x87, 0xce, 0x65, 0x0f, 0xfd, 0x9b, 0xb0, 0xc5, 0x17, 0xc1, 0x68, 0xa9, 0x45, 0x5e, 0x6a,
+	0x41, 0xb2, 0x5a, 0x74, 0x02, 0x69, 0xf0, 0x1a, 0x12, 0xa6, 0x2a, 0x30, 0xa8, 0x65, 0x3d, 0x18,
+	0xf9, 0xa3, 0x4b, 0x69, 0x1d, 0x6a, 0xb4, 0x18, 0xb9, 0x8b, 0x8e, 0x87, 0x17, 0xab, 0x23, 0x47,
+	0x89, 0xd5, 0x2b, 0xf2, 0xab, 0x50, 0x0f, 0x63, 0x7f, 0xe2, 0x07, 0xee, 0xd4, 0xd1, 0xfb, 0xd4,
+	0x65, 0x6c, 0x25, 0xe0, 0x3e, 0xee, 0xb7, 0xfe, 0x10, 0xea, 0x6b, 0x81, 0x72, 0x9f, 0xa4, 0x4f,
+	0x61, 0x17, 0xc1, 0x1e, 0xe3, 0x22, 0x09, 0xb9, 0xe5, 0x69, 0x31, 0x35, 0xb4, 0x19, 0x17, 0x8a,
+	0x0b, 0x1e, 0xfc, 0x7d, 0x15, 0xf8, 0x77, 0x03, 0x36, 0x5b, 0x71, 0xc8, 0x79, 0x97, 0x89, 0xbe,
+	0xbb, 0x20, 0x8f, 0x01, 0x78, 0x3c, 0x72, 0x02, 0x26, 0x12, 0x0d, 0x0a, 0xb4, 0xc2, 0xe3, 0x51,
+	0x97, 0x89, 0x8e, 0x87, 0x58, 0x8f, 0x8b, 0x04, 0xab, 0x3c, 0xaf, 0xe2, 0x71, 0xa1, 0xb0, 0x4f,
+	0xa1, 0x96, 0x95, 0xa9, 0x05, 0x6e, 0x66, 0x04, 0x92, 0x47, 0x50, 0x19, 0xa1, 0x34, 0x3f, 0x98,
+	0x48, 0x0f, 0xac, 0xd0, 0x74, 0x8d, 0xfe, 0x77, 0x11, 0xfb, 0xde, 0x84, 0x29, 0x97, 0x2f, 0x2a,
+	0x07, 0x55, 0x20, 0xdd, 0x4d, 0x6c, 0x69, 0x02, 0xad, 0x80, 0x72, 0x3e, 0xbd, 0x4b, 0xe9, 0xd0,
+	0x80, 0x32, 0x46, 0x42, 0x38, 0x17, 0xb2, 0x99, 0x28, 0xd0, 0x64, 0x69, 0xfd, 0x6b, 0x0e, 0xb6,
+	0x57, 0xab, 0x3e, 0x79, 0x08, 0x95, 0x24, 0x7f, 0x6a, 0x63, 0x97, 0x75, 0x82, 0x24, 0x3d, 0x68,
+	0x70, 0xe1, 0x0a, 0xe6, 0x84, 0xc1, 0x74, 0x21, 0x23, 0xc6, 0x19, 0xc7, 0xe1, 0x2c, 0xb5, 0x79,
+	0x52, 0xbe, 0x07, 0xfe, 0x24, 0x60, 0xde, 0xc0, 0x9f, 0x45, 0x53, 0xf6, 0xdd, 0x00, 0x77, 0xd0,
+	0x3d, 0xb9, 0xb1, 0x17, 0x4c, 0x17, 0x18, 0x56, 0x2f, 0xe3, 0x70, 0x86, 0x17, 0xf2, 0x0c, 0x0a,
+	0x41, 0x28, 0x58, 0x23, 0xaf, 0x73, 0xa4, 0x6a, 0xec, 0x8e, 0x92, 0xc6, 0xee, 0xa8, 0x19, 0x2c,
+	0xa8, 0xa4, 0x40, 0xad, 0x2e, 0x5c, 0xce, 0x1c, 0xcc, 0x0c, 0x05, 0x75, 0x06, 0x5c, 0x0f, 0xd8,
+	0x15, 0xf9, 0x10, 0xc0, 0xf3, 0x63, 0x19, 0xc3, 0xee, 0x42, 0x47, 0x68, 0x55, 0x41, 0x50, 0xe9,
+	0x4f, 0xa1, 0x2a, 0xad, 0x89, 0xf6, 0xd1, 0x2d, 0x97, 0xa9, 0x3c, 0x7d, 0x79, 0xc3, 0xda, 0xe0,
+	0x5d, 0x26, 0x8f, 0xaf, 0x2a, 0x90, 0xef, 0x49, 0x63, 0x55, 0x69, 0x59, 0xae, 0x3b, 0x1e, 0xd9,
+	0x83, 0x22, 0x96, 0x3d, 0xd6, 0xa8, 0x1c, 0xe6, 0xd1, 0x07, 0xe5, 0x02, 0xd3, 0xd5, 0x98, 0x31,
+	0xd9, 0x47, 0xd5, 0x28, 0x7e, 0x5a, 0x1c, 0xea, 0x6b, 0x7d, 0x10, 0xf9, 0x29, 0x6c, 0x2b, 0xcb,
+	0x8d, 0x42, 0x2e, 0x8d, 0xd3, 0x30, 0xde, 0x6d, 0xaf, 0x2d, 0x49, 0xde, 0xd2, 0xd4, 0xe4, 0x30,
+	0xe9, 0x19, 0x73, 0xeb, 0x3d, 0xa3, 0xee, 0x18, 0xad, 0x3f, 0x31, 0xa0, 0xd4, 0x77, 0x17, 0x27,
+	0x61, 0x74, 0x5f, 0xb0, 0x58, 0xb0, 0x15, 0xc5, 0xec, 0xda, 0xb9, 0x0c, 0x23, 0xe5, 0x4c, 0x2a,
+	0x4c, 0x36, 0x11, 0x78, 0x12, 0x46, 0x89, 0x37, 0x05, 0xec, 0x3b, 0xb1, 0xa4, 0xd1, 0xee, 0x8a,
+	0xc0, 0x84, 0xe6, 0x31, 0xe4, 0x59, 0xac, 0x72, 0xe5, 0xaa, 0x26, 0x08, 0xb6, 0xda, 0x50, 0x53,
+	0xc7, 0xd1, 0xca, 0xa0, 0x54, 0x77, 0x21, 0x19, 0x5e, 0x2c, 0x04, 0xe3, 0x5a, 0xa7, 0xcd, 0x48,
+	0xa2, 0x8f, 0x11, 0x24, 0x33, 0x7e, 0x1a, 0xb6, 0xf8, 0x69, 0xfd, 0x08, 0xca, 0x7d, 0x77, 0xd1,
+	0x77, 0xc5, 0x25, 0xf9, 0x18, 0x0a, 0x97, 0x61, 0x84, 0xfb, 0xf2, 0x69, 0x15, 0xce, 0x4a, 0xa0,
+	0x12, 0x6d, 0xfd, 0xb3, 0x01, 0xdb, 0xaa, 0xe7, 0xf2, 0x74, 0x6b, 0x46, 0x7e, 0x00, 0xdb, 0xaa,
+	0x83, 0xf3, 0x9c, 0x15, 0x7b, 0xd4, 0x78, 0x4a, 0xd7, 0xf1, 0xc8, 0x8f, 0x56, 0xca, 0xd0, 0xf6,
+	0x67, 0x8d, 0xbb, 0xda, 0x3b, 0xc4, 0xa7, 0x05, 0xea, 0x00, 0x4a, 0xee, 0x2c, 0x9c, 0x07, 0x42,
+	0x5b, 0x47, 0xaf, 0xb0, 0xe4, 0x45, 0xae, 0xb8, 0xd4, 0x96, 0xa9, 0x25, 0x7c, 0xf0, 0x14, 0x54,
+	0x62, 0xee, 0x4a, 0x40, 0xc5, 0xbb, 0x12, 0xd0, 0xdf, 0x18, 0x40, 0x6e, 0xf7, 0xc0, 0xe4, 0x1c,
+	0x1a, 0xd7, 0xaa, 0x32, 0x3b, 0xd9, 0x36, 0x7c, 0x3e, 0x15, 0x89, 0x79, 0xde, 0x59, 0xc1, 0xe9,
+	0xfe, 0xf5, 0x1d, 0x50, 0x4e, 0x3e, 0x87, 0x5a, 0xc6, 0x4e, 0xbc, 0x91, 0xcb, 0x14, 0xa5, 0x55,
+	0x93, 0xd2, 0xcd, 0xa5, 0xe9, 0xb8, 0xf5, 0x8f, 0x06, 0xec, 0xdd, 0xd5, 0x5a, 0xdf, 0x62, 0x68,
+	0xbc, 0x1f, 0xc3, 0xef, 0x3f, 0xbf, 0x64, 0xb3, 0x46, 0x7e, 0x25, 0x6b, 0x58, 0x0b, 0xd8, 0xbf,
+	0xb3, 0x85, 0xff, 0xfe, 0x42, 0x35, 0x7f, 0x5f, 0xa8, 0xfe, 0x8b, 0x01, 0xa4, 0x17, 0xb1, 0x40,
+	0xd7, 0xd5, 0xc4, 0x6a, 0x2f, 0x60, 0x57, 0x57, 0x64, 0xc7, 0x0f, 0x7c, 0xe1, 0xbb, 0x53, 0xff,
+	0xf7, 0x59, 0xd2, 0xe5, 0x90, 0x51, 0x52, 0x97, 0x53, 0x0c, 0xf9, 0x08, 0x1b, 0x55, 0xb9, 0x97,
+	0xc5, 0x99, 0xba, 0x57, 0x4b, 0x81, 0x68, 0x82, 0x5f, 0x83, 0x32, 0x36, 0x12, 0xce, 0x85, 0x2a,
+	0x3d, 0xdb, 0xba, 0xcc, 0x67, 0xe4, 0x1f, 0x2f, 0x68, 0x09, 0x49, 0x8e, 0x65, 0xa1, 0x0b, 0x79,
+	0xe4, 0x88, 0xd0, 0x09, 0x79, 0x94, 0xd4, 0xa2, 0x90, 0x47, 0xc3, 0xb0, 0xc7, 0x23, 0x59, 0x19,
+	0x2e, 0x5d, 0x3f, 0x48, 0xdc, 0xb6, 0x40, 0xcb, 0x72, 0xdd, 0xf1, 0xac, 0xff, 0x30, 0x60, 0x77,
+	0xe5, 0x48, 0xda, 0x98, 0xff, 0x3f, 0x67, 0x7a, 0x0a, 0x35, 0x37, 0x8a, 0xe2, 0xf0, 0x5a, 0xd3,
+	0xe8, 0x24, 0x95, 0xc0, 0x90, 0xe4, 0x08, 0x4a, 0x78, 0x2d, 0x73, 0x2e, 0x4f, 0xb1, 0xfd, 0xd9,
+	0xc1, 0xfa, 0xa9, 0x07, 0x12, 0x4b, 0x35, 0x15, 0xf9, 0x75, 0x48, 0x9e, 0x97, 0x4e, 0xaa, 0x70,
+	0x12, 0x9c, 0xa6, 0xc6, 0x24, 0xad, 0x91, 0x67, 0xfd, 0xa9, 0x01, 0x8f, 0xee, 0x7f, 0xb1, 0x91,
+	0x36, 0x6c, 0xa5, 0xcf, 0x33, 0x3f, 0x18, 0x87, 0xda, 0x83, 0x9e, 0x24, 0xc1, 0x79, 0xc7, 0xd6,
+	0x4e, 0x30, 0x0e, 0x69, 0xed, 0x26, 0xb3, 0x7a, 0x2f, 0x53, 0x58, 0x7f, 0x6f, 0xc0, 0x07, 0xef,
+	0x78, 0xec, 0xfd, 0x12, 0x55, 0x79, 0x8f, 0x5b, 0xb1, 0xfe, 0xcb, 0xc8, 0xb4, 0x1b, 0xea, 0xb5,
+	0x75, 0x4f, 0xb1, 0x3a, 0x84, 0xda, 0xb2, 0xb3, 0x4b, 0x05, 0x42, 0xd2, 0xd2, 0xf9, 0x13, 0xf2,
+	0x5c, 0x3d, 0x39, 0x3d, 0x36, 0x65, 0x13, 0x57, 0x84, 0x59, 0x99, 0x75, 0x49, 0xa6, 0xe1, 0x48,
+	0xfb, 0x05, 0x98, 0x9a, 0xce, 0x0f, 0x03, 0x3d, 0x6f, 0x28, 0x64, 0xde, 0x65, 0xed, 0x14, 0x29,
+	0x13, 0x2d, 0xad, 0x7b, 0xab, 0x80, 0xf7, 0x4d, 0xdc, 0x2b, 0xdd, 0x43, 0x69, 0xa5, 0x7b, 0x40,
+	0x9f, 0x21, 0xb7, 0xb3, 0x07, 0x9a, 0x96, 0xab, 0xb5, 0x23, 0xf3, 0x48, 0x5a, 0xa3, 0xb2, 0x44,
+	0x3f, 0x04, 0x93, 0xfb, 0x13, 0x27, 0x1c, 0x2f, 0x93, 0xa2, 0xb6, 0xc8, 0x16, 0xf7, 0x27, 0xbd,
+	0x71, 0x92, 0xf3, 0xc8, 0x47, 0xb0, 0x9d, 0x25, 0x14, 0x61, 0x72, 0x0b, 0x29, 0xd9, 0x30, 0xb4,
+	0x06, 0xb0, 0xa3, 0x14, 0x69, 0xcf, 0x97, 0x22, 0x30, 0xed, 0x65, 0xf5, 0x48, 0xb2, 0xf6, 0x3b,
+	0xd2, 0x5e, 0x66, 0xc5, 0xad, 0x97, 0xb0, 0x89, 0xec, 0xb1, 0x43, 0x60, 0x9c, 0x63, 0xcb, 0xe9,
+	0xaa, 0x4f, 0x3d, 0x85, 0x4b, 0x96, 0xd8, 0xae, 0x89, 0xf0, 0x2d, 0x0b, 0x96, 0x3d, 0x48, 0x95,
+	0x56, 0x25, 0x04, 0xf7, 0x5a, 0x63, 0x00, 0xe4, 0xa3, 0xc2, 0x13, 0x7d, 0x6a, 0x1c, 0x33, 0xe6,
+	0x5c, 0xb8, 0x53, 0x37, 0x18, 0x31, 0xcd, 0x6b, 0x13, 0x61, 0xc7, 0x0a, 0x44, 0x7e, 0x03, 0x36,
+	0x7f, 0x2f, 0xf4, 0x03, 0x47, 0x87, 0xbb, 0x2a, 0xe2, 0xea, 0x5a, 0xbf, 0x0c, 0xfd, 0x40, 0x8e,
+	0xf8, 0x74, 0xb0, 0x03, 0x12, 0xaa, 0x6f, 0xeb, 0x2f, 0xd1, 0x15, 0x57, 0x5e, 0x5c, 0xa8, 0x59,
+	0x26, 0xf6, 0xd5, 0x3d, 0x54, 0x93, 0x1c, 0x25, 0xfb, 0x7c, 0x7c, 0x4d, 0xb1, 0x2b, 0x27, 0x98,
+	0xcf, 0x92, 0x3e, 0x7f, 0xb6, 0x18, 0xb0, 0xab, 0xee, 0x7c, 0x26, 0x1d, 0x16, 0x4d, 0x9e, 0xe0,
+	0x55, 0xb9, 0x01, 0x84, 0x69, 0x8a, 0x27, 0xb0, 0x39, 0x65, 0xde, 0x84, 0xc5, 0xd9, 0xa1, 0x20,
+	0x28, 0x90, 0x3c, 0xfa, 0xdf, 0xe6, 0x61, 0x6b, 0xe5, 0xf9, 0x85, 0x8d, 0xd1, 0x28, 0x55, 0x05,
+	0x3f, 0xd1, 0x5d, 0x12, 0x1d, 0x95, 0xbb, 0xa0, 0x1e, 0x79, 0x5a, 0x1b, 0x2d, 0xb3, 0x1a, 0x0e,
+	0x9b, 0xf6, 0x65, 0xce, 0x4f, 0x28, 0xd3, 0x67, 0xbe, 0x2a, 0x49, 0x8d, 0xf5, 0x5c, 0x98, 0x64,
+	0x0b, 0xba, 0x1b, 0xde, 0x06, 0x92, 0x9f, 0x41, 0x1d, 0xdf, 0xbe, 0xee, 0xe8, 0xad, 0xa3, 0xaf,
+	0x5c, 0xc7, 0xce, 0xbd, 0xae, 0xb1, 0xad, 0xe9, 0x35, 0x90, 0xfc, 0x04, 0x6a, 0x09, 0x07, 0xd9,
+	0x0f, 0x14, 0x33, 0xad, 0x1c, 0xc6, 0x4d, 0xa0, 0xdf, 0xb5, 0x74, 0x53, 0x93, 0xc9, 0x6e, 0x40,
+	0xcb, 0x8d, 0xd9, 0x55, 0x2a, 0xb7, 0xf4, 0x1e, 0x72, 0x63, 0x76, 0xb5, 0x26, 0x17, 0x39, 0x48,
+	0xb9, 0xe5, 0x77, 0xca, 0x8d, 0xd9, 0x95, 0x94, 0xbb, 0x76, 0x4f, 0x95, 0x5b, 0xf7, 0xf4, 0xbb,
+	0x50, 0xcb, 0xee, 0xc6, 0x5b, 0x5a, 0x3e, 0x96, 0xf0, 0x33, 0x7d, 0xd7, 0xe4, 0xfe, 0xc7, 0x77,
+	0xcd, 0x1e, 0x14, 0xd5, 0x3d, 0xe6, 0xe5, 0x3d, 0xaa, 0x85, 0xf5, 0x0f, 0x06, 0xec, 0x2f, 0x73,
+	0x52, 0x9b, 0xf1, 0x51, 0xec, 0x47, 0xf8, 0x89, 0x53, 0x8e, 0x34, 0xe3, 0x25, 0x2e, 0x9a, 0x02,
+	0x32, 0x58, 0xc6, 0x74, 0x82, 0x58, 0x02, 0xc8, 0x11, 0xec, 0xb2, 0xef, 0x22, 0x3f, 0x66, 0xdc,
+	0x71, 0xc7, 0x98, 0xc9, 0x2f, 0xa6, 0xe1, 0xe8, 0xad, 0x96, 0xbc, 0xa3, 0x51, 0x4d, 0xc4, 0x1c,
+	0x23, 0x02, 0x33, 0xac, 0x8a, 0x54, 0x11, 0x26, 0x69, 0x96, 0x35, 0x0a, 0xf2, 0xed, 0x53, 0x97,
+	0x88, 0x61, 0xa8, 0x95, 0x64, 0xd6, 0x9f, 0x19, 0x50, 0x5f, 0xcb, 0xa2, 0xe4, 0x67, 0xf0, 0x38,
+	0x93, 0x75, 0xbd, 0xe5, 0x29, 0x56, 0x5e, 0x02, 0x8f, 0xbc, 0xbb, 0x0e, 0xaa, 0x1e, 0x06, 0x8f,
+	0xa1, 0x8a, 0x5d, 0x95, 0x2b, 0xe6, 0x71, 0x7a, 0x9e, 0x14, 0x20, 0xe7, 0x0c, 0xe8, 0x04, 0xc9,
+	0x2b, 0x45, 0xaf, 0xac, 0x2f, 0x60, 0x67, 0xa9, 0x4a, 0x52, 0x93, 0x9f, 0x43, 0x51, 0xe5, 0x7d,
+	0xe3, 0x1d, 0x79, 0x5f, 0x91, 0x58, 0xcf, 0x81, 0x64, 0x19, 0xe8, 0x38, 0xd8, 0x4b, 0x1a, 0x3b,
+	0x95, 0x84, 0xd4, 0xc2, 0xfa, 0x1c, 0x0e, 0x5e, 0xcf, 0x59, 0xbc, 0xb8, 0x2d, 0x71, 0xe5, 0x32,
+	0x8c, 0xb5, 0xcb, 0xb0, 0x6c, 0x78, 0x70, 0x6b, 0x9f, 0x16, 0xf4, 0xbf, 0x51, 0x35, 0x86, 0xfd,
+	0x33, 0x7f, 0x12, 0x63, 0x03, 0xba, 0xda, 0x4d, 0xfe, 0x04, 0x0e, 0x92, 0xf0, 0x9f, 0x49, 0x02,
+	0xb4, 0x7b, 0xda, 0x01, 0xd4, 0xe8, 0x9e, 0xc6, 0x9e, 0x25, 0xc8, 0xf7, 0xef, 0x39, 0x7e, 0x1b,
+	0x0e, 0xd6, 0x65, 0x6a, 0xcd, 0xd7, 0x5b, 0x00, 0xe3, 0x76, 0x0b, 0xf0, 0x4f, 0x06, 0xec, 0xbd,
+	0x92, 0x8f, 0xee, 0x13, 0x9f, 0x8b, 0x30, 0x4e, 0xe7, 0x0e, 0x04, 0x0a, 0x72, 0x06, 0xa7, 0xac,
+	0x2b, 0xbf, 0xc9, 0x07, 0x50, 0xbd, 0x60, 0xe3, 0x30, 0x66, 0x8e, 0xe0, 0x3a, 0xd3, 0x55, 0x14,
+	0x60, 0xc8, 0xf1, 0x79, 0xe7, 0x0b, 0x36, 0xe3, 0x4e, 0xc4, 0x62, 0x27, 0x72, 0x27, 0x2a, 0x86,
+	0x8a, 0xb4, 0x26, 0xa1, 0x7d, 0x16, 0xf7, 0xdd, 0x09, 0xc3, 0xfe, 0x42, 0x70, 0xa9, 0x8c, 0x4a,
+	0xb8, 0x45, 0xc1, 0xb1, 0x23, 0xd8, 0x86, 0x9c, 0xe0, 0xba, 0x8b, 0xcd, 0x09, 0x8e, 0x05, 0x9e,
+	0xcf, 0xdc, 0xe9, 0x14, 0xfb, 0x0d, 0x5d, 0xe0, 0x55, 0xfd, 0xde, 0x4a, 0xc0, 0xea, 0x65, 0xf6,
+	0x77, 0x06, 0x98, 0xbd, 0x80, 0x29, 0xdd, 0xfd, 0x91, 0x1a, 0xe0, 0x98, 0x90, 0xf7, 0xb8, 0x48,
+	0xfe, 0x6e, 0xf2, 0xb8, 0x40, 0x5f, 0x91, 0x11, 0xa2, 0xeb, 0x9b, 0x5a, 0x20, 0x9d, 0x3b, 0x53,
+	0xaf, 0xc6, 0x2a, 0xc5, 0xcf, 0x65, 0xf8, 0x17, 0x32, 0xe1, 0x9f, 0xe9, 0x89, 0x8a, 0x6a, 0xbb,
+	0xea, 0x89, 0x3e, 0xc0, 0x49, 0x06, 0xc3, 0xa7, 0x89, 0xe0, 0x52, 0xbb, 0x3c, 0xad, 0x28, 0xc0,
+	0x50, 0xbd, 0xa1, 0xe3, 0x91, 0x1e, 0x59, 0xe0, 0xa7, 0x75, 0x0c, 0xfb, 0x6b, 0x86, 0xd6, 0xb7,
+	0xf4, 0x09, 0x14, 0x32, 0xcf, 0x32, 0x35, 0x5a, 0x5f, 0x3f, 0x13, 0x95, 0x24, 0xd6, 0x29, 0x98,
+	0x8a, 0xc7, 0x4b, 0x96, 0xbe, 0xee, 0xd2, 0xb3, 0x19, 0x6b, 0x67, 0x43, 0x1b, 0xe4, 0x96, 0x36,
+	0xb8, 0x75, 0x5a, 0xeb, 0x63, 0xd8, 0xc9, 0x70, 0xd3, 0xda, 0xe8, 0xf9, 0x89, 0x36, 0x1e, 0xce,
+	0x4f, 0xfe, 0x00, 0x48, 0xe2, 0x58, 0xea, 0x5f, 0x06, 0xe9, 0x9a, 0x99, 0x5a, 0x58, 0x55, 0xb5,
+	0xb0, 0x01, 0xe5, 0xa4, 0x2f, 0x50, 0x62, 0x93, 0x65, 0xfa, 0xee, 0x43, 0xc6, 0xf9, 0x04, 0xc5,
+	0xd9, 0x4b, 0xc6, 0xb0, 0x4e, 0x8f, 0x19, 0x73, 0xd0, 0x7b, 0x9d, 0x28, 0x9a, 0xe9, 0x61, 0x12,
+	0x8c, 0x19, 0xa3, 0xae, 0x60, 0xfd, 0x68, 0x66, 0xfd, 0x95, 0x01, 0x5b, 0x5a, 0xf0, 0x79, 0xe4,
+	0xe1, 0x7d, 0x1c, 0x40, 0x49, 0xb5, 0x79, 0x5a, 0xba, 0x5e, 0x69, 0x27, 0xca, 0xa5, 0x4e, 0xf4,
+	0x63, 0xf9, 0x40, 0xca, 0xce, 0x54, 0x1f, 0x64, 0x67, 0xaa, 0x99, 0xd3, 0xd0, 0x94, 0x10, 0x43,
+	0x4e, 0xd6, 0x85, 0x74, 0x7a, 0xac, 0x34, 0xaa, 0x49, 0xa0, 0x1e, 0x1d, 0x5b, 0xaf, 0x61, 0x57,
+	0xd5, 0xbb, 0x5b, 0x8a, 0xcd, 0xe5, 0x57, 0x32, 0x0b, 0x57, 0xab, 0xdb, 0x03, 0x15, 0x84, 0x08,
+	0x31, 0x4d, 0x86, 0xea, 0x42, 0x4c, 0xad, 0x5f, 0xc0, 0xf6, 0xea, 0xdf, 0x3a, 0xe4, 0x33, 0x28,
+	0xab, 0xfd, 0x89, 0x6b, 0x34, 0x32, 0x85, 0x76, 0x45, 0x30, 0x4d, 0x08, 0xd5, 0xac, 0x37, 0xf0,
+	0x58, 0xd2, 0xc9, 0xe9, 0x95, 0xf5, 0x9f, 0x86, 0x2c, 0x92, 0xf2, 0xaf, 0x99, 0x41, 0xe4, 0x06,
+	0xf7, 0xf5, 0xf9, 0xd9, 0x86, 0x39, 0xb7, 0x3a, 0x6e, 0x23, 0x58, 0x44, 0x3d, 0xa6, 0x93, 0xbb,
+	0xfc, 0x46, 0xf2, 0x64, 0x86, 0xa5, 0xe3, 0xb9, 0xac, 0xc7, 0x57, 0x88, 0x4a, 0x46, 0x57, 0xba,
+	0x37, 0x2f, 0xeb, 0xa9, 0x15, 0xfe, 0x67, 0x10, 0xb3, 0xd1, 0xf5, 0x32, 0x6c, 0x4a, 0xb8, 0x1c,
+	0x72, 0x54, 0x6a, 0x7c, 0xe3, 0x21, 0xbc, 0xac, 0xe2, 0x6f, 0x7c, 0xe3, 0x0d, 0x65, 0x8b, 0xaa,
+	0xff, 0x39, 0x42, 0x54, 0x45, 0xa2, 0xaa, 0x1a, 0x32, 0x94, 0x67, 0x96, 0xb9, 0x9f, 0x37, 0xaa,
+	0x87, 0x79, 0x3c, 0xb3, 0x5a, 0x59, 0x13, 0xa8, 0xaf, 0xfd, 0xcd, 0x75, 0xdf, 0xa9, 0x7f, 0x05,
+	0xc0, 0x0b, 0x6f, 0x02, 0x2e, 0x62, 0xe6, 0xaa, 0x56, 0xb2, 0x42, 0x33, 0x10, 0x54, 0x40, 0x4f,
+	0x68, 0x9d, 0x19, 0x6f, 0xe4, 0x97, 0x7f, 0x66, 0x84, 0x73, 0x71, 0xc6, 0xad, 0x18, 0xcc, 0xf5,
+	0xbf, 0xc2, 0xfe, 0xaf, 0x92, 0x7e, 0x08, 0x45, 0x1e, 0xb9, 0x41, 0xe2, 0xaf, 0x3b, 0x2b, 0xff,
+	0xb3, 0xe1, 0xc5, 0x51, 0x85, 0x7f, 0xfe, 0xe7, 0x39, 0x28, 0xeb, 0xff, 0x6f, 0x48, 0x09, 0x72,
+	0xbd, 0xaf, 0xcc, 0x0d, 0x62, 0x42, 0xed, 0xbc, 0xdb, 0x3c, 0x1f, 0x9e, 0xf4, 0x68, 0xe7, 0xe7,
+	0x76, 0xdb, 0x34, 0x48, 0x1d, 0x36, 0x3b, 0xdd, 0x37, 0xcd, 0xd3, 0x4e, 0xdb, 0x19, 0x74, 0x5e,
+	0x99, 0x39, 0xb2, 0x0b, 0xf5, 0x4e, 0xb7, 0xd5, 0xa3, 0xd4, 0x6e, 0x0d, 0x9d, 0xd6, 0x69, 0xaf,
+	0xf5, 0x95, 0x99, 0x27, 0xdb, 0x00, 0x5f, 0xd3, 0x5e, 0xf7, 0x95, 0xd3, 0xb7, 0x6d, 0x6a, 0x16,
+	0x14, 0x91, 0xde, 0x65, 0xbf, 0x76, 0xba, 0xe7, 0x67, 0x66, 0x91, 0x10, 0xd8, 0xee, 0x37, 0xbf,
+	0x75, 0x68, 0xef, 0x7c, 0x68, 0x3b, 0xa7, 0xbd, 0x5e, 0xdf, 0x2c, 0x21, 0x61, 0xb7, 0xa7, 0x41,
+	0xc3, 0x9e, 0xd3, 0x1e, 0x0c, 0xcd, 0x32, 0x39, 0x00, 0xd2, 0xed, 0x0d, 0x1d, 0xbb, 0xdb, 0x3b,
+	0x7f, 0x75, 0xe2, 0x1c, 0x37, 0x4f, 0x9b, 0xdd, 0x96, 0x6d, 0x56, 0x90, 0x18, 0xf9, 0x3b, 0x88,
+	0xec, 0x75, 0x4f, 0x3b, 0x5d, 0xdb, 0xac, 0xa2, 0xe8, 0xb3, 0xce, 0xa0, 0xe5, 0xd8, 0x94, 0xf6,
+	0xa8, 0x09, 0x64, 0x0f, 0xcc, 0x4e, 0x77, 0x70, 0xfe, 0xf2, 0x65, 0xa7, 0xd5, 0xb1, 0xbb, 0x43,
+	0xe7, 0xa5, 0x6d, 0x9b, 0x9b, 0x78, 0x30, 0xda, 0x44, 0xb1, 0x9d, 0xb3, 0xce, 0xd0, 0x6e, 0x9b,
+	0x35, 0x52, 0x83, 0x4a, 0x9b, 0x36, 0x3b, 0xdd, 0x4e, 0xf7, 0x95, 0xb9, 0xf5, 0xfc, 0xaf, 0x0d,
+	0xd8, 0xbd, 0x63, 0x42, 0x48, 0x2a, 0x50, 0xe8, 0xf6, 0xba, 0xb6, 0xb9, 0x81, 0x86, 0x40, 0xed,
+	0xed, 0x6f, 0xfa, 0x1d, 0x2a, 0x2d, 0x63, 0x42, 0x4d, 0x1e, 0xc7, 0xfe, 0xd2, 0x6e, 0x21, 0xcb,
+	0x1c, 0x69, 0xc0, 0x9e, 0x82, 0x0c, 0x7a, 0xa7, 0x6f, 0xec, 0xb6, 0xd3, 0xeb, 0xb6, 0x4e, 0x9a,
+	0x9d, 0xae, 0x99, 0x4f, 0x68, 0xfb, 0xcd, 0x4e, 0xdb, 0x39, 0x6b, 0x7e, 0x63, 0x16, 0x12, 0xda,
+	0xb6, 0x3d, 0x18, 0x3a, 0xe7, 0x5d, 0x6a, 0x37, 0x5b, 0x27, 0xcd, 0xe3, 0x53, 0xdb, 0x2c, 0x26,
+	0x82, 0xde, 0xf4, 0xce, 0x5b, 0x27, 0x76, 0xdb, 0x2c, 0x3d, 0xff, 0x05, 0x6c, 0xad, 0x0c, 0x77,
+	0xc8, 0x3e, 0xec, 0x9c, 0x77, 0xdb, 0xf6, 0xcb, 0x4e, 0x17, 0x85, 0xf4, 0xed, 0xae, 0x73, 0xfc,
+	0xad, 0xb9, 0x41, 0x1e, 0xc2, 0xbe, 0x5c, 0xb4, 0x4e, 0x9a, 0xdd, 0xae, 0x7d, 0xea, 0xf4, 0x69,
+	0xaf, 0xdf, 0x1b, 0xd8, 0xd4, 0x34, 0x6e, 0xa1, 0x9a, 0xfd, 0x3e, 0xed, 0xbd, 0xb1, 0xa9, 0x99,
+	0x7b, 0xfe, 0x17, 0x06, 0xec, 0xdc, 0x9a, 0xa2, 0x90, 0xa7, 0xf0, 0xe1, 0x9a, 0x88, 0x64, 0xeb,
+	0x60, 0xd8, 0x1c, 0x9e, 0x0f, 0xcc, 0x8d, 0xfb, 0x78, 0xa2, 0x69, 0x3e, 0x84, 0x87, 0x2b, 0xa8,
+	0xe1, 0x37, 0xce, 0xe0, 0xfc, 0xf8, 0xac, 0x33, 0x54, 0x76, 0xfa, 0x00, 0x1e, 0xac, 0xa2, 0x5b,
+	0xc7, 0x52, 0x86, 0xdd, 0x36, 0xf3, 0xcf, 0x3f, 0x87, 0xfa, 0xda, 0x2b, 0x0f, 0xaf, 0x0a, 0xaf,
+	0xfc, 0xcb, 0x5e, 0xa7, 0x6b, 0x6e, 0x90, 0x2a, 0x14, 0x4f, 0x7b, 0xad, 0xe6, 0xa9, 0x69, 0x10,
+	0x80, 0x12, 0xb5, 0xcf, 0x7a, 0x43, 0xdb, 0xcc, 0x1d, 0xbf, 0x86, 0x87, 0x01, 0x13, 0x37, 0x61,
+	0xfc, 0xf6, 0x68, 0x84, 0x7b, 0x8f, 0x26, 0xa1, 0xfa, 0x9d, 0xf1, 0xc9, 0x71, 0xed, 0x4c, 0xfd,
+	0x9b, 0xd9, 0xc7, 0x14, 0xdc, 0x37, 0x7e, 0xfe, 0x83, 0x89, 0x2f, 0x2e, 0xe7, 0x17, 0x47, 0xa3,
+	0x70, 0xf6, 0x42, 0x52, 0x7d, 0xaa, 0xf7, 0xbe, 0x98, 0x84, 0x52, 0xf2, 0x8b, 0x38, 0x1a, 0x5d,
+	0x94, 0x64, 0xc6, 0xfe, 0xf1, 0x7f, 0x0f, 0x00, 0xeb, 0x69, 0xc6, 0x38, 0xc1, 0x22, 0x00, 0x00,
 }
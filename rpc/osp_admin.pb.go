@@ -62,6 +62,40 @@ func (DepositState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_a58c2d65cdc11488, []int{0}
 }
 
+type BatchOpenChannelState int32
+
+const (
+	BatchOpenChannelState_BatchOpen_PENDING      BatchOpenChannelState = 0
+	BatchOpenChannelState_BatchOpen_CO_SIGNED    BatchOpenChannelState = 1
+	BatchOpenChannelState_BatchOpen_TX_SUBMITTED BatchOpenChannelState = 2
+	BatchOpenChannelState_BatchOpen_OPENED       BatchOpenChannelState = 3
+	BatchOpenChannelState_BatchOpen_FAILED       BatchOpenChannelState = 4
+)
+
+var BatchOpenChannelState_name = map[int32]string{
+	0: "BatchOpen_PENDING",
+	1: "BatchOpen_CO_SIGNED",
+	2: "BatchOpen_TX_SUBMITTED",
+	3: "BatchOpen_OPENED",
+	4: "BatchOpen_FAILED",
+}
+
+var BatchOpenChannelState_value = map[string]int32{
+	"BatchOpen_PENDING":      0,
+	"BatchOpen_CO_SIGNED":    1,
+	"BatchOpen_TX_SUBMITTED": 2,
+	"BatchOpen_OPENED":       3,
+	"BatchOpen_FAILED":       4,
+}
+
+func (x BatchOpenChannelState) String() string {
+	return proto.EnumName(BatchOpenChannelState_name, int32(x))
+}
+
+func (BatchOpenChannelState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{1}
+}
+
 // Next Tag: 3
 type RegisterStreamRequest struct {
 	PeerRpcAddress       string   `protobuf:"bytes,1,opt,name=peer_rpc_address,json=peerRpcAddress,proto3" json:"peer_rpc_address,omitempty"`
@@ -110,13 +144,15 @@ func (m *RegisterStreamRequest) GetPeerEthAddress() []byte {
 	return nil
 }
 
-// Next Tag: 5
+// Next Tag: 7
 type SendTokenRequest struct {
-	DstAddr              string   `protobuf:"bytes,1,opt,name=dst_addr,json=dstAddr,proto3" json:"dst_addr,omitempty"`
-	AmtWei               string   `protobuf:"bytes,2,opt,name=amt_wei,json=amtWei,proto3" json:"amt_wei,omitempty"`
-	TokenAddr            string   `protobuf:"bytes,3,opt,name=token_addr,json=tokenAddr,proto3" json:"token_addr,omitempty"`
-	Note                 *any.Any `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
-	DstNetId             uint64   `protobuf:"varint,5,opt,name=dst_net_id,json=dstNetId,proto3" json:"dst_net_id,omitempty"`
+	DstAddr   string   `protobuf:"bytes,1,opt,name=dst_addr,json=dstAddr,proto3" json:"dst_addr,omitempty"`
+	AmtWei    string   `protobuf:"bytes,2,opt,name=amt_wei,json=amtWei,proto3" json:"amt_wei,omitempty"`
+	TokenAddr string   `protobuf:"bytes,3,opt,name=token_addr,json=tokenAddr,proto3" json:"token_addr,omitempty"`
+	Note      *any.Any `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	DstNetId  uint64   `protobuf:"varint,5,opt,name=dst_net_id,json=dstNetId,proto3" json:"dst_net_id,omitempty"`
+	// relay fee sent along with the pay to cover the fees of the osps on the path, optional
+	FeeWei               string   `protobuf:"bytes,6,opt,name=fee_wei,json=feeWei,proto3" json:"fee_wei,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -182,6 +218,13 @@ func (m *SendTokenRequest) GetDstNetId() uint64 {
 	return 0
 }
 
+func (m *SendTokenRequest) GetFeeWei() string {
+	if m != nil {
+		return m.FeeWei
+	}
+	return ""
+}
+
 // Next Tag: 4
 type SendTokenResponse struct {
 	Status               int32    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
@@ -537,6 +580,130 @@ func (m *OspOpenChannelRequest) GetPeerDepositAmtWei() string {
 	return ""
 }
 
+// Admin request to ask the receiving osp to open channels with multiple peers.
+// Next tag: 2
+type OspBatchOpenChannelRequest struct {
+	Channels             []*OspOpenChannelRequest `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *OspBatchOpenChannelRequest) Reset()         { *m = OspBatchOpenChannelRequest{} }
+func (m *OspBatchOpenChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*OspBatchOpenChannelRequest) ProtoMessage()    {}
+func (*OspBatchOpenChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{8}
+}
+
+func (m *OspBatchOpenChannelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OspBatchOpenChannelRequest.Unmarshal(m, b)
+}
+func (m *OspBatchOpenChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OspBatchOpenChannelRequest.Marshal(b, m, deterministic)
+}
+func (m *OspBatchOpenChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OspBatchOpenChannelRequest.Merge(m, src)
+}
+func (m *OspBatchOpenChannelRequest) XXX_Size() int {
+	return xxx_messageInfo_OspBatchOpenChannelRequest.Size(m)
+}
+func (m *OspBatchOpenChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OspBatchOpenChannelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OspBatchOpenChannelRequest proto.InternalMessageInfo
+
+func (m *OspBatchOpenChannelRequest) GetChannels() []*OspOpenChannelRequest {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+// Progress of one channel in a batch open, streamed on each state change.
+// Next tag: 7
+type BatchOpenChannelProgress struct {
+	// index of the channel in OspBatchOpenChannelRequest
+	Index          uint32                `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	PeerEthAddress []byte                `protobuf:"bytes,2,opt,name=peer_eth_address,json=peerEthAddress,proto3" json:"peer_eth_address,omitempty"`
+	TokenAddress   []byte                `protobuf:"bytes,3,opt,name=token_address,json=tokenAddress,proto3" json:"token_address,omitempty"`
+	State          BatchOpenChannelState `protobuf:"varint,4,opt,name=state,proto3,enum=rpc.BatchOpenChannelState" json:"state,omitempty"`
+	// hex string of channel id, set once the initializer is co-signed
+	Cid                  string   `protobuf:"bytes,5,opt,name=cid,proto3" json:"cid,omitempty"`
+	Error                string   `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchOpenChannelProgress) Reset()         { *m = BatchOpenChannelProgress{} }
+func (m *BatchOpenChannelProgress) String() string { return proto.CompactTextString(m) }
+func (*BatchOpenChannelProgress) ProtoMessage()    {}
+func (*BatchOpenChannelProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{9}
+}
+
+func (m *BatchOpenChannelProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchOpenChannelProgress.Unmarshal(m, b)
+}
+func (m *BatchOpenChannelProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchOpenChannelProgress.Marshal(b, m, deterministic)
+}
+func (m *BatchOpenChannelProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOpenChannelProgress.Merge(m, src)
+}
+func (m *BatchOpenChannelProgress) XXX_Size() int {
+	return xxx_messageInfo_BatchOpenChannelProgress.Size(m)
+}
+func (m *BatchOpenChannelProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOpenChannelProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchOpenChannelProgress proto.InternalMessageInfo
+
+func (m *BatchOpenChannelProgress) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *BatchOpenChannelProgress) GetPeerEthAddress() []byte {
+	if m != nil {
+		return m.PeerEthAddress
+	}
+	return nil
+}
+
+func (m *BatchOpenChannelProgress) GetTokenAddress() []byte {
+	if m != nil {
+		return m.TokenAddress
+	}
+	return nil
+}
+
+func (m *BatchOpenChannelProgress) GetState() BatchOpenChannelState {
+	if m != nil {
+		return m.State
+	}
+	return BatchOpenChannelState_BatchOpen_PENDING
+}
+
+func (m *BatchOpenChannelProgress) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+func (m *BatchOpenChannelProgress) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 // Admin request to build routing table.
 // Next tag: 2
 type BuildRoutingTableRequest struct {
@@ -550,7 +717,7 @@ func (m *BuildRoutingTableRequest) Reset()         { *m = BuildRoutingTableReque
 func (m *BuildRoutingTableRequest) String() string { return proto.CompactTextString(m) }
 func (*BuildRoutingTableRequest) ProtoMessage()    {}
 func (*BuildRoutingTableRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a58c2d65cdc11488, []int{8}
+	return fileDescriptor_a58c2d65cdc11488, []int{10}
 }
 
 func (m *BuildRoutingTableRequest) XXX_Unmarshal(b []byte) error {
@@ -591,7 +758,7 @@ func (m *ClearExpiredPaysRequest) Reset()         { *m = ClearExpiredPaysRequest
 func (m *ClearExpiredPaysRequest) String() string { return proto.CompactTextString(m) }
 func (*ClearExpiredPaysRequest) ProtoMessage()    {}
 func (*ClearExpiredPaysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a58c2d65cdc11488, []int{9}
+	return fileDescriptor_a58c2d65cdc11488, []int{11}
 }
 
 func (m *ClearExpiredPaysRequest) XXX_Unmarshal(b []byte) error {
@@ -632,7 +799,7 @@ func (m *ConfirmOnChainResolvedPaysRequest) Reset()         { *m = ConfirmOnChai
 func (m *ConfirmOnChainResolvedPaysRequest) String() string { return proto.CompactTextString(m) }
 func (*ConfirmOnChainResolvedPaysRequest) ProtoMessage()    {}
 func (*ConfirmOnChainResolvedPaysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a58c2d65cdc11488, []int{10}
+	return fileDescriptor_a58c2d65cdc11488, []int{12}
 }
 
 func (m *ConfirmOnChainResolvedPaysRequest) XXX_Unmarshal(b []byte) error {
@@ -672,7 +839,7 @@ func (m *TokenCidPair) Reset()         { *m = TokenCidPair{} }
 func (m *TokenCidPair) String() string { return proto.CompactTextString(m) }
 func (*TokenCidPair) ProtoMessage()    {}
 func (*TokenCidPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a58c2d65cdc11488, []int{11}
+	return fileDescriptor_a58c2d65cdc11488, []int{13}
 }
 
 func (m *TokenCidPair) XXX_Unmarshal(b []byte) error {
@@ -723,7 +890,7 @@ func (m *PeerOsp) Reset()         { *m = PeerOsp{} }
 func (m *PeerOsp) String() string { return proto.CompactTextString(m) }
 func (*PeerOsp) ProtoMessage()    {}
 func (*PeerOsp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a58c2d65cdc11488, []int{12}
+	return fileDescriptor_a58c2d65cdc11488, []int{14}
 }
 
 func (m *PeerOsp) XXX_Unmarshal(b []byte) error {
@@ -779,7 +946,7 @@ func (m *PeerOspsResponse) Reset()         { *m = PeerOspsResponse{} }
 func (m *PeerOspsResponse) String() string { return proto.CompactTextString(m) }
 func (*PeerOspsResponse) ProtoMessage()    {}
 func (*PeerOspsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a58c2d65cdc11488, []int{13}
+	return fileDescriptor_a58c2d65cdc11488, []int{15}
 }
 
 func (m *PeerOspsResponse) XXX_Unmarshal(b []byte) error {
@@ -820,7 +987,7 @@ func (m *ChannelOpRequest) Reset()         { *m = ChannelOpRequest{} }
 func (m *ChannelOpRequest) String() string { return proto.CompactTextString(m) }
 func (*ChannelOpRequest) ProtoMessage()    {}
 func (*ChannelOpRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a58c2d65cdc11488, []int{14}
+	return fileDescriptor_a58c2d65cdc11488, []int{16}
 }
 
 func (m *ChannelOpRequest) XXX_Unmarshal(b []byte) error {
@@ -867,7 +1034,7 @@ func (m *ChannelOpResponse) Reset()         { *m = ChannelOpResponse{} }
 func (m *ChannelOpResponse) String() string { return proto.CompactTextString(m) }
 func (*ChannelOpResponse) ProtoMessage()    {}
 func (*ChannelOpResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a58c2d65cdc11488, []int{15}
+	return fileDescriptor_a58c2d65cdc11488, []int{17}
 }
 
 func (m *ChannelOpResponse) XXX_Unmarshal(b []byte) error {
@@ -902,237 +1069,2463 @@ func (m *ChannelOpResponse) GetError() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("rpc.DepositState", DepositState_name, DepositState_value)
-	proto.RegisterType((*RegisterStreamRequest)(nil), "rpc.RegisterStreamRequest")
-	proto.RegisterType((*SendTokenRequest)(nil), "rpc.SendTokenRequest")
-	proto.RegisterType((*SendTokenResponse)(nil), "rpc.SendTokenResponse")
-	proto.RegisterType((*DepositRequest)(nil), "rpc.DepositRequest")
-	proto.RegisterType((*DepositResponse)(nil), "rpc.DepositResponse")
-	proto.RegisterType((*QueryDepositRequest)(nil), "rpc.QueryDepositRequest")
-	proto.RegisterType((*QueryDepositResponse)(nil), "rpc.QueryDepositResponse")
-	proto.RegisterType((*OspOpenChannelRequest)(nil), "rpc.OspOpenChannelRequest")
-	proto.RegisterType((*BuildRoutingTableRequest)(nil), "rpc.BuildRoutingTableRequest")
-	proto.RegisterType((*ClearExpiredPaysRequest)(nil), "rpc.ClearExpiredPaysRequest")
-	proto.RegisterType((*ConfirmOnChainResolvedPaysRequest)(nil), "rpc.ConfirmOnChainResolvedPaysRequest")
-	proto.RegisterType((*TokenCidPair)(nil), "rpc.TokenCidPair")
-	proto.RegisterType((*PeerOsp)(nil), "rpc.PeerOsp")
-	proto.RegisterType((*PeerOspsResponse)(nil), "rpc.PeerOspsResponse")
-	proto.RegisterType((*ChannelOpRequest)(nil), "rpc.ChannelOpRequest")
-	proto.RegisterType((*ChannelOpResponse)(nil), "rpc.ChannelOpResponse")
+// Next Tag: 2
+type GetPayTraceRequest struct {
+	PayId                string   `protobuf:"bytes,1,opt,name=pay_id,json=payId,proto3" json:"pay_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("osp_admin.proto", fileDescriptor_a58c2d65cdc11488) }
+func (m *GetPayTraceRequest) Reset()         { *m = GetPayTraceRequest{} }
+func (m *GetPayTraceRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPayTraceRequest) ProtoMessage()    {}
+func (*GetPayTraceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{18}
+}
 
-var fileDescriptor_a58c2d65cdc11488 = []byte{
-	// 1291 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xdd, 0x4e, 0x1b, 0x47,
-	0x14, 0xee, 0xf2, 0xef, 0x83, 0x43, 0xec, 0xc5, 0x0e, 0x60, 0x20, 0xa1, 0x93, 0x14, 0x39, 0x91,
-	0x6a, 0x57, 0x69, 0x14, 0xa9, 0x91, 0xda, 0x0a, 0x6c, 0xa7, 0x45, 0x6a, 0xe3, 0x64, 0x31, 0xa2,
-	0xea, 0x45, 0x56, 0xc3, 0xee, 0xc1, 0xac, 0xb0, 0x77, 0x26, 0x33, 0x63, 0x88, 0xd5, 0x8b, 0x4a,
-	0x79, 0x85, 0xde, 0x46, 0x7d, 0x83, 0x3e, 0x4d, 0x5f, 0xa1, 0x4f, 0xd1, 0xab, 0x6a, 0x66, 0xc7,
-	0xce, 0xae, 0x31, 0x4a, 0xc3, 0xdd, 0xce, 0xcf, 0xf9, 0xbe, 0xef, 0x7c, 0x33, 0xe7, 0xcc, 0xc2,
-	0x6d, 0x26, 0xb9, 0x4f, 0xc3, 0x7e, 0x14, 0xd7, 0xb8, 0x60, 0x8a, 0xb9, 0xb3, 0x82, 0x07, 0x95,
-	0xad, 0x2e, 0x63, 0xdd, 0x1e, 0xd6, 0x29, 0x8f, 0xea, 0x34, 0x8e, 0x99, 0xa2, 0x2a, 0x62, 0xb1,
-	0x4c, 0xb6, 0x54, 0x36, 0xec, 0xaa, 0x19, 0x9d, 0x0c, 0x4e, 0xeb, 0x34, 0x1e, 0xda, 0xa5, 0xcd,
-	0xc9, 0x25, 0xec, 0x73, 0x35, 0x5a, 0xcc, 0x63, 0xac, 0xa2, 0xf1, 0xe8, 0x56, 0x1f, 0xa5, 0xa4,
-	0x5d, 0x4c, 0x86, 0xe4, 0x1c, 0xca, 0x1e, 0x76, 0x23, 0xa9, 0x50, 0x1c, 0x2a, 0x81, 0xb4, 0xef,
-	0xe1, 0x9b, 0x01, 0x4a, 0xe5, 0x56, 0xa1, 0xc0, 0x11, 0x85, 0x2f, 0x78, 0xe0, 0xd3, 0x30, 0x14,
-	0x28, 0xe5, 0xba, 0xb3, 0xe3, 0x54, 0x73, 0xde, 0x8a, 0x9e, 0xf7, 0x78, 0xb0, 0x97, 0xcc, 0x8e,
-	0x77, 0xa2, 0x3a, 0x1b, 0xef, 0x9c, 0xd9, 0x71, 0xaa, 0xf9, 0x64, 0x67, 0x4b, 0x9d, 0xd9, 0x9d,
-	0xe4, 0x2f, 0x07, 0x0a, 0x87, 0x18, 0x87, 0x1d, 0x76, 0x8e, 0xf1, 0x88, 0x68, 0x03, 0x96, 0x42,
-	0xa9, 0x4c, 0xa4, 0x25, 0x58, 0x0c, 0xa5, 0xd2, 0x21, 0xee, 0x1a, 0x2c, 0xd2, 0xbe, 0xf2, 0x2f,
-	0x31, 0x32, 0x80, 0x39, 0x6f, 0x81, 0xf6, 0xd5, 0x31, 0x46, 0xee, 0x36, 0x80, 0xd2, 0x18, 0x49,
-	0xd4, 0xac, 0x59, 0xcb, 0x99, 0x19, 0x13, 0x57, 0x85, 0xb9, 0x98, 0x29, 0x5c, 0x9f, 0xdb, 0x71,
-	0xaa, 0xcb, 0x8f, 0x4b, 0xb5, 0xc4, 0x9d, 0xda, 0xc8, 0x9d, 0xda, 0x5e, 0x3c, 0xf4, 0xcc, 0x0e,
-	0x77, 0x0b, 0x40, 0x93, 0xc7, 0xa8, 0xfc, 0x28, 0x5c, 0x9f, 0xdf, 0x71, 0xaa, 0x73, 0x9e, 0x96,
-	0xf3, 0x02, 0xd5, 0x41, 0x48, 0x7e, 0x81, 0x62, 0x4a, 0xae, 0xe4, 0x2c, 0x96, 0xe8, 0xde, 0x81,
-	0x05, 0xa9, 0xa8, 0x1a, 0x24, 0x76, 0xcc, 0x7b, 0x76, 0xe4, 0x96, 0x60, 0x1e, 0x85, 0x60, 0xc2,
-	0x4a, 0x4d, 0x06, 0x6e, 0x19, 0x16, 0x38, 0x1d, 0x6a, 0xf0, 0x44, 0xe5, 0x3c, 0xa7, 0xc3, 0x83,
-	0x90, 0xbc, 0x77, 0x60, 0xa5, 0x89, 0x9c, 0xc9, 0x48, 0x8d, 0x7c, 0xd8, 0x84, 0x9c, 0xb1, 0x31,
-	0x65, 0xc4, 0x92, 0x9e, 0x30, 0x19, 0x65, 0x13, 0x9e, 0x99, 0x4c, 0x78, 0x0d, 0x16, 0x15, 0xf3,
-	0xf5, 0x6e, 0x43, 0xb3, 0xe4, 0x2d, 0x28, 0xf6, 0x12, 0x31, 0xe3, 0xe0, 0x5c, 0xc6, 0xc1, 0x2d,
-	0x80, 0x3e, 0x7d, 0xeb, 0x5f, 0xd2, 0x48, 0xf9, 0x72, 0x94, 0x78, 0x9f, 0xbe, 0x3d, 0xa6, 0x91,
-	0x3a, 0x24, 0xaf, 0xe1, 0xf6, 0x58, 0xdd, 0x8d, 0xd2, 0xde, 0x06, 0x08, 0x13, 0x80, 0x0f, 0xa9,
-	0xe7, 0xec, 0xcc, 0x41, 0x48, 0x9e, 0xc0, 0xea, 0xab, 0x01, 0x8a, 0xe1, 0x84, 0x05, 0xd9, 0x28,
-	0x67, 0x32, 0x2a, 0x84, 0x52, 0x36, 0xca, 0x4a, 0x7b, 0x0a, 0xb7, 0x46, 0x61, 0x5a, 0x14, 0x9a,
-	0xc8, 0x95, 0xc7, 0xc5, 0x9a, 0xe0, 0x41, 0xcd, 0x6e, 0x3e, 0xd4, 0x0b, 0x5e, 0x3e, 0x4c, 0x8d,
-	0xa6, 0x4b, 0x27, 0xff, 0x3a, 0x50, 0x6e, 0x4b, 0xde, 0xe6, 0x18, 0x37, 0xce, 0x68, 0x1c, 0x63,
-	0x6f, 0xb2, 0x24, 0xd2, 0x17, 0xdd, 0x99, 0x76, 0xd1, 0xdd, 0xaf, 0x46, 0xc7, 0xa5, 0x86, 0x1c,
-	0x0d, 0xbc, 0x96, 0x63, 0xeb, 0xd0, 0x5c, 0xa7, 0xce, 0x90, 0xa3, 0x3d, 0x41, 0xfd, 0xe9, 0xde,
-	0x87, 0x5b, 0x1f, 0x0e, 0x58, 0x03, 0xcf, 0x1a, 0xe0, 0xfc, 0xf8, 0x8c, 0x35, 0x6c, 0x1d, 0x4a,
-	0x12, 0x7b, 0xa7, 0xfe, 0x28, 0xdb, 0xec, 0xd1, 0x16, 0xf5, 0x9a, 0x4d, 0x77, 0x2f, 0x39, 0xe5,
-	0x3a, 0x94, 0x8c, 0xe2, 0xc9, 0x80, 0xf9, 0x24, 0x40, 0xaf, 0x65, 0x02, 0xc8, 0xf7, 0xb0, 0xbe,
-	0x3f, 0x88, 0x7a, 0xa1, 0xc7, 0x06, 0x2a, 0x8a, 0xbb, 0x1d, 0x7a, 0xd2, 0xc3, 0x51, 0xfa, 0x57,
-	0x24, 0x3a, 0x57, 0x25, 0x92, 0xef, 0x60, 0xad, 0xd1, 0x43, 0x2a, 0x5a, 0x6f, 0x79, 0x24, 0x30,
-	0x7c, 0x49, 0x87, 0xf2, 0x93, 0xe2, 0x7f, 0x84, 0xcf, 0x1b, 0x2c, 0x3e, 0x8d, 0x44, 0xbf, 0xad,
-	0xed, 0x8f, 0x74, 0xdd, 0xb1, 0xde, 0xc5, 0x0d, 0x90, 0x5a, 0x90, 0x37, 0x4e, 0x37, 0xa2, 0xf0,
-	0x25, 0x8d, 0xc4, 0xf4, 0xa0, 0xdc, 0x84, 0xc3, 0x05, 0x98, 0x0d, 0xa2, 0xd0, 0x5e, 0x08, 0xfd,
-	0x49, 0xde, 0x39, 0xb0, 0xa8, 0x4b, 0xa9, 0x2d, 0xb9, 0x7b, 0x0f, 0x96, 0x93, 0xbe, 0x9d, 0x06,
-	0x00, 0x26, 0xf9, 0x28, 0xfc, 0x1b, 0xb8, 0x9d, 0x70, 0x04, 0x51, 0xe8, 0x73, 0x1a, 0x09, 0xdd,
-	0x09, 0x67, 0xab, 0xcb, 0xf6, 0x2e, 0xa6, 0xf5, 0x78, 0x89, 0x1a, 0x3b, 0x92, 0xba, 0xfc, 0x07,
-	0x3c, 0xa4, 0x0a, 0x7d, 0x95, 0x1c, 0xfe, 0x9c, 0xb7, 0x94, 0x4c, 0x74, 0x24, 0xf9, 0x16, 0x0a,
-	0x56, 0x83, 0x1c, 0xdf, 0xfa, 0x87, 0xb6, 0x5f, 0x30, 0xc9, 0xb5, 0x14, 0xcd, 0x92, 0x37, 0x2c,
-	0x76, 0x67, 0xd2, 0x3d, 0x74, 0x08, 0x79, 0x0a, 0x05, 0x7b, 0x95, 0xdb, 0x7c, 0xe4, 0xa1, 0xcd,
-	0xd4, 0x19, 0x67, 0xaa, 0x67, 0x3e, 0x74, 0x5a, 0xfd, 0x49, 0xf6, 0xa0, 0x98, 0x8a, 0xbb, 0x49,
-	0x23, 0x78, 0xf4, 0x1b, 0xe4, 0xd3, 0x15, 0xe8, 0x96, 0xa1, 0x68, 0xc7, 0xfe, 0x8b, 0x76, 0xc7,
-	0x7f, 0xde, 0x3e, 0x7a, 0xd1, 0x2c, 0x7c, 0xe6, 0xba, 0xe3, 0x76, 0xe8, 0xbf, 0x3a, 0x6a, 0x1d,
-	0xb5, 0x9a, 0x05, 0x27, 0xbd, 0xf5, 0xf0, 0x68, 0xff, 0xe7, 0x83, 0x4e, 0xa7, 0xd5, 0x2c, 0xcc,
-	0x64, 0xa7, 0x1b, 0x8d, 0x56, 0xab, 0xd9, 0x6a, 0x16, 0x66, 0xd3, 0x08, 0xcf, 0xf7, 0x0e, 0x7e,
-	0x6a, 0x35, 0x0b, 0x73, 0x8f, 0xdf, 0x03, 0xcc, 0xef, 0xe9, 0x47, 0xd6, 0xfd, 0xd3, 0x81, 0xdd,
-	0xeb, 0xef, 0xd5, 0x71, 0xa4, 0xce, 0x46, 0xfe, 0xba, 0xbb, 0xc6, 0xc4, 0x8f, 0x5e, 0xc2, 0xca,
-	0x9d, 0x2b, 0xcf, 0x4a, 0x4b, 0x3f, 0xba, 0xe4, 0xc9, 0xbb, 0xbf, 0xff, 0xf9, 0x63, 0xa6, 0x46,
-	0x1e, 0xd6, 0xcd, 0xfb, 0x5e, 0xd7, 0xa7, 0x50, 0x0f, 0x12, 0x38, 0x9f, 0xc5, 0x81, 0xc6, 0xf3,
-	0x85, 0x05, 0xf4, 0x39, 0x1d, 0xca, 0x67, 0xce, 0x23, 0xf7, 0x77, 0xd8, 0x9a, 0xac, 0x9b, 0x8c,
-	0xaa, 0xad, 0x44, 0xd5, 0xf4, 0xd2, 0xba, 0x56, 0xcb, 0x43, 0xa3, 0xe5, 0x3e, 0xb9, 0x9b, 0xd1,
-	0xa2, 0x41, 0x7c, 0x4c, 0x50, 0xc6, 0x02, 0x22, 0x28, 0x5e, 0xa9, 0x7c, 0x77, 0xdb, 0xb0, 0x5e,
-	0xd7, 0x11, 0xae, 0xa5, 0xdd, 0x36, 0xb4, 0x6b, 0xc4, 0xb5, 0xb4, 0x82, 0x0d, 0x14, 0xd6, 0x4f,
-	0x34, 0x8c, 0xa6, 0xea, 0x42, 0xc9, 0xc3, 0xe0, 0x62, 0x3f, 0xa0, 0x52, 0x59, 0xd8, 0x83, 0xf8,
-	0x94, 0xb9, 0xab, 0x86, 0xcd, 0xce, 0x7c, 0x8c, 0x83, 0x18, 0x8e, 0x2d, 0xb2, 0x96, 0xe1, 0x10,
-	0x18, 0x5c, 0xf8, 0x27, 0x1a, 0x58, 0x13, 0xbd, 0x86, 0xe5, 0x1f, 0x50, 0x8d, 0x3d, 0xbc, 0x06,
-	0xaa, 0x52, 0x4e, 0x97, 0xcd, 0xb8, 0xc0, 0xc8, 0x8e, 0x61, 0xa8, 0x90, 0x72, 0xda, 0xbc, 0x71,
-	0xc9, 0x69, 0xfc, 0x33, 0x58, 0xc9, 0xbe, 0x14, 0x6e, 0xc5, 0x40, 0x4d, 0x7d, 0x3e, 0xfe, 0x77,
-	0x26, 0x86, 0x87, 0x71, 0xd4, 0x57, 0x45, 0xc7, 0x6b, 0xa6, 0x63, 0xc8, 0x8d, 0xff, 0x44, 0xdc,
-	0x44, 0xef, 0xe4, 0x8f, 0x54, 0xe5, 0xce, 0xe4, 0xb4, 0xcd, 0x63, 0xd3, 0xe0, 0x97, 0x49, 0xc1,
-	0xe2, 0x4b, 0x8c, 0x43, 0xd3, 0x7b, 0x34, 0x70, 0x1b, 0x16, 0x6d, 0xd9, 0x58, 0xfb, 0xb3, 0x4f,
-	0x72, 0xa5, 0x94, 0x9d, 0xb4, 0x90, 0x1b, 0x06, 0x72, 0x95, 0xac, 0x58, 0x48, 0xfb, 0xbe, 0x68,
-	0xc0, 0x10, 0xf2, 0xe9, 0x47, 0xda, 0x5d, 0x37, 0x00, 0x53, 0x5e, 0xfb, 0xca, 0xc6, 0x94, 0x15,
-	0x8b, 0x7f, 0xcf, 0xe0, 0x6f, 0x90, 0x92, 0xc5, 0x7f, 0xa3, 0x37, 0xf9, 0x29, 0x96, 0x73, 0x58,
-	0xc9, 0xfe, 0xb6, 0x5a, 0xe7, 0xa7, 0xfe, 0xcb, 0x5e, 0xeb, 0xfc, 0x17, 0x86, 0xe6, 0x1e, 0xa9,
-	0xa4, 0x9d, 0x17, 0x16, 0x42, 0x1a, 0x08, 0x4d, 0xd6, 0x83, 0xd5, 0x06, 0x63, 0x1c, 0x05, 0x55,
-	0xd1, 0x05, 0xea, 0xb2, 0x0c, 0x05, 0xbd, 0xb4, 0xc7, 0x30, 0xd9, 0x58, 0xed, 0x31, 0x5c, 0xe9,
-	0x9b, 0x64, 0xd7, 0x90, 0xed, 0x90, 0x4d, 0x4b, 0x66, 0x4f, 0xb7, 0x1e, 0x30, 0xc6, 0x2f, 0x2d,
-	0x66, 0x72, 0xa9, 0x8a, 0x29, 0xb6, 0x43, 0x54, 0xaa, 0x87, 0x9f, 0xca, 0xf5, 0xc0, 0x70, 0xdd,
-	0x25, 0x1b, 0x53, 0xb8, 0xa4, 0x41, 0x7c, 0xe6, 0x3c, 0xda, 0xdf, 0xfd, 0xf5, 0x41, 0x37, 0x52,
-	0x67, 0x83, 0x93, 0x5a, 0xc0, 0xfa, 0xf5, 0x00, 0x7b, 0x28, 0xbe, 0x8c, 0x51, 0x5d, 0x32, 0x71,
-	0x5e, 0xef, 0xb2, 0x86, 0x1e, 0xd7, 0x05, 0x0f, 0x4e, 0x16, 0x8c, 0x6d, 0x5f, 0xff, 0x17, 0x00,
-	0x00, 0xff, 0xff, 0x3e, 0x04, 0xe0, 0x9d, 0xb5, 0x0c, 0x00, 0x00,
+func (m *GetPayTraceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPayTraceRequest.Unmarshal(m, b)
+}
+func (m *GetPayTraceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPayTraceRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPayTraceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPayTraceRequest.Merge(m, src)
+}
+func (m *GetPayTraceRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPayTraceRequest.Size(m)
+}
+func (m *GetPayTraceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPayTraceRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_GetPayTraceRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *GetPayTraceRequest) GetPayId() string {
+	if m != nil {
+		return m.PayId
+	}
+	return ""
+}
 
-// AdminClient is the client API for Admin service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AdminClient interface {
-	// ConfirmOnChainResolvedPaysWithPeerOsps instructs Osp to confirm on-chain resolved pays between itself and connected osps.
-	ConfirmOnChainResolvedPaysWithPeerOsps(ctx context.Context, in *ConfirmOnChainResolvedPaysRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// ClearExpiredPaysWithPeerOsps instructs Osp to clear expired pays between itself and connected osps.
-	ClearExpiredPaysWithPeerOsps(ctx context.Context, in *ClearExpiredPaysRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// BuildRoutingTable instructs Osp to build routing table.
-	BuildRoutingTable(ctx context.Context, in *BuildRoutingTableRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// RecvBcastRoutingInfo gives the OSP (listener & router) the incoming
-	// broadcast routing information (OSP server forwarding to the listener).
-	RecvBcastRoutingInfo(ctx context.Context, in *RoutingRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// GetPeerOsps returns info of all peer osps.
-	GetPeerOsps(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PeerOspsResponse, error)
-	// OspOpenChannel instructs Osp to open a state channel with a peer described in request.
-	OspOpenChannel(ctx context.Context, in *OspOpenChannelRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// SendToken instructs the OSP to send token specified in SendTokenRequest.
-	SendToken(ctx context.Context, in *SendTokenRequest, opts ...grpc.CallOption) (*SendTokenResponse, error)
-	// Deposit instructs the OSP to deposit token specified in DepositRequest.
-	Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositResponse, error)
-	// QueryDeposit asks OSP about the deposit status
-	QueryDeposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error)
-	// RegisterStream instructs the OSP to connect with other osp specified in RegisterStreamRequest.
-	RegisterStream(ctx context.Context, in *RegisterStreamRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	CooperativeWithdraw(ctx context.Context, in *ChannelOpRequest, opts ...grpc.CallOption) (*ChannelOpResponse, error)
-	CooperativeSettle(ctx context.Context, in *ChannelOpRequest, opts ...grpc.CallOption) (*ChannelOpResponse, error)
+// Next Tag: 3
+type GetPayTraceResponse struct {
+	TraceId string `protobuf:"bytes,1,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	// spans of all reachable hops, ordered from pay src to pay dest
+	Spans                []*PayTraceSpan `protobuf:"bytes,2,rep,name=spans,proto3" json:"spans,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-type adminClient struct {
-	cc *grpc.ClientConn
+func (m *GetPayTraceResponse) Reset()         { *m = GetPayTraceResponse{} }
+func (m *GetPayTraceResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPayTraceResponse) ProtoMessage()    {}
+func (*GetPayTraceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{19}
 }
 
-func NewAdminClient(cc *grpc.ClientConn) AdminClient {
-	return &adminClient{cc}
+func (m *GetPayTraceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPayTraceResponse.Unmarshal(m, b)
+}
+func (m *GetPayTraceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPayTraceResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPayTraceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPayTraceResponse.Merge(m, src)
+}
+func (m *GetPayTraceResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPayTraceResponse.Size(m)
+}
+func (m *GetPayTraceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPayTraceResponse.DiscardUnknown(m)
 }
 
-func (c *adminClient) ConfirmOnChainResolvedPaysWithPeerOsps(ctx context.Context, in *ConfirmOnChainResolvedPaysRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/rpc.Admin/ConfirmOnChainResolvedPaysWithPeerOsps", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetPayTraceResponse proto.InternalMessageInfo
+
+func (m *GetPayTraceResponse) GetTraceId() string {
+	if m != nil {
+		return m.TraceId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *adminClient) ClearExpiredPaysWithPeerOsps(ctx context.Context, in *ClearExpiredPaysRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/rpc.Admin/ClearExpiredPaysWithPeerOsps", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetPayTraceResponse) GetSpans() []*PayTraceSpan {
+	if m != nil {
+		return m.Spans
 	}
-	return out, nil
+	return nil
 }
 
-func (c *adminClient) BuildRoutingTable(ctx context.Context, in *BuildRoutingTableRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/rpc.Admin/BuildRoutingTable", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// Admin request to rebalance peer osp channels through circular self pays.
+// Next Tag: 3
+type RebalanceRequest struct {
+	// only plan the rebalances without sending pays
+	DryRun bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// token to rebalance, all configured tokens if empty
+	TokenAddress         []byte   `protobuf:"bytes,2,opt,name=token_address,json=tokenAddress,proto3" json:"token_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *adminClient) RecvBcastRoutingInfo(ctx context.Context, in *RoutingRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/rpc.Admin/RecvBcastRoutingInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *RebalanceRequest) Reset()         { *m = RebalanceRequest{} }
+func (m *RebalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*RebalanceRequest) ProtoMessage()    {}
+func (*RebalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{20}
 }
 
-func (c *adminClient) GetPeerOsps(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PeerOspsResponse, error) {
-	out := new(PeerOspsResponse)
-	err := c.cc.Invoke(ctx, "/rpc.Admin/GetPeerOsps", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *RebalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebalanceRequest.Unmarshal(m, b)
+}
+func (m *RebalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebalanceRequest.Marshal(b, m, deterministic)
+}
+func (m *RebalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebalanceRequest.Merge(m, src)
+}
+func (m *RebalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_RebalanceRequest.Size(m)
+}
+func (m *RebalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebalanceRequest.DiscardUnknown(m)
 }
 
-func (c *adminClient) OspOpenChannel(ctx context.Context, in *OspOpenChannelRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/rpc.Admin/OspOpenChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RebalanceRequest proto.InternalMessageInfo
+
+func (m *RebalanceRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
 	}
-	return out, nil
+	return false
 }
 
-func (c *adminClient) SendToken(ctx context.Context, in *SendTokenRequest, opts ...grpc.CallOption) (*SendTokenResponse, error) {
-	out := new(SendTokenResponse)
-	err := c.cc.Invoke(ctx, "/rpc.Admin/SendToken", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RebalanceRequest) GetTokenAddress() []byte {
+	if m != nil {
+		return m.TokenAddress
 	}
-	return out, nil
+	return nil
 }
 
-func (c *adminClient) Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositResponse, error) {
-	out := new(DepositResponse)
-	err := c.cc.Invoke(ctx, "/rpc.Admin/Deposit", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// Next Tag: 8
+type RebalancePlan struct {
+	TokenAddress string `protobuf:"bytes,1,opt,name=token_address,json=tokenAddress,proto3" json:"token_address,omitempty"`
+	// decimal string of the rebalance amount in wei
+	AmtWei string `protobuf:"bytes,2,opt,name=amt_wei,json=amtWei,proto3" json:"amt_wei,omitempty"`
+	// hex strings of peer osps the self pay goes through, from the source channel peer to the drained channel peer
+	Route []string `protobuf:"bytes,3,rep,name=route,proto3" json:"route,omitempty"`
+	// my share of the free balance of the drained channel before the rebalance
+	DrainedRatio float64 `protobuf:"fixed64,4,opt,name=drained_ratio,json=drainedRatio,proto3" json:"drained_ratio,omitempty"`
+	// hex string of pay id, empty in dry run
+	PayId string `protobuf:"bytes,5,opt,name=pay_id,json=payId,proto3" json:"pay_id,omitempty"`
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	// decimal string of the relay fee paid to the osps on the route in wei
+	FeeWei               string   `protobuf:"bytes,7,opt,name=fee_wei,json=feeWei,proto3" json:"fee_wei,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *adminClient) QueryDeposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error) {
-	out := new(QueryDepositResponse)
-	err := c.cc.Invoke(ctx, "/rpc.Admin/QueryDeposit", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *RebalancePlan) Reset()         { *m = RebalancePlan{} }
+func (m *RebalancePlan) String() string { return proto.CompactTextString(m) }
+func (*RebalancePlan) ProtoMessage()    {}
+func (*RebalancePlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{21}
+}
+
+func (m *RebalancePlan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebalancePlan.Unmarshal(m, b)
+}
+func (m *RebalancePlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebalancePlan.Marshal(b, m, deterministic)
+}
+func (m *RebalancePlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebalancePlan.Merge(m, src)
+}
+func (m *RebalancePlan) XXX_Size() int {
+	return xxx_messageInfo_RebalancePlan.Size(m)
+}
+func (m *RebalancePlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebalancePlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebalancePlan proto.InternalMessageInfo
+
+func (m *RebalancePlan) GetTokenAddress() string {
+	if m != nil {
+		return m.TokenAddress
+	}
+	return ""
+}
+
+func (m *RebalancePlan) GetAmtWei() string {
+	if m != nil {
+		return m.AmtWei
+	}
+	return ""
+}
+
+func (m *RebalancePlan) GetRoute() []string {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
+func (m *RebalancePlan) GetDrainedRatio() float64 {
+	if m != nil {
+		return m.DrainedRatio
+	}
+	return 0
+}
+
+func (m *RebalancePlan) GetPayId() string {
+	if m != nil {
+		return m.PayId
+	}
+	return ""
+}
+
+func (m *RebalancePlan) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *RebalancePlan) GetFeeWei() string {
+	if m != nil {
+		return m.FeeWei
+	}
+	return ""
+}
+
+// Next Tag: 2
+type RebalanceResponse struct {
+	Plans                []*RebalancePlan `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *RebalanceResponse) Reset()         { *m = RebalanceResponse{} }
+func (m *RebalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*RebalanceResponse) ProtoMessage()    {}
+func (*RebalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{22}
+}
+
+func (m *RebalanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebalanceResponse.Unmarshal(m, b)
+}
+func (m *RebalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebalanceResponse.Marshal(b, m, deterministic)
+}
+func (m *RebalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebalanceResponse.Merge(m, src)
+}
+func (m *RebalanceResponse) XXX_Size() int {
+	return xxx_messageInfo_RebalanceResponse.Size(m)
+}
+func (m *RebalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebalanceResponse proto.InternalMessageInfo
+
+func (m *RebalanceResponse) GetPlans() []*RebalancePlan {
+	if m != nil {
+		return m.Plans
+	}
+	return nil
+}
+
+// Admin request to list balances of opened channels.
+// Next Tag: 3
+type ChannelBalancesRequest struct {
+	// only list channels with peer osps
+	PeerOspsOnly bool `protobuf:"varint,1,opt,name=peer_osps_only,json=peerOspsOnly,proto3" json:"peer_osps_only,omitempty"`
+	// token of channels, all tokens if empty
+	TokenAddress         []byte   `protobuf:"bytes,2,opt,name=token_address,json=tokenAddress,proto3" json:"token_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelBalancesRequest) Reset()         { *m = ChannelBalancesRequest{} }
+func (m *ChannelBalancesRequest) String() string { return proto.CompactTextString(m) }
+func (*ChannelBalancesRequest) ProtoMessage()    {}
+func (*ChannelBalancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{23}
+}
+
+func (m *ChannelBalancesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelBalancesRequest.Unmarshal(m, b)
+}
+func (m *ChannelBalancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelBalancesRequest.Marshal(b, m, deterministic)
+}
+func (m *ChannelBalancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelBalancesRequest.Merge(m, src)
+}
+func (m *ChannelBalancesRequest) XXX_Size() int {
+	return xxx_messageInfo_ChannelBalancesRequest.Size(m)
+}
+func (m *ChannelBalancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelBalancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelBalancesRequest proto.InternalMessageInfo
+
+func (m *ChannelBalancesRequest) GetPeerOspsOnly() bool {
+	if m != nil {
+		return m.PeerOspsOnly
+	}
+	return false
+}
+
+func (m *ChannelBalancesRequest) GetTokenAddress() []byte {
+	if m != nil {
+		return m.TokenAddress
+	}
+	return nil
+}
+
+// Next Tag: 8
+type ChannelBalanceInfo struct {
+	Cid          string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	PeerAddress  string `protobuf:"bytes,2,opt,name=peer_address,json=peerAddress,proto3" json:"peer_address,omitempty"`
+	TokenAddress string `protobuf:"bytes,3,opt,name=token_address,json=tokenAddress,proto3" json:"token_address,omitempty"`
+	// decimal strings in wei
+	MyFreeWei   string `protobuf:"bytes,4,opt,name=my_free_wei,json=myFreeWei,proto3" json:"my_free_wei,omitempty"`
+	PeerFreeWei string `protobuf:"bytes,5,opt,name=peer_free_wei,json=peerFreeWei,proto3" json:"peer_free_wei,omitempty"`
+	// amount locked in pending pays sent by myself
+	MyPendingWei string `protobuf:"bytes,6,opt,name=my_pending_wei,json=myPendingWei,proto3" json:"my_pending_wei,omitempty"`
+	// amount locked in pending pays sent by peer
+	PeerPendingWei       string   `protobuf:"bytes,7,opt,name=peer_pending_wei,json=peerPendingWei,proto3" json:"peer_pending_wei,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelBalanceInfo) Reset()         { *m = ChannelBalanceInfo{} }
+func (m *ChannelBalanceInfo) String() string { return proto.CompactTextString(m) }
+func (*ChannelBalanceInfo) ProtoMessage()    {}
+func (*ChannelBalanceInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{24}
+}
+
+func (m *ChannelBalanceInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelBalanceInfo.Unmarshal(m, b)
+}
+func (m *ChannelBalanceInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelBalanceInfo.Marshal(b, m, deterministic)
+}
+func (m *ChannelBalanceInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelBalanceInfo.Merge(m, src)
+}
+func (m *ChannelBalanceInfo) XXX_Size() int {
+	return xxx_messageInfo_ChannelBalanceInfo.Size(m)
+}
+func (m *ChannelBalanceInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelBalanceInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelBalanceInfo proto.InternalMessageInfo
+
+func (m *ChannelBalanceInfo) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+func (m *ChannelBalanceInfo) GetPeerAddress() string {
+	if m != nil {
+		return m.PeerAddress
+	}
+	return ""
+}
+
+func (m *ChannelBalanceInfo) GetTokenAddress() string {
+	if m != nil {
+		return m.TokenAddress
+	}
+	return ""
+}
+
+func (m *ChannelBalanceInfo) GetMyFreeWei() string {
+	if m != nil {
+		return m.MyFreeWei
+	}
+	return ""
+}
+
+func (m *ChannelBalanceInfo) GetPeerFreeWei() string {
+	if m != nil {
+		return m.PeerFreeWei
+	}
+	return ""
+}
+
+func (m *ChannelBalanceInfo) GetMyPendingWei() string {
+	if m != nil {
+		return m.MyPendingWei
+	}
+	return ""
+}
+
+func (m *ChannelBalanceInfo) GetPeerPendingWei() string {
+	if m != nil {
+		return m.PeerPendingWei
+	}
+	return ""
+}
+
+// Next Tag: 2
+type ChannelBalancesResponse struct {
+	Channels             []*ChannelBalanceInfo `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ChannelBalancesResponse) Reset()         { *m = ChannelBalancesResponse{} }
+func (m *ChannelBalancesResponse) String() string { return proto.CompactTextString(m) }
+func (*ChannelBalancesResponse) ProtoMessage()    {}
+func (*ChannelBalancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{25}
+}
+
+func (m *ChannelBalancesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelBalancesResponse.Unmarshal(m, b)
+}
+func (m *ChannelBalancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelBalancesResponse.Marshal(b, m, deterministic)
+}
+func (m *ChannelBalancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelBalancesResponse.Merge(m, src)
+}
+func (m *ChannelBalancesResponse) XXX_Size() int {
+	return xxx_messageInfo_ChannelBalancesResponse.Size(m)
+}
+func (m *ChannelBalancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelBalancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelBalancesResponse proto.InternalMessageInfo
+
+func (m *ChannelBalancesResponse) GetChannels() []*ChannelBalanceInfo {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+// Admin request to list pending pays of a channel.
+// Next Tag: 2
+type PendingPaysRequest struct {
+	// hex string of channel id, all peer osp channels if empty
+	Cid                  string   `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingPaysRequest) Reset()         { *m = PendingPaysRequest{} }
+func (m *PendingPaysRequest) String() string { return proto.CompactTextString(m) }
+func (*PendingPaysRequest) ProtoMessage()    {}
+func (*PendingPaysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{26}
+}
+
+func (m *PendingPaysRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingPaysRequest.Unmarshal(m, b)
+}
+func (m *PendingPaysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingPaysRequest.Marshal(b, m, deterministic)
+}
+func (m *PendingPaysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingPaysRequest.Merge(m, src)
+}
+func (m *PendingPaysRequest) XXX_Size() int {
+	return xxx_messageInfo_PendingPaysRequest.Size(m)
+}
+func (m *PendingPaysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingPaysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingPaysRequest proto.InternalMessageInfo
+
+func (m *PendingPaysRequest) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+// Next Tag: 11
+type PendingPayInfo struct {
+	PayId                string   `protobuf:"bytes,1,opt,name=pay_id,json=payId,proto3" json:"pay_id,omitempty"`
+	Src                  string   `protobuf:"bytes,2,opt,name=src,proto3" json:"src,omitempty"`
+	Dest                 string   `protobuf:"bytes,3,opt,name=dest,proto3" json:"dest,omitempty"`
+	TokenAddress         string   `protobuf:"bytes,4,opt,name=token_address,json=tokenAddress,proto3" json:"token_address,omitempty"`
+	AmtWei               string   `protobuf:"bytes,5,opt,name=amt_wei,json=amtWei,proto3" json:"amt_wei,omitempty"`
+	IngressCid           string   `protobuf:"bytes,6,opt,name=ingress_cid,json=ingressCid,proto3" json:"ingress_cid,omitempty"`
+	IngressState         string   `protobuf:"bytes,7,opt,name=ingress_state,json=ingressState,proto3" json:"ingress_state,omitempty"`
+	EgressCid            string   `protobuf:"bytes,8,opt,name=egress_cid,json=egressCid,proto3" json:"egress_cid,omitempty"`
+	EgressState          string   `protobuf:"bytes,9,opt,name=egress_state,json=egressState,proto3" json:"egress_state,omitempty"`
+	ResolveDeadline      uint64   `protobuf:"varint,10,opt,name=resolve_deadline,json=resolveDeadline,proto3" json:"resolve_deadline,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingPayInfo) Reset()         { *m = PendingPayInfo{} }
+func (m *PendingPayInfo) String() string { return proto.CompactTextString(m) }
+func (*PendingPayInfo) ProtoMessage()    {}
+func (*PendingPayInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{27}
+}
+
+func (m *PendingPayInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingPayInfo.Unmarshal(m, b)
+}
+func (m *PendingPayInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingPayInfo.Marshal(b, m, deterministic)
+}
+func (m *PendingPayInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingPayInfo.Merge(m, src)
+}
+func (m *PendingPayInfo) XXX_Size() int {
+	return xxx_messageInfo_PendingPayInfo.Size(m)
+}
+func (m *PendingPayInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingPayInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingPayInfo proto.InternalMessageInfo
+
+func (m *PendingPayInfo) GetPayId() string {
+	if m != nil {
+		return m.PayId
+	}
+	return ""
+}
+
+func (m *PendingPayInfo) GetSrc() string {
+	if m != nil {
+		return m.Src
+	}
+	return ""
+}
+
+func (m *PendingPayInfo) GetDest() string {
+	if m != nil {
+		return m.Dest
+	}
+	return ""
+}
+
+func (m *PendingPayInfo) GetTokenAddress() string {
+	if m != nil {
+		return m.TokenAddress
+	}
+	return ""
+}
+
+func (m *PendingPayInfo) GetAmtWei() string {
+	if m != nil {
+		return m.AmtWei
+	}
+	return ""
+}
+
+func (m *PendingPayInfo) GetIngressCid() string {
+	if m != nil {
+		return m.IngressCid
+	}
+	return ""
+}
+
+func (m *PendingPayInfo) GetIngressState() string {
+	if m != nil {
+		return m.IngressState
+	}
+	return ""
+}
+
+func (m *PendingPayInfo) GetEgressCid() string {
+	if m != nil {
+		return m.EgressCid
+	}
+	return ""
+}
+
+func (m *PendingPayInfo) GetEgressState() string {
+	if m != nil {
+		return m.EgressState
+	}
+	return ""
+}
+
+func (m *PendingPayInfo) GetResolveDeadline() uint64 {
+	if m != nil {
+		return m.ResolveDeadline
+	}
+	return 0
+}
+
+// Next Tag: 2
+type PendingPaysResponse struct {
+	Pays                 []*PendingPayInfo `protobuf:"bytes,1,rep,name=pays,proto3" json:"pays,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *PendingPaysResponse) Reset()         { *m = PendingPaysResponse{} }
+func (m *PendingPaysResponse) String() string { return proto.CompactTextString(m) }
+func (*PendingPaysResponse) ProtoMessage()    {}
+func (*PendingPaysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{28}
+}
+
+func (m *PendingPaysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingPaysResponse.Unmarshal(m, b)
+}
+func (m *PendingPaysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingPaysResponse.Marshal(b, m, deterministic)
+}
+func (m *PendingPaysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingPaysResponse.Merge(m, src)
+}
+func (m *PendingPaysResponse) XXX_Size() int {
+	return xxx_messageInfo_PendingPaysResponse.Size(m)
+}
+func (m *PendingPaysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingPaysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingPaysResponse proto.InternalMessageInfo
+
+func (m *PendingPaysResponse) GetPays() []*PendingPayInfo {
+	if m != nil {
+		return m.Pays
+	}
+	return nil
+}
+
+// Admin request to list deposit jobs.
+// Next Tag: 2
+type DepositJobsRequest struct {
+	// hex string of channel id, all running jobs if empty
+	Cid                  string   `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DepositJobsRequest) Reset()         { *m = DepositJobsRequest{} }
+func (m *DepositJobsRequest) String() string { return proto.CompactTextString(m) }
+func (*DepositJobsRequest) ProtoMessage()    {}
+func (*DepositJobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{29}
+}
+
+func (m *DepositJobsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DepositJobsRequest.Unmarshal(m, b)
+}
+func (m *DepositJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DepositJobsRequest.Marshal(b, m, deterministic)
+}
+func (m *DepositJobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositJobsRequest.Merge(m, src)
+}
+func (m *DepositJobsRequest) XXX_Size() int {
+	return xxx_messageInfo_DepositJobsRequest.Size(m)
+}
+func (m *DepositJobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositJobsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositJobsRequest proto.InternalMessageInfo
+
+func (m *DepositJobsRequest) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+// Next Tag: 10
+type DepositJobInfo struct {
+	DepositId    string       `protobuf:"bytes,1,opt,name=deposit_id,json=depositId,proto3" json:"deposit_id,omitempty"`
+	Cid          string       `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+	ToPeer       bool         `protobuf:"varint,3,opt,name=to_peer,json=toPeer,proto3" json:"to_peer,omitempty"`
+	AmtWei       string       `protobuf:"bytes,4,opt,name=amt_wei,json=amtWei,proto3" json:"amt_wei,omitempty"`
+	Refill       bool         `protobuf:"varint,5,opt,name=refill,proto3" json:"refill,omitempty"`
+	DepositState DepositState `protobuf:"varint,6,opt,name=deposit_state,json=depositState,proto3,enum=rpc.DepositState" json:"deposit_state,omitempty"`
+	TxHash       string       `protobuf:"bytes,7,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Error        string       `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	// unix timestamp in seconds
+	DeadlineTs           int64    `protobuf:"varint,9,opt,name=deadline_ts,json=deadlineTs,proto3" json:"deadline_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DepositJobInfo) Reset()         { *m = DepositJobInfo{} }
+func (m *DepositJobInfo) String() string { return proto.CompactTextString(m) }
+func (*DepositJobInfo) ProtoMessage()    {}
+func (*DepositJobInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{30}
+}
+
+func (m *DepositJobInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DepositJobInfo.Unmarshal(m, b)
+}
+func (m *DepositJobInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DepositJobInfo.Marshal(b, m, deterministic)
+}
+func (m *DepositJobInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositJobInfo.Merge(m, src)
+}
+func (m *DepositJobInfo) XXX_Size() int {
+	return xxx_messageInfo_DepositJobInfo.Size(m)
+}
+func (m *DepositJobInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositJobInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositJobInfo proto.InternalMessageInfo
+
+func (m *DepositJobInfo) GetDepositId() string {
+	if m != nil {
+		return m.DepositId
+	}
+	return ""
+}
+
+func (m *DepositJobInfo) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+func (m *DepositJobInfo) GetToPeer() bool {
+	if m != nil {
+		return m.ToPeer
+	}
+	return false
+}
+
+func (m *DepositJobInfo) GetAmtWei() string {
+	if m != nil {
+		return m.AmtWei
+	}
+	return ""
+}
+
+func (m *DepositJobInfo) GetRefill() bool {
+	if m != nil {
+		return m.Refill
+	}
+	return false
+}
+
+func (m *DepositJobInfo) GetDepositState() DepositState {
+	if m != nil {
+		return m.DepositState
+	}
+	return DepositState_Deposit_NOT_FOUND
+}
+
+func (m *DepositJobInfo) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *DepositJobInfo) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *DepositJobInfo) GetDeadlineTs() int64 {
+	if m != nil {
+		return m.DeadlineTs
+	}
+	return 0
+}
+
+// Next Tag: 2
+type DepositJobsResponse struct {
+	Jobs                 []*DepositJobInfo `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DepositJobsResponse) Reset()         { *m = DepositJobsResponse{} }
+func (m *DepositJobsResponse) String() string { return proto.CompactTextString(m) }
+func (*DepositJobsResponse) ProtoMessage()    {}
+func (*DepositJobsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{31}
+}
+
+func (m *DepositJobsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DepositJobsResponse.Unmarshal(m, b)
+}
+func (m *DepositJobsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DepositJobsResponse.Marshal(b, m, deterministic)
+}
+func (m *DepositJobsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositJobsResponse.Merge(m, src)
+}
+func (m *DepositJobsResponse) XXX_Size() int {
+	return xxx_messageInfo_DepositJobsResponse.Size(m)
+}
+func (m *DepositJobsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositJobsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositJobsResponse proto.InternalMessageInfo
+
+func (m *DepositJobsResponse) GetJobs() []*DepositJobInfo {
+	if m != nil {
+		return m.Jobs
+	}
+	return nil
+}
+
+// Admin request to list the routing table.
+// Next Tag: 2
+type RoutingTableRequest struct {
+	// all tokens if empty
+	TokenAddress         []byte   `protobuf:"bytes,1,opt,name=token_address,json=tokenAddress,proto3" json:"token_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RoutingTableRequest) Reset()         { *m = RoutingTableRequest{} }
+func (m *RoutingTableRequest) String() string { return proto.CompactTextString(m) }
+func (*RoutingTableRequest) ProtoMessage()    {}
+func (*RoutingTableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{32}
+}
+
+func (m *RoutingTableRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoutingTableRequest.Unmarshal(m, b)
+}
+func (m *RoutingTableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoutingTableRequest.Marshal(b, m, deterministic)
+}
+func (m *RoutingTableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoutingTableRequest.Merge(m, src)
+}
+func (m *RoutingTableRequest) XXX_Size() int {
+	return xxx_messageInfo_RoutingTableRequest.Size(m)
+}
+func (m *RoutingTableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoutingTableRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoutingTableRequest proto.InternalMessageInfo
+
+func (m *RoutingTableRequest) GetTokenAddress() []byte {
+	if m != nil {
+		return m.TokenAddress
+	}
+	return nil
+}
+
+// Next Tag: 5
+type RouteInfo struct {
+	DestAddress          string   `protobuf:"bytes,1,opt,name=dest_address,json=destAddress,proto3" json:"dest_address,omitempty"`
+	TokenAddress         string   `protobuf:"bytes,2,opt,name=token_address,json=tokenAddress,proto3" json:"token_address,omitempty"`
+	NextHopCid           string   `protobuf:"bytes,3,opt,name=next_hop_cid,json=nextHopCid,proto3" json:"next_hop_cid,omitempty"`
+	NextHopAddress       string   `protobuf:"bytes,4,opt,name=next_hop_address,json=nextHopAddress,proto3" json:"next_hop_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RouteInfo) Reset()         { *m = RouteInfo{} }
+func (m *RouteInfo) String() string { return proto.CompactTextString(m) }
+func (*RouteInfo) ProtoMessage()    {}
+func (*RouteInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{33}
+}
+
+func (m *RouteInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RouteInfo.Unmarshal(m, b)
+}
+func (m *RouteInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RouteInfo.Marshal(b, m, deterministic)
+}
+func (m *RouteInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteInfo.Merge(m, src)
+}
+func (m *RouteInfo) XXX_Size() int {
+	return xxx_messageInfo_RouteInfo.Size(m)
+}
+func (m *RouteInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteInfo proto.InternalMessageInfo
+
+func (m *RouteInfo) GetDestAddress() string {
+	if m != nil {
+		return m.DestAddress
+	}
+	return ""
+}
+
+func (m *RouteInfo) GetTokenAddress() string {
+	if m != nil {
+		return m.TokenAddress
+	}
+	return ""
+}
+
+func (m *RouteInfo) GetNextHopCid() string {
+	if m != nil {
+		return m.NextHopCid
+	}
+	return ""
+}
+
+func (m *RouteInfo) GetNextHopAddress() string {
+	if m != nil {
+		return m.NextHopAddress
+	}
+	return ""
+}
+
+// Next Tag: 2
+type RoutingTableResponse struct {
+	Routes               []*RouteInfo `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *RoutingTableResponse) Reset()         { *m = RoutingTableResponse{} }
+func (m *RoutingTableResponse) String() string { return proto.CompactTextString(m) }
+func (*RoutingTableResponse) ProtoMessage()    {}
+func (*RoutingTableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{34}
+}
+
+func (m *RoutingTableResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoutingTableResponse.Unmarshal(m, b)
+}
+func (m *RoutingTableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoutingTableResponse.Marshal(b, m, deterministic)
+}
+func (m *RoutingTableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoutingTableResponse.Merge(m, src)
+}
+func (m *RoutingTableResponse) XXX_Size() int {
+	return xxx_messageInfo_RoutingTableResponse.Size(m)
+}
+func (m *RoutingTableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoutingTableResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoutingTableResponse proto.InternalMessageInfo
+
+func (m *RoutingTableResponse) GetRoutes() []*RouteInfo {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+// Admin request to list channels, filters are ignored if empty.
+// Next Tag: 6
+type ListChannelsRequest struct {
+	// hex string of peer address
+	Peer string `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
+	// hex string of token address, ETH is the zero address
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// channel state as defined in common/structs, all states if 0
+	State  int32  `protobuf:"varint,3,opt,name=state,proto3" json:"state,omitempty"`
+	Offset uint32 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	// max number of channels to return, use default if 0
+	Limit                uint32   `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListChannelsRequest) Reset()         { *m = ListChannelsRequest{} }
+func (m *ListChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListChannelsRequest) ProtoMessage()    {}
+func (*ListChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{35}
+}
+
+func (m *ListChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListChannelsRequest.Unmarshal(m, b)
+}
+func (m *ListChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListChannelsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListChannelsRequest.Merge(m, src)
+}
+func (m *ListChannelsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListChannelsRequest.Size(m)
+}
+func (m *ListChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListChannelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListChannelsRequest proto.InternalMessageInfo
+
+func (m *ListChannelsRequest) GetPeer() string {
+	if m != nil {
+		return m.Peer
+	}
+	return ""
+}
+
+func (m *ListChannelsRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *ListChannelsRequest) GetState() int32 {
+	if m != nil {
+		return m.State
+	}
+	return 0
+}
+
+func (m *ListChannelsRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListChannelsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// Next Tag: 7
+type ChannelInfo struct {
+	Cid          string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	PeerAddress  string `protobuf:"bytes,2,opt,name=peer_address,json=peerAddress,proto3" json:"peer_address,omitempty"`
+	TokenAddress string `protobuf:"bytes,3,opt,name=token_address,json=tokenAddress,proto3" json:"token_address,omitempty"`
+	State        string `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	// unix timestamp in seconds
+	OpenTs int64 `protobuf:"varint,5,opt,name=open_ts,json=openTs,proto3" json:"open_ts,omitempty"`
+	// unix timestamp in seconds of the last state change
+	StateTs              int64    `protobuf:"varint,6,opt,name=state_ts,json=stateTs,proto3" json:"state_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelInfo) Reset()         { *m = ChannelInfo{} }
+func (m *ChannelInfo) String() string { return proto.CompactTextString(m) }
+func (*ChannelInfo) ProtoMessage()    {}
+func (*ChannelInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{36}
+}
+
+func (m *ChannelInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelInfo.Unmarshal(m, b)
+}
+func (m *ChannelInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelInfo.Marshal(b, m, deterministic)
+}
+func (m *ChannelInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelInfo.Merge(m, src)
+}
+func (m *ChannelInfo) XXX_Size() int {
+	return xxx_messageInfo_ChannelInfo.Size(m)
+}
+func (m *ChannelInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelInfo proto.InternalMessageInfo
+
+func (m *ChannelInfo) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+func (m *ChannelInfo) GetPeerAddress() string {
+	if m != nil {
+		return m.PeerAddress
+	}
+	return ""
+}
+
+func (m *ChannelInfo) GetTokenAddress() string {
+	if m != nil {
+		return m.TokenAddress
+	}
+	return ""
+}
+
+func (m *ChannelInfo) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *ChannelInfo) GetOpenTs() int64 {
+	if m != nil {
+		return m.OpenTs
+	}
+	return 0
+}
+
+func (m *ChannelInfo) GetStateTs() int64 {
+	if m != nil {
+		return m.StateTs
+	}
+	return 0
+}
+
+// Next Tag: 2
+type ListChannelsResponse struct {
+	Channels             []*ChannelInfo `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListChannelsResponse) Reset()         { *m = ListChannelsResponse{} }
+func (m *ListChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListChannelsResponse) ProtoMessage()    {}
+func (*ListChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{37}
+}
+
+func (m *ListChannelsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListChannelsResponse.Unmarshal(m, b)
+}
+func (m *ListChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListChannelsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListChannelsResponse.Merge(m, src)
+}
+func (m *ListChannelsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListChannelsResponse.Size(m)
+}
+func (m *ListChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListChannelsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListChannelsResponse proto.InternalMessageInfo
+
+func (m *ListChannelsResponse) GetChannels() []*ChannelInfo {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+// Next Tag: 2
+type ChannelDetailRequest struct {
+	// hex string of channel id
+	Cid                  string   `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelDetailRequest) Reset()         { *m = ChannelDetailRequest{} }
+func (m *ChannelDetailRequest) String() string { return proto.CompactTextString(m) }
+func (*ChannelDetailRequest) ProtoMessage()    {}
+func (*ChannelDetailRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{38}
+}
+
+func (m *ChannelDetailRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelDetailRequest.Unmarshal(m, b)
+}
+func (m *ChannelDetailRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelDetailRequest.Marshal(b, m, deterministic)
+}
+func (m *ChannelDetailRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelDetailRequest.Merge(m, src)
+}
+func (m *ChannelDetailRequest) XXX_Size() int {
+	return xxx_messageInfo_ChannelDetailRequest.Size(m)
+}
+func (m *ChannelDetailRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelDetailRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelDetailRequest proto.InternalMessageInfo
+
+func (m *ChannelDetailRequest) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+// Next Tag: 10
+type ChannelDetailResponse struct {
+	Channel *ChannelInfo `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// off-chain balances, only set for opened channels
+	Balance           *ChannelBalanceInfo `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	MyDepositWei      string              `protobuf:"bytes,3,opt,name=my_deposit_wei,json=myDepositWei,proto3" json:"my_deposit_wei,omitempty"`
+	MyWithdrawalWei   string              `protobuf:"bytes,4,opt,name=my_withdrawal_wei,json=myWithdrawalWei,proto3" json:"my_withdrawal_wei,omitempty"`
+	PeerDepositWei    string              `protobuf:"bytes,5,opt,name=peer_deposit_wei,json=peerDepositWei,proto3" json:"peer_deposit_wei,omitempty"`
+	PeerWithdrawalWei string              `protobuf:"bytes,6,opt,name=peer_withdrawal_wei,json=peerWithdrawalWei,proto3" json:"peer_withdrawal_wei,omitempty"`
+	SelfSeqNum        uint64              `protobuf:"varint,7,opt,name=self_seq_num,json=selfSeqNum,proto3" json:"self_seq_num,omitempty"`
+	PeerSeqNum        uint64              `protobuf:"varint,8,opt,name=peer_seq_num,json=peerSeqNum,proto3" json:"peer_seq_num,omitempty"`
+	// number of pending pays in both directions
+	PendingPays          uint32   `protobuf:"varint,9,opt,name=pending_pays,json=pendingPays,proto3" json:"pending_pays,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelDetailResponse) Reset()         { *m = ChannelDetailResponse{} }
+func (m *ChannelDetailResponse) String() string { return proto.CompactTextString(m) }
+func (*ChannelDetailResponse) ProtoMessage()    {}
+func (*ChannelDetailResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{39}
+}
+
+func (m *ChannelDetailResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelDetailResponse.Unmarshal(m, b)
+}
+func (m *ChannelDetailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelDetailResponse.Marshal(b, m, deterministic)
+}
+func (m *ChannelDetailResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelDetailResponse.Merge(m, src)
+}
+func (m *ChannelDetailResponse) XXX_Size() int {
+	return xxx_messageInfo_ChannelDetailResponse.Size(m)
+}
+func (m *ChannelDetailResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelDetailResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelDetailResponse proto.InternalMessageInfo
+
+func (m *ChannelDetailResponse) GetChannel() *ChannelInfo {
+	if m != nil {
+		return m.Channel
+	}
+	return nil
+}
+
+func (m *ChannelDetailResponse) GetBalance() *ChannelBalanceInfo {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+func (m *ChannelDetailResponse) GetMyDepositWei() string {
+	if m != nil {
+		return m.MyDepositWei
+	}
+	return ""
+}
+
+func (m *ChannelDetailResponse) GetMyWithdrawalWei() string {
+	if m != nil {
+		return m.MyWithdrawalWei
+	}
+	return ""
+}
+
+func (m *ChannelDetailResponse) GetPeerDepositWei() string {
+	if m != nil {
+		return m.PeerDepositWei
+	}
+	return ""
+}
+
+func (m *ChannelDetailResponse) GetPeerWithdrawalWei() string {
+	if m != nil {
+		return m.PeerWithdrawalWei
+	}
+	return ""
+}
+
+func (m *ChannelDetailResponse) GetSelfSeqNum() uint64 {
+	if m != nil {
+		return m.SelfSeqNum
+	}
+	return 0
+}
+
+func (m *ChannelDetailResponse) GetPeerSeqNum() uint64 {
+	if m != nil {
+		return m.PeerSeqNum
+	}
+	return 0
+}
+
+func (m *ChannelDetailResponse) GetPendingPays() uint32 {
+	if m != nil {
+		return m.PendingPays
+	}
+	return 0
+}
+
+// Next Tag: 2
+type GetPaymentRequest struct {
+	// hex string of pay id
+	PayId                string   `protobuf:"bytes,1,opt,name=pay_id,json=payId,proto3" json:"pay_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPaymentRequest) Reset()         { *m = GetPaymentRequest{} }
+func (m *GetPaymentRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPaymentRequest) ProtoMessage()    {}
+func (*GetPaymentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{40}
+}
+
+func (m *GetPaymentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPaymentRequest.Unmarshal(m, b)
+}
+func (m *GetPaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPaymentRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPaymentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPaymentRequest.Merge(m, src)
+}
+func (m *GetPaymentRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPaymentRequest.Size(m)
+}
+func (m *GetPaymentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPaymentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPaymentRequest proto.InternalMessageInfo
+
+func (m *GetPaymentRequest) GetPayId() string {
+	if m != nil {
+		return m.PayId
+	}
+	return ""
+}
+
+// Next Tag: 18
+type PaymentInfo struct {
+	PayId           string `protobuf:"bytes,1,opt,name=pay_id,json=payId,proto3" json:"pay_id,omitempty"`
+	Src             string `protobuf:"bytes,2,opt,name=src,proto3" json:"src,omitempty"`
+	Dest            string `protobuf:"bytes,3,opt,name=dest,proto3" json:"dest,omitempty"`
+	TokenAddress    string `protobuf:"bytes,4,opt,name=token_address,json=tokenAddress,proto3" json:"token_address,omitempty"`
+	AmtWei          string `protobuf:"bytes,5,opt,name=amt_wei,json=amtWei,proto3" json:"amt_wei,omitempty"`
+	IngressCid      string `protobuf:"bytes,6,opt,name=ingress_cid,json=ingressCid,proto3" json:"ingress_cid,omitempty"`
+	IngressPeer     string `protobuf:"bytes,7,opt,name=ingress_peer,json=ingressPeer,proto3" json:"ingress_peer,omitempty"`
+	IngressState    string `protobuf:"bytes,8,opt,name=ingress_state,json=ingressState,proto3" json:"ingress_state,omitempty"`
+	EgressCid       string `protobuf:"bytes,9,opt,name=egress_cid,json=egressCid,proto3" json:"egress_cid,omitempty"`
+	EgressPeer      string `protobuf:"bytes,10,opt,name=egress_peer,json=egressPeer,proto3" json:"egress_peer,omitempty"`
+	EgressState     string `protobuf:"bytes,11,opt,name=egress_state,json=egressState,proto3" json:"egress_state,omitempty"`
+	ResolveDeadline uint64 `protobuf:"varint,12,opt,name=resolve_deadline,json=resolveDeadline,proto3" json:"resolve_deadline,omitempty"`
+	// unix timestamp in seconds
+	CreateTs int64 `protobuf:"varint,13,opt,name=create_ts,json=createTs,proto3" json:"create_ts,omitempty"`
+	// json string of the pay note
+	Note string `protobuf:"bytes,14,opt,name=note,proto3" json:"note,omitempty"`
+	// decimal strings of the relay fees locked with the pay by the ingress peer and to the egress peer,
+	// only transferred if the pay is paid
+	FeeInWei  string `protobuf:"bytes,15,opt,name=fee_in_wei,json=feeInWei,proto3" json:"fee_in_wei,omitempty"`
+	FeeOutWei string `protobuf:"bytes,16,opt,name=fee_out_wei,json=feeOutWei,proto3" json:"fee_out_wei,omitempty"`
+	// decimal string of the relay fee earned by forwarding the pay, negative if paid by myself
+	EarnedFeeWei         string   `protobuf:"bytes,17,opt,name=earned_fee_wei,json=earnedFeeWei,proto3" json:"earned_fee_wei,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaymentInfo) Reset()         { *m = PaymentInfo{} }
+func (m *PaymentInfo) String() string { return proto.CompactTextString(m) }
+func (*PaymentInfo) ProtoMessage()    {}
+func (*PaymentInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{41}
+}
+
+func (m *PaymentInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaymentInfo.Unmarshal(m, b)
+}
+func (m *PaymentInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaymentInfo.Marshal(b, m, deterministic)
+}
+func (m *PaymentInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentInfo.Merge(m, src)
+}
+func (m *PaymentInfo) XXX_Size() int {
+	return xxx_messageInfo_PaymentInfo.Size(m)
+}
+func (m *PaymentInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentInfo proto.InternalMessageInfo
+
+func (m *PaymentInfo) GetPayId() string {
+	if m != nil {
+		return m.PayId
+	}
+	return ""
+}
+
+func (m *PaymentInfo) GetSrc() string {
+	if m != nil {
+		return m.Src
+	}
+	return ""
+}
+
+func (m *PaymentInfo) GetDest() string {
+	if m != nil {
+		return m.Dest
+	}
+	return ""
+}
+
+func (m *PaymentInfo) GetTokenAddress() string {
+	if m != nil {
+		return m.TokenAddress
+	}
+	return ""
+}
+
+func (m *PaymentInfo) GetAmtWei() string {
+	if m != nil {
+		return m.AmtWei
+	}
+	return ""
+}
+
+func (m *PaymentInfo) GetIngressCid() string {
+	if m != nil {
+		return m.IngressCid
+	}
+	return ""
+}
+
+func (m *PaymentInfo) GetIngressPeer() string {
+	if m != nil {
+		return m.IngressPeer
+	}
+	return ""
+}
+
+func (m *PaymentInfo) GetIngressState() string {
+	if m != nil {
+		return m.IngressState
+	}
+	return ""
+}
+
+func (m *PaymentInfo) GetEgressCid() string {
+	if m != nil {
+		return m.EgressCid
+	}
+	return ""
+}
+
+func (m *PaymentInfo) GetEgressPeer() string {
+	if m != nil {
+		return m.EgressPeer
+	}
+	return ""
+}
+
+func (m *PaymentInfo) GetEgressState() string {
+	if m != nil {
+		return m.EgressState
+	}
+	return ""
+}
+
+func (m *PaymentInfo) GetResolveDeadline() uint64 {
+	if m != nil {
+		return m.ResolveDeadline
+	}
+	return 0
+}
+
+func (m *PaymentInfo) GetCreateTs() int64 {
+	if m != nil {
+		return m.CreateTs
+	}
+	return 0
+}
+
+func (m *PaymentInfo) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+func (m *PaymentInfo) GetFeeInWei() string {
+	if m != nil {
+		return m.FeeInWei
+	}
+	return ""
+}
+
+func (m *PaymentInfo) GetFeeOutWei() string {
+	if m != nil {
+		return m.FeeOutWei
+	}
+	return ""
+}
+
+func (m *PaymentInfo) GetEarnedFeeWei() string {
+	if m != nil {
+		return m.EarnedFeeWei
+	}
+	return ""
+}
+
+// Next Tag: 2
+type GetPaymentResponse struct {
+	Payment              *PaymentInfo `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GetPaymentResponse) Reset()         { *m = GetPaymentResponse{} }
+func (m *GetPaymentResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPaymentResponse) ProtoMessage()    {}
+func (*GetPaymentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{42}
+}
+
+func (m *GetPaymentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPaymentResponse.Unmarshal(m, b)
+}
+func (m *GetPaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPaymentResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPaymentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPaymentResponse.Merge(m, src)
+}
+func (m *GetPaymentResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPaymentResponse.Size(m)
+}
+func (m *GetPaymentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPaymentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPaymentResponse proto.InternalMessageInfo
+
+func (m *GetPaymentResponse) GetPayment() *PaymentInfo {
+	if m != nil {
+		return m.Payment
+	}
+	return nil
+}
+
+// Admin request to list pays of a channel, in reverse-chronological order.
+// Next Tag: 4
+type ListChannelPaysRequest struct {
+	// hex string of channel id
+	Cid    string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Offset uint32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// max number of pays to return, use default if 0
+	Limit                uint32   `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListChannelPaysRequest) Reset()         { *m = ListChannelPaysRequest{} }
+func (m *ListChannelPaysRequest) String() string { return proto.CompactTextString(m) }
+func (*ListChannelPaysRequest) ProtoMessage()    {}
+func (*ListChannelPaysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{43}
+}
+
+func (m *ListChannelPaysRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListChannelPaysRequest.Unmarshal(m, b)
+}
+func (m *ListChannelPaysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListChannelPaysRequest.Marshal(b, m, deterministic)
+}
+func (m *ListChannelPaysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListChannelPaysRequest.Merge(m, src)
+}
+func (m *ListChannelPaysRequest) XXX_Size() int {
+	return xxx_messageInfo_ListChannelPaysRequest.Size(m)
+}
+func (m *ListChannelPaysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListChannelPaysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListChannelPaysRequest proto.InternalMessageInfo
+
+func (m *ListChannelPaysRequest) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+func (m *ListChannelPaysRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListChannelPaysRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// Next Tag: 3
+type ListChannelPaysResponse struct {
+	Pays []*PaymentInfo `protobuf:"bytes,1,rep,name=pays,proto3" json:"pays,omitempty"`
+	// total number of pays of the channel
+	Total                uint32   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListChannelPaysResponse) Reset()         { *m = ListChannelPaysResponse{} }
+func (m *ListChannelPaysResponse) String() string { return proto.CompactTextString(m) }
+func (*ListChannelPaysResponse) ProtoMessage()    {}
+func (*ListChannelPaysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{44}
+}
+
+func (m *ListChannelPaysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListChannelPaysResponse.Unmarshal(m, b)
+}
+func (m *ListChannelPaysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListChannelPaysResponse.Marshal(b, m, deterministic)
+}
+func (m *ListChannelPaysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListChannelPaysResponse.Merge(m, src)
+}
+func (m *ListChannelPaysResponse) XXX_Size() int {
+	return xxx_messageInfo_ListChannelPaysResponse.Size(m)
+}
+func (m *ListChannelPaysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListChannelPaysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListChannelPaysResponse proto.InternalMessageInfo
+
+func (m *ListChannelPaysResponse) GetPays() []*PaymentInfo {
+	if m != nil {
+		return m.Pays
+	}
+	return nil
+}
+
+func (m *ListChannelPaysResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+// Next Tag: 2
+type ChannelStatusRequest struct {
+	// hex string of channel id
+	Cid                  string   `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelStatusRequest) Reset()         { *m = ChannelStatusRequest{} }
+func (m *ChannelStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*ChannelStatusRequest) ProtoMessage()    {}
+func (*ChannelStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{45}
+}
+
+func (m *ChannelStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelStatusRequest.Unmarshal(m, b)
+}
+func (m *ChannelStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *ChannelStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelStatusRequest.Merge(m, src)
+}
+func (m *ChannelStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_ChannelStatusRequest.Size(m)
+}
+func (m *ChannelStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelStatusRequest proto.InternalMessageInfo
+
+func (m *ChannelStatusRequest) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+// Message delivery status of a channel.
+// Next Tag: 15
+type ChannelStatusResponse struct {
+	Cid         string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	PeerAddress string `protobuf:"bytes,2,opt,name=peer_address,json=peerAddress,proto3" json:"peer_address,omitempty"`
+	// msg queue fields below are only set if the peer is connected
+	PeerConnected bool `protobuf:"varint,3,opt,name=peer_connected,json=peerConnected,proto3" json:"peer_connected,omitempty"`
+	// persisted simplex seq nums
+	BaseSeqNum       uint64 `protobuf:"varint,4,opt,name=base_seq_num,json=baseSeqNum,proto3" json:"base_seq_num,omitempty"`
+	LastUsedSeqNum   uint64 `protobuf:"varint,5,opt,name=last_used_seq_num,json=lastUsedSeqNum,proto3" json:"last_used_seq_num,omitempty"`
+	LastAckedSeqNum  uint64 `protobuf:"varint,6,opt,name=last_acked_seq_num,json=lastAckedSeqNum,proto3" json:"last_acked_seq_num,omitempty"`
+	LastNackedSeqNum uint64 `protobuf:"varint,7,opt,name=last_nacked_seq_num,json=lastNackedSeqNum,proto3" json:"last_nacked_seq_num,omitempty"`
+	// last seq num sent by the msg queue
+	SentSeqNum uint64 `protobuf:"varint,8,opt,name=sent_seq_num,json=sentSeqNum,proto3" json:"sent_seq_num,omitempty"`
+	// messages sent and not yet acked
+	InFlight uint32 `protobuf:"varint,9,opt,name=in_flight,json=inFlight,proto3" json:"in_flight,omitempty"`
+	// max number of in-flight messages, adapted to the peer ack latency and nacks
+	Window uint32 `protobuf:"varint,10,opt,name=window,proto3" json:"window,omitempty"`
+	// smoothed ack latency of the peer
+	AckLatencyMs uint64 `protobuf:"varint,11,opt,name=ack_latency_ms,json=ackLatencyMs,proto3" json:"ack_latency_ms,omitempty"`
+	Nacks        uint64 `protobuf:"varint,12,opt,name=nacks,proto3" json:"nacks,omitempty"`
+	// acks slower than the ack_latency_target_ms of the runtime config
+	SlowAcks uint64 `protobuf:"varint,13,opt,name=slow_acks,json=slowAcks,proto3" json:"slow_acks,omitempty"`
+	// nacks of invalid messages, excluding retryable errors such as rate limited or no route
+	ViolationNacks       uint64   `protobuf:"varint,14,opt,name=violation_nacks,json=violationNacks,proto3" json:"violation_nacks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelStatusResponse) Reset()         { *m = ChannelStatusResponse{} }
+func (m *ChannelStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*ChannelStatusResponse) ProtoMessage()    {}
+func (*ChannelStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{46}
+}
+
+func (m *ChannelStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelStatusResponse.Unmarshal(m, b)
+}
+func (m *ChannelStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *ChannelStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelStatusResponse.Merge(m, src)
+}
+func (m *ChannelStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_ChannelStatusResponse.Size(m)
+}
+func (m *ChannelStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelStatusResponse proto.InternalMessageInfo
+
+func (m *ChannelStatusResponse) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+func (m *ChannelStatusResponse) GetPeerAddress() string {
+	if m != nil {
+		return m.PeerAddress
+	}
+	return ""
+}
+
+func (m *ChannelStatusResponse) GetPeerConnected() bool {
+	if m != nil {
+		return m.PeerConnected
+	}
+	return false
+}
+
+func (m *ChannelStatusResponse) GetBaseSeqNum() uint64 {
+	if m != nil {
+		return m.BaseSeqNum
+	}
+	return 0
+}
+
+func (m *ChannelStatusResponse) GetLastUsedSeqNum() uint64 {
+	if m != nil {
+		return m.LastUsedSeqNum
+	}
+	return 0
+}
+
+func (m *ChannelStatusResponse) GetLastAckedSeqNum() uint64 {
+	if m != nil {
+		return m.LastAckedSeqNum
+	}
+	return 0
+}
+
+func (m *ChannelStatusResponse) GetLastNackedSeqNum() uint64 {
+	if m != nil {
+		return m.LastNackedSeqNum
+	}
+	return 0
+}
+
+func (m *ChannelStatusResponse) GetSentSeqNum() uint64 {
+	if m != nil {
+		return m.SentSeqNum
+	}
+	return 0
+}
+
+func (m *ChannelStatusResponse) GetInFlight() uint32 {
+	if m != nil {
+		return m.InFlight
+	}
+	return 0
+}
+
+func (m *ChannelStatusResponse) GetWindow() uint32 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *ChannelStatusResponse) GetAckLatencyMs() uint64 {
+	if m != nil {
+		return m.AckLatencyMs
+	}
+	return 0
+}
+
+func (m *ChannelStatusResponse) GetNacks() uint64 {
+	if m != nil {
+		return m.Nacks
+	}
+	return 0
+}
+
+func (m *ChannelStatusResponse) GetSlowAcks() uint64 {
+	if m != nil {
+		return m.SlowAcks
+	}
+	return 0
+}
+
+func (m *ChannelStatusResponse) GetViolationNacks() uint64 {
+	if m != nil {
+		return m.ViolationNacks
+	}
+	return 0
+}
+
+// Admin request to drain the node before shutdown.
+// Next Tag: 2
+type DrainRequest struct {
+	// max seconds to wait for outstanding pays and msgs, use default if 0
+	TimeoutSec           uint32   `protobuf:"varint,1,opt,name=timeout_sec,json=timeoutSec,proto3" json:"timeout_sec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DrainRequest) Reset()         { *m = DrainRequest{} }
+func (m *DrainRequest) String() string { return proto.CompactTextString(m) }
+func (*DrainRequest) ProtoMessage()    {}
+func (*DrainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{47}
+}
+
+func (m *DrainRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DrainRequest.Unmarshal(m, b)
+}
+func (m *DrainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DrainRequest.Marshal(b, m, deterministic)
+}
+func (m *DrainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainRequest.Merge(m, src)
+}
+func (m *DrainRequest) XXX_Size() int {
+	return xxx_messageInfo_DrainRequest.Size(m)
+}
+func (m *DrainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrainRequest proto.InternalMessageInfo
+
+func (m *DrainRequest) GetTimeoutSec() uint32 {
+	if m != nil {
+		return m.TimeoutSec
+	}
+	return 0
+}
+
+// Outstanding work left when the drain finished.
+// Next Tag: 7
+type DrainResponse struct {
+	// true if no outstanding work was left before the timeout
+	Completed  bool   `protobuf:"varint,1,opt,name=completed,proto3" json:"completed,omitempty"`
+	ElapsedSec uint32 `protobuf:"varint,2,opt,name=elapsed_sec,json=elapsedSec,proto3" json:"elapsed_sec,omitempty"`
+	// pays waiting for the peer to co-sign their egress states
+	PendingPays uint32 `protobuf:"varint,3,opt,name=pending_pays,json=pendingPays,proto3" json:"pending_pays,omitempty"`
+	// msg queue messages not acked by the peers
+	UnackedMsgs uint32 `protobuf:"varint,4,opt,name=unacked_msgs,json=unackedMsgs,proto3" json:"unacked_msgs,omitempty"`
+	// channels with unacked messages
+	UnackedCids uint32 `protobuf:"varint,5,opt,name=unacked_cids,json=unackedCids,proto3" json:"unacked_cids,omitempty"`
+	// celer streams closed at the end of the drain
+	ClosedStreams        uint32   `protobuf:"varint,6,opt,name=closed_streams,json=closedStreams,proto3" json:"closed_streams,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DrainResponse) Reset()         { *m = DrainResponse{} }
+func (m *DrainResponse) String() string { return proto.CompactTextString(m) }
+func (*DrainResponse) ProtoMessage()    {}
+func (*DrainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a58c2d65cdc11488, []int{48}
+}
+
+func (m *DrainResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DrainResponse.Unmarshal(m, b)
+}
+func (m *DrainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DrainResponse.Marshal(b, m, deterministic)
+}
+func (m *DrainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainResponse.Merge(m, src)
+}
+func (m *DrainResponse) XXX_Size() int {
+	return xxx_messageInfo_DrainResponse.Size(m)
+}
+func (m *DrainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrainResponse proto.InternalMessageInfo
+
+func (m *DrainResponse) GetCompleted() bool {
+	if m != nil {
+		return m.Completed
+	}
+	return false
+}
+
+func (m *DrainResponse) GetElapsedSec() uint32 {
+	if m != nil {
+		return m.ElapsedSec
+	}
+	return 0
+}
+
+func (m *DrainResponse) GetPendingPays() uint32 {
+	if m != nil {
+		return m.PendingPays
+	}
+	return 0
+}
+
+func (m *DrainResponse) GetUnackedMsgs() uint32 {
+	if m != nil {
+		return m.UnackedMsgs
+	}
+	return 0
+}
+
+func (m *DrainResponse) GetUnackedCids() uint32 {
+	if m != nil {
+		return m.UnackedCids
+	}
+	return 0
+}
+
+func (m *DrainResponse) GetClosedStreams() uint32 {
+	if m != nil {
+		return m.ClosedStreams
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("rpc.DepositState", DepositState_name, DepositState_value)
+	proto.RegisterEnum("rpc.BatchOpenChannelState", BatchOpenChannelState_name, BatchOpenChannelState_value)
+	proto.RegisterType((*RegisterStreamRequest)(nil), "rpc.RegisterStreamRequest")
+	proto.RegisterType((*SendTokenRequest)(nil), "rpc.SendTokenRequest")
+	proto.RegisterType((*SendTokenResponse)(nil), "rpc.SendTokenResponse")
+	proto.RegisterType((*DepositRequest)(nil), "rpc.DepositRequest")
+	proto.RegisterType((*DepositResponse)(nil), "rpc.DepositResponse")
+	proto.RegisterType((*QueryDepositRequest)(nil), "rpc.QueryDepositRequest")
+	proto.RegisterType((*QueryDepositResponse)(nil), "rpc.QueryDepositResponse")
+	proto.RegisterType((*OspOpenChannelRequest)(nil), "rpc.OspOpenChannelRequest")
+	proto.RegisterType((*OspBatchOpenChannelRequest)(nil), "rpc.OspBatchOpenChannelRequest")
+	proto.RegisterType((*BatchOpenChannelProgress)(nil), "rpc.BatchOpenChannelProgress")
+	proto.RegisterType((*BuildRoutingTableRequest)(nil), "rpc.BuildRoutingTableRequest")
+	proto.RegisterType((*ClearExpiredPaysRequest)(nil), "rpc.ClearExpiredPaysRequest")
+	proto.RegisterType((*ConfirmOnChainResolvedPaysRequest)(nil), "rpc.ConfirmOnChainResolvedPaysRequest")
+	proto.RegisterType((*TokenCidPair)(nil), "rpc.TokenCidPair")
+	proto.RegisterType((*PeerOsp)(nil), "rpc.PeerOsp")
+	proto.RegisterType((*PeerOspsResponse)(nil), "rpc.PeerOspsResponse")
+	proto.RegisterType((*ChannelOpRequest)(nil), "rpc.ChannelOpRequest")
+	proto.RegisterType((*ChannelOpResponse)(nil), "rpc.ChannelOpResponse")
+	proto.RegisterType((*GetPayTraceRequest)(nil), "rpc.GetPayTraceRequest")
+	proto.RegisterType((*GetPayTraceResponse)(nil), "rpc.GetPayTraceResponse")
+	proto.RegisterType((*RebalanceRequest)(nil), "rpc.RebalanceRequest")
+	proto.RegisterType((*RebalancePlan)(nil), "rpc.RebalancePlan")
+	proto.RegisterType((*RebalanceResponse)(nil), "rpc.RebalanceResponse")
+	proto.RegisterType((*ChannelBalancesRequest)(nil), "rpc.ChannelBalancesRequest")
+	proto.RegisterType((*ChannelBalanceInfo)(nil), "rpc.ChannelBalanceInfo")
+	proto.RegisterType((*ChannelBalancesResponse)(nil), "rpc.ChannelBalancesResponse")
+	proto.RegisterType((*PendingPaysRequest)(nil), "rpc.PendingPaysRequest")
+	proto.RegisterType((*PendingPayInfo)(nil), "rpc.PendingPayInfo")
+	proto.RegisterType((*PendingPaysResponse)(nil), "rpc.PendingPaysResponse")
+	proto.RegisterType((*DepositJobsRequest)(nil), "rpc.DepositJobsRequest")
+	proto.RegisterType((*DepositJobInfo)(nil), "rpc.DepositJobInfo")
+	proto.RegisterType((*DepositJobsResponse)(nil), "rpc.DepositJobsResponse")
+	proto.RegisterType((*RoutingTableRequest)(nil), "rpc.RoutingTableRequest")
+	proto.RegisterType((*RouteInfo)(nil), "rpc.RouteInfo")
+	proto.RegisterType((*RoutingTableResponse)(nil), "rpc.RoutingTableResponse")
+	proto.RegisterType((*ListChannelsRequest)(nil), "rpc.ListChannelsRequest")
+	proto.RegisterType((*ChannelInfo)(nil), "rpc.ChannelInfo")
+	proto.RegisterType((*ListChannelsResponse)(nil), "rpc.ListChannelsResponse")
+	proto.RegisterType((*ChannelDetailRequest)(nil), "rpc.ChannelDetailRequest")
+	proto.RegisterType((*ChannelDetailResponse)(nil), "rpc.ChannelDetailResponse")
+	proto.RegisterType((*GetPaymentRequest)(nil), "rpc.GetPaymentRequest")
+	proto.RegisterType((*PaymentInfo)(nil), "rpc.PaymentInfo")
+	proto.RegisterType((*GetPaymentResponse)(nil), "rpc.GetPaymentResponse")
+	proto.RegisterType((*ListChannelPaysRequest)(nil), "rpc.ListChannelPaysRequest")
+	proto.RegisterType((*ListChannelPaysResponse)(nil), "rpc.ListChannelPaysResponse")
+	proto.RegisterType((*ChannelStatusRequest)(nil), "rpc.ChannelStatusRequest")
+	proto.RegisterType((*ChannelStatusResponse)(nil), "rpc.ChannelStatusResponse")
+	proto.RegisterType((*DrainRequest)(nil), "rpc.DrainRequest")
+	proto.RegisterType((*DrainResponse)(nil), "rpc.DrainResponse")
+}
+
+func init() { proto.RegisterFile("osp_admin.proto", fileDescriptor_a58c2d65cdc11488) }
+
+var fileDescriptor_a58c2d65cdc11488 = []byte{
+	// 3221 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x39, 0x4d, 0x6f, 0x1c, 0xc7,
+	0xb1, 0x5e, 0xee, 0x07, 0x77, 0x6b, 0x3f, 0xb8, 0xec, 0x5d, 0x92, 0xcb, 0x15, 0x25, 0x52, 0x63,
+	0x59, 0xa2, 0xe4, 0x67, 0xae, 0x9f, 0x6c, 0x18, 0x78, 0x06, 0xec, 0xf7, 0x28, 0x92, 0x92, 0x69,
+	0xd8, 0x24, 0x3d, 0x5c, 0x41, 0x7e, 0x89, 0xe3, 0xc1, 0x70, 0xa6, 0xb9, 0x9c, 0x70, 0x76, 0x66,
+	0x34, 0xdd, 0x2b, 0x6a, 0x61, 0x18, 0x09, 0x9c, 0x53, 0x4e, 0x39, 0x38, 0xc7, 0x20, 0xf9, 0x0d,
+	0xf9, 0x03, 0x39, 0xe6, 0x98, 0x4b, 0x4e, 0x41, 0x80, 0x9c, 0xf2, 0x17, 0x72, 0xc9, 0x29, 0xe8,
+	0x8f, 0x99, 0xe9, 0x99, 0x9d, 0x95, 0x64, 0x01, 0x01, 0x72, 0x9b, 0xa9, 0xaa, 0xae, 0xaa, 0xae,
+	0xaa, 0xae, 0xaa, 0xae, 0x86, 0x25, 0x9f, 0x04, 0x86, 0x69, 0x8f, 0x1d, 0x6f, 0x27, 0x08, 0x7d,
+	0xea, 0xa3, 0x62, 0x18, 0x58, 0xfd, 0x8d, 0x91, 0xef, 0x8f, 0x5c, 0x3c, 0x30, 0x03, 0x67, 0x60,
+	0x7a, 0x9e, 0x4f, 0x4d, 0xea, 0xf8, 0x1e, 0x11, 0x24, 0xfd, 0x75, 0x89, 0xe5, 0x7f, 0x67, 0x93,
+	0xf3, 0x81, 0xe9, 0x4d, 0x25, 0xea, 0x5a, 0x16, 0x85, 0xc7, 0x01, 0x8d, 0x90, 0x0d, 0xec, 0x51,
+	0x27, 0xfe, 0x6b, 0x8e, 0x31, 0x21, 0xe6, 0x08, 0x8b, 0x5f, 0xed, 0x12, 0x56, 0x74, 0x3c, 0x72,
+	0x08, 0xc5, 0xe1, 0x29, 0x0d, 0xb1, 0x39, 0xd6, 0xf1, 0xd3, 0x09, 0x26, 0x14, 0x6d, 0x43, 0x3b,
+	0xc0, 0x38, 0x34, 0xc2, 0xc0, 0x32, 0x4c, 0xdb, 0x0e, 0x31, 0x21, 0xbd, 0xc2, 0x56, 0x61, 0xbb,
+	0xa6, 0xb7, 0x18, 0x5c, 0x0f, 0xac, 0x5d, 0x01, 0x8d, 0x29, 0x31, 0xbd, 0x88, 0x29, 0x17, 0xb6,
+	0x0a, 0xdb, 0x0d, 0x41, 0x79, 0x40, 0x2f, 0x24, 0xa5, 0xf6, 0xc7, 0x02, 0xb4, 0x4f, 0xb1, 0x67,
+	0x0f, 0xfd, 0x4b, 0xec, 0x45, 0x82, 0xd6, 0xa1, 0x6a, 0x13, 0xca, 0x57, 0x4a, 0x01, 0x8b, 0x36,
+	0xa1, 0x6c, 0x09, 0x5a, 0x83, 0x45, 0x73, 0x4c, 0x8d, 0x2b, 0xec, 0x70, 0x86, 0x35, 0xbd, 0x62,
+	0x8e, 0xe9, 0x13, 0xec, 0xa0, 0xeb, 0x00, 0x94, 0xf1, 0x10, 0xab, 0x8a, 0x1c, 0x57, 0xe3, 0x10,
+	0xbe, 0x6e, 0x1b, 0x4a, 0x9e, 0x4f, 0x71, 0xaf, 0xb4, 0x55, 0xd8, 0xae, 0xdf, 0xef, 0xee, 0x08,
+	0xeb, 0xec, 0x44, 0xd6, 0xd9, 0xd9, 0xf5, 0xa6, 0x3a, 0xa7, 0x40, 0x1b, 0x00, 0x4c, 0xb8, 0x87,
+	0xa9, 0xe1, 0xd8, 0xbd, 0xf2, 0x56, 0x61, 0xbb, 0xa4, 0x33, 0x75, 0x8e, 0x30, 0x3d, 0xb4, 0x99,
+	0xfc, 0x73, 0x8c, 0xb9, 0xfc, 0x8a, 0x90, 0x7f, 0x8e, 0xf1, 0x13, 0xec, 0x68, 0x5f, 0xc2, 0xb2,
+	0xb2, 0x0f, 0x12, 0xf8, 0x1e, 0xc1, 0x68, 0x15, 0x2a, 0x84, 0x9a, 0x74, 0x22, 0xec, 0x54, 0xd6,
+	0xe5, 0x1f, 0xea, 0x42, 0x19, 0x87, 0xa1, 0x1f, 0xca, 0x3d, 0x88, 0x1f, 0xb4, 0x02, 0x95, 0xc0,
+	0x9c, 0x32, 0xa9, 0x42, 0xfd, 0x72, 0x60, 0x4e, 0x0f, 0x6d, 0xed, 0x37, 0x05, 0x68, 0xed, 0xe3,
+	0xc0, 0x27, 0x0e, 0x8d, 0x0c, 0x74, 0x0d, 0x6a, 0xdc, 0xbe, 0x8a, 0x85, 0xaa, 0x0c, 0xc0, 0xb7,
+	0x9a, 0xb6, 0xc4, 0x42, 0xd6, 0x12, 0x6b, 0xb0, 0x48, 0x7d, 0x83, 0x51, 0x73, 0x31, 0x55, 0xbd,
+	0x42, 0xfd, 0x13, 0x8c, 0x53, 0xa6, 0x2d, 0xa5, 0x4c, 0xbb, 0x01, 0x30, 0x36, 0x9f, 0x1b, 0x57,
+	0xa6, 0x43, 0x0d, 0x12, 0x59, 0x64, 0x6c, 0x3e, 0x7f, 0x62, 0x3a, 0xf4, 0x54, 0xfb, 0x1a, 0x96,
+	0x62, 0xed, 0x5e, 0x6b, 0xdb, 0xd7, 0x01, 0x6c, 0xc1, 0x20, 0xd9, 0x7a, 0x4d, 0x42, 0x0e, 0x6d,
+	0xed, 0x7d, 0xe8, 0x7c, 0x31, 0xc1, 0xe1, 0x34, 0x63, 0x82, 0xf4, 0xaa, 0x42, 0x76, 0x95, 0x0d,
+	0xdd, 0xf4, 0x2a, 0xa9, 0xda, 0x07, 0xd0, 0x8c, 0x96, 0x31, 0xa5, 0x30, 0x5f, 0xd9, 0xba, 0xbf,
+	0xbc, 0x13, 0x06, 0xd6, 0x8e, 0x24, 0x3e, 0x65, 0x08, 0xbd, 0x61, 0x2b, 0x7f, 0xf9, 0xaa, 0x6b,
+	0xff, 0x2c, 0xc0, 0xca, 0x31, 0x09, 0x8e, 0x03, 0xec, 0xed, 0x5d, 0x98, 0x9e, 0x87, 0xdd, 0xec,
+	0x59, 0x51, 0x4f, 0x40, 0x21, 0xef, 0x04, 0xa0, 0x77, 0x23, 0x77, 0xd1, 0x69, 0x80, 0x7b, 0x0b,
+	0x52, 0x1d, 0x79, 0x40, 0x79, 0x38, 0x0d, 0xa7, 0x01, 0x96, 0x1e, 0x64, 0x9f, 0xe8, 0x4d, 0x68,
+	0x26, 0x0e, 0x66, 0x8c, 0x8b, 0x9c, 0x71, 0x23, 0xf6, 0x31, 0x63, 0x3b, 0x80, 0x2e, 0xc1, 0xee,
+	0xb9, 0x11, 0xed, 0x36, 0xed, 0xda, 0x65, 0x86, 0x93, 0xdb, 0xdd, 0x15, 0x5e, 0x1e, 0x40, 0x97,
+	0x6b, 0x9c, 0x5d, 0x50, 0x16, 0x0b, 0x18, 0x2e, 0xb5, 0x40, 0x1b, 0x42, 0xff, 0x98, 0x04, 0x0f,
+	0x4c, 0x6a, 0x5d, 0xe4, 0x18, 0xe0, 0x03, 0xa8, 0x5a, 0x02, 0xc2, 0x36, 0x5e, 0xdc, 0xae, 0xdf,
+	0xef, 0x73, 0x1b, 0xe7, 0x9a, 0x4b, 0x8f, 0x69, 0xb5, 0xbf, 0x14, 0xa0, 0x97, 0xe5, 0x79, 0x12,
+	0xfa, 0x23, 0xbe, 0xa9, 0x2e, 0x94, 0x1d, 0xcf, 0xc6, 0xcf, 0xb9, 0x29, 0x9b, 0xba, 0xf8, 0x79,
+	0xf5, 0x6c, 0xf3, 0x6a, 0x96, 0x7b, 0x17, 0xca, 0x22, 0x34, 0x4a, 0xdc, 0x17, 0x42, 0xed, 0xac,
+	0x4a, 0x22, 0x46, 0x04, 0x21, 0x6a, 0x43, 0xd1, 0x92, 0xb9, 0xa2, 0xa6, 0xb3, 0xcf, 0x24, 0x5c,
+	0x2a, 0x6a, 0xb8, 0xfc, 0x2f, 0xf4, 0x1e, 0x4c, 0x1c, 0xd7, 0xd6, 0xfd, 0x09, 0x75, 0xbc, 0xd1,
+	0xd0, 0x3c, 0x73, 0x71, 0x64, 0xaf, 0x19, 0xd5, 0x0a, 0xb3, 0xaa, 0x69, 0x1f, 0xc3, 0xda, 0x9e,
+	0x8b, 0xcd, 0xf0, 0xe0, 0x79, 0xe0, 0x84, 0xd8, 0x3e, 0x31, 0xa7, 0xe4, 0x07, 0xad, 0xff, 0x04,
+	0x6e, 0xee, 0xf9, 0xde, 0xb9, 0x13, 0x8e, 0x8f, 0xd9, 0x46, 0x1c, 0x4f, 0xc7, 0xc4, 0x77, 0x9f,
+	0xbd, 0x06, 0xa7, 0x03, 0x68, 0xf0, 0xd8, 0xdc, 0x73, 0xec, 0x13, 0xd3, 0x09, 0xf3, 0x17, 0xd5,
+	0x32, 0x96, 0x95, 0x76, 0x5a, 0x88, 0xed, 0xa4, 0x7d, 0x57, 0x80, 0x45, 0x96, 0x7c, 0x8e, 0x49,
+	0x80, 0x36, 0xa1, 0x2e, 0x4a, 0xa0, 0xca, 0x00, 0x7c, 0x12, 0x44, 0xcb, 0xff, 0x07, 0x96, 0x84,
+	0x0c, 0xcb, 0xb1, 0x8d, 0xc0, 0x74, 0x42, 0xe6, 0x66, 0x16, 0x59, 0xe2, 0xf4, 0xaa, 0xfa, 0xe8,
+	0x4d, 0xaa, 0xfc, 0x11, 0x96, 0x30, 0x27, 0x81, 0x6d, 0x52, 0x6c, 0x50, 0xe1, 0xf4, 0x92, 0x5e,
+	0x15, 0x80, 0x21, 0xd1, 0x3e, 0x82, 0xb6, 0xd4, 0x81, 0xc4, 0x79, 0xe2, 0xae, 0xcc, 0xb0, 0x3e,
+	0x09, 0xa2, 0xf8, 0x6d, 0x70, 0x29, 0x92, 0x52, 0xe4, 0x5b, 0xb6, 0x44, 0xfb, 0x00, 0xda, 0x32,
+	0x28, 0x8e, 0x83, 0xc8, 0x86, 0x72, 0xa7, 0x85, 0x24, 0x22, 0xda, 0x50, 0x4c, 0x8a, 0x16, 0xfb,
+	0xd4, 0x76, 0x61, 0x59, 0x59, 0xf7, 0x3a, 0xa9, 0x53, 0x7b, 0x1b, 0xd0, 0x23, 0x4c, 0x4f, 0xcc,
+	0xe9, 0x30, 0x34, 0xad, 0x38, 0x94, 0x92, 0x3a, 0x52, 0x50, 0xeb, 0xc8, 0xff, 0x43, 0x27, 0x45,
+	0x2c, 0x25, 0xae, 0x43, 0x95, 0x32, 0x40, 0x42, 0xbf, 0xc8, 0xff, 0x0f, 0x6d, 0x74, 0x07, 0xca,
+	0x24, 0x30, 0xbd, 0xb4, 0x99, 0x23, 0x06, 0xa7, 0x81, 0xe9, 0xe9, 0x02, 0xaf, 0x9d, 0x40, 0x5b,
+	0xc7, 0x67, 0xa6, 0x6b, 0x7a, 0x89, 0x16, 0x6b, 0xb0, 0x68, 0x87, 0x53, 0x23, 0x9c, 0x78, 0x9c,
+	0x6d, 0x55, 0xaf, 0xd8, 0xe1, 0x54, 0x9f, 0x78, 0xb3, 0xa1, 0xb2, 0x90, 0x13, 0x5f, 0x7f, 0x2a,
+	0x40, 0x33, 0x66, 0x79, 0xe2, 0x9a, 0xde, 0xab, 0x45, 0xd8, 0xdc, 0xf6, 0xa0, 0x0b, 0xe5, 0xd0,
+	0x9f, 0x50, 0xdc, 0x2b, 0x6e, 0x15, 0x99, 0x49, 0xf8, 0x0f, 0xe3, 0x69, 0x87, 0xa6, 0xe3, 0x61,
+	0xdb, 0x08, 0x59, 0x63, 0xc5, 0x8f, 0x7c, 0x41, 0x6f, 0x48, 0xa0, 0xce, 0x60, 0x8a, 0x39, 0xcb,
+	0x8a, 0x39, 0xf3, 0x8f, 0xb8, 0xda, 0x1f, 0x2c, 0xa6, 0xfa, 0x83, 0x8f, 0x60, 0x59, 0x31, 0x91,
+	0xb4, 0xfd, 0x36, 0x94, 0x03, 0xd7, 0xf4, 0xa2, 0x08, 0x43, 0xdc, 0xc0, 0xa9, 0x6d, 0xeb, 0x82,
+	0x40, 0xb3, 0x60, 0x55, 0x06, 0xcb, 0x03, 0x81, 0x8c, 0x8f, 0xeb, 0x2d, 0x68, 0xc5, 0x91, 0x6a,
+	0xf8, 0x9e, 0x3b, 0x95, 0xe6, 0x6e, 0x44, 0x01, 0x7a, 0xec, 0xb9, 0xd3, 0x57, 0x33, 0xfa, 0x77,
+	0x0b, 0x80, 0xd2, 0x52, 0x0e, 0xbd, 0x73, 0x3f, 0x27, 0x98, 0x6f, 0x42, 0x23, 0xee, 0x3f, 0x22,
+	0x66, 0x35, 0xbd, 0x1e, 0xb5, 0x20, 0x73, 0x53, 0x6d, 0xd6, 0x5d, 0x37, 0xa0, 0x3e, 0x9e, 0x1a,
+	0xe7, 0xa1, 0xb4, 0x98, 0xa8, 0x4d, 0xb5, 0xf1, 0xf4, 0x61, 0xc8, 0x8d, 0x86, 0x34, 0x68, 0x72,
+	0x39, 0x31, 0x45, 0x39, 0x11, 0x14, 0xd1, 0xdc, 0x82, 0xd6, 0x78, 0x6a, 0x04, 0xd8, 0xb3, 0x1d,
+	0x6f, 0xa4, 0x34, 0x66, 0x8d, 0xf1, 0xf4, 0x44, 0x00, 0x19, 0x55, 0x54, 0x23, 0x54, 0xba, 0xc5,
+	0xa4, 0x77, 0x4d, 0x28, 0xb5, 0x23, 0x58, 0x9b, 0xb1, 0xb4, 0x74, 0xd7, 0x7b, 0x33, 0x35, 0x6d,
+	0x8d, 0x7b, 0x6c, 0xd6, 0x66, 0x4a, 0x41, 0xbb, 0x0d, 0x48, 0x72, 0x57, 0x93, 0xec, 0x8c, 0x4d,
+	0xb5, 0x3f, 0x2c, 0x40, 0x2b, 0x21, 0xe4, 0x86, 0xcf, 0x3f, 0xc8, 0x6c, 0x2d, 0x09, 0xad, 0x28,
+	0x95, 0x90, 0xd0, 0x42, 0x08, 0x4a, 0x36, 0x26, 0x54, 0xda, 0x98, 0x7f, 0xcf, 0x3a, 0xa0, 0xf4,
+	0xe2, 0xf3, 0x52, 0x4e, 0x9d, 0x97, 0x4d, 0xa8, 0x3b, 0x1e, 0x2f, 0xba, 0x2c, 0xdb, 0x4a, 0x93,
+	0x82, 0x04, 0xed, 0x39, 0x36, 0x63, 0x1f, 0x11, 0x88, 0x6a, 0x29, 0xac, 0xd9, 0x90, 0x40, 0xd1,
+	0x35, 0x5d, 0x07, 0xc0, 0x09, 0x93, 0xaa, 0x70, 0x2f, 0x8e, 0x79, 0xdc, 0x84, 0x06, 0x56, 0x59,
+	0xd4, 0x84, 0x77, 0xb1, 0xc2, 0xe1, 0x2e, 0xb4, 0x43, 0x51, 0xa3, 0x0c, 0x1b, 0x9b, 0xb6, 0xeb,
+	0x78, 0xb8, 0x07, 0x3c, 0x7f, 0x2f, 0x49, 0xf8, 0xbe, 0x04, 0x6b, 0x1f, 0x43, 0x27, 0x65, 0x68,
+	0xe9, 0xb4, 0x3b, 0x50, 0x0a, 0xcc, 0x69, 0xe4, 0xb0, 0x8e, 0x4c, 0xe2, 0xaa, 0x9d, 0x75, 0x4e,
+	0xc0, 0x1c, 0x25, 0x1b, 0x9c, 0x4f, 0xfd, 0xb3, 0x17, 0x38, 0xea, 0xfb, 0x85, 0xb8, 0x1f, 0xff,
+	0xd4, 0x3f, 0xe3, 0x8e, 0x7a, 0x71, 0x33, 0x3a, 0x5b, 0xf7, 0x5e, 0xa3, 0x09, 0x5f, 0x85, 0x4a,
+	0x88, 0xcf, 0x1d, 0xd7, 0xe5, 0x8e, 0xaa, 0xea, 0xf2, 0x6f, 0xb6, 0xa1, 0xad, 0xbc, 0x5a, 0x43,
+	0xcb, 0x34, 0x78, 0x6e, 0x5c, 0x98, 0xe4, 0x22, 0x4a, 0x54, 0xf4, 0xf9, 0x27, 0x26, 0xb9, 0x48,
+	0xf2, 0x5a, 0x55, 0xcd, 0x6b, 0x9b, 0x50, 0x8f, 0xec, 0xcf, 0x4a, 0x28, 0xf3, 0x54, 0x51, 0x87,
+	0x08, 0x34, 0x64, 0xad, 0x49, 0x27, 0x65, 0xbd, 0xc4, 0xfa, 0x3f, 0xf5, 0xcf, 0xd2, 0xd6, 0x4f,
+	0x1b, 0x4f, 0xe7, 0x04, 0xda, 0x87, 0xd0, 0x79, 0xed, 0xb6, 0xe8, 0x77, 0x05, 0xa8, 0xb1, 0xc5,
+	0x22, 0x5d, 0xdd, 0x84, 0x06, 0x3b, 0x00, 0x99, 0x3a, 0x51, 0x67, 0xb0, 0xb9, 0xc9, 0x69, 0x21,
+	0xe7, 0x6c, 0x6c, 0x41, 0xc3, 0xc3, 0xcf, 0xa9, 0x71, 0xe1, 0x07, 0x3c, 0x7c, 0xc5, 0xe1, 0x02,
+	0x06, 0xfb, 0xc4, 0x0f, 0x58, 0xfc, 0x6e, 0x43, 0x3b, 0xa6, 0x48, 0x9f, 0xb2, 0x96, 0xa4, 0x4a,
+	0x1a, 0xb7, 0x6e, 0x7a, 0x77, 0xd2, 0x3c, 0xb7, 0xa1, 0xc2, 0x2b, 0x51, 0x64, 0xa0, 0x96, 0xa8,
+	0x00, 0xd1, 0x5e, 0x74, 0x89, 0xd5, 0x7e, 0x51, 0x80, 0xce, 0x67, 0x0e, 0xa1, 0x32, 0xd3, 0xc4,
+	0xd1, 0x89, 0xa0, 0xc4, 0x83, 0x48, 0xec, 0x91, 0x7f, 0x33, 0x07, 0xf2, 0x7d, 0x44, 0xad, 0x02,
+	0xff, 0x61, 0x50, 0x11, 0x1f, 0x45, 0xde, 0x57, 0x88, 0x1f, 0x16, 0x55, 0xfe, 0xf9, 0x39, 0xc1,
+	0x94, 0xeb, 0xdd, 0xd4, 0xe5, 0x1f, 0xa3, 0x76, 0x9d, 0xb1, 0x43, 0x79, 0xb0, 0x35, 0x75, 0xf1,
+	0xa3, 0xfd, 0xbe, 0x00, 0x75, 0xa9, 0xc1, 0xbf, 0xb9, 0x30, 0x74, 0xd5, 0x1e, 0xbc, 0x16, 0x69,
+	0xbb, 0x06, 0x8b, 0x7e, 0xc0, 0x6e, 0x4a, 0xe2, 0x16, 0x5a, 0xd4, 0x2b, 0xec, 0x77, 0x48, 0x58,
+	0x0f, 0xc3, 0x29, 0x18, 0xa6, 0xc2, 0x31, 0x8b, 0xfc, 0x7f, 0x48, 0xb4, 0x7d, 0xe8, 0xa6, 0x0d,
+	0x27, 0x2d, 0xff, 0x5f, 0x33, 0xb9, 0xbc, 0xad, 0xe6, 0xf2, 0x4c, 0x12, 0xdf, 0x86, 0xae, 0x44,
+	0xec, 0x63, 0x6a, 0x3a, 0xee, 0xfc, 0xec, 0xf0, 0xcb, 0x22, 0xac, 0x64, 0x48, 0xa5, 0xc4, 0x7b,
+	0xb0, 0x28, 0xf9, 0x71, 0xfa, 0x3c, 0x81, 0x11, 0x01, 0xfa, 0x6f, 0x58, 0x94, 0x4d, 0x00, 0x37,
+	0xe1, 0x0b, 0x0a, 0x4d, 0x44, 0x27, 0xeb, 0x60, 0x94, 0x0b, 0x58, 0x02, 0x29, 0x46, 0x75, 0x50,
+	0x1e, 0x38, 0x96, 0x46, 0xee, 0xc1, 0xf2, 0x78, 0x6a, 0x5c, 0x39, 0xf4, 0xc2, 0x0e, 0xcd, 0x2b,
+	0xd3, 0x55, 0x32, 0xcd, 0xd2, 0x78, 0xfa, 0x24, 0x86, 0xab, 0x35, 0x53, 0xe5, 0x59, 0x4e, 0x6a,
+	0xa6, 0xc2, 0x75, 0x07, 0x3a, 0x9c, 0x32, 0xc3, 0xb7, 0x92, 0x5c, 0x1d, 0xd3, 0x9c, 0xb7, 0xa0,
+	0xc1, 0x2f, 0xa7, 0x04, 0x3f, 0x35, 0xbc, 0xc9, 0x98, 0x67, 0xa0, 0x92, 0x0e, 0x0c, 0x76, 0x8a,
+	0x9f, 0x1e, 0x4d, 0xc6, 0x8c, 0x82, 0x73, 0x8c, 0x28, 0xaa, 0x82, 0x82, 0xc1, 0x24, 0x05, 0x0f,
+	0x35, 0x51, 0xcc, 0x79, 0x7e, 0xaf, 0xf1, 0x48, 0xad, 0x07, 0x49, 0x09, 0xd0, 0xee, 0xc1, 0xb2,
+	0xe8, 0x78, 0xc7, 0xd8, 0xa3, 0x2f, 0xe9, 0x8e, 0x7f, 0x5d, 0x82, 0xba, 0xa4, 0xfc, 0x8f, 0xaf,
+	0xbd, 0x37, 0x21, 0x2a, 0xb3, 0xa2, 0x84, 0x88, 0x04, 0x1e, 0x2d, 0xe2, 0x75, 0x64, 0xa6, 0x3c,
+	0x57, 0x5f, 0x5a, 0x9e, 0x6b, 0xd9, 0xf2, 0xbc, 0x09, 0x75, 0xac, 0x48, 0x01, 0xa1, 0x07, 0x4e,
+	0x84, 0x64, 0xeb, 0x77, 0xfd, 0xd5, 0xea, 0x77, 0x23, 0xb7, 0x7e, 0xb3, 0x3b, 0x9a, 0x15, 0x62,
+	0x79, 0x8a, 0x9b, 0xfc, 0x14, 0x57, 0x05, 0x60, 0x48, 0x98, 0x95, 0xf9, 0xfc, 0xae, 0x25, 0xac,
+	0x1c, 0x4d, 0xea, 0x58, 0xaf, 0xed, 0x78, 0xdc, 0x86, 0x4b, 0x1c, 0x53, 0x3d, 0xc7, 0xf8, 0xd0,
+	0x63, 0x56, 0xbc, 0x01, 0x75, 0x86, 0xf5, 0x27, 0xc2, 0xc4, 0x6d, 0xb1, 0xbb, 0x73, 0x8c, 0x8f,
+	0x27, 0x54, 0xf6, 0x8d, 0xd8, 0x0c, 0x59, 0xeb, 0x1f, 0x35, 0xec, 0xcb, 0xc2, 0x44, 0x02, 0xfa,
+	0x50, 0xb4, 0xed, 0xff, 0x17, 0xdd, 0xb0, 0x44, 0x08, 0x25, 0x47, 0x39, 0x10, 0xa0, 0xd4, 0x51,
+	0x56, 0xe2, 0x47, 0x8f, 0x08, 0xb4, 0x2f, 0x61, 0x55, 0x49, 0x40, 0x2f, 0xec, 0x01, 0x95, 0x74,
+	0xbc, 0x90, 0x9f, 0x8e, 0x8b, 0x6a, 0x3a, 0x7e, 0x0c, 0x6b, 0x33, 0x9c, 0xa5, 0x82, 0xb7, 0x52,
+	0x4d, 0xcf, 0xac, 0x76, 0x1c, 0x2b, 0x2a, 0x05, 0x35, 0x5d, 0x29, 0x4d, 0xfc, 0x28, 0xb9, 0xee,
+	0x94, 0xdf, 0x3d, 0xe7, 0xe7, 0xba, 0x7f, 0x24, 0xb9, 0x2e, 0x22, 0x95, 0xf2, 0x5f, 0xab, 0x32,
+	0xbc, 0x25, 0x6f, 0x32, 0x96, 0xef, 0x79, 0xd8, 0xa2, 0xd8, 0x96, 0xbd, 0x11, 0xbf, 0x03, 0xec,
+	0x45, 0x40, 0x96, 0x1a, 0xce, 0x4c, 0x82, 0xe3, 0xd4, 0x50, 0x12, 0xa9, 0x81, 0xc1, 0x64, 0x6a,
+	0xb8, 0x0b, 0xcb, 0xae, 0x49, 0xa8, 0x31, 0x21, 0xd8, 0x8e, 0xc9, 0xc4, 0xdc, 0xb2, 0xc5, 0x10,
+	0x8f, 0x09, 0xb6, 0x25, 0xe9, 0xdb, 0x80, 0x38, 0xa9, 0x69, 0x5d, 0x2a, 0xb4, 0x15, 0x11, 0xa1,
+	0x0c, 0xb3, 0x6b, 0x5d, 0xc6, 0xc4, 0xef, 0x40, 0x87, 0x13, 0x7b, 0x69, 0x6a, 0x91, 0xbd, 0xda,
+	0x0c, 0x75, 0x64, 0x2a, 0xe4, 0x3c, 0xcb, 0x79, 0x34, 0x9b, 0xc3, 0x18, 0x4c, 0x52, 0x5c, 0x83,
+	0x9a, 0xe3, 0x19, 0xe7, 0xae, 0x33, 0xba, 0xa0, 0x32, 0x81, 0x55, 0x1d, 0xef, 0x21, 0xff, 0x67,
+	0xc1, 0x70, 0xe5, 0x78, 0xb6, 0x7f, 0xc5, 0x4f, 0x5e, 0x53, 0x97, 0x7f, 0x2c, 0x70, 0x4d, 0xeb,
+	0xd2, 0x70, 0x4d, 0x8a, 0x3d, 0x6b, 0x6a, 0x8c, 0x09, 0x3f, 0x77, 0x25, 0xbd, 0x61, 0x5a, 0x97,
+	0x9f, 0x09, 0xe0, 0xe7, 0xdc, 0xb7, 0x4c, 0x4d, 0x22, 0x4f, 0x9b, 0xf8, 0x61, 0x02, 0x89, 0xeb,
+	0x5f, 0x19, 0x1c, 0xd3, 0xe4, 0x98, 0x2a, 0x03, 0xec, 0x32, 0xe4, 0x1d, 0x58, 0x7a, 0xe6, 0xf8,
+	0x2e, 0x7f, 0x61, 0x30, 0xc4, 0xe2, 0x96, 0x30, 0x5a, 0x0c, 0x66, 0xfb, 0x23, 0xda, 0x00, 0x1a,
+	0xfb, 0x21, 0x9f, 0x1e, 0x89, 0xc8, 0xd8, 0x84, 0x3a, 0x75, 0xc6, 0x98, 0x1d, 0x35, 0x82, 0x2d,
+	0x39, 0x9c, 0x03, 0x09, 0x3a, 0xc5, 0x96, 0xf6, 0xb7, 0x02, 0x34, 0xe5, 0x0a, 0x19, 0x20, 0x1b,
+	0x50, 0xb3, 0xfc, 0x71, 0xe0, 0x62, 0xe6, 0x66, 0x71, 0x61, 0x4d, 0x00, 0x8c, 0x21, 0x76, 0xcd,
+	0x40, 0xb8, 0xcf, 0x92, 0xe1, 0x09, 0x12, 0x74, 0x8a, 0xad, 0x99, 0xe4, 0x5f, 0x9c, 0x49, 0xfe,
+	0x8c, 0x64, 0x22, 0x1d, 0x35, 0x26, 0x23, 0x22, 0x1b, 0x9c, 0xba, 0x84, 0x7d, 0x4e, 0x46, 0x29,
+	0x12, 0xcb, 0xb1, 0x49, 0xaf, 0x9c, 0x22, 0xd9, 0x73, 0x6c, 0x1e, 0x93, 0x96, 0xeb, 0x73, 0x45,
+	0xf8, 0x5b, 0x88, 0xe8, 0x2f, 0x9a, 0x7a, 0x53, 0x40, 0xc5, 0x03, 0x09, 0xb9, 0xf7, 0x0d, 0x34,
+	0xd4, 0x5e, 0x1b, 0xad, 0xc0, 0xb2, 0xfc, 0x37, 0x8e, 0x8e, 0x87, 0xc6, 0xc3, 0xe3, 0xc7, 0x47,
+	0xfb, 0xed, 0x37, 0x10, 0x8a, 0x6f, 0x0e, 0xc6, 0x17, 0x8f, 0x0f, 0x1e, 0x1f, 0xec, 0xb7, 0x0b,
+	0x2a, 0xe9, 0xe9, 0xe3, 0x07, 0x9f, 0x1f, 0x0e, 0x87, 0x07, 0xfb, 0xed, 0x85, 0x34, 0x78, 0x6f,
+	0xef, 0xe0, 0x60, 0xff, 0x60, 0xbf, 0x5d, 0x54, 0x39, 0x3c, 0xdc, 0x3d, 0xfc, 0xec, 0x60, 0xbf,
+	0x5d, 0xba, 0xf7, 0xab, 0x02, 0xac, 0xe4, 0xce, 0x27, 0x19, 0x93, 0x18, 0x61, 0x9c, 0x1c, 0x1c,
+	0xed, 0x1f, 0x1e, 0x3d, 0x6a, 0xbf, 0x81, 0xd6, 0xa0, 0x93, 0x80, 0xf7, 0x8e, 0x8d, 0xd3, 0xc3,
+	0x47, 0x47, 0x5c, 0x97, 0x3e, 0xac, 0x26, 0x88, 0xe1, 0x97, 0x29, 0x85, 0xba, 0xd0, 0x4e, 0x70,
+	0xc7, 0x27, 0x07, 0x47, 0x5c, 0x9f, 0x14, 0x34, 0xd2, 0xe8, 0xfe, 0x5f, 0xbb, 0x50, 0xde, 0x65,
+	0x4f, 0x59, 0xe8, 0xb7, 0x05, 0xb8, 0x3d, 0x7f, 0xe4, 0xc8, 0xba, 0x82, 0x68, 0xf4, 0x86, 0x6e,
+	0x8b, 0x16, 0xe7, 0x65, 0xf3, 0xc9, 0xfe, 0xea, 0xcc, 0xe3, 0xcd, 0x01, 0x7b, 0xda, 0xd2, 0xde,
+	0xff, 0xee, 0xcf, 0x7f, 0xff, 0x7e, 0x61, 0xe7, 0xc3, 0xc2, 0x3d, 0xed, 0xee, 0x80, 0x3f, 0xa4,
+	0x0d, 0x02, 0x8c, 0xc3, 0x81, 0x25, 0x38, 0x1a, 0xbe, 0x67, 0x31, 0x96, 0x86, 0xac, 0x3b, 0x36,
+	0x8f, 0x1c, 0xf4, 0x33, 0xd8, 0xc8, 0x8e, 0x54, 0x53, 0x5a, 0x6d, 0x08, 0xad, 0xf2, 0xa7, 0xae,
+	0x73, 0x75, 0xb9, 0xcb, 0x75, 0x79, 0x93, 0xe9, 0x72, 0x23, 0xa5, 0x0b, 0xe3, 0x63, 0x60, 0xc1,
+	0x48, 0x28, 0xe0, 0xc0, 0xf2, 0xcc, 0x50, 0x18, 0x5d, 0x17, 0x43, 0xe7, 0x39, 0xc3, 0xe2, 0xb9,
+	0x62, 0xaf, 0x73, 0xb1, 0x6b, 0x4c, 0x2c, 0x92, 0x62, 0xf9, 0x05, 0x62, 0x70, 0xc6, 0x38, 0xa1,
+	0x11, 0x74, 0x75, 0x6c, 0x3d, 0x7b, 0x60, 0x99, 0x84, 0x4a, 0xb6, 0xbc, 0xd7, 0xe9, 0xc4, 0xb7,
+	0x0e, 0xc7, 0x1b, 0xbd, 0x4c, 0x86, 0xc6, 0x65, 0x6c, 0x30, 0x19, 0x6b, 0x29, 0x19, 0x21, 0xb6,
+	0x9e, 0x19, 0x67, 0x8c, 0x37, 0xfa, 0x1a, 0xea, 0xac, 0x6a, 0x46, 0x36, 0x9c, 0xc3, 0xaa, 0xbf,
+	0xa2, 0x4e, 0x54, 0xe3, 0xe2, 0xa1, 0x6d, 0x71, 0x09, 0x7d, 0x26, 0x61, 0x45, 0x35, 0x5e, 0x3c,
+	0xe6, 0x42, 0x17, 0xd0, 0x4a, 0xbf, 0x23, 0xa0, 0x17, 0x3c, 0x2e, 0xfc, 0x90, 0x9d, 0x70, 0x39,
+	0x7e, 0x80, 0xbd, 0xa8, 0x11, 0xff, 0x79, 0x01, 0x3a, 0x39, 0xaf, 0x1c, 0x68, 0x33, 0x92, 0x37,
+	0xe7, 0xfd, 0xa3, 0x7f, 0x3d, 0xf7, 0xd9, 0x20, 0x7a, 0xc9, 0xd0, 0xee, 0x70, 0xd9, 0x37, 0x99,
+	0xec, 0x0d, 0x55, 0xf6, 0x19, 0x5b, 0xa0, 0x28, 0xf0, 0x6e, 0x01, 0x3d, 0x81, 0x5a, 0xfc, 0xb2,
+	0x88, 0x84, 0xc9, 0xb2, 0x2f, 0xa6, 0xfd, 0xd5, 0x2c, 0x58, 0x9a, 0xf2, 0x1a, 0x17, 0xb3, 0xc2,
+	0xc4, 0xb4, 0xa5, 0x18, 0x82, 0x3d, 0x5b, 0x5c, 0x09, 0x8f, 0x61, 0x51, 0xe6, 0x12, 0x94, 0xba,
+	0x98, 0x47, 0x4c, 0xbb, 0x69, 0xa0, 0x64, 0xb9, 0xce, 0x59, 0x76, 0x18, 0xcb, 0x96, 0x64, 0x29,
+	0x2f, 0x08, 0xc8, 0x86, 0x86, 0xfa, 0xe8, 0x86, 0x7a, 0x9c, 0x41, 0xce, 0xeb, 0x5d, 0x7f, 0x3d,
+	0x07, 0x23, 0xf9, 0x6f, 0x72, 0xfe, 0xeb, 0x8c, 0x7f, 0x57, 0xf2, 0x7f, 0xca, 0xe8, 0xa2, 0x6b,
+	0x08, 0xba, 0x84, 0x56, 0xfa, 0x7d, 0x5a, 0x3a, 0x3f, 0xf7, 0xd1, 0x7a, 0xae, 0xf3, 0xdf, 0xe2,
+	0x62, 0x36, 0x99, 0x98, 0xbe, 0xea, 0x80, 0x50, 0x72, 0x11, 0xe9, 0x1e, 0xb9, 0xd0, 0xd9, 0xf3,
+	0xfd, 0x00, 0xb3, 0xf1, 0xf0, 0x33, 0x1c, 0xdd, 0x62, 0xa4, 0x1b, 0xb2, 0x63, 0xff, 0xfe, 0x6a,
+	0x16, 0x2c, 0xf7, 0x74, 0x9b, 0x0b, 0xdb, 0x62, 0xc2, 0xae, 0x49, 0x61, 0xd2, 0xbf, 0x03, 0xcb,
+	0xf7, 0x83, 0xe8, 0xda, 0x84, 0x2e, 0x60, 0x59, 0x91, 0x76, 0x8a, 0x29, 0x75, 0xf1, 0x0f, 0x95,
+	0x75, 0x8b, 0xcb, 0xba, 0xc1, 0x64, 0xad, 0xe7, 0xc8, 0x22, 0x82, 0xe9, 0x4f, 0xc4, 0x09, 0x95,
+	0xb3, 0x7c, 0x24, 0xae, 0x97, 0xb3, 0x6f, 0x09, 0xfd, 0xde, 0x2c, 0x62, 0x7e, 0x68, 0x05, 0xe6,
+	0x74, 0xc0, 0xdf, 0x0e, 0x10, 0xe1, 0x6d, 0x73, 0x66, 0x8e, 0x8a, 0xae, 0xe5, 0x5c, 0x62, 0xe3,
+	0x54, 0xba, 0x91, 0x8f, 0x94, 0xd2, 0x72, 0xce, 0x6a, 0xb4, 0xab, 0xb3, 0x88, 0xfd, 0x25, 0xb4,
+	0x98, 0xa2, 0x4a, 0x0f, 0xb0, 0x96, 0x99, 0xf6, 0x91, 0xf4, 0xb6, 0x72, 0xc6, 0x85, 0x2f, 0x72,
+	0x95, 0xda, 0x71, 0x20, 0x8b, 0x0b, 0x53, 0x46, 0x5e, 0x52, 0xd8, 0xec, 0x08, 0xb1, 0xdf, 0x9b,
+	0x45, 0x48, 0x61, 0x37, 0xb8, 0xb0, 0x1e, 0x13, 0xd6, 0x49, 0x9f, 0x25, 0x83, 0x0d, 0xc5, 0x10,
+	0x86, 0xa5, 0x47, 0x98, 0xa6, 0x2a, 0x43, 0x4f, 0xcd, 0xd5, 0xa9, 0xa2, 0xb0, 0x9e, 0x83, 0x91,
+	0x72, 0xe6, 0xd6, 0x05, 0xca, 0x79, 0x7e, 0x05, 0xb5, 0xf8, 0xd1, 0x41, 0x86, 0x5b, 0xf6, 0x39,
+	0xa7, 0xbf, 0x9a, 0x05, 0xbf, 0x24, 0x59, 0x87, 0x31, 0xc3, 0xaf, 0xa0, 0xa1, 0x4e, 0x60, 0xe4,
+	0x0e, 0x72, 0xa6, 0x59, 0xfd, 0xf5, 0x1c, 0x8c, 0x14, 0xb3, 0xc6, 0xc5, 0x2c, 0xa3, 0xa5, 0xb4,
+	0x4f, 0x58, 0x29, 0x68, 0x27, 0x91, 0x26, 0x26, 0x2e, 0x68, 0x5d, 0x0d, 0xa5, 0xd4, 0xc0, 0xa6,
+	0xdf, 0xcf, 0x43, 0xa5, 0xad, 0x84, 0x56, 0x32, 0x32, 0x06, 0xdf, 0x58, 0x8e, 0xfd, 0x2d, 0xf2,
+	0x55, 0x49, 0xe2, 0xbe, 0x93, 0x96, 0x94, 0xba, 0x2e, 0xf5, 0xfb, 0x79, 0xa8, 0xf4, 0x19, 0x45,
+	0x1b, 0xb9, 0x92, 0x06, 0xf2, 0xcd, 0x2f, 0x80, 0xa5, 0xcc, 0xfd, 0x4e, 0x9e, 0xa0, 0xfc, 0xfb,
+	0x64, 0x7f, 0x23, 0x1f, 0x99, 0x3e, 0x41, 0xa8, 0x9f, 0x2f, 0x93, 0x07, 0xf5, 0x8f, 0x01, 0x92,
+	0xdb, 0x2e, 0x5a, 0x55, 0xce, 0xbe, 0x32, 0x41, 0xe9, 0xaf, 0xcd, 0xc0, 0xa5, 0x88, 0x0d, 0x2e,
+	0x62, 0x15, 0x75, 0x93, 0x7c, 0x40, 0x06, 0xdf, 0x88, 0x31, 0xca, 0xb7, 0xe8, 0x11, 0x94, 0xf9,
+	0x1d, 0x00, 0xc9, 0xd9, 0xb4, 0x72, 0x83, 0xe8, 0x23, 0x15, 0x94, 0x76, 0x39, 0x8b, 0xac, 0x46,
+	0x74, 0x38, 0x18, 0xc1, 0x83, 0xdb, 0x3f, 0xba, 0x35, 0x72, 0xe8, 0xc5, 0xe4, 0x6c, 0xc7, 0xf2,
+	0xc7, 0x03, 0x0b, 0xbb, 0x38, 0x7c, 0xc7, 0xc3, 0xf4, 0xca, 0x0f, 0x2f, 0x07, 0x23, 0x7f, 0x8f,
+	0xfd, 0x0f, 0xc2, 0xc0, 0x3a, 0xab, 0xf0, 0x94, 0xff, 0xde, 0xbf, 0x06, 0x00, 0x2f, 0x35, 0x60,
+	0xf0, 0x5a, 0x25, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AdminClient is the client API for Admin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AdminClient interface {
+	// ConfirmOnChainResolvedPaysWithPeerOsps instructs Osp to confirm on-chain resolved pays between itself and connected osps.
+	ConfirmOnChainResolvedPaysWithPeerOsps(ctx context.Context, in *ConfirmOnChainResolvedPaysRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// ClearExpiredPaysWithPeerOsps instructs Osp to clear expired pays between itself and connected osps.
+	ClearExpiredPaysWithPeerOsps(ctx context.Context, in *ClearExpiredPaysRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// BuildRoutingTable instructs Osp to build routing table.
+	BuildRoutingTable(ctx context.Context, in *BuildRoutingTableRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// RecvBcastRoutingInfo gives the OSP (listener & router) the incoming
+	// broadcast routing information (OSP server forwarding to the listener).
+	RecvBcastRoutingInfo(ctx context.Context, in *RoutingRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// GetPeerOsps returns info of all peer osps.
+	GetPeerOsps(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PeerOspsResponse, error)
+	// OspOpenChannel instructs Osp to open a state channel with a peer described in request.
+	OspOpenChannel(ctx context.Context, in *OspOpenChannelRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// OspBatchOpenChannel instructs Osp to open state channels with multiple peers, and streams
+	// the progress of each channel until all of them are opened or failed.
+	OspBatchOpenChannel(ctx context.Context, in *OspBatchOpenChannelRequest, opts ...grpc.CallOption) (Admin_OspBatchOpenChannelClient, error)
+	// SendToken instructs the OSP to send token specified in SendTokenRequest.
+	SendToken(ctx context.Context, in *SendTokenRequest, opts ...grpc.CallOption) (*SendTokenResponse, error)
+	// Deposit instructs the OSP to deposit token specified in DepositRequest.
+	Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositResponse, error)
+	// QueryDeposit asks OSP about the deposit status
+	QueryDeposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error)
+	// RegisterStream instructs the OSP to connect with other osp specified in RegisterStreamRequest.
+	RegisterStream(ctx context.Context, in *RegisterStreamRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	CooperativeWithdraw(ctx context.Context, in *ChannelOpRequest, opts ...grpc.CallOption) (*ChannelOpResponse, error)
+	CooperativeSettle(ctx context.Context, in *ChannelOpRequest, opts ...grpc.CallOption) (*ChannelOpResponse, error)
+	// GetPayTrace collects the hop spans of a traced pay from this OSP and its peers along the pay path.
+	GetPayTrace(ctx context.Context, in *GetPayTraceRequest, opts ...grpc.CallOption) (*GetPayTraceResponse, error)
+	// GetChannelBalances returns the balances of opened channels.
+	GetChannelBalances(ctx context.Context, in *ChannelBalancesRequest, opts ...grpc.CallOption) (*ChannelBalancesResponse, error)
+	// GetPendingPays returns the pending pays of channels.
+	GetPendingPays(ctx context.Context, in *PendingPaysRequest, opts ...grpc.CallOption) (*PendingPaysResponse, error)
+	// GetDepositJobs returns the deposit jobs of a channel or all running deposit jobs.
+	GetDepositJobs(ctx context.Context, in *DepositJobsRequest, opts ...grpc.CallOption) (*DepositJobsResponse, error)
+	// GetRoutingTable returns the next hop of each destination osp.
+	GetRoutingTable(ctx context.Context, in *RoutingTableRequest, opts ...grpc.CallOption) (*RoutingTableResponse, error)
+	// Rebalance sends self pays around cycles of peer osps to refill drained peer osp channels.
+	Rebalance(ctx context.Context, in *RebalanceRequest, opts ...grpc.CallOption) (*RebalanceResponse, error)
+	// ListChannels returns channels matching the peer, token and state filters.
+	ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error)
+	// GetChannelDetail returns the states and balances of a channel.
+	GetChannelDetail(ctx context.Context, in *ChannelDetailRequest, opts ...grpc.CallOption) (*ChannelDetailResponse, error)
+	// GetChannelStatus returns the message delivery status and sliding window of a channel.
+	GetChannelStatus(ctx context.Context, in *ChannelStatusRequest, opts ...grpc.CallOption) (*ChannelStatusResponse, error)
+	// ListChannelPays returns a page of pays sent or received through a channel.
+	ListChannelPays(ctx context.Context, in *ListChannelPaysRequest, opts ...grpc.CallOption) (*ListChannelPaysResponse, error)
+	// GetPayment returns the pay and its ingress and egress states.
+	GetPayment(ctx context.Context, in *GetPaymentRequest, opts ...grpc.CallOption) (*GetPaymentResponse, error)
+	// Drain stops accepting new pays, waits for outstanding pays and msgs, then closes all streams.
+	Drain(ctx context.Context, in *DrainRequest, opts ...grpc.CallOption) (*DrainResponse, error)
+}
+
+type adminClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAdminClient(cc *grpc.ClientConn) AdminClient {
+	return &adminClient{cc}
+}
+
+func (c *adminClient) ConfirmOnChainResolvedPaysWithPeerOsps(ctx context.Context, in *ConfirmOnChainResolvedPaysRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/rpc.Admin/ConfirmOnChainResolvedPaysWithPeerOsps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ClearExpiredPaysWithPeerOsps(ctx context.Context, in *ClearExpiredPaysRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/rpc.Admin/ClearExpiredPaysWithPeerOsps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) BuildRoutingTable(ctx context.Context, in *BuildRoutingTableRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/rpc.Admin/BuildRoutingTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) RecvBcastRoutingInfo(ctx context.Context, in *RoutingRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/rpc.Admin/RecvBcastRoutingInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetPeerOsps(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PeerOspsResponse, error) {
+	out := new(PeerOspsResponse)
+	err := c.cc.Invoke(ctx, "/rpc.Admin/GetPeerOsps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) OspOpenChannel(ctx context.Context, in *OspOpenChannelRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/rpc.Admin/OspOpenChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) OspBatchOpenChannel(ctx context.Context, in *OspBatchOpenChannelRequest, opts ...grpc.CallOption) (Admin_OspBatchOpenChannelClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Admin_serviceDesc.Streams[0], "/rpc.Admin/OspBatchOpenChannel", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminOspBatchOpenChannelClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Admin_OspBatchOpenChannelClient interface {
+	Recv() (*BatchOpenChannelProgress, error)
+	grpc.ClientStream
+}
+
+type adminOspBatchOpenChannelClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminOspBatchOpenChannelClient) Recv() (*BatchOpenChannelProgress, error) {
+	m := new(BatchOpenChannelProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *adminClient) SendToken(ctx context.Context, in *SendTokenRequest, opts ...grpc.CallOption) (*SendTokenResponse, error) {
+	out := new(SendTokenResponse)
+	err := c.cc.Invoke(ctx, "/rpc.Admin/SendToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositResponse, error) {
+	out := new(DepositResponse)
+	err := c.cc.Invoke(ctx, "/rpc.Admin/Deposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) QueryDeposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error) {
+	out := new(QueryDepositResponse)
+	err := c.cc.Invoke(ctx, "/rpc.Admin/QueryDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
 func (c *adminClient) RegisterStream(ctx context.Context, in *RegisterStreamRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
@@ -1144,18 +3537,126 @@ func (c *adminClient) RegisterStream(ctx context.Context, in *RegisterStreamRequ
 	return out, nil
 }
 
-func (c *adminClient) CooperativeWithdraw(ctx context.Context, in *ChannelOpRequest, opts ...grpc.CallOption) (*ChannelOpResponse, error) {
-	out := new(ChannelOpResponse)
-	err := c.cc.Invoke(ctx, "/rpc.Admin/CooperativeWithdraw", in, out, opts...)
+func (c *adminClient) CooperativeWithdraw(ctx context.Context, in *ChannelOpRequest, opts ...grpc.CallOption) (*ChannelOpResponse, error) {
+	out := new(ChannelOpResponse)
+	err := c.cc.Invoke(ctx, "/rpc.Admin/CooperativeWithdraw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) CooperativeSettle(ctx context.Context, in *ChannelOpRequest, opts ...grpc.CallOption) (*ChannelOpResponse, error) {
+	out := new(ChannelOpResponse)
+	err := c.cc.Invoke(ctx, "/rpc.Admin/CooperativeSettle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetPayTrace(ctx context.Context, in *GetPayTraceRequest, opts ...grpc.CallOption) (*GetPayTraceResponse, error) {
+	out := new(GetPayTraceResponse)
+	err := c.cc.Invoke(ctx, "/rpc.Admin/GetPayTrace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetChannelBalances(ctx context.Context, in *ChannelBalancesRequest, opts ...grpc.CallOption) (*ChannelBalancesResponse, error) {
+	out := new(ChannelBalancesResponse)
+	err := c.cc.Invoke(ctx, "/rpc.Admin/GetChannelBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetPendingPays(ctx context.Context, in *PendingPaysRequest, opts ...grpc.CallOption) (*PendingPaysResponse, error) {
+	out := new(PendingPaysResponse)
+	err := c.cc.Invoke(ctx, "/rpc.Admin/GetPendingPays", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetDepositJobs(ctx context.Context, in *DepositJobsRequest, opts ...grpc.CallOption) (*DepositJobsResponse, error) {
+	out := new(DepositJobsResponse)
+	err := c.cc.Invoke(ctx, "/rpc.Admin/GetDepositJobs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *adminClient) CooperativeSettle(ctx context.Context, in *ChannelOpRequest, opts ...grpc.CallOption) (*ChannelOpResponse, error) {
-	out := new(ChannelOpResponse)
-	err := c.cc.Invoke(ctx, "/rpc.Admin/CooperativeSettle", in, out, opts...)
+func (c *adminClient) GetRoutingTable(ctx context.Context, in *RoutingTableRequest, opts ...grpc.CallOption) (*RoutingTableResponse, error) {
+	out := new(RoutingTableResponse)
+	err := c.cc.Invoke(ctx, "/rpc.Admin/GetRoutingTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) Rebalance(ctx context.Context, in *RebalanceRequest, opts ...grpc.CallOption) (*RebalanceResponse, error) {
+	out := new(RebalanceResponse)
+	err := c.cc.Invoke(ctx, "/rpc.Admin/Rebalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error) {
+	out := new(ListChannelsResponse)
+	err := c.cc.Invoke(ctx, "/rpc.Admin/ListChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetChannelDetail(ctx context.Context, in *ChannelDetailRequest, opts ...grpc.CallOption) (*ChannelDetailResponse, error) {
+	out := new(ChannelDetailResponse)
+	err := c.cc.Invoke(ctx, "/rpc.Admin/GetChannelDetail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetChannelStatus(ctx context.Context, in *ChannelStatusRequest, opts ...grpc.CallOption) (*ChannelStatusResponse, error) {
+	out := new(ChannelStatusResponse)
+	err := c.cc.Invoke(ctx, "/rpc.Admin/GetChannelStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ListChannelPays(ctx context.Context, in *ListChannelPaysRequest, opts ...grpc.CallOption) (*ListChannelPaysResponse, error) {
+	out := new(ListChannelPaysResponse)
+	err := c.cc.Invoke(ctx, "/rpc.Admin/ListChannelPays", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetPayment(ctx context.Context, in *GetPaymentRequest, opts ...grpc.CallOption) (*GetPaymentResponse, error) {
+	out := new(GetPaymentResponse)
+	err := c.cc.Invoke(ctx, "/rpc.Admin/GetPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) Drain(ctx context.Context, in *DrainRequest, opts ...grpc.CallOption) (*DrainResponse, error) {
+	out := new(DrainResponse)
+	err := c.cc.Invoke(ctx, "/rpc.Admin/Drain", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1177,6 +3678,9 @@ type AdminServer interface {
 	GetPeerOsps(context.Context, *empty.Empty) (*PeerOspsResponse, error)
 	// OspOpenChannel instructs Osp to open a state channel with a peer described in request.
 	OspOpenChannel(context.Context, *OspOpenChannelRequest) (*empty.Empty, error)
+	// OspBatchOpenChannel instructs Osp to open state channels with multiple peers, and streams
+	// the progress of each channel until all of them are opened or failed.
+	OspBatchOpenChannel(*OspBatchOpenChannelRequest, Admin_OspBatchOpenChannelServer) error
 	// SendToken instructs the OSP to send token specified in SendTokenRequest.
 	SendToken(context.Context, *SendTokenRequest) (*SendTokenResponse, error)
 	// Deposit instructs the OSP to deposit token specified in DepositRequest.
@@ -1187,6 +3691,30 @@ type AdminServer interface {
 	RegisterStream(context.Context, *RegisterStreamRequest) (*empty.Empty, error)
 	CooperativeWithdraw(context.Context, *ChannelOpRequest) (*ChannelOpResponse, error)
 	CooperativeSettle(context.Context, *ChannelOpRequest) (*ChannelOpResponse, error)
+	// GetPayTrace collects the hop spans of a traced pay from this OSP and its peers along the pay path.
+	GetPayTrace(context.Context, *GetPayTraceRequest) (*GetPayTraceResponse, error)
+	// GetChannelBalances returns the balances of opened channels.
+	GetChannelBalances(context.Context, *ChannelBalancesRequest) (*ChannelBalancesResponse, error)
+	// GetPendingPays returns the pending pays of channels.
+	GetPendingPays(context.Context, *PendingPaysRequest) (*PendingPaysResponse, error)
+	// GetDepositJobs returns the deposit jobs of a channel or all running deposit jobs.
+	GetDepositJobs(context.Context, *DepositJobsRequest) (*DepositJobsResponse, error)
+	// GetRoutingTable returns the next hop of each destination osp.
+	GetRoutingTable(context.Context, *RoutingTableRequest) (*RoutingTableResponse, error)
+	// Rebalance sends self pays around cycles of peer osps to refill drained peer osp channels.
+	Rebalance(context.Context, *RebalanceRequest) (*RebalanceResponse, error)
+	// ListChannels returns channels matching the peer, token and state filters.
+	ListChannels(context.Context, *ListChannelsRequest) (*ListChannelsResponse, error)
+	// GetChannelDetail returns the states and balances of a channel.
+	GetChannelDetail(context.Context, *ChannelDetailRequest) (*ChannelDetailResponse, error)
+	// GetChannelStatus returns the message delivery status and sliding window of a channel.
+	GetChannelStatus(context.Context, *ChannelStatusRequest) (*ChannelStatusResponse, error)
+	// ListChannelPays returns a page of pays sent or received through a channel.
+	ListChannelPays(context.Context, *ListChannelPaysRequest) (*ListChannelPaysResponse, error)
+	// GetPayment returns the pay and its ingress and egress states.
+	GetPayment(context.Context, *GetPaymentRequest) (*GetPaymentResponse, error)
+	// Drain stops accepting new pays, waits for outstanding pays and msgs, then closes all streams.
+	Drain(context.Context, *DrainRequest) (*DrainResponse, error)
 }
 
 // UnimplementedAdminServer can be embedded to have forward compatible implementations.
@@ -1211,6 +3739,9 @@ func (*UnimplementedAdminServer) GetPeerOsps(ctx context.Context, req *empty.Emp
 func (*UnimplementedAdminServer) OspOpenChannel(ctx context.Context, req *OspOpenChannelRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OspOpenChannel not implemented")
 }
+func (*UnimplementedAdminServer) OspBatchOpenChannel(req *OspBatchOpenChannelRequest, srv Admin_OspBatchOpenChannelServer) error {
+	return status.Errorf(codes.Unimplemented, "method OspBatchOpenChannel not implemented")
+}
 func (*UnimplementedAdminServer) SendToken(ctx context.Context, req *SendTokenRequest) (*SendTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendToken not implemented")
 }
@@ -1229,6 +3760,42 @@ func (*UnimplementedAdminServer) CooperativeWithdraw(ctx context.Context, req *C
 func (*UnimplementedAdminServer) CooperativeSettle(ctx context.Context, req *ChannelOpRequest) (*ChannelOpResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CooperativeSettle not implemented")
 }
+func (*UnimplementedAdminServer) GetPayTrace(ctx context.Context, req *GetPayTraceRequest) (*GetPayTraceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayTrace not implemented")
+}
+func (*UnimplementedAdminServer) GetChannelBalances(ctx context.Context, req *ChannelBalancesRequest) (*ChannelBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelBalances not implemented")
+}
+func (*UnimplementedAdminServer) GetPendingPays(ctx context.Context, req *PendingPaysRequest) (*PendingPaysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingPays not implemented")
+}
+func (*UnimplementedAdminServer) GetDepositJobs(ctx context.Context, req *DepositJobsRequest) (*DepositJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDepositJobs not implemented")
+}
+func (*UnimplementedAdminServer) GetRoutingTable(ctx context.Context, req *RoutingTableRequest) (*RoutingTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoutingTable not implemented")
+}
+func (*UnimplementedAdminServer) Rebalance(ctx context.Context, req *RebalanceRequest) (*RebalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rebalance not implemented")
+}
+func (*UnimplementedAdminServer) ListChannels(ctx context.Context, req *ListChannelsRequest) (*ListChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChannels not implemented")
+}
+func (*UnimplementedAdminServer) GetChannelDetail(ctx context.Context, req *ChannelDetailRequest) (*ChannelDetailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelDetail not implemented")
+}
+func (*UnimplementedAdminServer) GetChannelStatus(ctx context.Context, req *ChannelStatusRequest) (*ChannelStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelStatus not implemented")
+}
+func (*UnimplementedAdminServer) ListChannelPays(ctx context.Context, req *ListChannelPaysRequest) (*ListChannelPaysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChannelPays not implemented")
+}
+func (*UnimplementedAdminServer) GetPayment(ctx context.Context, req *GetPaymentRequest) (*GetPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayment not implemented")
+}
+func (*UnimplementedAdminServer) Drain(ctx context.Context, req *DrainRequest) (*DrainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Drain not implemented")
+}
 
 func RegisterAdminServer(s *grpc.Server, srv AdminServer) {
 	s.RegisterService(&_Admin_serviceDesc, srv)
@@ -1342,6 +3909,27 @@ func _Admin_OspOpenChannel_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Admin_OspBatchOpenChannel_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(OspBatchOpenChannelRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServer).OspBatchOpenChannel(m, &adminOspBatchOpenChannelServer{stream})
+}
+
+type Admin_OspBatchOpenChannelServer interface {
+	Send(*BatchOpenChannelProgress) error
+	grpc.ServerStream
+}
+
+type adminOspBatchOpenChannelServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminOspBatchOpenChannelServer) Send(m *BatchOpenChannelProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Admin_SendToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SendTokenRequest)
 	if err := dec(in); err != nil {
@@ -1450,6 +4038,222 @@ func _Admin_CooperativeSettle_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Admin_GetPayTrace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPayTraceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetPayTrace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Admin/GetPayTrace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetPayTrace(ctx, req.(*GetPayTraceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetChannelBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetChannelBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Admin/GetChannelBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetChannelBalances(ctx, req.(*ChannelBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetPendingPays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingPaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetPendingPays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Admin/GetPendingPays",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetPendingPays(ctx, req.(*PendingPaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetDepositJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepositJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetDepositJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Admin/GetDepositJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetDepositJobs(ctx, req.(*DepositJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetRoutingTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoutingTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetRoutingTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Admin/GetRoutingTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetRoutingTable(ctx, req.(*RoutingTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_Rebalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Rebalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Admin/Rebalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Rebalance(ctx, req.(*RebalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ListChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ListChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Admin/ListChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ListChannels(ctx, req.(*ListChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetChannelDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetChannelDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Admin/GetChannelDetail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetChannelDetail(ctx, req.(*ChannelDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetChannelStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetChannelStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Admin/GetChannelStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetChannelStatus(ctx, req.(*ChannelStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ListChannelPays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChannelPaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ListChannelPays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Admin/ListChannelPays",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ListChannelPays(ctx, req.(*ListChannelPaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Admin/GetPayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetPayment(ctx, req.(*GetPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_Drain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Drain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Admin/Drain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Drain(ctx, req.(*DrainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Admin_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rpc.Admin",
 	HandlerType: (*AdminServer)(nil),
@@ -1502,7 +4306,61 @@ var _Admin_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CooperativeSettle",
 			Handler:    _Admin_CooperativeSettle_Handler,
 		},
+		{
+			MethodName: "GetPayTrace",
+			Handler:    _Admin_GetPayTrace_Handler,
+		},
+		{
+			MethodName: "GetChannelBalances",
+			Handler:    _Admin_GetChannelBalances_Handler,
+		},
+		{
+			MethodName: "GetPendingPays",
+			Handler:    _Admin_GetPendingPays_Handler,
+		},
+		{
+			MethodName: "GetDepositJobs",
+			Handler:    _Admin_GetDepositJobs_Handler,
+		},
+		{
+			MethodName: "GetRoutingTable",
+			Handler:    _Admin_GetRoutingTable_Handler,
+		},
+		{
+			MethodName: "Rebalance",
+			Handler:    _Admin_Rebalance_Handler,
+		},
+		{
+			MethodName: "ListChannels",
+			Handler:    _Admin_ListChannels_Handler,
+		},
+		{
+			MethodName: "GetChannelDetail",
+			Handler:    _Admin_GetChannelDetail_Handler,
+		},
+		{
+			MethodName: "GetChannelStatus",
+			Handler:    _Admin_GetChannelStatus_Handler,
+		},
+		{
+			MethodName: "ListChannelPays",
+			Handler:    _Admin_ListChannelPays_Handler,
+		},
+		{
+			MethodName: "GetPayment",
+			Handler:    _Admin_GetPayment_Handler,
+		},
+		{
+			MethodName: "Drain",
+			Handler:    _Admin_Drain_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "OspBatchOpenChannel",
+			Handler:       _Admin_OspBatchOpenChannel_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "osp_admin.proto",
 }
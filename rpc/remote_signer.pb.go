@@ -0,0 +1,467 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: remote_signer.proto
+
+package rpc
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// Next tag: 1
+type GetAddressRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAddressRequest) Reset()         { *m = GetAddressRequest{} }
+func (m *GetAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAddressRequest) ProtoMessage()    {}
+func (*GetAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35894c6e9efc1a9d, []int{0}
+}
+
+func (m *GetAddressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAddressRequest.Unmarshal(m, b)
+}
+func (m *GetAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAddressRequest.Marshal(b, m, deterministic)
+}
+func (m *GetAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAddressRequest.Merge(m, src)
+}
+func (m *GetAddressRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAddressRequest.Size(m)
+}
+func (m *GetAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAddressRequest proto.InternalMessageInfo
+
+// Next tag: 2
+type GetAddressResponse struct {
+	// eth address of the signing key
+	Address              []byte   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAddressResponse) Reset()         { *m = GetAddressResponse{} }
+func (m *GetAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAddressResponse) ProtoMessage()    {}
+func (*GetAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35894c6e9efc1a9d, []int{1}
+}
+
+func (m *GetAddressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAddressResponse.Unmarshal(m, b)
+}
+func (m *GetAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAddressResponse.Marshal(b, m, deterministic)
+}
+func (m *GetAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAddressResponse.Merge(m, src)
+}
+func (m *GetAddressResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAddressResponse.Size(m)
+}
+func (m *GetAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAddressResponse proto.InternalMessageInfo
+
+func (m *GetAddressResponse) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+// Next tag: 3
+type SignMessageRequest struct {
+	// raw message to be signed, the daemon signs its eth prefixed hash
+	Msg []byte `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// message kind declared by the node, the daemon checks the message is of this kind
+	Kind                 string   `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignMessageRequest) Reset()         { *m = SignMessageRequest{} }
+func (m *SignMessageRequest) String() string { return proto.CompactTextString(m) }
+func (*SignMessageRequest) ProtoMessage()    {}
+func (*SignMessageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35894c6e9efc1a9d, []int{2}
+}
+
+func (m *SignMessageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignMessageRequest.Unmarshal(m, b)
+}
+func (m *SignMessageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignMessageRequest.Marshal(b, m, deterministic)
+}
+func (m *SignMessageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignMessageRequest.Merge(m, src)
+}
+func (m *SignMessageRequest) XXX_Size() int {
+	return xxx_messageInfo_SignMessageRequest.Size(m)
+}
+func (m *SignMessageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignMessageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignMessageRequest proto.InternalMessageInfo
+
+func (m *SignMessageRequest) GetMsg() []byte {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (m *SignMessageRequest) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+// Next tag: 3
+type SignMessageResponse struct {
+	// signature in the R,S,V format
+	Sig []byte `protobuf:"bytes,1,opt,name=sig,proto3" json:"sig,omitempty"`
+	// message kind checked by the daemon
+	Kind                 string   `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignMessageResponse) Reset()         { *m = SignMessageResponse{} }
+func (m *SignMessageResponse) String() string { return proto.CompactTextString(m) }
+func (*SignMessageResponse) ProtoMessage()    {}
+func (*SignMessageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35894c6e9efc1a9d, []int{3}
+}
+
+func (m *SignMessageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignMessageResponse.Unmarshal(m, b)
+}
+func (m *SignMessageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignMessageResponse.Marshal(b, m, deterministic)
+}
+func (m *SignMessageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignMessageResponse.Merge(m, src)
+}
+func (m *SignMessageResponse) XXX_Size() int {
+	return xxx_messageInfo_SignMessageResponse.Size(m)
+}
+func (m *SignMessageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignMessageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignMessageResponse proto.InternalMessageInfo
+
+func (m *SignMessageResponse) GetSig() []byte {
+	if m != nil {
+		return m.Sig
+	}
+	return nil
+}
+
+func (m *SignMessageResponse) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+// Next tag: 2
+type SignTransactionRequest struct {
+	// RLP encoded unsigned eth transaction
+	RawTx                []byte   `protobuf:"bytes,1,opt,name=raw_tx,json=rawTx,proto3" json:"raw_tx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignTransactionRequest) Reset()         { *m = SignTransactionRequest{} }
+func (m *SignTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*SignTransactionRequest) ProtoMessage()    {}
+func (*SignTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35894c6e9efc1a9d, []int{4}
+}
+
+func (m *SignTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignTransactionRequest.Unmarshal(m, b)
+}
+func (m *SignTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignTransactionRequest.Marshal(b, m, deterministic)
+}
+func (m *SignTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignTransactionRequest.Merge(m, src)
+}
+func (m *SignTransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_SignTransactionRequest.Size(m)
+}
+func (m *SignTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignTransactionRequest proto.InternalMessageInfo
+
+func (m *SignTransactionRequest) GetRawTx() []byte {
+	if m != nil {
+		return m.RawTx
+	}
+	return nil
+}
+
+// Next tag: 2
+type SignTransactionResponse struct {
+	// RLP encoded signed eth transaction
+	SignedTx             []byte   `protobuf:"bytes,1,opt,name=signed_tx,json=signedTx,proto3" json:"signed_tx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignTransactionResponse) Reset()         { *m = SignTransactionResponse{} }
+func (m *SignTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*SignTransactionResponse) ProtoMessage()    {}
+func (*SignTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35894c6e9efc1a9d, []int{5}
+}
+
+func (m *SignTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignTransactionResponse.Unmarshal(m, b)
+}
+func (m *SignTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignTransactionResponse.Marshal(b, m, deterministic)
+}
+func (m *SignTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignTransactionResponse.Merge(m, src)
+}
+func (m *SignTransactionResponse) XXX_Size() int {
+	return xxx_messageInfo_SignTransactionResponse.Size(m)
+}
+func (m *SignTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignTransactionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignTransactionResponse proto.InternalMessageInfo
+
+func (m *SignTransactionResponse) GetSignedTx() []byte {
+	if m != nil {
+		return m.SignedTx
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GetAddressRequest)(nil), "rpc.GetAddressRequest")
+	proto.RegisterType((*GetAddressResponse)(nil), "rpc.GetAddressResponse")
+	proto.RegisterType((*SignMessageRequest)(nil), "rpc.SignMessageRequest")
+	proto.RegisterType((*SignMessageResponse)(nil), "rpc.SignMessageResponse")
+	proto.RegisterType((*SignTransactionRequest)(nil), "rpc.SignTransactionRequest")
+	proto.RegisterType((*SignTransactionResponse)(nil), "rpc.SignTransactionResponse")
+}
+
+func init() { proto.RegisterFile("remote_signer.proto", fileDescriptor_35894c6e9efc1a9d) }
+
+var fileDescriptor_35894c6e9efc1a9d = []byte{
+	// 317 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x4f, 0x4f, 0xc2, 0x40,
+	0x10, 0xc5, 0xad, 0x28, 0xca, 0x48, 0x22, 0x2e, 0x11, 0x1a, 0xf0, 0x40, 0x1a, 0x63, 0xb8, 0xd8,
+	0x26, 0x9a, 0x78, 0xd0, 0x83, 0x11, 0x0f, 0x9e, 0xf4, 0x50, 0x39, 0x79, 0x21, 0xa5, 0x9d, 0xd4,
+	0x0d, 0xb2, 0x5b, 0x67, 0x96, 0xc0, 0xf7, 0xf5, 0x8b, 0x98, 0xfe, 0xa1, 0xa0, 0xe5, 0xb6, 0xfb,
+	0x76, 0x7e, 0xef, 0x65, 0x5e, 0x16, 0xda, 0x84, 0x73, 0x6d, 0x70, 0xc2, 0x32, 0x56, 0x48, 0x6e,
+	0x42, 0xda, 0x68, 0x51, 0xa3, 0x24, 0x74, 0xda, 0x70, 0xf6, 0x82, 0xe6, 0x29, 0x8a, 0x08, 0x99,
+	0x7d, 0xfc, 0x5e, 0x20, 0x1b, 0xc7, 0x05, 0xb1, 0x2d, 0x72, 0xa2, 0x15, 0xa3, 0xb0, 0xe1, 0x28,
+	0xc8, 0x25, 0xdb, 0x1a, 0x58, 0xc3, 0xa6, 0xbf, 0xbe, 0x3a, 0xf7, 0x20, 0xde, 0x65, 0xac, 0x5e,
+	0x91, 0x39, 0x88, 0xb1, 0x70, 0x11, 0x2d, 0xa8, 0xcd, 0x39, 0x2e, 0x66, 0xd3, 0xa3, 0x10, 0x70,
+	0x30, 0x93, 0x2a, 0xb2, 0xf7, 0x07, 0xd6, 0xb0, 0xe1, 0x67, 0x67, 0xe7, 0x01, 0xda, 0x7f, 0xd8,
+	0x22, 0xac, 0x05, 0x35, 0x96, 0x25, 0xcc, 0x72, 0x37, 0xec, 0x41, 0x27, 0x85, 0xc7, 0x14, 0x28,
+	0x0e, 0x42, 0x23, 0xb5, 0x5a, 0x87, 0x9f, 0x43, 0x9d, 0x82, 0xe5, 0xc4, 0xac, 0x0a, 0x8b, 0x43,
+	0x0a, 0x96, 0xe3, 0x95, 0x73, 0x07, 0xdd, 0x0a, 0x50, 0x24, 0xf6, 0xa1, 0x91, 0xd5, 0x13, 0x6d,
+	0xa0, 0xe3, 0x5c, 0x18, 0xaf, 0x6e, 0x7e, 0x2c, 0x68, 0xfa, 0x59, 0x87, 0x29, 0x8e, 0x24, 0x1e,
+	0x01, 0x36, 0x15, 0x89, 0x8e, 0x4b, 0x49, 0xe8, 0x56, 0x8a, 0xec, 0x75, 0x2b, 0x7a, 0x1e, 0xe6,
+	0xec, 0x89, 0x11, 0x9c, 0x6c, 0xed, 0x2d, 0xf2, 0xc9, 0x6a, 0x8b, 0x3d, 0xbb, 0xfa, 0x50, 0x7a,
+	0xbc, 0xc1, 0xe9, 0xbf, 0x6d, 0x44, 0xbf, 0x1c, 0xaf, 0x96, 0xd2, 0xbb, 0xd8, 0xfd, 0xb8, 0xf6,
+	0x1b, 0x5d, 0x7d, 0x5c, 0xc6, 0xd2, 0x7c, 0x2e, 0xa6, 0x6e, 0xa8, 0xe7, 0x5e, 0x88, 0x5f, 0x48,
+	0xd7, 0x0a, 0xcd, 0x52, 0xd3, 0xcc, 0x8b, 0xf5, 0x73, 0x7a, 0xf7, 0x28, 0x09, 0xa7, 0xf5, 0xec,
+	0x03, 0xdd, 0xfe, 0x0e, 0x00, 0xe3, 0xe9, 0x39, 0x62, 0x57, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RemoteSignerClient is the client API for RemoteSigner service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RemoteSignerClient interface {
+	GetAddress(ctx context.Context, in *GetAddressRequest, opts ...grpc.CallOption) (*GetAddressResponse, error)
+	SignMessage(ctx context.Context, in *SignMessageRequest, opts ...grpc.CallOption) (*SignMessageResponse, error)
+	SignTransaction(ctx context.Context, in *SignTransactionRequest, opts ...grpc.CallOption) (*SignTransactionResponse, error)
+}
+
+type remoteSignerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRemoteSignerClient(cc *grpc.ClientConn) RemoteSignerClient {
+	return &remoteSignerClient{cc}
+}
+
+func (c *remoteSignerClient) GetAddress(ctx context.Context, in *GetAddressRequest, opts ...grpc.CallOption) (*GetAddressResponse, error) {
+	out := new(GetAddressResponse)
+	err := c.cc.Invoke(ctx, "/rpc.RemoteSigner/GetAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteSignerClient) SignMessage(ctx context.Context, in *SignMessageRequest, opts ...grpc.CallOption) (*SignMessageResponse, error) {
+	out := new(SignMessageResponse)
+	err := c.cc.Invoke(ctx, "/rpc.RemoteSigner/SignMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteSignerClient) SignTransaction(ctx context.Context, in *SignTransactionRequest, opts ...grpc.CallOption) (*SignTransactionResponse, error) {
+	out := new(SignTransactionResponse)
+	err := c.cc.Invoke(ctx, "/rpc.RemoteSigner/SignTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RemoteSignerServer is the server API for RemoteSigner service.
+type RemoteSignerServer interface {
+	GetAddress(context.Context, *GetAddressRequest) (*GetAddressResponse, error)
+	SignMessage(context.Context, *SignMessageRequest) (*SignMessageResponse, error)
+	SignTransaction(context.Context, *SignTransactionRequest) (*SignTransactionResponse, error)
+}
+
+// UnimplementedRemoteSignerServer can be embedded to have forward compatible implementations.
+type UnimplementedRemoteSignerServer struct {
+}
+
+func (*UnimplementedRemoteSignerServer) GetAddress(ctx context.Context, req *GetAddressRequest) (*GetAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAddress not implemented")
+}
+func (*UnimplementedRemoteSignerServer) SignMessage(ctx context.Context, req *SignMessageRequest) (*SignMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignMessage not implemented")
+}
+func (*UnimplementedRemoteSignerServer) SignTransaction(ctx context.Context, req *SignTransactionRequest) (*SignTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignTransaction not implemented")
+}
+
+func RegisterRemoteSignerServer(s *grpc.Server, srv RemoteSignerServer) {
+	s.RegisterService(&_RemoteSigner_serviceDesc, srv)
+}
+
+func _RemoteSigner_GetAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSignerServer).GetAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RemoteSigner/GetAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSignerServer).GetAddress(ctx, req.(*GetAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteSigner_SignMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSignerServer).SignMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RemoteSigner/SignMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSignerServer).SignMessage(ctx, req.(*SignMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteSigner_SignTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSignerServer).SignTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RemoteSigner/SignTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSignerServer).SignTransaction(ctx, req.(*SignTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RemoteSigner_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "rpc.RemoteSigner",
+	HandlerType: (*RemoteSignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAddress",
+			Handler:    _RemoteSigner_GetAddress_Handler,
+		},
+		{
+			MethodName: "SignMessage",
+			Handler:    _RemoteSigner_SignMessage_Handler,
+		},
+		{
+			MethodName: "SignTransaction",
+			Handler:    _RemoteSigner_SignTransaction_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "remote_signer.proto",
+}
@@ -27,28 +27,29 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("rpc.proto", fileDescriptor_77a6da22d6a3feb1) }
 
 var fileDescriptor_77a6da22d6a3feb1 = []byte{
-	// 334 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xcd, 0x4e, 0xf2, 0x40,
-	0x14, 0x86, 0x21, 0x7c, 0xf9, 0xa2, 0x63, 0x08, 0x38, 0x26, 0x2a, 0xc5, 0x15, 0x71, 0xe1, 0xc6,
-	0xe2, 0xcf, 0xca, 0xa5, 0x60, 0x84, 0x98, 0xa0, 0x15, 0x5c, 0xb9, 0x1b, 0x86, 0x93, 0xa1, 0x11,
-	0x66, 0xc6, 0x33, 0xd3, 0x98, 0xde, 0x9b, 0x17, 0x67, 0x3a, 0xfd, 0x09, 0xb5, 0xdd, 0xb9, 0x6a,
-	0xfa, 0x9c, 0xf3, 0x3e, 0x79, 0xd3, 0x1e, 0xb2, 0x8f, 0x9a, 0xfb, 0x1a, 0x95, 0x55, 0xb4, 0x85,
-	0x9a, 0x7b, 0xed, 0x2d, 0x18, 0xc3, 0x04, 0xa4, 0xec, 0xe6, 0xfb, 0x1f, 0x69, 0xcd, 0x35, 0xa7,
-	0x53, 0xd2, 0x9e, 0x80, 0x0d, 0x58, 0x3c, 0x0d, 0x8d, 0x55, 0x18, 0xd3, 0x9e, 0x9f, 0x04, 0x4b,
-	0x6c, 0x0e, 0x9f, 0x11, 0x18, 0xeb, 0x79, 0x75, 0x23, 0xa3, 0x95, 0x34, 0x30, 0x68, 0xd0, 0x67,
-	0xd2, 0x79, 0x8d, 0x00, 0xe3, 0x07, 0xd8, 0x80, 0x60, 0x36, 0x54, 0x92, 0xf6, 0x5d, 0xe0, 0x17,
-	0xcd, 0x6d, 0x67, 0xf5, 0xc3, 0xc2, 0xf7, 0x48, 0x0e, 0xb3, 0xd5, 0x1d, 0xe3, 0xb1, 0x0b, 0x55,
-	0x65, 0x27, 0x15, 0x5e, 0x78, 0x26, 0xa4, 0x3b, 0x86, 0x0d, 0xe0, 0x8b, 0x06, 0x39, 0x5e, 0x33,
-	0x29, 0x61, 0x43, 0xd3, 0xf5, 0x1d, 0x92, 0x7b, 0x4e, 0xab, 0x83, 0x42, 0xf4, 0x44, 0x8e, 0x0a,
-	0xd1, 0x1b, 0x5f, 0xfe, 0xc9, 0x75, 0x47, 0xa8, 0x73, 0x25, 0x1f, 0x13, 0x00, 0x17, 0x96, 0xd9,
-	0xc8, 0xd0, 0xae, 0x4b, 0x24, 0xe0, 0x7e, 0xb5, 0x42, 0x30, 0xc6, 0xeb, 0x14, 0x24, 0x5d, 0x19,
-	0x34, 0xe8, 0x35, 0x39, 0x70, 0xd1, 0x85, 0x45, 0x60, 0x5b, 0xda, 0x76, 0x1b, 0x8e, 0xcc, 0x8c,
-	0xf0, 0xca, 0xaf, 0x83, 0xc6, 0x45, 0xf3, 0xaa, 0x49, 0x83, 0xac, 0xf9, 0x2c, 0x14, 0xc8, 0x2c,
-	0xe4, 0xcd, 0xd3, 0xff, 0x59, 0x86, 0x79, 0xf9, 0x7e, 0xed, 0x2c, 0xef, 0x3f, 0x9a, 0x91, 0x9e,
-	0x04, 0xfb, 0xa5, 0xf0, 0xc3, 0xe7, 0x89, 0xd9, 0x17, 0x2a, 0x7d, 0xa2, 0xe6, 0xa3, 0xbd, 0xb9,
-	0xe6, 0x41, 0x72, 0x65, 0x41, 0xf3, 0xfd, 0x5c, 0x84, 0x76, 0x1d, 0x2d, 0x7d, 0xae, 0xb6, 0x43,
-	0xb7, 0x71, 0x99, 0xe5, 0x86, 0x42, 0xb9, 0x4e, 0x43, 0xd4, 0x7c, 0xf9, 0xdf, 0x1d, 0xe5, 0xed,
-	0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5e, 0xea, 0xed, 0x80, 0xb5, 0x02, 0x00, 0x00,
+	// 346 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xcb, 0x4f, 0xc2, 0x40,
+	0x10, 0xc6, 0x21, 0x18, 0x23, 0x6b, 0x08, 0xb8, 0xc6, 0x07, 0xc5, 0x13, 0xf1, 0xe0, 0xc5, 0xe2,
+	0xe3, 0x64, 0xe2, 0x45, 0x30, 0x40, 0x4c, 0xd0, 0x0a, 0x9e, 0xbc, 0x2d, 0xcb, 0x64, 0x69, 0x84,
+	0xee, 0x3a, 0xbb, 0x8d, 0xe1, 0x5f, 0xf7, 0x64, 0xba, 0x4b, 0x37, 0xbc, 0x6e, 0x9e, 0x9a, 0xfe,
+	0xe6, 0x9b, 0x5f, 0xbf, 0x34, 0x43, 0xca, 0xa8, 0x78, 0xa8, 0x50, 0x1a, 0x49, 0x4b, 0xa8, 0x78,
+	0x50, 0x99, 0x83, 0xd6, 0x4c, 0x80, 0x63, 0x77, 0xbf, 0x7b, 0xa4, 0x34, 0x54, 0x9c, 0xf6, 0x49,
+	0xa5, 0x07, 0x26, 0x62, 0x8b, 0x7e, 0xac, 0x8d, 0xc4, 0x05, 0xad, 0x87, 0xd9, 0xe2, 0x1a, 0x1b,
+	0xc2, 0x77, 0x0a, 0xda, 0x04, 0xc1, 0xae, 0x91, 0x56, 0x32, 0xd1, 0xd0, 0x2c, 0xd0, 0x47, 0x52,
+	0x76, 0xa3, 0x2e, 0x00, 0x3d, 0x59, 0x89, 0x76, 0x01, 0x72, 0xc3, 0xe9, 0x26, 0xf6, 0xdb, 0xaf,
+	0xa4, 0xfa, 0x9e, 0x02, 0x2e, 0x9e, 0x61, 0x06, 0x82, 0x99, 0x58, 0x26, 0xb4, 0x61, 0xc3, 0x1b,
+	0x34, 0x37, 0x5d, 0xec, 0x1e, 0x7a, 0x5f, 0x97, 0x1c, 0x2d, 0xa3, 0x2b, 0x46, 0xf7, 0xf9, 0x6d,
+	0xd9, 0xd9, 0x16, 0xf7, 0x9e, 0x1e, 0xa9, 0x75, 0x60, 0x06, 0xf8, 0xa6, 0x20, 0xe9, 0x4c, 0x59,
+	0x92, 0xc0, 0x8c, 0xba, 0xf8, 0x0a, 0xc9, 0x3d, 0xe7, 0xdb, 0x03, 0x2f, 0x7a, 0x21, 0xc7, 0x5e,
+	0xf4, 0xc1, 0xc7, 0xff, 0x72, 0x3d, 0x10, 0x6a, 0x5d, 0xd9, 0x8f, 0x04, 0xc0, 0x91, 0x61, 0x26,
+	0xd5, 0xb4, 0x66, 0x37, 0x32, 0xf0, 0x34, 0x99, 0x20, 0x68, 0x1d, 0x54, 0x3d, 0x71, 0x91, 0x66,
+	0x81, 0xde, 0x92, 0x43, 0xbb, 0x3a, 0x32, 0x08, 0x6c, 0x4e, 0x2b, 0x36, 0x61, 0xc9, 0x40, 0x8b,
+	0x60, 0xfd, 0xb5, 0x59, 0xb8, 0x2a, 0xde, 0x14, 0x69, 0xb4, 0x6c, 0x3e, 0x88, 0x05, 0x32, 0x03,
+	0x79, 0x73, 0x77, 0x0d, 0xeb, 0x30, 0x2f, 0xdf, 0xd8, 0x39, 0xcb, 0xfb, 0xb7, 0x07, 0xa4, 0x9e,
+	0x80, 0xf9, 0x91, 0xf8, 0x15, 0xf2, 0xcc, 0x1c, 0x0a, 0xe9, 0x9e, 0xa8, 0x78, 0xfb, 0x60, 0xa8,
+	0x78, 0x94, 0xdd, 0x68, 0x54, 0xfc, 0xbc, 0x14, 0xb1, 0x99, 0xa6, 0xe3, 0x90, 0xcb, 0x79, 0xcb,
+	0x26, 0xae, 0x97, 0x7b, 0x2d, 0x21, 0x6d, 0xa7, 0x16, 0x2a, 0x3e, 0xde, 0xb7, 0x27, 0x7d, 0xff,
+	0x37, 0x00, 0x4d, 0x8f, 0xa2, 0x2a, 0xf3, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -64,6 +65,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type RpcClient interface {
 	GetPayHistory(ctx context.Context, in *GetPayHistoryRequest, opts ...grpc.CallOption) (*GetPayHistoryResponse, error)
+	GetPayFee(ctx context.Context, in *GetPayFeeRequest, opts ...grpc.CallOption) (*GetPayFeeResponse, error)
 	QueryDelegation(ctx context.Context, in *QueryDelegationRequest, opts ...grpc.CallOption) (*QueryDelegationResponse, error)
 	RequestDelegation(ctx context.Context, in *DelegationRequest, opts ...grpc.CallOption) (*DelegationResponse, error)
 	CelerOpenChannel(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (*OpenChannelResponse, error)
@@ -91,6 +93,15 @@ func (c *rpcClient) GetPayHistory(ctx context.Context, in *GetPayHistoryRequest,
 	return out, nil
 }
 
+func (c *rpcClient) GetPayFee(ctx context.Context, in *GetPayFeeRequest, opts ...grpc.CallOption) (*GetPayFeeResponse, error) {
+	out := new(GetPayFeeResponse)
+	err := c.cc.Invoke(ctx, "/rpc.Rpc/GetPayFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rpcClient) QueryDelegation(ctx context.Context, in *QueryDelegationRequest, opts ...grpc.CallOption) (*QueryDelegationResponse, error) {
 	out := new(QueryDelegationResponse)
 	err := c.cc.Invoke(ctx, "/rpc.Rpc/QueryDelegation", in, out, opts...)
@@ -179,6 +190,7 @@ func (c *rpcClient) CelerMigrateChannel(ctx context.Context, in *MigrateChannelR
 // RpcServer is the server API for Rpc service.
 type RpcServer interface {
 	GetPayHistory(context.Context, *GetPayHistoryRequest) (*GetPayHistoryResponse, error)
+	GetPayFee(context.Context, *GetPayFeeRequest) (*GetPayFeeResponse, error)
 	QueryDelegation(context.Context, *QueryDelegationRequest) (*QueryDelegationResponse, error)
 	RequestDelegation(context.Context, *DelegationRequest) (*DelegationResponse, error)
 	CelerOpenChannel(context.Context, *OpenChannelRequest) (*OpenChannelResponse, error)
@@ -196,6 +208,9 @@ type UnimplementedRpcServer struct {
 func (*UnimplementedRpcServer) GetPayHistory(ctx context.Context, req *GetPayHistoryRequest) (*GetPayHistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPayHistory not implemented")
 }
+func (*UnimplementedRpcServer) GetPayFee(ctx context.Context, req *GetPayFeeRequest) (*GetPayFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayFee not implemented")
+}
 func (*UnimplementedRpcServer) QueryDelegation(ctx context.Context, req *QueryDelegationRequest) (*QueryDelegationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryDelegation not implemented")
 }
@@ -240,6 +255,24 @@ func _Rpc_GetPayHistory_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Rpc_GetPayFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPayFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServer).GetPayFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Rpc/GetPayFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServer).GetPayFee(ctx, req.(*GetPayFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Rpc_QueryDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryDelegationRequest)
 	if err := dec(in); err != nil {
@@ -382,6 +415,10 @@ var _Rpc_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPayHistory",
 			Handler:    _Rpc_GetPayHistory_Handler,
 		},
+		{
+			MethodName: "GetPayFee",
+			Handler:    _Rpc_GetPayFee_Handler,
+		},
 		{
 			MethodName: "QueryDelegation",
 			Handler:    _Rpc_QueryDelegation_Handler,
@@ -60,9 +60,13 @@ type ConnectionManager struct {
 	// a peer when its  connection is broken.
 	enableMsgQueue  MsgQueueCallbackFunc
 	disableMsgQueue MsgQueueCallbackFunc
+	// Parent of the contexts returned by AddCelerStream, cancelled by CloseAll.
+	streamsCtx   context.Context
+	closeStreams context.CancelFunc
 }
 
 func NewConnectionManager(regClient RegisterClientCallbackFunc) *ConnectionManager {
+	streamsCtx, closeStreams := context.WithCancel(context.Background())
 	return &ConnectionManager{
 		celerStreams: make(map[ctype.Addr]*SafeSendCelerStream),
 		conns:        make(map[ctype.Addr]*grpc.ClientConn),
@@ -70,6 +74,8 @@ func NewConnectionManager(regClient RegisterClientCallbackFunc) *ConnectionManag
 		errCallbacks: make(map[ctype.Addr]ErrCallbackFunc),
 		lock:         &sync.RWMutex{},
 		regClient:    regClient,
+		streamsCtx:   streamsCtx,
+		closeStreams: closeStreams,
 	}
 }
 
@@ -120,6 +126,23 @@ func (m *ConnectionManager) CloseNoRetry(onchainAddr ctype.Addr) {
 	m.CloseConnection(onchainAddr)
 }
 
+// CloseAll removes all errCallbacks so no more retry, then ends all celer streams and
+// closes all grpc conns. It returns the number of streams open before the call.
+// Expect to be only called when the node is shutting down.
+func (m *ConnectionManager) CloseAll() int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.errCallbacks = make(map[ctype.Addr]ErrCallbackFunc)
+	numStreams := len(m.celerStreams)
+	// server side streams end when their handlers return on the cancelled contexts
+	m.closeStreams()
+	for peerAddr, cc := range m.conns {
+		cc.Close()
+		delete(m.conns, peerAddr)
+	}
+	return numStreams
+}
+
 func (m *ConnectionManager) CloseConnection(peerAddr ctype.Addr) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
@@ -155,7 +178,7 @@ func (m *ConnectionManager) AddCelerStream(peerAddr ctype.Addr, stream CelerStre
 			log.Warnln("CelerStream: enable peer message queue error:", peerAddr.Hex(), ":", err)
 		}
 	}
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(m.streamsCtx)
 	go func() {
 		var err error
 		defer close(msgProcessor)
@@ -281,6 +304,14 @@ func (m *ConnectionManager) GetCelerStream(peerAddr ctype.Addr) *SafeSendCelerSt
 	return m.celerStreams[peerAddr]
 }
 
+// HasCelerStream returns true if this node holds a celer stream of the peer
+func (m *ConnectionManager) HasCelerStream(peerAddr ctype.Addr) bool {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	_, ok := m.celerStreams[peerAddr]
+	return ok
+}
+
 func (m *ConnectionManager) GetNumCelerStreams() int {
 	m.lock.RLock()
 	defer m.lock.RUnlock()
@@ -22,7 +22,7 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 // RuntimeConfig is the data object holding configs reloadable during runtime
 // numeric field name should end with unit, eg. gwei, ms
-// Next tag: 21
+// Next tag: 34
 type RuntimeConfig struct {
 	// wait seconds before accepting next open chan request
 	// if 0, means no wait. negative values are treated as 0
@@ -36,6 +36,42 @@ type RuntimeConfig struct {
 	MaxGasGwei uint64 `protobuf:"varint,3,opt,name=max_gas_gwei,json=maxGasGwei,proto3" json:"max_gas_gwei,omitempty"`
 	// add gas price to the suggested price to speed up transaction
 	AddGasGwei uint64 `protobuf:"varint,20,opt,name=add_gas_gwei,json=addGasGwei,proto3" json:"add_gas_gwei,omitempty"`
+	// rebroadcast a pending tx with higher gas price after this many seconds
+	// if 0, use default 180s
+	TxBumpIntervalS uint64 `protobuf:"varint,21,opt,name=tx_bump_interval_s,json=txBumpIntervalS,proto3" json:"tx_bump_interval_s,omitempty"`
+	// percentage to raise the gas price of a rebroadcast tx, at least 10 (geth replacement rule)
+	// if 0, use default 20
+	TxBumpPercent uint64 `protobuf:"varint,22,opt,name=tx_bump_percent,json=txBumpPercent,proto3" json:"tx_bump_percent,omitempty"`
+	// price onchain txs from base fee and priority fee (EIP-1559) instead of the suggested
+	// gas price. falls back to legacy pricing on chains without base fee
+	Eip1559 bool `protobuf:"varint,23,opt,name=eip1559,proto3" json:"eip1559,omitempty"`
+	// max fee per gas in gwei for EIP-1559 pricing, replaces max_gas_gwei in this mode
+	// if 0, means no max
+	MaxFeeGwei uint64 `protobuf:"varint,24,opt,name=max_fee_gwei,json=maxFeeGwei,proto3" json:"max_fee_gwei,omitempty"`
+	// max priority fee (tip) per gas in gwei for EIP-1559 pricing
+	// if 0, use default 2 gwei
+	MaxPriorityFeeGwei uint64 `protobuf:"varint,25,opt,name=max_priority_fee_gwei,json=maxPriorityFeeGwei,proto3" json:"max_priority_fee_gwei,omitempty"`
+	// reward percentile of recent blocks used to estimate the priority fee
+	// if 0, use default 50
+	PriorityFeePercentile uint64 `protobuf:"varint,26,opt,name=priority_fee_percentile,json=priorityFeePercentile,proto3" json:"priority_fee_percentile,omitempty"`
+	// key: event name, eg. Deposit, IntendSettle. value: number of confirmation blocks
+	// before the event log is processed. events not in the map use the profile block delay
+	// only applies to event monitors started after the config is loaded
+	EventConfirmBlocks map[string]uint64 `protobuf:"bytes,27,rep,name=event_confirm_blocks,json=eventConfirmBlocks,proto3" json:"event_confirm_blocks,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// check processed event logs within this many recent blocks for chain reorgs
+	// if 0, use default 200
+	ReorgTrackBlocks uint64 `protobuf:"varint,28,opt,name=reorg_track_blocks,json=reorgTrackBlocks,proto3" json:"reorg_track_blocks,omitempty"`
+	// circular rebalance configuration of peer osp channels
+	RebalanceConfigs *RebalanceConfigs `protobuf:"bytes,29,opt,name=rebalance_configs,json=rebalanceConfigs,proto3" json:"rebalance_configs,omitempty"`
+	// fees charged for forwarding pays to the next hop
+	FeeConfigs *FeeConfigs `protobuf:"bytes,30,opt,name=fee_configs,json=feeConfigs,proto3" json:"fee_configs,omitempty"`
+	// rate limits of messages and rpc calls from each peer
+	RateLimitConfigs *RateLimitConfigs `protobuf:"bytes,31,opt,name=rate_limit_configs,json=rateLimitConfigs,proto3" json:"rate_limit_configs,omitempty"`
+	// max number of unacked messages queued to a channel before new pays through it are rejected
+	// if 0, use default 1000
+	MsgQueueMaxDepth uint64 `protobuf:"varint,32,opt,name=msg_queue_max_depth,json=msgQueueMaxDepth,proto3" json:"msg_queue_max_depth,omitempty"`
+	// window of unacked in-flight messages per channel, adapted to the peer ack latency and nacks
+	SlidingWindowConfig *SlidingWindowConfig `protobuf:"bytes,33,opt,name=sliding_window_config,json=slidingWindowConfig,proto3" json:"sliding_window_config,omitempty"`
 	// wait time (in seconds) of stream send.
 	StreamSendTimeoutS uint64 `protobuf:"varint,4,opt,name=stream_send_timeout_s,json=streamSendTimeoutS,proto3" json:"stream_send_timeout_s,omitempty"`
 	// decimal. eth deposit cap for cold bootstrap
@@ -124,6 +160,97 @@ func (m *RuntimeConfig) GetAddGasGwei() uint64 {
 	return 0
 }
 
+func (m *RuntimeConfig) GetTxBumpIntervalS() uint64 {
+	if m != nil {
+		return m.TxBumpIntervalS
+	}
+	return 0
+}
+
+func (m *RuntimeConfig) GetTxBumpPercent() uint64 {
+	if m != nil {
+		return m.TxBumpPercent
+	}
+	return 0
+}
+
+func (m *RuntimeConfig) GetEip1559() bool {
+	if m != nil {
+		return m.Eip1559
+	}
+	return false
+}
+
+func (m *RuntimeConfig) GetMaxFeeGwei() uint64 {
+	if m != nil {
+		return m.MaxFeeGwei
+	}
+	return 0
+}
+
+func (m *RuntimeConfig) GetMaxPriorityFeeGwei() uint64 {
+	if m != nil {
+		return m.MaxPriorityFeeGwei
+	}
+	return 0
+}
+
+func (m *RuntimeConfig) GetPriorityFeePercentile() uint64 {
+	if m != nil {
+		return m.PriorityFeePercentile
+	}
+	return 0
+}
+
+func (m *RuntimeConfig) GetEventConfirmBlocks() map[string]uint64 {
+	if m != nil {
+		return m.EventConfirmBlocks
+	}
+	return nil
+}
+
+func (m *RuntimeConfig) GetReorgTrackBlocks() uint64 {
+	if m != nil {
+		return m.ReorgTrackBlocks
+	}
+	return 0
+}
+
+func (m *RuntimeConfig) GetRebalanceConfigs() *RebalanceConfigs {
+	if m != nil {
+		return m.RebalanceConfigs
+	}
+	return nil
+}
+
+func (m *RuntimeConfig) GetFeeConfigs() *FeeConfigs {
+	if m != nil {
+		return m.FeeConfigs
+	}
+	return nil
+}
+
+func (m *RuntimeConfig) GetRateLimitConfigs() *RateLimitConfigs {
+	if m != nil {
+		return m.RateLimitConfigs
+	}
+	return nil
+}
+
+func (m *RuntimeConfig) GetMsgQueueMaxDepth() uint64 {
+	if m != nil {
+		return m.MsgQueueMaxDepth
+	}
+	return 0
+}
+
+func (m *RuntimeConfig) GetSlidingWindowConfig() *SlidingWindowConfig {
+	if m != nil {
+		return m.SlidingWindowConfig
+	}
+	return nil
+}
+
 func (m *RuntimeConfig) GetStreamSendTimeoutS() uint64 {
 	if m != nil {
 		return m.StreamSendTimeoutS
@@ -722,6 +849,420 @@ func (m *RefillConfigs) GetMaxWaitS() uint64 {
 	return 0
 }
 
+// Next Tag: 7
+type RebalanceConfig struct {
+	Token *Token `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// rebalance a peer osp channel when my share of its free balance is below this ratio
+	LowRatio float64 `protobuf:"fixed64,2,opt,name=low_ratio,json=lowRatio,proto3" json:"low_ratio,omitempty"`
+	// use peer osp channels with my share of free balance above this ratio to send the rebalance pay
+	HighRatio float64 `protobuf:"fixed64,3,opt,name=high_ratio,json=highRatio,proto3" json:"high_ratio,omitempty"`
+	// decimal. max amount of one rebalance pay
+	MaxAmount string `protobuf:"bytes,4,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	// decimal. max total amount of rebalance pays in a day
+	DailyAmountBudget string `protobuf:"bytes,5,opt,name=daily_amount_budget,json=dailyAmountBudget,proto3" json:"daily_amount_budget,omitempty"`
+	// decimal. max total relay fees paid for rebalance pays in a day
+	DailyFeeBudget       string   `protobuf:"bytes,6,opt,name=daily_fee_budget,json=dailyFeeBudget,proto3" json:"daily_fee_budget,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RebalanceConfig) Reset()         { *m = RebalanceConfig{} }
+func (m *RebalanceConfig) String() string { return proto.CompactTextString(m) }
+func (*RebalanceConfig) ProtoMessage()    {}
+func (*RebalanceConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{10}
+}
+
+func (m *RebalanceConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebalanceConfig.Unmarshal(m, b)
+}
+func (m *RebalanceConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebalanceConfig.Marshal(b, m, deterministic)
+}
+func (m *RebalanceConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebalanceConfig.Merge(m, src)
+}
+func (m *RebalanceConfig) XXX_Size() int {
+	return xxx_messageInfo_RebalanceConfig.Size(m)
+}
+func (m *RebalanceConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebalanceConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebalanceConfig proto.InternalMessageInfo
+
+func (m *RebalanceConfig) GetToken() *Token {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+func (m *RebalanceConfig) GetLowRatio() float64 {
+	if m != nil {
+		return m.LowRatio
+	}
+	return 0
+}
+
+func (m *RebalanceConfig) GetHighRatio() float64 {
+	if m != nil {
+		return m.HighRatio
+	}
+	return 0
+}
+
+func (m *RebalanceConfig) GetMaxAmount() string {
+	if m != nil {
+		return m.MaxAmount
+	}
+	return ""
+}
+
+func (m *RebalanceConfig) GetDailyAmountBudget() string {
+	if m != nil {
+		return m.DailyAmountBudget
+	}
+	return ""
+}
+
+func (m *RebalanceConfig) GetDailyFeeBudget() string {
+	if m != nil {
+		return m.DailyFeeBudget
+	}
+	return ""
+}
+
+// Next Tag: 5
+type RebalanceConfigs struct {
+	// keyed by token addr
+	Config map[string]*RebalanceConfig `protobuf:"bytes,1,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// interval in seconds to check peer osp channels, if 0, rebalance is disabled
+	IntervalS uint64 `protobuf:"varint,2,opt,name=interval_s,json=intervalS,proto3" json:"interval_s,omitempty"`
+	// max number of peer osps on a rebalance cycle, if 0, use default 4
+	MaxHops uint64 `protobuf:"varint,3,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty"`
+	// only log the planned rebalances without sending pays
+	DryRun               bool     `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RebalanceConfigs) Reset()         { *m = RebalanceConfigs{} }
+func (m *RebalanceConfigs) String() string { return proto.CompactTextString(m) }
+func (*RebalanceConfigs) ProtoMessage()    {}
+func (*RebalanceConfigs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{11}
+}
+
+func (m *RebalanceConfigs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebalanceConfigs.Unmarshal(m, b)
+}
+func (m *RebalanceConfigs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebalanceConfigs.Marshal(b, m, deterministic)
+}
+func (m *RebalanceConfigs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebalanceConfigs.Merge(m, src)
+}
+func (m *RebalanceConfigs) XXX_Size() int {
+	return xxx_messageInfo_RebalanceConfigs.Size(m)
+}
+func (m *RebalanceConfigs) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebalanceConfigs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebalanceConfigs proto.InternalMessageInfo
+
+func (m *RebalanceConfigs) GetConfig() map[string]*RebalanceConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *RebalanceConfigs) GetIntervalS() uint64 {
+	if m != nil {
+		return m.IntervalS
+	}
+	return 0
+}
+
+func (m *RebalanceConfigs) GetMaxHops() uint64 {
+	if m != nil {
+		return m.MaxHops
+	}
+	return 0
+}
+
+func (m *RebalanceConfigs) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+// Next Tag: 4
+type FeeConfig struct {
+	Token *Token `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// decimal. flat fee of forwarding one pay
+	BaseFee string `protobuf:"bytes,2,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`
+	// proportional fee in parts per million of the pay amount
+	FeeRatePpm           uint64   `protobuf:"varint,3,opt,name=fee_rate_ppm,json=feeRatePpm,proto3" json:"fee_rate_ppm,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FeeConfig) Reset()         { *m = FeeConfig{} }
+func (m *FeeConfig) String() string { return proto.CompactTextString(m) }
+func (*FeeConfig) ProtoMessage()    {}
+func (*FeeConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{12}
+}
+
+func (m *FeeConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeeConfig.Unmarshal(m, b)
+}
+func (m *FeeConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeeConfig.Marshal(b, m, deterministic)
+}
+func (m *FeeConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeConfig.Merge(m, src)
+}
+func (m *FeeConfig) XXX_Size() int {
+	return xxx_messageInfo_FeeConfig.Size(m)
+}
+func (m *FeeConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeConfig proto.InternalMessageInfo
+
+func (m *FeeConfig) GetToken() *Token {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+func (m *FeeConfig) GetBaseFee() string {
+	if m != nil {
+		return m.BaseFee
+	}
+	return ""
+}
+
+func (m *FeeConfig) GetFeeRatePpm() uint64 {
+	if m != nil {
+		return m.FeeRatePpm
+	}
+	return 0
+}
+
+// Next Tag: 2
+type FeeConfigs struct {
+	// keyed by token addr
+	Config               map[string]*FeeConfig `protobuf:"bytes,1,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *FeeConfigs) Reset()         { *m = FeeConfigs{} }
+func (m *FeeConfigs) String() string { return proto.CompactTextString(m) }
+func (*FeeConfigs) ProtoMessage()    {}
+func (*FeeConfigs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{13}
+}
+
+func (m *FeeConfigs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeeConfigs.Unmarshal(m, b)
+}
+func (m *FeeConfigs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeeConfigs.Marshal(b, m, deterministic)
+}
+func (m *FeeConfigs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeConfigs.Merge(m, src)
+}
+func (m *FeeConfigs) XXX_Size() int {
+	return xxx_messageInfo_FeeConfigs.Size(m)
+}
+func (m *FeeConfigs) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeConfigs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeConfigs proto.InternalMessageInfo
+
+func (m *FeeConfigs) GetConfig() map[string]*FeeConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+// Next Tag: 3
+type RateLimitConfig struct {
+	// tokens refilled per second, if 0, means no limit
+	RatePerS float64 `protobuf:"fixed64,1,opt,name=rate_per_s,json=ratePerS,proto3" json:"rate_per_s,omitempty"`
+	// max number of requests allowed in a burst, at least 1
+	Burst                uint64   `protobuf:"varint,2,opt,name=burst,proto3" json:"burst,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RateLimitConfig) Reset()         { *m = RateLimitConfig{} }
+func (m *RateLimitConfig) String() string { return proto.CompactTextString(m) }
+func (*RateLimitConfig) ProtoMessage()    {}
+func (*RateLimitConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{14}
+}
+
+func (m *RateLimitConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RateLimitConfig.Unmarshal(m, b)
+}
+func (m *RateLimitConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RateLimitConfig.Marshal(b, m, deterministic)
+}
+func (m *RateLimitConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitConfig.Merge(m, src)
+}
+func (m *RateLimitConfig) XXX_Size() int {
+	return xxx_messageInfo_RateLimitConfig.Size(m)
+}
+func (m *RateLimitConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitConfig proto.InternalMessageInfo
+
+func (m *RateLimitConfig) GetRatePerS() float64 {
+	if m != nil {
+		return m.RatePerS
+	}
+	return 0
+}
+
+func (m *RateLimitConfig) GetBurst() uint64 {
+	if m != nil {
+		return m.Burst
+	}
+	return 0
+}
+
+// Next Tag: 2
+type RateLimitConfigs struct {
+	// keyed by message name, eg. CondPayRequestMessage, RoutingRequestMessage,
+	// or rpc method name, eg. GetPayHistory
+	Config               map[string]*RateLimitConfig `protobuf:"bytes,1,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *RateLimitConfigs) Reset()         { *m = RateLimitConfigs{} }
+func (m *RateLimitConfigs) String() string { return proto.CompactTextString(m) }
+func (*RateLimitConfigs) ProtoMessage()    {}
+func (*RateLimitConfigs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{15}
+}
+
+func (m *RateLimitConfigs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RateLimitConfigs.Unmarshal(m, b)
+}
+func (m *RateLimitConfigs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RateLimitConfigs.Marshal(b, m, deterministic)
+}
+func (m *RateLimitConfigs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitConfigs.Merge(m, src)
+}
+func (m *RateLimitConfigs) XXX_Size() int {
+	return xxx_messageInfo_RateLimitConfigs.Size(m)
+}
+func (m *RateLimitConfigs) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitConfigs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitConfigs proto.InternalMessageInfo
+
+func (m *RateLimitConfigs) GetConfig() map[string]*RateLimitConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+// The window of a channel grows by one message per window of timely acks (additive increase),
+// and halves on a nack or an ack slower than the target latency (multiplicative decrease).
+// Next Tag: 5
+type SlidingWindowConfig struct {
+	// window of a channel when its peer connects, if 0 use default 8
+	InitWindow uint64 `protobuf:"varint,1,opt,name=init_window,json=initWindow,proto3" json:"init_window,omitempty"`
+	// if 0 use default 1
+	MinWindow uint64 `protobuf:"varint,2,opt,name=min_window,json=minWindow,proto3" json:"min_window,omitempty"`
+	// if 0 use default 64
+	MaxWindow uint64 `protobuf:"varint,3,opt,name=max_window,json=maxWindow,proto3" json:"max_window,omitempty"`
+	// acks slower than this shrink the window, if 0 use default 3000
+	AckLatencyTargetMs   uint64   `protobuf:"varint,4,opt,name=ack_latency_target_ms,json=ackLatencyTargetMs,proto3" json:"ack_latency_target_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SlidingWindowConfig) Reset()         { *m = SlidingWindowConfig{} }
+func (m *SlidingWindowConfig) String() string { return proto.CompactTextString(m) }
+func (*SlidingWindowConfig) ProtoMessage()    {}
+func (*SlidingWindowConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{16}
+}
+
+func (m *SlidingWindowConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SlidingWindowConfig.Unmarshal(m, b)
+}
+func (m *SlidingWindowConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SlidingWindowConfig.Marshal(b, m, deterministic)
+}
+func (m *SlidingWindowConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlidingWindowConfig.Merge(m, src)
+}
+func (m *SlidingWindowConfig) XXX_Size() int {
+	return xxx_messageInfo_SlidingWindowConfig.Size(m)
+}
+func (m *SlidingWindowConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlidingWindowConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlidingWindowConfig proto.InternalMessageInfo
+
+func (m *SlidingWindowConfig) GetInitWindow() uint64 {
+	if m != nil {
+		return m.InitWindow
+	}
+	return 0
+}
+
+func (m *SlidingWindowConfig) GetMinWindow() uint64 {
+	if m != nil {
+		return m.MinWindow
+	}
+	return 0
+}
+
+func (m *SlidingWindowConfig) GetMaxWindow() uint64 {
+	if m != nil {
+		return m.MaxWindow
+	}
+	return 0
+}
+
+func (m *SlidingWindowConfig) GetAckLatencyTargetMs() uint64 {
+	if m != nil {
+		return m.AckLatencyTargetMs
+	}
+	return 0
+}
+
 // Next Tag: 4
 type DepositConfig struct {
 	// deposit polling interval in seconds
@@ -737,7 +1278,7 @@ func (m *DepositConfig) Reset()         { *m = DepositConfig{} }
 func (m *DepositConfig) String() string { return proto.CompactTextString(m) }
 func (*DepositConfig) ProtoMessage()    {}
 func (*DepositConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3eaf2c85e69e9ea4, []int{10}
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{17}
 }
 
 func (m *DepositConfig) XXX_Unmarshal(b []byte) error {
@@ -792,7 +1333,7 @@ func (m *WaitMinedConfig) Reset()         { *m = WaitMinedConfig{} }
 func (m *WaitMinedConfig) String() string { return proto.CompactTextString(m) }
 func (*WaitMinedConfig) ProtoMessage()    {}
 func (*WaitMinedConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3eaf2c85e69e9ea4, []int{11}
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{18}
 }
 
 func (m *WaitMinedConfig) XXX_Unmarshal(b []byte) error {
@@ -837,6 +1378,7 @@ func (m *WaitMinedConfig) GetTxQueryRetryIntervalS() uint64 {
 func init() {
 	proto.RegisterType((*RuntimeConfig)(nil), "RuntimeConfig")
 	proto.RegisterMapType((map[string]string)(nil), "RuntimeConfig.Erc20ColdBootstrapDepositMapEntry")
+	proto.RegisterMapType((map[string]uint64)(nil), "RuntimeConfig.EventConfirmBlocksEntry")
 	proto.RegisterType((*Token)(nil), "Token")
 	proto.RegisterType((*TcbConfig)(nil), "TcbConfig")
 	proto.RegisterType((*TcbConfigs)(nil), "TcbConfigs")
@@ -851,6 +1393,16 @@ func init() {
 	proto.RegisterType((*RefillConfig)(nil), "RefillConfig")
 	proto.RegisterType((*RefillConfigs)(nil), "RefillConfigs")
 	proto.RegisterMapType((map[string]*RefillConfig)(nil), "RefillConfigs.ConfigEntry")
+	proto.RegisterType((*RebalanceConfig)(nil), "RebalanceConfig")
+	proto.RegisterType((*RebalanceConfigs)(nil), "RebalanceConfigs")
+	proto.RegisterMapType((map[string]*RebalanceConfig)(nil), "RebalanceConfigs.ConfigEntry")
+	proto.RegisterType((*FeeConfig)(nil), "FeeConfig")
+	proto.RegisterType((*FeeConfigs)(nil), "FeeConfigs")
+	proto.RegisterMapType((map[string]*FeeConfig)(nil), "FeeConfigs.ConfigEntry")
+	proto.RegisterType((*RateLimitConfig)(nil), "RateLimitConfig")
+	proto.RegisterType((*RateLimitConfigs)(nil), "RateLimitConfigs")
+	proto.RegisterMapType((map[string]*RateLimitConfig)(nil), "RateLimitConfigs.ConfigEntry")
+	proto.RegisterType((*SlidingWindowConfig)(nil), "SlidingWindowConfig")
 	proto.RegisterType((*DepositConfig)(nil), "DepositConfig")
 	proto.RegisterType((*WaitMinedConfig)(nil), "WaitMinedConfig")
 }
@@ -858,87 +1410,127 @@ func init() {
 func init() { proto.RegisterFile("config.proto", fileDescriptor_3eaf2c85e69e9ea4) }
 
 var fileDescriptor_3eaf2c85e69e9ea4 = []byte{
-	// 1306 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4d, 0x6f, 0xdb, 0x46,
-	0x10, 0x05, 0xfd, 0xad, 0x91, 0x64, 0xd9, 0x6b, 0x27, 0x61, 0x14, 0x03, 0x55, 0x9c, 0xa4, 0x75,
-	0x90, 0x54, 0x4e, 0x9d, 0x14, 0x08, 0x9a, 0x14, 0x68, 0x6d, 0x07, 0x69, 0x83, 0x24, 0x76, 0x29,
-	0xa3, 0x05, 0x7a, 0x59, 0xac, 0xc9, 0xb5, 0xb4, 0x30, 0xb9, 0xcb, 0x70, 0x57, 0xb6, 0x94, 0x73,
-	0x81, 0xa2, 0xe7, 0xde, 0x7a, 0x2a, 0xd0, 0x63, 0x6f, 0xfd, 0x6b, 0xfd, 0x03, 0xc5, 0x7e, 0x90,
-	0x26, 0x65, 0x25, 0x3e, 0xf4, 0x64, 0x71, 0xe6, 0xcd, 0x70, 0x38, 0x6f, 0xe6, 0xed, 0x1a, 0x1a,
-	0xa1, 0xe0, 0x27, 0xac, 0xdf, 0x4d, 0x33, 0xa1, 0xc4, 0xe6, 0x9f, 0x35, 0x68, 0x06, 0x43, 0xae,
-	0x58, 0x42, 0xf7, 0x8c, 0x1d, 0x7d, 0x06, 0x2b, 0x22, 0xa5, 0x1c, 0x87, 0x03, 0xc2, 0xf1, 0x39,
-	0x61, 0x0a, 0x4b, 0xdf, 0xeb, 0x78, 0x5b, 0xb3, 0x41, 0x53, 0xdb, 0xf7, 0x06, 0x84, 0xff, 0x44,
-	0x98, 0xea, 0xa1, 0x0e, 0x34, 0x12, 0xc6, 0x71, 0x9f, 0x48, 0xdc, 0x3f, 0xa7, 0xcc, 0x9f, 0xe9,
-	0x78, 0x5b, 0x73, 0x01, 0x24, 0x8c, 0xbf, 0x24, 0xf2, 0xe5, 0x39, 0x65, 0x06, 0x41, 0x46, 0x17,
-	0x88, 0x59, 0x87, 0x20, 0xa3, 0x12, 0x82, 0x44, 0xd1, 0x05, 0x62, 0xdd, 0x22, 0x48, 0x14, 0xe5,
-	0x88, 0x2f, 0xe0, 0x9a, 0x54, 0x19, 0x25, 0x09, 0x96, 0x94, 0x47, 0x58, 0x17, 0x2a, 0x86, 0xba,
-	0xa6, 0x39, 0x03, 0x45, 0xd6, 0xd9, 0xa3, 0x3c, 0x3a, 0xb2, 0xae, 0x1e, 0x7a, 0x06, 0x6d, 0xaa,
-	0x06, 0x38, 0x14, 0x71, 0x84, 0x8f, 0x85, 0x50, 0x52, 0x65, 0x24, 0xc5, 0x11, 0x4d, 0x85, 0x64,
-	0xca, 0x9f, 0xef, 0x78, 0x5b, 0xb5, 0xe0, 0x06, 0x55, 0x83, 0x3d, 0x11, 0x47, 0xbb, 0xb9, 0x7f,
-	0xdf, 0xba, 0xd1, 0x08, 0x3a, 0x34, 0x0b, 0x77, 0x1e, 0x7d, 0x20, 0x1c, 0x27, 0x24, 0xf5, 0x17,
-	0x3a, 0xb3, 0x5b, 0xf5, 0x9d, 0x47, 0xdd, 0x4a, 0xe3, 0xba, 0x2f, 0x74, 0xd8, 0xb4, 0x9c, 0x6f,
-	0x48, 0xfa, 0x82, 0xab, 0x6c, 0x1c, 0x6c, 0xd0, 0x8f, 0x40, 0xd0, 0x5b, 0xb8, 0xfb, 0xd1, 0x37,
-	0x47, 0xf4, 0x84, 0x0c, 0x63, 0xe5, 0x2f, 0x9a, 0x0f, 0xe8, 0x7c, 0x30, 0xd7, 0xbe, 0xc5, 0xa1,
-	0x27, 0x70, 0x5d, 0xc8, 0x52, 0xe1, 0xc3, 0x58, 0xb1, 0x34, 0x66, 0x34, 0xf3, 0x97, 0x0c, 0x9d,
-	0xeb, 0x42, 0x16, 0xaf, 0x2f, 0x7c, 0xa8, 0x0b, 0x6b, 0x9a, 0xb3, 0x88, 0xc9, 0x74, 0xa8, 0x68,
-	0xde, 0x6f, 0xbf, 0x66, 0xba, 0xbd, 0x9a, 0x90, 0xd1, 0xbe, 0xf5, 0xb8, 0x6e, 0x1b, 0x3c, 0xe3,
-	0x97, 0xf0, 0xe0, 0xf0, 0x8c, 0x4f, 0xe0, 0x6f, 0x41, 0x2d, 0x16, 0x7d, 0x1c, 0xd3, 0x33, 0x1a,
-	0xfb, 0x75, 0xf3, 0x29, 0x4b, 0xb1, 0xe8, 0xbf, 0xd6, 0xcf, 0xe8, 0x21, 0xd4, 0x55, 0x78, 0x8c,
-	0xed, 0x84, 0x4a, 0xbf, 0xd1, 0xf1, 0xb6, 0xea, 0x3b, 0xf5, 0xee, 0x51, 0x78, 0x6c, 0x7b, 0x2c,
-	0x03, 0x50, 0xc5, 0x6f, 0xf4, 0x0c, 0x56, 0xa4, 0x22, 0x3c, 0x22, 0x59, 0x54, 0x84, 0x34, 0x4d,
-	0xc8, 0x4a, 0xb7, 0xe7, 0x1c, 0x79, 0x5c, 0x4b, 0x56, 0x0d, 0xe8, 0x15, 0xdc, 0xd0, 0xdd, 0x51,
-	0x02, 0xeb, 0x3f, 0x76, 0xe2, 0x5d, 0x8e, 0x55, 0x93, 0x63, 0xbd, 0x7b, 0x20, 0xd3, 0x23, 0x71,
-	0x20, 0xd3, 0x03, 0x3d, 0xf6, 0x2e, 0xcf, 0x9a, 0xb8, 0x6c, 0xcc, 0x7b, 0x96, 0x92, 0x71, 0x42,
-	0xb9, 0x2a, 0x7a, 0xb0, 0x5c, 0xf4, 0xec, 0xd0, 0x7a, 0xf2, 0x1e, 0x6c, 0xc3, 0xba, 0xc6, 0xf3,
-	0x61, 0x82, 0x53, 0xca, 0x23, 0xc6, 0xfb, 0x3a, 0x56, 0xfa, 0xad, 0x22, 0xe0, 0xed, 0x30, 0x39,
-	0xb4, 0x9e, 0x43, 0x32, 0x96, 0xe8, 0x4b, 0x58, 0xce, 0xe8, 0x09, 0x8b, 0xe3, 0xa2, 0xc6, 0x15,
-	0x53, 0xe3, 0x72, 0x37, 0x30, 0xe6, 0xbc, 0xba, 0x66, 0x56, 0x7e, 0xd4, 0x61, 0x39, 0xfb, 0x36,
-	0xce, 0x47, 0x2e, 0xcc, 0xf1, 0x6e, 0x81, 0x41, 0x33, 0x2a, 0x3f, 0xa2, 0xe7, 0xb0, 0x6a, 0xf6,
-	0x3e, 0x61, 0x9c, 0xe6, 0x9d, 0xf5, 0xd7, 0x5c, 0x63, 0xf5, 0xee, 0xbf, 0xd1, 0x0e, 0x17, 0xdb,
-	0x3a, 0xaf, 0x1a, 0xda, 0x07, 0x70, 0xfb, 0xca, 0x4d, 0x40, 0x2b, 0x30, 0x7b, 0x4a, 0xc7, 0x46,
-	0x57, 0x6a, 0x81, 0xfe, 0x89, 0xd6, 0x61, 0xfe, 0x8c, 0xc4, 0x43, 0x6a, 0x64, 0xa4, 0x16, 0xd8,
-	0x87, 0xaf, 0x66, 0x9e, 0x7a, 0x9b, 0xcf, 0x61, 0xfe, 0x48, 0x9c, 0x52, 0x8e, 0x6e, 0xc2, 0x12,
-	0xcd, 0x42, 0xac, 0xc6, 0x29, 0x75, 0x91, 0x8b, 0x34, 0x0b, 0x8f, 0xc6, 0x29, 0x45, 0x3e, 0x2c,
-	0x92, 0x28, 0xca, 0xa8, 0x94, 0x2e, 0x3e, 0x7f, 0xdc, 0xfc, 0x75, 0x06, 0x6a, 0xc5, 0xfc, 0xa0,
-	0x0d, 0x98, 0x57, 0x3a, 0x97, 0x89, 0xaf, 0xef, 0x2c, 0x74, 0x4d, 0xe6, 0xc0, 0x1a, 0xd1, 0xa7,
-	0xd0, 0xd2, 0xbc, 0x94, 0xb6, 0xc6, 0x65, 0x6b, 0x26, 0x64, 0x74, 0x50, 0x6c, 0x0b, 0xfa, 0x1a,
-	0x6e, 0x09, 0x1e, 0x0e, 0x08, 0xe3, 0xf8, 0x98, 0xc4, 0x84, 0x87, 0x14, 0x4b, 0x72, 0x42, 0x71,
-	0x42, 0xb2, 0x3e, 0xe3, 0x46, 0xe6, 0x6a, 0x81, 0xef, 0x20, 0xbb, 0x16, 0xd1, 0x23, 0x27, 0xf4,
-	0x8d, 0xf1, 0xa3, 0x6f, 0x60, 0x23, 0xa3, 0xef, 0x86, 0x2c, 0xa3, 0x11, 0x96, 0x22, 0x64, 0x24,
-	0xc6, 0x67, 0x34, 0x63, 0x27, 0x2c, 0x24, 0x8a, 0x09, 0x6e, 0x94, 0x6d, 0x29, 0x68, 0xe7, 0x98,
-	0x9e, 0x81, 0xfc, 0x58, 0x42, 0xa0, 0xc7, 0x70, 0x5d, 0x9e, 0xb2, 0x14, 0x8b, 0x33, 0x9a, 0xe1,
-	0x50, 0x24, 0x89, 0x66, 0x78, 0x40, 0xc3, 0x53, 0xa3, 0x6e, 0x4b, 0xc1, 0x9a, 0xf6, 0x1e, 0x9c,
-	0xd1, 0x6c, 0xcf, 0xf8, 0xf6, 0xb4, 0x6b, 0xf3, 0x17, 0x0f, 0xe0, 0x62, 0x93, 0xd0, 0x36, 0x2c,
-	0x38, 0x6a, 0x3d, 0x23, 0x67, 0x37, 0x4a, 0x6b, 0xd6, 0xb5, 0x7f, 0xad, 0x6a, 0x39, 0x58, 0xfb,
-	0x05, 0xd4, 0x4b, 0xe6, 0x29, 0x14, 0x76, 0xca, 0x14, 0xd6, 0x77, 0xe0, 0x22, 0x61, 0x99, 0xce,
-	0x7f, 0x3d, 0x58, 0xae, 0x6e, 0xe7, 0x15, 0xac, 0x7c, 0x02, 0x75, 0xa3, 0x30, 0x15, 0xfd, 0xd6,
-	0xc7, 0x4c, 0x4e, 0x87, 0x06, 0x68, 0xc9, 0xaa, 0x50, 0xa6, 0x4f, 0x99, 0x1c, 0xf0, 0x10, 0x90,
-	0xcd, 0x40, 0xa2, 0x98, 0x71, 0x8a, 0x23, 0x1a, 0x2b, 0xe2, 0x4e, 0xa3, 0x15, 0x93, 0xc8, 0x3a,
-	0xf6, 0xb5, 0xdd, 0xa0, 0x4d, 0xba, 0x0a, 0x7a, 0xce, 0xa1, 0x75, 0xd6, 0x32, 0xfa, 0x1e, 0x2c,
-	0x27, 0x44, 0x85, 0x03, 0xbd, 0xc4, 0x99, 0x66, 0xc7, 0x5f, 0xe8, 0x78, 0x5b, 0x33, 0x7a, 0x64,
-	0xac, 0x35, 0xd0, 0xc6, 0xcd, 0xdf, 0x3d, 0x68, 0x4d, 0x68, 0x12, 0x7a, 0x32, 0xc1, 0xc0, 0xc6,
-	0xa4, 0x6a, 0x4d, 0xa5, 0xe1, 0xd5, 0x55, 0x34, 0xdc, 0xab, 0xd2, 0xd0, 0x9a, 0xc8, 0x5a, 0xe6,
-	0xe2, 0x1f, 0x0f, 0xd0, 0x65, 0x95, 0x43, 0xaf, 0xa0, 0x69, 0x5a, 0x2f, 0x71, 0xa5, 0xbe, 0x7b,
-	0x53, 0x14, 0xd1, 0x52, 0x25, 0xcb, 0x85, 0x36, 0x54, 0xc9, 0xd4, 0x3e, 0x84, 0xd5, 0x4b, 0x90,
-	0xff, 0x57, 0xf4, 0x5f, 0x1e, 0xac, 0x4d, 0x91, 0x66, 0xf4, 0x0c, 0x16, 0x73, 0x75, 0xb4, 0xf5,
-	0xde, 0x9e, 0xa6, 0xe0, 0xae, 0xa7, 0xd2, 0xd6, 0x9a, 0x47, 0xb4, 0x0f, 0xa0, 0x51, 0x76, 0x4c,
-	0xa9, 0xf0, 0x7e, 0xb5, 0xc2, 0xb5, 0x29, 0xc9, 0x27, 0x5a, 0xdb, 0x28, 0x8b, 0xf3, 0x15, 0x43,
-	0xbe, 0x01, 0x35, 0x35, 0xc8, 0xa8, 0x1c, 0x88, 0x38, 0x72, 0x13, 0x7c, 0x61, 0x40, 0x77, 0xc0,
-	0x29, 0x3b, 0x26, 0x89, 0x18, 0x72, 0xe5, 0x24, 0xa6, 0x61, 0x8d, 0xdf, 0x1a, 0x9b, 0x3e, 0x59,
-	0x53, 0x21, 0x62, 0x2c, 0xd9, 0x7b, 0x6a, 0xc6, 0xb5, 0x16, 0x2c, 0x69, 0x43, 0x8f, 0xbd, 0xa7,
-	0xe8, 0x2e, 0x2c, 0x1b, 0x67, 0x2c, 0xce, 0xdd, 0x98, 0xea, 0x3d, 0xf2, 0x82, 0x86, 0xb6, 0xbe,
-	0x16, 0xe7, 0x76, 0x4a, 0xff, 0xf6, 0xa0, 0x59, 0x39, 0x51, 0xd0, 0xce, 0xc4, 0x8c, 0xb6, 0xab,
-	0x27, 0xce, 0xb4, 0x09, 0x45, 0x1b, 0xa0, 0x97, 0x2f, 0xbf, 0x3b, 0xda, 0x6b, 0xe1, 0x52, 0x42,
-	0x46, 0xe6, 0xda, 0xd8, 0xfe, 0xee, 0xaa, 0xf9, 0xbd, 0x53, 0x6d, 0x74, 0xb3, 0xf2, 0xc6, 0x72,
-	0x8b, 0x7f, 0xf3, 0xa0, 0x59, 0x39, 0xc8, 0xf4, 0xea, 0xa6, 0x22, 0x8e, 0xf5, 0x2e, 0x32, 0xae,
-	0x68, 0x76, 0x46, 0x62, 0x77, 0x7b, 0x9d, 0x0b, 0x56, 0x9c, 0xe7, 0x7b, 0xe7, 0xe8, 0xe9, 0x9e,
-	0x24, 0x46, 0xc2, 0x55, 0x38, 0xb0, 0x5d, 0xb3, 0xb5, 0xea, 0x6b, 0xed, 0xae, 0x36, 0xe6, 0x9d,
-	0xd3, 0x5f, 0x53, 0x42, 0xcd, 0x3a, 0x14, 0x19, 0x15, 0xa8, 0xcd, 0x3f, 0x3c, 0x68, 0x4d, 0x1c,
-	0x8d, 0xfa, 0x72, 0xab, 0x46, 0xa5, 0x1b, 0xab, 0xad, 0x03, 0xd4, 0xa8, 0xb8, 0xa9, 0x3e, 0x00,
-	0xa4, 0x46, 0xf8, 0xdd, 0x90, 0x66, 0xe3, 0x12, 0xce, 0x56, 0xd1, 0x52, 0xa3, 0x1f, 0xb4, 0xa3,
-	0x00, 0x3f, 0x85, 0x9b, 0x05, 0x38, 0xa3, 0x2a, 0x1b, 0x97, 0xbf, 0xd1, 0xd6, 0x74, 0xcd, 0xc5,
-	0x04, 0xda, 0x5d, 0x7c, 0xe8, 0xee, 0x83, 0x9f, 0xef, 0xf7, 0x99, 0x1a, 0x0c, 0x8f, 0xbb, 0xa1,
-	0x48, 0xb6, 0x43, 0x1a, 0xd3, 0xec, 0x73, 0x4e, 0xd5, 0xb9, 0xc8, 0x4e, 0xb7, 0xfb, 0x62, 0x4f,
-	0x3f, 0x6f, 0x67, 0xca, 0xb2, 0x77, 0xbc, 0x60, 0xfe, 0x31, 0x78, 0xfc, 0x5f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x48, 0x5f, 0x2d, 0xf2, 0x28, 0x0c, 0x00, 0x00,
+	// 1946 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0x4b, 0x73, 0x1b, 0xc7,
+	0x11, 0xae, 0x25, 0x25, 0x12, 0x68, 0x12, 0x04, 0x38, 0x20, 0xc5, 0x15, 0x44, 0xc5, 0x10, 0x2d,
+	0x29, 0x74, 0x59, 0x06, 0x2d, 0xda, 0x4a, 0x39, 0x91, 0xf3, 0xe2, 0xcb, 0x8a, 0x22, 0x9a, 0xf4,
+	0x82, 0x15, 0xa7, 0x72, 0xd9, 0x1a, 0xec, 0x36, 0x80, 0x29, 0xee, 0x4b, 0xb3, 0xb3, 0x24, 0xe0,
+	0x73, 0xaa, 0x52, 0x39, 0xbb, 0x2a, 0x87, 0x5c, 0x73, 0xc8, 0x21, 0xb7, 0xfc, 0xa6, 0x5c, 0x72,
+	0xce, 0x1f, 0x48, 0xcd, 0x63, 0x17, 0x0b, 0x10, 0x12, 0x53, 0xce, 0x09, 0xd8, 0xfe, 0xbe, 0x9e,
+	0xed, 0xe9, 0xee, 0xe9, 0x9e, 0x5e, 0x58, 0xf5, 0xe2, 0xa8, 0xcf, 0x06, 0x9d, 0x84, 0xc7, 0x22,
+	0xde, 0xf9, 0xbe, 0x0e, 0x35, 0x27, 0x8b, 0x04, 0x0b, 0xf1, 0x50, 0xc9, 0xc9, 0x8f, 0xa1, 0x11,
+	0x27, 0x18, 0xb9, 0xde, 0x90, 0x46, 0xee, 0x35, 0x65, 0xc2, 0x4d, 0x6d, 0xab, 0x6d, 0xed, 0x2e,
+	0x3a, 0x35, 0x29, 0x3f, 0x1c, 0xd2, 0xe8, 0x5b, 0xca, 0x44, 0x97, 0xb4, 0x61, 0x35, 0x64, 0x91,
+	0x3b, 0xa0, 0xa9, 0x3b, 0xb8, 0x46, 0x66, 0x2f, 0xb4, 0xad, 0xdd, 0x3b, 0x0e, 0x84, 0x2c, 0xfa,
+	0x8a, 0xa6, 0x5f, 0x5d, 0x23, 0x53, 0x0c, 0x3a, 0x9a, 0x30, 0x16, 0x0d, 0x83, 0x8e, 0x4a, 0x0c,
+	0xea, 0xfb, 0x13, 0xc6, 0x86, 0x66, 0x50, 0xdf, 0xcf, 0x19, 0x1f, 0x03, 0x11, 0x23, 0xb7, 0x97,
+	0x85, 0x89, 0xcb, 0x22, 0x81, 0xfc, 0x8a, 0x06, 0x6e, 0x6a, 0x6f, 0x2a, 0x5e, 0x5d, 0x8c, 0x0e,
+	0xb2, 0x30, 0xf9, 0x8d, 0x91, 0x77, 0xc9, 0x53, 0xa8, 0xe7, 0xe4, 0x04, 0xb9, 0x87, 0x91, 0xb0,
+	0xef, 0x29, 0x66, 0x4d, 0x33, 0xcf, 0xb5, 0x90, 0xd8, 0xb0, 0x8c, 0x2c, 0x79, 0xfe, 0xe2, 0xc5,
+	0x4f, 0xed, 0xad, 0xb6, 0xb5, 0x5b, 0x71, 0xf2, 0xc7, 0xdc, 0xe4, 0x3e, 0xa2, 0x36, 0xc8, 0x2e,
+	0x4c, 0x3e, 0x41, 0x54, 0x06, 0x3d, 0x87, 0x4d, 0xc9, 0x48, 0x38, 0x8b, 0x39, 0x13, 0xe3, 0x09,
+	0xf5, 0xbe, 0xa2, 0x92, 0x90, 0x8e, 0xce, 0x0d, 0x96, 0xab, 0xfc, 0x04, 0xb6, 0xa6, 0xe8, 0xc6,
+	0x36, 0x16, 0xa0, 0xdd, 0x52, 0x4a, 0x9b, 0xc9, 0x44, 0xe3, 0xbc, 0x00, 0xc9, 0xef, 0x61, 0x03,
+	0xaf, 0x30, 0x12, 0xae, 0x0a, 0x19, 0x0f, 0xdd, 0x5e, 0x10, 0x7b, 0x97, 0xa9, 0xfd, 0xa0, 0xbd,
+	0xb8, 0xbb, 0xb2, 0xff, 0xb4, 0x33, 0x15, 0xb8, 0xce, 0xb1, 0xa4, 0x1e, 0x6a, 0xe6, 0x81, 0x22,
+	0x1e, 0x47, 0x82, 0x8f, 0x1d, 0x82, 0x37, 0x00, 0xf2, 0x0c, 0x08, 0xc7, 0x98, 0x0f, 0x5c, 0xc1,
+	0xa9, 0x77, 0x99, 0xaf, 0xbb, 0xad, 0x8c, 0x69, 0x28, 0xe4, 0x42, 0x02, 0x86, 0xfd, 0x0b, 0x58,
+	0xe7, 0xd8, 0xa3, 0x01, 0x8d, 0x3c, 0xd4, 0xb6, 0x0c, 0x52, 0xfb, 0x61, 0xdb, 0xda, 0x5d, 0xd9,
+	0x5f, 0xef, 0x38, 0x39, 0xa2, 0xcd, 0x48, 0xa5, 0xfe, 0xb4, 0x84, 0x3c, 0x83, 0x95, 0x3e, 0x4e,
+	0x34, 0x7f, 0xa4, 0x34, 0x57, 0x3a, 0x27, 0x58, 0xe8, 0x40, 0xbf, 0xf8, 0x4f, 0x7e, 0x09, 0x84,
+	0x53, 0x81, 0x6e, 0xc0, 0x42, 0x26, 0x0a, 0xa5, 0x0f, 0xf2, 0xd7, 0x51, 0x81, 0x6f, 0x24, 0x32,
+	0x79, 0xdd, 0x8c, 0x84, 0x7c, 0x02, 0xcd, 0x30, 0x1d, 0xb8, 0x6f, 0x33, 0xcc, 0xd0, 0x95, 0xb1,
+	0xf2, 0x31, 0x11, 0x43, 0xbb, 0xad, 0x77, 0x17, 0xa6, 0x83, 0x6f, 0x24, 0x72, 0x4a, 0x47, 0x47,
+	0x52, 0x4e, 0x5e, 0xc1, 0x66, 0x1a, 0x30, 0x9f, 0x45, 0x03, 0xf7, 0x9a, 0x45, 0x7e, 0x7c, 0x6d,
+	0xde, 0x69, 0x3f, 0x52, 0xaf, 0xdc, 0xe8, 0x74, 0x35, 0xfa, 0xad, 0x02, 0xf5, 0x4b, 0x9c, 0x66,
+	0x7a, 0x53, 0x28, 0x53, 0x23, 0x15, 0x1c, 0x69, 0xe8, 0xa6, 0x18, 0xf9, 0xae, 0x8c, 0x4d, 0x9c,
+	0xc9, 0xf3, 0x73, 0x47, 0xa7, 0x86, 0x06, 0xbb, 0x18, 0xf9, 0x17, 0x1a, 0xea, 0x92, 0x97, 0xd0,
+	0x42, 0x31, 0x74, 0xbd, 0x38, 0xf0, 0xdd, 0x5e, 0x1c, 0x8b, 0x54, 0x70, 0x9a, 0x48, 0x83, 0xe3,
+	0x94, 0x09, 0xfb, 0x6e, 0xdb, 0xda, 0xad, 0x3a, 0x5b, 0x28, 0x86, 0x87, 0x71, 0xe0, 0x1f, 0xe4,
+	0xf8, 0x91, 0x86, 0xc9, 0x08, 0xda, 0xc8, 0xbd, 0xfd, 0x4f, 0xdf, 0xa1, 0xee, 0x86, 0x34, 0xb1,
+	0x97, 0x54, 0xae, 0x7c, 0x3a, 0x9b, 0x2b, 0x52, 0x6d, 0xde, 0x9a, 0xa7, 0x34, 0xd1, 0x59, 0xb3,
+	0x8d, 0xef, 0xa1, 0x90, 0xaf, 0xe1, 0xf1, 0x7b, 0xdf, 0xec, 0x63, 0x9f, 0x66, 0x81, 0xb0, 0x97,
+	0xd5, 0x06, 0xda, 0xef, 0x5c, 0xeb, 0x48, 0xf3, 0xc8, 0xe7, 0x70, 0x2f, 0x4e, 0x4b, 0x86, 0x67,
+	0x81, 0x60, 0x49, 0xc0, 0x90, 0xdb, 0x15, 0x55, 0x7a, 0x36, 0xe2, 0xb4, 0x78, 0x7d, 0x81, 0x91,
+	0x0e, 0x34, 0x55, 0x78, 0x59, 0x9a, 0x64, 0x02, 0x73, 0x7f, 0xdb, 0x55, 0xe5, 0xed, 0xf5, 0x90,
+	0x8e, 0x8e, 0x34, 0x62, 0xbc, 0xad, 0xf8, 0x2c, 0xba, 0xc1, 0x07, 0xc3, 0x67, 0xd1, 0x0c, 0xff,
+	0x01, 0x54, 0x83, 0x78, 0xe0, 0x06, 0x78, 0x85, 0x81, 0xbd, 0xa2, 0xb6, 0x52, 0x09, 0xe2, 0xc1,
+	0x1b, 0xf9, 0x2c, 0x93, 0x5a, 0x78, 0xbd, 0x22, 0x3f, 0x57, 0x4d, 0x52, 0x5f, 0x78, 0xbd, 0x22,
+	0xa9, 0x45, 0xf1, 0x9f, 0xbc, 0x84, 0x46, 0x2a, 0x68, 0xe4, 0x53, 0xee, 0x17, 0x2a, 0x35, 0xa5,
+	0xd2, 0xe8, 0x74, 0x0d, 0x90, 0xeb, 0xd5, 0xd3, 0x69, 0x01, 0x79, 0x0d, 0x5b, 0xd2, 0x3b, 0x22,
+	0x76, 0xe5, 0x8f, 0xae, 0xce, 0x66, 0x8d, 0x75, 0x93, 0xa3, 0x67, 0x69, 0x72, 0x11, 0x9f, 0xa5,
+	0xc9, 0x99, 0x2c, 0xd1, 0x66, 0x9d, 0x66, 0x7c, 0x53, 0x98, 0xfb, 0x2c, 0xa1, 0xe3, 0x50, 0x56,
+	0x96, 0xdc, 0x07, 0x6b, 0x85, 0xcf, 0xce, 0x35, 0x92, 0xfb, 0x60, 0x0f, 0x36, 0x24, 0x3f, 0xca,
+	0x42, 0x37, 0xc1, 0x48, 0x9d, 0x92, 0x84, 0x8e, 0x53, 0xbb, 0x5e, 0x28, 0x7c, 0x9d, 0x85, 0xe7,
+	0x1a, 0x39, 0xa7, 0xe3, 0x94, 0xbc, 0x80, 0x35, 0x8e, 0x7d, 0x16, 0x04, 0x85, 0x8d, 0x0d, 0x65,
+	0xe3, 0x5a, 0xc7, 0x51, 0xe2, 0xdc, 0xba, 0x1a, 0x2f, 0x3f, 0x4a, 0xb5, 0x3c, 0xfa, 0xe6, 0xf8,
+	0x11, 0xa3, 0x66, 0xe2, 0x6e, 0x0e, 0x5e, 0xcd, 0x2f, 0x3f, 0x92, 0x2f, 0x61, 0x5d, 0xf5, 0xa8,
+	0x90, 0x45, 0x98, 0x7b, 0xd6, 0x6e, 0x1a, 0xc7, 0xca, 0x3e, 0x75, 0x2a, 0x01, 0xa3, 0x5b, 0xbf,
+	0x9e, 0x16, 0xb4, 0x8e, 0x61, 0xeb, 0x1d, 0x55, 0x93, 0x34, 0x60, 0xf1, 0x12, 0xc7, 0xaa, 0xf3,
+	0x55, 0x1d, 0xf9, 0x97, 0x6c, 0xc0, 0xdd, 0x2b, 0x1a, 0x64, 0x68, 0x1a, 0x9d, 0x7e, 0xf8, 0xd9,
+	0xc2, 0x17, 0x56, 0xeb, 0x0c, 0x1e, 0xdd, 0x7a, 0xa0, 0x6e, 0x5b, 0xb0, 0x5a, 0x5a, 0x70, 0xe7,
+	0x4b, 0xb8, 0x7b, 0x11, 0x5f, 0x62, 0x44, 0xee, 0x43, 0x05, 0xb9, 0xe7, 0x8a, 0x71, 0x82, 0x46,
+	0x73, 0x19, 0xb9, 0x77, 0x31, 0x4e, 0x50, 0xf6, 0x30, 0xea, 0xfb, 0x1c, 0xd3, 0xd4, 0xe8, 0xe7,
+	0x8f, 0x3b, 0x7f, 0x5a, 0x80, 0x6a, 0x91, 0x86, 0x64, 0x1b, 0xee, 0x0a, 0xb9, 0x96, 0xd2, 0x5f,
+	0xd9, 0x5f, 0xea, 0xa8, 0x95, 0x1d, 0x2d, 0x94, 0x1d, 0x53, 0x86, 0xb7, 0x74, 0xf8, 0xcc, 0x6a,
+	0xb5, 0x90, 0x8e, 0xce, 0x8a, 0x43, 0x47, 0x7e, 0x0e, 0x0f, 0xe2, 0xc8, 0x1b, 0x52, 0x16, 0xb9,
+	0x79, 0x23, 0x48, 0x69, 0x5f, 0x96, 0x57, 0x3e, 0x60, 0x91, 0xea, 0xec, 0x55, 0xc7, 0x36, 0x94,
+	0x03, 0xcd, 0xe8, 0xd2, 0x3e, 0x9e, 0x2a, 0x9c, 0xfc, 0x0a, 0xb6, 0x39, 0xbe, 0xcd, 0x18, 0x47,
+	0xdf, 0x4d, 0x63, 0x8f, 0xd1, 0xc0, 0xbd, 0x42, 0xce, 0xfa, 0xcc, 0xa3, 0x82, 0xc5, 0x91, 0x2a,
+	0x90, 0x15, 0xa7, 0x95, 0x73, 0xba, 0x8a, 0xf2, 0xbb, 0x12, 0x83, 0x7c, 0x06, 0xf7, 0xd2, 0x4b,
+	0x96, 0xb8, 0xf1, 0x15, 0x72, 0xd7, 0x8b, 0x43, 0xd5, 0x1b, 0x86, 0xe8, 0x5d, 0xaa, 0x22, 0x59,
+	0x71, 0x9a, 0x12, 0x3d, 0xbb, 0x42, 0x7e, 0xa8, 0xb0, 0x43, 0x09, 0xed, 0xfc, 0xd1, 0x02, 0x98,
+	0x1c, 0x48, 0xb2, 0x07, 0x4b, 0x26, 0x43, 0x2c, 0x55, 0x15, 0xb7, 0x4a, 0xa7, 0xb5, 0xa3, 0x7f,
+	0x75, 0xf1, 0x33, 0xb4, 0xd6, 0x31, 0xac, 0x94, 0xc4, 0x73, 0x42, 0xd8, 0x2e, 0x87, 0x70, 0x65,
+	0x1f, 0x26, 0x0b, 0x96, 0xc3, 0xf9, 0x1f, 0x0b, 0xd6, 0xa6, 0x0f, 0xf9, 0x2d, 0x51, 0xf9, 0x00,
+	0x56, 0x54, 0xa1, 0x9a, 0x6a, 0x03, 0xf2, 0x66, 0x95, 0x87, 0x43, 0x12, 0x74, 0x63, 0x2b, 0x85,
+	0x4c, 0xde, 0x52, 0x72, 0xc2, 0x33, 0x20, 0x7a, 0x05, 0xea, 0x07, 0x2c, 0x42, 0xd7, 0xc7, 0x40,
+	0x50, 0x73, 0x01, 0x6b, 0xa8, 0x85, 0x34, 0x70, 0x24, 0xe5, 0x8a, 0xad, 0x96, 0x9b, 0x62, 0xdf,
+	0x31, 0x6c, 0xb9, 0x6a, 0x99, 0xfd, 0x04, 0xd6, 0x42, 0x2a, 0xbc, 0xa1, 0xac, 0x05, 0x5c, 0x46,
+	0xc7, 0x5e, 0x6a, 0x5b, 0xbb, 0x0b, 0x4e, 0x2d, 0x97, 0x3a, 0x52, 0xb8, 0xf3, 0xbd, 0x05, 0xf5,
+	0x99, 0xd2, 0x46, 0x3e, 0x9f, 0x89, 0xc0, 0xf6, 0x6c, 0xf1, 0x9b, 0x1b, 0x86, 0xd7, 0xb7, 0x85,
+	0xe1, 0xc9, 0x74, 0x18, 0xea, 0x33, 0xab, 0x96, 0x63, 0xf1, 0x4f, 0x0b, 0xc8, 0xcd, 0x62, 0x49,
+	0x5e, 0x43, 0x4d, 0xb9, 0x3e, 0x75, 0xa7, 0xec, 0x7b, 0x32, 0xa7, 0xb0, 0xea, 0x50, 0xa5, 0x65,
+	0x43, 0x57, 0x45, 0x49, 0xd4, 0x3a, 0x87, 0xf5, 0x1b, 0x94, 0xff, 0xcf, 0xe8, 0xbf, 0x59, 0xd0,
+	0x9c, 0x53, 0xe1, 0xc9, 0x4b, 0x58, 0xce, 0x8b, 0xac, 0xb6, 0xf7, 0xd1, 0xbc, 0x46, 0x60, 0x7c,
+	0x6a, 0xae, 0x83, 0xb9, 0x46, 0xeb, 0x0c, 0x56, 0xcb, 0xc0, 0x1c, 0x0b, 0x3f, 0x9a, 0xb6, 0xb0,
+	0x39, 0x67, 0xf1, 0x19, 0xd7, 0xae, 0x96, 0x6b, 0xfc, 0x2d, 0x49, 0xbe, 0x0d, 0x55, 0x31, 0xe4,
+	0x98, 0x0e, 0xe3, 0xc0, 0x37, 0x19, 0x3c, 0x11, 0x90, 0x0f, 0xc1, 0x34, 0x08, 0x97, 0x86, 0x71,
+	0x16, 0x09, 0x53, 0x62, 0x56, 0xb5, 0xf0, 0xd7, 0x4a, 0x26, 0x1b, 0x74, 0x12, 0xc7, 0x81, 0x9b,
+	0xb2, 0xef, 0x50, 0xa5, 0x6b, 0xd5, 0xa9, 0x48, 0x41, 0x97, 0x7d, 0x87, 0xe4, 0x31, 0xac, 0x29,
+	0x30, 0x88, 0xaf, 0x4d, 0x9a, 0xca, 0x73, 0x64, 0x39, 0xab, 0x52, 0xfa, 0x26, 0xbe, 0xd6, 0x59,
+	0xfa, 0x0f, 0x0b, 0x6a, 0x53, 0x8d, 0x89, 0xec, 0xcf, 0xe4, 0x68, 0x6b, 0xba, 0x71, 0xcd, 0xcb,
+	0x50, 0xb2, 0x0d, 0xf2, 0xf0, 0xe5, 0xe3, 0x92, 0x6e, 0x10, 0x95, 0x90, 0x8e, 0xd4, 0xa4, 0xd4,
+	0x7a, 0x75, 0x5b, 0xfe, 0x7e, 0x38, 0xed, 0xe8, 0xda, 0xd4, 0x1b, 0xcb, 0x2e, 0xfe, 0x97, 0x05,
+	0xf5, 0x99, 0x0b, 0xf7, 0x2d, 0x5e, 0x56, 0x77, 0x98, 0xdc, 0x01, 0x0b, 0xca, 0x01, 0x95, 0xc0,
+	0x6c, 0x9e, 0x3c, 0x04, 0x18, 0xb2, 0xc1, 0xd0, 0xa0, 0x8b, 0x0a, 0xad, 0x4a, 0x49, 0x01, 0xcb,
+	0x5d, 0x99, 0x00, 0x68, 0xff, 0x56, 0x43, 0x3a, 0x32, 0xde, 0xef, 0x40, 0xd3, 0xa7, 0x2c, 0x18,
+	0x1b, 0x82, 0xdb, 0xcb, 0xfc, 0x01, 0xe6, 0xd5, 0x6a, 0x5d, 0x41, 0x9a, 0x79, 0xa0, 0x00, 0xb2,
+	0x0b, 0x0d, 0xcd, 0x97, 0xc3, 0x80, 0x21, 0x2f, 0x29, 0xf2, 0x9a, 0x92, 0x9f, 0x20, 0x6a, 0xe6,
+	0xce, 0xbf, 0x2d, 0x68, 0xcc, 0xce, 0x15, 0xe4, 0xc5, 0x4c, 0x5c, 0x1e, 0xde, 0x18, 0x3d, 0xe6,
+	0x86, 0xe6, 0x21, 0x40, 0x69, 0x70, 0xd4, 0xa1, 0xa9, 0xb2, 0x62, 0x64, 0xbc, 0x0f, 0x32, 0x4e,
+	0xee, 0x30, 0x4e, 0x52, 0x53, 0x1e, 0x97, 0x43, 0x3a, 0x7a, 0x15, 0x27, 0x29, 0xd9, 0x82, 0x65,
+	0x9f, 0x8f, 0x5d, 0x9e, 0xe5, 0xfd, 0x69, 0xc9, 0xe7, 0x63, 0x27, 0x8b, 0x5a, 0xbf, 0xbd, 0x2d,
+	0x9e, 0x4f, 0xa7, 0xe3, 0xd9, 0x98, 0xb5, 0xb4, 0x1c, 0xd2, 0x3e, 0x54, 0x4f, 0xf0, 0x7f, 0x8b,
+	0xe5, 0x7d, 0xa8, 0xf4, 0x68, 0x8a, 0xd2, 0x7f, 0x79, 0xcf, 0x97, 0xcf, 0x27, 0x88, 0x72, 0x6e,
+	0x95, 0x5e, 0x55, 0x83, 0x53, 0x92, 0x84, 0xf9, 0xa8, 0xdd, 0x47, 0x94, 0x03, 0xd3, 0x79, 0x12,
+	0xaa, 0x5e, 0x78, 0x82, 0xf8, 0xee, 0x5e, 0x78, 0x82, 0xef, 0xf3, 0xe3, 0x0f, 0xe8, 0x85, 0x27,
+	0x38, 0x67, 0xbb, 0xc7, 0x50, 0x9f, 0x19, 0xe1, 0xe4, 0xe1, 0xd1, 0x76, 0x23, 0x37, 0xdf, 0x1a,
+	0x2c, 0xa7, 0x22, 0x25, 0xe7, 0xc8, 0xbb, 0xf2, 0x96, 0xd4, 0xcb, 0x78, 0x2a, 0xf2, 0x6b, 0x97,
+	0x7a, 0xd8, 0xf9, 0x8b, 0xcc, 0x90, 0xd9, 0xc1, 0x6f, 0x4e, 0x86, 0xcc, 0x50, 0xe6, 0xee, 0xec,
+	0x07, 0x84, 0x73, 0x7a, 0xd9, 0xf2, 0xfe, 0xfe, 0x6e, 0x41, 0x73, 0xce, 0xc0, 0x28, 0x3b, 0x36,
+	0x8b, 0x98, 0x30, 0x23, 0xa6, 0x5a, 0xfd, 0x8e, 0x03, 0x52, 0xa4, 0x69, 0xea, 0xb0, 0xb1, 0x28,
+	0xc7, 0x4d, 0x9e, 0x86, 0x2c, 0x2a, 0xc1, 0xb2, 0xc2, 0x68, 0x78, 0xd1, 0xc0, 0x74, 0x64, 0xe0,
+	0xe7, 0xb0, 0x29, 0x07, 0xf9, 0x80, 0x0a, 0x8c, 0xbc, 0xb1, 0x2b, 0x28, 0x1f, 0xa0, 0x70, 0xc3,
+	0x62, 0xf4, 0xa4, 0xde, 0xe5, 0x1b, 0x8d, 0x5d, 0x28, 0xe8, 0x34, 0xdd, 0xf9, 0xb3, 0x05, 0xb5,
+	0xa9, 0xbb, 0xb5, 0xbc, 0x06, 0x24, 0x71, 0x10, 0xc8, 0xbe, 0x5e, 0x3a, 0x32, 0xda, 0xd4, 0x86,
+	0x41, 0x26, 0x1f, 0x5b, 0x1e, 0xc3, 0x5a, 0xa8, 0xae, 0x83, 0xc2, 0x1b, 0xea, 0x0a, 0xac, 0x8d,
+	0x96, 0x5f, 0x85, 0x0e, 0xa4, 0x30, 0xaf, 0xc2, 0xd2, 0xee, 0x12, 0x6b, 0xd1, 0xb0, 0xe8, 0xa8,
+	0x60, 0xed, 0xfc, 0xd5, 0x82, 0xfa, 0xcc, 0x6d, 0x5d, 0xa6, 0xb4, 0x18, 0x95, 0x86, 0x68, 0xe3,
+	0x32, 0x31, 0x2a, 0x86, 0x67, 0xfd, 0x6d, 0xe8, 0x6d, 0x86, 0x7c, 0x5c, 0xe2, 0x2d, 0xe4, 0xdf,
+	0x86, 0xbe, 0x91, 0x40, 0x41, 0xfe, 0x02, 0xee, 0x17, 0x64, 0x8e, 0x82, 0x8f, 0xcb, 0x7b, 0xd4,
+	0x36, 0x6d, 0x1a, 0x1d, 0x47, 0xc2, 0xc5, 0x46, 0x0f, 0x3e, 0xfe, 0xc3, 0x47, 0x03, 0x26, 0x86,
+	0x59, 0xaf, 0xe3, 0xc5, 0xe1, 0x9e, 0x87, 0x01, 0xf2, 0x4f, 0x22, 0x14, 0xd7, 0x31, 0xbf, 0xdc,
+	0x1b, 0xc4, 0x87, 0xf2, 0x79, 0x8f, 0x0b, 0x9d, 0x4c, 0xbd, 0x25, 0xf5, 0x5d, 0xed, 0xb3, 0xff,
+	0x0e, 0x00, 0x36, 0xb1, 0x86, 0x8b, 0x67, 0x13, 0x00, 0x00,
 }
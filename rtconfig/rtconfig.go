@@ -38,6 +38,18 @@ const (
 	defaultDepositPollingInterval = uint64(10)
 	defaultDepositMinBatchSize    = uint64(10)
 	defaultDepositMaxBatchSize    = uint64(30) // upper bound is around 60 limited by gas
+	defaultTxBumpIntervalS        = uint64(180)
+	defaultTxBumpPercent          = uint64(20)
+	minTxBumpPercent              = uint64(10) // geth rejects replacements with less than 10% bump
+	defaultMaxPriorityFeeGwei     = uint64(2)
+	defaultPriorityFeePercentile  = uint64(50)
+	defaultReorgTrackBlocks       = uint64(200)
+	defaultRebalanceMaxHops       = uint64(4)
+	defaultMsgQueueMaxDepth       = uint64(1000)
+	defaultInitWindow             = uint64(8)
+	defaultMinWindow              = uint64(1)
+	defaultMaxWindow              = uint64(64)
+	defaultAckLatencyTargetMs     = uint64(3000)
 )
 
 // Init parse the json config file at path and start a goroutine to reload upon syscall.SIGHUP
@@ -121,6 +133,89 @@ func GetAddGasGwei() uint64 {
 	return rtc.AddGasGwei
 }
 
+// GetTxBumpIntervalSecond returns tx_bump_interval_s
+// If not set in rtconfig, returns 180.
+func GetTxBumpIntervalSecond() uint64 {
+	lock.RLock()
+	defer lock.RUnlock()
+	if rtc.TxBumpIntervalS == 0 {
+		return defaultTxBumpIntervalS
+	}
+	return rtc.TxBumpIntervalS
+}
+
+// GetTxBumpPercent returns tx_bump_percent
+// If not set in rtconfig, returns 20. Values below 10 are raised to 10.
+func GetTxBumpPercent() uint64 {
+	lock.RLock()
+	defer lock.RUnlock()
+	if rtc.TxBumpPercent == 0 {
+		return defaultTxBumpPercent
+	}
+	if rtc.TxBumpPercent < minTxBumpPercent {
+		return minTxBumpPercent
+	}
+	return rtc.TxBumpPercent
+}
+
+// GetEip1559 returns eip1559
+func GetEip1559() bool {
+	lock.RLock()
+	defer lock.RUnlock()
+	return rtc.Eip1559
+}
+
+// GetMaxFeeGwei returns max_fee_gwei
+func GetMaxFeeGwei() uint64 {
+	lock.RLock()
+	defer lock.RUnlock()
+	return rtc.MaxFeeGwei
+}
+
+// GetMaxPriorityFeeGwei returns max_priority_fee_gwei
+// If not set in rtconfig, returns 2.
+func GetMaxPriorityFeeGwei() uint64 {
+	lock.RLock()
+	defer lock.RUnlock()
+	if rtc.MaxPriorityFeeGwei == 0 {
+		return defaultMaxPriorityFeeGwei
+	}
+	return rtc.MaxPriorityFeeGwei
+}
+
+// GetPriorityFeePercentile returns priority_fee_percentile
+// If not set in rtconfig, returns 50. Values above 100 are lowered to 100.
+func GetPriorityFeePercentile() uint64 {
+	lock.RLock()
+	defer lock.RUnlock()
+	if rtc.PriorityFeePercentile == 0 {
+		return defaultPriorityFeePercentile
+	}
+	if rtc.PriorityFeePercentile > 100 {
+		return 100
+	}
+	return rtc.PriorityFeePercentile
+}
+
+// GetEventConfirmBlocks returns the confirmation blocks of the event in event_confirm_blocks
+// If not set in rtconfig, returns 0, which means using the profile block delay.
+func GetEventConfirmBlocks(eventName string) uint64 {
+	lock.RLock()
+	defer lock.RUnlock()
+	return rtc.GetEventConfirmBlocks()[eventName]
+}
+
+// GetReorgTrackBlocks returns reorg_track_blocks
+// If not set in rtconfig, returns 200.
+func GetReorgTrackBlocks() uint64 {
+	lock.RLock()
+	defer lock.RUnlock()
+	if rtc.ReorgTrackBlocks == 0 {
+		return defaultReorgTrackBlocks
+	}
+	return rtc.ReorgTrackBlocks
+}
+
 // GetOspDepositMultiplier returns osp_deposit_multiplier
 // If not set in rtconfig, returns 10.
 func GetOspDepositMultiplier() int64 {
@@ -142,6 +237,46 @@ func GetStreamSendTimeoutSecond() uint64 {
 	return rtc.StreamSendTimeoutS
 }
 
+// GetMsgQueueMaxDepth returns msg_queue_max_depth
+func GetMsgQueueMaxDepth() uint64 {
+	lock.RLock()
+	defer lock.RUnlock()
+	if rtc.MsgQueueMaxDepth == 0 {
+		return defaultMsgQueueMaxDepth
+	}
+	return rtc.MsgQueueMaxDepth
+}
+
+// GetSlidingWindow returns the init, min and max window sizes and the ack latency target
+// of sliding_window_config, the init window is bounded by the min and max.
+func GetSlidingWindow() (uint64, uint64, uint64, time.Duration) {
+	lock.RLock()
+	defer lock.RUnlock()
+	cfg := rtc.GetSlidingWindowConfig()
+	initWin, minWin, maxWin, target := cfg.GetInitWindow(), cfg.GetMinWindow(), cfg.GetMaxWindow(), cfg.GetAckLatencyTargetMs()
+	if initWin == 0 {
+		initWin = defaultInitWindow
+	}
+	if minWin == 0 {
+		minWin = defaultMinWindow
+	}
+	if maxWin == 0 {
+		maxWin = defaultMaxWindow
+	}
+	if maxWin < minWin {
+		maxWin = minWin
+	}
+	if initWin < minWin {
+		initWin = minWin
+	} else if initWin > maxWin {
+		initWin = maxWin
+	}
+	if target == 0 {
+		target = defaultAckLatencyTargetMs
+	}
+	return initWin, minWin, maxWin, time.Duration(target) * time.Millisecond
+}
+
 // GetMaxDisputeTimeout returns max_dispute_timeout
 func GetMaxDisputeTimeout() uint64 {
 	lock.RLock()
@@ -291,6 +426,111 @@ func GetRefillPoolThreshold(tokenAddr string) *big.Int {
 	return threshold
 }
 
+func GetRebalanceConfigs() *RebalanceConfigs {
+	lock.RLock()
+	defer lock.RUnlock()
+	return rtc.RebalanceConfigs
+}
+
+// GetRebalanceMaxHops returns rebalance_configs.max_hops
+// If not set in rtconfig, returns 4.
+func GetRebalanceMaxHops() uint64 {
+	maxHops := GetRebalanceConfigs().GetMaxHops()
+	if maxHops == 0 {
+		return defaultRebalanceMaxHops
+	}
+	return maxHops
+}
+
+// GetRebalanceAmountLimits returns the max amount of one rebalance pay and the daily budget of the token.
+// Both are 0 if the token is not configured for rebalance.
+func GetRebalanceAmountLimits(tokenAddr string) (*big.Int, *big.Int) {
+	rebalanceConfig, ok := GetRebalanceConfigs().GetConfig()[tokenAddr]
+	if !ok {
+		return big.NewInt(0), big.NewInt(0)
+	}
+	maxAmount, success := new(big.Int).SetString(rebalanceConfig.GetMaxAmount(), 10)
+	if !success {
+		log.Errorln("Can't parse rebalance max amount in decimal", rebalanceConfig.GetMaxAmount())
+		return big.NewInt(0), big.NewInt(0)
+	}
+	dailyBudget, success := new(big.Int).SetString(rebalanceConfig.GetDailyAmountBudget(), 10)
+	if !success {
+		log.Errorln("Can't parse rebalance daily budget in decimal", rebalanceConfig.GetDailyAmountBudget())
+		return big.NewInt(0), big.NewInt(0)
+	}
+	return maxAmount, dailyBudget
+}
+
+// GetRebalanceFeeBudget returns the daily budget of relay fees paid for rebalance pays of the token.
+// Returns 0 if the token is not configured for rebalance or the fee budget is not set.
+func GetRebalanceFeeBudget(tokenAddr string) *big.Int {
+	rebalanceConfig, ok := GetRebalanceConfigs().GetConfig()[tokenAddr]
+	if !ok || rebalanceConfig.GetDailyFeeBudget() == "" {
+		return big.NewInt(0)
+	}
+	feeBudget, success := new(big.Int).SetString(rebalanceConfig.GetDailyFeeBudget(), 10)
+	if !success {
+		log.Errorln("Can't parse rebalance daily fee budget in decimal", rebalanceConfig.GetDailyFeeBudget())
+		return big.NewInt(0)
+	}
+	return feeBudget
+}
+
+func GetFeeConfigs() *FeeConfigs {
+	lock.RLock()
+	defer lock.RUnlock()
+	return rtc.FeeConfigs
+}
+
+// GetFeePolicy returns the base fee and the proportional fee rate in ppm of forwarding pays of the token.
+// Both are 0 if the token is not configured.
+func GetFeePolicy(tokenAddr string) (*big.Int, uint64) {
+	feeConfig, ok := GetFeeConfigs().GetConfig()[tokenAddr]
+	if !ok {
+		return big.NewInt(0), 0
+	}
+	baseFee := big.NewInt(0)
+	if feeConfig.GetBaseFee() != "" {
+		var success bool
+		baseFee, success = new(big.Int).SetString(feeConfig.GetBaseFee(), 10)
+		if !success {
+			log.Errorln("Can't parse base fee in decimal", feeConfig.GetBaseFee())
+			return big.NewInt(0), 0
+		}
+	}
+	return baseFee, feeConfig.GetFeeRatePpm()
+}
+
+// GetForwardFee returns the fee of forwarding a pay of amt on the token
+func GetForwardFee(tokenAddr string, amt *big.Int) *big.Int {
+	baseFee, ratePpm := GetFeePolicy(tokenAddr)
+	return ComputeFee(baseFee, ratePpm, amt)
+}
+
+// ComputeFee returns baseFee + amt * ratePpm / 1e6
+func ComputeFee(baseFee *big.Int, ratePpm uint64, amt *big.Int) *big.Int {
+	fee := new(big.Int).Mul(amt, new(big.Int).SetUint64(ratePpm))
+	fee.Quo(fee, big.NewInt(1e6))
+	return fee.Add(fee, baseFee)
+}
+
+func GetRateLimitConfigs() *RateLimitConfigs {
+	lock.RLock()
+	defer lock.RUnlock()
+	return rtc.RateLimitConfigs
+}
+
+// GetRateLimit returns the rate per second and burst of a message or rpc name from each peer.
+// Rate is 0 (no limit) if the name is not configured.
+func GetRateLimit(name string) (float64, int) {
+	rateLimitConfig, ok := GetRateLimitConfigs().GetConfig()[name]
+	if !ok {
+		return 0, 0
+	}
+	return rateLimitConfig.GetRatePerS(), int(rateLimitConfig.GetBurst())
+}
+
 func GetDepositPollingInterval() uint64 {
 	lock.RLock()
 	defer lock.RUnlock()
@@ -3,6 +3,7 @@
 package rtconfig
 
 import (
+	"math/big"
 	"os"
 	"syscall"
 	"testing"
@@ -18,6 +19,35 @@ func TestLoadFromFile(t *testing.T) {
 	if ocw != 10 {
 		t.Error("mismatch open_chan_wait_s: ", ocw, " expect: ", 10)
 	}
+	maxAmount, dailyBudget := GetRebalanceAmountLimits("0000000000000000000000000000000000000000")
+	chkEq(maxAmount.String(), "1000000000000000000", t)
+	chkEq(dailyBudget.String(), "10000000000000000000", t)
+	maxAmount, _ = GetRebalanceAmountLimits("1111111111111111111111111111111111111111")
+	chkEq(maxAmount.String(), "0", t)
+	if GetRebalanceMaxHops() != defaultRebalanceMaxHops {
+		t.Error("mismatch rebalance max_hops: ", GetRebalanceMaxHops())
+	}
+	chkEq(GetRebalanceFeeBudget("0000000000000000000000000000000000000000").String(), "5000000000000000", t)
+	chkEq(GetRebalanceFeeBudget("1111111111111111111111111111111111111111").String(), "0", t)
+	fee := GetForwardFee("0000000000000000000000000000000000000000", big.NewInt(2000000000000000000))
+	chkEq(fee.String(), "3000000000000000", t)
+	fee = GetForwardFee("1111111111111111111111111111111111111111", big.NewInt(2000000000000000000))
+	chkEq(fee.String(), "0", t)
+	rate, burst := GetRateLimit("CondPayRequestMessage")
+	if rate != 10 || burst != 20 {
+		t.Error("mismatch CondPayRequestMessage rate limit: ", rate, burst)
+	}
+	rate, _ = GetRateLimit("RoutingRequestMessage")
+	if rate != 0 {
+		t.Error("mismatch RoutingRequestMessage rate limit: ", rate)
+	}
+	if GetMsgQueueMaxDepth() != 500 {
+		t.Error("mismatch msg_queue_max_depth: ", GetMsgQueueMaxDepth())
+	}
+	initWin, minWin, maxWin, target := GetSlidingWindow()
+	if initWin != 32 || minWin != 2 || maxWin != 32 || target != 3*time.Second {
+		t.Error("mismatch sliding window: ", initWin, minWin, maxWin, target)
+	}
 }
 
 func TestInitAndSignal(t *testing.T) {
@@ -0,0 +1,206 @@
+// Copyright 2020 Celer Network
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/celer-network/goCeler/rpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testViewCid   = "00000000000000000000000000000000000000000000000000000000000000c1"
+	testViewPayID = "00000000000000000000000000000000000000000000000000000000000000a1"
+)
+
+// testViewAdmin serves canned channel and pay views, and records the last list request
+type testViewAdmin struct {
+	rpc.UnimplementedAdminServer
+	listReq     *rpc.ListChannelsRequest
+	listPaysReq *rpc.ListChannelPaysRequest
+}
+
+func (s *testViewAdmin) ListChannels(ctx context.Context, in *rpc.ListChannelsRequest) (*rpc.ListChannelsResponse, error) {
+	if in.GetPeer() != "" || in.GetToken() != "" {
+		// filters of the test requests are invalid, adminService rejects them before reaching cNode
+		return (&adminService{}).ListChannels(ctx, in)
+	}
+	s.listReq = in
+	return &rpc.ListChannelsResponse{
+		Channels: []*rpc.ChannelInfo{{Cid: testViewCid, State: "CHANNEL_OPENED", OpenTs: 1000}},
+	}, nil
+}
+
+func (s *testViewAdmin) GetChannelDetail(ctx context.Context, in *rpc.ChannelDetailRequest) (*rpc.ChannelDetailResponse, error) {
+	if in.GetCid() != testViewCid {
+		return nil, status.Error(codes.NotFound, "channel not found")
+	}
+	return &rpc.ChannelDetailResponse{
+		Channel:      &rpc.ChannelInfo{Cid: testViewCid},
+		Balance:      &rpc.ChannelBalanceInfo{Cid: testViewCid, MyFreeWei: "10"},
+		MyDepositWei: "10",
+		PendingPays:  2,
+	}, nil
+}
+
+func (s *testViewAdmin) ListChannelPays(ctx context.Context, in *rpc.ListChannelPaysRequest) (*rpc.ListChannelPaysResponse, error) {
+	if in.GetCid() != testViewCid {
+		return nil, status.Error(codes.Unavailable, "db error")
+	}
+	s.listPaysReq = in
+	return &rpc.ListChannelPaysResponse{Pays: []*rpc.PaymentInfo{{PayId: testViewPayID}}, Total: 3}, nil
+}
+
+func (s *testViewAdmin) GetPayment(ctx context.Context, in *rpc.GetPaymentRequest) (*rpc.GetPaymentResponse, error) {
+	if in.GetPayId() != testViewPayID {
+		return nil, status.Error(codes.NotFound, "pay not found")
+	}
+	return &rpc.GetPaymentResponse{Payment: &rpc.PaymentInfo{PayId: testViewPayID, AmtWei: "5", ResolveDeadline: 200}}, nil
+}
+
+// newTestAdminGateway serves the admin HTTP gateway the same way as setUpAdminService
+func newTestAdminGateway(t *testing.T, adminS rpc.AdminServer) *httptest.Server {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := grpc.NewServer()
+	rpc.RegisterAdminServer(s, adminS)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	gwmux := runtime.NewServeMux()
+	err = rpc.RegisterAdminHandlerFromEndpoint(ctx, gwmux, lis.Addr().String(), []grpc.DialOption{grpc.WithInsecure()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/admin/", gwmux)
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestAdminViewHTTP(t *testing.T) {
+	adminS := &testViewAdmin{}
+	ts := newTestAdminGateway(t, adminS)
+
+	testCases := []struct {
+		name string
+		path string
+		code int
+		keys []string // top level keys of the response body
+	}{
+		{"list channels", "/admin/channels?state=3&offset=2&limit=5", http.StatusOK, []string{"channels"}},
+		{"list channels bad state", "/admin/channels?state=abc", http.StatusBadRequest, []string{"error", "code", "message"}},
+		{"list channels bad limit", "/admin/channels?limit=-1", http.StatusBadRequest, []string{"error", "code", "message"}},
+		{"list channels bad peer", "/admin/channels?peer=xyz", http.StatusBadRequest, []string{"error", "code", "message"}},
+		{"list channels bad token", "/admin/channels?token=0x12", http.StatusBadRequest, []string{"error", "code", "message"}},
+		{"channel detail", "/admin/channels/" + testViewCid, http.StatusOK,
+			[]string{"channel", "balance", "my_deposit_wei", "pending_pays"}},
+		{"unknown channel detail", "/admin/channels/c2", http.StatusNotFound, []string{"error", "code", "message"}},
+		{"channel pays", "/admin/channels/" + testViewCid + "/pays?offset=1&limit=1", http.StatusOK, []string{"pays", "total"}},
+		{"channel pays unavailable", "/admin/channels/c2/pays", http.StatusServiceUnavailable, []string{"error", "code", "message"}},
+		{"payment", "/admin/pays/" + testViewPayID, http.StatusOK, []string{"payment"}},
+		{"unknown payment", "/admin/pays/a2", http.StatusNotFound, []string{"error", "code", "message"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := http.Get(ts.URL + tc.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != tc.code {
+				t.Errorf("status code %d, expected %d", resp.StatusCode, tc.code)
+			}
+			if ct := resp.Header.Get("Content-Type"); ct != "application/json" {
+				t.Errorf("content type %s", ct)
+			}
+			var body map[string]json.RawMessage
+			err = json.NewDecoder(resp.Body).Decode(&body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, key := range tc.keys {
+				if _, ok := body[key]; !ok {
+					t.Errorf("key %s missing in %v", key, body)
+				}
+			}
+		})
+	}
+
+	// query params reach the rpc
+	if adminS.listReq.GetState() != 3 || adminS.listReq.GetOffset() != 2 || adminS.listReq.GetLimit() != 5 {
+		t.Errorf("wrong list channels request %v", adminS.listReq)
+	}
+	if adminS.listPaysReq.GetCid() != testViewCid || adminS.listPaysReq.GetOffset() != 1 || adminS.listPaysReq.GetLimit() != 1 {
+		t.Errorf("wrong list channel pays request %v", adminS.listPaysReq)
+	}
+
+	// json field names and value encodings
+	var list struct {
+		Channels []struct {
+			Cid    string `json:"cid"`
+			State  string `json:"state"`
+			OpenTs string `json:"open_ts"`
+		} `json:"channels"`
+	}
+	getJSON(t, ts.URL+"/admin/channels", &list)
+	if len(list.Channels) != 1 || list.Channels[0].Cid != testViewCid ||
+		list.Channels[0].State != "CHANNEL_OPENED" || list.Channels[0].OpenTs != "1000" {
+		t.Errorf("wrong channel list %+v", list)
+	}
+	var pays struct {
+		Pays []struct {
+			PayID string `json:"pay_id"`
+		} `json:"pays"`
+		Total uint32 `json:"total"`
+	}
+	getJSON(t, ts.URL+"/admin/channels/"+testViewCid+"/pays", &pays)
+	if len(pays.Pays) != 1 || pays.Pays[0].PayID != testViewPayID || pays.Total != 3 {
+		t.Errorf("wrong channel pays %+v", pays)
+	}
+	var payment struct {
+		Payment struct {
+			PayID           string `json:"pay_id"`
+			AmtWei          string `json:"amt_wei"`
+			ResolveDeadline string `json:"resolve_deadline"`
+		} `json:"payment"`
+	}
+	getJSON(t, ts.URL+"/admin/pays/"+testViewPayID, &payment)
+	if payment.Payment.PayID != testViewPayID || payment.Payment.AmtWei != "5" || payment.Payment.ResolveDeadline != "200" {
+		t.Errorf("wrong payment %+v", payment)
+	}
+	var notFound struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+	getJSON(t, ts.URL+"/admin/pays/a2", &notFound)
+	if notFound.Code != int(codes.NotFound) || notFound.Message != "pay not found" {
+		t.Errorf("wrong error body %+v", notFound)
+	}
+}
+
+func getJSON(t *testing.T, url string, v interface{}) {
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	err = json.NewDecoder(resp.Body).Decode(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
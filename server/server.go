@@ -10,6 +10,7 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"flag"
 	"fmt"
@@ -18,6 +19,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -34,6 +36,8 @@ import (
 	"github.com/celer-network/goCeler/entity"
 	celerx_fee_interface "github.com/celer-network/goCeler/fee-manager/interface"
 	"github.com/celer-network/goCeler/metrics"
+	"github.com/celer-network/goCeler/ratelimit"
+	"github.com/celer-network/goCeler/remotesigner"
 	"github.com/celer-network/goCeler/route"
 	"github.com/celer-network/goCeler/rpc"
 	"github.com/celer-network/goCeler/rtconfig"
@@ -52,6 +56,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 )
@@ -85,6 +90,13 @@ var (
 	tlsKey               = flag.String("tlskey", "", "Path to TLS private key file")
 	tlsClient            = flag.Bool("tlsclient", false, "Require tls client cert by CelerCA")
 	allowTsDiffInMinutes = flag.Uint64("allowtsdiff", 120, "Allowed timestamp diff (in minutes) when authenticating peer in pay history request")
+	otlpTrace            = flag.String("otlptrace", "", "Export message processing spans in OTLP JSON format to a file path or an OTLP/HTTP collector URL")
+	remoteSigner         = flag.String("remotesigner", "", "Remote signer daemon host:port holding the OSP key, replaces -ks and -depositks")
+	signerCert           = flag.String("signercert", "", "Path to TLS client cert file for the remote signer")
+	signerKey            = flag.String("signerkey", "", "Path to TLS client private key file for the remote signer")
+	signerCA             = flag.String("signerca", "", "Path to CA cert file that signs the remote signer cert")
+	drainTimeout         = flag.Uint64("draintimeout", uint64(config.DefaultDrainTimeout/time.Second), "Max seconds to wait for outstanding pays and msgs when draining on SIGTERM")
+	chainProfiles        = flag.String("chainprofiles", "", "Paths to profile json files of extra chains served with the -ks account, separated by comma")
 
 	routerBcastInterval = flag.Uint64("routerbcastinterval", 0, "interval (in sec) to broadcast route updates, should only set for test purpose")
 	routerBuildInterval = flag.Uint64("routerbuildinterval", 0, "interval (in sec) to build routing table, should only set for test purpose")
@@ -109,6 +121,7 @@ type server struct {
 	delegate     *delegate.DelegateManager
 	config       *common.CProfile
 	redisClient  *redis.Client
+	rateLimiter  *ratelimit.KeyedLimiter // per caller and rpc method
 	rpc.UnimplementedRpcServer
 }
 type serverInterOSP struct {
@@ -180,10 +193,58 @@ func newAdminService(cNode *cnode.CNode) *adminService {
 	return adminS
 }
 
+// checkRateLimit returns a ResourceExhausted error if the caller is over the rate limit of the rpc method.
+// Callers are identified by the given key, or by the remote host if key is empty.
+func (s *server) checkRateLimit(ctx context.Context, method, key string) error {
+	if key == "" {
+		key = remoteHost(ctx)
+	}
+	if s.rateLimiter.Allow(key, method) {
+		return nil
+	}
+	log.Warnln("rate limited", method, "from", key)
+	metrics.IncRateLimitedCnt(method)
+	return status.Error(codes.ResourceExhausted, common.ErrRateLimited.Error())
+}
+
+// rateLimitChanPeer returns the rate limit key of the channel peer if the migration request is signed by it,
+// or empty to fall back to the remote host. Clients sharing an IP are then limited separately, while
+// requests with unverified signers cannot get a new bucket on every call.
+func (s *server) rateLimitChanPeer(in *rpc.MigrateChannelRequest) string {
+	var info entity.ChannelMigrationInfo
+	err := proto.Unmarshal(in.GetChannelMigrationInfo(), &info)
+	if err != nil {
+		return ""
+	}
+	peer, found, err := s.cNode.GetDAL().GetChanPeer(ctype.Bytes2Cid(info.GetChannelId()))
+	if err != nil || !found {
+		return ""
+	}
+	if !eth.IsSignatureValid(peer, in.GetChannelMigrationInfo(), in.GetRequesterSig()) {
+		return ""
+	}
+	return ctype.Addr2Hex(peer)
+}
+
+func remoteHost(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
 func (s *server) RequestDelegation(ctx context.Context, in *rpc.DelegationRequest) (*rpc.DelegationResponse, error) {
-	log.Infof("RequestDelegation: %x", in.GetProof().GetSigner())
-	dal := s.cNode.GetDAL()
 	proof := in.GetProof()
+	if err := s.checkRateLimit(ctx, "RequestDelegation", ""); err != nil {
+		return nil, err
+	}
+	log.Infof("RequestDelegation: %x", proof.GetSigner())
+	dal := s.cNode.GetDAL()
 	delegationDesc := &rpc.DelegationDescription{}
 	signer, err := eth.RecoverSigner(proof.GetDelegationDescriptionBytes(), proof.GetSignature())
 	if err != nil {
@@ -208,6 +269,9 @@ func (s *server) RequestDelegation(ctx context.Context, in *rpc.DelegationReques
 }
 
 func (s *server) QueryDelegation(ctx context.Context, in *rpc.QueryDelegationRequest) (*rpc.QueryDelegationResponse, error) {
+	if err := s.checkRateLimit(ctx, "QueryDelegation", ""); err != nil {
+		return nil, err
+	}
 	dal := s.cNode.GetDAL()
 	proof, found, err := dal.GetPeerDelegateProof(ctype.Bytes2Addr(in.GetDelegatee()))
 	if err != nil {
@@ -247,6 +311,9 @@ func (s *server) CelerStream(stream rpc.Rpc_CelerStreamServer) error {
 		ctx, err = s.cNode.AddCelerStream(msg, stream)
 		if err != nil {
 			log.Warnln("AddCelerStream err:", err.Error())
+			if errors.Is(err, common.ErrDraining) {
+				return status.Error(codes.Unavailable, err.Error())
+			}
 			return status.Error(codes.InvalidArgument, err.Error())
 		}
 	} else {
@@ -293,6 +360,10 @@ func (s *server) GetPayHistory(ctx context.Context, in *rpc.GetPayHistoryRequest
 	if tsFromPeer > tsFromServer+*allowTsDiffInMinutes*60 || tsFromPeer < tsFromServer-*allowTsDiffInMinutes*60 {
 		return nil, errors.New("Invalid Timestamp")
 	}
+	err = s.checkRateLimit(ctx, "GetPayHistory", ctype.Addr2Hex(peer))
+	if err != nil {
+		return nil, err
+	}
 
 	// Query history with parameters
 	payIDs, pays, instates, createTses, err := s.cNode.GetDAL().GetPayHistory(peer, beforeTsTime, smallestPayID, itemsPerPage)
@@ -324,7 +395,38 @@ func (s *server) GetPayHistory(ctx context.Context, in *rpc.GetPayHistoryRequest
 	return resp, nil
 }
 
+// GetPayFee quotes the relay fee a peer should send along with a pay forwarded by this osp
+func (s *server) GetPayFee(ctx context.Context, in *rpc.GetPayFeeRequest) (*rpc.GetPayFeeResponse, error) {
+	if err := s.checkRateLimit(ctx, "GetPayFee", ""); err != nil {
+		return nil, err
+	}
+	tokenAddr, err := utils.ValidateAndFormatAddress(in.GetToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	dst, err := utils.ValidateAndFormatAddress(in.GetDst())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	amt := utils.Wei2BigInt(in.GetAmt())
+	if amt == nil || amt.Sign() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Can't parse amount")
+	}
+	if s.cNode == nil {
+		return nil, fmt.Errorf("server error: cNode not initialized")
+	}
+	fee, err := s.cNode.GetPayFee(tokenAddr, dst, amt)
+	if err != nil {
+		log.Warnln("GetPayFee err:", err, in.GetDst())
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	return &rpc.GetPayFeeResponse{Fee: fee.String()}, nil
+}
+
 func (s *server) CelerGetPeerStatus(ctx context.Context, in *rpc.PeerAddress) (*rpc.PeerStatus, error) {
+	if err := s.checkRateLimit(ctx, "CelerGetPeerStatus", ""); err != nil {
+		return nil, err
+	}
 	peer, err := utils.ValidateAndFormatAddress(in.Address)
 	if err != nil {
 		return nil, err
@@ -400,7 +502,49 @@ func (s *adminService) OspOpenChannel(ctx context.Context, in *rpc.OspOpenChanne
 	return &empty.Empty{}, nil
 }
 
+func (s *adminService) OspBatchOpenChannel(in *rpc.OspBatchOpenChannelRequest, stream rpc.Admin_OspBatchOpenChannelServer) error {
+	log.Infof("OspBatchOpenChannel: %d channels", len(in.GetChannels()))
+	items := make([]*cnode.BatchOpenChannelItem, 0, len(in.GetChannels()))
+	for i, ch := range in.GetChannels() {
+		selfDeposit, ok := big.NewInt(0).SetString(ch.GetSelfDepositAmtWei(), 10)
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, "wrong self deposit of channel %d", i)
+		}
+		peerDeposit, ok := big.NewInt(0).SetString(ch.GetPeerDepositAmtWei(), 10)
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, "wrong peer deposit of channel %d", i)
+		}
+		items = append(items, &cnode.BatchOpenChannelItem{
+			Peer: ctype.Bytes2Addr(ch.GetPeerEthAddress()),
+			TokenInfo: &entity.TokenInfo{
+				TokenType:    ch.GetTokenType(),
+				TokenAddress: ch.GetTokenAddress(),
+			},
+			AmtSelf: selfDeposit,
+			AmtPeer: peerDeposit,
+		})
+	}
+	s.cNode.BatchOpenChannel(items, &batchOpenStreamer{stream: stream})
+	return nil
+}
+
+// batchOpenStreamer sends batch open progress to the admin stream
+type batchOpenStreamer struct {
+	stream rpc.Admin_OspBatchOpenChannelServer
+}
+
+func (b *batchOpenStreamer) HandleBatchOpenChannelProgress(progress *rpc.BatchOpenChannelProgress) {
+	log.Infof("batch open channel %d with %x: %s %s %s", progress.GetIndex(), progress.GetPeerEthAddress(),
+		progress.GetState(), progress.GetCid(), progress.GetError())
+	if err := b.stream.Send(progress); err != nil {
+		log.Warnln("failed to send batch open progress:", err)
+	}
+}
+
 func (s *server) CelerOpenTcbChannel(ctx context.Context, in *rpc.OpenChannelRequest) (*rpc.OpenChannelResponse, error) {
+	if err := s.checkRateLimit(ctx, "CelerOpenTcbChannel", ""); err != nil {
+		return nil, err
+	}
 	return s.cNode.ProcessTcbRequest(in)
 }
 
@@ -408,6 +552,9 @@ func (s *server) CelerOpenChannel(ctx context.Context, in *rpc.OpenChannelReques
 	if in == nil {
 		return nil, common.ErrInvalidArg
 	}
+	if err := s.checkRateLimit(ctx, "CelerOpenChannel", ""); err != nil {
+		return nil, err
+	}
 	ocWait := rtconfig.GetOpenChanWaitSecond()
 	if ocWait > 0 {
 		now := time.Now().Unix()
@@ -429,6 +576,9 @@ func (s *server) CelerMigrateChannel(ctx context.Context, in *rpc.MigrateChannel
 	if in == nil {
 		return nil, common.ErrInvalidArg
 	}
+	if err := s.checkRateLimit(ctx, "CelerMigrateChannel", s.rateLimitChanPeer(in)); err != nil {
+		return nil, err
+	}
 
 	return s.cNode.ProcessMigrateChannelRequest(in)
 }
@@ -597,6 +747,13 @@ func (s *adminService) SendToken(ctx context.Context, in *rpc.SendTokenRequest)
 	if amt == nil {
 		return &rpc.SendTokenResponse{Status: 1, Error: "Can't parse amount."}, status.Error(codes.InvalidArgument, "Can't parse amount")
 	}
+	fee := new(big.Int)
+	if in.FeeWei != "" {
+		fee = utils.Wei2BigInt(in.FeeWei)
+		if fee == nil || fee.Sign() < 0 {
+			return &rpc.SendTokenResponse{Status: 1, Error: "Can't parse fee."}, status.Error(codes.InvalidArgument, "Can't parse fee")
+		}
+	}
 	dstAddr, err := hex.DecodeString(strings.TrimPrefix(in.DstAddr, "0x"))
 	if err != nil {
 		log.Errorln("Error parsing dst:", in.DstAddr)
@@ -638,7 +795,7 @@ func (s *adminService) SendToken(ctx context.Context, in *rpc.SendTokenRequest)
 	}
 
 	metrics.IncSvrAdminSendTokenCnt(metrics.SvrAdminSendAttempt, noteType)
-	payID, err := s.cNode.AddBooleanPay(pay, in.Note, in.DstNetId)
+	payID, err := s.cNode.AddBooleanPayWithFee(pay, in.Note, in.DstNetId, fee)
 	if err != nil {
 		log.Errorln(
 			err, "sending token from admin error to", ctype.Bytes2Hex(dstAddr),
@@ -685,22 +842,195 @@ func (s *adminService) QueryDeposit(ctx context.Context, in *rpc.QueryDepositReq
 			DepositState: rpc.DepositState_Deposit_NOT_FOUND, Error: err.Error(),
 		}, status.Error(errCode, err.Error())
 	}
-	var depositState rpc.DepositState
+	return &rpc.QueryDepositResponse{
+		DepositState: toRpcDepositState(state),
+		Error:        errMsg,
+	}, nil
+}
+
+func toRpcDepositState(state int) rpc.DepositState {
 	switch state {
-	case structs.DepositState_NULL:
-		depositState = rpc.DepositState_Deposit_NOT_FOUND
 	case structs.DepositState_QUEUED, structs.DepositState_APPROVING_ERC20, structs.DepositState_TX_SUBMITTING:
-		depositState = rpc.DepositState_Deposit_QUEUED
+		return rpc.DepositState_Deposit_QUEUED
 	case structs.DepositState_TX_SUBMITTED:
-		depositState = rpc.DepositState_Deposit_SUBMITTED
+		return rpc.DepositState_Deposit_SUBMITTED
 	case structs.DepositState_SUCCEEDED:
-		depositState = rpc.DepositState_Deposit_SUCCEEDED
+		return rpc.DepositState_Deposit_SUCCEEDED
 	case structs.DepositState_FAILED:
-		depositState = rpc.DepositState_Deposit_FAILED
+		return rpc.DepositState_Deposit_FAILED
 	}
-	return &rpc.QueryDepositResponse{
-		DepositState: depositState,
-		Error:        errMsg,
+	return rpc.DepositState_Deposit_NOT_FOUND
+}
+
+func (s *adminService) GetPayTrace(ctx context.Context, in *rpc.GetPayTraceRequest) (*rpc.GetPayTraceResponse, error) {
+	traceID, spans, err := s.cNode.GetPayTrace(ctype.Hex2PayID(in.GetPayId()))
+	if err != nil {
+		errCode := codes.Unavailable
+		if errors.Is(err, common.ErrPayTraceNotFound) {
+			errCode = codes.NotFound
+		}
+		return nil, status.Error(errCode, err.Error())
+	}
+	return &rpc.GetPayTraceResponse{
+		TraceId: traceID,
+		Spans:   spans,
+	}, nil
+}
+
+func (s *adminService) GetChannelBalances(
+	ctx context.Context, in *rpc.ChannelBalancesRequest) (*rpc.ChannelBalancesResponse, error) {
+	var tokenAddrs []ctype.Addr
+	if len(in.GetTokenAddress()) > 0 {
+		tokenAddrs = append(tokenAddrs, ctype.Bytes2Addr(in.GetTokenAddress()))
+	}
+	channels, err := s.cNode.GetChannelBalances(tokenAddrs, in.GetPeerOspsOnly())
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	return &rpc.ChannelBalancesResponse{Channels: channels}, nil
+}
+
+func (s *adminService) GetPendingPays(ctx context.Context, in *rpc.PendingPaysRequest) (*rpc.PendingPaysResponse, error) {
+	pays, err := s.cNode.GetPendingPays(ctype.Hex2Cid(in.GetCid()))
+	if err != nil {
+		errCode := codes.Unavailable
+		if errors.Is(err, common.ErrChannelNotFound) {
+			errCode = codes.NotFound
+		}
+		return nil, status.Error(errCode, err.Error())
+	}
+	return &rpc.PendingPaysResponse{Pays: pays}, nil
+}
+
+func (s *adminService) GetDepositJobs(ctx context.Context, in *rpc.DepositJobsRequest) (*rpc.DepositJobsResponse, error) {
+	jobs, err := s.cNode.GetDepositJobs(ctype.Hex2Cid(in.GetCid()))
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	resp := &rpc.DepositJobsResponse{}
+	for _, job := range jobs {
+		resp.Jobs = append(resp.Jobs, &rpc.DepositJobInfo{
+			DepositId:    job.UUID,
+			Cid:          ctype.Cid2Hex(job.Cid),
+			ToPeer:       job.ToPeer,
+			AmtWei:       job.Amount.String(),
+			Refill:       job.Refill,
+			DepositState: toRpcDepositState(job.State),
+			TxHash:       job.TxHash,
+			Error:        job.ErrMsg,
+			DeadlineTs:   job.Deadline.Unix(),
+		})
+	}
+	return resp, nil
+}
+
+func (s *adminService) GetRoutingTable(ctx context.Context, in *rpc.RoutingTableRequest) (*rpc.RoutingTableResponse, error) {
+	var tokenAddrs []ctype.Addr
+	if len(in.GetTokenAddress()) > 0 {
+		tokenAddrs = append(tokenAddrs, ctype.Bytes2Addr(in.GetTokenAddress()))
+	}
+	routes, err := s.cNode.GetRoutingTable(tokenAddrs)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	return &rpc.RoutingTableResponse{Routes: routes}, nil
+}
+
+func (s *adminService) Rebalance(ctx context.Context, in *rpc.RebalanceRequest) (*rpc.RebalanceResponse, error) {
+	var tokenAddrs []ctype.Addr
+	if len(in.GetTokenAddress()) > 0 {
+		tokenAddrs = append(tokenAddrs, ctype.Bytes2Addr(in.GetTokenAddress()))
+	}
+	plans, err := s.cNode.Rebalance(tokenAddrs, in.GetDryRun())
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	return &rpc.RebalanceResponse{Plans: plans}, nil
+}
+
+func (s *adminService) ListChannels(ctx context.Context, in *rpc.ListChannelsRequest) (*rpc.ListChannelsResponse, error) {
+	var peer, token *ctype.Addr
+	if in.GetPeer() != "" {
+		addr, err := utils.ValidateAndFormatAddress(in.GetPeer())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		peer = &addr
+	}
+	if in.GetToken() != "" {
+		addr, err := utils.ValidateAndFormatAddress(in.GetToken())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		token = &addr
+	}
+	channels, err := s.cNode.ListChannels(peer, token, int(in.GetState()), in.GetOffset(), in.GetLimit())
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	return &rpc.ListChannelsResponse{Channels: channels}, nil
+}
+
+func (s *adminService) GetChannelDetail(ctx context.Context, in *rpc.ChannelDetailRequest) (*rpc.ChannelDetailResponse, error) {
+	detail, err := s.cNode.GetChannelDetail(ctype.Hex2Cid(in.GetCid()))
+	if err != nil {
+		errCode := codes.Unavailable
+		if errors.Is(err, common.ErrChannelNotFound) {
+			errCode = codes.NotFound
+		}
+		return nil, status.Error(errCode, err.Error())
+	}
+	return detail, nil
+}
+
+func (s *adminService) GetChannelStatus(ctx context.Context, in *rpc.ChannelStatusRequest) (*rpc.ChannelStatusResponse, error) {
+	resp, err := s.cNode.GetChannelStatus(ctype.Hex2Cid(in.GetCid()))
+	if err != nil {
+		errCode := codes.Unavailable
+		if errors.Is(err, common.ErrChannelNotFound) {
+			errCode = codes.NotFound
+		}
+		return nil, status.Error(errCode, err.Error())
+	}
+	return resp, nil
+}
+
+func (s *adminService) ListChannelPays(ctx context.Context, in *rpc.ListChannelPaysRequest) (*rpc.ListChannelPaysResponse, error) {
+	pays, total, err := s.cNode.ListChannelPays(ctype.Hex2Cid(in.GetCid()), in.GetOffset(), in.GetLimit())
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	return &rpc.ListChannelPaysResponse{Pays: pays, Total: total}, nil
+}
+
+func (s *adminService) GetPayment(ctx context.Context, in *rpc.GetPaymentRequest) (*rpc.GetPaymentResponse, error) {
+	payment, err := s.cNode.GetPayment(ctype.Hex2PayID(in.GetPayId()))
+	if err != nil {
+		errCode := codes.Unavailable
+		if errors.Is(err, common.ErrPayNotFound) {
+			errCode = codes.NotFound
+		}
+		return nil, status.Error(errCode, err.Error())
+	}
+	return &rpc.GetPaymentResponse{Payment: payment}, nil
+}
+
+func (s *adminService) Drain(ctx context.Context, in *rpc.DrainRequest) (*rpc.DrainResponse, error) {
+	timeout := config.DefaultDrainTimeout
+	if in.GetTimeoutSec() > 0 {
+		timeout = time.Duration(in.GetTimeoutSec()) * time.Second
+	}
+	report, err := s.cNode.Drain(timeout)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	return &rpc.DrainResponse{
+		Completed:     report.Completed,
+		ElapsedSec:    uint32(report.Elapsed / time.Second),
+		PendingPays:   uint32(report.PendingPays),
+		UnackedMsgs:   uint32(report.UnackedMsgs),
+		UnackedCids:   uint32(report.UnackedCids),
+		ClosedStreams: uint32(report.ClosedStreams),
 	}, nil
 }
 
@@ -834,6 +1164,44 @@ func (s *server) Initialize(
 	if err != nil {
 		log.Fatalln("Server init error:", err)
 	}
+	s.addChains(masterTxConfig)
+	s.setupCallbacks()
+}
+
+// InitializeWithRemoteSigner initializes the server with the OSP key held by a remote signer
+func (s *server) InitializeWithRemoteSigner(
+	signer *remotesigner.Client, transactorConfigs []*eth.TransactorConfig, routingBytes []byte) {
+	s.config = common.ParseProfile(*pjson)
+	overrideConfig(s.config)
+	var err error
+	s.cNode, err = cnode.NewOSPWithExternalSigner(
+		signer.Address(),
+		signer,
+		transactorConfigs,
+		*s.config,
+		route.ServiceProviderPolicy,
+		routingBytes)
+	if err != nil {
+		log.Fatalln("Server init error:", err)
+	}
+	s.addChains(nil)
+	s.setupCallbacks()
+}
+
+// addChains sets up the extra chains given by -chainprofiles
+func (s *server) addChains(masterTxConfig *eth.TransactorConfig) {
+	if *chainProfiles == "" {
+		return
+	}
+	for _, path := range strings.Split(*chainProfiles, ",") {
+		err := s.cNode.AddChain(*common.ParseProfile(path), masterTxConfig)
+		if err != nil {
+			log.Fatalln("Add chain error:", err, path)
+		}
+	}
+}
+
+func (s *server) setupCallbacks() {
 	s.delegate = delegate.NewDelegateManager(s.cNode.EthAddress, s.cNode.GetDAL(), s.cNode)
 	s.cNode.OnReceivingToken(s)
 	s.cNode.OnSendToken(s)
@@ -1035,6 +1403,12 @@ func main() {
 	}
 	setGlobalConfig()
 	var err error
+	if *otlpTrace != "" {
+		err = metrics.StartTraceExport(*otlpTrace, "celer-osp")
+		if err != nil {
+			log.Fatalln(err)
+		}
+	}
 
 	var ksBytes []byte
 	var ksStr string
@@ -1101,15 +1475,28 @@ func main() {
 	}
 	var rpcServer server
 	rpcServer.netClient = &http.Client{Timeout: 3 * time.Second}
+	rpcServer.rateLimiter = ratelimit.NewKeyedLimiter(rtconfig.GetRateLimit)
 	if *redisAddr != "" {
 		rpcServer.redisClient = redis.NewClient(&redis.Options{Addr: *redisAddr})
 	}
-	masterTxConfig := eth.NewTransactorConfig(ksStr, readPassword(ksBytes))
-	if dksStr != "" {
-		depositTxConfig := eth.NewTransactorConfig(dksStr, readPassword(dksBytes))
-		rpcServer.Initialize(masterTxConfig, depositTxConfig, tConfigs, routingBytes)
+	if *remoteSigner != "" {
+		tlsConfig, err := remotesigner.LoadTLSConfig(*signerCert, *signerKey, *signerCA, false)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		signer, err := remotesigner.NewClient(*remoteSigner, tlsConfig)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		rpcServer.InitializeWithRemoteSigner(signer, tConfigs, routingBytes)
 	} else {
-		rpcServer.Initialize(masterTxConfig, nil, tConfigs, routingBytes)
+		masterTxConfig := eth.NewTransactorConfig(ksStr, readPassword(ksBytes))
+		if dksStr != "" {
+			depositTxConfig := eth.NewTransactorConfig(dksStr, readPassword(dksBytes))
+			rpcServer.Initialize(masterTxConfig, depositTxConfig, tConfigs, routingBytes)
+		} else {
+			rpcServer.Initialize(masterTxConfig, nil, tConfigs, routingBytes)
+		}
 	}
 	rpc.RegisterRpcServer(s, &rpcServer)
 
@@ -1141,8 +1528,34 @@ func main() {
 		go s2.Serve(lis2)
 	}
 
+	shutdown := make(chan bool)
+	go handleShutdownSignal(&rpcServer, s, shutdown)
+
 	// Run the main server.
-	s.Serve(lis)
+	err = s.Serve(lis)
+	if err != nil {
+		log.Errorln("serve err:", err)
+		return
+	}
+	<-shutdown
+}
+
+// handleShutdownSignal drains the node on SIGTERM so that pays in flight can complete,
+// then stops the server and closes the node.
+func handleShutdownSignal(osp *server, s *grpc.Server, shutdown chan bool) {
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGTERM)
+	sig := <-sigs
+	log.Infoln("received", sig, "signal, draining before shutdown")
+	report, err := osp.cNode.Drain(time.Duration(*drainTimeout) * time.Second)
+	if err != nil {
+		log.Errorln("drain err:", err)
+	} else if !report.Completed {
+		log.Warnln("shutdown with remaining work:", report)
+	}
+	s.Stop()
+	osp.cNode.Close()
+	close(shutdown)
 }
 
 func setUpAdminService(osp *server) *adminService {
@@ -1172,6 +1585,8 @@ func setUpAdminService(osp *server) *adminService {
 
 	http.Handle("/admin/", gwmux)
 	http.Handle("/metrics", metrics.GetPromExporter())
+	http.HandleFunc("/healthz", serveHealth(osp.cNode.CheckLiveness))
+	http.HandleFunc("/readyz", serveHealth(osp.cNode.CheckReadiness))
 	log.Infoln("Celer server has admin HTTP:", *adminweb)
 	go func() {
 		err := http.ListenAndServe(*adminweb, http.DefaultServeMux)
@@ -1182,6 +1597,21 @@ func setUpAdminService(osp *server) *adminService {
 	return adminS
 }
 
+// serveHealth writes the health report in JSON, with 503 status code if any check fails
+func serveHealth(check func() *cnode.HealthReport) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		report := check()
+		w.Header().Set("Content-Type", "application/json")
+		if report.Status == cnode.HealthFail {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		err := json.NewEncoder(w).Encode(report)
+		if err != nil {
+			log.Errorln("write health report err:", err)
+		}
+	}
+}
+
 func getServerTlsOption() grpc.ServerOption {
 	if *tlsCert != "" && *tlsKey != "" {
 		if *tlsClient {
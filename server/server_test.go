@@ -0,0 +1,50 @@
+// Copyright 2020 Celer Network
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/celer-network/goCeler/cnode"
+)
+
+func TestServeHealth(t *testing.T) {
+	testCases := []struct {
+		status string
+		code   int
+	}{
+		{status: cnode.HealthOK, code: http.StatusOK},
+		{status: cnode.HealthWarn, code: http.StatusOK},
+		{status: cnode.HealthFail, code: http.StatusServiceUnavailable},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.status, func(t *testing.T) {
+			report := &cnode.HealthReport{
+				Status: tc.status,
+				Checks: map[string]*cnode.HealthCheck{
+					"db": {Status: tc.status, Error: "err"},
+				},
+			}
+			rec := httptest.NewRecorder()
+			serveHealth(func() *cnode.HealthReport { return report })(rec, httptest.NewRequest("GET", "/readyz", nil))
+			if rec.Code != tc.code {
+				t.Errorf("status code %d, expected %d", rec.Code, tc.code)
+			}
+			if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+				t.Errorf("content type %s", ct)
+			}
+			var got cnode.HealthReport
+			err := json.Unmarshal(rec.Body.Bytes(), &got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Status != tc.status || got.Checks["db"] == nil ||
+				got.Checks["db"].Status != tc.status || got.Checks["db"].Error != "err" {
+				t.Errorf("wrong report %s", rec.Body.String())
+			}
+		})
+	}
+}
@@ -17,9 +17,11 @@ import (
 	"github.com/celer-network/goCeler/common/structs"
 	"github.com/celer-network/goCeler/ctype"
 	"github.com/celer-network/goCeler/entity"
+	"github.com/celer-network/goCeler/metrics"
 	"github.com/celer-network/goCeler/rpc"
 	"github.com/celer-network/goutils/log"
 	"github.com/golang/protobuf/ptypes/any"
+	"go.opencensus.io/trace"
 )
 
 const (
@@ -99,24 +101,38 @@ func (tx *DALTx) ConvertError(err error) error {
 }
 
 func (d *DAL) Transactional(callback TxFunc, args ...interface{}) error {
+	_, err := d.transactional(callback, args...)
+	return err
+}
+
+// TracedTransactional runs Transactional within a child span of parent.
+func (d *DAL) TracedTransactional(parent *trace.Span, name string, callback TxFunc, args ...interface{}) error {
+	span := metrics.StartSpan(parent, "DAL."+name)
+	attempts, err := d.transactional(callback, args...)
+	span.AddAttributes(trace.Int64Attribute(metrics.AkTxAttempts, int64(attempts)))
+	metrics.EndSpan(span, err)
+	return err
+}
+
+func (d *DAL) transactional(callback TxFunc, args ...interface{}) (int, error) {
 	for i := 0; i < transactionalMaxRetry; i++ {
 		tx, err := d.OpenTransaction()
 		if err != nil {
-			return err
+			return i + 1, err
 		}
 
 		err = callback(tx, args...)
 		if err == nil {
 			err = tx.Commit()
 			if err == nil {
-				return nil
+				return i + 1, nil
 			}
 		}
 
 		err = tx.ConvertError(err)
 		tx.Discard()
 		if err != ErrTxConflict {
-			return err
+			return i + 1, err
 		}
 
 		log.Debugf("transactional: [%d] Tx conflict, retrying...", i)
@@ -125,18 +141,24 @@ func (d *DAL) Transactional(callback TxFunc, args ...interface{}) error {
 
 	err := fmt.Errorf("%d Tx commit retries", transactionalMaxRetry)
 	log.Error(err)
-	return err
+	return transactionalMaxRetry, err
 }
 
 // ====================== DAL APIs for SQL schema ======================
 
 // The "channels" table.
 func (d *DAL) InsertChanWithTs(cid ctype.CidType, peer ctype.Addr, token *entity.TokenInfo, ledger ctype.Addr, state int, stateTs, openTs time.Time, openResp *rpc.OpenChannelResponse, onchainBalance *structs.OnChainBalance, baseSeqNum uint64, lastUsedSeqNum uint64, lastAckedSeqNum uint64, lastNackedSeqNum uint64, selfSimplex *rpc.SignedSimplexState, peerSimplex *rpc.SignedSimplexState) error {
-	return insertChanWithTs(d.st, cid, peer, token, ledger, state, stateTs, openTs, openResp, onchainBalance, baseSeqNum, lastUsedSeqNum, lastAckedSeqNum, lastNackedSeqNum, selfSimplex, peerSimplex)
+	return insertChanWithTs(d.st, 0 /*chainId*/, cid, peer, token, ledger, state, stateTs, openTs, openResp, onchainBalance, baseSeqNum, lastUsedSeqNum, lastAckedSeqNum, lastNackedSeqNum, selfSimplex, peerSimplex)
 }
 
 func (d *DAL) InsertChan(cid ctype.CidType, peer ctype.Addr, token *entity.TokenInfo, ledger ctype.Addr, state int, openResp *rpc.OpenChannelResponse, onchainBalance *structs.OnChainBalance, baseSeqNum uint64, lastUsedSeqNum uint64, lastAckedSeqNum uint64, lastNackedSeqNum uint64, selfSimplex *rpc.SignedSimplexState, peerSimplex *rpc.SignedSimplexState) error {
-	return insertChan(d.st, cid, peer, token, ledger, state, openResp, onchainBalance, baseSeqNum, lastUsedSeqNum, lastAckedSeqNum, lastNackedSeqNum, selfSimplex, peerSimplex)
+	return insertChan(d.st, 0 /*chainId*/, cid, peer, token, ledger, state, openResp, onchainBalance, baseSeqNum, lastUsedSeqNum, lastAckedSeqNum, lastNackedSeqNum, selfSimplex, peerSimplex)
+}
+
+// InsertChanOnChain inserts a channel on one of the extra chains of a multi-chain node,
+// chainId 0 is the primary chain.
+func (d *DAL) InsertChanOnChain(chainId uint64, cid ctype.CidType, peer ctype.Addr, token *entity.TokenInfo, ledger ctype.Addr, state int, openResp *rpc.OpenChannelResponse, onchainBalance *structs.OnChainBalance, baseSeqNum uint64, lastUsedSeqNum uint64, lastAckedSeqNum uint64, lastNackedSeqNum uint64, selfSimplex *rpc.SignedSimplexState, peerSimplex *rpc.SignedSimplexState) error {
+	return insertChan(d.st, chainId, cid, peer, token, ledger, state, openResp, onchainBalance, baseSeqNum, lastUsedSeqNum, lastAckedSeqNum, lastNackedSeqNum, selfSimplex, peerSimplex)
 }
 
 func (d *DAL) DeleteChan(cid ctype.CidType) error {
@@ -183,12 +205,26 @@ func (d *DAL) GetChanForDeposit(cid ctype.CidType) (int, *entity.TokenInfo, ctyp
 	return getChanForDeposit(d.st, cid)
 }
 
+// GetCidByPeerToken looks up the channel on the primary chain of the node
 func (d *DAL) GetCidByPeerToken(peer ctype.Addr, token *entity.TokenInfo) (ctype.CidType, bool, error) {
-	return getCidByPeerToken(d.st, peer, token)
+	return getCidByPeerToken(d.st, peer, token, 0 /*chainId*/)
 }
 
+// GetCidStateByPeerToken looks up the channel on the primary chain of the node
 func (d *DAL) GetCidStateByPeerToken(peer ctype.Addr, token *entity.TokenInfo) (ctype.CidType, int, bool, error) {
-	return getCidStateByPeerToken(d.st, peer, token)
+	return getCidStateByPeerToken(d.st, peer, token, 0 /*chainId*/)
+}
+
+func (d *DAL) GetCidByPeerTokenOnChain(peer ctype.Addr, token *entity.TokenInfo, chainId uint64) (ctype.CidType, bool, error) {
+	return getCidByPeerToken(d.st, peer, token, chainId)
+}
+
+func (d *DAL) GetCidStateByPeerTokenOnChain(peer ctype.Addr, token *entity.TokenInfo, chainId uint64) (ctype.CidType, int, bool, error) {
+	return getCidStateByPeerToken(d.st, peer, token, chainId)
+}
+
+func (d *DAL) GetChanChainId(cid ctype.CidType) (uint64, bool, error) {
+	return getChanChainId(d.st, cid)
 }
 
 func (d *DAL) GetSelfSimplex(cid ctype.CidType) (*entity.SimplexPaymentChannel, *rpc.SignedSimplexState, bool, error) {
@@ -239,6 +275,21 @@ func (d *DAL) GetCidsByTokenAndState(token *entity.TokenInfo, state int) ([]ctyp
 	return getCidsByTokenAndState(d.st, token, state)
 }
 
+func (d *DAL) GetCidPeerTokensByState(state int) ([]ctype.CidType, []ctype.Addr, []ctype.Addr, error) {
+	return getCidPeerTokensByState(d.st, state)
+}
+
+func (d *DAL) GetChansForBalanceByState(state int) ([]*ChanForBalance, error) {
+	return getChansForBalanceByState(d.st, state)
+}
+
+// GetChansByFilter returns cids, peers, tokens, states, state timestamps and open timestamps of a page
+// of channels. Nil peer or token and zero state match all channels.
+func (d *DAL) GetChansByFilter(peer, token *ctype.Addr, state int, offset, limit int) (
+	[]ctype.CidType, []ctype.Addr, []ctype.Addr, []int, []*time.Time, []*time.Time, error) {
+	return getChansByFilter(d.st, peer, token, state, offset, limit)
+}
+
 func (d *DAL) CountCidsByTokenAndState(token *entity.TokenInfo, state int) (int, error) {
 	return countCidsByTokenAndState(d.st, token, state)
 }
@@ -264,7 +315,11 @@ func (d *DAL) GetAllChanLedgers() ([]ctype.Addr, error) {
 }
 
 func (dtx *DALTx) InsertChan(cid ctype.CidType, peer ctype.Addr, token *entity.TokenInfo, ledger ctype.Addr, state int, openResp *rpc.OpenChannelResponse, onchainBalance *structs.OnChainBalance, baseSeqNum uint64, lastUsedSeqNum uint64, lastAckedSeqNum uint64, lastNackedSeqNum uint64, selfSimplex *rpc.SignedSimplexState, peerSimplex *rpc.SignedSimplexState) error {
-	return insertChan(dtx.stx, cid, peer, token, ledger, state, openResp, onchainBalance, baseSeqNum, lastUsedSeqNum, lastAckedSeqNum, lastNackedSeqNum, selfSimplex, peerSimplex)
+	return insertChan(dtx.stx, 0 /*chainId*/, cid, peer, token, ledger, state, openResp, onchainBalance, baseSeqNum, lastUsedSeqNum, lastAckedSeqNum, lastNackedSeqNum, selfSimplex, peerSimplex)
+}
+
+func (dtx *DALTx) InsertChanOnChain(chainId uint64, cid ctype.CidType, peer ctype.Addr, token *entity.TokenInfo, ledger ctype.Addr, state int, openResp *rpc.OpenChannelResponse, onchainBalance *structs.OnChainBalance, baseSeqNum uint64, lastUsedSeqNum uint64, lastAckedSeqNum uint64, lastNackedSeqNum uint64, selfSimplex *rpc.SignedSimplexState, peerSimplex *rpc.SignedSimplexState) error {
+	return insertChan(dtx.stx, chainId, cid, peer, token, ledger, state, openResp, onchainBalance, baseSeqNum, lastUsedSeqNum, lastAckedSeqNum, lastNackedSeqNum, selfSimplex, peerSimplex)
 }
 
 func (dtx *DALTx) DeleteChan(cid ctype.CidType) error {
@@ -279,6 +334,10 @@ func (dtx *DALTx) UpdateChanState(cid ctype.CidType, state int) error {
 	return updateChanState(dtx.stx, cid, state)
 }
 
+func (dtx *DALTx) GetChanStateToken(cid ctype.CidType) (int, *entity.TokenInfo, bool, error) {
+	return getChanStateToken(dtx.stx, cid)
+}
+
 func (dtx *DALTx) GetChanPeer(cid ctype.CidType) (ctype.Addr, bool, error) {
 	return getChanPeer(dtx.stx, cid)
 }
@@ -287,7 +346,7 @@ func (dtx *DALTx) GetChanPeerState(cid ctype.CidType) (ctype.Addr, int, bool, er
 	return getChanPeerState(dtx.stx, cid)
 }
 
-func (dtx *DALTx) GetChanForClose(cid ctype.CidType) (ctype.Addr, *entity.TokenInfo, time.Time, bool, error) {
+func (dtx *DALTx) GetChanForClose(cid ctype.CidType) (ctype.Addr, *entity.TokenInfo, time.Time, uint64, bool, error) {
 	return getChanForClose(dtx.stx, cid)
 }
 
@@ -393,12 +452,12 @@ func (dtx *DALTx) DeleteChanMessage(cid ctype.CidType, seqnum uint64) error {
 }
 
 // The "closedchannels" table.
-func (d *DAL) InsertClosedChan(cid ctype.CidType, peer ctype.Addr, token *entity.TokenInfo, openTs time.Time, closeTs time.Time) error {
-	return insertClosedChan(d.st, cid, peer, token, openTs, closeTs)
+func (d *DAL) InsertClosedChan(cid ctype.CidType, peer ctype.Addr, token *entity.TokenInfo, openTs time.Time, closeTs time.Time, chainId uint64) error {
+	return insertClosedChan(d.st, cid, peer, token, openTs, closeTs, chainId)
 }
 
-func (dtx *DALTx) InsertClosedChan(cid ctype.CidType, peer ctype.Addr, token *entity.TokenInfo, openTs time.Time, closeTs time.Time) error {
-	return insertClosedChan(dtx.stx, cid, peer, token, openTs, closeTs)
+func (dtx *DALTx) InsertClosedChan(cid ctype.CidType, peer ctype.Addr, token *entity.TokenInfo, openTs time.Time, closeTs time.Time, chainId uint64) error {
+	return insertClosedChan(dtx.stx, cid, peer, token, openTs, closeTs, chainId)
 }
 
 func (d *DAL) GetClosedChan(cid ctype.CidType) (ctype.Addr, *entity.TokenInfo, *time.Time, *time.Time, bool, error) {
@@ -434,6 +493,11 @@ func (d *DAL) GetAllPaymentInfoByCid(cid ctype.CidType) ([]ctype.PayIDType, []*e
 	return getAllPaymentInfoByCid(d.st, cid)
 }
 
+// GetPaymentInfoByCidPage returns a page of the pays returned by GetAllPaymentInfoByCid
+func (d *DAL) GetPaymentInfoByCidPage(cid ctype.CidType, offset, limit int) ([]ctype.PayIDType, []*entity.ConditionalPay, []*any.Any, []ctype.CidType, []int, []ctype.CidType, []int, []*time.Time, error) {
+	return getPaymentInfoByCidPage(d.st, cid, offset, limit)
+}
+
 func (d *DAL) GetPayStates(payID ctype.PayIDType) (int, int, bool, error) {
 	return getPayStates(d.st, payID)
 }
@@ -489,6 +553,15 @@ func (d *DAL) CountPayments() (int, error) {
 	return countPayments(d.st)
 }
 
+func (d *DAL) CountPaymentsByCid(cid ctype.CidType) (int, error) {
+	return countPaymentsByCid(d.st, cid)
+}
+
+// CountPaymentsByOutStatesPerPeer returns the number of pays in the out states per egress peer
+func (d *DAL) CountPaymentsByOutStatesPerPeer(states []int) (map[ctype.Addr]int, error) {
+	return countPaymentsByOutStatesPerPeer(d.st, states)
+}
+
 func (dtx *DALTx) InsertPayment(payID ctype.PayIDType, payBytes []byte, pay *entity.ConditionalPay, note *any.Any, inCid ctype.CidType, inState int, outCid ctype.CidType, outState int) error {
 	return insertPayment(dtx.stx, payID, payBytes, pay, note, inCid, inState, outCid, outState)
 }
@@ -509,6 +582,10 @@ func (dtx *DALTx) GetPayIngressChannel(payID ctype.PayIDType) (ctype.CidType, bo
 	return getPayIngressChannel(dtx.stx, payID)
 }
 
+func (dtx *DALTx) UpdatePayIngress(payID ctype.PayIDType, cid ctype.CidType, state int) error {
+	return updatePayIngress(dtx.stx, payID, cid, state)
+}
+
 func (dtx *DALTx) UpdatePayIngressState(payID ctype.PayIDType, state int) error {
 	return updatePayIngressState(dtx.stx, payID, state)
 }
@@ -951,8 +1028,8 @@ func (d *DAL) GetDepositJobByTxHash(txhash string) (*structs.DepositJob, bool, e
 	return getDepositJobByTxHash(d.st, txhash)
 }
 
-func (d *DAL) GetAllDepositJobsByState(state int) ([]*structs.DepositJob, error) {
-	return getAllDepositJobsByState(d.st, state)
+func (d *DAL) GetAllDepositJobsByState(chainId uint64, state int) ([]*structs.DepositJob, error) {
+	return getAllDepositJobsByState(d.st, chainId, state)
 }
 
 func (d *DAL) GetAllDepositJobsByCid(cid ctype.CidType) ([]*structs.DepositJob, error) {
@@ -963,8 +1040,8 @@ func (d *DAL) GetAllRunningDepositJobs() ([]*structs.DepositJob, error) {
 	return getAllRunningDepositJobs(d.st)
 }
 
-func (d *DAL) GetAllSubmittedDepositTxHashes() ([]string, error) {
-	return getAllSubmittedDepositTxHashes(d.st)
+func (d *DAL) GetAllSubmittedDepositTxHashes(chainId uint64) ([]string, error) {
+	return getAllSubmittedDepositTxHashes(d.st, chainId)
 }
 
 func (d *DAL) UpdateDepositStateAndTxHash(uuid string, state int, txhash string) error {
@@ -1045,6 +1122,168 @@ func (dtx *DALTx) DeleteLease(id string) error {
 	return deleteLease(dtx.stx, id)
 }
 
+// The "appsessions" table
+
+func (d *DAL) InsertAppSession(s *structs.AppSession) error {
+	return insertAppSession(d.st, s)
+}
+
+func (d *DAL) GetAppSession(sessionID string) (*structs.AppSession, bool, error) {
+	return getAppSession(d.st, sessionID)
+}
+
+func (d *DAL) GetAllAppSessions() ([]*structs.AppSession, error) {
+	return getAllAppSessions(d.st)
+}
+
+func (d *DAL) UpdateAppSessionStateProof(sessionID string, seqNum uint64, stateProof []byte) error {
+	return updateAppSessionStateProof(d.st, sessionID, seqNum, stateProof)
+}
+
+func (d *DAL) UpdateAppSessionDeployedAddr(sessionID string, deployedAddr ctype.Addr) error {
+	return updateAppSessionDeployedAddr(d.st, sessionID, deployedAddr)
+}
+
+func (d *DAL) UpdateAppSessionWatchBlock(sessionID string, watchBlock uint64) error {
+	return updateAppSessionWatchBlock(d.st, sessionID, watchBlock)
+}
+
+func (d *DAL) DeleteAppSession(sessionID string) error {
+	return deleteAppSession(d.st, sessionID)
+}
+
+// The "appdisputes" table
+
+// PutAppDispute keeps the seq num of a dispute not yet delivered to the app callback
+func (d *DAL) PutAppDispute(sessionID string, seqNum int) error {
+	return upsertAppDispute(d.st, sessionID, seqNum)
+}
+
+func (d *DAL) GetAppDispute(sessionID string) (int, bool, error) {
+	return getAppDispute(d.st, sessionID)
+}
+
+func (d *DAL) DeleteAppDispute(sessionID string) error {
+	return deleteAppDispute(d.st, sessionID)
+}
+
+// The "paytrace" table
+
+func (d *DAL) InsertPayTrace(span *rpc.PayTraceSpan) error {
+	return insertPayTrace(d.st, span)
+}
+
+func (d *DAL) GetPayTrace(payID ctype.PayIDType) (*rpc.PayTraceSpan, bool, error) {
+	return getPayTrace(d.st, payID)
+}
+
+func (d *DAL) UpdatePayTraceFwd(payID ctype.PayIDType, nextHop ctype.Addr, fwdTs int64) error {
+	return updatePayTraceFwd(d.st, payID, nextHop, fwdTs)
+}
+
+func (d *DAL) UpdatePayTraceReceipt(payID ctype.PayIDType, receiptTs int64) error {
+	return updatePayTraceReceipt(d.st, payID, receiptTs)
+}
+
+func (d *DAL) UpdatePayTraceErrors(payID ctype.PayIDType, errs []string) error {
+	return updatePayTraceErrors(d.st, payID, errs)
+}
+
+func (d *DAL) DeletePayTracesBefore(ts time.Time) error {
+	return deletePayTracesBefore(d.st, ts)
+}
+
+// The "payfees" table
+
+func (d *DAL) PutPayFee(payID ctype.PayIDType, cid ctype.CidType, fee *big.Int) error {
+	return upsertPayFee(d.st, payID, cid, fee)
+}
+
+func (d *DAL) GetPayFee(payID ctype.PayIDType, cid ctype.CidType) (*big.Int, bool, error) {
+	return getPayFee(d.st, payID, cid)
+}
+
+// GetPayFees returns the relay fees locked with the pay in its ingress and egress channels
+func (d *DAL) GetPayFees(payID ctype.PayIDType) (*big.Int, *big.Int, bool, error) {
+	return getPayFees(d.st, payID)
+}
+
+func (dtx *DALTx) PutPayFee(payID ctype.PayIDType, cid ctype.CidType, fee *big.Int) error {
+	return upsertPayFee(dtx.stx, payID, cid, fee)
+}
+
+func (dtx *DALTx) GetPayFee(payID ctype.PayIDType, cid ctype.CidType) (*big.Int, bool, error) {
+	return getPayFee(dtx.stx, payID, cid)
+}
+
+// The "payhistory" table
+
+func (d *DAL) PutPayHistoryItem(item *structs.PayHistoryItem) error {
+	return upsertPayHistoryItem(d.st, item)
+}
+
+func (d *DAL) GetPayHistoryItem(payID ctype.PayIDType) (*structs.PayHistoryItem, bool, error) {
+	return getPayHistoryItem(d.st, payID)
+}
+
+// GetOldestPayHistoryTs returns the creation time of the oldest pay history item in one of the statuses
+func (d *DAL) GetOldestPayHistoryTs(statuses []int) (time.Time, bool, error) {
+	return getOldestPayHistoryTs(d.st, statuses)
+}
+
+// GetPayHistoryItems returns the pay history items matching the filter in reverse-chronological order
+func (d *DAL) GetPayHistoryItems(filter *structs.PayHistoryFilter) ([]*structs.PayHistoryItem, error) {
+	return getPayHistoryItems(d.st, filter)
+}
+
+// The "txs" table
+
+func (d *DAL) InsertTx(tx *structs.TxRecord) error {
+	return insertTx(d.st, tx)
+}
+
+func (d *DAL) GetTx(txHash string) (*structs.TxRecord, bool, error) {
+	return getTx(d.st, txHash)
+}
+
+func (d *DAL) GetAllTxsByState(chainId uint64, state int) ([]*structs.TxRecord, error) {
+	return getAllTxsByState(d.st, chainId, state)
+}
+
+func (d *DAL) UpdateTxRebroadcast(txHash string, rawTx []byte, hashes []string) error {
+	return updateTxRebroadcast(d.st, txHash, rawTx, hashes)
+}
+
+func (d *DAL) UpdateTxState(txHash string, state int) error {
+	return updateTxState(d.st, txHash, state)
+}
+
+func (d *DAL) DeleteFinishedTxsBefore(ts time.Time) error {
+	return deleteFinishedTxsBefore(d.st, ts)
+}
+
+// The "eventlogs" table
+
+func (d *DAL) InsertEventLog(record *structs.EventLogRecord) error {
+	return insertEventLog(d.st, record)
+}
+
+func (d *DAL) GetEventLogsSince(chainId uint64, blkNum uint64) ([]*structs.EventLogRecord, error) {
+	return getEventLogsSince(d.st, chainId, blkNum)
+}
+
+func (d *DAL) UpdateEventLogBlock(blkHash string, logIndex uint, newBlkHash string, newLogIndex uint, newBlkNum uint64) error {
+	return updateEventLogBlock(d.st, blkHash, logIndex, newBlkHash, newLogIndex, newBlkNum)
+}
+
+func (d *DAL) DeleteEventLog(blkHash string, logIndex uint) error {
+	return deleteEventLog(d.st, blkHash, logIndex)
+}
+
+func (d *DAL) DeleteEventLogsBefore(chainId uint64, blkNum uint64) error {
+	return deleteEventLogsBefore(d.st, chainId, blkNum)
+}
+
 // ====================== DAL APIs for K/V store ======================
 
 // PendingOpenChannel
@@ -1227,3 +1466,78 @@ func balanceBigIntToBytes(balance *structs.OnChainBalance) *OnChainBalance {
 	}
 	return onchainBalance
 }
+
+// The "acctentries" table
+
+// InsertAcctRecord saves a balanced accounting entry, an entry with the same id is kept as is
+func (d *DAL) InsertAcctRecord(record *structs.AcctRecord) error {
+	return d.Transactional(func(tx *DALTx, args ...interface{}) error {
+		return insertAcctRecord(tx.stx, record)
+	})
+}
+
+func (d *DAL) DeleteAcctRecord(entryID string) error {
+	return deleteAcctRecord(d.st, entryID)
+}
+
+func (d *DAL) GetAcctRecords(since, until time.Time) ([]*structs.AcctRecord, error) {
+	return getAcctRecords(d.st, since, until)
+}
+
+func (dtx *DALTx) InsertAcctRecord(record *structs.AcctRecord) error {
+	return insertAcctRecord(dtx.stx, record)
+}
+
+// The "exitjobs" table
+
+func (d *DAL) InsertExitJob(job *structs.ExitJob) error {
+	return insertExitJob(d.st, job)
+}
+
+func (d *DAL) UpdateExitJob(cid ctype.CidType, state int, finalizeBlk uint64, errMsg string) error {
+	return updateExitJob(d.st, cid, state, finalizeBlk, errMsg)
+}
+
+func (d *DAL) GetExitJob(cid ctype.CidType) (*structs.ExitJob, bool, error) {
+	return getExitJob(d.st, cid)
+}
+
+// GetAllExitJobs returns all exit jobs, oldest first
+func (d *DAL) GetAllExitJobs() ([]*structs.ExitJob, error) {
+	return getAllExitJobs(d.st)
+}
+
+// The "payclearjobs" table
+
+func (d *DAL) PutPayClearJob(job *structs.PayClearJob) error {
+	return upsertPayClearJob(d.st, job)
+}
+
+func (d *DAL) DeletePayClearJob(payID ctype.PayIDType) error {
+	return deletePayClearJob(d.st, payID)
+}
+
+func (d *DAL) GetAllPayClearJobs() ([]*structs.PayClearJob, error) {
+	return getAllPayClearJobs(d.st)
+}
+
+// The "rebalancebudgets" table
+
+func (d *DAL) PutRebalanceBudget(budget *structs.RebalanceBudget) error {
+	return upsertRebalanceBudget(d.st, budget)
+}
+
+func (d *DAL) GetRebalanceBudget(token ctype.Addr) (*structs.RebalanceBudget, bool, error) {
+	return getRebalanceBudget(d.st, token)
+}
+
+// The "peerstreams" table
+
+// PutPeerOfflineTime saves how long the stream to the peer has been down while running
+func (d *DAL) PutPeerOfflineTime(peer ctype.Addr, offline time.Duration) error {
+	return upsertPeerOfflineTime(d.st, peer, offline)
+}
+
+func (d *DAL) GetPeerOfflineTime(peer ctype.Addr) (time.Duration, bool, error) {
+	return getPeerOfflineTime(d.st, peer)
+}
@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"strconv"
 	"strings"
 	"time"
 
@@ -26,8 +27,9 @@ import (
 )
 
 const (
-	listSep   = "," // separator for lists stored in a string column
-	separator = "|" // reserved character for keys construction
+	listSep   = ","  // separator for lists stored in a string column
+	errSep    = "\n" // separator for error messages stored in a string column
+	separator = "|"  // reserved character for keys construction
 )
 
 var (
@@ -163,6 +165,7 @@ func inClause(column string, num, start int) string {
 // The "channels" table.
 func insertChanWithTs(
 	st SqlStorage,
+	chainId uint64,
 	cid ctype.CidType,
 	peer ctype.Addr,
 	token *entity.TokenInfo,
@@ -180,8 +183,8 @@ func insertChanWithTs(
 	peerSimplex *rpc.SignedSimplexState) error {
 	q := `INSERT INTO channels (cid, peer, token, ledger, state, statets, opents,
 		openresp, onchainbalance, basesn, lastusedsn, lastackedsn,
-		lastnackedsn, selfsimplex, peersimplex) VALUES ($1, $2, $3, $4,
-		$5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)`
+		lastnackedsn, selfsimplex, peersimplex, chainid) VALUES ($1, $2, $3, $4,
+		$5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)`
 	openRespBytes, err := marshal(openResp)
 	if err != nil {
 		return err
@@ -206,12 +209,13 @@ func insertChanWithTs(
 		utils.GetTokenAddrStr(token), ctype.Addr2Hex(ledger),
 		state, stateTs, openTs, openRespBytes, balanceBytes,
 		baseSeqNum, lastUsedSeqNum, lastAckedSeqNum, lastNackedSeqNum,
-		selfSimplexBytes, peerSimplexBytes)
+		selfSimplexBytes, peerSimplexBytes, chainId)
 	return chkExec(res, err, 1, "insertChanWithTs")
 }
 
 func insertChan(
 	st SqlStorage,
+	chainId uint64,
 	cid ctype.CidType,
 	peer ctype.Addr,
 	token *entity.TokenInfo,
@@ -226,7 +230,7 @@ func insertChan(
 	selfSimplex *rpc.SignedSimplexState,
 	peerSimplex *rpc.SignedSimplexState) error {
 	ts := now()
-	return insertChanWithTs(st, cid, peer, token, ledger, state, ts, ts,
+	return insertChanWithTs(st, chainId, cid, peer, token, ledger, state, ts, ts,
 		openResp, onChainBalance, baseSeqNum, lastUsedSeqNum,
 		lastAckedSeqNum, lastNackedSeqNum, selfSimplex, peerSimplex)
 }
@@ -378,6 +382,87 @@ func getCidsByTokenAndState(st SqlStorage, token *entity.TokenInfo, state int) (
 	return cids, nil
 }
 
+func getCidPeerTokensByState(st SqlStorage, state int) ([]ctype.CidType, []ctype.Addr, []ctype.Addr, error) {
+	q := `SELECT cid, peer, token FROM channels WHERE state = $1`
+	rows, err := st.Query(q, state)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	defer rows.Close()
+	var cids []ctype.CidType
+	var peers, tokens []ctype.Addr
+	for rows.Next() {
+		var cidStr, peerStr, tokenStr string
+		err = rows.Scan(&cidStr, &peerStr, &tokenStr)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		cids = append(cids, ctype.Hex2Cid(cidStr))
+		peers = append(peers, ctype.Hex2Addr(peerStr))
+		tokens = append(tokens, ctype.Hex2Addr(tokenStr))
+	}
+	return cids, peers, tokens, nil
+}
+
+// getChansByFilter returns at most limit channels after skipping offset channels, filtered by
+// peer, token and state if they are not nil or zero, with the most recently opened channels first.
+func getChansByFilter(st SqlStorage, peer, token *ctype.Addr, state int, offset, limit int) (
+	[]ctype.CidType, []ctype.Addr, []ctype.Addr, []int, []*time.Time, []*time.Time, error) {
+	var conds []string
+	var args []interface{}
+	if peer != nil {
+		args = append(args, ctype.Addr2Hex(*peer))
+		conds = append(conds, fmt.Sprintf("peer = $%d", len(args)))
+	}
+	if token != nil {
+		args = append(args, ctype.Addr2Hex(*token))
+		conds = append(conds, fmt.Sprintf("token = $%d", len(args)))
+	}
+	if state != structs.ChanState_NULL {
+		args = append(args, state)
+		conds = append(conds, fmt.Sprintf("state = $%d", len(args)))
+	}
+	q := `SELECT cid, peer, token, state, statets, opents FROM channels`
+	if len(conds) > 0 {
+		q += " WHERE " + strings.Join(conds, " AND ")
+	}
+	args = append(args, limit, offset)
+	q += fmt.Sprintf(" ORDER BY opents DESC, cid ASC LIMIT $%d OFFSET $%d", len(args)-1, len(args))
+	rows, err := st.Query(q, args...)
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, err
+	}
+	defer rows.Close()
+
+	var cids []ctype.CidType
+	var peers, tokens []ctype.Addr
+	var states []int
+	var stateTses, openTses []*time.Time
+	for rows.Next() {
+		var cidStr, peerStr, tokenStr, stateTsStr, openTsStr string
+		var chanState int
+		err = rows.Scan(&cidStr, &peerStr, &tokenStr, &chanState, &stateTsStr, &openTsStr)
+		if err != nil {
+			return nil, nil, nil, nil, nil, nil, err
+		}
+		stateTs, err := str2Time(stateTsStr)
+		if err != nil {
+			return nil, nil, nil, nil, nil, nil, err
+		}
+		openTs, err := str2Time(openTsStr)
+		if err != nil {
+			return nil, nil, nil, nil, nil, nil, err
+		}
+		cids = append(cids, ctype.Hex2Cid(cidStr))
+		peers = append(peers, ctype.Hex2Addr(peerStr))
+		tokens = append(tokens, ctype.Hex2Addr(tokenStr))
+		states = append(states, chanState)
+		stateTses = append(stateTses, &stateTs)
+		openTses = append(openTses, &openTs)
+	}
+	return cids, peers, tokens, states, stateTses, openTses, nil
+}
+
 func countCidsByTokenAndState(st SqlStorage, token *entity.TokenInfo, state int) (int, error) {
 	q := `SELECT COUNT(*) FROM channels WHERE token = $1 AND state = $2`
 	var count int
@@ -510,23 +595,40 @@ func getChanForDeposit(st SqlStorage, cid ctype.CidType) (int, *entity.TokenInfo
 	return state, utils.GetTokenInfoFromAddress(ctype.Hex2Addr(token)), ctype.Hex2Addr(peer), ctype.Hex2Addr(ledger), found, err
 }
 
-func getCidByPeerToken(st SqlStorage, peer ctype.Addr, token *entity.TokenInfo) (ctype.CidType, bool, error) {
+func getCidByPeerToken(
+	st SqlStorage, peer ctype.Addr, token *entity.TokenInfo, chainId uint64) (ctype.CidType, bool, error) {
 	var cid string
-	q := `SELECT cid FROM channels WHERE peer = $1 AND token = $2`
-	err := st.QueryRow(q, ctype.Addr2Hex(peer), utils.GetTokenAddrStr(token)).Scan(&cid)
+	q := `SELECT cid FROM channels WHERE peer = $1 AND token = $2 AND chainid = $3`
+	err := st.QueryRow(q, ctype.Addr2Hex(peer), utils.GetTokenAddrStr(token), chainId).Scan(&cid)
 	found, err := chkQueryRow(err)
 	return ctype.Hex2Cid(cid), found, err
 }
 
-func getCidStateByPeerToken(st SqlStorage, peer ctype.Addr, token *entity.TokenInfo) (ctype.CidType, int, bool, error) {
+func getCidStateByPeerToken(
+	st SqlStorage, peer ctype.Addr, token *entity.TokenInfo, chainId uint64) (ctype.CidType, int, bool, error) {
 	var cid string
 	var state int
-	q := `SELECT cid, state FROM channels WHERE peer = $1 AND token = $2`
-	err := st.QueryRow(q, ctype.Addr2Hex(peer), utils.GetTokenAddrStr(token)).Scan(&cid, &state)
+	q := `SELECT cid, state FROM channels WHERE peer = $1 AND token = $2 AND chainid = $3`
+	err := st.QueryRow(q, ctype.Addr2Hex(peer), utils.GetTokenAddrStr(token), chainId).Scan(&cid, &state)
 	found, err := chkQueryRow(err)
 	return ctype.Hex2Cid(cid), state, found, err
 }
 
+// getChanChainId returns the chain of an open channel, or of a closed one if not found in channels
+func getChanChainId(st SqlStorage, cid ctype.CidType) (uint64, bool, error) {
+	var chainId uint64
+	q := `SELECT chainid FROM channels WHERE cid = $1`
+	err := st.QueryRow(q, ctype.Cid2Hex(cid)).Scan(&chainId)
+	found, err := chkQueryRow(err)
+	if found || err != nil {
+		return chainId, found, err
+	}
+	q = `SELECT chainid FROM closedchannels WHERE cid = $1`
+	err = st.QueryRow(q, ctype.Cid2Hex(cid)).Scan(&chainId)
+	found, err = chkQueryRow(err)
+	return chainId, found, err
+}
+
 func getSelfSimplex(st SqlStorage, cid ctype.CidType) (*entity.SimplexPaymentChannel, *rpc.SignedSimplexState, bool, error) {
 	var selfSimplexBytes []byte
 	q := `SELECT selfsimplex FROM channels WHERE cid = $1`
@@ -597,6 +699,51 @@ func getChanForBalance(st SqlStorage, cid ctype.CidType) (
 	return ctype.Hex2Addr(peer), onChainBalance, baseSeq, lastAckedSeq, selfSimplex, peerSimplex, found, err
 }
 
+// ChanForBalance is the intermediate data format to compute the balances of many channels
+type ChanForBalance struct {
+	Cid            ctype.CidType
+	Peer           ctype.Addr
+	Token          ctype.Addr
+	ChainId        uint64
+	OnChainBalance *structs.OnChainBalance
+	BaseSeq        uint64
+	LastAckedSeq   uint64
+	SelfSimplex    *entity.SimplexPaymentChannel
+	PeerSimplex    *entity.SimplexPaymentChannel
+}
+
+func getChansForBalanceByState(st SqlStorage, state int) ([]*ChanForBalance, error) {
+	q := `SELECT cid, peer, token, COALESCE(chainid, 0), onchainbalance, basesn, lastackedsn,
+		selfsimplex, peersimplex FROM channels WHERE state = $1`
+	rows, err := st.Query(q, state)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var chans []*ChanForBalance
+	for rows.Next() {
+		var cidStr, peerStr, tokenStr string
+		var onChainBalanceBytes, selfSimplexBytes, peerSimplexBytes []byte
+		ch := &ChanForBalance{}
+		err = rows.Scan(&cidStr, &peerStr, &tokenStr, &ch.ChainId, &onChainBalanceBytes,
+			&ch.BaseSeq, &ch.LastAckedSeq, &selfSimplexBytes, &peerSimplexBytes)
+		if err != nil {
+			return nil, err
+		}
+		ch.Cid, ch.Peer, ch.Token = ctype.Hex2Cid(cidStr), ctype.Hex2Addr(peerStr), ctype.Hex2Addr(tokenStr)
+		ch.OnChainBalance, err = unmarshalBalance(onChainBalanceBytes)
+		if err != nil {
+			return nil, err
+		}
+		ch.SelfSimplex, _, ch.PeerSimplex, _, err = unmarshalDuplexChannel(selfSimplexBytes, peerSimplexBytes)
+		if err != nil {
+			return nil, err
+		}
+		chans = append(chans, ch)
+	}
+	return chans, nil
+}
+
 func getOnChainBalance(st SqlStorage, cid ctype.CidType) (*structs.OnChainBalance, bool, error) {
 	var onChainBalanceBytes []byte
 	q := `SELECT onchainbalance FROM channels WHERE cid = $1`
@@ -836,17 +983,18 @@ func getCidTokensByPeer(st SqlStorage, peerAddr ctype.Addr) ([]ctype.CidType, []
 	return cids, tokens, nil
 }
 
-func getChanForClose(st SqlStorage, cid ctype.CidType) (ctype.Addr, *entity.TokenInfo, time.Time, bool, error) {
+func getChanForClose(st SqlStorage, cid ctype.CidType) (ctype.Addr, *entity.TokenInfo, time.Time, uint64, bool, error) {
 	var peer, token string
 	var openTsStr string
-	q := `SELECT peer, token, opents FROM channels WHERE cid = $1`
-	err := st.QueryRow(q, ctype.Cid2Hex(cid)).Scan(&peer, &token, &openTsStr)
+	var chainId uint64
+	q := `SELECT peer, token, opents, chainid FROM channels WHERE cid = $1`
+	err := st.QueryRow(q, ctype.Cid2Hex(cid)).Scan(&peer, &token, &openTsStr, &chainId)
 	found, err := chkQueryRow(err)
 	var opents time.Time
 	if found && err == nil {
 		opents, err = str2Time(openTsStr)
 	}
-	return ctype.Hex2Addr(peer), utils.GetTokenInfoFromAddress(ctype.Hex2Addr(token)), opents, found, err
+	return ctype.Hex2Addr(peer), utils.GetTokenInfoFromAddress(ctype.Hex2Addr(token)), opents, chainId, found, err
 }
 
 // intermediate data format for easier handling in auth
@@ -1037,11 +1185,12 @@ func insertClosedChan(
 	peer ctype.Addr,
 	token *entity.TokenInfo,
 	openTs time.Time,
-	closeTs time.Time) error {
-	q := `INSERT INTO closedchannels (cid, peer, token, opents, closets)
-		VALUES ($1, $2, $3, $4, $5)`
+	closeTs time.Time,
+	chainId uint64) error {
+	q := `INSERT INTO closedchannels (cid, peer, token, opents, closets, chainid)
+		VALUES ($1, $2, $3, $4, $5, $6)`
 	res, err := st.Exec(q, ctype.Cid2Hex(cid), ctype.Addr2Hex(peer),
-		utils.GetTokenAddrStr(token), openTs, closeTs)
+		utils.GetTokenAddrStr(token), openTs, closeTs, chainId)
 	return chkExec(res, err, 1, "insertClosedChan")
 }
 
@@ -1170,7 +1319,26 @@ func getAllPaymentInfoByCid(st SqlStorage, cid ctype.CidType) (
 		return nil, nil, nil, nil, nil, nil, nil, nil, err
 	}
 	defer rows.Close()
+	return getPaymentInfoFromRows(rows)
+}
+
+// getPaymentInfoByCidPage returns at most limit pays of the channel after skipping offset pays,
+// in the same reverse-chronological order as getAllPaymentInfoByCid.
+func getPaymentInfoByCidPage(st SqlStorage, cid ctype.CidType, offset, limit int) (
+	[]ctype.PayIDType, []*entity.ConditionalPay, []*any.Any, []ctype.CidType, []int, []ctype.CidType, []int, []*time.Time, error) {
+	q := `SELECT payid, pay, paynote, incid, instate, outcid, outstate, createts FROM payments WHERE incid = $1 OR outcid = $2 ORDER BY createts DESC, payid ASC LIMIT $3 OFFSET $4`
+	cidStr := ctype.Cid2Hex(cid)
+	rows, err := st.Query(q, cidStr, cidStr, limit, offset)
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, nil, nil, err
+	}
+	defer rows.Close()
+	return getPaymentInfoFromRows(rows)
+}
 
+func getPaymentInfoFromRows(rows *sql.Rows) (
+	[]ctype.PayIDType, []*entity.ConditionalPay, []*any.Any, []ctype.CidType, []int, []ctype.CidType, []int, []*time.Time, error) {
+	var err error
 	var payIDs []ctype.PayIDType
 	var pays []*entity.ConditionalPay
 	var notes []*any.Any
@@ -1407,6 +1575,12 @@ func getPaysForAuthAck(st SqlStorage, payIDs []ctype.PayIDType, isOut bool) ([]*
 	return ret, nil
 }
 
+func updatePayIngress(st SqlStorage, payID ctype.PayIDType, cid ctype.CidType, state int) error {
+	q := `UPDATE payments SET incid = $1, instate = $2 WHERE payid = $3`
+	res, err := st.Exec(q, ctype.Cid2Hex(cid), state, ctype.PayID2Hex(payID))
+	return chkExec(res, err, 1, "updatePayIngress")
+}
+
 func updatePayIngressState(st SqlStorage, payID ctype.PayIDType, state int) error {
 	q := `UPDATE payments SET instate = $1 WHERE payid = $2`
 	res, err := st.Exec(q, state, ctype.PayID2Hex(payID))
@@ -1452,6 +1626,47 @@ func countPayments(st SqlStorage) (int, error) {
 	return count, nil
 }
 
+func countPaymentsByCid(st SqlStorage, cid ctype.CidType) (int, error) {
+	var count int
+	q := `SELECT COUNT(*) FROM payments WHERE incid = $1 OR outcid = $2`
+	cidStr := ctype.Cid2Hex(cid)
+	err := st.QueryRow(q, cidStr, cidStr).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// countPaymentsByOutStatesPerPeer counts the pays in the out states per egress channel peer
+func countPaymentsByOutStatesPerPeer(st SqlStorage, states []int) (map[ctype.Addr]int, error) {
+	counts := make(map[ctype.Addr]int)
+	if len(states) == 0 {
+		return counts, nil
+	}
+	q := fmt.Sprintf(`SELECT c.peer, COUNT(*) FROM payments p JOIN channels c ON p.outcid = c.cid
+		WHERE %s GROUP BY c.peer`, inClause("p.outstate", len(states), 1))
+	args := make([]interface{}, len(states))
+	for i, state := range states {
+		args[i] = state
+	}
+	rows, err := st.Query(q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var peer string
+	var count int
+	for rows.Next() {
+		err = rows.Scan(&peer, &count)
+		if err != nil {
+			return nil, err
+		}
+		counts[ctype.Hex2Addr(peer)] = count
+	}
+	return counts, nil
+}
+
 // The "paydelegation" table.
 func insertDelegatedPay(
 	st SqlStorage,
@@ -2359,8 +2574,9 @@ func insertDeposit(
 	state int,
 	txhash string,
 	errmsg string) error {
-	q := `INSERT INTO deposit (uuid, cid, topeer, amount, refill, deadline, state, txhash, errmsg) 
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+	// the job is on the chain of the channel, or on the primary chain if the channel is not found
+	q := `INSERT INTO deposit (uuid, cid, topeer, amount, refill, deadline, state, txhash, errmsg, chainid)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, COALESCE((SELECT chainid FROM channels WHERE cid = $2), 0))`
 	res, err := st.Exec(q, uuid, ctype.Cid2Hex(cid), topeer, amount.String(), refill, deadline, state, txhash, errmsg)
 	return chkExec(res, err, 1, "insertDeposit")
 }
@@ -2446,9 +2662,11 @@ func getDepositJobByTxHash(st SqlStorage, txhash string) (*structs.DepositJob, b
 	return job, found, err
 }
 
-func getAllDepositJobsByState(st SqlStorage, state int) ([]*structs.DepositJob, error) {
-	q := `SELECT uuid, cid, topeer, amount, refill, deadline, txhash, errmsg FROM deposit WHERE state = $1`
-	rows, err := st.Query(q, state)
+// getAllDepositJobsByState returns the jobs of channels on the given chain
+func getAllDepositJobsByState(st SqlStorage, chainId uint64, state int) ([]*structs.DepositJob, error) {
+	q := `SELECT uuid, cid, topeer, amount, refill, deadline, txhash, errmsg
+		FROM deposit WHERE state = $1 AND chainid = $2`
+	rows, err := st.Query(q, state, chainId)
 	if err != nil {
 		return nil, err
 	}
@@ -2566,9 +2784,9 @@ func getAllRunningDepositJobs(st SqlStorage) ([]*structs.DepositJob, error) {
 	return jobs, nil
 }
 
-func getAllSubmittedDepositTxHashes(st SqlStorage) ([]string, error) {
-	q := `SELECT DISTINCT txhash FROM deposit WHERE state = $1`
-	rows, err := st.Query(q, structs.DepositState_TX_SUBMITTED)
+func getAllSubmittedDepositTxHashes(st SqlStorage, chainId uint64) ([]string, error) {
+	q := `SELECT DISTINCT txhash FROM deposit WHERE state = $1 AND chainid = $2`
+	rows, err := st.Query(q, structs.DepositState_TX_SUBMITTED, chainId)
 	if err != nil {
 		return nil, err
 	}
@@ -2647,7 +2865,7 @@ func updateDepositsErrMsg(st SqlStorage, uuids []string, errmsg string) error {
 
 func updateDepositStatesByTxHashAndCid(st SqlStorage, txhash string, cid ctype.CidType, state int) error {
 	q := `UPDATE deposit SET state = $1 WHERE txhash = $2 AND cid = $3`
-	_, err := st.Exec(q, structs.DepositState_SUCCEEDED, txhash, ctype.Cid2Hex(cid))
+	_, err := st.Exec(q, state, txhash, ctype.Cid2Hex(cid))
 	return err
 }
 
@@ -2713,3 +2931,677 @@ func deleteLease(st SqlStorage, id string) error {
 	res, err := st.Exec(q, id)
 	return chkExec(res, err, 1, "deleteLease")
 }
+
+// The "appsessions" table
+func insertAppSession(st SqlStorage, s *structs.AppSession) error {
+	players := make([]string, 0, len(s.Players))
+	for _, p := range s.Players {
+		players = append(players, ctype.Addr2Hex(p))
+	}
+	q := `INSERT INTO appsessions (sessionid, type, nonce, bytecode, constructor, players, deployedaddr,
+		onchaintimeout, seqnum, stateproof, watchblock, createts)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
+	res, err := st.Exec(q, s.ID, s.Type, strconv.FormatUint(s.Nonce, 10), s.ByteCode, s.Constructor,
+		strings.Join(players, listSep), ctype.Addr2Hex(s.DeployedAddr), s.OnChainTimeout,
+		s.SeqNum, s.StateProof, s.WatchBlock, now())
+	return chkExec(res, err, 1, "insertAppSession")
+}
+
+const appSessionColumns = `sessionid, type, nonce, bytecode, constructor, players, deployedaddr,
+	onchaintimeout, seqnum, stateproof, watchblock, createts`
+
+type sqlScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanAppSession(row sqlScanner) (*structs.AppSession, error) {
+	var nonce, players, deployedAddr, createTsStr string
+	s := &structs.AppSession{}
+	err := row.Scan(&s.ID, &s.Type, &nonce, &s.ByteCode, &s.Constructor, &players, &deployedAddr,
+		&s.OnChainTimeout, &s.SeqNum, &s.StateProof, &s.WatchBlock, &createTsStr)
+	if err != nil {
+		return nil, err
+	}
+	s.Nonce, err = strconv.ParseUint(nonce, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid app session nonce %s: %w", nonce, err)
+	}
+	if players != "" {
+		for _, p := range strings.Split(players, listSep) {
+			s.Players = append(s.Players, ctype.Hex2Addr(p))
+		}
+	}
+	s.DeployedAddr = ctype.Hex2Addr(deployedAddr)
+	s.CreateTs, err = str2Time(createTsStr)
+	return s, err
+}
+
+func getAppSession(st SqlStorage, sessionID string) (*structs.AppSession, bool, error) {
+	q := fmt.Sprintf(`SELECT %s FROM appsessions WHERE sessionid = $1`, appSessionColumns)
+	s, err := scanAppSession(st.QueryRow(q, sessionID))
+	found, err := chkQueryRow(err)
+	return s, found, err
+}
+
+func getAllAppSessions(st SqlStorage) ([]*structs.AppSession, error) {
+	q := fmt.Sprintf(`SELECT %s FROM appsessions ORDER BY createts`, appSessionColumns)
+	rows, err := st.Query(q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sessions []*structs.AppSession
+	for rows.Next() {
+		s, err := scanAppSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, s)
+	}
+	return sessions, nil
+}
+
+func updateAppSessionStateProof(st SqlStorage, sessionID string, seqNum uint64, stateProof []byte) error {
+	q := `UPDATE appsessions SET seqnum = $1, stateproof = $2 WHERE sessionid = $3`
+	res, err := st.Exec(q, seqNum, stateProof, sessionID)
+	return chkExec(res, err, 1, "updateAppSessionStateProof")
+}
+
+func updateAppSessionDeployedAddr(st SqlStorage, sessionID string, deployedAddr ctype.Addr) error {
+	q := `UPDATE appsessions SET deployedaddr = $1 WHERE sessionid = $2`
+	res, err := st.Exec(q, ctype.Addr2Hex(deployedAddr), sessionID)
+	return chkExec(res, err, 1, "updateAppSessionDeployedAddr")
+}
+
+func updateAppSessionWatchBlock(st SqlStorage, sessionID string, watchBlock uint64) error {
+	q := `UPDATE appsessions SET watchblock = $1 WHERE sessionid = $2`
+	res, err := st.Exec(q, watchBlock, sessionID)
+	return chkExec(res, err, 1, "updateAppSessionWatchBlock")
+}
+
+func deleteAppSession(st SqlStorage, sessionID string) error {
+	q := `DELETE FROM appsessions WHERE sessionid = $1`
+	res, err := st.Exec(q, sessionID)
+	return chkExec(res, err, 1, "deleteAppSession")
+}
+
+// The "appdisputes" table
+func upsertAppDispute(st SqlStorage, sessionID string, seqNum int) error {
+	q := `INSERT INTO appdisputes (sessionid, seqnum) VALUES ($1, $2)
+		ON CONFLICT (sessionid) DO UPDATE SET seqnum = excluded.seqnum`
+	res, err := st.Exec(q, sessionID, seqNum)
+	return chkExec(res, err, 1, "upsertAppDispute")
+}
+
+func getAppDispute(st SqlStorage, sessionID string) (int, bool, error) {
+	var seqNum int
+	q := `SELECT seqnum FROM appdisputes WHERE sessionid = $1`
+	err := st.QueryRow(q, sessionID).Scan(&seqNum)
+	found, err := chkQueryRow(err)
+	return seqNum, found, err
+}
+
+func deleteAppDispute(st SqlStorage, sessionID string) error {
+	q := `DELETE FROM appdisputes WHERE sessionid = $1`
+	res, err := st.Exec(q, sessionID)
+	return chkExec(res, err, 1, "deleteAppDispute")
+}
+
+// The "paytrace" table
+func insertPayTrace(st SqlStorage, span *rpc.PayTraceSpan) error {
+	q := `INSERT INTO paytrace (payid, traceid, prevhop, nexthop, recvts, fwdts, receiptts, errs)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	res, err := st.Exec(q, ctype.Bytes2Hex(span.GetPayId()), span.GetTraceId(),
+		ctype.Bytes2Hex(span.GetPrevHop()), ctype.Bytes2Hex(span.GetNextHop()),
+		span.GetRecvTs(), span.GetFwdTs(), span.GetReceiptTs(), strings.Join(span.GetErrors(), errSep))
+	return chkExec(res, err, 1, "insertPayTrace")
+}
+
+func getPayTrace(st SqlStorage, payID ctype.PayIDType) (*rpc.PayTraceSpan, bool, error) {
+	var prevHop, nextHop, errs string
+	span := &rpc.PayTraceSpan{PayId: payID.Bytes()}
+	q := `SELECT traceid, prevhop, nexthop, recvts, fwdts, receiptts, errs FROM paytrace WHERE payid = $1`
+	err := st.QueryRow(q, ctype.PayID2Hex(payID)).Scan(
+		&span.TraceId, &prevHop, &nextHop, &span.RecvTs, &span.FwdTs, &span.ReceiptTs, &errs)
+	found, err := chkQueryRow(err)
+	if !found || err != nil {
+		return nil, found, err
+	}
+	if prevHop != "" {
+		span.PrevHop = ctype.Hex2Bytes(prevHop)
+	}
+	if nextHop != "" {
+		span.NextHop = ctype.Hex2Bytes(nextHop)
+	}
+	if errs != "" {
+		span.Errors = strings.Split(errs, errSep)
+	}
+	return span, true, nil
+}
+
+func updatePayTraceFwd(st SqlStorage, payID ctype.PayIDType, nextHop ctype.Addr, fwdTs int64) error {
+	q := `UPDATE paytrace SET nexthop = $1, fwdts = $2 WHERE payid = $3`
+	res, err := st.Exec(q, ctype.Addr2Hex(nextHop), fwdTs, ctype.PayID2Hex(payID))
+	return chkExec(res, err, 1, "updatePayTraceFwd")
+}
+
+func updatePayTraceReceipt(st SqlStorage, payID ctype.PayIDType, receiptTs int64) error {
+	q := `UPDATE paytrace SET receiptts = $1 WHERE payid = $2`
+	res, err := st.Exec(q, receiptTs, ctype.PayID2Hex(payID))
+	return chkExec(res, err, 1, "updatePayTraceReceipt")
+}
+
+func updatePayTraceErrors(st SqlStorage, payID ctype.PayIDType, errs []string) error {
+	q := `UPDATE paytrace SET errs = $1 WHERE payid = $2`
+	res, err := st.Exec(q, strings.Join(errs, errSep), ctype.PayID2Hex(payID))
+	return chkExec(res, err, 1, "updatePayTraceErrors")
+}
+
+// deletePayTracesBefore deletes the spans neither received nor forwarded since ts
+func deletePayTracesBefore(st SqlStorage, ts time.Time) error {
+	q := `DELETE FROM paytrace WHERE recvts < $1 AND fwdts < $1`
+	_, err := st.Exec(q, ts.UnixNano())
+	return err
+}
+
+// The "payfees" table
+func upsertPayFee(st SqlStorage, payID ctype.PayIDType, cid ctype.CidType, fee *big.Int) error {
+	q := `INSERT INTO payfees (payid, cid, fee) VALUES ($1, $2, $3)
+		ON CONFLICT (payid, cid) DO UPDATE SET fee = excluded.fee`
+	res, err := st.Exec(q, ctype.PayID2Hex(payID), ctype.Cid2Hex(cid), fee.String())
+	return chkExec(res, err, 1, "upsertPayFee")
+}
+
+func getPayFee(st SqlStorage, payID ctype.PayIDType, cid ctype.CidType) (*big.Int, bool, error) {
+	var feeStr string
+	q := `SELECT fee FROM payfees WHERE payid = $1 AND cid = $2`
+	err := st.QueryRow(q, ctype.PayID2Hex(payID), ctype.Cid2Hex(cid)).Scan(&feeStr)
+	found, err := chkQueryRow(err)
+	if !found || err != nil {
+		return nil, found, err
+	}
+	fee, ok := new(big.Int).SetString(feeStr, 10)
+	if !ok {
+		return nil, true, fmt.Errorf("invalid fee %s", feeStr)
+	}
+	return fee, true, nil
+}
+
+// getPayFees returns the relay fees locked with the pay in its ingress and egress channels
+func getPayFees(st SqlStorage, payID ctype.PayIDType) (*big.Int, *big.Int, bool, error) {
+	q := `SELECT p.incid, p.outcid, f.cid, f.fee FROM payments p JOIN payfees f ON f.payid = p.payid
+		WHERE p.payid = $1`
+	rows, err := st.Query(q, ctype.PayID2Hex(payID))
+	if err != nil {
+		return nil, nil, false, err
+	}
+	defer rows.Close()
+
+	feeIn, feeOut := new(big.Int), new(big.Int)
+	found := false
+	var inCid, outCid, cid, feeStr string
+	for rows.Next() {
+		err = rows.Scan(&inCid, &outCid, &cid, &feeStr)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		fee, ok := new(big.Int).SetString(feeStr, 10)
+		if !ok {
+			return nil, nil, false, fmt.Errorf("invalid fee %s", feeStr)
+		}
+		if cid == inCid {
+			feeIn = fee
+			found = true
+		} else if cid == outCid {
+			feeOut = fee
+			found = true
+		}
+	}
+	return feeIn, feeOut, found, nil
+}
+
+// The "payhistory" table
+func upsertPayHistoryItem(st SqlStorage, item *structs.PayHistoryItem) error {
+	q := `INSERT INTO payhistory (payid, src, dest, token, amt, status, createts, updatets)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (payid) DO UPDATE SET status = excluded.status, updatets = excluded.updatets`
+	res, err := st.Exec(q, ctype.PayID2Hex(item.PayID), ctype.Addr2Hex(item.Src), ctype.Addr2Hex(item.Dest),
+		ctype.Addr2Hex(item.Token), item.Amt.String(), item.Status, item.CreateTs, now())
+	return chkExec(res, err, 1, "upsertPayHistoryItem")
+}
+
+const payHistoryColumns = `payid, src, dest, token, amt, status, createts, updatets`
+
+func scanPayHistoryItem(row sqlScanner) (*structs.PayHistoryItem, error) {
+	var payID, src, dest, token, amt, createTsStr, updateTsStr string
+	item := &structs.PayHistoryItem{}
+	err := row.Scan(&payID, &src, &dest, &token, &amt, &item.Status, &createTsStr, &updateTsStr)
+	if err != nil {
+		return nil, err
+	}
+	item.PayID = ctype.Hex2PayID(payID)
+	item.Src = ctype.Hex2Addr(src)
+	item.Dest = ctype.Hex2Addr(dest)
+	item.Token = ctype.Hex2Addr(token)
+	var ok bool
+	item.Amt, ok = new(big.Int).SetString(amt, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid pay history amt %s", amt)
+	}
+	item.CreateTs, err = str2Time(createTsStr)
+	if err != nil {
+		return nil, err
+	}
+	item.UpdateTs, err = str2Time(updateTsStr)
+	return item, err
+}
+
+func getPayHistoryItem(st SqlStorage, payID ctype.PayIDType) (*structs.PayHistoryItem, bool, error) {
+	q := fmt.Sprintf(`SELECT %s FROM payhistory WHERE payid = $1`, payHistoryColumns)
+	item, err := scanPayHistoryItem(st.QueryRow(q, ctype.PayID2Hex(payID)))
+	found, err := chkQueryRow(err)
+	return item, found, err
+}
+
+func getOldestPayHistoryTs(st SqlStorage, statuses []int) (time.Time, bool, error) {
+	var ts time.Time
+	if len(statuses) == 0 {
+		return ts, false, nil
+	}
+	q := fmt.Sprintf(`SELECT createts FROM payhistory WHERE %s ORDER BY createts ASC LIMIT 1`,
+		inClause("status", len(statuses), 1))
+	args := make([]interface{}, len(statuses))
+	for i, status := range statuses {
+		args[i] = status
+	}
+	var tsStr string
+	err := st.QueryRow(q, args...).Scan(&tsStr)
+	found, err := chkQueryRow(err)
+	if found && err == nil {
+		ts, err = str2Time(tsStr)
+	}
+	return ts, found, err
+}
+
+func getPayHistoryItems(st SqlStorage, filter *structs.PayHistoryFilter) ([]*structs.PayHistoryItem, error) {
+	var conds []string
+	var args []interface{}
+	if filter.Counterparty != ctype.ZeroAddr {
+		cp := ctype.Addr2Hex(filter.Counterparty)
+		conds = append(conds, fmt.Sprintf("(src = $%d OR dest = $%d)", len(args)+1, len(args)+2))
+		args = append(args, cp, cp)
+	}
+	if filter.Token != nil {
+		conds = append(conds, fmt.Sprintf("token = $%d", len(args)+1))
+		args = append(args, ctype.Addr2Hex(*filter.Token))
+	}
+	if filter.Status != 0 {
+		conds = append(conds, fmt.Sprintf("status = $%d", len(args)+1))
+		args = append(args, filter.Status)
+	}
+	if !filter.Since.IsZero() {
+		conds = append(conds, fmt.Sprintf("createts >= $%d", len(args)+1))
+		args = append(args, filter.Since)
+	}
+	if !filter.Until.IsZero() {
+		conds = append(conds, fmt.Sprintf("createts < $%d", len(args)+1))
+		args = append(args, filter.Until)
+	}
+	q := fmt.Sprintf(`SELECT %s FROM payhistory`, payHistoryColumns)
+	if len(conds) > 0 {
+		q += " WHERE " + strings.Join(conds, " AND ")
+	}
+	q += " ORDER BY createts DESC, payid ASC"
+	if filter.Limit > 0 {
+		q += fmt.Sprintf(" LIMIT $%d", len(args)+1)
+		args = append(args, filter.Limit)
+	}
+	rows, err := st.Query(q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []*structs.PayHistoryItem
+	for rows.Next() {
+		item, err := scanPayHistoryItem(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// The "txs" table
+func insertTx(st SqlStorage, tx *structs.TxRecord) error {
+	q := `INSERT INTO txs (txhash, chainid, sender, nonce, state, rawtx, hashes, description, createts, updatets)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+	ts := now()
+	res, err := st.Exec(q, tx.TxHash, tx.ChainId, ctype.Addr2Hex(tx.Sender), tx.Nonce, tx.State, tx.RawTx,
+		strings.Join(tx.Hashes, listSep), tx.Description, ts, ts)
+	return chkExec(res, err, 1, "insertTx")
+}
+
+const txColumns = `txhash, chainid, sender, nonce, state, rawtx, hashes, description, createts, updatets`
+
+func scanTx(row sqlScanner) (*structs.TxRecord, error) {
+	var sender, hashes, createTsStr, updateTsStr string
+	tx := &structs.TxRecord{}
+	err := row.Scan(&tx.TxHash, &tx.ChainId, &sender, &tx.Nonce, &tx.State, &tx.RawTx, &hashes, &tx.Description,
+		&createTsStr, &updateTsStr)
+	if err != nil {
+		return nil, err
+	}
+	tx.Sender = ctype.Hex2Addr(sender)
+	tx.Hashes = strings.Split(hashes, listSep)
+	tx.CreateTs, err = str2Time(createTsStr)
+	if err != nil {
+		return nil, err
+	}
+	tx.UpdateTs, err = str2Time(updateTsStr)
+	return tx, err
+}
+
+func getTx(st SqlStorage, txHash string) (*structs.TxRecord, bool, error) {
+	q := fmt.Sprintf(`SELECT %s FROM txs WHERE txhash = $1`, txColumns)
+	tx, err := scanTx(st.QueryRow(q, txHash))
+	found, err := chkQueryRow(err)
+	return tx, found, err
+}
+
+func getAllTxsByState(st SqlStorage, chainId uint64, state int) ([]*structs.TxRecord, error) {
+	q := fmt.Sprintf(`SELECT %s FROM txs WHERE chainid = $1 AND state = $2 ORDER BY nonce`, txColumns)
+	rows, err := st.Query(q, chainId, state)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var txs []*structs.TxRecord
+	for rows.Next() {
+		tx, err := scanTx(rows)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, tx)
+	}
+	return txs, nil
+}
+
+func updateTxRebroadcast(st SqlStorage, txHash string, rawTx []byte, hashes []string) error {
+	q := `UPDATE txs SET rawtx = $1, hashes = $2, updatets = $3 WHERE txhash = $4`
+	res, err := st.Exec(q, rawTx, strings.Join(hashes, listSep), now(), txHash)
+	return chkExec(res, err, 1, "updateTxRebroadcast")
+}
+
+func updateTxState(st SqlStorage, txHash string, state int) error {
+	q := `UPDATE txs SET state = $1 WHERE txhash = $2`
+	res, err := st.Exec(q, state, txHash)
+	return chkExec(res, err, 1, "updateTxState")
+}
+
+func deleteFinishedTxsBefore(st SqlStorage, ts time.Time) error {
+	q := `DELETE FROM txs WHERE state != $1 AND updatets < $2`
+	_, err := st.Exec(q, structs.TxState_PENDING, ts)
+	return err
+}
+
+func insertEventLog(st SqlStorage, record *structs.EventLogRecord) error {
+	q := `INSERT INTO eventlogs (blkhash, logindex, chainid, blknum, event, txhash, rawlog)
+		VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (blkhash, logindex) DO NOTHING`
+	_, err := st.Exec(q, record.BlkHash, record.LogIndex, record.ChainId, record.BlkNum, record.Event,
+		record.TxHash, record.RawLog)
+	return err
+}
+
+func getEventLogsSince(st SqlStorage, chainId uint64, blkNum uint64) ([]*structs.EventLogRecord, error) {
+	q := `SELECT blkhash, logindex, chainid, blknum, event, txhash, rawlog FROM eventlogs
+		WHERE chainid = $1 AND blknum >= $2 ORDER BY blknum, logindex`
+	rows, err := st.Query(q, chainId, blkNum)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var records []*structs.EventLogRecord
+	for rows.Next() {
+		record := &structs.EventLogRecord{}
+		err = rows.Scan(&record.BlkHash, &record.LogIndex, &record.ChainId, &record.BlkNum, &record.Event,
+			&record.TxHash, &record.RawLog)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func updateEventLogBlock(
+	st SqlStorage, blkHash string, logIndex uint, newBlkHash string, newLogIndex uint, newBlkNum uint64) error {
+	q := `UPDATE eventlogs SET blkhash = $1, logindex = $2, blknum = $3 WHERE blkhash = $4 AND logindex = $5`
+	res, err := st.Exec(q, newBlkHash, newLogIndex, newBlkNum, blkHash, logIndex)
+	return chkExec(res, err, 1, "updateEventLogBlock")
+}
+
+func deleteEventLog(st SqlStorage, blkHash string, logIndex uint) error {
+	q := `DELETE FROM eventlogs WHERE blkhash = $1 AND logindex = $2`
+	res, err := st.Exec(q, blkHash, logIndex)
+	return chkExec(res, err, 1, "deleteEventLog")
+}
+
+func deleteEventLogsBefore(st SqlStorage, chainId uint64, blkNum uint64) error {
+	q := `DELETE FROM eventlogs WHERE chainid = $1 AND blknum < $2`
+	_, err := st.Exec(q, chainId, blkNum)
+	return err
+}
+
+// The "acctentries" table
+func insertAcctRecord(st SqlStorage, record *structs.AcctRecord) error {
+	sums := make(map[ctype.Addr]*big.Int)
+	for _, p := range record.Postings {
+		if sums[p.Token] == nil {
+			sums[p.Token] = new(big.Int)
+		}
+		sums[p.Token].Add(sums[p.Token], p.Amt)
+	}
+	for token, sum := range sums {
+		if sum.Sign() != 0 {
+			return fmt.Errorf("unbalanced acct entry %s token %x sum %s", record.EntryID, token, sum)
+		}
+	}
+	q := `INSERT INTO acctentries (entryid, account, token, amt, memo, ts)
+		VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT (entryid, account, token) DO NOTHING`
+	for _, p := range record.Postings {
+		_, err := st.Exec(q, record.EntryID, p.Account, ctype.Addr2Hex(p.Token), p.Amt.String(), record.Memo, record.Ts)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func deleteAcctRecord(st SqlStorage, entryID string) error {
+	q := `DELETE FROM acctentries WHERE entryid = $1`
+	_, err := st.Exec(q, entryID)
+	return err
+}
+
+// getAcctRecords returns entries with ts in [since, until), oldest first
+func getAcctRecords(st SqlStorage, since, until time.Time) ([]*structs.AcctRecord, error) {
+	q := `SELECT entryid, account, token, amt, memo, ts FROM acctentries
+		WHERE ts >= $1 AND ts < $2 ORDER BY ts, entryid, account, token`
+	rows, err := st.Query(q, since, until)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var records []*structs.AcctRecord
+	var record *structs.AcctRecord
+	for rows.Next() {
+		var entryID, account, token, amtStr, memo, tsStr string
+		err = rows.Scan(&entryID, &account, &token, &amtStr, &memo, &tsStr)
+		if err != nil {
+			return nil, err
+		}
+		amt, ok := new(big.Int).SetString(amtStr, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid acct entry %s amt %s", entryID, amtStr)
+		}
+		if record == nil || record.EntryID != entryID {
+			ts, err2 := str2Time(tsStr)
+			if err2 != nil {
+				return nil, err2
+			}
+			record = &structs.AcctRecord{EntryID: entryID, Memo: memo, Ts: ts}
+			records = append(records, record)
+		}
+		record.Postings = append(record.Postings, &structs.AcctPosting{
+			Account: account,
+			Token:   ctype.Hex2Addr(token),
+			Amt:     amt,
+		})
+	}
+	return records, nil
+}
+
+// The "exitjobs" table
+func insertExitJob(st SqlStorage, job *structs.ExitJob) error {
+	q := `INSERT INTO exitjobs (cid, reason, state, finalizeblk, errmsg, createts, updatets)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	ts := now()
+	res, err := st.Exec(q, ctype.Cid2Hex(job.Cid), job.Reason, job.State, job.FinalizeBlk, job.ErrMsg, ts, ts)
+	return chkExec(res, err, 1, "insertExitJob")
+}
+
+func updateExitJob(st SqlStorage, cid ctype.CidType, state int, finalizeBlk uint64, errMsg string) error {
+	q := `UPDATE exitjobs SET state = $1, finalizeblk = $2, errmsg = $3, updatets = $4 WHERE cid = $5`
+	res, err := st.Exec(q, state, finalizeBlk, errMsg, now(), ctype.Cid2Hex(cid))
+	return chkExec(res, err, 1, "updateExitJob")
+}
+
+const exitJobColumns = `cid, reason, state, finalizeblk, errmsg, createts, updatets`
+
+func scanExitJob(row sqlScanner) (*structs.ExitJob, error) {
+	var cid, createTsStr, updateTsStr string
+	job := &structs.ExitJob{}
+	err := row.Scan(&cid, &job.Reason, &job.State, &job.FinalizeBlk, &job.ErrMsg, &createTsStr, &updateTsStr)
+	if err != nil {
+		return nil, err
+	}
+	job.Cid = ctype.Hex2Cid(cid)
+	job.CreateTs, err = str2Time(createTsStr)
+	if err != nil {
+		return nil, err
+	}
+	job.UpdateTs, err = str2Time(updateTsStr)
+	return job, err
+}
+
+func getExitJob(st SqlStorage, cid ctype.CidType) (*structs.ExitJob, bool, error) {
+	q := fmt.Sprintf(`SELECT %s FROM exitjobs WHERE cid = $1`, exitJobColumns)
+	job, err := scanExitJob(st.QueryRow(q, ctype.Cid2Hex(cid)))
+	found, err := chkQueryRow(err)
+	return job, found, err
+}
+
+func getAllExitJobs(st SqlStorage) ([]*structs.ExitJob, error) {
+	q := fmt.Sprintf(`SELECT %s FROM exitjobs ORDER BY createts`, exitJobColumns)
+	rows, err := st.Query(q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var jobs []*structs.ExitJob
+	for rows.Next() {
+		job, err := scanExitJob(rows)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+// The "payclearjobs" table
+func upsertPayClearJob(st SqlStorage, job *structs.PayClearJob) error {
+	q := `INSERT INTO payclearjobs (payid, cid, step, blknum) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (payid) DO UPDATE SET cid = excluded.cid, step = excluded.step, blknum = excluded.blknum`
+	res, err := st.Exec(q, ctype.PayID2Hex(job.PayID), ctype.Cid2Hex(job.Cid), job.Step, job.BlkNum)
+	return chkExec(res, err, 1, "upsertPayClearJob")
+}
+
+func deletePayClearJob(st SqlStorage, payID ctype.PayIDType) error {
+	q := `DELETE FROM payclearjobs WHERE payid = $1`
+	res, err := st.Exec(q, ctype.PayID2Hex(payID))
+	return chkExec(res, err, 1, "deletePayClearJob")
+}
+
+func getAllPayClearJobs(st SqlStorage) ([]*structs.PayClearJob, error) {
+	q := `SELECT payid, cid, step, blknum FROM payclearjobs`
+	rows, err := st.Query(q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var jobs []*structs.PayClearJob
+	var payID, cid string
+	for rows.Next() {
+		job := &structs.PayClearJob{}
+		err = rows.Scan(&payID, &cid, &job.Step, &job.BlkNum)
+		if err != nil {
+			return nil, err
+		}
+		job.PayID = ctype.Hex2PayID(payID)
+		job.Cid = ctype.Hex2Cid(cid)
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+// The "rebalancebudgets" table
+func upsertRebalanceBudget(st SqlStorage, budget *structs.RebalanceBudget) error {
+	q := `INSERT INTO rebalancebudgets (token, day, spent, feespent) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (token) DO UPDATE SET day = excluded.day, spent = excluded.spent, feespent = excluded.feespent`
+	res, err := st.Exec(q, ctype.Addr2Hex(budget.Token), budget.Day, budget.Spent.String(), budget.FeeSpent.String())
+	return chkExec(res, err, 1, "upsertRebalanceBudget")
+}
+
+func getRebalanceBudget(st SqlStorage, token ctype.Addr) (*structs.RebalanceBudget, bool, error) {
+	var spent, feeSpent string
+	budget := &structs.RebalanceBudget{Token: token}
+	q := `SELECT day, spent, feespent FROM rebalancebudgets WHERE token = $1`
+	err := st.QueryRow(q, ctype.Addr2Hex(token)).Scan(&budget.Day, &spent, &feeSpent)
+	found, err := chkQueryRow(err)
+	if !found || err != nil {
+		return nil, found, err
+	}
+	var ok bool
+	if budget.Spent, ok = new(big.Int).SetString(spent, 10); !ok {
+		return nil, false, fmt.Errorf("invalid spent value: %s", spent)
+	}
+	if budget.FeeSpent, ok = new(big.Int).SetString(feeSpent, 10); !ok {
+		return nil, false, fmt.Errorf("invalid fee spent value: %s", feeSpent)
+	}
+	return budget, true, nil
+}
+
+// The "peerstreams" table
+func upsertPeerOfflineTime(st SqlStorage, peer ctype.Addr, offline time.Duration) error {
+	q := `INSERT INTO peerstreams (peer, offlinesecs) VALUES ($1, $2)
+		ON CONFLICT (peer) DO UPDATE SET offlinesecs = excluded.offlinesecs`
+	res, err := st.Exec(q, ctype.Addr2Hex(peer), int64(offline/time.Second))
+	return chkExec(res, err, 1, "upsertPeerOfflineTime")
+}
+
+func getPeerOfflineTime(st SqlStorage, peer ctype.Addr) (time.Duration, bool, error) {
+	var secs int64
+	q := `SELECT offlinesecs FROM peerstreams WHERE peer = $1`
+	err := st.QueryRow(q, ctype.Addr2Hex(peer)).Scan(&secs)
+	found, err := chkQueryRow(err)
+	return time.Duration(secs) * time.Second, found, err
+}
@@ -5,6 +5,7 @@
 package storage
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
 	"errors"
@@ -21,6 +22,7 @@ import (
 
 const (
 	dbPingPolling = 1 * time.Minute
+	dbPingTimeout = 5 * time.Second
 	// sql driver does dynamic conn pooling and is agressive open/closing connections,
 	// causing unnecessary churns in high concurrent scenario. we can adjust the value
 	// in the future if db tx latency is high due to queued tx
@@ -100,7 +102,8 @@ func NewKVStoreSQL(driver, info string) (*KVStoreSQL, error) {
 
 	s.db = db
 
-	// Initialize the database schema if needed.
+	// Initialize the database schema if needed, or upgrade the schema of an
+	// existing SQLite file. CockroachDB is upgraded by applying schema.sql.
 	if initSchema {
 		for _, cmd := range sqlSchemaCmds {
 			_, err = db.Exec(cmd)
@@ -109,6 +112,13 @@ func NewKVStoreSQL(driver, info string) (*KVStoreSQL, error) {
 				return nil, err
 			}
 		}
+	} else if driver == "sqlite3" {
+		err = migrateSchema(db)
+		if err != nil {
+			log.Errorln("NewKVStoreSQL: cannot migrate schema:", info, err)
+			db.Close()
+			return nil, err
+		}
 	}
 
 	// For CockroachDB start a background DB connection pinger.
@@ -150,6 +160,13 @@ func (s *KVStoreSQL) dbPing(db *sql.DB) {
 	}
 }
 
+// Ping checks if the DB connection is alive.
+func (s *KVStoreSQL) Ping() error {
+	ctx, cancel := context.WithTimeout(context.Background(), dbPingTimeout)
+	defer cancel()
+	return s.db.PingContext(ctx)
+}
+
 // Close the remote K/V store.
 func (s *KVStoreSQL) Close() {
 	if s.closed.IsSet() {
@@ -3,8 +3,10 @@
 package storage
 
 import (
+	"database/sql"
 	"flag"
 	"fmt"
+	"math"
 	"math/big"
 	"os"
 	"os/user"
@@ -15,8 +17,10 @@ import (
 
 	"github.com/celer-network/goCeler/common/structs"
 	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/entity"
 	"github.com/celer-network/goCeler/rpc"
 	"github.com/celer-network/goCeler/utils"
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/any"
 )
 
@@ -624,7 +628,7 @@ func testDalSqlChan(t *testing.T, st *KVStoreSQL) {
 		t.Errorf("failed InsertChan: %v", err)
 	}
 
-	err = dal.InsertClosedChan(cid, peer, token, now, now)
+	err = dal.InsertClosedChan(cid, peer, token, now, now, 0)
 	if err != nil {
 		t.Errorf("failed InsertClosedChan: %v", err)
 	}
@@ -659,6 +663,41 @@ func testDalSqlChan(t *testing.T, st *KVStoreSQL) {
 		t.Errorf("failed GetChanViewInfoByID: %v", err)
 	}
 
+	cids, peers, tokens, err := dal.GetCidPeerTokensByState(55)
+	if err != nil {
+		t.Errorf("failed GetCidPeerTokensByState: %v", err)
+	} else if len(cids) != 1 || cids[0] != cid || peers[0] != peer || tokens[0] != peer {
+		t.Errorf("wrong cid peer tokens: %v, %v, %v", cids, peers, tokens)
+	}
+
+	chans, err := dal.GetChansForBalanceByState(55)
+	if err != nil {
+		t.Errorf("failed GetChansForBalanceByState: %v", err)
+	} else if len(chans) != 1 || chans[0].Cid != cid || chans[0].Peer != peer || chans[0].Token != peer ||
+		chans[0].ChainId != 0 || chans[0].BaseSeq != 5 || chans[0].LastAckedSeq != 7 ||
+		chans[0].OnChainBalance == nil || chans[0].SelfSimplex == nil || chans[0].PeerSimplex == nil {
+		t.Errorf("wrong chans for balance: %v", chans)
+	}
+	chans, err = dal.GetChansForBalanceByState(56)
+	if err != nil || len(chans) != 0 {
+		t.Errorf("wrong chans for balance of another state: %v, %v", chans, err)
+	}
+
+	cids, peers, tokens, states, _, _, err := dal.GetChansByFilter(&peer, &peer, 55, 0, 10)
+	if err != nil {
+		t.Errorf("failed GetChansByFilter: %v", err)
+	} else if len(cids) != 1 || cids[0] != cid || peers[0] != peer || tokens[0] != peer || states[0] != 55 {
+		t.Errorf("wrong filtered channels: %v, %v, %v, %v", cids, peers, tokens, states)
+	}
+	cids, _, _, _, _, _, err = dal.GetChansByFilter(nil, &ledger, 0, 0, 10)
+	if err != nil || len(cids) != 0 {
+		t.Errorf("wrong channels of another token: %v, %v", cids, err)
+	}
+	cids, _, _, _, _, _, err = dal.GetChansByFilter(nil, nil, 0, 1, 10)
+	if err != nil || len(cids) != 0 {
+		t.Errorf("wrong channels after offset: %v, %v", cids, err)
+	}
+
 	err = dal.DeleteChan(cid)
 	if err != nil {
 		t.Errorf("failed DeleteChan: %v", err)
@@ -674,6 +713,176 @@ func TestDalSqlChan_Client(t *testing.T) {
 	runWithDatabase(t, true, testDalSqlChan)
 }
 
+// Schema of a client database created before the chainid columns were added.
+var baselineSchemaCmds = []string{
+	`CREATE TABLE channels (cid TEXT PRIMARY KEY NOT NULL, peer TEXT NOT NULL,
+		token TEXT NOT NULL, ledger TEXT NOT NULL, state INT NOT NULL,
+		statets TIMESTAMPTZ NOT NULL, opents TIMESTAMPTZ NOT NULL, openresp BYTEA,
+		onchainbalance BYTEA, basesn INT NOT NULL, lastusedsn INT NOT NULL,
+		lastackedsn INT NOT NULL, lastnackedsn INT NOT NULL, selfsimplex BYTEA,
+		peersimplex BYTEA, UNIQUE (peer, token))`,
+	`CREATE INDEX chan_ledger_idx ON channels (ledger)`,
+	`CREATE TABLE txs (txhash TEXT PRIMARY KEY NOT NULL, sender TEXT NOT NULL,
+		nonce INT NOT NULL, state INT NOT NULL, rawtx BYTEA NOT NULL, hashes TEXT NOT NULL,
+		description TEXT NOT NULL, createts TIMESTAMPTZ NOT NULL, updatets TIMESTAMPTZ NOT NULL)`,
+	`CREATE TABLE closedchannels (cid TEXT PRIMARY KEY NOT NULL, peer TEXT NOT NULL,
+		token TEXT NOT NULL, opents TIMESTAMPTZ NOT NULL, closets TIMESTAMPTZ NOT NULL)`,
+	`CREATE TABLE deposit (uuid TEXT PRIMARY KEY NOT NULL, cid TEXT NOT NULL, topeer BOOL NOT NULL,
+		amount TEXT NOT NULL, refill BOOL NOT NULL, deadline TIMESTAMPTZ NOT NULL, state INT NOT NULL,
+		txhash TEXT NOT NULL, errmsg TEXT NOT NULL)`,
+}
+
+func TestMigrateSchema_Client(t *testing.T) {
+	stFile := tempStoreFile()
+	defer os.Remove(stFile)
+
+	peer := ctype.Hex2Addr("bcd123")
+	token := utils.GetTokenInfoFromAddress(ctype.ZeroAddr)
+	cid := ctype.Hex2Cid("abcdef")
+	db, err := sql.Open(stDriverLT, stFile)
+	if err != nil {
+		t.Fatalf("cannot create baseline store: %v", err)
+	}
+	for _, cmd := range baselineSchemaCmds {
+		if _, err = db.Exec(cmd); err != nil {
+			t.Fatalf("cannot create baseline schema: %v", err)
+		}
+	}
+	_, err = db.Exec(`INSERT INTO channels (cid, peer, token, ledger, state, statets, opents,
+		basesn, lastusedsn, lastackedsn, lastnackedsn) VALUES ($1, $2, $3, $4, $5, $6, $7, 0, 0, 0, 0)`,
+		ctype.Cid2Hex(cid), ctype.Addr2Hex(peer), utils.GetTokenAddrStr(token), ctype.Addr2Hex(ctype.ZeroAddr),
+		structs.ChanState_OPENED, time.Now().UTC(), time.Now().UTC())
+	if err != nil {
+		t.Fatalf("cannot insert baseline channel: %v", err)
+	}
+	_, err = db.Exec(`INSERT INTO deposit (uuid, cid, topeer, amount, refill, deadline, state, txhash, errmsg)
+		VALUES ('job', $1, false, '1', false, $2, $3, '', '')`,
+		ctype.Cid2Hex(ctype.Hex2Cid("dead")), time.Now().UTC(), structs.DepositState_QUEUED)
+	if err != nil {
+		t.Fatalf("cannot insert baseline deposit: %v", err)
+	}
+	db.Close()
+
+	// opening twice checks the migration is idempotent
+	for i := 0; i < 2; i++ {
+		st, err := NewKVStoreSQL(stDriverLT, stFile)
+		if err != nil {
+			t.Fatalf("cannot open baseline store: %v", err)
+		}
+		dal := NewDAL(st)
+		got, found, err := dal.GetCidByPeerToken(peer, token)
+		if err != nil || !found || got != cid {
+			t.Errorf("wrong migrated channel: %x %t %v", got, found, err)
+		}
+		chainId, found, err := dal.GetChanChainId(cid)
+		if err != nil || !found || chainId != 0 {
+			t.Errorf("wrong chain id of migrated channel: %d %t %v", chainId, found, err)
+		}
+		err = dal.InsertChanOnChain(5, ctype.Hex2Cid(fmt.Sprintf("abcdf%d", i)), peer, token, ctype.ZeroAddr,
+			structs.ChanState_OPENED, nil, &structs.OnChainBalance{}, 0, 0, 0, 0,
+			&rpc.SignedSimplexState{}, &rpc.SignedSimplexState{})
+		if i == 0 && err != nil {
+			t.Errorf("failed InsertChanOnChain of the same peer and token: %v", err)
+		} else if i == 1 && err == nil {
+			t.Errorf("InsertChanOnChain of a duplicate peer and token did not fail")
+		}
+		err = dal.InsertTx(&structs.TxRecord{TxHash: fmt.Sprintf("0x%d", i), ChainId: 5, RawTx: []byte{1}, Hashes: []string{"0x0"}})
+		if err != nil {
+			t.Errorf("failed InsertTx: %v", err)
+		}
+		err = dal.InsertExitJob(&structs.ExitJob{Cid: ctype.Hex2Cid(fmt.Sprintf("abc%d", i))})
+		if err != nil {
+			t.Errorf("failed InsertExitJob in a table added after baseline: %v", err)
+		}
+		// the deposit job of a deleted channel written before the upgrade is on the primary chain
+		jobs, err := dal.GetAllDepositJobsByState(0, structs.DepositState_QUEUED)
+		if err != nil || len(jobs) != 1 || jobs[0].Cid != ctype.Hex2Cid("dead") {
+			t.Errorf("wrong migrated deposit jobs: %v %v", jobs, err)
+		}
+		closedCid := ctype.Hex2Cid(fmt.Sprintf("c1053d%d", i))
+		err = dal.InsertClosedChan(closedCid, peer, token, time.Now(), time.Now(), 5)
+		if err != nil {
+			t.Errorf("failed InsertClosedChan: %v", err)
+		}
+		chainId, found, err = dal.GetChanChainId(closedCid)
+		if err != nil || !found || chainId != 5 {
+			t.Errorf("wrong chain id of closed channel: %d %t %v", chainId, found, err)
+		}
+		st.Close()
+	}
+}
+
+func testDalSqlChanOnChain(t *testing.T, st *KVStoreSQL) {
+	dal := NewDAL(st)
+
+	peer := ctype.Hex2Addr("bcd123")
+	token := utils.GetTokenInfoFromAddress(ctype.ZeroAddr)
+	ledger := ctype.Hex2Addr("6666666666666666666666666666666666666666")
+	simplex := &rpc.SignedSimplexState{}
+	cids := map[uint64]ctype.CidType{
+		0: ctype.Hex2Cid("abcdef"),
+		5: ctype.Hex2Cid("abcdf0"),
+	}
+	err := dal.InsertChan(cids[0], peer, token, ledger, structs.ChanState_OPENED,
+		nil, &structs.OnChainBalance{}, 0, 0, 0, 0, simplex, simplex)
+	if err != nil {
+		t.Errorf("failed InsertChan: %v", err)
+	}
+	// the same peer and token on another chain
+	err = dal.InsertChanOnChain(5, cids[5], peer, token, ledger, structs.ChanState_SETTLING,
+		nil, &structs.OnChainBalance{}, 0, 0, 0, 0, simplex, simplex)
+	if err != nil {
+		t.Errorf("failed InsertChanOnChain: %v", err)
+	}
+	err = dal.InsertChanOnChain(5, ctype.Hex2Cid("abcdf1"), peer, token, ledger, structs.ChanState_OPENED,
+		nil, &structs.OnChainBalance{}, 0, 0, 0, 0, simplex, simplex)
+	if err == nil {
+		t.Errorf("InsertChanOnChain of a duplicate peer and token did not fail")
+	}
+
+	cid, state, found, err := dal.GetCidStateByPeerToken(peer, token)
+	if err != nil || !found || cid != cids[0] || state != structs.ChanState_OPENED {
+		t.Errorf("wrong channel on primary chain: %x %d %t %v", cid, state, found, err)
+	}
+	cid, state, found, err = dal.GetCidStateByPeerTokenOnChain(peer, token, 5)
+	if err != nil || !found || cid != cids[5] || state != structs.ChanState_SETTLING {
+		t.Errorf("wrong channel on chain 5: %x %d %t %v", cid, state, found, err)
+	}
+	_, found, err = dal.GetCidByPeerTokenOnChain(peer, token, 6)
+	if err != nil || found {
+		t.Errorf("GetCidByPeerTokenOnChain on chain 6: %t %v", found, err)
+	}
+	for chainId, cid := range cids {
+		id, found, err := dal.GetChanChainId(cid)
+		if err != nil || !found || id != chainId {
+			t.Errorf("wrong chain id of %x: %d %t %v", cid, id, found, err)
+		}
+	}
+
+	for chainId, cid := range cids {
+		err = dal.InsertDeposit(fmt.Sprintf("job%d", chainId), cid, false, big.NewInt(1), false,
+			time.Now(), structs.DepositState_QUEUED, "", "")
+		if err != nil {
+			t.Errorf("failed InsertDeposit: %v", err)
+		}
+	}
+	// jobs stay on the chain of the channel after the channel is deleted
+	err = dal.DeleteChan(cids[5])
+	if err != nil {
+		t.Errorf("failed DeleteChan: %v", err)
+	}
+	for chainId, cid := range cids {
+		jobs, err := dal.GetAllDepositJobsByState(chainId, structs.DepositState_QUEUED)
+		if err != nil || len(jobs) != 1 || jobs[0].Cid != cid {
+			t.Errorf("wrong deposit jobs on chain %d: %v %v", chainId, jobs, err)
+		}
+	}
+}
+
+func TestDalSqlChanOnChain_Client(t *testing.T) {
+	runWithDatabase(t, true, testDalSqlChanOnChain)
+}
+
 func testDalSqlPay(t *testing.T, st *KVStoreSQL) {
 	dal := NewDAL(st)
 
@@ -695,6 +904,86 @@ func testDalSqlPay(t *testing.T, st *KVStoreSQL) {
 		t.Errorf("wrong pay IDs: %v", payIDs)
 	}
 
+	incid := ctype.Hex2Cid("123456")
+	err = updatePayIngress(st, payID, incid, 2)
+	if err != nil {
+		t.Errorf("failed updatePayIngress: %v", err)
+	}
+	gotCid, state, found, err := dal.GetPayIngress(payID)
+	if err != nil || !found {
+		t.Errorf("failed GetPayIngress: %v %t", err, found)
+	} else if gotCid != incid || state != 2 {
+		t.Errorf("wrong pay ingress: %x %d", gotCid, state)
+	}
+	err = updatePayIngress(st, ctype.Hex2PayID("123"), incid, 2)
+	if err == nil {
+		t.Errorf("updatePayIngress of unknown pay did not fail")
+	}
+
+	pagedCid := ctype.Hex2Cid("fedcba")
+	pay := &entity.ConditionalPay{Src: []byte{1}, Dest: []byte{2}}
+	pagedPayBytes, _ := proto.Marshal(pay)
+	for i := 0; i < 5; i++ {
+		err = dal.InsertPaymentWithTs(ctype.Hex2PayID(fmt.Sprintf("fe%02d", i)), pagedPayBytes, pay, note,
+			pagedCid, 1, ctype.ZeroCid, 0, time.Unix(int64(1000+i), 0).UTC())
+		if err != nil {
+			t.Errorf("failed InsertPaymentWithTs: %v", err)
+		}
+	}
+	total, err := dal.CountPaymentsByCid(pagedCid)
+	if err != nil || total != 5 {
+		t.Errorf("wrong pay count: %d %v", total, err)
+	}
+	peer := ctype.Hex2Addr("abc123")
+	err = dal.InsertChanOnChain(0, cid, peer, utils.GetTokenInfoFromAddress(ctype.ZeroAddr), ctype.ZeroAddr,
+		structs.ChanState_OPENED, nil, &structs.OnChainBalance{}, 0, 0, 0, 0,
+		&rpc.SignedSimplexState{}, &rpc.SignedSimplexState{})
+	if err != nil {
+		t.Errorf("failed InsertChanOnChain: %v", err)
+	}
+	counts, err := dal.CountPaymentsByOutStatesPerPeer([]int{1, 3})
+	if err != nil || len(counts) != 1 || counts[peer] != 1 {
+		t.Errorf("wrong pay count by out states: %v %v", counts, err)
+	}
+	payIDs, _, _, inCids, _, _, _, _, err := dal.GetPaymentInfoByCidPage(pagedCid, 1, 2)
+	if err != nil {
+		t.Errorf("failed GetPaymentInfoByCidPage: %v", err)
+	} else if len(payIDs) != 2 || payIDs[0] != ctype.Hex2PayID("fe03") || payIDs[1] != ctype.Hex2PayID("fe02") ||
+		inCids[0] != pagedCid {
+		t.Errorf("wrong pay page: %v", payIDs)
+	}
+
+	_, _, found, err = dal.GetPayFees(payID)
+	if err != nil || found {
+		t.Errorf("GetPayFees of pay without fees: %t %v", found, err)
+	}
+	err = dal.PutPayFee(payID, incid, big.NewInt(30))
+	if err != nil {
+		t.Errorf("failed PutPayFee in: %v", err)
+	}
+	err = dal.PutPayFee(payID, cid, big.NewInt(20))
+	if err != nil {
+		t.Errorf("failed PutPayFee out: %v", err)
+	}
+	err = dal.PutPayFee(payID, cid, big.NewInt(25))
+	if err != nil {
+		t.Errorf("failed PutPayFee out again: %v", err)
+	}
+	fee, found, err := dal.GetPayFee(payID, cid)
+	if err != nil || !found || fee.Int64() != 25 {
+		t.Errorf("wrong pay fee: %s %t %v", fee, found, err)
+	}
+	_, found, err = dal.GetPayFee(payID, pagedCid)
+	if err != nil || found {
+		t.Errorf("GetPayFee of channel without fee: %t %v", found, err)
+	}
+	feeIn, feeOut, found, err := dal.GetPayFees(payID)
+	if err != nil || !found {
+		t.Errorf("failed GetPayFees: %t %v", found, err)
+	} else if feeIn.Int64() != 30 || feeOut.Int64() != 25 {
+		t.Errorf("wrong pay fees: %s %s", feeIn, feeOut)
+	}
+
 	dest := ctype.Hex2Addr("bcd123")
 	err = dal.InsertDelegatedPay(payID, dest, 5)
 	if err != nil {
@@ -918,6 +1207,7 @@ func testDalSqlMonitor(t *testing.T, st *KVStoreSQL) {
 	if len(addrs) != 0 {
 		t.Errorf("wrong address number: want(0), get(%d)", len(addrs))
 	}
+
 }
 
 func TestDalSqlMonitor_Client(t *testing.T) {
@@ -1046,6 +1336,461 @@ func TestDalSqlPeer_Client(t *testing.T) {
 	runWithDatabase(t, true, testDalSqlPeer)
 }
 
+func testDalSqlAppSession(t *testing.T, st *KVStoreSQL) {
+	dal := NewDAL(st)
+
+	session := &structs.AppSession{
+		ID:             "abcdef",
+		Type:           2,
+		Nonce:          math.MaxUint64 - 1,
+		Players:        []ctype.Addr{ctype.Hex2Addr("abc1231"), ctype.Hex2Addr("abc1232")},
+		DeployedAddr:   ctype.Hex2Addr("def456"),
+		OnChainTimeout: 100,
+		WatchBlock:     1234,
+	}
+
+	err := dal.InsertAppSession(session)
+	if err != nil {
+		t.Errorf("failed InsertAppSession: %v", err)
+	}
+
+	err = dal.UpdateAppSessionStateProof(session.ID, 5, []byte("proof"))
+	if err != nil {
+		t.Errorf("failed UpdateAppSessionStateProof: %v", err)
+	}
+	err = dal.UpdateAppSessionWatchBlock(session.ID, 0)
+	if err != nil {
+		t.Errorf("failed UpdateAppSessionWatchBlock: %v", err)
+	}
+
+	session2, found, err := dal.GetAppSession(session.ID)
+	if err != nil {
+		t.Errorf("failed GetAppSession: %v", err)
+	} else if !found {
+		t.Errorf("GetAppSession did not find entry")
+	} else {
+		if session2.Nonce != session.Nonce {
+			t.Errorf("wrong nonce: %d, %d", session2.Nonce, session.Nonce)
+		}
+		if !reflect.DeepEqual(session2.Players, session.Players) {
+			t.Errorf("wrong players: %v, %v", session2.Players, session.Players)
+		}
+		if session2.SeqNum != 5 || string(session2.StateProof) != "proof" {
+			t.Errorf("wrong state proof: %d %s", session2.SeqNum, session2.StateProof)
+		}
+		if session2.WatchBlock != 0 {
+			t.Errorf("wrong watch block: %d", session2.WatchBlock)
+		}
+	}
+
+	sessions, err := dal.GetAllAppSessions()
+	if err != nil {
+		t.Errorf("failed GetAllAppSessions: %v", err)
+	} else if len(sessions) != 1 {
+		t.Errorf("wrong number of app sessions: %d", len(sessions))
+	}
+
+	_, found, err = dal.GetAppDispute(session.ID)
+	if err != nil || found {
+		t.Errorf("GetAppDispute should not find dispute: %t %v", found, err)
+	}
+	for _, seq := range []int{0, 3} {
+		err = dal.PutAppDispute(session.ID, seq)
+		if err != nil {
+			t.Errorf("failed PutAppDispute: %v", err)
+		}
+	}
+	seq, found, err := dal.GetAppDispute(session.ID)
+	if err != nil || !found || seq != 3 {
+		t.Errorf("failed GetAppDispute: %d %t %v", seq, found, err)
+	}
+	err = dal.DeleteAppDispute(session.ID)
+	if err != nil {
+		t.Errorf("failed DeleteAppDispute: %v", err)
+	}
+	_, found, err = dal.GetAppDispute(session.ID)
+	if err != nil || found {
+		t.Errorf("GetAppDispute found dispute after delete: %t %v", found, err)
+	}
+
+	err = dal.DeleteAppSession(session.ID)
+	if err != nil {
+		t.Errorf("failed DeleteAppSession: %v", err)
+	}
+	_, found, err = dal.GetAppSession(session.ID)
+	if err != nil {
+		t.Errorf("failed GetAppSession after delete: %v", err)
+	} else if found {
+		t.Errorf("GetAppSession found entry after delete")
+	}
+}
+
+func TestDalSqlAppSession_Client(t *testing.T) {
+	runWithDatabase(t, true, testDalSqlAppSession)
+}
+
+func testDalSqlPayTrace(t *testing.T, st *KVStoreSQL) {
+	dal := NewDAL(st)
+
+	payID := ctype.Bytes2PayID([]byte{1, 2, 3})
+	prevHop := ctype.Hex2Addr("abc1231")
+	nextHop := ctype.Hex2Addr("abc1232")
+	err := dal.InsertPayTrace(&rpc.PayTraceSpan{
+		PayId:   payID.Bytes(),
+		TraceId: "trace-1",
+		PrevHop: prevHop.Bytes(),
+		RecvTs:  100,
+	})
+	if err != nil {
+		t.Errorf("failed InsertPayTrace: %v", err)
+	}
+
+	err = dal.UpdatePayTraceFwd(payID, nextHop, 200)
+	if err != nil {
+		t.Errorf("failed UpdatePayTraceFwd: %v", err)
+	}
+	err = dal.UpdatePayTraceReceipt(payID, 300)
+	if err != nil {
+		t.Errorf("failed UpdatePayTraceReceipt: %v", err)
+	}
+	errs := []string{"nack: no route, to dst", "second error"}
+	err = dal.UpdatePayTraceErrors(payID, errs)
+	if err != nil {
+		t.Errorf("failed UpdatePayTraceErrors: %v", err)
+	}
+
+	span, found, err := dal.GetPayTrace(payID)
+	if err != nil {
+		t.Errorf("failed GetPayTrace: %v", err)
+	} else if !found {
+		t.Errorf("GetPayTrace did not find entry")
+	} else {
+		if span.GetTraceId() != "trace-1" {
+			t.Errorf("wrong trace id: %s", span.GetTraceId())
+		}
+		if ctype.Bytes2Addr(span.GetPrevHop()) != prevHop || ctype.Bytes2Addr(span.GetNextHop()) != nextHop {
+			t.Errorf("wrong hops: %x %x", span.GetPrevHop(), span.GetNextHop())
+		}
+		if span.GetRecvTs() != 100 || span.GetFwdTs() != 200 || span.GetReceiptTs() != 300 {
+			t.Errorf("wrong timestamps: %d %d %d", span.GetRecvTs(), span.GetFwdTs(), span.GetReceiptTs())
+		}
+		if !reflect.DeepEqual(span.GetErrors(), errs) {
+			t.Errorf("wrong errors: %v, %v", span.GetErrors(), errs)
+		}
+	}
+
+	recentPayID := ctype.Bytes2PayID([]byte{4, 5, 6})
+	err = dal.InsertPayTrace(&rpc.PayTraceSpan{
+		PayId:   recentPayID.Bytes(),
+		TraceId: "trace-2",
+		NextHop: nextHop.Bytes(),
+		FwdTs:   time.Now().UnixNano(),
+	})
+	if err != nil {
+		t.Errorf("failed InsertPayTrace: %v", err)
+	}
+	err = dal.DeletePayTracesBefore(time.Now().Add(-time.Minute))
+	if err != nil {
+		t.Errorf("failed DeletePayTracesBefore: %v", err)
+	}
+	_, found, err = dal.GetPayTrace(payID)
+	if err != nil {
+		t.Errorf("failed GetPayTrace after delete: %v", err)
+	} else if found {
+		t.Errorf("GetPayTrace found entry after delete")
+	}
+	_, found, err = dal.GetPayTrace(recentPayID)
+	if err != nil || !found {
+		t.Errorf("recent pay trace not kept: %t %v", found, err)
+	}
+}
+
+func TestDalSqlPayTrace_Client(t *testing.T) {
+	runWithDatabase(t, true, testDalSqlPayTrace)
+}
+
+func testDalSqlPayHistory(t *testing.T, st *KVStoreSQL) {
+	dal := NewDAL(st)
+
+	me := ctype.Hex2Addr("abc001")
+	peer1 := ctype.Hex2Addr("abc002")
+	peer2 := ctype.Hex2Addr("abc003")
+	token := ctype.Hex2Addr("def001")
+	base := time.Unix(1600000000, 0).UTC()
+	items := []*structs.PayHistoryItem{
+		{PayID: ctype.Bytes2PayID([]byte{1}), Src: me, Dest: peer1, Token: ctype.ZeroAddr, Amt: big.NewInt(10), Status: 2, CreateTs: base},
+		{PayID: ctype.Bytes2PayID([]byte{2}), Src: peer1, Dest: me, Token: token, Amt: big.NewInt(20), Status: 1, CreateTs: base.Add(time.Minute)},
+		{PayID: ctype.Bytes2PayID([]byte{3}), Src: me, Dest: peer2, Token: token, Amt: big.NewInt(30), Status: 4, CreateTs: base.Add(2 * time.Minute)},
+	}
+	for _, item := range items {
+		err := dal.PutPayHistoryItem(item)
+		if err != nil {
+			t.Errorf("failed PutPayHistoryItem: %v", err)
+		}
+	}
+
+	item, found, err := dal.GetPayHistoryItem(items[1].PayID)
+	if err != nil || !found {
+		t.Errorf("failed GetPayHistoryItem: %t %v", found, err)
+	} else if item.Src != peer1 || item.Dest != me || item.Token != token || item.Amt.Int64() != 20 ||
+		item.Status != 1 || !item.CreateTs.Equal(items[1].CreateTs) {
+		t.Errorf("wrong pay history item: %+v", item)
+	}
+
+	items[1].Status = 2
+	err = dal.PutPayHistoryItem(items[1])
+	if err != nil {
+		t.Errorf("failed PutPayHistoryItem update: %v", err)
+	}
+
+	checkItems := func(filter *structs.PayHistoryFilter, expected ...int) {
+		res, err := dal.GetPayHistoryItems(filter)
+		if err != nil {
+			t.Errorf("failed GetPayHistoryItems %+v: %v", filter, err)
+			return
+		}
+		if len(res) != len(expected) {
+			t.Errorf("GetPayHistoryItems %+v returned %d items, expect %d", filter, len(res), len(expected))
+			return
+		}
+		for i, idx := range expected {
+			if res[i].PayID != items[idx].PayID {
+				t.Errorf("GetPayHistoryItems %+v item %d: %x, expect %x", filter, i, res[i].PayID, items[idx].PayID)
+			}
+		}
+	}
+	checkItems(&structs.PayHistoryFilter{}, 2, 1, 0)
+	checkItems(&structs.PayHistoryFilter{Counterparty: peer1}, 1, 0)
+	checkItems(&structs.PayHistoryFilter{Token: &token}, 2, 1)
+	checkItems(&structs.PayHistoryFilter{Token: &ctype.ZeroAddr}, 0)
+	checkItems(&structs.PayHistoryFilter{Status: 2}, 1, 0)
+	checkItems(&structs.PayHistoryFilter{Since: base.Add(time.Minute), Until: base.Add(2 * time.Minute)}, 1)
+	checkItems(&structs.PayHistoryFilter{Counterparty: peer2, Token: &token, Status: 4}, 2)
+	checkItems(&structs.PayHistoryFilter{Limit: 1}, 2)
+
+	ts, found, err := dal.GetOldestPayHistoryTs([]int{2, 4})
+	if err != nil || !found || !ts.Equal(items[0].CreateTs) {
+		t.Errorf("wrong oldest pay history ts: %s %t %v", ts, found, err)
+	}
+	ts, found, err = dal.GetOldestPayHistoryTs([]int{4})
+	if err != nil || !found || !ts.Equal(items[2].CreateTs) {
+		t.Errorf("wrong oldest pay history ts of status 4: %s %t %v", ts, found, err)
+	}
+	_, found, err = dal.GetOldestPayHistoryTs([]int{1})
+	if err != nil || found {
+		t.Errorf("oldest pay history ts of status 1 found: %t %v", found, err)
+	}
+}
+
+func TestDalSqlPayHistory_Client(t *testing.T) {
+	runWithDatabase(t, true, testDalSqlPayHistory)
+}
+
+func testDalSqlAcctRecord(t *testing.T, st *KVStoreSQL) {
+	dal := NewDAL(st)
+
+	token := ctype.Hex2Addr("def001")
+	base := time.Unix(1600000000, 0).UTC()
+	fee := &structs.AcctRecord{
+		EntryID: "feein-01",
+		Memo:    "relay fee",
+		Ts:      base,
+		Postings: []*structs.AcctPosting{
+			{Account: "channel:01", Token: token, Amt: big.NewInt(5)},
+			{Account: "income:relayfee", Token: token, Amt: big.NewInt(-5)},
+		},
+	}
+	gas := &structs.AcctRecord{
+		EntryID: "gas-0x02",
+		Memo:    "deposit",
+		Ts:      base.Add(time.Minute),
+		Postings: []*structs.AcctPosting{
+			{Account: "expense:gas", Token: ctype.ZeroAddr, Amt: big.NewInt(21000)},
+			{Account: "wallet", Token: ctype.ZeroAddr, Amt: big.NewInt(-21000)},
+		},
+	}
+	for _, record := range []*structs.AcctRecord{fee, gas} {
+		err := dal.InsertAcctRecord(record)
+		if err != nil {
+			t.Errorf("failed InsertAcctRecord %s: %v", record.EntryID, err)
+		}
+	}
+	// same entry again is a no-op
+	err := dal.InsertAcctRecord(fee)
+	if err != nil {
+		t.Errorf("failed InsertAcctRecord again: %v", err)
+	}
+	unbalanced := &structs.AcctRecord{
+		EntryID: "bad",
+		Ts:      base,
+		Postings: []*structs.AcctPosting{
+			{Account: "channel:01", Token: token, Amt: big.NewInt(5)},
+			{Account: "income:relayfee", Token: ctype.ZeroAddr, Amt: big.NewInt(-5)},
+		},
+	}
+	err = dal.InsertAcctRecord(unbalanced)
+	if err == nil {
+		t.Errorf("unbalanced entry should fail")
+	}
+
+	records, err := dal.GetAcctRecords(base, base.Add(time.Hour))
+	if err != nil {
+		t.Errorf("failed GetAcctRecords: %v", err)
+	} else if len(records) != 2 {
+		t.Errorf("GetAcctRecords returned %d records, expect 2", len(records))
+	} else {
+		if records[0].EntryID != fee.EntryID || records[0].Memo != fee.Memo || !records[0].Ts.Equal(base) ||
+			len(records[0].Postings) != 2 {
+			t.Errorf("wrong acct record: %+v", records[0])
+		}
+		if records[1].EntryID != gas.EntryID || records[1].Postings[0].Account != "expense:gas" ||
+			records[1].Postings[0].Amt.Int64() != 21000 || records[1].Postings[1].Amt.Int64() != -21000 {
+			t.Errorf("wrong acct record: %+v", records[1])
+		}
+	}
+	records, err = dal.GetAcctRecords(base.Add(time.Second), base.Add(time.Hour))
+	if err != nil || len(records) != 1 || records[0].EntryID != gas.EntryID {
+		t.Errorf("failed GetAcctRecords since: %v %v", records, err)
+	}
+
+	err = dal.DeleteAcctRecord(gas.EntryID)
+	if err != nil {
+		t.Errorf("failed DeleteAcctRecord: %v", err)
+	}
+	records, err = dal.GetAcctRecords(base, base.Add(time.Hour))
+	if err != nil || len(records) != 1 || records[0].EntryID != fee.EntryID {
+		t.Errorf("failed GetAcctRecords after delete: %v %v", records, err)
+	}
+}
+
+func TestDalSqlAcctRecord_Client(t *testing.T) {
+	runWithDatabase(t, true, testDalSqlAcctRecord)
+}
+
+func testDalSqlExitJob(t *testing.T, st *KVStoreSQL) {
+	dal := NewDAL(st)
+
+	cid1, cid2 := ctype.Hex2Cid("e01"), ctype.Hex2Cid("e02")
+	for _, cid := range []ctype.CidType{cid1, cid2} {
+		err := dal.InsertExitJob(&structs.ExitJob{
+			Cid:    cid,
+			Reason: "offline",
+			State:  structs.ExitState_RESOLVING_PAYS,
+		})
+		if err != nil {
+			t.Errorf("failed InsertExitJob %x: %v", cid, err)
+		}
+	}
+	err := dal.InsertExitJob(&structs.ExitJob{Cid: cid1})
+	if err == nil {
+		t.Errorf("duplicate InsertExitJob should fail")
+	}
+
+	err = dal.UpdateExitJob(cid2, structs.ExitState_WAITING_FINALIZE, 1234, "not finalized")
+	if err != nil {
+		t.Errorf("failed UpdateExitJob: %v", err)
+	}
+	job, found, err := dal.GetExitJob(cid2)
+	if err != nil || !found {
+		t.Errorf("failed GetExitJob: %t %v", found, err)
+	} else if job.Reason != "offline" || job.State != structs.ExitState_WAITING_FINALIZE ||
+		job.FinalizeBlk != 1234 || job.ErrMsg != "not finalized" || job.UpdateTs.Before(job.CreateTs) {
+		t.Errorf("wrong exit job: %+v", job)
+	}
+	_, found, err = dal.GetExitJob(ctype.Hex2Cid("e03"))
+	if err != nil || found {
+		t.Errorf("GetExitJob should not find job: %t %v", found, err)
+	}
+	jobs, err := dal.GetAllExitJobs()
+	if err != nil || len(jobs) != 2 {
+		t.Errorf("failed GetAllExitJobs: %v %v", jobs, err)
+	}
+
+	peer := ctype.Hex2Addr("e0e0")
+	_, found, err = dal.GetPeerOfflineTime(peer)
+	if err != nil || found {
+		t.Errorf("GetPeerOfflineTime should not find offline time: %t %v", found, err)
+	}
+	for _, offline := range []time.Duration{time.Hour, 90 * time.Minute} {
+		err = dal.PutPeerOfflineTime(peer, offline)
+		if err != nil {
+			t.Errorf("failed PutPeerOfflineTime: %v", err)
+		}
+	}
+	offline, found, err := dal.GetPeerOfflineTime(peer)
+	if err != nil || !found || offline != 90*time.Minute {
+		t.Errorf("failed GetPeerOfflineTime: %s %t %v", offline, found, err)
+	}
+}
+
+func TestDalSqlExitJob_Client(t *testing.T) {
+	runWithDatabase(t, true, testDalSqlExitJob)
+}
+
+func testDalSqlPayClearJob(t *testing.T, st *KVStoreSQL) {
+	dal := NewDAL(st)
+
+	cid := ctype.Hex2Cid("c01")
+	payID1, payID2 := ctype.Hex2PayID("a01"), ctype.Hex2PayID("a02")
+	for _, payID := range []ctype.PayIDType{payID1, payID2} {
+		err := dal.PutPayClearJob(&structs.PayClearJob{PayID: payID, Cid: cid, Step: "SETTLE_REQUESTED", BlkNum: 10})
+		if err != nil {
+			t.Errorf("failed PutPayClearJob %x: %v", payID, err)
+		}
+	}
+	err := dal.PutPayClearJob(&structs.PayClearJob{PayID: payID2, Cid: cid, Step: "INTEND_SETTLE", BlkNum: 20})
+	if err != nil {
+		t.Errorf("failed PutPayClearJob update: %v", err)
+	}
+	err = dal.DeletePayClearJob(payID1)
+	if err != nil {
+		t.Errorf("failed DeletePayClearJob: %v", err)
+	}
+	err = dal.DeletePayClearJob(payID1)
+	if err == nil {
+		t.Errorf("DeletePayClearJob of deleted job should fail")
+	}
+	jobs, err := dal.GetAllPayClearJobs()
+	if err != nil || len(jobs) != 1 {
+		t.Fatalf("failed GetAllPayClearJobs: %v %v", jobs, err)
+	}
+	if jobs[0].PayID != payID2 || jobs[0].Cid != cid || jobs[0].Step != "INTEND_SETTLE" || jobs[0].BlkNum != 20 {
+		t.Errorf("wrong pay clear job: %+v", jobs[0])
+	}
+}
+
+func TestDalSqlPayClearJob_Client(t *testing.T) {
+	runWithDatabase(t, true, testDalSqlPayClearJob)
+}
+
+func testDalSqlRebalanceBudget(t *testing.T, st *KVStoreSQL) {
+	dal := NewDAL(st)
+
+	token := ctype.Hex2Addr("abc1")
+	_, found, err := dal.GetRebalanceBudget(token)
+	if err != nil || found {
+		t.Errorf("unknown rebalance budget found: %t %v", found, err)
+	}
+	for _, day := range []int64{100, 101} {
+		err = dal.PutRebalanceBudget(&structs.RebalanceBudget{
+			Token: token, Day: day, Spent: big.NewInt(day * 10), FeeSpent: big.NewInt(day)})
+		if err != nil {
+			t.Errorf("failed PutRebalanceBudget day %d: %v", day, err)
+		}
+	}
+	budget, found, err := dal.GetRebalanceBudget(token)
+	if err != nil || !found {
+		t.Fatalf("failed GetRebalanceBudget: %t %v", found, err)
+	}
+	if budget.Token != token || budget.Day != 101 || budget.Spent.Int64() != 1010 || budget.FeeSpent.Int64() != 101 {
+		t.Errorf("wrong rebalance budget: %+v", budget)
+	}
+}
+
+func TestDalSqlRebalanceBudget_Client(t *testing.T) {
+	runWithDatabase(t, true, testDalSqlRebalanceBudget)
+}
+
 func TestStr2Time(t *testing.T) {
 	goodTs := []string{
 		"2019-12-11T23:09:11.09099Z",       // cockroachdb
@@ -1070,3 +1815,148 @@ func TestStr2Time(t *testing.T) {
 		}
 	}
 }
+
+func testDalSqlTx(t *testing.T, st *KVStoreSQL) {
+	dal := NewDAL(st)
+
+	sender := ctype.Hex2Addr("abc1231")
+	for i, hash := range []string{"0x01", "0x02", "0x04"} {
+		var chainId uint64
+		if hash == "0x04" {
+			chainId = 5
+		}
+		err := dal.InsertTx(&structs.TxRecord{
+			TxHash:      hash,
+			ChainId:     chainId,
+			Sender:      sender,
+			Nonce:       uint64(10 + i),
+			State:       structs.TxState_PENDING,
+			RawTx:       []byte{byte(i)},
+			Hashes:      []string{hash},
+			Description: "deposit",
+		})
+		if err != nil {
+			t.Errorf("failed InsertTx: %v", err)
+		}
+	}
+
+	hashes := []string{"0x01", "0x03"}
+	err := dal.UpdateTxRebroadcast("0x01", []byte{3}, hashes)
+	if err != nil {
+		t.Errorf("failed UpdateTxRebroadcast: %v", err)
+	}
+	tx, found, err := dal.GetTx("0x01")
+	if err != nil {
+		t.Errorf("failed GetTx: %v", err)
+	} else if !found {
+		t.Errorf("GetTx did not find entry")
+	} else {
+		if tx.Sender != sender || tx.Nonce != 10 || tx.Description != "deposit" {
+			t.Errorf("wrong tx: %+v", tx)
+		}
+		if !reflect.DeepEqual(tx.RawTx, []byte{3}) || !reflect.DeepEqual(tx.Hashes, hashes) {
+			t.Errorf("wrong rebroadcast tx: %x %v", tx.RawTx, tx.Hashes)
+		}
+	}
+
+	err = dal.UpdateTxState("0x01", structs.TxState_MINED)
+	if err != nil {
+		t.Errorf("failed UpdateTxState: %v", err)
+	}
+	txs, err := dal.GetAllTxsByState(0, structs.TxState_PENDING)
+	if err != nil {
+		t.Errorf("failed GetAllTxsByState: %v", err)
+	} else if len(txs) != 1 || txs[0].TxHash != "0x02" {
+		t.Errorf("wrong pending txs: %v", txs)
+	}
+	txs, err = dal.GetAllTxsByState(5, structs.TxState_PENDING)
+	if err != nil {
+		t.Errorf("failed GetAllTxsByState: %v", err)
+	} else if len(txs) != 1 || txs[0].TxHash != "0x04" || txs[0].ChainId != 5 {
+		t.Errorf("wrong pending txs on chain 5: %v", txs)
+	}
+
+	err = dal.DeleteFinishedTxsBefore(time.Now().Add(time.Minute))
+	if err != nil {
+		t.Errorf("failed DeleteFinishedTxsBefore: %v", err)
+	}
+	_, found, err = dal.GetTx("0x01")
+	if err != nil || found {
+		t.Errorf("GetTx after delete: %t %v", found, err)
+	}
+	_, found, err = dal.GetTx("0x02")
+	if err != nil || !found {
+		t.Errorf("GetTx pending tx after delete: %t %v", found, err)
+	}
+}
+
+func TestDalSqlTx_Client(t *testing.T) {
+	runWithDatabase(t, true, testDalSqlTx)
+}
+
+func testDalSqlEventLog(t *testing.T, st *KVStoreSQL) {
+	dal := NewDAL(st)
+
+	records := []*structs.EventLogRecord{
+		{BlkHash: "0xb1", LogIndex: 0, BlkNum: 10, Event: "Deposit", TxHash: "0x01", RawLog: []byte{1}},
+		{BlkHash: "0xb2", LogIndex: 3, BlkNum: 11, Event: "IntendSettle", TxHash: "0x02", RawLog: []byte{2}},
+	}
+	otherChainRecord := &structs.EventLogRecord{
+		BlkHash: "0xc1", LogIndex: 0, ChainId: 5, BlkNum: 10, Event: "Deposit", TxHash: "0x03", RawLog: []byte{3}}
+	for _, record := range append(records, otherChainRecord) {
+		err := dal.InsertEventLog(record)
+		if err != nil {
+			t.Errorf("failed InsertEventLog: %v", err)
+		}
+	}
+	// processing the same log again is a no-op
+	err := dal.InsertEventLog(records[0])
+	if err != nil {
+		t.Errorf("failed InsertEventLog duplicate: %v", err)
+	}
+
+	got, err := dal.GetEventLogsSince(0, 10)
+	if err != nil {
+		t.Errorf("failed GetEventLogsSince: %v", err)
+	} else if !reflect.DeepEqual(got, records) {
+		t.Errorf("wrong event logs: %v", got)
+	}
+	got, err = dal.GetEventLogsSince(5, 0)
+	if err != nil {
+		t.Errorf("failed GetEventLogsSince: %v", err)
+	} else if len(got) != 1 || !reflect.DeepEqual(got[0], otherChainRecord) {
+		t.Errorf("wrong event logs on chain 5: %v", got)
+	}
+
+	err = dal.UpdateEventLogBlock("0xb2", 3, "0xb3", 1, 12)
+	if err != nil {
+		t.Errorf("failed UpdateEventLogBlock: %v", err)
+	}
+	got, err = dal.GetEventLogsSince(0, 11)
+	if err != nil {
+		t.Errorf("failed GetEventLogsSince: %v", err)
+	} else if len(got) != 1 || got[0].BlkHash != "0xb3" || got[0].LogIndex != 1 || got[0].BlkNum != 12 {
+		t.Errorf("wrong moved event log: %v", got)
+	}
+
+	err = dal.DeleteEventLog("0xb3", 1)
+	if err != nil {
+		t.Errorf("failed DeleteEventLog: %v", err)
+	}
+	err = dal.DeleteEventLogsBefore(0, 11)
+	if err != nil {
+		t.Errorf("failed DeleteEventLogsBefore: %v", err)
+	}
+	got, err = dal.GetEventLogsSince(0, 0)
+	if err != nil || len(got) != 0 {
+		t.Errorf("GetEventLogsSince after delete: %v %v", got, err)
+	}
+	got, err = dal.GetEventLogsSince(5, 0)
+	if err != nil || len(got) != 1 {
+		t.Errorf("GetEventLogsSince on chain 5 after delete: %v %v", got, err)
+	}
+}
+
+func TestDalSqlEventLog_Client(t *testing.T) {
+	runWithDatabase(t, true, testDalSqlEventLog)
+}
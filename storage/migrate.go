@@ -0,0 +1,124 @@
+// Copyright 2020 Celer Network
+//
+// Upgrade the schema of SQLite databases created by older versions.
+
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/celer-network/goutils/log"
+)
+
+// chainid columns added to tables that existed before multi-chain support. Rows written before
+// the upgrade are all on the primary chain, which is the column default.
+var chainIdTables = []string{"txs", "eventlogs", "closedchannels", "deposit"}
+
+// migrateSchema brings an existing SQLite database to the current schema. Tables and
+// indexes missing in the file are created, and the chainid columns are added to the
+// tables created before them. Statements are idempotent, so it is safe to run on
+// every open.
+func migrateSchema(db *sql.DB) error {
+	// create the missing tables first so the columns below can be checked
+	for _, cmd := range sqlSchemaCmds {
+		if !strings.HasPrefix(cmd, "CREATE TABLE") {
+			continue
+		}
+		if _, err := db.Exec(cmd); err != nil {
+			return err
+		}
+	}
+
+	ok, err := hasColumn(db, "channels", "chainid")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		if err = rebuildChannels(db); err != nil {
+			return fmt.Errorf("rebuild channels: %w", err)
+		}
+	}
+	for _, tbl := range chainIdTables {
+		ok, err = hasColumn(db, tbl, "chainid")
+		if err != nil {
+			return err
+		}
+		if ok {
+			continue
+		}
+		log.Infoln("migrate schema: add chainid column to", tbl)
+		_, err = db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN chainid INT NOT NULL DEFAULT 0", tbl))
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, cmd := range sqlSchemaCmds {
+		if strings.HasPrefix(cmd, "CREATE TABLE") {
+			continue
+		}
+		if _, err = db.Exec(cmd); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rebuildChannels recreates the channels table with the chainid column. SQLite cannot
+// alter a table constraint, and the old UNIQUE (peer, token) would block channels with
+// the same peer and token on different chains. Existing channels are on chain 0.
+func rebuildChannels(db *sql.DB) error {
+	log.Infoln("migrate schema: rebuild channels table with chainid column")
+	var create string
+	for _, cmd := range sqlSchemaCmds {
+		if strings.HasPrefix(cmd, "CREATE TABLE IF NOT EXISTS channels ") {
+			create = cmd
+			break
+		}
+	}
+	if create == "" {
+		return fmt.Errorf("channels table not in schema")
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	stmts := []string{
+		"ALTER TABLE channels RENAME TO channels_old",
+		create,
+		"INSERT INTO channels SELECT *, 0 FROM channels_old",
+		"DROP TABLE channels_old",
+	}
+	for _, stmt := range stmts {
+		if _, err = tx.Exec(stmt); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func hasColumn(db *sql.DB, tbl, col string) (bool, error) {
+	rows, err := db.Query(fmt.Sprintf("PRAGMA table_info(%s)", tbl))
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	found := false
+	for rows.Next() {
+		var cid, notnull, pk int
+		var name, ctype string
+		var dflt sql.NullString
+		if err = rows.Scan(&cid, &name, &ctype, &notnull, &dflt, &pk); err != nil {
+			return false, err
+		}
+		if name == col {
+			found = true
+		}
+	}
+	return found, rows.Err()
+}
@@ -38,7 +38,8 @@ CREATE TABLE IF NOT EXISTS channels (
     lastnackedsn INT NOT NULL,
     selfsimplex BYTEA,
     peersimplex BYTEA,
-    UNIQUE (peer, token)
+    chainid INT NOT NULL DEFAULT 0, -- chain of the channel ledger, 0 for the primary chain of the node
+    UNIQUE (peer, token, chainid)
 );
 CREATE INDEX IF NOT EXISTS chan_ledger_idx ON channels (ledger);
 CREATE INDEX IF NOT EXISTS chan_state_idx ON channels (state);
@@ -49,7 +50,8 @@ CREATE TABLE IF NOT EXISTS closedchannels (
     peer TEXT NOT NULL,
     token TEXT NOT NULL,
     opents TIMESTAMPTZ NOT NULL,
-    closets TIMESTAMPTZ NOT NULL
+    closets TIMESTAMPTZ NOT NULL,
+    chainid INT NOT NULL DEFAULT 0 -- chain of the channel ledger, 0 for the primary chain of the node
 );
 CREATE INDEX IF NOT EXISTS cc_peer_token_idx ON closedchannels (peer, token);
 
@@ -68,6 +70,7 @@ CREATE TABLE IF NOT EXISTS payments (
 CREATE INDEX IF NOT EXISTS pay_src_idx ON payments (src);
 CREATE INDEX IF NOT EXISTS pay_dest_idx ON payments (dest);
 CREATE INDEX IF NOT EXISTS pay_ts_idx ON payments (createts);
+CREATE INDEX IF NOT EXISTS pay_outstate_idx ON payments (outstate);
 
 CREATE TABLE IF NOT EXISTS paydelegation (
     payid TEXT PRIMARY KEY NOT NULL REFERENCES payments (payid) ON UPDATE CASCADE ON DELETE CASCADE,
@@ -188,7 +191,8 @@ CREATE TABLE IF NOT EXISTS deposit (
     deadline TIMESTAMPTZ NOT NULL,
     state INT NOT NULL,
     txhash TEXT NOT NULL,
-    errmsg TEXT NOT NULL
+    errmsg TEXT NOT NULL,
+    chainid INT NOT NULL DEFAULT 0 -- chain of the channel, 0 for the primary chain of the node
 );
 CREATE INDEX IF NOT EXISTS deposit_cid_idx ON deposit (cid);
 CREATE INDEX IF NOT EXISTS deposit_state_idx ON deposit (state);
@@ -199,3 +203,138 @@ CREATE TABLE IF NOT EXISTS lease (
     owner TEXT NOT NULL,
     updatets TIMESTAMPTZ NOT NULL
 );
+
+CREATE TABLE IF NOT EXISTS appsessions (
+    sessionid TEXT PRIMARY KEY NOT NULL,
+    type INT NOT NULL,
+    nonce TEXT NOT NULL,
+    bytecode BYTEA,
+    constructor BYTEA,
+    players TEXT NOT NULL, -- comma-separated list of players
+    deployedaddr TEXT NOT NULL,
+    onchaintimeout INT NOT NULL,
+    seqnum INT NOT NULL,
+    stateproof BYTEA,
+    watchblock INT NOT NULL, -- start block of the dispute watch, 0 if not watching
+    createts TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS appdisputes (
+    sessionid TEXT PRIMARY KEY NOT NULL,
+    seqnum INT NOT NULL -- seq num of the dispute caught before the app attached its callback
+);
+
+CREATE TABLE IF NOT EXISTS paytrace (
+    payid TEXT PRIMARY KEY NOT NULL,
+    traceid TEXT NOT NULL,
+    prevhop TEXT NOT NULL,
+    nexthop TEXT NOT NULL,
+    recvts INT NOT NULL, -- unix nanoseconds, 0 if not reached
+    fwdts INT NOT NULL,
+    receiptts INT NOT NULL,
+    errs TEXT NOT NULL -- newline-separated list of errors
+);
+CREATE INDEX IF NOT EXISTS paytrace_traceid_idx ON paytrace (traceid);
+
+CREATE TABLE IF NOT EXISTS payfees (
+    payid TEXT NOT NULL REFERENCES payments (payid) ON UPDATE CASCADE ON DELETE CASCADE,
+    cid TEXT NOT NULL,
+    fee TEXT NOT NULL, -- relay fee locked with the pay in the channel, transferred only if the pay is paid
+    PRIMARY KEY (payid, cid)
+);
+
+CREATE TABLE IF NOT EXISTS payhistory (
+    payid TEXT PRIMARY KEY NOT NULL,
+    src TEXT NOT NULL,
+    dest TEXT NOT NULL,
+    token TEXT NOT NULL,
+    amt TEXT NOT NULL,
+    status INT NOT NULL, -- sdk pay status
+    createts TIMESTAMPTZ NOT NULL,
+    updatets TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX IF NOT EXISTS payhist_src_idx ON payhistory (src);
+CREATE INDEX IF NOT EXISTS payhist_dest_idx ON payhistory (dest);
+CREATE INDEX IF NOT EXISTS payhist_token_idx ON payhistory (token);
+CREATE INDEX IF NOT EXISTS payhist_ts_idx ON payhistory (createts);
+
+CREATE TABLE IF NOT EXISTS txs (
+    txhash TEXT PRIMARY KEY NOT NULL, -- hash of the first broadcast, used as tx id
+    chainid INT NOT NULL, -- 0 for the primary chain of the node
+    sender TEXT NOT NULL,
+    nonce INT NOT NULL,
+    state INT NOT NULL,
+    rawtx BYTEA NOT NULL, -- latest broadcast signed tx
+    hashes TEXT NOT NULL, -- comma-separated list of all broadcast hashes
+    description TEXT NOT NULL,
+    createts TIMESTAMPTZ NOT NULL,
+    updatets TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS eventlogs (
+    blkhash TEXT NOT NULL,
+    logindex INT NOT NULL,
+    chainid INT NOT NULL, -- 0 for the primary chain of the node
+    blknum INT NOT NULL,
+    event TEXT NOT NULL,
+    txhash TEXT NOT NULL,
+    rawlog BYTEA NOT NULL, -- json encoded log
+    PRIMARY KEY (blkhash, logindex)
+);
+
+CREATE TABLE IF NOT EXISTS acctentries (
+    entryid TEXT NOT NULL,
+    account TEXT NOT NULL,
+    token TEXT NOT NULL,
+    amt TEXT NOT NULL, -- debit positive, credit negative, sums to zero per entry and token
+    memo TEXT NOT NULL,
+    ts TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (entryid, account, token)
+);
+CREATE INDEX IF NOT EXISTS acct_ts_idx ON acctentries (ts);
+
+CREATE TABLE IF NOT EXISTS exitjobs (
+    cid TEXT PRIMARY KEY NOT NULL,
+    reason TEXT NOT NULL,
+    state INT NOT NULL,
+    finalizeblk INT NOT NULL, -- block number after which the settle can be confirmed, 0 if not intended
+    errmsg TEXT NOT NULL, -- last error of the current step
+    createts TIMESTAMPTZ NOT NULL,
+    updatets TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS peerstreams (
+    peer TEXT PRIMARY KEY NOT NULL,
+    offlinesecs INT NOT NULL -- seconds the stream to the peer has been down while running, 0 once connected
+);
+
+CREATE TABLE IF NOT EXISTS payclearjobs (
+    payid TEXT PRIMARY KEY NOT NULL,
+    cid TEXT NOT NULL,
+    step TEXT NOT NULL, -- last step taken by the client pay clearer
+    blknum INT NOT NULL -- block number when the step was taken
+);
+
+CREATE TABLE IF NOT EXISTS rebalancebudgets (
+    token TEXT PRIMARY KEY NOT NULL,
+    day INT NOT NULL, -- UTC day of the spent amounts, days since epoch
+    spent TEXT NOT NULL, -- amount of rebalance pays sent on the day
+    feespent TEXT NOT NULL -- relay fees of rebalance pays sent on the day
+);
+
+-- Upgrade of databases created before the chainid columns were added.
+-- SQLite clients are upgraded by the store on open, see migrateSchema().
+-- START OF CRDB MIGRATION
+ALTER TABLE channels ADD COLUMN IF NOT EXISTS chainid INT NOT NULL DEFAULT 0;
+ALTER TABLE txs ADD COLUMN IF NOT EXISTS chainid INT NOT NULL DEFAULT 0;
+ALTER TABLE eventlogs ADD COLUMN IF NOT EXISTS chainid INT NOT NULL DEFAULT 0;
+ALTER TABLE closedchannels ADD COLUMN IF NOT EXISTS chainid INT NOT NULL DEFAULT 0;
+ALTER TABLE deposit ADD COLUMN IF NOT EXISTS chainid INT NOT NULL DEFAULT 0;
+CREATE UNIQUE INDEX IF NOT EXISTS channels_peer_token_chainid_key ON channels (peer, token, chainid);
+DROP INDEX IF EXISTS channels@channels_peer_token_key CASCADE;
+-- END OF CRDB MIGRATION
+
+CREATE INDEX IF NOT EXISTS chan_chainid_idx ON channels (chainid);
+CREATE INDEX IF NOT EXISTS txs_chainid_state_idx ON txs (chainid, state);
+CREATE INDEX IF NOT EXISTS eventlogs_chainid_blknum_idx ON eventlogs (chainid, blknum);
+CREATE INDEX IF NOT EXISTS deposit_chainid_state_idx ON deposit (chainid, state);
@@ -6,16 +6,17 @@ package storage
 var sqlSchemaCmds = [...]string{
 	"CREATE TABLE IF NOT EXISTS keyvals ( key TEXT PRIMARY KEY NOT NULL, tbl TEXT NOT NULL, val BYTEA NOT NULL );",
 	"CREATE INDEX IF NOT EXISTS kvs_tbl_idx ON keyvals (tbl);",
-	"CREATE TABLE IF NOT EXISTS channels ( cid TEXT PRIMARY KEY NOT NULL, peer TEXT NOT NULL, token TEXT NOT NULL, ledger TEXT NOT NULL, state INT NOT NULL, statets TIMESTAMPTZ NOT NULL, opents TIMESTAMPTZ NOT NULL, openresp BYTEA, onchainbalance BYTEA, basesn INT NOT NULL, lastusedsn INT NOT NULL, lastackedsn INT NOT NULL, lastnackedsn INT NOT NULL, selfsimplex BYTEA, peersimplex BYTEA, UNIQUE (peer, token) );",
+	"CREATE TABLE IF NOT EXISTS channels ( cid TEXT PRIMARY KEY NOT NULL, peer TEXT NOT NULL, token TEXT NOT NULL, ledger TEXT NOT NULL, state INT NOT NULL, statets TIMESTAMPTZ NOT NULL, opents TIMESTAMPTZ NOT NULL, openresp BYTEA, onchainbalance BYTEA, basesn INT NOT NULL, lastusedsn INT NOT NULL, lastackedsn INT NOT NULL, lastnackedsn INT NOT NULL, selfsimplex BYTEA, peersimplex BYTEA, chainid INT NOT NULL DEFAULT 0,  UNIQUE (peer, token, chainid) );",
 	"CREATE INDEX IF NOT EXISTS chan_ledger_idx ON channels (ledger);",
 	"CREATE INDEX IF NOT EXISTS chan_state_idx ON channels (state);",
 	"CREATE INDEX IF NOT EXISTS chan_token_idx ON channels (token);",
-	"CREATE TABLE IF NOT EXISTS closedchannels ( cid TEXT PRIMARY KEY NOT NULL, peer TEXT NOT NULL, token TEXT NOT NULL, opents TIMESTAMPTZ NOT NULL, closets TIMESTAMPTZ NOT NULL );",
+	"CREATE TABLE IF NOT EXISTS closedchannels ( cid TEXT PRIMARY KEY NOT NULL, peer TEXT NOT NULL, token TEXT NOT NULL, opents TIMESTAMPTZ NOT NULL, closets TIMESTAMPTZ NOT NULL, chainid INT NOT NULL DEFAULT 0  );",
 	"CREATE INDEX IF NOT EXISTS cc_peer_token_idx ON closedchannels (peer, token);",
 	"CREATE TABLE IF NOT EXISTS payments ( payid TEXT PRIMARY KEY NOT NULL, pay BYTEA, paynote BYTEA, incid TEXT NOT NULL, instate INT NOT NULL, outcid TEXT NOT NULL, outstate INT NOT NULL, src TEXT NOT NULL, dest TEXT NOT NULL, createts TIMESTAMPTZ NOT NULL );",
 	"CREATE INDEX IF NOT EXISTS pay_src_idx ON payments (src);",
 	"CREATE INDEX IF NOT EXISTS pay_dest_idx ON payments (dest);",
 	"CREATE INDEX IF NOT EXISTS pay_ts_idx ON payments (createts);",
+	"CREATE INDEX IF NOT EXISTS pay_outstate_idx ON payments (outstate);",
 	"CREATE TABLE IF NOT EXISTS paydelegation ( payid TEXT PRIMARY KEY NOT NULL REFERENCES payments (payid) ON UPDATE CASCADE ON DELETE CASCADE, dest TEXT NOT NULL, status INT NOT NULL, payidout TEXT, delegator TEXT );",
 	"CREATE INDEX IF NOT EXISTS paydel_dest_idx ON paydelegation (dest);",
 	"CREATE TABLE IF NOT EXISTS crossnetpays ( payid TEXT PRIMARY KEY NOT NULL REFERENCES payments (payid) ON UPDATE CASCADE ON DELETE CASCADE, originalpayid TEXT NOT NULL, originalpay BYTEA, state INT NOT NULL, srcnetid INT NOT NULL, dstnetid INT NOT NULL, bridgeaddr TEXT NOT NULL, bridgenetid INT NOT NULL, UNIQUE (originalpayid) );",
@@ -34,9 +35,31 @@ var sqlSchemaCmds = [...]string{
 	"CREATE TABLE IF NOT EXISTS chanmessages ( cid TEXT NOT NULL, seqnum INT NOT NULL, msg BYTEA, UNIQUE (cid, seqnum) );",
 	"CREATE TABLE IF NOT EXISTS chanmigration ( cid TEXT NOT NULL REFERENCES channels (cid) ON DELETE CASCADE, toledger TEXT NOT NULL, deadline INT NOT NULL, onchainreq BYTEA, state INT NOT NULL, ts TIMESTAMPTZ NOT NULL, UNIQUE (cid, toledger) );",
 	"CREATE INDEX IF NOT EXISTS mg_toledger_state_idx ON chanmigration (toledger, state);",
-	"CREATE TABLE IF NOT EXISTS deposit ( uuid TEXT PRIMARY KEY NOT NULL, cid TEXT NOT NULL, topeer BOOL NOT NULL, amount TEXT NOT NULL, refill BOOL NOT NULL, deadline TIMESTAMPTZ NOT NULL, state INT NOT NULL, txhash TEXT NOT NULL, errmsg TEXT NOT NULL );",
+	"CREATE TABLE IF NOT EXISTS deposit ( uuid TEXT PRIMARY KEY NOT NULL, cid TEXT NOT NULL, topeer BOOL NOT NULL, amount TEXT NOT NULL, refill BOOL NOT NULL, deadline TIMESTAMPTZ NOT NULL, state INT NOT NULL, txhash TEXT NOT NULL, errmsg TEXT NOT NULL, chainid INT NOT NULL DEFAULT 0  );",
 	"CREATE INDEX IF NOT EXISTS deposit_cid_idx ON deposit (cid);",
 	"CREATE INDEX IF NOT EXISTS deposit_state_idx ON deposit (state);",
 	"CREATE INDEX IF NOT EXISTS deposit_txhash_idx ON deposit (txhash);",
 	"CREATE TABLE IF NOT EXISTS lease ( id TEXT PRIMARY KEY NOT NULL, owner TEXT NOT NULL, updatets TIMESTAMPTZ NOT NULL );",
+	"CREATE TABLE IF NOT EXISTS appsessions ( sessionid TEXT PRIMARY KEY NOT NULL, type INT NOT NULL, nonce TEXT NOT NULL, bytecode BYTEA, constructor BYTEA, players TEXT NOT NULL,  deployedaddr TEXT NOT NULL, onchaintimeout INT NOT NULL, seqnum INT NOT NULL, stateproof BYTEA, watchblock INT NOT NULL,  createts TIMESTAMPTZ NOT NULL );",
+	"CREATE TABLE IF NOT EXISTS appdisputes ( sessionid TEXT PRIMARY KEY NOT NULL, seqnum INT NOT NULL  );",
+	"CREATE TABLE IF NOT EXISTS paytrace ( payid TEXT PRIMARY KEY NOT NULL, traceid TEXT NOT NULL, prevhop TEXT NOT NULL, nexthop TEXT NOT NULL, recvts INT NOT NULL,  fwdts INT NOT NULL, receiptts INT NOT NULL, errs TEXT NOT NULL  );",
+	"CREATE INDEX IF NOT EXISTS paytrace_traceid_idx ON paytrace (traceid);",
+	"CREATE TABLE IF NOT EXISTS payfees ( payid TEXT NOT NULL REFERENCES payments (payid) ON UPDATE CASCADE ON DELETE CASCADE, cid TEXT NOT NULL, fee TEXT NOT NULL,  PRIMARY KEY (payid, cid) );",
+	"CREATE TABLE IF NOT EXISTS payhistory ( payid TEXT PRIMARY KEY NOT NULL, src TEXT NOT NULL, dest TEXT NOT NULL, token TEXT NOT NULL, amt TEXT NOT NULL, status INT NOT NULL,  createts TIMESTAMPTZ NOT NULL, updatets TIMESTAMPTZ NOT NULL );",
+	"CREATE INDEX IF NOT EXISTS payhist_src_idx ON payhistory (src);",
+	"CREATE INDEX IF NOT EXISTS payhist_dest_idx ON payhistory (dest);",
+	"CREATE INDEX IF NOT EXISTS payhist_token_idx ON payhistory (token);",
+	"CREATE INDEX IF NOT EXISTS payhist_ts_idx ON payhistory (createts);",
+	"CREATE TABLE IF NOT EXISTS txs ( txhash TEXT PRIMARY KEY NOT NULL,  chainid INT NOT NULL,  sender TEXT NOT NULL, nonce INT NOT NULL, state INT NOT NULL, rawtx BYTEA NOT NULL,  hashes TEXT NOT NULL,  description TEXT NOT NULL, createts TIMESTAMPTZ NOT NULL, updatets TIMESTAMPTZ NOT NULL );",
+	"CREATE TABLE IF NOT EXISTS eventlogs ( blkhash TEXT NOT NULL, logindex INT NOT NULL, chainid INT NOT NULL,  blknum INT NOT NULL, event TEXT NOT NULL, txhash TEXT NOT NULL, rawlog BYTEA NOT NULL,  PRIMARY KEY (blkhash, logindex) );",
+	"CREATE TABLE IF NOT EXISTS acctentries ( entryid TEXT NOT NULL, account TEXT NOT NULL, token TEXT NOT NULL, amt TEXT NOT NULL,  memo TEXT NOT NULL, ts TIMESTAMPTZ NOT NULL, PRIMARY KEY (entryid, account, token) );",
+	"CREATE INDEX IF NOT EXISTS acct_ts_idx ON acctentries (ts);",
+	"CREATE TABLE IF NOT EXISTS exitjobs ( cid TEXT PRIMARY KEY NOT NULL, reason TEXT NOT NULL, state INT NOT NULL, finalizeblk INT NOT NULL,  errmsg TEXT NOT NULL,  createts TIMESTAMPTZ NOT NULL, updatets TIMESTAMPTZ NOT NULL );",
+	"CREATE TABLE IF NOT EXISTS peerstreams ( peer TEXT PRIMARY KEY NOT NULL, offlinesecs INT NOT NULL  );",
+	"CREATE TABLE IF NOT EXISTS payclearjobs ( payid TEXT PRIMARY KEY NOT NULL, cid TEXT NOT NULL, step TEXT NOT NULL,  blknum INT NOT NULL  );",
+	"CREATE TABLE IF NOT EXISTS rebalancebudgets ( token TEXT PRIMARY KEY NOT NULL, day INT NOT NULL,  spent TEXT NOT NULL,  feespent TEXT NOT NULL  );",
+	"CREATE INDEX IF NOT EXISTS chan_chainid_idx ON channels (chainid);",
+	"CREATE INDEX IF NOT EXISTS txs_chainid_state_idx ON txs (chainid, state);",
+	"CREATE INDEX IF NOT EXISTS eventlogs_chainid_blknum_idx ON eventlogs (chainid, blknum);",
+	"CREATE INDEX IF NOT EXISTS deposit_chainid_state_idx ON deposit (chainid, state);",
 }
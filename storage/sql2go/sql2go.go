@@ -16,6 +16,8 @@ import (
 
 const (
 	sqliteStart = "START OF PORTABLE SCHEMA"
+	crdbStart   = "START OF CRDB MIGRATION"
+	crdbEnd     = "END OF CRDB MIGRATION"
 )
 
 var (
@@ -40,7 +42,7 @@ func sql2go(fname string) error {
 	}
 
 	scanner := bufio.NewScanner(in)
-	start := false
+	start, skip := false, false
 	var buf, sqlCmds []string
 	for scanner.Scan() {
 		line := strings.TrimSpace(scanner.Text())
@@ -53,6 +55,16 @@ func sql2go(fname string) error {
 			}
 			continue
 		}
+		// CockroachDB-only statements are not part of the SQLite schema
+		if strings.Contains(line, crdbStart) {
+			skip = true
+			continue
+		} else if strings.Contains(line, crdbEnd) {
+			skip = false
+			continue
+		} else if skip {
+			continue
+		}
 		if pos := strings.Index(line, "--"); pos >= 0 {
 			line = line[:pos] // strip trailing comment
 			if len(line) == 0 {
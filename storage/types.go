@@ -23,6 +23,7 @@ type KVStore interface {
 	Exec(query string, args ...interface{}) (sql.Result, error)
 	Query(query string, args ...interface{}) (*sql.Rows, error)
 	QueryRow(query string, args ...interface{}) *sql.Row
+	Ping() error
 }
 
 // Transaction is the interface implemented by the local and remote stores.
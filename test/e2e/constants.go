@@ -100,6 +100,7 @@ const (
 
 	rtConfig         = "../../testing/profile/rt_config.json"
 	rtConfigMultiOSP = "../../testing/profile/rt_config_multiosp.json"
+	rtConfigFee      = "../../testing/profile/rt_config_fee.json"
 	tokensConfig     = "../../testing/profile/tokens.json"
 	xnetConfigDir    = "../../testing/profile/crossnet/"
 
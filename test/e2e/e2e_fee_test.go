@@ -0,0 +1,153 @@
+// Copyright 2020 Celer Network
+
+package e2e
+
+import (
+	"os"
+	"testing"
+
+	"github.com/celer-network/goCeler/entity"
+	tf "github.com/celer-network/goCeler/testing"
+	"github.com/celer-network/goutils/log"
+)
+
+func setUpRelayFee() []Killable {
+	os.RemoveAll(sStoreDir)
+	s1 := tf.StartServerController(outRootDir+toBuild["server"],
+		"-profile", noProxyProfile,
+		"-port", sPort,
+		"-selfrpc", sSelfRPC,
+		"-storedir", sStoreDir,
+		"-ks", ospKeystore,
+		"-depositks", depositKeystore,
+		"-nopassword",
+		"-rtc", rtConfigFee,
+		"-svrname", "s1",
+		"-logprefix", "s1_"+ospEthAddr[:4],
+		"-logcolor")
+
+	return []Killable{s1}
+}
+
+func TestE2ERelayFee(t *testing.T) {
+	toKill := setUpRelayFee()
+	defer tearDownMultiSvr(toKill)
+
+	t.Run("e2e-fee", func(t *testing.T) {
+		t.Run("sendCondPayWithRelayFee", sendCondPayWithRelayFee)
+	})
+}
+
+func sendCondPayWithRelayFee(t *testing.T) {
+	log.Info("============== start test sendCondPayWithRelayFee ==============")
+	defer log.Info("============== end test sendCondPayWithRelayFee ==============")
+	// rt_config_fee.json charges 1000 wei base fee plus 1000 ppm for eth
+	const payAmt = "1000000"
+	const expFee = "2000"
+	tokenType := entity.TokenType_ETH
+	tokenAddr := tokenAddrEth
+
+	ks, addrs, err := tf.CreateAccountsWithBalance(2, accountBalance)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	log.Infoln("create accounts for sendCondPayWithRelayFee", addrs)
+	c1KeyStore := ks[0]
+	c2KeyStore := ks[1]
+	c1EthAddr := addrs[0]
+	c2EthAddr := addrs[1]
+
+	c1, err := tf.StartC1WithoutProxy(c1KeyStore)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer c1.Kill()
+
+	c2, err := tf.StartC2WithoutProxy(c2KeyStore)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer c2.Kill()
+
+	_, err = c1.OpenChannel(c1EthAddr, tokenType, tokenAddr, initialBalance, initialBalance)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	_, err = c2.OpenChannel(c2EthAddr, tokenType, tokenAddr, initialBalance, initialBalance)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	fee, err := c1.GetPayFee(c2EthAddr, payAmt, tokenAddr)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if fee != expFee {
+		t.Errorf("wrong fee quote %s, expected %s", fee, expFee)
+		return
+	}
+	fee, err = c1.GetPayFee(ospEthAddr, payAmt, tokenAddr)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if fee != "0" {
+		t.Errorf("wrong fee quote %s for pay to osp", fee)
+		return
+	}
+
+	log.Info("------------ sending pay without fee --------")
+	c1SendCompleteChan := make(chan string, 1)
+	c1SendErrChan := make(chan string, 1)
+	c1.SubscribeOutgoingPayments(c1SendCompleteChan, c1SendErrChan)
+	_, err = c1.SendPayment(c2EthAddr, payAmt, tokenType, tokenAddr)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	c1Err := <-c1SendErrChan
+	log.Infoln("pay without fee failed:", c1Err)
+	err = c1.AssertBalance(tokenAddr, initialBalance, "0", initialBalance)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	log.Info("------------ sending pay with fee --------")
+	p1, err := c1.SendPaymentWithFee(c2EthAddr, payAmt, fee, tokenType, tokenAddr)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	err = waitForPaymentCompletion(p1, c1, c2)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	c1Sent := tf.AddAmtStr(payAmt, expFee)
+	err = c1.AssertBalance(
+		tokenAddr,
+		tf.AddAmtStr(initialBalance, "-"+c1Sent),
+		"0",
+		tf.AddAmtStr(initialBalance, c1Sent))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	err = c2.AssertBalance(
+		tokenAddr,
+		tf.AddAmtStr(initialBalance, payAmt),
+		"0",
+		tf.AddAmtStr(initialBalance, "-"+payAmt))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+}
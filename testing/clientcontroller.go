@@ -124,6 +124,31 @@ func (cc *ClientController) SendPayment(
 		destination, amountWei, tokenType, tokenAddress, []*entity.Condition{}, 100)
 }
 
+// SendPaymentWithFee sends a payment along with the relay fee for the OSPs
+func (cc *ClientController) SendPaymentWithFee(
+	destination string,
+	amountWei string,
+	feeWei string,
+	tokenType entity.TokenType,
+	tokenAddress string) (string, error) {
+	return cc.sendPayment(destination, amountWei, feeWei, tokenType, tokenAddress, []*entity.Condition{}, 100)
+}
+
+// GetPayFee returns the relay fee quoted by OSP for a payment
+func (cc *ClientController) GetPayFee(destination, amountWei, tokenAddress string) (string, error) {
+	resp, err := cc.apiClient.GetPayFee(
+		context.Background(),
+		&msgrpc.GetPayFeeRequest{
+			Token: tokenAddress,
+			Dst:   destination,
+			Amt:   amountWei,
+		})
+	if err != nil {
+		return "", err
+	}
+	return resp.Fee, nil
+}
+
 func (cc *ClientController) SendPaymentWithBooleanConditions(
 	destination string,
 	amountWei string,
@@ -131,6 +156,17 @@ func (cc *ClientController) SendPaymentWithBooleanConditions(
 	tokenAddress string,
 	conditions []*entity.Condition,
 	timeout uint64) (string, error) {
+	return cc.sendPayment(destination, amountWei, "", tokenType, tokenAddress, conditions, timeout)
+}
+
+func (cc *ClientController) sendPayment(
+	destination string,
+	amountWei string,
+	feeWei string,
+	tokenType entity.TokenType,
+	tokenAddress string,
+	conditions []*entity.Condition,
+	timeout uint64) (string, error) {
 	rpcConditions := make([]*rpc.Condition, len(conditions))
 	for i, condition := range conditions {
 		var onChainDeployed bool
@@ -159,6 +195,7 @@ func (cc *ClientController) SendPaymentWithBooleanConditions(
 			Amount:      amountWei,
 			Conditions:  rpcConditions,
 			Timeout:     timeout,
+			Fee:         feeWei,
 		})
 	if err != nil {
 		return "", err
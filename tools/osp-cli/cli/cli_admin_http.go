@@ -5,6 +5,7 @@ package cli
 import (
 	"bufio"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -49,6 +50,10 @@ func registerStream(peerAddr, peerHostPort string) {
 }
 
 func OpenChannel() {
+	if *batchfile != "" {
+		batchOpenChannel()
+		return
+	}
 	peerDepositWei := utils.Float2Wei(*peerdeposit)
 	selfDepositWei := utils.Float2Wei(*selfdeposit)
 	err := utils.RequestOpenChannel(
@@ -61,6 +66,51 @@ func OpenChannel() {
 		*peeraddr, utils.PrintTokenAddr(ctype.Hex2Addr(*tokenaddr)), *selfdeposit, *peerdeposit)
 }
 
+// batchOpenChannel reads lines of "peer token selfdeposit peerdeposit" from the batch file
+func batchOpenChannel() {
+	file, err := os.Open(*batchfile)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	defer file.Close()
+	var channels []*rpc.OspOpenChannelRequest
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		fields := strings.Fields(line)
+		if len(fields) != 4 {
+			log.Errorln("invalid file input:", line)
+			return
+		}
+		selfDeposit, err := strconv.ParseFloat(fields[2], 64)
+		if err != nil || selfDeposit < 0 {
+			log.Errorln("invalid self deposit:", line)
+			return
+		}
+		peerDeposit, err := strconv.ParseFloat(fields[3], 64)
+		if err != nil || peerDeposit < 0 {
+			log.Errorln("invalid peer deposit:", line)
+			return
+		}
+		channels = append(channels, utils.NewOspOpenChannelRequest(
+			ctype.Hex2Addr(fields[0]), ctype.Hex2Addr(fields[1]), utils.Float2Wei(peerDeposit), utils.Float2Wei(selfDeposit)))
+	}
+	log.Infof("requesting to open %d channels", len(channels))
+	err = utils.RequestBatchOpenChannel(*adminhostport, channels, func(progress *rpc.BatchOpenChannelProgress) {
+		if progress.GetError() != "" {
+			log.Infof("channel %d with %x, token %s: %s, cid %s, err: %s", progress.GetIndex(), progress.GetPeerEthAddress(),
+				utils.PrintTokenAddr(ctype.Bytes2Addr(progress.GetTokenAddress())), progress.GetState(), progress.GetCid(), progress.GetError())
+			return
+		}
+		log.Infof("channel %d with %x, token %s: %s, cid %s", progress.GetIndex(), progress.GetPeerEthAddress(),
+			utils.PrintTokenAddr(ctype.Bytes2Addr(progress.GetTokenAddress())), progress.GetState(), progress.GetCid())
+	})
+	if err != nil {
+		log.Error(err)
+	}
+}
+
 func SendToken() {
 	amtWei := utils.Float2Wei(*amount)
 	payID, err := utils.RequestSendToken(
@@ -120,3 +170,49 @@ func QueryPeerOsps() {
 		}
 	}
 }
+
+func QueryPayTrace() {
+	res, err := utils.QueryPayTrace(*adminhostport, *payid)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	log.Infof("pay %s trace %s, %d hops", *payid, res.GetTraceId(), len(res.GetSpans()))
+	for _, span := range res.GetSpans() {
+		log.Infof("-- node %x prev %x next %x recv %s fwd %s receipt %s",
+			span.GetNode(), span.GetPrevHop(), span.GetNextHop(),
+			printTraceTs(span.GetRecvTs()), printTraceTs(span.GetFwdTs()), printTraceTs(span.GetReceiptTs()))
+		for _, e := range span.GetErrors() {
+			log.Infof("---- error: %s", e)
+		}
+	}
+}
+
+func Rebalance() {
+	res, err := utils.RequestRebalance(*adminhostport, *tokenaddr, *dryrun)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	if len(res.GetPlans()) == 0 {
+		log.Info("no peer OSP channel to rebalance")
+		return
+	}
+	for _, plan := range res.GetPlans() {
+		if plan.GetError() != "" {
+			log.Errorf("rebalance token %s amount %s route %v failed: %s",
+				utils.PrintTokenAddr(ctype.Hex2Addr(plan.GetTokenAddress())), plan.GetAmtWei(), plan.GetRoute(), plan.GetError())
+			continue
+		}
+		log.Infof("rebalance token %s amount %s drained ratio %.2f route %v pay %s",
+			utils.PrintTokenAddr(ctype.Hex2Addr(plan.GetTokenAddress())), plan.GetAmtWei(), plan.GetDrainedRatio(),
+			plan.GetRoute(), plan.GetPayId())
+	}
+}
+
+func printTraceTs(ts int64) string {
+	if ts == 0 {
+		return "-"
+	}
+	return time.Unix(0, ts).UTC().Format(time.RFC3339Nano)
+}
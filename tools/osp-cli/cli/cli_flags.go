@@ -49,10 +49,18 @@ var (
 	netid        = flag.Uint64("netid", 0, "net id")
 	bridgeaddr   = flag.String("bridgeaddr", "", "net bridge address")
 	localtoken   = flag.String("localtoken", "", "local token address")
+	dryrun       = flag.Bool("dryrun", false, "plan the operation without executing it")
+	refreshsec   = flag.Int("refreshsec", 5, "refresh interval (in sec) of the interactive admin ui")
+	fromdate     = flag.String("from", "", "report start date (inclusive) in YYYY-MM-DD, default first day of this month")
+	todate       = flag.String("to", "", "report end date (exclusive) in YYYY-MM-DD, default now")
+	period       = flag.String("period", "", "break the report down by day or month")
 )
 
 func CheckFlags() {
 	if *amount < 0 || *peerdeposit < 0 || *selfdeposit < 0 || *maxwaitsec < 0 {
 		log.Fatal("incorrect parameters")
 	}
+	if *period != "" && *period != "day" && *period != "month" {
+		log.Fatal("period must be day or month")
+	}
 }
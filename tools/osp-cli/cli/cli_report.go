@@ -0,0 +1,97 @@
+// Copyright 2020 Celer Network
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/celer-network/goCeler/accounting"
+	"github.com/celer-network/goCeler/common/structs"
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goutils/log"
+)
+
+const dateLayout = "2006-01-02"
+
+// Report prints the accounting summaries by token over [from, to), broken down by day or month if requested
+func (p *Processor) Report() {
+	fmt.Println()
+	since, until := reportRange()
+	records, err := p.dal.GetAcctRecords(since, until)
+	if err != nil {
+		log.Fatalln("GetAcctRecords err:", err)
+	}
+	if *tokenaddr != "" {
+		records = filterRecordsByToken(records, ctype.Hex2Addr(*tokenaddr))
+	}
+
+	fmt.Printf("-- accounting report from %s to %s, %d entries\n",
+		since.Format(dateLayout), until.Format(dateLayout), len(records))
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "PERIOD\tTOKEN\tFEE INCOME\tFEE PAID\tGAS COST\tNET REVENUE\tDEPOSITS\tWITHDRAWALS")
+	start := 0
+	for start < len(records) {
+		period := periodOf(records[start].Ts)
+		end := start
+		for end < len(records) && periodOf(records[end].Ts) == period {
+			end++
+		}
+		for _, s := range accounting.Summarize(records[start:end]) {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", period, ctype.Addr2Hex(s.Token),
+				s.FeeIncome, s.FeeExpense, s.GasCost, s.NetRevenue(), s.Deposits, s.Withdrawals)
+		}
+		start = end
+	}
+	w.Flush()
+}
+
+// reportRange parses the -from and -to dates, default to the current month
+func reportRange() (time.Time, time.Time) {
+	now := time.Now().UTC()
+	since := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	until := now
+	var err error
+	if *fromdate != "" {
+		since, err = time.Parse(dateLayout, *fromdate)
+		if err != nil {
+			log.Fatalln("invalid -from date:", err)
+		}
+	}
+	if *todate != "" {
+		until, err = time.Parse(dateLayout, *todate)
+		if err != nil {
+			log.Fatalln("invalid -to date:", err)
+		}
+	}
+	if !until.After(since) {
+		log.Fatalln("-to date must be after -from date")
+	}
+	return since, until
+}
+
+// periodOf returns the -period the ts falls in
+func periodOf(ts time.Time) string {
+	switch *period {
+	case "day":
+		return ts.UTC().Format(dateLayout)
+	case "month":
+		return ts.UTC().Format("2006-01")
+	}
+	return "all"
+}
+
+func filterRecordsByToken(records []*structs.AcctRecord, token ctype.Addr) []*structs.AcctRecord {
+	var res []*structs.AcctRecord
+	for _, record := range records {
+		for _, posting := range record.Postings {
+			if posting.Token == token {
+				res = append(res, record)
+				break
+			}
+		}
+	}
+	return res
+}
@@ -0,0 +1,323 @@
+// Copyright 2020 Celer Network
+
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/rpc"
+	"github.com/celer-network/goCeler/utils"
+)
+
+const (
+	tuiViewPeerOsps = iota + 1
+	tuiViewBalances
+	tuiViewPendingPays
+	tuiViewDepositJobs
+	tuiViewRoutes
+)
+
+var tuiViewNames = map[int]string{
+	tuiViewPeerOsps:    "peer OSPs",
+	tuiViewBalances:    "channel balances",
+	tuiViewPendingPays: "pending pays",
+	tuiViewDepositJobs: "deposit jobs",
+	tuiViewRoutes:      "routing table",
+}
+
+// tui is an interactive terminal ui over the OSP admin http endpoint. The current view is
+// refreshed periodically, and commands are read line by line from stdin.
+type tui struct {
+	view   int
+	token  string // token filter of balances and routes, all tokens if empty
+	cid    string // channel filter of pending pays and deposit jobs, all channels if empty
+	status string // result of the last command
+	lines  chan string
+	out    io.Writer
+}
+
+// RunTUI runs the interactive admin ui until quit or stdin is closed
+func RunTUI() {
+	t := &tui{
+		view:  tuiViewPeerOsps,
+		token: *tokenaddr,
+		cid:   *chanid,
+		lines: make(chan string),
+		out:   os.Stdout,
+	}
+	go t.readLines()
+	refresh := time.Duration(*refreshsec) * time.Second
+	if refresh <= 0 {
+		refresh = 5 * time.Second
+	}
+	for {
+		t.render()
+		select {
+		case line, ok := <-t.lines:
+			if !ok || !t.handle(strings.Fields(line)) {
+				return
+			}
+		case <-time.After(refresh):
+		}
+	}
+}
+
+func (t *tui) readLines() {
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		t.lines <- scanner.Text()
+	}
+	close(t.lines)
+}
+
+// handle runs a command line, returns false to quit
+func (t *tui) handle(fields []string) bool {
+	if len(fields) == 0 {
+		return true
+	}
+	arg := ""
+	if len(fields) > 1 {
+		arg = fields[1]
+	}
+	switch fields[0] {
+	case "q", "quit":
+		return false
+	case "1", "2", "3", "4", "5":
+		t.view, _ = strconv.Atoi(fields[0])
+	case "token":
+		t.token = arg
+	case "cid":
+		t.cid = arg
+	case "open":
+		t.openChannel()
+	case "deposit":
+		t.deposit()
+	case "withdraw":
+		t.withdraw()
+	case "settle":
+		t.settle()
+	default:
+		t.status = fmt.Sprintf("unknown command %q", strings.Join(fields, " "))
+	}
+	return true
+}
+
+func (t *tui) render() {
+	fmt.Fprint(t.out, "\033[H\033[2J")
+	fmt.Fprintf(t.out, "OSP admin %s | %s | %s\n", *adminhostport, tuiViewNames[t.view], time.Now().Format("15:04:05"))
+	fmt.Fprintf(t.out, "token filter: %s | cid filter: %s\n\n", tuiFilter(t.token), tuiFilter(t.cid))
+	w := tabwriter.NewWriter(t.out, 0, 0, 2, ' ', 0)
+	var err error
+	switch t.view {
+	case tuiViewPeerOsps:
+		err = t.renderPeerOsps(w)
+	case tuiViewBalances:
+		err = t.renderBalances(w)
+	case tuiViewPendingPays:
+		err = t.renderPendingPays(w)
+	case tuiViewDepositJobs:
+		err = t.renderDepositJobs(w)
+	case tuiViewRoutes:
+		err = t.renderRoutes(w)
+	}
+	w.Flush()
+	if err != nil {
+		fmt.Fprintln(t.out, "query err:", err)
+	}
+	fmt.Fprintln(t.out)
+	if t.status != "" {
+		fmt.Fprintln(t.out, t.status)
+	}
+	fmt.Fprintln(t.out, "views: 1 peer osps, 2 balances, 3 pending pays, 4 deposit jobs, 5 routes")
+	fmt.Fprintln(t.out, "filters: token [addr], cid [cid] | actions: open, deposit, withdraw, settle | q quit")
+	fmt.Fprint(t.out, "> ")
+}
+
+func (t *tui) renderPeerOsps(w io.Writer) error {
+	res, err := utils.QueryPeerOsps(*adminhostport)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(w, "OSP\tTOKEN\tCID\tUPDATED")
+	for _, peerOsp := range res.GetPeerOsps() {
+		updated := time.Since(time.Unix(int64(peerOsp.GetUpdateTs()), 0)).Truncate(time.Second)
+		for _, tkcid := range peerOsp.GetTokenCidPairs() {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s ago\n", peerOsp.GetOspAddress(),
+				utils.PrintTokenAddr(ctype.Hex2Addr(tkcid.GetTokenAddress())), tkcid.GetCid(), updated)
+		}
+	}
+	return nil
+}
+
+func (t *tui) renderBalances(w io.Writer) error {
+	res, err := utils.QueryChannelBalances(*adminhostport, t.token, true)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(w, "CID\tPEER\tTOKEN\tMY FREE\tPEER FREE\tMY PENDING\tPEER PENDING")
+	for _, ch := range res.GetChannels() {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", ch.GetCid(), ch.GetPeerAddress(),
+			utils.PrintTokenAddr(ctype.Hex2Addr(ch.GetTokenAddress())), ch.GetMyFreeWei(), ch.GetPeerFreeWei(),
+			ch.GetMyPendingWei(), ch.GetPeerPendingWei())
+	}
+	return nil
+}
+
+func (t *tui) renderPendingPays(w io.Writer) error {
+	res, err := utils.QueryPendingPays(*adminhostport, t.cid)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(w, "PAY\tSRC\tDEST\tTOKEN\tAMOUNT\tINGRESS\tEGRESS\tDEADLINE")
+	for _, pay := range res.GetPays() {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s %s\t%s %s\t%d\n", pay.GetPayId(), pay.GetSrc(), pay.GetDest(),
+			utils.PrintTokenAddr(ctype.Hex2Addr(pay.GetTokenAddress())), pay.GetAmtWei(),
+			pay.GetIngressCid(), pay.GetIngressState(), pay.GetEgressCid(), pay.GetEgressState(), pay.GetResolveDeadline())
+	}
+	return nil
+}
+
+func (t *tui) renderDepositJobs(w io.Writer) error {
+	res, err := utils.QueryDepositJobs(*adminhostport, t.cid)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(w, "DEPOSIT\tCID\tTO PEER\tAMOUNT\tSTATE\tTX\tERROR")
+	for _, job := range res.GetJobs() {
+		fmt.Fprintf(w, "%s\t%s\t%t\t%s\t%s\t%s\t%s\n", job.GetDepositId(), job.GetCid(), job.GetToPeer(),
+			job.GetAmtWei(), rpc.DepositState_name[int32(job.GetDepositState())], job.GetTxHash(), job.GetError())
+	}
+	return nil
+}
+
+func (t *tui) renderRoutes(w io.Writer) error {
+	res, err := utils.QueryRoutingTable(*adminhostport, t.token)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(w, "DEST\tTOKEN\tNEXT HOP\tCID")
+	for _, route := range res.GetRoutes() {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", route.GetDestAddress(),
+			utils.PrintTokenAddr(ctype.Hex2Addr(route.GetTokenAddress())), route.GetNextHopAddress(), route.GetNextHopCid())
+	}
+	return nil
+}
+
+func (t *tui) openChannel() {
+	peer := t.prompt("peer address")
+	token := t.prompt("token address (empty for ETH)")
+	selfDeposit, err := strconv.ParseFloat(t.prompt("self deposit"), 64)
+	if err != nil {
+		t.status = fmt.Sprintf("invalid self deposit: %s", err)
+		return
+	}
+	peerDeposit, err := strconv.ParseFloat(t.prompt("peer deposit"), 64)
+	if err != nil {
+		t.status = fmt.Sprintf("invalid peer deposit: %s", err)
+		return
+	}
+	summary := fmt.Sprintf("open channel with %s, token %s, deposit: <self %f peer %f>",
+		peer, utils.PrintTokenAddr(ctype.Hex2Addr(token)), selfDeposit, peerDeposit)
+	if !t.confirm(summary) {
+		return
+	}
+	err = utils.RequestOpenChannel(*adminhostport, ctype.Hex2Addr(peer), ctype.Hex2Addr(token),
+		utils.Float2Wei(peerDeposit), utils.Float2Wei(selfDeposit))
+	t.setResult(summary, err)
+}
+
+func (t *tui) deposit() {
+	peer := t.prompt("peer address")
+	token := t.prompt("token address (empty for ETH)")
+	amt, err := strconv.ParseFloat(t.prompt("amount"), 64)
+	if err != nil || amt <= 0 {
+		t.status = "invalid deposit amount"
+		return
+	}
+	toPeer := strings.ToLower(t.prompt("deposit to peer side [y/N]")) == "y"
+	summary := fmt.Sprintf("deposit %f to channel with %s, token %s, to peer %t",
+		amt, peer, utils.PrintTokenAddr(ctype.Hex2Addr(token)), toPeer)
+	if !t.confirm(summary) {
+		return
+	}
+	depositID, err := utils.RequestDeposit(
+		*adminhostport, ctype.Hex2Addr(peer), ctype.Hex2Addr(token), utils.Float2Wei(amt), toPeer, 0)
+	t.setResult(fmt.Sprintf("%s, deposit id %s", summary, depositID), err)
+}
+
+func (t *tui) withdraw() {
+	cid := t.prompt("channel id")
+	var amtWei string
+	if amt := t.prompt("amount (empty for all free balance)"); amt != "" {
+		f, err := strconv.ParseFloat(amt, 64)
+		if err != nil || f <= 0 {
+			t.status = "invalid withdraw amount"
+			return
+		}
+		amtWei = utils.Float2Wei(f).String()
+	}
+	summary := fmt.Sprintf("cooperatively withdraw %s wei from channel %s", tuiFilter(amtWei), cid)
+	if !t.confirm(summary) {
+		return
+	}
+	res, err := utils.RequestCooperativeWithdraw(*adminhostport, cid, amtWei)
+	if err == nil && res.GetStatus() != 0 {
+		err = errors.New(res.GetError())
+	}
+	t.setResult(summary, err)
+}
+
+func (t *tui) settle() {
+	cid := t.prompt("channel id")
+	summary := fmt.Sprintf("cooperatively settle channel %s", cid)
+	if !t.confirm(summary) {
+		return
+	}
+	res, err := utils.RequestCooperativeSettle(*adminhostport, cid)
+	if err == nil && res.GetStatus() != 0 {
+		err = errors.New(res.GetError())
+	}
+	t.setResult(summary, err)
+}
+
+// prompt reads the answer without refreshing the view
+func (t *tui) prompt(question string) string {
+	fmt.Fprintf(t.out, "%s: ", question)
+	line, ok := <-t.lines
+	if !ok {
+		return ""
+	}
+	return strings.TrimSpace(line)
+}
+
+func (t *tui) confirm(summary string) bool {
+	if strings.ToLower(t.prompt(summary+"? [y/N]")) != "y" {
+		t.status = "cancelled: " + summary
+		return false
+	}
+	return true
+}
+
+func (t *tui) setResult(summary string, err error) {
+	if err != nil {
+		t.status = fmt.Sprintf("failed to %s: %s", summary, err)
+		return
+	}
+	t.status = "requested to " + summary
+}
+
+func tuiFilter(s string) string {
+	if s == "" {
+		return "all"
+	}
+	return s
+}
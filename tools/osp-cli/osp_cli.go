@@ -16,6 +16,9 @@ var (
 	deposit         = flag.Bool("deposit", false, "make an on-chain deposit to a channel")
 	querydeposit    = flag.Bool("querydeposit", false, "query the status of a deposit job")
 	querypeerosps   = flag.Bool("querypeerosps", false, "query info of peer OSPs")
+	paytrace        = flag.Bool("paytrace", false, "query hop spans of a traced payment")
+	rebalance       = flag.Bool("rebalance", false, "rebalance peer OSP channels with circular payments")
+	tui             = flag.Bool("tui", false, "run the interactive admin ui")
 	intendsettle    = flag.Bool("intendsettle", false, "intend unilaterally settle channel")
 	confirmsettle   = flag.Bool("confirmsettle", false, "confirm unilaterally settle channel")
 	intendwithdraw  = flag.Bool("intendwithdraw", false, "intend unilaterally withdraw from channel")
@@ -27,6 +30,7 @@ var (
 	ethpoolwithdraw = flag.Bool("ethpoolwithdraw", false, "withdraw ETH from ethpool")
 	register        = flag.Bool("register", false, "register OSP as a state channel router")
 	deregister      = flag.Bool("deregister", false, "deregister OSP as a state channel router")
+	report          = flag.Bool("report", false, "summarize fee income, gas cost, deposits and withdrawals by token")
 )
 
 func main() {
@@ -57,9 +61,21 @@ func main() {
 		cli.QueryPeerOsps()
 		return
 	}
+	if *paytrace {
+		cli.QueryPayTrace()
+		return
+	}
+	if *rebalance {
+		cli.Rebalance()
+		return
+	}
+	if *tui {
+		cli.RunTUI()
+		return
+	}
 
 	var p cli.Processor
-	if *intendsettle || *confirmsettle || *intendwithdraw || *confirmwithdraw || *dbview != "" || *dbupdate != "" {
+	if *intendsettle || *confirmsettle || *intendwithdraw || *confirmwithdraw || *dbview != "" || *dbupdate != "" || *report {
 		p.Setup(true, false, true) // connect to db, not enforcig osp keystore, set disputer if keystore is provided
 	} else if *ethpoolwithdraw || *register || *deregister {
 		p.Setup(false, true, false) // no db, enforce using osp keystore, no disputer
@@ -85,6 +101,10 @@ func main() {
 		p.ConfirmWithdraw()
 		return
 	}
+	if *report {
+		p.Report()
+		return
+	}
 
 	switch *dbview {
 	case "":
@@ -0,0 +1,111 @@
+// Copyright 2020 Celer Network
+
+// Reference signer daemon that holds the node key and serves the remote signer
+// protocol over mutual TLS.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"strings"
+	"syscall"
+
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/remotesigner"
+	"github.com/celer-network/goCeler/rpc"
+	"github.com/celer-network/goCeler/utils"
+	"github.com/celer-network/goutils/eth"
+	"github.com/celer-network/goutils/log"
+	"golang.org/x/crypto/ssh/terminal"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var (
+	addr       = flag.String("addr", "localhost:9600", "signer listening host:port")
+	ks         = flag.String("ks", "", "Path to keystore json file of the signing key")
+	pwdFile    = flag.String("passwordfile", "", "Path to the keystore password file")
+	noPassword = flag.Bool("nopassword", false, "Assume empty password for keystore")
+	chainId    = flag.Int64("chainid", 0, "Chain id used to sign transactions")
+	policyFile = flag.String("policy", "", "Path to policy json file, default allows all known message kinds")
+	auditFile  = flag.String("audit", "signer_audit.log", "Path to append the audit log")
+	tlsCert    = flag.String("tlscert", "", "Path to TLS cert file")
+	tlsKey     = flag.String("tlskey", "", "Path to TLS private key file")
+	tlsCA      = flag.String("tlsca", "", "Path to CA cert file that signs client certs")
+)
+
+func main() {
+	flag.Parse()
+	if *chainId == 0 {
+		log.Fatalln("chainid not set")
+	}
+	ksBytes, err := ioutil.ReadFile(*ks)
+	if err != nil {
+		log.Fatalln("read keystore err:", err)
+	}
+	address, err := utils.GetAddressFromKeystore(ksBytes)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	signer, err := eth.NewSignerFromKeystore(string(ksBytes), readPassword(address), big.NewInt(*chainId))
+	if err != nil {
+		log.Fatalln("load signer err:", err)
+	}
+	policy, err := remotesigner.LoadPolicy(*policyFile)
+	if err != nil {
+		log.Fatalln("load policy err:", err)
+	}
+	audit, err := os.OpenFile(*auditFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		log.Fatalln("open audit log err:", err)
+	}
+	defer audit.Close()
+	tlsConfig, err := remotesigner.LoadTLSConfig(*tlsCert, *tlsKey, *tlsCA, true)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	lis, err := net.Listen("tcp", *addr)
+	if err != nil {
+		log.Fatalln("failed to listen:", err)
+	}
+	s := grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsConfig)))
+	rpc.RegisterRemoteSignerServer(s, remotesigner.NewServer(signer, ctype.Hex2Addr(address), policy, audit))
+	log.Infof("signer %s serving on %s, policy %s", address, *addr, policy)
+	err = s.Serve(lis)
+	if err != nil {
+		log.Fatalln(err)
+	}
+}
+
+func readPassword(address string) string {
+	if *noPassword {
+		return ""
+	}
+	if *pwdFile != "" {
+		pwd, err := ioutil.ReadFile(*pwdFile)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		return strings.TrimSuffix(string(pwd), "\n")
+	}
+	if terminal.IsTerminal(syscall.Stdin) {
+		fmt.Printf("Enter password for %s: ", address)
+		pwd, err := terminal.ReadPassword(syscall.Stdin)
+		if err != nil {
+			log.Fatalln("Cannot read password from terminal:", err)
+		}
+		fmt.Println()
+		return string(pwd)
+	}
+	pwd, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		log.Fatalln("Cannot read password from stdin:", err)
+	}
+	return strings.TrimSuffix(pwd, "\n")
+}
@@ -1,7 +1,8 @@
 // Copyright 2018-2020 Celer Network
 
 // This binary provides a web interface for channel view tool so we can easily check channel and pay
-// status in browser.
+// status in browser. Database views are served from the OSP admin http API, and on-chain views are
+// served by the osp-cli binary.
 package main
 
 import (
@@ -9,13 +10,21 @@ import (
 	"fmt"
 	"net/http"
 	"os/exec"
+	"strconv"
 	"strings"
+
+	"github.com/celer-network/goCeler/utils"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
 )
 
 var (
 	profile       = flag.String("profile", "config/profile.json", "Path to profile json file")
+	adminHostPort = flag.String("adminhostport", "localhost:8090", "the OSP admin http host:port")
 	viewBinary    = flag.String("viewbin", "/usr/local/bin/osp-cli", "location of view binary")
-	storedir      = flag.String("storedir", "", "sqllite directory")
+	// Deprecated: database views are served by the admin http API, kept so that existing
+	// invocations still parse. Only passed to the view binary for on-chain views.
+	storedir      = flag.String("storedir", "", "deprecated, sqlite directory passed to the view binary")
 	staticFileDir = flag.String("staticfiledir", "/etc/cv_static/", "location of static file dir")
 	port          = flag.String("port", "10080", "port to serve on")
 )
@@ -23,7 +32,9 @@ var (
 func view(argsToAppend ...string) ([]byte, error) {
 	commonArgs := []string{
 		"-profile", *profile,
-		"-storedir", *storedir,
+	}
+	if *storedir != "" {
+		commonArgs = append(commonArgs, "-storedir", *storedir)
 	}
 	args := make([]string, len(commonArgs), len(commonArgs)+len(argsToAppend))
 	copy(args, commonArgs)
@@ -31,6 +42,27 @@ func view(argsToAppend ...string) ([]byte, error) {
 	cmd := exec.Command(*viewBinary, args...)
 	return cmd.CombinedOutput()
 }
+
+// writeJSON writes the admin api response as indented json
+func writeJSON(w http.ResponseWriter, res proto.Message, err error) {
+	if err != nil {
+		fmt.Fprintf(w, "admin request failed with %s\n", err)
+		return
+	}
+	marshaler := &jsonpb.Marshaler{Indent: "  ", EmitDefaults: true}
+	w.Header().Set("Content-Type", "application/json")
+	if err = marshaler.Marshal(w, res); err != nil {
+		fmt.Fprintf(w, "marshal response failed with %s\n", err)
+	}
+}
+
+// pageQuery parses the optional offset and limit query parameters
+func pageQuery(r *http.Request) (uint32, uint32) {
+	offset, _ := strconv.ParseUint(r.URL.Query().Get("offset"), 10, 32)
+	limit, _ := strconv.ParseUint(r.URL.Query().Get("limit"), 10, 32)
+	return uint32(offset), uint32(limit)
+}
+
 func main() {
 	flag.Parse()
 	// Each handler handles one HTML "form" in static/index.html
@@ -41,11 +73,24 @@ func main() {
 			fmt.Fprintf(w, "Input Wrong")
 			return
 		}
-		out, err := view("-dbview", "channel", "-peer", peer[0], "-token", token[0])
+		res, err := utils.ListChannels(*adminHostPort, peer[0], token[0], 0, 0, 1)
 		if err != nil {
-			fmt.Fprintf(w, "cmd.Run() failed with %s\n", err)
+			writeJSON(w, nil, err)
+			return
 		}
-		fmt.Fprintf(w, string(out))
+		if len(res.GetChannels()) == 0 {
+			fmt.Fprintf(w, "channel with peer %s token %s not found\n", peer[0], token[0])
+			return
+		}
+		detail, err := utils.GetChannelDetail(*adminHostPort, res.GetChannels()[0].GetCid())
+		writeJSON(w, detail, err)
+	})
+	http.HandleFunc("/db/channels", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		state, _ := strconv.Atoi(query.Get("state"))
+		offset, limit := pageQuery(r)
+		res, err := utils.ListChannels(*adminHostPort, query.Get("peer"), query.Get("token"), state, offset, limit)
+		writeJSON(w, res, err)
 	})
 	http.HandleFunc("/db/channel-cid", func(w http.ResponseWriter, r *http.Request) {
 		cid := r.URL.Query()["cid"]
@@ -53,11 +98,27 @@ func main() {
 			fmt.Fprintf(w, "Input Wrong")
 			return
 		}
-		out, err := view("-dbview", "channel", "-cid", cid[0])
-		if err != nil {
-			fmt.Fprintf(w, "cmd.Run() failed with %s\n", err)
+		res, err := utils.GetChannelDetail(*adminHostPort, cid[0])
+		writeJSON(w, res, err)
+	})
+	http.HandleFunc("/db/channel-status", func(w http.ResponseWriter, r *http.Request) {
+		cid := r.URL.Query()["cid"]
+		if len(cid) != 1 {
+			fmt.Fprintf(w, "Input Wrong")
+			return
 		}
-		fmt.Fprintf(w, string(out))
+		res, err := utils.GetChannelStatus(*adminHostPort, cid[0])
+		writeJSON(w, res, err)
+	})
+	http.HandleFunc("/db/channel-pays", func(w http.ResponseWriter, r *http.Request) {
+		cid := r.URL.Query()["cid"]
+		if len(cid) != 1 {
+			fmt.Fprintf(w, "Input Wrong")
+			return
+		}
+		offset, limit := pageQuery(r)
+		res, err := utils.ListChannelPays(*adminHostPort, cid[0], offset, limit)
+		writeJSON(w, res, err)
 	})
 	http.HandleFunc("/db/pay", func(w http.ResponseWriter, r *http.Request) {
 		payid := r.URL.Query()["payid"]
@@ -65,11 +126,8 @@ func main() {
 			fmt.Fprintf(w, "Input Wrong")
 			return
 		}
-		out, err := view("-dbview", "pay", "-payid", payid[0])
-		if err != nil {
-			fmt.Fprintf(w, "cmd.Run() failed with %s\n", err)
-		}
-		fmt.Fprintf(w, string(out))
+		res, err := utils.GetPayment(*adminHostPort, payid[0])
+		writeJSON(w, res, err)
 	})
 	http.HandleFunc("/db/deposit-id", func(w http.ResponseWriter, r *http.Request) {
 		depositid := r.URL.Query()["depositid"]
@@ -77,11 +135,8 @@ func main() {
 			fmt.Fprintf(w, "Input Wrong")
 			return
 		}
-		out, err := view("-dbview", "deposit", "-depositid", depositid[0])
-		if err != nil {
-			fmt.Fprintf(w, "cmd.Run() failed with %s\n", err)
-		}
-		fmt.Fprintf(w, string(out))
+		res, err := utils.QueryDeposit(*adminHostPort, depositid[0])
+		writeJSON(w, res, err)
 	})
 	http.HandleFunc("/db/deposits-cid", func(w http.ResponseWriter, r *http.Request) {
 		cid := r.URL.Query()["cid"]
@@ -89,11 +144,8 @@ func main() {
 			fmt.Fprintf(w, "Input Wrong")
 			return
 		}
-		out, err := view("-dbview", "deposit", "-cid", cid[0])
-		if err != nil {
-			fmt.Fprintf(w, "cmd.Run() failed with %s\n", err)
-		}
-		fmt.Fprintf(w, string(out))
+		res, err := utils.QueryDepositJobs(*adminHostPort, cid[0])
+		writeJSON(w, res, err)
 	})
 	http.HandleFunc("/onchain/channel", func(w http.ResponseWriter, r *http.Request) {
 		cid := r.URL.Query()["cid"]
@@ -0,0 +1,106 @@
+// Copyright 2020 Celer Network
+
+package txmgr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/celer-network/goCeler/rtconfig"
+	"github.com/celer-network/goutils/log"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const feeHistoryBlocks = 10
+
+var errNoBaseFee = errors.New("chain has no base fee")
+
+type feeHistory struct {
+	BaseFee []*hexutil.Big   `json:"baseFeePerGas"`
+	Reward  [][]*hexutil.Big `json:"reward"`
+}
+
+// estimateFees returns the base fee of the next block and the priority fee estimated from
+// the reward percentile of recent blocks, or errNoBaseFee if the chain is not on EIP-1559.
+func (m *TxManager) estimateFees(ctx context.Context) (baseFee, tip *big.Int, err error) {
+	percentile := rtconfig.GetPriorityFeePercentile()
+	var history feeHistory
+	err = m.rpc.CallContext(ctx, &history, "eth_feeHistory",
+		hexutil.Uint64(feeHistoryBlocks), "latest", []float64{float64(percentile)})
+	if err != nil {
+		// nodes without the London fork do not serve eth_feeHistory
+		return nil, nil, fmt.Errorf("%w: eth_feeHistory err: %s", errNoBaseFee, err)
+	}
+	// baseFeePerGas has one more entry than the queried blocks, which is the next block
+	if len(history.BaseFee) == 0 {
+		return nil, nil, errNoBaseFee
+	}
+	baseFee = history.BaseFee[len(history.BaseFee)-1].ToInt()
+	if baseFee.Sign() == 0 {
+		return nil, nil, errNoBaseFee
+	}
+	var rewards []*big.Int
+	for _, reward := range history.Reward {
+		if len(reward) > 0 && reward[0] != nil {
+			rewards = append(rewards, reward[0].ToInt())
+		}
+	}
+	tip = new(big.Int).SetUint64(rtconfig.GetMaxPriorityFeeGwei() * 1e9)
+	if len(rewards) > 0 {
+		sort.Slice(rewards, func(i, j int) bool { return rewards[i].Cmp(rewards[j]) < 0 })
+		if median := rewards[len(rewards)/2]; median.Cmp(tip) < 0 {
+			tip = median
+		}
+	}
+	return baseFee, tip, nil
+}
+
+// eip1559GasPrice returns the gas price of a tx priced from the base fee and priority fee.
+// Txs are sent in the legacy encoding, where the gas price serves as both the max fee and
+// the priority fee, so leave room for one full base fee increase (12.5%) and add the tip.
+func eip1559GasPrice(baseFee, tip *big.Int, maxFeeGwei uint64) *big.Int {
+	price := new(big.Int).Mul(baseFee, big.NewInt(9))
+	price.Div(price, big.NewInt(8))
+	price.Add(price, tip)
+	if maxFeeGwei > 0 {
+		maxFee := new(big.Int).SetUint64(maxFeeGwei * 1e9)
+		if price.Cmp(maxFee) > 0 {
+			log.Warnf("estimated max fee %s larger than cap %s, set to cap", price, maxFee)
+			price = maxFee
+		}
+	}
+	return price
+}
+
+// setEip1559GasPrice replaces the legacy gas price set by eth.Transactor with the one
+// estimated from the fee history, or keeps it if the chain has no base fee.
+func (m *TxManager) setEip1559GasPrice(txopts *bind.TransactOpts) {
+	ctx, cancel := context.WithTimeout(context.Background(), queryTimeout)
+	defer cancel()
+	baseFee, tip, err := m.estimateFees(ctx)
+	if err != nil {
+		log.Debugln("fall back to legacy gas price:", err)
+		return
+	}
+	txopts.GasPrice = eip1559GasPrice(baseFee, tip, rtconfig.GetMaxFeeGwei())
+}
+
+// gasPrice returns the current gas price to use and the cap of gas price in gwei (0 means no cap).
+// If EIP-1559 pricing is enabled and the chain has base fee, the price is estimated from the
+// fee history, otherwise it is the price suggested by the eth client.
+func (m *TxManager) gasPrice(ctx context.Context) (*big.Int, uint64, error) {
+	if rtconfig.GetEip1559() {
+		baseFee, tip, err := m.estimateFees(ctx)
+		if err == nil {
+			maxFeeGwei := rtconfig.GetMaxFeeGwei()
+			return eip1559GasPrice(baseFee, tip, maxFeeGwei), maxFeeGwei, nil
+		}
+		log.Debugln("fall back to legacy gas price:", err)
+	}
+	price, err := m.client.SuggestGasPrice(ctx)
+	return price, rtconfig.GetMaxGasGwei(), err
+}
@@ -0,0 +1,37 @@
+// Copyright 2020 Celer Network
+
+package txmgr
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/celer-network/goutils/eth"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// KeySigner is an eth.Signer holding a local private key,
+// it also implements intfs.HashSigner
+type KeySigner struct {
+	*eth.CelerSigner
+	key *ecdsa.PrivateKey
+}
+
+// NewKeySigner creates a signer from the hex private key,
+// chainId could be nil if the signer is not expected to sign transactions
+func NewKeySigner(privateKey string, chainId *big.Int) (*KeySigner, error) {
+	key, err := crypto.HexToECDSA(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := eth.NewSigner(privateKey, chainId)
+	if err != nil {
+		return nil, err
+	}
+	return &KeySigner{CelerSigner: signer, key: key}, nil
+}
+
+// SignHash implements intfs.HashSigner
+func (s *KeySigner) SignHash(hash []byte) ([]byte, error) {
+	return crypto.Sign(hash, s.key)
+}
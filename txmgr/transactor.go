@@ -0,0 +1,149 @@
+// Copyright 2020 Celer Network
+
+package txmgr
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/celer-network/goCeler/common"
+	"github.com/celer-network/goCeler/common/intfs"
+	"github.com/celer-network/goutils/eth"
+	"github.com/celer-network/goutils/log"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Transactor sends txs from a single account through the tx manager, it implements intfs.Transactor
+type Transactor struct {
+	m      *TxManager
+	sender *sender
+}
+
+// Transact sends a tx and calls the handler once the tx or any of its replacements is mined
+func (t *Transactor) Transact(
+	handler *eth.TransactionStateHandler,
+	method eth.TxMethod,
+	opts ...eth.TxOption) (*types.Transaction, error) {
+	tx, err := t.m.transact(t.sender, "", method, opts)
+	if err != nil {
+		return nil, err
+	}
+	if handler != nil {
+		go func() {
+			receipt, err := t.m.waitMined(tx.Hash().Hex(), opts)
+			if err != nil {
+				if handler.OnError != nil {
+					handler.OnError(tx, err)
+				}
+				return
+			}
+			if handler.OnMined != nil {
+				handler.OnMined(receipt)
+			}
+		}()
+	}
+	return tx, nil
+}
+
+func (t *Transactor) TransactWaitMined(
+	description string,
+	method eth.TxMethod,
+	opts ...eth.TxOption) (*types.Receipt, error) {
+	tx, err := t.m.transact(t.sender, description, method, opts)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := t.m.waitMined(tx.Hash().Hex(), opts)
+	if err != nil {
+		log.Errorf("%s transaction %x err: %s", description, tx.Hash(), err)
+		return nil, err
+	}
+	if receipt.Status == types.ReceiptStatusSuccessful {
+		log.Infof("%s transaction %x succeeded", description, receipt.TxHash)
+	} else {
+		log.Errorf("%s transaction %x failed", description, receipt.TxHash)
+	}
+	return receipt, nil
+}
+
+// WaitMined waits for the tx of the given hash or any of its replacements to be mined
+func (t *Transactor) WaitMined(txHash string, opts ...eth.TxOption) (*types.Receipt, error) {
+	return t.m.waitMined(txHash, opts)
+}
+
+func (t *Transactor) ContractCaller() bind.ContractCaller {
+	return t.m.client
+}
+
+func (t *Transactor) Address() ethcommon.Address {
+	return t.sender.address
+}
+
+// SignHash implements intfs.HashSigner with the signer of the account, if the signer supports it
+func (t *Transactor) SignHash(hash []byte) ([]byte, error) {
+	hashSigner, ok := t.sender.signer.(intfs.HashSigner)
+	if !ok {
+		return nil, common.ErrHashSignUnsupported
+	}
+	return hashSigner.SignHash(hash)
+}
+
+// TransactorPool sends each tx from the account with the fewest in-flight txs,
+// it implements intfs.TransactorPool
+type TransactorPool struct {
+	m           *TxManager
+	transactors []*Transactor
+	current     int
+	lock        sync.Mutex
+}
+
+func (m *TxManager) NewTransactorPool(transactors []*Transactor) (*TransactorPool, error) {
+	if len(transactors) == 0 {
+		return nil, fmt.Errorf("empty transactor pool")
+	}
+	return &TransactorPool{m: m, transactors: transactors}, nil
+}
+
+func (p *TransactorPool) Submit(
+	handler *eth.TransactionStateHandler,
+	method eth.TxMethod,
+	opts ...eth.TxOption) (*types.Transaction, error) {
+	return p.nextTransactor().Transact(handler, method, opts...)
+}
+
+func (p *TransactorPool) SubmitWaitMined(
+	description string,
+	method eth.TxMethod,
+	opts ...eth.TxOption) (*types.Receipt, error) {
+	return p.nextTransactor().TransactWaitMined(description, method, opts...)
+}
+
+func (p *TransactorPool) WaitMined(txHash string, opts ...eth.TxOption) (*types.Receipt, error) {
+	return p.m.waitMined(txHash, opts)
+}
+
+func (p *TransactorPool) ContractCaller() bind.ContractCaller {
+	return p.m.client
+}
+
+// nextTransactor returns the transactor with the fewest in-flight txs,
+// ties are broken in round robin order
+func (p *TransactorPool) nextTransactor() *Transactor {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	n := len(p.transactors)
+	var next *Transactor
+	var minPending int
+	for i := 0; i < n; i++ {
+		t := p.transactors[(p.current+i)%n]
+		pending := t.sender.pendingCount()
+		if next == nil || pending < minPending {
+			next = t
+			minPending = pending
+		}
+	}
+	p.current = (p.current + 1) % n
+	return next
+}
@@ -0,0 +1,496 @@
+// Copyright 2020 Celer Network
+
+// Package txmgr manages on-chain transactions sent from the node accounts. It tracks
+// in-flight transactions in storage, rebroadcasts lingering ones with escalating gas
+// price, balances transactions across a pool of accounts, and recovers the nonce state
+// after a restart.
+package txmgr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/celer-network/goCeler/accounting"
+	"github.com/celer-network/goCeler/chain/channel-eth-go/ledger"
+	"github.com/celer-network/goCeler/common/structs"
+	"github.com/celer-network/goCeler/config"
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/rtconfig"
+	"github.com/celer-network/goCeler/storage"
+	"github.com/celer-network/goutils/eth"
+	"github.com/celer-network/goutils/log"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ec "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rlp"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	queryTimeout       = 10 * time.Second
+	txWaitTimeout      = 6 * time.Hour // same as the eth.WaitMined default
+	txPurgeInterval    = time.Hour
+	parityErrIncrNonce = "incrementing the nonce"
+)
+
+// TxManager tracks the transactions of all accounts added to it
+type TxManager struct {
+	chainId uint64 // chain id keying the tx records in storage, 0 for the primary chain
+	client  *ethclient.Client
+	rpc     *ethrpc.Client // for calls not wrapped by ethclient, e.g., eth_feeHistory
+	dal     *storage.DAL
+	senders map[ctype.Addr]*sender
+	lock    sync.RWMutex // protects senders
+}
+
+type sender struct {
+	address    ctype.Addr
+	signer     eth.Signer
+	transactor *eth.Transactor // builds, signs and sends the first broadcast of txs
+	nonce      uint64          // next nonce to use, 0 if unknown
+	pending    int             // number of in-flight txs
+	lock       sync.Mutex      // protects nonce and pending
+}
+
+// NewTxManager creates the tx manager of a chain. chainId is 0 for the primary chain of the node,
+// whose chain id is in the global config, and the chain id for an extra chain of a multi-chain node.
+func NewTxManager(chainId uint64, rpcClient *ethrpc.Client) *TxManager {
+	return &TxManager{
+		chainId: chainId,
+		client:  ethclient.NewClient(rpcClient),
+		rpc:     rpcClient,
+		senders: make(map[ctype.Addr]*sender),
+	}
+}
+
+// AddSender adds an account and returns a Transactor sending from it.
+// Transactors of the same account share the nonce state.
+func (m *TxManager) AddSender(address ctype.Addr, signer eth.Signer) *Transactor {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	s, ok := m.senders[address]
+	if !ok {
+		s = &sender{
+			address:    address,
+			signer:     signer,
+			transactor: eth.NewTransactorByExternalSigner(address, signer, m.client),
+		}
+		m.senders[address] = s
+	}
+	return &Transactor{m: m, sender: s}
+}
+
+// AddKeystoreSender adds the account of a keystore and returns a Transactor sending from it
+func (m *TxManager) AddKeystoreSender(txConfig *eth.TransactorConfig) (*Transactor, error) {
+	address, privKey, err := eth.GetAddrPrivKeyFromKeystore(txConfig.Keyjson, txConfig.Passphrase)
+	if err != nil {
+		return nil, err
+	}
+	chainId := config.ChainId
+	if m.chainId != 0 {
+		chainId = new(big.Int).SetUint64(m.chainId)
+	}
+	signer, err := NewKeySigner(privKey, chainId)
+	if err != nil {
+		return nil, err
+	}
+	return m.AddSender(address, signer), nil
+}
+
+// Start loads the in-flight txs from storage to recover the nonce state, and starts tracking
+// in-flight txs until quit is closed. In a multi-server setup, only the server listening on
+// chain checks and rebroadcasts the in-flight txs, other servers sharing the database reload
+// them periodically to keep the nonce state.
+func (m *TxManager) Start(dal *storage.DAL, quit chan bool, listenOnChain bool) error {
+	m.dal = dal
+	err := m.loadPendingTxs()
+	if err != nil {
+		return err
+	}
+	m.lock.RLock()
+	for _, s := range m.senders {
+		s.lock.Lock()
+		if s.pending > 0 {
+			log.Infof("recovered %d in-flight txs of %x, next nonce %d", s.pending, s.address, s.nonce)
+		}
+		s.lock.Unlock()
+	}
+	m.lock.RUnlock()
+	go m.run(quit, listenOnChain)
+	return nil
+}
+
+// loadPendingTxs sets the in-flight tx counts of the senders from storage,
+// and moves their nonces past the in-flight txs
+func (m *TxManager) loadPendingTxs() error {
+	txs, err := m.dal.GetAllTxsByState(m.chainId, structs.TxState_PENDING)
+	if err != nil {
+		return fmt.Errorf("GetAllTxsByState err: %w", err)
+	}
+	pending := make(map[ctype.Addr]int)
+	nonces := make(map[ctype.Addr]uint64)
+	for _, tx := range txs {
+		pending[tx.Sender]++
+		if tx.Nonce+1 > nonces[tx.Sender] {
+			nonces[tx.Sender] = tx.Nonce + 1
+		}
+	}
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	for addr, s := range m.senders {
+		s.lock.Lock()
+		s.pending = pending[addr]
+		if nonces[addr] > s.nonce {
+			s.nonce = nonces[addr]
+		}
+		s.lock.Unlock()
+	}
+	return nil
+}
+
+func (m *TxManager) getSender(address ctype.Addr) *sender {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.senders[address]
+}
+
+func (s *sender) pendingCount() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.pending
+}
+
+func (s *sender) txDone() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.pending > 0 {
+		s.pending--
+	}
+}
+
+// transact sends a new tx from the sender and records it as in-flight
+func (m *TxManager) transact(
+	s *sender, description string, method eth.TxMethod, opts []eth.TxOption) (*types.Transaction, error) {
+	// eth.Transactor sets the nonce from the pending nonce of the eth client, override it
+	// if the local nonce is ahead, e.g., txs were dropped from the mempool of the eth client.
+	// It also sets the legacy gas price, override it if EIP-1559 pricing is enabled.
+	wrapped := func(transactor bind.ContractTransactor, txopts *bind.TransactOpts) (*types.Transaction, error) {
+		s.lock.Lock()
+		if txopts.Nonce.Uint64() < s.nonce {
+			txopts.Nonce = new(big.Int).SetUint64(s.nonce)
+		}
+		s.lock.Unlock()
+		if rtconfig.GetEip1559() {
+			m.setEip1559GasPrice(txopts)
+		}
+		tx, err := method(transactor, txopts)
+		if err != nil && isNonceErr(err) {
+			// eth.Transactor retries with the next nonce
+			s.lock.Lock()
+			s.nonce = txopts.Nonce.Uint64() + 1
+			s.lock.Unlock()
+		}
+		return tx, err
+	}
+	tx, err := s.transactor.Transact(nil, wrapped, opts...)
+	if err != nil {
+		return nil, err
+	}
+	s.lock.Lock()
+	s.nonce = tx.Nonce() + 1
+	s.pending++
+	s.lock.Unlock()
+
+	if m.dal == nil {
+		return tx, nil
+	}
+	rawTx, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		log.Errorln("encode tx err:", err, tx.Hash().Hex())
+		return tx, nil
+	}
+	err = m.dal.InsertTx(&structs.TxRecord{
+		TxHash:      tx.Hash().Hex(),
+		ChainId:     m.chainId,
+		Sender:      s.address,
+		Nonce:       tx.Nonce(),
+		State:       structs.TxState_PENDING,
+		RawTx:       rawTx,
+		Hashes:      []string{tx.Hash().Hex()},
+		Description: description,
+	})
+	if err != nil {
+		// tx already sent, it is still waited by hash but will not be rebroadcast
+		log.Errorln("InsertTx err:", err, tx.Hash().Hex())
+	}
+	return tx, nil
+}
+
+func isNonceErr(err error) bool {
+	errStr := err.Error()
+	return errStr == core.ErrNonceTooLow.Error() ||
+		errStr == core.ErrReplaceUnderpriced.Error() ||
+		strings.Contains(errStr, parityErrIncrNonce)
+}
+
+// waitMined waits for the tx or any of its replacements to be mined
+func (m *TxManager) waitMined(txHash string, opts []eth.TxOption) (*types.Receipt, error) {
+	if m.dal == nil {
+		return eth.WaitMinedWithTxHash(context.Background(), m.client, txHash, opts...)
+	}
+	id := ec.HexToHash(txHash).Hex()
+	timeout := time.Duration(rtconfig.GetWaitMinedTxTimeout()) * time.Second
+	if timeout == 0 {
+		timeout = txWaitTimeout
+	}
+	deadline := time.Now().Add(timeout)
+	ticker := time.NewTicker(time.Duration(config.BlockIntervalSec) * time.Second)
+	defer ticker.Stop()
+	for {
+		tx, found, err := m.dal.GetTx(id)
+		if err != nil {
+			log.Warnln("GetTx err:", err, id)
+		} else if !found {
+			// not sent through the tx manager, e.g., sent before upgrade
+			return eth.WaitMinedWithTxHash(context.Background(), m.client, txHash, opts...)
+		} else if tx.State == structs.TxState_MINED {
+			hash, err2 := m.getMinedHash(tx)
+			if err2 == nil {
+				// wait for block confirmations
+				return eth.WaitMinedWithTxHash(context.Background(), m.client, hash, opts...)
+			}
+			log.Warnln("getMinedHash err:", err2, id)
+		} else if tx.State == structs.TxState_DROPPED {
+			return nil, fmt.Errorf("tx %s err: %w", id, eth.ErrTxDropped)
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("tx %s err: %w", id, eth.ErrTxTimeout)
+		}
+		<-ticker.C
+	}
+}
+
+var errNoReceipt = errors.New("no receipt for any broadcast hash")
+
+// getMinedHash returns the hash of the mined broadcast of the tx
+func (m *TxManager) getMinedHash(tx *structs.TxRecord) (string, error) {
+	for i := len(tx.Hashes) - 1; i >= 0; i-- {
+		ctx, cancel := context.WithTimeout(context.Background(), queryTimeout)
+		_, err := m.client.TransactionReceipt(ctx, ec.HexToHash(tx.Hashes[i]))
+		cancel()
+		if err == nil {
+			return tx.Hashes[i], nil
+		}
+	}
+	return "", errNoReceipt
+}
+
+func (m *TxManager) run(quit chan bool, listenOnChain bool) {
+	ticker := time.NewTicker(time.Duration(config.BlockIntervalSec) * time.Second)
+	defer ticker.Stop()
+	lastPurge := time.Now()
+	for {
+		select {
+		case <-quit:
+			return
+		case <-ticker.C:
+			if listenOnChain {
+				m.checkPendingTxs()
+				if time.Since(lastPurge) > txPurgeInterval {
+					err := m.dal.DeleteFinishedTxsBefore(time.Now().Add(-config.TxRecordRetention))
+					if err != nil {
+						log.Warnln("DeleteFinishedTxsBefore err:", err)
+					}
+					lastPurge = time.Now()
+				}
+			}
+			// txs of the same accounts may be sent or finished by other servers sharing the database
+			err := m.loadPendingTxs()
+			if err != nil {
+				log.Warnln(err)
+			}
+		}
+	}
+}
+
+// checkPendingTxs finishes the in-flight txs whose nonces are used on chain,
+// and rebroadcasts those lingering longer than the bump interval
+func (m *TxManager) checkPendingTxs() {
+	txs, err := m.dal.GetAllTxsByState(m.chainId, structs.TxState_PENDING)
+	if err != nil {
+		log.Warnln("GetAllTxsByState err:", err)
+		return
+	}
+	bumpInterval := time.Duration(rtconfig.GetTxBumpIntervalSecond()) * time.Second
+	minedNonces := make(map[ctype.Addr]uint64)
+	for _, tx := range txs {
+		s := m.getSender(tx.Sender)
+		if s == nil {
+			// sent by another server sharing the database
+			continue
+		}
+		minedNonce, ok := minedNonces[tx.Sender]
+		if !ok {
+			ctx, cancel := context.WithTimeout(context.Background(), queryTimeout)
+			minedNonce, err = m.client.NonceAt(ctx, tx.Sender, nil)
+			cancel()
+			if err != nil {
+				log.Warnln("NonceAt err:", err, ctype.Addr2Hex(tx.Sender))
+				continue
+			}
+			minedNonces[tx.Sender] = minedNonce
+		}
+		if tx.Nonce < minedNonce {
+			m.finishTx(s, tx)
+		} else if time.Since(tx.UpdateTs) > bumpInterval {
+			m.rebroadcast(s, tx)
+		}
+	}
+}
+
+func (m *TxManager) finishTx(s *sender, tx *structs.TxRecord) {
+	state := structs.TxState_MINED
+	hash, err := m.getMinedHash(tx)
+	if err != nil {
+		log.Warnf("tx %s (%s) nonce %d of %x dropped: %s",
+			tx.TxHash, tx.Description, tx.Nonce, tx.Sender, err)
+		state = structs.TxState_DROPPED
+	} else {
+		log.Debugf("tx %s (%s) mined as %s after %d broadcasts", tx.TxHash, tx.Description, hash, len(tx.Hashes))
+	}
+	err = m.dal.UpdateTxState(tx.TxHash, state)
+	if err != nil {
+		log.Errorln("UpdateTxState err:", err, tx.TxHash)
+		return
+	}
+	s.txDone()
+	if state == structs.TxState_MINED {
+		m.recordGasCost(tx, hash)
+	}
+}
+
+// recordGasCost adds the gas paid by the mined broadcast of the tx to the accounting ledger
+func (m *TxManager) recordGasCost(tx *structs.TxRecord, minedHash string) {
+	ctx, cancel := context.WithTimeout(context.Background(), queryTimeout)
+	defer cancel()
+	receipt, err := m.client.TransactionReceipt(ctx, ec.HexToHash(minedHash))
+	if err != nil {
+		log.Warnln("TransactionReceipt err:", err, minedHash)
+		return
+	}
+	mined, _, err := m.client.TransactionByHash(ctx, ec.HexToHash(minedHash))
+	if err != nil {
+		log.Warnln("TransactionByHash err:", err, minedHash)
+		return
+	}
+	cost := new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), mined.GasPrice())
+	err = m.dal.InsertAcctRecord(accounting.GasRecord(tx.TxHash, txMemo(tx, mined), m.chainId, cost))
+	if err != nil {
+		log.Errorln("InsertAcctRecord err:", err, tx.TxHash)
+	}
+}
+
+var ledgerABI, ledgerABIErr = abi.JSON(strings.NewReader(ledger.CelerLedgerABI))
+
+// txMemo returns the description of the tx, or the ledger method called by the tx if not described
+func txMemo(record *structs.TxRecord, tx *types.Transaction) string {
+	if record.Description != "" || ledgerABIErr != nil || len(tx.Data()) < 4 {
+		return record.Description
+	}
+	method, err := ledgerABI.MethodById(tx.Data()[:4])
+	if err != nil {
+		return ""
+	}
+	return method.Name
+}
+
+// rebroadcast replaces the tx with the same one at a higher gas price
+func (m *TxManager) rebroadcast(s *sender, record *structs.TxRecord) {
+	tx := new(types.Transaction)
+	err := rlp.DecodeBytes(record.RawTx, tx)
+	if err != nil {
+		log.Errorln("decode tx err:", err, record.TxHash)
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), queryTimeout)
+	defer cancel()
+	gasPrice := bumpGasPrice(tx.GasPrice(), rtconfig.GetTxBumpPercent())
+	current, maxGwei, err := m.gasPrice(ctx)
+	if err == nil && current.Cmp(gasPrice) > 0 {
+		gasPrice = current
+	}
+	if maxGwei > 0 {
+		maxPrice := new(big.Int).SetUint64(maxGwei * 1e9)
+		if gasPrice.Cmp(maxPrice) > 0 {
+			gasPrice = maxPrice
+		}
+	}
+
+	signed := tx
+	if gasPrice.Cmp(tx.GasPrice()) > 0 {
+		signed, err = s.signTx(newTxWithGasPrice(tx, gasPrice))
+		if err != nil {
+			log.Errorln("sign rebroadcast tx err:", err, record.TxHash)
+			return
+		}
+	} // otherwise gas price is capped, resend the same tx in case it was dropped from the mempool
+
+	err = m.client.SendTransaction(ctx, signed)
+	if err != nil && !strings.Contains(err.Error(), "known transaction") &&
+		!strings.Contains(err.Error(), "already known") {
+		// nonce too low means the tx is mined, which is handled in the next check
+		log.Warnf("rebroadcast tx %s (%s) nonce %d err: %s", record.TxHash, record.Description, tx.Nonce(), err)
+		return
+	}
+	hashes := record.Hashes
+	if signed != tx {
+		hashes = append(hashes, signed.Hash().Hex())
+	}
+	rawTx, err := rlp.EncodeToBytes(signed)
+	if err != nil {
+		log.Errorln("encode tx err:", err, record.TxHash)
+		return
+	}
+	err = m.dal.UpdateTxRebroadcast(record.TxHash, rawTx, hashes)
+	if err != nil {
+		log.Errorln("UpdateTxRebroadcast err:", err, record.TxHash)
+	}
+	log.Infof("rebroadcast tx %s (%s) nonce %d of %x as %s, gas price %s -> %s",
+		record.TxHash, record.Description, tx.Nonce(), s.address, signed.Hash().Hex(), tx.GasPrice(), gasPrice)
+}
+
+func bumpGasPrice(price *big.Int, percent uint64) *big.Int {
+	bumped := new(big.Int).Mul(price, new(big.Int).SetUint64(100+percent))
+	bumped.Div(bumped, big.NewInt(100))
+	// make sure the price increases when the original price is tiny
+	return bumped.Add(bumped, big.NewInt(1))
+}
+
+func newTxWithGasPrice(tx *types.Transaction, gasPrice *big.Int) *types.Transaction {
+	if tx.To() == nil {
+		return types.NewContractCreation(tx.Nonce(), tx.Value(), tx.Gas(), gasPrice, tx.Data())
+	}
+	return types.NewTransaction(tx.Nonce(), *tx.To(), tx.Value(), tx.Gas(), gasPrice, tx.Data())
+}
+
+func (s *sender) signTx(tx *types.Transaction) (*types.Transaction, error) {
+	rawTx, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		return nil, err
+	}
+	rawTx, err = s.signer.SignEthTransaction(rawTx)
+	if err != nil {
+		return nil, err
+	}
+	signed := new(types.Transaction)
+	err = rlp.DecodeBytes(rawTx, signed)
+	return signed, err
+}
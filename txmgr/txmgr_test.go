@@ -0,0 +1,321 @@
+// Copyright 2020 Celer Network
+
+package txmgr
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/celer-network/goCeler/common/structs"
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/storage"
+	ec "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+func TestBumpGasPrice(t *testing.T) {
+	cases := []struct {
+		price, percent, expect uint64
+	}{
+		{price: 10e9, percent: 20, expect: 12e9 + 1},
+		{price: 1, percent: 10, expect: 2},
+		{price: 0, percent: 10, expect: 1},
+	}
+	for _, c := range cases {
+		got := bumpGasPrice(new(big.Int).SetUint64(c.price), c.percent)
+		if got.Uint64() != c.expect {
+			t.Errorf("bump %d by %d%%, expect %d, got %s", c.price, c.percent, c.expect, got)
+		}
+	}
+}
+
+func TestNewTxWithGasPrice(t *testing.T) {
+	to := ctype.Hex2Addr("0x1000000000000000000000000000000000000001")
+	gasPrice := big.NewInt(2)
+	txs := []*types.Transaction{
+		types.NewTransaction(3, to, big.NewInt(5), 21000, big.NewInt(1), []byte{1}),
+		types.NewContractCreation(3, big.NewInt(0), 100000, big.NewInt(1), []byte{2}),
+	}
+	for _, tx := range txs {
+		newTx := newTxWithGasPrice(tx, gasPrice)
+		if newTx.GasPrice().Cmp(gasPrice) != 0 {
+			t.Errorf("expect gas price %s, got %s", gasPrice, newTx.GasPrice())
+		}
+		if newTx.Nonce() != tx.Nonce() || newTx.Gas() != tx.Gas() ||
+			newTx.Value().Cmp(tx.Value()) != 0 || string(newTx.Data()) != string(tx.Data()) {
+			t.Errorf("tx fields changed, old %v, new %v", tx, newTx)
+		}
+		if (tx.To() == nil) != (newTx.To() == nil) || (tx.To() != nil && *tx.To() != *newTx.To()) {
+			t.Errorf("tx recipient changed, old %x, new %x", tx.To(), newTx.To())
+		}
+	}
+}
+
+type fakeEthService struct {
+	history  *feeHistory
+	gasPrice *big.Int
+	nonce    uint64           // mined nonce of all accounts
+	receipts map[ec.Hash]bool // hashes of mined txs
+	sent     []*types.Transaction
+}
+
+func (s *fakeEthService) FeeHistory(blocks hexutil.Uint64, last string, percentiles []float64) (*feeHistory, error) {
+	if s.history == nil {
+		return nil, errors.New("the method eth_feeHistory does not exist/is not available")
+	}
+	return s.history, nil
+}
+
+func (s *fakeEthService) GasPrice() *hexutil.Big {
+	return (*hexutil.Big)(s.gasPrice)
+}
+
+func (s *fakeEthService) GetTransactionCount(addr ec.Address, block string) hexutil.Uint64 {
+	return hexutil.Uint64(s.nonce)
+}
+
+func (s *fakeEthService) GetTransactionReceipt(hash ec.Hash) *types.Receipt {
+	if !s.receipts[hash] {
+		return nil
+	}
+	return &types.Receipt{Status: types.ReceiptStatusSuccessful, TxHash: hash, Logs: []*types.Log{}}
+}
+
+func (s *fakeEthService) SendRawTransaction(rawTx hexutil.Bytes) (ec.Hash, error) {
+	tx := new(types.Transaction)
+	err := rlp.DecodeBytes(rawTx, tx)
+	if err != nil {
+		return ec.Hash{}, err
+	}
+	s.sent = append(s.sent, tx)
+	return tx.Hash(), nil
+}
+
+func newTestTxManager(t *testing.T, svc *fakeEthService) *TxManager {
+	server := ethrpc.NewServer()
+	err := server.RegisterName("eth", svc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewTxManager(0, ethrpc.DialInProc(server))
+}
+
+func gwei(n int64) *hexutil.Big {
+	return (*hexutil.Big)(new(big.Int).Mul(big.NewInt(n), big.NewInt(1e9)))
+}
+
+func TestEstimateFees(t *testing.T) {
+	m := newTestTxManager(t, &fakeEthService{history: &feeHistory{
+		BaseFee: []*hexutil.Big{gwei(80), gwei(90), gwei(100)},
+		Reward:  [][]*hexutil.Big{{gwei(1)}, {gwei(3)}},
+	}})
+	baseFee, tip, err := m.estimateFees(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if baseFee.Cmp(gwei(100).ToInt()) != 0 {
+		t.Errorf("expect base fee of the next block, got %s", baseFee)
+	}
+	// median reward 3 gwei is capped by the default max priority fee 2 gwei
+	if tip.Cmp(gwei(2).ToInt()) != 0 {
+		t.Errorf("expect tip 2 gwei, got %s", tip)
+	}
+	if price := eip1559GasPrice(baseFee, tip, 0); price.Cmp(big.NewInt(114.5e9)) != 0 {
+		t.Errorf("expect gas price 114.5 gwei, got %s", price)
+	}
+	if price := eip1559GasPrice(baseFee, tip, 110); price.Cmp(gwei(110).ToInt()) != 0 {
+		t.Errorf("expect gas price capped at 110 gwei, got %s", price)
+	}
+
+	for _, m = range []*TxManager{
+		newTestTxManager(t, &fakeEthService{}),
+		newTestTxManager(t, &fakeEthService{history: &feeHistory{BaseFee: []*hexutil.Big{gwei(0), gwei(0)}}}),
+	} {
+		_, _, err = m.estimateFees(context.Background())
+		if !errors.Is(err, errNoBaseFee) {
+			t.Errorf("expect errNoBaseFee, got %v", err)
+		}
+	}
+}
+
+func newTestDAL(t *testing.T, name string) (*storage.DAL, func()) {
+	stFile := filepath.Join(os.TempDir(), name)
+	os.Remove(stFile)
+	st, err := storage.NewKVStoreSQL("sqlite3", stFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return storage.NewDAL(st), func() {
+		st.Close()
+		os.Remove(stFile)
+	}
+}
+
+func newTestSender(t *testing.T, m *TxManager) *sender {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := NewKeySigner(hex.EncodeToString(crypto.FromECDSA(key)), big.NewInt(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m.AddSender(crypto.PubkeyToAddress(key.PublicKey), signer).sender
+}
+
+// insertTestTx signs and records an in-flight tx of the sender
+func insertTestTx(t *testing.T, dal *storage.DAL, s *sender, nonce uint64) *structs.TxRecord {
+	to := ctype.Hex2Addr("0x1000000000000000000000000000000000000001")
+	tx, err := s.signTx(types.NewTransaction(nonce, to, big.NewInt(1), 21000, big.NewInt(1e9), nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rawTx, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	record := &structs.TxRecord{
+		TxHash: tx.Hash().Hex(),
+		Sender: s.address,
+		Nonce:  nonce,
+		State:  structs.TxState_PENDING,
+		RawTx:  rawTx,
+		Hashes: []string{tx.Hash().Hex()},
+	}
+	err = dal.InsertTx(record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return record
+}
+
+func checkSenderState(t *testing.T, s *sender, pending int, nonce uint64) {
+	t.Helper()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.pending != pending || s.nonce != nonce {
+		t.Errorf("expect %d in-flight txs and nonce %d, got %d and %d", pending, nonce, s.pending, s.nonce)
+	}
+}
+
+func TestRecoverNonce(t *testing.T) {
+	dal, cleanup := newTestDAL(t, "txmgr_recover_test.db")
+	defer cleanup()
+	m := newTestTxManager(t, &fakeEthService{})
+	s, other := newTestSender(t, m), newTestSender(t, m)
+	insertTestTx(t, dal, s, 3)
+	fourth := insertTestTx(t, dal, s, 4)
+	mined := insertTestTx(t, dal, other, 7)
+	err := dal.UpdateTxState(mined.TxHash, structs.TxState_MINED)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	quit := make(chan bool)
+	defer close(quit)
+	err = m.Start(dal, quit, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSenderState(t, s, 2, 5)
+	checkSenderState(t, other, 0, 0)
+
+	// txs sent and finished by another server sharing the database
+	insertTestTx(t, dal, s, 5)
+	err = dal.UpdateTxState(fourth.TxHash, structs.TxState_MINED)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = m.loadPendingTxs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSenderState(t, s, 2, 6)
+
+	// nonce never goes back
+	s.nonce = 9
+	err = m.loadPendingTxs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSenderState(t, s, 2, 9)
+}
+
+func TestCheckPendingTxs(t *testing.T) {
+	dal, cleanup := newTestDAL(t, "txmgr_check_test.db")
+	defer cleanup()
+	svc := &fakeEthService{gasPrice: big.NewInt(1e9), nonce: 4, receipts: make(map[ec.Hash]bool)}
+	m := newTestTxManager(t, svc)
+	m.dal = dal
+	s := newTestSender(t, m)
+	dropped := insertTestTx(t, dal, s, 2)
+	mined := insertTestTx(t, dal, s, 3)
+	pending := insertTestTx(t, dal, s, 4)
+	svc.receipts[ec.HexToHash(mined.TxHash)] = true
+	err := m.loadPendingTxs()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// txs below the mined nonce are finished, the recent one is not rebroadcast
+	m.checkPendingTxs()
+	for _, c := range []struct {
+		record *structs.TxRecord
+		state  int
+	}{
+		{dropped, structs.TxState_DROPPED},
+		{mined, structs.TxState_MINED},
+		{pending, structs.TxState_PENDING},
+	} {
+		tx, found, err2 := dal.GetTx(c.record.TxHash)
+		if err2 != nil || !found {
+			t.Fatalf("GetTx %s: %t %v", c.record.TxHash, found, err2)
+		}
+		if tx.State != c.state {
+			t.Errorf("tx nonce %d expect state %d, got %d", tx.Nonce, c.state, tx.State)
+		}
+	}
+	checkSenderState(t, s, 1, 5)
+	if len(svc.sent) != 0 {
+		t.Errorf("recent tx rebroadcast")
+	}
+
+	// rebroadcast with the bumped gas price, then with the current gas price if higher
+	for i, expect := range []*big.Int{big.NewInt(1.2e9 + 1), big.NewInt(5e9)} {
+		if i == 1 {
+			svc.gasPrice = big.NewInt(5e9)
+		}
+		record, _, err2 := dal.GetTx(pending.TxHash)
+		if err2 != nil {
+			t.Fatal(err2)
+		}
+		m.rebroadcast(s, record)
+		if len(svc.sent) != i+1 {
+			t.Fatalf("expect %d txs sent, got %d", i+1, len(svc.sent))
+		}
+		sent := svc.sent[i]
+		if sent.Nonce() != 4 || sent.GasPrice().Cmp(expect) != 0 {
+			t.Errorf("rebroadcast nonce %d gas price %s, expect 4 and %s", sent.Nonce(), sent.GasPrice(), expect)
+		}
+		from, err2 := types.Sender(types.NewEIP155Signer(big.NewInt(1)), sent)
+		if err2 != nil || from != s.address {
+			t.Errorf("rebroadcast tx signed by %x, expect %x: %v", from, s.address, err2)
+		}
+		record, _, err2 = dal.GetTx(pending.TxHash)
+		if err2 != nil {
+			t.Fatal(err2)
+		}
+		if len(record.Hashes) != i+2 || record.Hashes[i+1] != sent.Hash().Hex() {
+			t.Errorf("wrong broadcast hashes %v", record.Hashes)
+		}
+	}
+}
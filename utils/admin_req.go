@@ -6,9 +6,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"math/big"
 	"net/http"
+	neturl "net/url"
+	"strconv"
 	"time"
 
 	"github.com/celer-network/goCeler/ctype"
@@ -78,18 +81,23 @@ func RequestRegisterStream(adminHostPort string, peerAddr ctype.Addr, peerHostPo
 	return err
 }
 
-func RequestOpenChannel(adminHostPort string, peerAddr, tokenAddr ctype.Addr, peerDeposit, selfDeposit *big.Int) error {
+func NewOspOpenChannelRequest(
+	peerAddr, tokenAddr ctype.Addr, peerDeposit, selfDeposit *big.Int) *rpc.OspOpenChannelRequest {
 	tokenType := entity.TokenType_ERC20
 	if tokenAddr == ctype.EthTokenAddr {
 		tokenType = entity.TokenType_ETH
 	}
-	request := &rpc.OspOpenChannelRequest{
+	return &rpc.OspOpenChannelRequest{
 		PeerEthAddress:    peerAddr.Bytes(),
 		TokenType:         tokenType,
 		TokenAddress:      tokenAddr.Bytes(),
 		PeerDepositAmtWei: peerDeposit.String(),
 		SelfDepositAmtWei: selfDeposit.String(),
 	}
+}
+
+func RequestOpenChannel(adminHostPort string, peerAddr, tokenAddr ctype.Addr, peerDeposit, selfDeposit *big.Int) error {
+	request := NewOspOpenChannelRequest(peerAddr, tokenAddr, peerDeposit, selfDeposit)
 	url := fmt.Sprintf("http://%s/admin/peer/openchannel", adminHostPort)
 	resBody, err := HttpPost(url, request)
 	if errors.Is(err, ErrHttpReponse) {
@@ -98,6 +106,54 @@ func RequestOpenChannel(adminHostPort string, peerAddr, tokenAddr ctype.Addr, pe
 	return err
 }
 
+// RequestBatchOpenChannel asks the OSP to open channels with multiple peers, and calls onProgress
+// with each progress streamed back until all channels are opened or failed.
+func RequestBatchOpenChannel(
+	adminHostPort string,
+	channels []*rpc.OspOpenChannelRequest,
+	onProgress func(progress *rpc.BatchOpenChannelProgress)) error {
+	request := &rpc.OspBatchOpenChannelRequest{Channels: channels}
+	url := fmt.Sprintf("http://%s/admin/peer/batchopenchannel", adminHostPort)
+	payload, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("json.Marshal err: %w", err)
+	}
+	// no timeout as the stream lasts until all channels are opened on-chain
+	resp, err := http.Post(url, "application/json", bytes.NewBuffer(payload))
+	if err != nil {
+		return fmt.Errorf("http.Post err: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		buf, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("%w: %s, err msg: %s", ErrHttpReponse, resp.Status, getGrpcHttpErrMsg(buf))
+	}
+	// each streamed message is a json object of either result or error
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		var msg struct {
+			Result json.RawMessage `json:"result"`
+			Error  json.RawMessage `json:"error"`
+		}
+		err = decoder.Decode(&msg)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("decode stream err: %w", err)
+		}
+		if len(msg.Error) > 0 {
+			return fmt.Errorf("%w, err msg: %s", ErrHttpReponse, getGrpcHttpErrMsg(msg.Error))
+		}
+		progress := &rpc.BatchOpenChannelProgress{}
+		err = jsonpb.Unmarshal(bytes.NewReader(msg.Result), progress)
+		if err != nil {
+			return err
+		}
+		onProgress(progress)
+	}
+}
+
 func RequestDeposit(
 	adminHostPort string, peerAddr, tokenAddr ctype.Addr, amount *big.Int, toPeer bool, maxWaitSec uint64) (string, error) {
 	request := &rpc.DepositRequest{
@@ -144,6 +200,45 @@ func QueryDeposit(adminHostPort string, depositID string) (*rpc.QueryDepositResp
 	return res, nil
 }
 
+func QueryPayTrace(adminHostPort string, payID string) (*rpc.GetPayTraceResponse, error) {
+	request := &rpc.GetPayTraceRequest{PayId: payID}
+	url := fmt.Sprintf("http://%s/admin/pay/trace", adminHostPort)
+	resBody, err := HttpPost(url, request)
+	if err != nil {
+		if errors.Is(err, ErrHttpReponse) {
+			err = fmt.Errorf("%w, err msg: %s", err, getGrpcHttpErrMsg(resBody))
+		}
+		return nil, err
+	}
+	res := &rpc.GetPayTraceResponse{}
+	err = jsonpb.Unmarshal(bytes.NewReader(resBody), res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func RequestRebalance(adminHostPort string, tokenAddr string, dryRun bool) (*rpc.RebalanceResponse, error) {
+	request := &rpc.RebalanceRequest{DryRun: dryRun}
+	if tokenAddr != "" {
+		request.TokenAddress = ctype.Hex2Bytes(tokenAddr)
+	}
+	url := fmt.Sprintf("http://%s/admin/peer/rebalance", adminHostPort)
+	resBody, err := HttpPost(url, request)
+	if err != nil {
+		if errors.Is(err, ErrHttpReponse) {
+			err = fmt.Errorf("%w, err msg: %s", err, getGrpcHttpErrMsg(resBody))
+		}
+		return nil, err
+	}
+	res := &rpc.RebalanceResponse{}
+	err = jsonpb.Unmarshal(bytes.NewReader(resBody), res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func QueryPeerOsps(adminHostPort string) (*rpc.PeerOspsResponse, error) {
 	url := fmt.Sprintf("http://%s/admin/peer/peer_osps", adminHostPort)
 	resBody, err := HttpPost(url, nil)
@@ -161,6 +256,131 @@ func QueryPeerOsps(adminHostPort string) (*rpc.PeerOspsResponse, error) {
 	return res, nil
 }
 
+func QueryChannelBalances(
+	adminHostPort string, tokenAddr string, peerOspsOnly bool) (*rpc.ChannelBalancesResponse, error) {
+	request := &rpc.ChannelBalancesRequest{PeerOspsOnly: peerOspsOnly}
+	if tokenAddr != "" {
+		request.TokenAddress = ctype.Hex2Bytes(tokenAddr)
+	}
+	res := &rpc.ChannelBalancesResponse{}
+	err := adminRequest(adminHostPort, "channel/balances", request, res)
+	return res, err
+}
+
+func QueryPendingPays(adminHostPort string, cid string) (*rpc.PendingPaysResponse, error) {
+	res := &rpc.PendingPaysResponse{}
+	err := adminRequest(adminHostPort, "channel/pending_pays", &rpc.PendingPaysRequest{Cid: cid}, res)
+	return res, err
+}
+
+func QueryDepositJobs(adminHostPort string, cid string) (*rpc.DepositJobsResponse, error) {
+	res := &rpc.DepositJobsResponse{}
+	err := adminRequest(adminHostPort, "deposit_jobs", &rpc.DepositJobsRequest{Cid: cid}, res)
+	return res, err
+}
+
+func QueryRoutingTable(adminHostPort string, tokenAddr string) (*rpc.RoutingTableResponse, error) {
+	request := &rpc.RoutingTableRequest{}
+	if tokenAddr != "" {
+		request.TokenAddress = ctype.Hex2Bytes(tokenAddr)
+	}
+	res := &rpc.RoutingTableResponse{}
+	err := adminRequest(adminHostPort, "route/table", request, res)
+	return res, err
+}
+
+// RequestCooperativeWithdraw withdraws amtWei from the channel, all free balance if amtWei is empty
+func RequestCooperativeWithdraw(adminHostPort string, cid string, amtWei string) (*rpc.ChannelOpResponse, error) {
+	res := &rpc.ChannelOpResponse{}
+	err := adminRequest(adminHostPort, "channel/coopwithdraw", &rpc.ChannelOpRequest{Cid: cid, Wei: amtWei}, res)
+	return res, err
+}
+
+func RequestCooperativeSettle(adminHostPort string, cid string) (*rpc.ChannelOpResponse, error) {
+	res := &rpc.ChannelOpResponse{}
+	err := adminRequest(adminHostPort, "channel/coopsettle", &rpc.ChannelOpRequest{Cid: cid}, res)
+	return res, err
+}
+
+// ListChannels lists channels filtered by peer, token and state, filters are ignored if empty or zero
+func ListChannels(
+	adminHostPort string, peer, token string, state int, offset, limit uint32) (*rpc.ListChannelsResponse, error) {
+	query := neturl.Values{}
+	if peer != "" {
+		query.Set("peer", peer)
+	}
+	if token != "" {
+		query.Set("token", token)
+	}
+	if state != 0 {
+		query.Set("state", strconv.Itoa(state))
+	}
+	setPageQuery(query, offset, limit)
+	res := &rpc.ListChannelsResponse{}
+	err := adminGet(adminHostPort, "channels?"+query.Encode(), res)
+	return res, err
+}
+
+func GetChannelDetail(adminHostPort string, cid string) (*rpc.ChannelDetailResponse, error) {
+	res := &rpc.ChannelDetailResponse{}
+	err := adminGet(adminHostPort, "channels/"+neturl.PathEscape(cid), res)
+	return res, err
+}
+
+func GetChannelStatus(adminHostPort string, cid string) (*rpc.ChannelStatusResponse, error) {
+	res := &rpc.ChannelStatusResponse{}
+	err := adminGet(adminHostPort, "channels/"+neturl.PathEscape(cid)+"/status", res)
+	return res, err
+}
+
+func ListChannelPays(adminHostPort string, cid string, offset, limit uint32) (*rpc.ListChannelPaysResponse, error) {
+	query := neturl.Values{}
+	setPageQuery(query, offset, limit)
+	res := &rpc.ListChannelPaysResponse{}
+	err := adminGet(adminHostPort, fmt.Sprintf("channels/%s/pays?%s", neturl.PathEscape(cid), query.Encode()), res)
+	return res, err
+}
+
+func GetPayment(adminHostPort string, payID string) (*rpc.PaymentInfo, error) {
+	res := &rpc.GetPaymentResponse{}
+	err := adminGet(adminHostPort, "pays/"+neturl.PathEscape(payID), res)
+	return res.GetPayment(), err
+}
+
+func setPageQuery(query neturl.Values, offset, limit uint32) {
+	if offset != 0 {
+		query.Set("offset", strconv.FormatUint(uint64(offset), 10))
+	}
+	if limit != 0 {
+		query.Set("limit", strconv.FormatUint(uint64(limit), 10))
+	}
+}
+
+// adminGet gets the admin http endpoint at path and parses the response into res
+func adminGet(adminHostPort string, path string, res proto.Message) error {
+	resBody, err := HttpGet(fmt.Sprintf("http://%s/admin/%s", adminHostPort, path))
+	if err != nil {
+		if errors.Is(err, ErrHttpReponse) {
+			err = fmt.Errorf("%w, err msg: %s", err, getGrpcHttpErrMsg(resBody))
+		}
+		return err
+	}
+	return jsonpb.Unmarshal(bytes.NewReader(resBody), res)
+}
+
+// adminRequest posts the request to the admin http endpoint at path and parses the response into res
+func adminRequest(adminHostPort string, path string, request interface{}, res proto.Message) error {
+	url := fmt.Sprintf("http://%s/admin/%s", adminHostPort, path)
+	resBody, err := HttpPost(url, request)
+	if err != nil {
+		if errors.Is(err, ErrHttpReponse) {
+			err = fmt.Errorf("%w, err msg: %s", err, getGrpcHttpErrMsg(resBody))
+		}
+		return err
+	}
+	return jsonpb.Unmarshal(bytes.NewReader(resBody), res)
+}
+
 func RequestBuildRoutingTable(adminHostPort string, tokenAddr ctype.Addr) error {
 	request := &rpc.BuildRoutingTableRequest{TokenAddress: tokenAddr.Bytes()}
 	url := fmt.Sprintf("http://%s/admin/route/build", adminHostPort)
@@ -182,13 +402,24 @@ func HttpPost(url string, input interface{}) ([]byte, error) {
 		return nil, fmt.Errorf("json.Marshal err: %w", err)
 	}
 
+	return httpDo("POST", url, bytes.NewBuffer(payload))
+}
+
+func HttpGet(url string) ([]byte, error) {
+	log.Debugln("URL:>", url)
+	return httpDo("GET", url, nil)
+}
+
+func httpDo(method string, url string, body io.Reader) ([]byte, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
-	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(payload))
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
 		return nil, fmt.Errorf("NewRequestWithContext err: %w", err)
 	}
-	req.Header.Set("Content-Type", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
 
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
@@ -14,10 +14,12 @@ import (
 	"time"
 	"unsafe"
 
+	"github.com/celer-network/goCeler/common/intfs"
 	"github.com/celer-network/goCeler/ctype"
 	"github.com/celer-network/goCeler/entity"
 	"github.com/celer-network/goCeler/rpc"
 	"github.com/celer-network/goCeler/utils/bar"
+	"github.com/celer-network/goutils/eth"
 	"github.com/celer-network/goutils/jsonpbhex"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/golang/protobuf/jsonpb"
@@ -192,6 +194,14 @@ func GetTsAndSig(sign func([]byte) []byte) (ts uint64, sig []byte) {
 	return ts, sig
 }
 
+// SignEthMessageOfKind signs the message with its kind declared if the signer checks message kinds
+func SignEthMessageOfKind(signer eth.Signer, kind string, data []byte) ([]byte, error) {
+	if kindSigner, ok := signer.(intfs.KindSigner); ok {
+		return kindSigner.SignEthMessageOfKind(kind, data)
+	}
+	return signer.SignEthMessage(data)
+}
+
 // PbToJSONString marshals a protobuf msg to json string
 // Note we set EmitDefaults so json is always complete.
 // If you think you have a use case for omit default in json,
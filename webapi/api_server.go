@@ -100,6 +100,7 @@ func NewApiServer(
 	select {
 	case client := <-callbackImpl.clientReady:
 		s.apiClient = client
+		s.restoreAppSessions()
 	case err := <-callbackImpl.clientInitErr:
 		log.Fatal(err)
 		return nil
@@ -364,6 +365,20 @@ func (s *ApiServer) GetPeerFreeBalance(
 	return &rpc.FreeBalance{FreeBalance: status.FreeBalance, JoinStatus: status.JoinStatus}, nil
 }
 
+// GetPayFee returns the relay fee quoted by OSP for a pay
+func (s *ApiServer) GetPayFee(
+	context context.Context, request *msgrpc.GetPayFeeRequest) (*msgrpc.GetPayFeeResponse, error) {
+	var token *celersdk.Token
+	if ctype.Hex2Addr(request.Token) != ctype.EthTokenAddr {
+		token = &celersdk.Token{Addr: request.Token}
+	}
+	fee, err := s.apiClient.GetPayFee(token, request.Dst, request.Amt)
+	if err != nil {
+		return nil, err
+	}
+	return &msgrpc.GetPayFeeResponse{Fee: fee}, nil
+}
+
 func (s *ApiServer) SendConditionalPayment(
 	context context.Context,
 	request *rpc.SendConditionalPaymentRequest) (*rpc.PaymentID, error) {
@@ -385,13 +400,14 @@ func (s *ApiServer) SendConditionalPayment(
 			GetOutcomeArgs:  condition.GetOutcomeArgs,
 		}
 	}
-	payID, err = s.apiClient.SendConditionalPayment(
+	payID, err = s.apiClient.SendConditionalPaymentWithFee(
 		&celersdk.TokenInfo{
 			TokenType:    celersdk.TokenType(int32(tokenInfo.TokenType)),
 			TokenAddress: tokenInfo.TokenAddress,
 		},
 		request.Destination,
 		request.Amount,
+		request.Fee,
 		celersdk.TransferLogicType(int32(request.TransferLogicType)),
 		sdkConditions,
 		int64(request.Timeout),
@@ -670,6 +686,56 @@ func (s *ApiServer) GetPayHistory(
 	return resp, nil
 }
 
+// GetLocalPayHistory returns pays in the local pay history matching the filter.
+func (s *ApiServer) GetLocalPayHistory(
+	context context.Context, request *rpc.PayHistoryFilter) (*rpc.LocalPayHistory, error) {
+	paysJSONStr, err := s.apiClient.ExportPayHistory(toSdkPayHistoryFilter(request), celersdk.PayHistoryFormatJSON)
+	if err != nil {
+		return nil, err
+	}
+	var pays []*rpc.PayHistoryItem
+	if err = json.Unmarshal([]byte(paysJSONStr), &pays); err != nil {
+		return nil, err
+	}
+	return &rpc.LocalPayHistory{Pays: pays}, nil
+}
+
+// ExportPayHistory returns pays in the local pay history matching the filter in json or csv.
+func (s *ApiServer) ExportPayHistory(
+	context context.Context, request *rpc.ExportPayHistoryRequest) (*rpc.ExportedPayHistory, error) {
+	data, err := s.apiClient.ExportPayHistory(toSdkPayHistoryFilter(request.GetFilter()), request.GetFormat())
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.ExportedPayHistory{Data: data}, nil
+}
+
+func (s *ApiServer) SyncPayHistory(
+	context context.Context, request *empty.Empty) (*empty.Empty, error) {
+	err := s.apiClient.SyncPayHistory()
+	if err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func toSdkPayHistoryFilter(filter *rpc.PayHistoryFilter) *celersdk.PayHistoryFilter {
+	sdkFilter := &celersdk.PayHistoryFilter{
+		Counterparty: filter.GetCounterparty(),
+		Status:       int(filter.GetStatus()),
+		SinceTs:      filter.GetSinceTs(),
+		UntilTs:      filter.GetUntilTs(),
+		Limit:        int(filter.GetLimit()),
+	}
+	if filter.GetToken() != nil {
+		sdkFilter.Token = &celersdk.TokenInfo{
+			TokenType:    celersdk.TokenType(int32(filter.GetToken().GetTokenType())),
+			TokenAddress: filter.GetToken().GetTokenAddress(),
+		}
+	}
+	return sdkFilter
+}
+
 func (s *ApiServer) SyncOnChainPaymentChannelStatus(
 	context context.Context, request *rpc.TokenInfo) (*empty.Empty, error) {
 	var ercType string
@@ -975,6 +1041,29 @@ func (s *ApiServer) GetSeqNumForAppSession(
 	return &rpc.AppSessionSeqNum{SeqNum: uint64(seqNum)}, err
 }
 
+func (s *ApiServer) ListAppSessions(
+	context context.Context, request *empty.Empty) (*rpc.AppSessionList, error) {
+	sessionList := s.apiClient.ListAppSessions()
+	sessions := make([]*rpc.AppSessionInfo, 0, sessionList.Length)
+	for _, info := range sessionList.Sessions {
+		var participants []string
+		if info.Players != "" {
+			participants = strings.Split(info.Players, ",")
+		}
+		sessions = append(sessions, &rpc.AppSessionInfo{
+			SessionId:          info.ID,
+			OnDeployedContract: info.OnDeployedContract,
+			DeployedAddress:    info.DeployedAddr,
+			Nonce:              info.Nonce,
+			OnChainTimeout:     info.OnChainTimeout,
+			Participants:       participants,
+			SeqNum:             info.SeqNum,
+			StateProof:         info.StateProof,
+		})
+	}
+	return &rpc.AppSessionList{Sessions: sessions}, nil
+}
+
 func (s *ApiServer) GetBlockNumber(
 	context context.Context, request *empty.Empty) (*rpc.BlockNumber, error) {
 	return &rpc.BlockNumber{BlockNumber: uint64(s.apiClient.GetCurrentBlockNumber())}, nil
@@ -985,6 +1074,25 @@ func (s *ApiServer) SetMsgDropper(context context.Context, req *rpc.SetMsgDropRe
 	return new(empty.Empty), nil
 }
 
+// restoreAppSessions reloads the app sessions persisted before restart
+func (s *ApiServer) restoreAppSessions() {
+	sessionList := s.apiClient.ListAppSessions()
+	for _, info := range sessionList.Sessions {
+		callback := &appSessionCallback{seqNumChan: make(chan int)}
+		session, err := s.apiClient.RestoreAppSession(info.ID, callback)
+		if err != nil {
+			log.Errorln("restore app session", info.ID, err)
+			continue
+		}
+		s.appSessionMapLock.Lock()
+		s.appSessionMap[info.ID] = session
+		s.appSessionMapLock.Unlock()
+		s.appSessionCallbackMapLock.Lock()
+		s.appSessionCallbackMap[info.ID] = callback
+		s.appSessionCallbackMapLock.Unlock()
+	}
+}
+
 func (s *ApiServer) getAppSession(sessionID string) *celersdk.AppSession {
 	s.appSessionMapLock.Lock()
 	session := s.appSessionMap[sessionID]
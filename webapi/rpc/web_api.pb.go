@@ -12,6 +12,8 @@ import (
 	any "github.com/golang/protobuf/ptypes/any"
 	empty "github.com/golang/protobuf/ptypes/empty"
 	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
 	math "math"
 )
 
@@ -169,6 +171,319 @@ func (m *TokenInfo) GetTokenAddress() string {
 	return ""
 }
 
+// Filter of pays in the local pay history, zero value fields match all
+// Next tag: 7
+type PayHistoryFilter struct {
+	// hex address of the pay source or destination
+	Counterparty string `protobuf:"bytes,1,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+	// not set for all tokens
+	Token *TokenInfo `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// pay status, same as PaymentStatus
+	Status uint32 `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	// unix seconds, inclusive
+	SinceTs int64 `protobuf:"varint,4,opt,name=since_ts,json=sinceTs,proto3" json:"since_ts,omitempty"`
+	// unix seconds, exclusive
+	UntilTs int64 `protobuf:"varint,5,opt,name=until_ts,json=untilTs,proto3" json:"until_ts,omitempty"`
+	// max number of pays
+	Limit                int32    `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PayHistoryFilter) Reset()         { *m = PayHistoryFilter{} }
+func (m *PayHistoryFilter) String() string { return proto.CompactTextString(m) }
+func (*PayHistoryFilter) ProtoMessage()    {}
+func (*PayHistoryFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cedb4ba9fba0c04, []int{3}
+}
+
+func (m *PayHistoryFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayHistoryFilter.Unmarshal(m, b)
+}
+func (m *PayHistoryFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayHistoryFilter.Marshal(b, m, deterministic)
+}
+func (m *PayHistoryFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayHistoryFilter.Merge(m, src)
+}
+func (m *PayHistoryFilter) XXX_Size() int {
+	return xxx_messageInfo_PayHistoryFilter.Size(m)
+}
+func (m *PayHistoryFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayHistoryFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayHistoryFilter proto.InternalMessageInfo
+
+func (m *PayHistoryFilter) GetCounterparty() string {
+	if m != nil {
+		return m.Counterparty
+	}
+	return ""
+}
+
+func (m *PayHistoryFilter) GetToken() *TokenInfo {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+func (m *PayHistoryFilter) GetStatus() uint32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *PayHistoryFilter) GetSinceTs() int64 {
+	if m != nil {
+		return m.SinceTs
+	}
+	return 0
+}
+
+func (m *PayHistoryFilter) GetUntilTs() int64 {
+	if m != nil {
+		return m.UntilTs
+	}
+	return 0
+}
+
+func (m *PayHistoryFilter) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// Next tag: 9
+type PayHistoryItem struct {
+	PayId                string   `protobuf:"bytes,1,opt,name=pay_id,json=payId,proto3" json:"pay_id,omitempty"`
+	Src                  string   `protobuf:"bytes,2,opt,name=src,proto3" json:"src,omitempty"`
+	Dst                  string   `protobuf:"bytes,3,opt,name=dst,proto3" json:"dst,omitempty"`
+	Token                string   `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	Amt                  string   `protobuf:"bytes,5,opt,name=amt,proto3" json:"amt,omitempty"`
+	Status               uint32   `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`
+	CreateTs             int64    `protobuf:"varint,7,opt,name=create_ts,json=createTs,proto3" json:"create_ts,omitempty"`
+	UpdateTs             int64    `protobuf:"varint,8,opt,name=update_ts,json=updateTs,proto3" json:"update_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PayHistoryItem) Reset()         { *m = PayHistoryItem{} }
+func (m *PayHistoryItem) String() string { return proto.CompactTextString(m) }
+func (*PayHistoryItem) ProtoMessage()    {}
+func (*PayHistoryItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cedb4ba9fba0c04, []int{4}
+}
+
+func (m *PayHistoryItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayHistoryItem.Unmarshal(m, b)
+}
+func (m *PayHistoryItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayHistoryItem.Marshal(b, m, deterministic)
+}
+func (m *PayHistoryItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayHistoryItem.Merge(m, src)
+}
+func (m *PayHistoryItem) XXX_Size() int {
+	return xxx_messageInfo_PayHistoryItem.Size(m)
+}
+func (m *PayHistoryItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayHistoryItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayHistoryItem proto.InternalMessageInfo
+
+func (m *PayHistoryItem) GetPayId() string {
+	if m != nil {
+		return m.PayId
+	}
+	return ""
+}
+
+func (m *PayHistoryItem) GetSrc() string {
+	if m != nil {
+		return m.Src
+	}
+	return ""
+}
+
+func (m *PayHistoryItem) GetDst() string {
+	if m != nil {
+		return m.Dst
+	}
+	return ""
+}
+
+func (m *PayHistoryItem) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *PayHistoryItem) GetAmt() string {
+	if m != nil {
+		return m.Amt
+	}
+	return ""
+}
+
+func (m *PayHistoryItem) GetStatus() uint32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *PayHistoryItem) GetCreateTs() int64 {
+	if m != nil {
+		return m.CreateTs
+	}
+	return 0
+}
+
+func (m *PayHistoryItem) GetUpdateTs() int64 {
+	if m != nil {
+		return m.UpdateTs
+	}
+	return 0
+}
+
+// Next tag: 2
+type LocalPayHistory struct {
+	// sorted in reverse-chronological order
+	Pays                 []*PayHistoryItem `protobuf:"bytes,1,rep,name=pays,proto3" json:"pays,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *LocalPayHistory) Reset()         { *m = LocalPayHistory{} }
+func (m *LocalPayHistory) String() string { return proto.CompactTextString(m) }
+func (*LocalPayHistory) ProtoMessage()    {}
+func (*LocalPayHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cedb4ba9fba0c04, []int{5}
+}
+
+func (m *LocalPayHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LocalPayHistory.Unmarshal(m, b)
+}
+func (m *LocalPayHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LocalPayHistory.Marshal(b, m, deterministic)
+}
+func (m *LocalPayHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocalPayHistory.Merge(m, src)
+}
+func (m *LocalPayHistory) XXX_Size() int {
+	return xxx_messageInfo_LocalPayHistory.Size(m)
+}
+func (m *LocalPayHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocalPayHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LocalPayHistory proto.InternalMessageInfo
+
+func (m *LocalPayHistory) GetPays() []*PayHistoryItem {
+	if m != nil {
+		return m.Pays
+	}
+	return nil
+}
+
+// Next tag: 3
+type ExportPayHistoryRequest struct {
+	Filter *PayHistoryFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// "json" or "csv"
+	Format               string   `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportPayHistoryRequest) Reset()         { *m = ExportPayHistoryRequest{} }
+func (m *ExportPayHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportPayHistoryRequest) ProtoMessage()    {}
+func (*ExportPayHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cedb4ba9fba0c04, []int{6}
+}
+
+func (m *ExportPayHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportPayHistoryRequest.Unmarshal(m, b)
+}
+func (m *ExportPayHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportPayHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportPayHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportPayHistoryRequest.Merge(m, src)
+}
+func (m *ExportPayHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportPayHistoryRequest.Size(m)
+}
+func (m *ExportPayHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportPayHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportPayHistoryRequest proto.InternalMessageInfo
+
+func (m *ExportPayHistoryRequest) GetFilter() *PayHistoryFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ExportPayHistoryRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+// Next tag: 2
+type ExportedPayHistory struct {
+	Data                 string   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportedPayHistory) Reset()         { *m = ExportedPayHistory{} }
+func (m *ExportedPayHistory) String() string { return proto.CompactTextString(m) }
+func (*ExportedPayHistory) ProtoMessage()    {}
+func (*ExportedPayHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cedb4ba9fba0c04, []int{7}
+}
+
+func (m *ExportedPayHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportedPayHistory.Unmarshal(m, b)
+}
+func (m *ExportedPayHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportedPayHistory.Marshal(b, m, deterministic)
+}
+func (m *ExportedPayHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportedPayHistory.Merge(m, src)
+}
+func (m *ExportedPayHistory) XXX_Size() int {
+	return xxx_messageInfo_ExportedPayHistory.Size(m)
+}
+func (m *ExportedPayHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportedPayHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportedPayHistory proto.InternalMessageInfo
+
+func (m *ExportedPayHistory) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
 type SetDelegationRequest struct {
 	TokenInfos           []*TokenInfo `protobuf:"bytes,1,rep,name=token_infos,json=tokenInfos,proto3" json:"token_infos,omitempty"`
 	BlockDuration        int64        `protobuf:"varint,2,opt,name=block_duration,json=blockDuration,proto3" json:"block_duration,omitempty"`
@@ -181,7 +496,7 @@ func (m *SetDelegationRequest) Reset()         { *m = SetDelegationRequest{} }
 func (m *SetDelegationRequest) String() string { return proto.CompactTextString(m) }
 func (*SetDelegationRequest) ProtoMessage()    {}
 func (*SetDelegationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{3}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{8}
 }
 
 func (m *SetDelegationRequest) XXX_Unmarshal(b []byte) error {
@@ -229,7 +544,7 @@ func (m *OpenPaymentChannelRequest) Reset()         { *m = OpenPaymentChannelReq
 func (m *OpenPaymentChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*OpenPaymentChannelRequest) ProtoMessage()    {}
 func (*OpenPaymentChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{4}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{9}
 }
 
 func (m *OpenPaymentChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -282,7 +597,7 @@ func (m *ChannelID) Reset()         { *m = ChannelID{} }
 func (m *ChannelID) String() string { return proto.CompactTextString(m) }
 func (*ChannelID) ProtoMessage()    {}
 func (*ChannelID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{5}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{10}
 }
 
 func (m *ChannelID) XXX_Unmarshal(b []byte) error {
@@ -322,7 +637,7 @@ func (m *DepositOrWithdrawRequest) Reset()         { *m = DepositOrWithdrawReque
 func (m *DepositOrWithdrawRequest) String() string { return proto.CompactTextString(m) }
 func (*DepositOrWithdrawRequest) ProtoMessage()    {}
 func (*DepositOrWithdrawRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{6}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{11}
 }
 
 func (m *DepositOrWithdrawRequest) XXX_Unmarshal(b []byte) error {
@@ -369,7 +684,7 @@ func (m *DepositOrWithdrawJob) Reset()         { *m = DepositOrWithdrawJob{} }
 func (m *DepositOrWithdrawJob) String() string { return proto.CompactTextString(m) }
 func (*DepositOrWithdrawJob) ProtoMessage()    {}
 func (*DepositOrWithdrawJob) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{7}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{12}
 }
 
 func (m *DepositOrWithdrawJob) XXX_Unmarshal(b []byte) error {
@@ -417,7 +732,7 @@ func (m *GetBalanceResponse) Reset()         { *m = GetBalanceResponse{} }
 func (m *GetBalanceResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBalanceResponse) ProtoMessage()    {}
 func (*GetBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{8}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{13}
 }
 
 func (m *GetBalanceResponse) XXX_Unmarshal(b []byte) error {
@@ -471,7 +786,7 @@ func (m *GetPeerFreeBalanceRequest) Reset()         { *m = GetPeerFreeBalanceReq
 func (m *GetPeerFreeBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPeerFreeBalanceRequest) ProtoMessage()    {}
 func (*GetPeerFreeBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{9}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{14}
 }
 
 func (m *GetPeerFreeBalanceRequest) XXX_Unmarshal(b []byte) error {
@@ -518,7 +833,7 @@ func (m *FreeBalance) Reset()         { *m = FreeBalance{} }
 func (m *FreeBalance) String() string { return proto.CompactTextString(m) }
 func (*FreeBalance) ProtoMessage()    {}
 func (*FreeBalance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{10}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{15}
 }
 
 func (m *FreeBalance) XXX_Unmarshal(b []byte) error {
@@ -567,7 +882,7 @@ func (m *Condition) Reset()         { *m = Condition{} }
 func (m *Condition) String() string { return proto.CompactTextString(m) }
 func (*Condition) ProtoMessage()    {}
 func (*Condition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{11}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{16}
 }
 
 func (m *Condition) XXX_Unmarshal(b []byte) error {
@@ -617,23 +932,25 @@ func (m *Condition) GetGetOutcomeArgs() []byte {
 }
 
 type SendConditionalPaymentRequest struct {
-	TokenInfo            *TokenInfo                  `protobuf:"bytes,1,opt,name=token_info,json=tokenInfo,proto3" json:"token_info,omitempty"`
-	Amount               string                      `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Destination          string                      `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
-	TransferLogicType    entity.TransferFunctionType `protobuf:"varint,4,opt,name=transfer_logic_type,json=transferLogicType,proto3,enum=entity.TransferFunctionType" json:"transfer_logic_type,omitempty"`
-	Conditions           []*Condition                `protobuf:"bytes,5,rep,name=conditions,proto3" json:"conditions,omitempty"`
-	Timeout              uint64                      `protobuf:"varint,6,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	Note                 *any.Any                    `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+	TokenInfo         *TokenInfo                  `protobuf:"bytes,1,opt,name=token_info,json=tokenInfo,proto3" json:"token_info,omitempty"`
+	Amount            string                      `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Destination       string                      `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	TransferLogicType entity.TransferFunctionType `protobuf:"varint,4,opt,name=transfer_logic_type,json=transferLogicType,proto3,enum=entity.TransferFunctionType" json:"transfer_logic_type,omitempty"`
+	Conditions        []*Condition                `protobuf:"bytes,5,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Timeout           uint64                      `protobuf:"varint,6,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Note              *any.Any                    `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	// optional relay fee in wei quoted by GetPayFee
+	Fee                  string   `protobuf:"bytes,8,opt,name=fee,proto3" json:"fee,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SendConditionalPaymentRequest) Reset()         { *m = SendConditionalPaymentRequest{} }
 func (m *SendConditionalPaymentRequest) String() string { return proto.CompactTextString(m) }
 func (*SendConditionalPaymentRequest) ProtoMessage()    {}
 func (*SendConditionalPaymentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{12}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{17}
 }
 
 func (m *SendConditionalPaymentRequest) XXX_Unmarshal(b []byte) error {
@@ -703,6 +1020,13 @@ func (m *SendConditionalPaymentRequest) GetNote() *any.Any {
 	return nil
 }
 
+func (m *SendConditionalPaymentRequest) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
 type PaymentID struct {
 	PaymentId            string   `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -714,7 +1038,7 @@ func (m *PaymentID) Reset()         { *m = PaymentID{} }
 func (m *PaymentID) String() string { return proto.CompactTextString(m) }
 func (*PaymentID) ProtoMessage()    {}
 func (*PaymentID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{13}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{18}
 }
 
 func (m *PaymentID) XXX_Unmarshal(b []byte) error {
@@ -759,7 +1083,7 @@ func (m *PaymentInfo) Reset()         { *m = PaymentInfo{} }
 func (m *PaymentInfo) String() string { return proto.CompactTextString(m) }
 func (*PaymentInfo) ProtoMessage()    {}
 func (*PaymentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{14}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{19}
 }
 
 func (m *PaymentInfo) XXX_Unmarshal(b []byte) error {
@@ -842,7 +1166,7 @@ func (m *OutgoingPaymentInfo) Reset()         { *m = OutgoingPaymentInfo{} }
 func (m *OutgoingPaymentInfo) String() string { return proto.CompactTextString(m) }
 func (*OutgoingPaymentInfo) ProtoMessage()    {}
 func (*OutgoingPaymentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{15}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{20}
 }
 
 func (m *OutgoingPaymentInfo) XXX_Unmarshal(b []byte) error {
@@ -896,7 +1220,7 @@ func (m *OnChainPaymentInfo) Reset()         { *m = OnChainPaymentInfo{} }
 func (m *OnChainPaymentInfo) String() string { return proto.CompactTextString(m) }
 func (*OnChainPaymentInfo) ProtoMessage()    {}
 func (*OnChainPaymentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{16}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{21}
 }
 
 func (m *OnChainPaymentInfo) XXX_Unmarshal(b []byte) error {
@@ -942,7 +1266,7 @@ func (m *SessionID) Reset()         { *m = SessionID{} }
 func (m *SessionID) String() string { return proto.CompactTextString(m) }
 func (*SessionID) ProtoMessage()    {}
 func (*SessionID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{17}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{22}
 }
 
 func (m *SessionID) XXX_Unmarshal(b []byte) error {
@@ -986,7 +1310,7 @@ func (m *CreateAppSessionOnVirtualContractRequest) Reset() {
 func (m *CreateAppSessionOnVirtualContractRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateAppSessionOnVirtualContractRequest) ProtoMessage()    {}
 func (*CreateAppSessionOnVirtualContractRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{18}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{23}
 }
 
 func (m *CreateAppSessionOnVirtualContractRequest) XXX_Unmarshal(b []byte) error {
@@ -1048,10 +1372,12 @@ type CreateAppSessionOnDeployedContractRequest struct {
 func (m *CreateAppSessionOnDeployedContractRequest) Reset() {
 	*m = CreateAppSessionOnDeployedContractRequest{}
 }
-func (m *CreateAppSessionOnDeployedContractRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateAppSessionOnDeployedContractRequest) ProtoMessage()    {}
+func (m *CreateAppSessionOnDeployedContractRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*CreateAppSessionOnDeployedContractRequest) ProtoMessage() {}
 func (*CreateAppSessionOnDeployedContractRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{19}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{24}
 }
 
 func (m *CreateAppSessionOnDeployedContractRequest) XXX_Unmarshal(b []byte) error {
@@ -1112,7 +1438,7 @@ func (m *DisputeInfo) Reset()         { *m = DisputeInfo{} }
 func (m *DisputeInfo) String() string { return proto.CompactTextString(m) }
 func (*DisputeInfo) ProtoMessage()    {}
 func (*DisputeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{20}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{25}
 }
 
 func (m *DisputeInfo) XXX_Unmarshal(b []byte) error {
@@ -1159,7 +1485,7 @@ func (m *SignOutgoingStateRequest) Reset()         { *m = SignOutgoingStateReque
 func (m *SignOutgoingStateRequest) String() string { return proto.CompactTextString(m) }
 func (*SignOutgoingStateRequest) ProtoMessage()    {}
 func (*SignOutgoingStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{21}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{26}
 }
 
 func (m *SignOutgoingStateRequest) XXX_Unmarshal(b []byte) error {
@@ -1205,7 +1531,7 @@ func (m *SignedState) Reset()         { *m = SignedState{} }
 func (m *SignedState) String() string { return proto.CompactTextString(m) }
 func (*SignedState) ProtoMessage()    {}
 func (*SignedState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{22}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{27}
 }
 
 func (m *SignedState) XXX_Unmarshal(b []byte) error {
@@ -1244,7 +1570,7 @@ func (m *Data) Reset()         { *m = Data{} }
 func (m *Data) String() string { return proto.CompactTextString(m) }
 func (*Data) ProtoMessage()    {}
 func (*Data) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{23}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{28}
 }
 
 func (m *Data) XXX_Unmarshal(b []byte) error {
@@ -1283,7 +1609,7 @@ func (m *Signature) Reset()         { *m = Signature{} }
 func (m *Signature) String() string { return proto.CompactTextString(m) }
 func (*Signature) ProtoMessage()    {}
 func (*Signature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{24}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{29}
 }
 
 func (m *Signature) XXX_Unmarshal(b []byte) error {
@@ -1323,7 +1649,7 @@ func (m *ValidateAckRequest) Reset()         { *m = ValidateAckRequest{} }
 func (m *ValidateAckRequest) String() string { return proto.CompactTextString(m) }
 func (*ValidateAckRequest) ProtoMessage()    {}
 func (*ValidateAckRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{25}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{30}
 }
 
 func (m *ValidateAckRequest) XXX_Unmarshal(b []byte) error {
@@ -1369,7 +1695,7 @@ func (m *BoolValue) Reset()         { *m = BoolValue{} }
 func (m *BoolValue) String() string { return proto.CompactTextString(m) }
 func (*BoolValue) ProtoMessage()    {}
 func (*BoolValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{26}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{31}
 }
 
 func (m *BoolValue) XXX_Unmarshal(b []byte) error {
@@ -1409,7 +1735,7 @@ func (m *ProcessReceivedStateRequest) Reset()         { *m = ProcessReceivedStat
 func (m *ProcessReceivedStateRequest) String() string { return proto.CompactTextString(m) }
 func (*ProcessReceivedStateRequest) ProtoMessage()    {}
 func (*ProcessReceivedStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{27}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{32}
 }
 
 func (m *ProcessReceivedStateRequest) XXX_Unmarshal(b []byte) error {
@@ -1456,7 +1782,7 @@ func (m *ProcessReceivedStateResponse) Reset()         { *m = ProcessReceivedSta
 func (m *ProcessReceivedStateResponse) String() string { return proto.CompactTextString(m) }
 func (*ProcessReceivedStateResponse) ProtoMessage()    {}
 func (*ProcessReceivedStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{28}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{33}
 }
 
 func (m *ProcessReceivedStateResponse) XXX_Unmarshal(b []byte) error {
@@ -1503,7 +1829,7 @@ func (m *SettleAppSessionRequest) Reset()         { *m = SettleAppSessionRequest
 func (m *SettleAppSessionRequest) String() string { return proto.CompactTextString(m) }
 func (*SettleAppSessionRequest) ProtoMessage()    {}
 func (*SettleAppSessionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{29}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{34}
 }
 
 func (m *SettleAppSessionRequest) XXX_Unmarshal(b []byte) error {
@@ -1550,7 +1876,7 @@ func (m *SettleAppSessionByTimeoutRequest) Reset()         { *m = SettleAppSessi
 func (m *SettleAppSessionByTimeoutRequest) String() string { return proto.CompactTextString(m) }
 func (*SettleAppSessionByTimeoutRequest) ProtoMessage()    {}
 func (*SettleAppSessionByTimeoutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{30}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{35}
 }
 
 func (m *SettleAppSessionByTimeoutRequest) XXX_Unmarshal(b []byte) error {
@@ -1598,7 +1924,7 @@ func (m *SettleAppSessionByInvalidityRequest) Reset()         { *m = SettleAppSe
 func (m *SettleAppSessionByInvalidityRequest) String() string { return proto.CompactTextString(m) }
 func (*SettleAppSessionByInvalidityRequest) ProtoMessage()    {}
 func (*SettleAppSessionByInvalidityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{31}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{36}
 }
 
 func (m *SettleAppSessionByInvalidityRequest) XXX_Unmarshal(b []byte) error {
@@ -1651,7 +1977,7 @@ func (m *Address) Reset()         { *m = Address{} }
 func (m *Address) String() string { return proto.CompactTextString(m) }
 func (*Address) ProtoMessage()    {}
 func (*Address) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{32}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{37}
 }
 
 func (m *Address) XXX_Unmarshal(b []byte) error {
@@ -1691,7 +2017,7 @@ func (m *GetBooleanOutcomeForAppSessionRequest) Reset()         { *m = GetBoolea
 func (m *GetBooleanOutcomeForAppSessionRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBooleanOutcomeForAppSessionRequest) ProtoMessage()    {}
 func (*GetBooleanOutcomeForAppSessionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{33}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{38}
 }
 
 func (m *GetBooleanOutcomeForAppSessionRequest) XXX_Unmarshal(b []byte) error {
@@ -1738,7 +2064,7 @@ func (m *BooleanOutcome) Reset()         { *m = BooleanOutcome{} }
 func (m *BooleanOutcome) String() string { return proto.CompactTextString(m) }
 func (*BooleanOutcome) ProtoMessage()    {}
 func (*BooleanOutcome) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{34}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{39}
 }
 
 func (m *BooleanOutcome) XXX_Unmarshal(b []byte) error {
@@ -1785,7 +2111,7 @@ func (m *ApplyActionForAppSessionRequest) Reset()         { *m = ApplyActionForA
 func (m *ApplyActionForAppSessionRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplyActionForAppSessionRequest) ProtoMessage()    {}
 func (*ApplyActionForAppSessionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{35}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{40}
 }
 
 func (m *ApplyActionForAppSessionRequest) XXX_Unmarshal(b []byte) error {
@@ -1831,7 +2157,7 @@ func (m *BlockNumber) Reset()         { *m = BlockNumber{} }
 func (m *BlockNumber) String() string { return proto.CompactTextString(m) }
 func (*BlockNumber) ProtoMessage()    {}
 func (*BlockNumber) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{36}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{41}
 }
 
 func (m *BlockNumber) XXX_Unmarshal(b []byte) error {
@@ -1870,7 +2196,7 @@ func (m *AppSessionStatus) Reset()         { *m = AppSessionStatus{} }
 func (m *AppSessionStatus) String() string { return proto.CompactTextString(m) }
 func (*AppSessionStatus) ProtoMessage()    {}
 func (*AppSessionStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{37}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{42}
 }
 
 func (m *AppSessionStatus) XXX_Unmarshal(b []byte) error {
@@ -1910,7 +2236,7 @@ func (m *GetStateForAppSessionRequest) Reset()         { *m = GetStateForAppSess
 func (m *GetStateForAppSessionRequest) String() string { return proto.CompactTextString(m) }
 func (*GetStateForAppSessionRequest) ProtoMessage()    {}
 func (*GetStateForAppSessionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{38}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{43}
 }
 
 func (m *GetStateForAppSessionRequest) XXX_Unmarshal(b []byte) error {
@@ -1956,7 +2282,7 @@ func (m *AppSessionState) Reset()         { *m = AppSessionState{} }
 func (m *AppSessionState) String() string { return proto.CompactTextString(m) }
 func (*AppSessionState) ProtoMessage()    {}
 func (*AppSessionState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{39}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{44}
 }
 
 func (m *AppSessionState) XXX_Unmarshal(b []byte) error {
@@ -1995,7 +2321,7 @@ func (m *AppSessionSeqNum) Reset()         { *m = AppSessionSeqNum{} }
 func (m *AppSessionSeqNum) String() string { return proto.CompactTextString(m) }
 func (*AppSessionSeqNum) ProtoMessage()    {}
 func (*AppSessionSeqNum) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{40}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{45}
 }
 
 func (m *AppSessionSeqNum) XXX_Unmarshal(b []byte) error {
@@ -2023,6 +2349,140 @@ func (m *AppSessionSeqNum) GetSeqNum() uint64 {
 	return 0
 }
 
+type AppSessionInfo struct {
+	SessionId            string   `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	OnDeployedContract   bool     `protobuf:"varint,2,opt,name=on_deployed_contract,json=onDeployedContract,proto3" json:"on_deployed_contract,omitempty"`
+	DeployedAddress      string   `protobuf:"bytes,3,opt,name=deployed_address,json=deployedAddress,proto3" json:"deployed_address,omitempty"`
+	Nonce                uint64   `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	OnChainTimeout       uint64   `protobuf:"varint,5,opt,name=on_chain_timeout,json=onChainTimeout,proto3" json:"on_chain_timeout,omitempty"`
+	Participants         []string `protobuf:"bytes,6,rep,name=participants,proto3" json:"participants,omitempty"`
+	SeqNum               uint64   `protobuf:"varint,7,opt,name=seq_num,json=seqNum,proto3" json:"seq_num,omitempty"`
+	StateProof           []byte   `protobuf:"bytes,8,opt,name=state_proof,json=stateProof,proto3" json:"state_proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AppSessionInfo) Reset()         { *m = AppSessionInfo{} }
+func (m *AppSessionInfo) String() string { return proto.CompactTextString(m) }
+func (*AppSessionInfo) ProtoMessage()    {}
+func (*AppSessionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cedb4ba9fba0c04, []int{46}
+}
+
+func (m *AppSessionInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppSessionInfo.Unmarshal(m, b)
+}
+func (m *AppSessionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppSessionInfo.Marshal(b, m, deterministic)
+}
+func (m *AppSessionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppSessionInfo.Merge(m, src)
+}
+func (m *AppSessionInfo) XXX_Size() int {
+	return xxx_messageInfo_AppSessionInfo.Size(m)
+}
+func (m *AppSessionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppSessionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppSessionInfo proto.InternalMessageInfo
+
+func (m *AppSessionInfo) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
+	}
+	return ""
+}
+
+func (m *AppSessionInfo) GetOnDeployedContract() bool {
+	if m != nil {
+		return m.OnDeployedContract
+	}
+	return false
+}
+
+func (m *AppSessionInfo) GetDeployedAddress() string {
+	if m != nil {
+		return m.DeployedAddress
+	}
+	return ""
+}
+
+func (m *AppSessionInfo) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *AppSessionInfo) GetOnChainTimeout() uint64 {
+	if m != nil {
+		return m.OnChainTimeout
+	}
+	return 0
+}
+
+func (m *AppSessionInfo) GetParticipants() []string {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+func (m *AppSessionInfo) GetSeqNum() uint64 {
+	if m != nil {
+		return m.SeqNum
+	}
+	return 0
+}
+
+func (m *AppSessionInfo) GetStateProof() []byte {
+	if m != nil {
+		return m.StateProof
+	}
+	return nil
+}
+
+type AppSessionList struct {
+	Sessions             []*AppSessionInfo `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *AppSessionList) Reset()         { *m = AppSessionList{} }
+func (m *AppSessionList) String() string { return proto.CompactTextString(m) }
+func (*AppSessionList) ProtoMessage()    {}
+func (*AppSessionList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cedb4ba9fba0c04, []int{47}
+}
+
+func (m *AppSessionList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppSessionList.Unmarshal(m, b)
+}
+func (m *AppSessionList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppSessionList.Marshal(b, m, deterministic)
+}
+func (m *AppSessionList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppSessionList.Merge(m, src)
+}
+func (m *AppSessionList) XXX_Size() int {
+	return xxx_messageInfo_AppSessionList.Size(m)
+}
+func (m *AppSessionList) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppSessionList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppSessionList proto.InternalMessageInfo
+
+func (m *AppSessionList) GetSessions() []*AppSessionInfo {
+	if m != nil {
+		return m.Sessions
+	}
+	return nil
+}
+
 type SetMsgDropReq struct {
 	DropRecv             bool     `protobuf:"varint,1,opt,name=drop_recv,json=dropRecv,proto3" json:"drop_recv,omitempty"`
 	DropSend             bool     `protobuf:"varint,2,opt,name=drop_send,json=dropSend,proto3" json:"drop_send,omitempty"`
@@ -2035,7 +2495,7 @@ func (m *SetMsgDropReq) Reset()         { *m = SetMsgDropReq{} }
 func (m *SetMsgDropReq) String() string { return proto.CompactTextString(m) }
 func (*SetMsgDropReq) ProtoMessage()    {}
 func (*SetMsgDropReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{41}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{48}
 }
 
 func (m *SetMsgDropReq) XXX_Unmarshal(b []byte) error {
@@ -2081,7 +2541,7 @@ func (m *PaymentStatus) Reset()         { *m = PaymentStatus{} }
 func (m *PaymentStatus) String() string { return proto.CompactTextString(m) }
 func (*PaymentStatus) ProtoMessage()    {}
 func (*PaymentStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cedb4ba9fba0c04, []int{42}
+	return fileDescriptor_4cedb4ba9fba0c04, []int{49}
 }
 
 func (m *PaymentStatus) XXX_Unmarshal(b []byte) error {
@@ -2113,6 +2573,11 @@ func init() {
 	proto.RegisterType((*GetPayHistoryRequest)(nil), "webrpc.GetPayHistoryRequest")
 	proto.RegisterType((*GetPayHistoryResponse)(nil), "webrpc.GetPayHistoryResponse")
 	proto.RegisterType((*TokenInfo)(nil), "webrpc.TokenInfo")
+	proto.RegisterType((*PayHistoryFilter)(nil), "webrpc.PayHistoryFilter")
+	proto.RegisterType((*PayHistoryItem)(nil), "webrpc.PayHistoryItem")
+	proto.RegisterType((*LocalPayHistory)(nil), "webrpc.LocalPayHistory")
+	proto.RegisterType((*ExportPayHistoryRequest)(nil), "webrpc.ExportPayHistoryRequest")
+	proto.RegisterType((*ExportedPayHistory)(nil), "webrpc.ExportedPayHistory")
 	proto.RegisterType((*SetDelegationRequest)(nil), "webrpc.SetDelegationRequest")
 	proto.RegisterType((*OpenPaymentChannelRequest)(nil), "webrpc.OpenPaymentChannelRequest")
 	proto.RegisterType((*ChannelID)(nil), "webrpc.ChannelID")
@@ -2151,6 +2616,8 @@ func init() {
 	proto.RegisterType((*GetStateForAppSessionRequest)(nil), "webrpc.GetStateForAppSessionRequest")
 	proto.RegisterType((*AppSessionState)(nil), "webrpc.AppSessionState")
 	proto.RegisterType((*AppSessionSeqNum)(nil), "webrpc.AppSessionSeqNum")
+	proto.RegisterType((*AppSessionInfo)(nil), "webrpc.AppSessionInfo")
+	proto.RegisterType((*AppSessionList)(nil), "webrpc.AppSessionList")
 	proto.RegisterType((*SetMsgDropReq)(nil), "webrpc.SetMsgDropReq")
 	proto.RegisterType((*PaymentStatus)(nil), "webrpc.PaymentStatus")
 }
@@ -2158,166 +2625,191 @@ func init() {
 func init() { proto.RegisterFile("web_api.proto", fileDescriptor_4cedb4ba9fba0c04) }
 
 var fileDescriptor_4cedb4ba9fba0c04 = []byte{
-	// 2533 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xd9, 0x76, 0x1b, 0xc7,
-	0xd1, 0x06, 0xcc, 0x4d, 0x28, 0x80, 0x5b, 0x8b, 0x92, 0x21, 0x88, 0x32, 0xc9, 0x91, 0xfd, 0x8b,
-	0xb2, 0x8f, 0x28, 0x59, 0xff, 0x75, 0x16, 0x8a, 0x14, 0x29, 0x2a, 0xa2, 0x40, 0x0f, 0x78, 0x2c,
-	0x3b, 0x27, 0x39, 0x38, 0x8d, 0x99, 0x22, 0x38, 0x24, 0xd0, 0x3d, 0xec, 0x69, 0x50, 0x46, 0x1e,
-	0x20, 0x77, 0x79, 0x80, 0xbc, 0x49, 0x4e, 0xae, 0xf2, 0x08, 0x79, 0x88, 0xe4, 0x3d, 0x72, 0x7a,
-	0x99, 0x05, 0xcb, 0x80, 0x8b, 0x79, 0x87, 0xae, 0xae, 0xf9, 0xaa, 0xba, 0xba, 0xab, 0xba, 0xbe,
-	0x06, 0xcc, 0x7f, 0xc6, 0x56, 0x93, 0x86, 0xc1, 0x56, 0x28, 0xb8, 0xe4, 0x64, 0xf6, 0x33, 0xb6,
-	0x44, 0xe8, 0xd5, 0x1e, 0xb5, 0x39, 0x6f, 0x77, 0xf0, 0xa5, 0x96, 0xb6, 0x7a, 0x27, 0x2f, 0x29,
-	0xeb, 0x1b, 0x95, 0xda, 0xe3, 0xe1, 0x29, 0xec, 0x86, 0x32, 0x9e, 0xac, 0x20, 0x93, 0x41, 0x32,
-	0x9a, 0xef, 0x62, 0x14, 0xd1, 0x36, 0x9a, 0xa1, 0xf3, 0x33, 0xac, 0xec, 0xa3, 0x3c, 0xa2, 0xfd,
-	0x77, 0x41, 0x24, 0xb9, 0xe8, 0xbb, 0x78, 0xd1, 0xc3, 0x48, 0x92, 0x27, 0x00, 0x27, 0x82, 0x77,
-	0x9b, 0x91, 0xa4, 0x42, 0x56, 0x8b, 0xeb, 0xc5, 0xcd, 0x7b, 0x6e, 0x49, 0x49, 0x1a, 0x4a, 0x40,
-	0x1c, 0xa8, 0x04, 0x12, 0xbb, 0xd1, 0x11, 0x8a, 0x23, 0xda, 0xc6, 0xea, 0x17, 0xeb, 0xc5, 0xcd,
-	0x19, 0x77, 0x40, 0xe6, 0x9c, 0xc1, 0x83, 0x21, 0xe8, 0x28, 0xe4, 0x2c, 0x42, 0xf2, 0x1c, 0xa6,
-	0x43, 0xda, 0x8f, 0xaa, 0xc5, 0xf5, 0xa9, 0xcd, 0xf2, 0xeb, 0x07, 0x5b, 0x22, 0xf4, 0xb6, 0xea,
-	0x0c, 0x8d, 0x5a, 0xe0, 0xd1, 0xce, 0x11, 0xed, 0xbb, 0x5a, 0x85, 0xfc, 0x1f, 0x2c, 0x9e, 0xd2,
-	0xa8, 0xd9, 0xe5, 0x02, 0x9b, 0x02, 0xa3, 0x5e, 0x47, 0x6a, 0x53, 0xf7, 0xdc, 0xf9, 0x53, 0x1a,
-	0x1d, 0x72, 0x81, 0xae, 0x16, 0x3a, 0x2d, 0x28, 0x1d, 0xf3, 0x73, 0x64, 0x07, 0xec, 0x84, 0x93,
-	0x57, 0x00, 0x52, 0x0d, 0x9a, 0xb2, 0x1f, 0xa2, 0xf6, 0x7d, 0xe1, 0xf5, 0xf2, 0x96, 0x8d, 0x82,
-	0x56, 0x3b, 0xee, 0x87, 0xe8, 0x96, 0x64, 0xfc, 0x93, 0x3c, 0x85, 0x79, 0xf3, 0x05, 0xf5, 0x7d,
-	0x81, 0x51, 0xa4, 0x8d, 0x94, 0xdc, 0x8a, 0x16, 0x6e, 0x1b, 0x99, 0x73, 0x01, 0x2b, 0x0d, 0x94,
-	0xbb, 0xd8, 0xc1, 0x36, 0x95, 0x01, 0x67, 0x71, 0xa8, 0x5e, 0x43, 0xd9, 0x7c, 0x1c, 0xb0, 0x13,
-	0x1e, 0xaf, 0x6a, 0x79, 0xcb, 0xec, 0xda, 0x56, 0xe2, 0x96, 0x6b, 0x9c, 0x52, 0x3f, 0x23, 0xf2,
-	0x0d, 0x2c, 0xb4, 0x3a, 0xdc, 0x3b, 0x6f, 0xfa, 0x3d, 0xa1, 0xc1, 0xb4, 0xc5, 0x29, 0x77, 0x5e,
-	0x4b, 0x77, 0xad, 0xd0, 0xf9, 0x6b, 0x11, 0x1e, 0xd5, 0x43, 0x64, 0x47, 0xb4, 0xdf, 0x45, 0x26,
-	0x77, 0x4e, 0x29, 0x63, 0xd8, 0x89, 0x0d, 0x27, 0xeb, 0x54, 0x86, 0xf5, 0x3a, 0xc7, 0xda, 0x2d,
-	0x25, 0x76, 0xc9, 0x43, 0x98, 0xa5, 0x5d, 0xde, 0x63, 0xd2, 0x2e, 0xd0, 0x8e, 0xc8, 0x1a, 0x94,
-	0x43, 0x44, 0xd1, 0xb4, 0x93, 0x53, 0x7a, 0x12, 0x94, 0x68, 0x5b, 0x4b, 0x9c, 0x6f, 0xa1, 0x64,
-	0x8d, 0x1f, 0xec, 0xaa, 0xb3, 0xe1, 0x99, 0x41, 0x33, 0xf0, 0xb5, 0xdd, 0x92, 0x5b, 0xb2, 0x92,
-	0x03, 0xdf, 0xf1, 0xa1, 0xba, 0x8b, 0x21, 0x8f, 0x02, 0x59, 0x17, 0x9f, 0x02, 0x79, 0xea, 0x0b,
-	0xfa, 0xf9, 0xce, 0x5d, 0x76, 0xf6, 0x60, 0x65, 0xc4, 0xca, 0x7b, 0xde, 0x22, 0x0f, 0x60, 0xf6,
-	0x8c, 0xb7, 0x52, 0xc7, 0x66, 0xce, 0x78, 0xeb, 0xc0, 0x27, 0x5f, 0xc2, 0x9c, 0xfc, 0xa5, 0x79,
-	0x4a, 0xa3, 0xd3, 0x18, 0x47, 0xfe, 0xf2, 0x8e, 0x46, 0xa7, 0xce, 0xdf, 0x8a, 0x40, 0xf6, 0x51,
-	0xbe, 0xa1, 0x1d, 0xca, 0x3c, 0x4c, 0xce, 0xe8, 0x06, 0x54, 0x4e, 0x04, 0x62, 0xb3, 0x65, 0xe4,
-	0x16, 0xac, 0xac, 0x64, 0x56, 0x55, 0xed, 0xa1, 0xda, 0x2c, 0xf4, 0x13, 0x25, 0x83, 0x3c, 0x6f,
-	0xa4, 0xb1, 0xda, 0x0b, 0x20, 0x02, 0x3d, 0x0c, 0x2e, 0x03, 0xd6, 0x6e, 0x7a, 0x34, 0xa4, 0x5e,
-	0x20, 0xfb, 0x36, 0xc4, 0xcb, 0xc9, 0xcc, 0x8e, 0x9d, 0x70, 0x42, 0x78, 0xa4, 0xb2, 0x06, 0x51,
-	0xec, 0xa5, 0xb6, 0x6e, 0x1f, 0xbe, 0x0d, 0xa8, 0x98, 0x9d, 0x1d, 0x38, 0xd8, 0x7a, 0xb7, 0xe3,
-	0x73, 0xfd, 0x03, 0x94, 0x33, 0xa6, 0xae, 0xb3, 0xf2, 0x35, 0x28, 0x9f, 0xf1, 0x80, 0xa9, 0xe2,
-	0x20, 0x7b, 0x91, 0x4d, 0x7e, 0x50, 0xa2, 0x86, 0x96, 0x38, 0xff, 0x28, 0x42, 0x69, 0x87, 0x33,
-	0x3f, 0x50, 0xa7, 0x98, 0x7c, 0x0b, 0xcb, 0x9c, 0x35, 0xbd, 0x53, 0x1a, 0xb0, 0xa6, 0x8f, 0x61,
-	0x87, 0xf7, 0xd1, 0xb7, 0x25, 0x65, 0x91, 0xb3, 0x1d, 0x25, 0xdf, 0xb5, 0x62, 0xf2, 0x1c, 0x96,
-	0x3c, 0xce, 0xa4, 0xa0, 0x9e, 0x1c, 0xf2, 0x79, 0x31, 0x96, 0x5b, 0xbf, 0x15, 0x6c, 0x10, 0x35,
-	0x4f, 0x02, 0x46, 0x3b, 0xc1, 0x5f, 0xd0, 0x6f, 0x52, 0xd1, 0x8e, 0x74, 0x5c, 0x2b, 0xee, 0x62,
-	0x10, 0xed, 0xc5, 0xf2, 0x6d, 0xd1, 0x8e, 0xc8, 0x26, 0x2c, 0xb5, 0x51, 0x36, 0x79, 0x4f, 0x7a,
-	0xbc, 0x8b, 0x46, 0x75, 0x5a, 0xab, 0x2e, 0xb4, 0x51, 0xd6, 0x8d, 0x58, 0x69, 0x3a, 0xff, 0xfe,
-	0x02, 0x9e, 0x34, 0x90, 0xf9, 0x89, 0xfb, 0xba, 0x1c, 0xa9, 0xec, 0xbb, 0xfb, 0xb4, 0x5b, 0x87,
-	0xb2, 0x8f, 0x91, 0x0c, 0x98, 0x29, 0x01, 0xe6, 0x4c, 0x64, 0x45, 0xe4, 0x03, 0xdc, 0x97, 0x82,
-	0xb2, 0xe8, 0x04, 0x45, 0xb3, 0xc3, 0xdb, 0x81, 0x67, 0x6a, 0xda, 0xb4, 0xae, 0x69, 0xab, 0x49,
-	0x4d, 0xb3, 0x2a, 0x7b, 0x3d, 0xe6, 0xa9, 0xcf, 0x74, 0x79, 0x5b, 0x8e, 0x3f, 0xfc, 0xa0, 0xbe,
-	0xd3, 0x65, 0xee, 0x7b, 0x00, 0x2f, 0x5e, 0x56, 0x54, 0x9d, 0x19, 0x2c, 0x54, 0xc9, 0x82, 0xdd,
-	0x8c, 0x12, 0xa9, 0xc2, 0x9c, 0x0c, 0xba, 0xc8, 0x7b, 0xb2, 0x3a, 0xbb, 0x5e, 0xdc, 0x9c, 0x76,
-	0xe3, 0x21, 0xd9, 0x84, 0x69, 0xc6, 0x25, 0x56, 0xe7, 0x74, 0x00, 0x56, 0xb6, 0xcc, 0x15, 0xb4,
-	0x15, 0x5f, 0x41, 0x5b, 0xdb, 0xac, 0xef, 0x6a, 0x0d, 0x55, 0x3c, 0x6c, 0x08, 0x4d, 0xf1, 0x08,
-	0xcd, 0x20, 0x53, 0x3c, 0xac, 0xe4, 0xc0, 0x77, 0xfe, 0x5b, 0x84, 0x72, 0xac, 0xac, 0x42, 0x37,
-	0x59, 0x5d, 0x45, 0x36, 0x42, 0xe6, 0xa3, 0x88, 0x23, 0x6b, 0x46, 0xa4, 0x06, 0xf7, 0x4c, 0x6a,
-	0xa1, 0xb0, 0x61, 0x4d, 0xc6, 0x43, 0xfb, 0x37, 0x7d, 0xa3, 0xfd, 0x9b, 0x19, 0xd8, 0x3f, 0x95,
-	0x5c, 0xd6, 0xb9, 0xb3, 0x88, 0x33, 0x1d, 0x21, 0x95, 0x5c, 0x46, 0xf6, 0x3e, 0xe2, 0x4c, 0x3b,
-	0x68, 0xb2, 0x44, 0xc5, 0x69, 0xde, 0xb5, 0x23, 0x55, 0xd9, 0xef, 0xd7, 0x7b, 0xb2, 0xcd, 0x03,
-	0xd6, 0xce, 0xae, 0xf7, 0x05, 0xcc, 0xd9, 0xcf, 0xed, 0xc9, 0xba, 0x1f, 0x7b, 0x96, 0xd1, 0x72,
-	0x63, 0x1d, 0xe5, 0x01, 0x0a, 0xc1, 0x45, 0x53, 0x20, 0x8d, 0xec, 0x2d, 0x52, 0x72, 0xcb, 0x5a,
-	0xe6, 0x6a, 0x91, 0x8a, 0xa0, 0x51, 0xf1, 0xb8, 0x8f, 0x3a, 0x18, 0x53, 0x6e, 0x49, 0x4b, 0x76,
-	0xb8, 0x8f, 0xce, 0x27, 0x20, 0x75, 0x93, 0x83, 0x59, 0x37, 0xd2, 0x15, 0x17, 0x07, 0x56, 0xfc,
-	0x1c, 0x96, 0x04, 0x46, 0xbc, 0x73, 0x89, 0x4d, 0x1f, 0xa9, 0xdf, 0x09, 0x98, 0xa9, 0x7a, 0xd3,
-	0xee, 0xa2, 0x95, 0xef, 0x5a, 0xb1, 0xda, 0xf5, 0x06, 0x46, 0x51, 0xc0, 0x99, 0xd9, 0xf5, 0xc8,
-	0x0c, 0x32, 0xdb, 0x68, 0x25, 0x07, 0xbe, 0xf3, 0xaf, 0x22, 0x6c, 0xee, 0x08, 0xa4, 0x12, 0xb7,
-	0xc3, 0xd0, 0x7e, 0x55, 0x67, 0x3f, 0x06, 0x42, 0xf6, 0x68, 0x67, 0xc7, 0xe6, 0x7d, 0x9c, 0x7f,
-	0x1b, 0x50, 0x49, 0x4a, 0x44, 0x2b, 0x60, 0x71, 0x81, 0x8a, 0x65, 0x6f, 0x02, 0x46, 0xbe, 0x87,
-	0x95, 0x44, 0xc5, 0xe3, 0x2c, 0x92, 0xa2, 0xe7, 0x49, 0x1e, 0x1f, 0x92, 0xfb, 0xf1, 0xdc, 0x4e,
-	0x3a, 0x45, 0x56, 0x60, 0x86, 0x71, 0x55, 0xef, 0xa6, 0xf4, 0x72, 0xcc, 0x40, 0xd5, 0x8d, 0xa4,
-	0x74, 0xc5, 0x79, 0x30, 0xad, 0x15, 0x16, 0x6c, 0xe5, 0x3a, 0x36, 0x52, 0xe7, 0x9f, 0x45, 0x78,
-	0x3e, 0xba, 0x84, 0xb8, 0xae, 0x0d, 0xaf, 0x61, 0x5c, 0x99, 0x2b, 0x8e, 0x2f, 0x73, 0x89, 0x63,
-	0x5f, 0x5c, 0xe5, 0xd8, 0xd4, 0x38, 0xc7, 0x54, 0xab, 0x16, 0x52, 0x21, 0x03, 0x2f, 0x08, 0x29,
-	0x93, 0xaa, 0xec, 0x4d, 0xa9, 0xd6, 0x26, 0x2b, 0x73, 0xde, 0x42, 0x79, 0x37, 0x88, 0xc2, 0x9e,
-	0xc4, 0x38, 0xe9, 0x26, 0xec, 0x96, 0xba, 0x4b, 0x23, 0xbc, 0x68, 0xb2, 0x5e, 0xd7, 0xfa, 0x34,
-	0x1b, 0xe1, 0xc5, 0xc7, 0x5e, 0xd7, 0xa9, 0x43, 0xb5, 0x11, 0xb4, 0x59, 0x7c, 0xae, 0xd5, 0x65,
-	0x80, 0x99, 0x86, 0x72, 0x12, 0xe6, 0x0a, 0xcc, 0xa8, 0xcc, 0x30, 0xab, 0xac, 0xb8, 0x66, 0xe0,
-	0xbc, 0x82, 0xb2, 0x02, 0x44, 0x5f, 0x43, 0xa9, 0x9d, 0x8f, 0xf4, 0xb0, 0x69, 0x74, 0x8b, 0x5a,
-	0xb7, 0x1c, 0xa5, 0x2a, 0x4e, 0x0d, 0xa6, 0x77, 0xa9, 0xa4, 0x84, 0xc0, 0xb4, 0x4f, 0x25, 0xb5,
-	0x2a, 0xfa, 0xb7, 0xf3, 0x1c, 0x4a, 0x0a, 0x8d, 0xca, 0x9e, 0x40, 0xb2, 0x0a, 0xa5, 0x28, 0x1e,
-	0x58, 0xad, 0x54, 0xe0, 0xd4, 0x81, 0xfc, 0x48, 0x3b, 0x81, 0xaf, 0xb6, 0xd3, 0x3b, 0xbf, 0xe6,
-	0x1a, 0x6a, 0x70, 0x0f, 0xd9, 0x25, 0x76, 0x78, 0x18, 0x2f, 0x23, 0x19, 0x3b, 0x1b, 0x50, 0x7a,
-	0xc3, 0x79, 0xe7, 0x47, 0xda, 0xe9, 0xa1, 0x5a, 0xec, 0xa5, 0xfa, 0x61, 0x2f, 0x41, 0x33, 0x70,
-	0x7e, 0x82, 0xc7, 0x47, 0x82, 0x7b, 0x18, 0x45, 0xae, 0x29, 0x55, 0xfe, 0x4d, 0x02, 0x38, 0xc9,
-	0xf8, 0x09, 0xac, 0x8e, 0x47, 0xb6, 0xcd, 0xce, 0x53, 0x98, 0xf7, 0x51, 0x95, 0x87, 0xc1, 0xc0,
-	0x56, 0xac, 0x30, 0x09, 0x7e, 0x28, 0x30, 0xa4, 0x42, 0x5d, 0xb5, 0xde, 0xb9, 0x35, 0x52, 0x8e,
-	0x65, 0xdb, 0xde, 0xb9, 0xf3, 0x33, 0x7c, 0xd9, 0x40, 0x29, 0x3b, 0x99, 0x14, 0xb8, 0xa6, 0xf7,
-	0x6b, 0x50, 0xd6, 0x96, 0x9b, 0xa1, 0xe0, 0xfc, 0xc4, 0x62, 0x83, 0x16, 0x1d, 0x29, 0x89, 0xe3,
-	0xc3, 0xfa, 0x30, 0xf4, 0x9b, 0xbe, 0x3d, 0xe2, 0xd7, 0xb4, 0xb1, 0x01, 0x15, 0x2e, 0xa8, 0xd7,
-	0x19, 0x34, 0x52, 0x36, 0x32, 0x63, 0xe5, 0xef, 0x45, 0x78, 0x3a, 0x6a, 0xe6, 0x80, 0x5d, 0xaa,
-	0xb3, 0x10, 0xc8, 0xfe, 0x9d, 0x59, 0x22, 0xaf, 0x54, 0x85, 0xca, 0x1e, 0x66, 0xab, 0x6a, 0xfa,
-	0x17, 0x12, 0xcf, 0x35, 0xd2, 0x08, 0x3c, 0x85, 0xb9, 0xb8, 0x24, 0x54, 0x61, 0x6e, 0xb0, 0x68,
-	0xc4, 0x43, 0xe7, 0x4f, 0xf0, 0x8d, 0x6a, 0x66, 0x39, 0xef, 0x20, 0x65, 0xb6, 0xad, 0xd9, 0xe3,
-	0xe2, 0xc6, 0xfb, 0xb1, 0x02, 0x33, 0x17, 0x3d, 0x14, 0xfd, 0x38, 0x1d, 0xf5, 0xc0, 0x79, 0x07,
-	0x0b, 0x83, 0xd0, 0x2a, 0x8b, 0x92, 0x06, 0x2c, 0x61, 0x89, 0xb1, 0x40, 0xf9, 0x69, 0x3b, 0x2e,
-	0xcb, 0xda, 0xe2, 0xa1, 0xf3, 0x13, 0xac, 0x6d, 0x87, 0x61, 0xa7, 0xbf, 0xad, 0xfb, 0x95, 0xdb,
-	0x78, 0xa8, 0x6e, 0x28, 0x2f, 0x61, 0x4e, 0x15, 0xd7, 0x8e, 0x54, 0xc9, 0x78, 0xa3, 0x1a, 0xf0,
-	0x8f, 0xbd, 0x6e, 0x0b, 0x85, 0xda, 0x0a, 0x43, 0xb4, 0x98, 0x1e, 0x6b, 0x9c, 0x69, 0xb7, 0xdc,
-	0x4a, 0x55, 0x9c, 0x6f, 0x61, 0x29, 0xb5, 0x6e, 0x1a, 0xd8, 0xcc, 0xb5, 0x5d, 0x1c, 0xb8, 0xb6,
-	0xeb, 0xb0, 0xba, 0x8f, 0x52, 0xef, 0xca, 0x6d, 0x9c, 0x5e, 0x82, 0xa9, 0x73, 0xec, 0x5b, 0xae,
-	0xa7, 0x7e, 0x3a, 0xcf, 0x60, 0x71, 0xd0, 0x38, 0xa6, 0xa5, 0xb0, 0x98, 0x2d, 0x85, 0xdf, 0x0d,
-	0x78, 0xa9, 0xeb, 0x6d, 0xb6, 0x10, 0x17, 0x07, 0x0a, 0xf1, 0x01, 0xcc, 0x37, 0x50, 0x1e, 0x46,
-	0xed, 0x5d, 0xc1, 0x43, 0x17, 0x2f, 0xc8, 0x63, 0x28, 0xf9, 0x82, 0x87, 0x4d, 0x81, 0xde, 0xa5,
-	0xdd, 0xa7, 0x7b, 0xbe, 0x9e, 0xf3, 0x2e, 0x93, 0x49, 0xd5, 0x3b, 0xd9, 0x8d, 0xd2, 0x93, 0xaa,
-	0x0d, 0x76, 0x9e, 0xc1, 0xbc, 0x6d, 0x0c, 0x26, 0x87, 0xe6, 0xf5, 0x7f, 0xd6, 0x61, 0xf6, 0x13,
-	0xb6, 0xb6, 0xc3, 0x80, 0x7c, 0x84, 0xf9, 0x01, 0xe6, 0x4f, 0x56, 0xe3, 0x26, 0x66, 0xdc, 0x5b,
-	0x43, 0xed, 0x49, 0xce, 0xac, 0xa9, 0x4e, 0x4e, 0x81, 0xec, 0xeb, 0xe5, 0xa4, 0xcc, 0x3b, 0xc5,
-	0x1b, 0x47, 0xc8, 0x6b, 0x0f, 0x47, 0x7a, 0xd1, 0xb7, 0xdd, 0x50, 0xf6, 0x9d, 0x02, 0xf9, 0x00,
-	0x64, 0x94, 0x4e, 0x93, 0x8d, 0x18, 0x2d, 0x97, 0x6a, 0xd7, 0xd2, 0x2e, 0x39, 0x66, 0xc1, 0x4e,
-	0x81, 0xfc, 0x01, 0xe6, 0x2c, 0x05, 0x25, 0xeb, 0xf1, 0x7c, 0x1e, 0xf3, 0xad, 0xad, 0xe6, 0x6a,
-	0xbc, 0xe7, 0x2d, 0xa7, 0x40, 0x7e, 0x80, 0xe5, 0x43, 0xce, 0x02, 0xc9, 0x85, 0x55, 0x50, 0x64,
-	0x76, 0xe2, 0x47, 0x57, 0x42, 0x7e, 0x82, 0xfb, 0x3b, 0x9c, 0x87, 0x28, 0xa8, 0x0c, 0x2e, 0x31,
-	0x9e, 0xbb, 0x03, 0x5f, 0xff, 0x0c, 0x4f, 0xac, 0xaf, 0x63, 0xf0, 0x7f, 0xbd, 0xdf, 0xbf, 0x01,
-	0x48, 0x19, 0x39, 0x19, 0x6d, 0xcd, 0x6b, 0xb5, 0xcc, 0x81, 0x19, 0x22, 0xee, 0x4e, 0x81, 0x7c,
-	0xd4, 0x84, 0x7e, 0x88, 0x41, 0xa7, 0x9b, 0x9c, 0xcb, 0xae, 0x6b, 0x49, 0xab, 0x9d, 0x99, 0x73,
-	0x0a, 0xe4, 0x18, 0x1e, 0x8e, 0x27, 0x84, 0xe4, 0x9b, 0xf4, 0x18, 0x4e, 0x20, 0x8c, 0xe9, 0xe1,
-	0x49, 0x58, 0x90, 0x3e, 0x8a, 0x8f, 0x1a, 0xbd, 0x56, 0xe4, 0x89, 0xa0, 0x85, 0x07, 0xcc, 0xe3,
-	0xdd, 0x94, 0x08, 0x44, 0x24, 0xe7, 0x04, 0xd7, 0xc6, 0x91, 0x01, 0xa7, 0xf0, 0xaa, 0x48, 0x8e,
-	0x33, 0x68, 0x43, 0xb4, 0x22, 0x1f, 0xed, 0x71, 0x72, 0xee, 0x47, 0x89, 0x88, 0x46, 0xdd, 0x83,
-	0xea, 0x3e, 0xca, 0x21, 0xef, 0x6c, 0x19, 0x18, 0x5d, 0x54, 0xed, 0xc1, 0x90, 0xc8, 0x3e, 0x06,
-	0x14, 0x2c, 0xce, 0x90, 0x95, 0x5b, 0xe0, 0xbc, 0x85, 0x87, 0x3b, 0x9c, 0x9d, 0x04, 0xa2, 0x3b,
-	0x84, 0x35, 0x0e, 0x25, 0xbf, 0x0a, 0xec, 0xc2, 0x03, 0x17, 0xcf, 0xd0, 0x1b, 0x5e, 0xd9, 0xcd,
-	0x50, 0x1a, 0xf0, 0xb5, 0x69, 0x15, 0x2c, 0x7d, 0x72, 0x0d, 0xff, 0xf1, 0x7f, 0x15, 0xe8, 0x07,
-	0x78, 0x62, 0x71, 0x86, 0x60, 0xac, 0x91, 0x9b, 0xa1, 0xbd, 0xd3, 0x2f, 0xb0, 0x63, 0xe8, 0xdd,
-	0x18, 0x94, 0x24, 0xa7, 0x46, 0xd5, 0x9d, 0x02, 0x39, 0x84, 0xaf, 0xe2, 0xc8, 0x0f, 0xae, 0x36,
-	0x39, 0x64, 0x63, 0xd2, 0x74, 0xe2, 0x0e, 0x98, 0xd8, 0xbd, 0xfd, 0x25, 0x0c, 0xc4, 0x6d, 0x51,
-	0x3e, 0xc0, 0xc2, 0x01, 0x93, 0xc8, 0xfc, 0x1b, 0x94, 0xb6, 0x7c, 0xb4, 0xdf, 0xc2, 0xa2, 0x5d,
-	0x62, 0x02, 0x77, 0x23, 0x6f, 0x0e, 0xa0, 0x66, 0xbc, 0x31, 0x2b, 0x1b, 0xba, 0x63, 0x6e, 0x04,
-	0xf5, 0x1e, 0x1e, 0x5b, 0x57, 0x7e, 0x3d, 0x56, 0x03, 0x9e, 0xa9, 0x8e, 0x45, 0xe3, 0x24, 0x6f,
-	0x62, 0xaa, 0x71, 0xde, 0xe3, 0xe2, 0x6a, 0xdc, 0xa4, 0xe0, 0x64, 0x7a, 0x2a, 0x5d, 0x62, 0xd7,
-	0x1a, 0x7d, 0xe6, 0x0d, 0x1e, 0x15, 0x0b, 0x33, 0x9c, 0xd7, 0xd7, 0x71, 0xf2, 0x2d, 0x2c, 0x2b,
-	0x3c, 0xdd, 0xff, 0xa8, 0xe8, 0xab, 0x02, 0x9d, 0x5b, 0xb6, 0xf2, 0x61, 0xce, 0x60, 0xe3, 0xca,
-	0x57, 0x04, 0xf2, 0x2a, 0xb9, 0xca, 0xaf, 0xf9, 0xe0, 0x90, 0xd6, 0xef, 0xe4, 0x3d, 0xc3, 0x29,
-	0x90, 0x0e, 0x38, 0x57, 0xd3, 0x7d, 0xf2, 0x7d, 0xbe, 0xb1, 0x9c, 0xa7, 0x81, 0xf1, 0xd6, 0xde,
-	0x41, 0x2d, 0xa9, 0xef, 0x29, 0x88, 0xe5, 0xec, 0x64, 0xf4, 0x93, 0x74, 0xe3, 0x32, 0xbc, 0x5e,
-	0xd7, 0xf4, 0x0f, 0xb0, 0x3c, 0xc2, 0xd1, 0xd3, 0xbc, 0xc9, 0xa3, 0xef, 0x29, 0x5e, 0x86, 0x8f,
-	0xeb, 0xa4, 0x29, 0x67, 0x78, 0x32, 0x49, 0x8a, 0xc8, 0x28, 0x79, 0x4e, 0xd7, 0x95, 0xf0, 0x60,
-	0xa7, 0x40, 0x5e, 0xc0, 0x3d, 0x05, 0xa8, 0x29, 0x7b, 0x25, 0x71, 0x99, 0x4a, 0x9a, 0x09, 0x43,
-	0x42, 0xca, 0x0b, 0xc4, 0x83, 0x95, 0x71, 0x44, 0x96, 0x3c, 0x4d, 0xea, 0x59, 0x3e, 0x81, 0xae,
-	0x7d, 0x3d, 0x59, 0x29, 0xe9, 0x1f, 0x0e, 0x61, 0x69, 0x98, 0x03, 0x92, 0xb5, 0x4c, 0xc3, 0x39,
-	0x8e, 0xdf, 0x4e, 0x38, 0x94, 0x2d, 0x58, 0x1d, 0xa5, 0x94, 0x8d, 0xa0, 0x1d, 0xbf, 0xcf, 0x6c,
-	0xe6, 0x41, 0x0f, 0xf3, 0xdb, 0x09, 0x36, 0x3c, 0x78, 0x32, 0xfa, 0xf5, 0x21, 0xbf, 0xc4, 0xbb,
-	0x34, 0x72, 0x32, 0xce, 0x88, 0xe5, 0xc6, 0xc7, 0x3d, 0xc1, 0xc8, 0x77, 0xf9, 0x46, 0x46, 0x28,
-	0xf4, 0x04, 0x3b, 0x6d, 0xf8, 0x2a, 0x17, 0xc0, 0x6c, 0xf7, 0x1d, 0x19, 0xfa, 0x1d, 0x2c, 0x29,
-	0xf2, 0x90, 0x4d, 0xcb, 0x71, 0xa9, 0x94, 0x0f, 0xb0, 0x0f, 0x6b, 0xfb, 0x8a, 0x80, 0x98, 0x44,
-	0xb6, 0xec, 0x7c, 0x80, 0x17, 0x8e, 0xc3, 0x5b, 0x8c, 0x45, 0xf1, 0x1f, 0x30, 0x05, 0x12, 0xc0,
-	0x57, 0x93, 0x69, 0x3b, 0x79, 0x91, 0x6d, 0x79, 0xaf, 0xa4, 0xf7, 0xb5, 0x87, 0xd9, 0x64, 0x4b,
-	0x75, 0x75, 0xef, 0x5e, 0xcd, 0x63, 0xde, 0xe4, 0x59, 0xe2, 0xd9, 0x64, 0x6e, 0x3e, 0xb9, 0x2b,
-	0x8a, 0x6f, 0x99, 0x3a, 0x33, 0x08, 0xf6, 0x80, 0x5d, 0x19, 0x97, 0x7c, 0xd0, 0x3a, 0x3c, 0xcd,
-	0xbd, 0xc3, 0x26, 0x63, 0xe6, 0xdc, 0x5f, 0xef, 0x75, 0xbc, 0x8d, 0x7b, 0xf1, 0x83, 0xf5, 0x6d,
-	0xb1, 0xf6, 0xe1, 0xa1, 0x7d, 0x12, 0xe8, 0x5d, 0xbd, 0xf7, 0xd5, 0x4c, 0x84, 0x07, 0x5e, 0x1c,
-	0x9c, 0x02, 0xf9, 0x49, 0x77, 0x6b, 0xa3, 0x6f, 0x0b, 0xe4, 0xeb, 0xcc, 0xde, 0xe7, 0x3e, 0x3d,
-	0xd4, 0xbe, 0x1c, 0x0f, 0x8d, 0xa9, 0x8b, 0xfa, 0x6d, 0xe0, 0x56, 0x2e, 0x9a, 0x57, 0x05, 0x95,
-	0x31, 0x0b, 0xea, 0xfc, 0x65, 0xde, 0x57, 0xae, 0x64, 0x2a, 0x83, 0xc1, 0xfa, 0x7d, 0xf6, 0x61,
-	0x22, 0x44, 0x41, 0x1e, 0x64, 0x52, 0x39, 0x7d, 0xaf, 0xc8, 0x3f, 0x0b, 0x6f, 0x5e, 0xfc, 0xf1,
-	0xbb, 0x76, 0x20, 0x4f, 0x7b, 0xad, 0x2d, 0x8f, 0x77, 0x5f, 0x7a, 0xd8, 0x41, 0xf1, 0x82, 0xa1,
-	0xfc, 0xcc, 0xc5, 0xf9, 0xcb, 0x36, 0xdf, 0x51, 0xe3, 0x97, 0x9f, 0xb1, 0x45, 0xc3, 0xe0, 0xa5,
-	0x08, 0xbd, 0xd6, 0xac, 0x06, 0xf8, 0xff, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x2f, 0xf9, 0xae,
-	0xee, 0x54, 0x21, 0x00, 0x00,
+	// 2942 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x59, 0x72, 0x1b, 0xc9,
+	0xd1, 0x06, 0x44, 0x70, 0x41, 0x82, 0x6b, 0x89, 0x92, 0x20, 0x48, 0x1a, 0x51, 0xad, 0x99, 0x5f,
+	0x94, 0x26, 0x44, 0x71, 0xf8, 0xbf, 0xfe, 0xf3, 0xdb, 0x5c, 0x44, 0x0a, 0x32, 0x25, 0x72, 0x1a,
+	0x8c, 0xd1, 0x8c, 0xc3, 0x0e, 0x44, 0xa3, 0x3b, 0x01, 0x36, 0x09, 0x54, 0xb5, 0xaa, 0x0b, 0x94,
+	0xe0, 0x03, 0xf8, 0xcd, 0x07, 0xf0, 0x15, 0x7c, 0x02, 0x87, 0x9f, 0x1c, 0xe1, 0xf0, 0x83, 0xaf,
+	0xe1, 0x08, 0xdf, 0xc3, 0x51, 0x5b, 0x77, 0x63, 0xe5, 0x32, 0x7a, 0x43, 0x65, 0x65, 0xe7, 0x56,
+	0x59, 0x99, 0x59, 0x1f, 0x60, 0xe1, 0x13, 0x36, 0xea, 0x5e, 0x14, 0x6e, 0x44, 0x9c, 0x09, 0x46,
+	0x66, 0x3e, 0x61, 0x83, 0x47, 0x7e, 0xe5, 0x7e, 0x8b, 0xb1, 0x56, 0x1b, 0x5f, 0x29, 0x6a, 0xa3,
+	0xdb, 0x7c, 0xe5, 0xd1, 0x9e, 0x66, 0xa9, 0x3c, 0x18, 0xdc, 0xc2, 0x4e, 0x24, 0xec, 0xe6, 0x3c,
+	0x52, 0x11, 0x26, 0xab, 0x85, 0x0e, 0xc6, 0xb1, 0xd7, 0x42, 0xbd, 0x74, 0x7e, 0x86, 0xd5, 0x03,
+	0x14, 0xc7, 0x5e, 0xef, 0x4d, 0x18, 0x0b, 0xc6, 0x7b, 0x2e, 0x7e, 0xec, 0x62, 0x2c, 0xc8, 0x23,
+	0x80, 0x26, 0x67, 0x9d, 0x7a, 0x2c, 0x3c, 0x2e, 0xca, 0xf9, 0xb5, 0xfc, 0xfa, 0x9c, 0x5b, 0x94,
+	0x94, 0x9a, 0x24, 0x10, 0x07, 0xe6, 0x43, 0x81, 0x9d, 0xf8, 0x18, 0xf9, 0xb1, 0xd7, 0xc2, 0xf2,
+	0xad, 0xb5, 0xfc, 0xfa, 0xb4, 0xdb, 0x47, 0x73, 0xce, 0xe0, 0xce, 0x80, 0xe8, 0x38, 0x62, 0x34,
+	0x46, 0xf2, 0x1c, 0x0a, 0x91, 0xd7, 0x8b, 0xcb, 0xf9, 0xb5, 0xa9, 0xf5, 0xd2, 0xd6, 0x9d, 0x0d,
+	0x1e, 0xf9, 0x1b, 0x47, 0x14, 0x35, 0x5b, 0xe8, 0x7b, 0xed, 0x63, 0xaf, 0xe7, 0x2a, 0x16, 0xf2,
+	0x3f, 0xb0, 0x74, 0xea, 0xc5, 0xf5, 0x0e, 0xe3, 0x58, 0xe7, 0x18, 0x77, 0xdb, 0x42, 0xa9, 0x9a,
+	0x73, 0x17, 0x4e, 0xbd, 0xf8, 0x1d, 0xe3, 0xe8, 0x2a, 0xa2, 0xd3, 0x80, 0xe2, 0x09, 0x3b, 0x47,
+	0x5a, 0xa5, 0x4d, 0x46, 0x36, 0x01, 0x84, 0x5c, 0xd4, 0x45, 0x2f, 0x42, 0x65, 0xfb, 0xe2, 0xd6,
+	0xca, 0x86, 0x89, 0x82, 0x62, 0x3b, 0xe9, 0x45, 0xe8, 0x16, 0x85, 0xfd, 0x49, 0x9e, 0xc2, 0x82,
+	0xfe, 0xc2, 0x0b, 0x02, 0x8e, 0x71, 0xac, 0x94, 0x14, 0xdd, 0x79, 0x45, 0xdc, 0xd6, 0x34, 0xe7,
+	0x1f, 0x79, 0x58, 0x4e, 0xbd, 0xd9, 0x0f, 0xdb, 0x02, 0xb9, 0x0c, 0x84, 0xcf, 0xba, 0x54, 0x20,
+	0x8f, 0x3c, 0x2e, 0x7a, 0x4a, 0x5b, 0xd1, 0xed, 0xa3, 0x91, 0x67, 0x30, 0xad, 0x04, 0x29, 0xa9,
+	0xa5, 0xad, 0x95, 0x0d, 0x7d, 0xa0, 0x1b, 0x89, 0xc5, 0xae, 0xde, 0x27, 0x77, 0x61, 0x26, 0x16,
+	0x9e, 0xe8, 0xc6, 0xe5, 0xa9, 0xb5, 0xfc, 0xfa, 0x82, 0x6b, 0x56, 0xe4, 0x3e, 0xcc, 0xc5, 0x21,
+	0xf5, 0xb1, 0x2e, 0xe2, 0x72, 0x61, 0x2d, 0xbf, 0x3e, 0xe5, 0xce, 0xaa, 0xf5, 0x89, 0xda, 0xea,
+	0x52, 0x11, 0xb6, 0xe5, 0xd6, 0xb4, 0xde, 0x52, 0xeb, 0x93, 0x98, 0xac, 0xc2, 0x74, 0x3b, 0xec,
+	0x84, 0xa2, 0x3c, 0xa3, 0x0e, 0x47, 0x2f, 0x9c, 0x7f, 0xe6, 0x61, 0x31, 0xf5, 0xa2, 0x2a, 0xb0,
+	0x43, 0xee, 0xc0, 0x4c, 0xe4, 0xf5, 0xea, 0x61, 0x60, 0xac, 0x9f, 0x8e, 0xbc, 0x5e, 0x35, 0x20,
+	0xcb, 0x30, 0x15, 0x73, 0xdf, 0x84, 0x42, 0xfe, 0x94, 0x94, 0x20, 0x16, 0xca, 0xb8, 0xa2, 0x2b,
+	0x7f, 0x4a, 0x1d, 0xda, 0xb5, 0x82, 0xfe, 0x52, 0xfb, 0xb1, 0x0c, 0x53, 0x5e, 0x47, 0x28, 0x7b,
+	0x8a, 0xae, 0xfc, 0x99, 0xf1, 0x6c, 0xa6, 0xcf, 0xb3, 0x07, 0x50, 0xf4, 0x39, 0x7a, 0x42, 0xb9,
+	0x36, 0xab, 0xec, 0x9f, 0xd3, 0x84, 0x13, 0xb5, 0xd9, 0x8d, 0x02, 0xb3, 0x39, 0xa7, 0x37, 0x35,
+	0xe1, 0x24, 0x76, 0xbe, 0x87, 0xa5, 0x43, 0xa6, 0x73, 0xc5, 0xf8, 0x42, 0x5e, 0xf4, 0xe5, 0xd5,
+	0x5d, 0x1b, 0xe6, 0x7e, 0x6f, 0x75, 0x62, 0x39, 0x3e, 0xdc, 0x7b, 0xfd, 0x39, 0x62, 0x7c, 0x44,
+	0xea, 0x6f, 0xc2, 0x4c, 0x53, 0x1d, 0xae, 0x0a, 0x47, 0x69, 0xab, 0x3c, 0x2c, 0x48, 0x1f, 0xbe,
+	0x6b, 0xf8, 0xa4, 0x77, 0x4d, 0xc6, 0x3b, 0x9e, 0x30, 0xc1, 0x32, 0x2b, 0x67, 0x1d, 0x88, 0x56,
+	0x82, 0x41, 0xc6, 0x4c, 0x02, 0x85, 0xc0, 0x13, 0x9e, 0x09, 0xb6, 0xfa, 0xed, 0x7c, 0x84, 0xd5,
+	0x1a, 0x8a, 0x3d, 0x6c, 0x63, 0xcb, 0x13, 0x21, 0xa3, 0xd6, 0x96, 0x2d, 0x28, 0xe9, 0xc4, 0x0c,
+	0x69, 0x93, 0x59, 0xcf, 0x46, 0x24, 0x10, 0x08, 0xfb, 0x33, 0x26, 0xdf, 0xc0, 0x62, 0xa3, 0xcd,
+	0xfc, 0xf3, 0x7a, 0xd0, 0xe5, 0x4a, 0x98, 0xb2, 0x6a, 0xca, 0x5d, 0x50, 0xd4, 0x3d, 0x43, 0x74,
+	0xfe, 0x98, 0x87, 0xfb, 0x47, 0x11, 0xd2, 0x63, 0xaf, 0xd7, 0x41, 0x2a, 0x76, 0x4f, 0x3d, 0x4a,
+	0xb1, 0x9d, 0x06, 0x01, 0x52, 0xc5, 0x26, 0x10, 0x23, 0xf4, 0x16, 0x13, 0xbd, 0x32, 0x08, 0x5e,
+	0x47, 0xa6, 0xbd, 0x0d, 0x82, 0x5e, 0x91, 0xc7, 0x50, 0x8a, 0x10, 0x79, 0xdd, 0x6c, 0xea, 0xe4,
+	0x01, 0x49, 0xda, 0x56, 0x14, 0xe7, 0x05, 0x14, 0x8d, 0xf2, 0xea, 0x9e, 0xac, 0x3b, 0xbe, 0x5e,
+	0xa4, 0xf9, 0x58, 0x34, 0x94, 0x6a, 0xe0, 0x04, 0x50, 0xde, 0xc3, 0x88, 0xc5, 0xa1, 0x38, 0xe2,
+	0x1f, 0x42, 0x71, 0x1a, 0x70, 0xef, 0xd3, 0x17, 0x37, 0xd9, 0xd9, 0x87, 0xd5, 0x21, 0x2d, 0x6f,
+	0x59, 0x43, 0x5e, 0x94, 0x33, 0xd6, 0xc8, 0x5c, 0x94, 0x33, 0xd6, 0xa8, 0x06, 0xe4, 0x1e, 0xcc,
+	0x8a, 0xcf, 0xf5, 0x53, 0x2f, 0x3e, 0xb5, 0x72, 0xc4, 0xe7, 0x37, 0x5e, 0x7c, 0xea, 0xfc, 0x29,
+	0x0f, 0xe4, 0x00, 0xc5, 0x8e, 0xd7, 0xf6, 0xa8, 0x8f, 0x49, 0xfd, 0x7b, 0x02, 0xf3, 0x4d, 0x8e,
+	0x58, 0x6f, 0x68, 0xba, 0x11, 0x56, 0x92, 0x34, 0xc3, 0x2a, 0xcf, 0x50, 0x1e, 0x16, 0x06, 0x09,
+	0x93, 0x96, 0xbc, 0xa0, 0xa9, 0x96, 0xed, 0x25, 0x10, 0x8e, 0x3e, 0x86, 0x17, 0x21, 0x6d, 0xd5,
+	0x7d, 0x2f, 0xf2, 0xfc, 0x50, 0xf4, 0x4c, 0x88, 0x57, 0x92, 0x9d, 0x5d, 0xb3, 0xe1, 0x44, 0x70,
+	0x5f, 0x56, 0x64, 0x44, 0xbe, 0x9f, 0xea, 0xba, 0x79, 0xf8, 0x9e, 0xc0, 0xbc, 0x3e, 0xd9, 0xbe,
+	0xa2, 0xa9, 0x4e, 0xdb, 0xd6, 0xcc, 0x1f, 0xa0, 0x94, 0x51, 0x75, 0x15, 0xcf, 0x1f, 0x43, 0xe9,
+	0x8c, 0x85, 0xb4, 0x6e, 0xca, 0x85, 0x6e, 0x2c, 0x20, 0x49, 0x35, 0x45, 0x71, 0xfe, 0x9a, 0x87,
+	0xe2, 0x2e, 0xa3, 0x41, 0x28, 0xb3, 0x98, 0xbc, 0x80, 0x15, 0x46, 0xeb, 0xfe, 0xa9, 0x17, 0xd2,
+	0x7a, 0x80, 0x51, 0x9b, 0xf5, 0x30, 0x30, 0xed, 0x6a, 0x89, 0xd1, 0x5d, 0x49, 0xdf, 0x33, 0x64,
+	0xf2, 0x1c, 0x96, 0x7d, 0x46, 0x05, 0xf7, 0x7c, 0x31, 0x60, 0xf3, 0x92, 0xa5, 0x1b, 0xbb, 0xa5,
+	0xd8, 0x30, 0xae, 0x37, 0x43, 0xea, 0xb5, 0xc3, 0x3f, 0x60, 0x50, 0xf7, 0x78, 0x4b, 0x17, 0xe5,
+	0x79, 0x77, 0x29, 0x8c, 0xf7, 0x2d, 0x7d, 0x9b, 0xb7, 0x62, 0xb2, 0x0e, 0xcb, 0x2d, 0x14, 0x75,
+	0xd6, 0x15, 0x3e, 0xeb, 0xa0, 0x66, 0x2d, 0x28, 0xd6, 0xc5, 0x16, 0x8a, 0x23, 0x4d, 0x96, 0x9c,
+	0xce, 0xbf, 0x6f, 0xc1, 0xa3, 0x1a, 0xd2, 0x20, 0x31, 0xdf, 0x6b, 0x9b, 0xdb, 0xf7, 0xe5, 0xaf,
+	0xdd, 0x1a, 0x94, 0x02, 0x8c, 0x45, 0x48, 0x75, 0x09, 0xd0, 0x39, 0x91, 0x25, 0x91, 0x43, 0xb8,
+	0x2d, 0xb8, 0x47, 0xe3, 0x26, 0xf2, 0x7a, 0x9b, 0xb5, 0x42, 0x5f, 0xf7, 0xcb, 0x82, 0xea, 0x97,
+	0x0f, 0x93, 0x7e, 0x69, 0x58, 0xf6, 0xbb, 0xd4, 0x97, 0x9f, 0xa9, 0xd6, 0xb9, 0x62, 0x3f, 0x3c,
+	0x94, 0xdf, 0x49, 0x12, 0xf9, 0x0e, 0xc0, 0xb7, 0x6e, 0xc9, 0x56, 0xd4, 0x57, 0xa8, 0x12, 0x87,
+	0xdd, 0x0c, 0x13, 0x29, 0xc3, 0xac, 0x08, 0x3b, 0xc8, 0xba, 0xba, 0x45, 0x15, 0x5c, 0xbb, 0x24,
+	0xeb, 0x50, 0xa0, 0x4c, 0xa0, 0xea, 0x08, 0xa5, 0xad, 0xd5, 0x0d, 0x3d, 0xde, 0x6c, 0xd8, 0xf1,
+	0x66, 0x63, 0x9b, 0xf6, 0x5c, 0xc5, 0x21, 0x5b, 0x4d, 0x13, 0x51, 0x75, 0x87, 0xa2, 0x2b, 0x7f,
+	0xca, 0x72, 0x62, 0x82, 0xaa, 0xcb, 0x49, 0xa4, 0x17, 0x99, 0x72, 0x62, 0x28, 0xd5, 0xc0, 0xf9,
+	0x4f, 0x1e, 0x4a, 0x96, 0x59, 0x06, 0x73, 0x32, 0xbb, 0xea, 0x62, 0x48, 0x03, 0xe4, 0x36, 0xd6,
+	0x7a, 0x45, 0x2a, 0x30, 0xa7, 0x2f, 0x1b, 0x72, 0x13, 0xe8, 0x64, 0x3d, 0x70, 0xa2, 0x85, 0x6b,
+	0x9d, 0xe8, 0x74, 0xdf, 0x89, 0xca, 0xeb, 0x66, 0x8c, 0x3b, 0x8b, 0x19, 0x2d, 0xcf, 0x98, 0xeb,
+	0xa6, 0x69, 0x6f, 0x63, 0x96, 0x1d, 0x20, 0x66, 0xb3, 0x6d, 0x56, 0xd6, 0xfa, 0xdb, 0x47, 0x5d,
+	0xd1, 0x62, 0x21, 0x6d, 0x65, 0xfd, 0x7d, 0x09, 0xb3, 0xe6, 0x73, 0x93, 0x6b, 0xb7, 0x33, 0xbd,
+	0xce, 0x72, 0xb9, 0x96, 0x47, 0x5a, 0x80, 0x9c, 0x33, 0x5e, 0xe7, 0xe8, 0xc5, 0xa6, 0xaf, 0x14,
+	0xdd, 0x92, 0xa2, 0xb9, 0x8a, 0x24, 0x23, 0xa8, 0x59, 0x7c, 0x16, 0xa0, 0x0a, 0xc6, 0x94, 0x5b,
+	0x54, 0x94, 0x5d, 0x16, 0xa0, 0xf3, 0x01, 0xc8, 0x91, 0xbe, 0x95, 0x59, 0x33, 0x52, 0x8f, 0xf3,
+	0x7d, 0x1e, 0x3f, 0x87, 0x65, 0x8e, 0x31, 0x6b, 0x5f, 0x60, 0x3d, 0x40, 0x2f, 0x68, 0x87, 0x54,
+	0xd7, 0xc1, 0x82, 0xbb, 0x64, 0xe8, 0x7b, 0x86, 0x2c, 0x4f, 0xbd, 0x86, 0x71, 0x1c, 0x32, 0xaa,
+	0x4f, 0x3d, 0xd6, 0x8b, 0xcc, 0x31, 0x1a, 0x4a, 0x35, 0x70, 0xfe, 0x9e, 0x87, 0xf5, 0x5d, 0x35,
+	0x64, 0x6c, 0x47, 0x91, 0xf9, 0xea, 0x88, 0xfe, 0x18, 0x72, 0xd1, 0xf5, 0xda, 0xbb, 0xa6, 0x12,
+	0xd8, 0x1b, 0xf9, 0x44, 0x0e, 0x78, 0xa6, 0x68, 0x34, 0x42, 0x6a, 0x4b, 0x96, 0xa5, 0xed, 0x84,
+	0x94, 0x7c, 0x07, 0xab, 0x09, 0x8b, 0xcf, 0x68, 0x2c, 0x78, 0xd7, 0x17, 0xcc, 0x26, 0xc9, 0x6d,
+	0xbb, 0xb7, 0x9b, 0x6e, 0xc9, 0xb9, 0x89, 0x32, 0xea, 0xeb, 0x08, 0x15, 0x5c, 0xbd, 0x90, 0x95,
+	0x24, 0x29, 0x66, 0xf6, 0x66, 0x14, 0x14, 0xc3, 0xa2, 0xa9, 0x65, 0x27, 0x9a, 0xea, 0xfc, 0x2d,
+	0x0f, 0xcf, 0x87, 0x5d, 0xb0, 0x95, 0x6e, 0xd0, 0x87, 0x51, 0x85, 0x2f, 0x3f, 0xba, 0xf0, 0x25,
+	0x86, 0xdd, 0xba, 0xcc, 0xb0, 0xa9, 0x51, 0x86, 0xc9, 0x79, 0x58, 0x0e, 0xbd, 0xa1, 0x1f, 0x46,
+	0x1e, 0x55, 0xe3, 0xea, 0x94, 0x9c, 0x87, 0xb3, 0x34, 0xe7, 0x35, 0x94, 0xf6, 0xc2, 0x38, 0xea,
+	0x0a, 0xb4, 0x97, 0x6e, 0xc2, 0x69, 0xc9, 0xee, 0x1a, 0xe3, 0xc7, 0x3a, 0xed, 0x76, 0x8c, 0x4d,
+	0x33, 0x31, 0x7e, 0x7c, 0xdf, 0xed, 0x38, 0x47, 0x50, 0xae, 0x85, 0x2d, 0x6a, 0xf3, 0x5a, 0xb6,
+	0x07, 0xcc, 0x3c, 0x5f, 0x26, 0xc9, 0x5c, 0x85, 0x69, 0x79, 0x33, 0xb4, 0x97, 0xf3, 0xae, 0x5e,
+	0x38, 0x9b, 0x50, 0x92, 0x02, 0x31, 0x50, 0xa2, 0xe4, 0xc9, 0xc7, 0x6a, 0x59, 0xd7, 0xbc, 0x79,
+	0xc5, 0x5b, 0x8a, 0x53, 0x16, 0xa7, 0x02, 0x85, 0x3d, 0x4f, 0x78, 0x7d, 0x23, 0xdd, 0xbc, 0x19,
+	0xe9, 0x9e, 0x43, 0x51, 0x4a, 0xf3, 0x44, 0x97, 0x23, 0x79, 0x08, 0xc5, 0xd8, 0x2e, 0x0c, 0x57,
+	0x4a, 0x70, 0x8e, 0x80, 0xfc, 0xe8, 0xb5, 0x43, 0x39, 0xd9, 0x6e, 0xfb, 0xe7, 0x57, 0xf4, 0xa1,
+	0x02, 0x73, 0x48, 0x2f, 0xb0, 0xcd, 0x22, 0xeb, 0x46, 0xb2, 0x76, 0x9e, 0x40, 0x71, 0x87, 0xb1,
+	0xf6, 0x8f, 0x5e, 0xbb, 0x8b, 0xd2, 0xd9, 0x0b, 0xf9, 0xc3, 0xb4, 0x45, 0xbd, 0x70, 0x7e, 0x82,
+	0x07, 0xc7, 0x9c, 0xf9, 0x18, 0xc7, 0xae, 0x2e, 0x55, 0xc1, 0x75, 0x02, 0x38, 0x49, 0x79, 0x13,
+	0x1e, 0x8e, 0x96, 0x6c, 0xc6, 0x9f, 0xa7, 0xb0, 0x10, 0xa0, 0x2c, 0x0f, 0xfd, 0x81, 0x9d, 0x37,
+	0xc4, 0x24, 0xf8, 0x11, 0xc7, 0xc8, 0xe3, 0xb2, 0xf9, 0xfa, 0xe7, 0x46, 0x49, 0xc9, 0xd2, 0xb6,
+	0xfd, 0x73, 0xe7, 0x67, 0xb8, 0x57, 0x43, 0x21, 0xda, 0x99, 0x2b, 0x70, 0x45, 0xeb, 0x1f, 0x43,
+	0x49, 0x69, 0xae, 0x47, 0x9c, 0xb1, 0xa6, 0x91, 0x0d, 0x8a, 0x74, 0x2c, 0x29, 0x4e, 0x00, 0x6b,
+	0x83, 0xa2, 0x77, 0x7a, 0x26, 0xc5, 0xaf, 0xa8, 0xe3, 0x09, 0xcc, 0x33, 0xee, 0xf9, 0xed, 0x7e,
+	0x25, 0x25, 0x4d, 0xd3, 0x5a, 0xfe, 0x9c, 0x87, 0xa7, 0xc3, 0x6a, 0xaa, 0xf4, 0x42, 0xe6, 0x42,
+	0x28, 0x7a, 0x5f, 0x4c, 0x13, 0xd9, 0x94, 0x15, 0x2a, 0x9b, 0xcc, 0x86, 0x55, 0x4f, 0x34, 0xc4,
+	0xee, 0xd5, 0xd2, 0x08, 0x3c, 0x85, 0x59, 0x5b, 0x12, 0xca, 0x30, 0xdb, 0x5f, 0x34, 0xec, 0xd2,
+	0xf9, 0x1d, 0x7c, 0x23, 0xc7, 0x5b, 0xc6, 0xda, 0xe8, 0x51, 0x33, 0xe8, 0xec, 0x33, 0x7e, 0xed,
+	0xf3, 0x58, 0x85, 0xe9, 0x8f, 0x5d, 0xe4, 0x3d, 0x7b, 0x1d, 0xd5, 0xc2, 0x79, 0x03, 0x8b, 0xfd,
+	0xa2, 0xe5, 0x2d, 0x4a, 0x46, 0xb2, 0x04, 0x93, 0xb0, 0x04, 0x69, 0xa7, 0x99, 0xc1, 0x0c, 0x46,
+	0x60, 0x97, 0xce, 0x4f, 0xf0, 0x78, 0x3b, 0x8a, 0xda, 0xbd, 0x6d, 0x35, 0xc1, 0xdc, 0xc4, 0x42,
+	0xd9, 0xa1, 0xfc, 0xe4, 0x2d, 0x35, 0xef, 0x9a, 0x95, 0x2c, 0x19, 0x3b, 0x72, 0x24, 0x7f, 0xdf,
+	0xed, 0x34, 0x90, 0xcb, 0xa3, 0xd0, 0x4f, 0x2f, 0xaa, 0xd6, 0x4a, 0x4e, 0xc1, 0x2d, 0x35, 0x52,
+	0x16, 0xe7, 0x05, 0x2c, 0xa7, 0xda, 0xf5, 0x48, 0x9b, 0x69, 0xdb, 0xf9, 0xbe, 0xb6, 0x7d, 0x04,
+	0x0f, 0x0f, 0x50, 0xa8, 0x53, 0xb9, 0x89, 0xd1, 0xcb, 0x30, 0x75, 0x8e, 0x3d, 0xf3, 0xfa, 0x93,
+	0x3f, 0x9d, 0x67, 0xb0, 0xd4, 0xaf, 0x1c, 0xd3, 0x52, 0x98, 0xcf, 0x96, 0xc2, 0x6f, 0xfb, 0xac,
+	0x54, 0xf5, 0x36, 0x5b, 0x88, 0xf3, 0x7d, 0x85, 0xf8, 0x2f, 0xb7, 0x60, 0x31, 0xe5, 0xbe, 0x4a,
+	0x4d, 0xdf, 0x84, 0x55, 0x96, 0xce, 0xeb, 0x75, 0xdb, 0x84, 0xcc, 0xb9, 0x11, 0x36, 0xd4, 0xc9,
+	0x64, 0x0b, 0x4b, 0xd8, 0x6d, 0x36, 0xea, 0x51, 0x6b, 0xc9, 0xd2, 0x87, 0x5a, 0x58, 0xe1, 0xb2,
+	0x16, 0x36, 0x7d, 0xa5, 0x16, 0x36, 0x33, 0xdc, 0xc2, 0xb2, 0xb1, 0x98, 0xcd, 0xc6, 0x62, 0xb0,
+	0xb4, 0xcc, 0x0d, 0x95, 0x96, 0xbd, 0x6c, 0xac, 0x0e, 0x43, 0xf5, 0xc6, 0x9f, 0x33, 0x91, 0x19,
+	0x82, 0x2e, 0xfa, 0xa3, 0xea, 0x26, 0x7c, 0x4e, 0x15, 0x16, 0x6a, 0x28, 0xde, 0xc5, 0xad, 0x3d,
+	0xce, 0x22, 0x17, 0x3f, 0x4a, 0xac, 0x24, 0xe0, 0x2c, 0xaa, 0x73, 0xf4, 0x2f, 0xcc, 0xd5, 0x98,
+	0x0b, 0xd4, 0x9e, 0x7f, 0x91, 0x6c, 0xca, 0x71, 0xb5, 0x7c, 0x2b, 0xdd, 0x94, 0x6f, 0x11, 0xe7,
+	0x19, 0x2c, 0x98, 0x59, 0x6c, 0x72, 0x36, 0x6e, 0xfd, 0xeb, 0x29, 0xcc, 0x7c, 0xc0, 0xc6, 0x76,
+	0x14, 0x92, 0xf7, 0xb0, 0xd0, 0x07, 0xed, 0x91, 0x87, 0xd6, 0xe2, 0x51, 0x60, 0x62, 0xe5, 0xd1,
+	0x98, 0x5d, 0xdd, 0x10, 0x9c, 0x1c, 0xa9, 0xaa, 0x77, 0xf2, 0x20, 0x9e, 0x33, 0x16, 0x78, 0xa9,
+	0xdc, 0xb3, 0x3b, 0x03, 0x9f, 0x38, 0x39, 0xf2, 0x03, 0x2c, 0x0f, 0x02, 0x3b, 0xe4, 0xb1, 0x65,
+	0x1f, 0x03, 0xf9, 0x54, 0x2a, 0xfd, 0x0c, 0x59, 0xb8, 0xc6, 0xc9, 0x91, 0x1d, 0x58, 0xac, 0xf5,
+	0xa8, 0x9f, 0x11, 0x78, 0x77, 0xe8, 0x45, 0xf2, 0x5a, 0x02, 0xae, 0x95, 0x31, 0x74, 0x27, 0x47,
+	0x0e, 0x60, 0xa1, 0x0f, 0xe0, 0x49, 0x23, 0x36, 0x0a, 0xf7, 0x99, 0x20, 0xe8, 0x10, 0xc8, 0x30,
+	0x6a, 0x43, 0x9e, 0x58, 0x69, 0x63, 0x11, 0x9d, 0x4a, 0xfa, 0x18, 0xb3, 0x60, 0x8b, 0x93, 0x23,
+	0xbf, 0x81, 0x59, 0x83, 0x74, 0x90, 0x35, 0xbb, 0x3f, 0x0e, 0x60, 0xa9, 0x3c, 0x1c, 0xcb, 0xf1,
+	0x96, 0x35, 0x54, 0xe8, 0x57, 0xde, 0x31, 0x1a, 0x0a, 0xc6, 0x0d, 0x83, 0xc4, 0x4c, 0x26, 0x7e,
+	0x74, 0xa9, 0xc8, 0x0f, 0x70, 0x7b, 0x97, 0xb1, 0x08, 0x25, 0x66, 0x75, 0x81, 0x76, 0xef, 0x0b,
+	0xd8, 0xfa, 0x7b, 0x78, 0x64, 0x6c, 0x1d, 0x21, 0xff, 0x97, 0xdb, 0xfd, 0x3d, 0x40, 0x0a, 0xfc,
+	0x90, 0xe1, 0xf7, 0x5e, 0x9a, 0x71, 0xc3, 0xf8, 0x90, 0x93, 0x23, 0xef, 0x81, 0x0c, 0x03, 0x35,
+	0xe9, 0x21, 0x8f, 0x05, 0x71, 0x2a, 0xc9, 0xfb, 0x2d, 0xb3, 0xe7, 0xe4, 0xc8, 0xff, 0x41, 0x51,
+	0x5f, 0xbd, 0x7d, 0x44, 0xa2, 0x01, 0xf7, 0x64, 0x9d, 0xa6, 0xdc, 0x00, 0x39, 0xb1, 0xe6, 0x04,
+	0xee, 0x8e, 0x46, 0x2d, 0xc8, 0x37, 0x69, 0x12, 0x4f, 0x40, 0x35, 0xd2, 0xd4, 0x4b, 0x1e, 0xe6,
+	0x2a, 0x91, 0xef, 0xd7, 0xba, 0x8d, 0xd8, 0xe7, 0x61, 0x03, 0xab, 0xd4, 0x67, 0x9d, 0xf4, 0x6d,
+	0x1a, 0x8f, 0xbd, 0x60, 0xa3, 0xde, 0xa7, 0x4e, 0x6e, 0x33, 0x4f, 0x4e, 0x32, 0xd2, 0x06, 0x5e,
+	0xba, 0xe3, 0xa5, 0x3d, 0x48, 0x6e, 0xcd, 0xf0, 0xdb, 0x58, 0x49, 0xdd, 0x87, 0xf2, 0x01, 0x8a,
+	0x01, 0xeb, 0x4c, 0x99, 0x1c, 0x76, 0xaa, 0x72, 0x67, 0x80, 0xa4, 0x39, 0x9d, 0x9c, 0x91, 0x33,
+	0xa0, 0xe5, 0x06, 0x72, 0x5e, 0xc3, 0xdd, 0x5d, 0x46, 0x9b, 0x21, 0xef, 0x0c, 0xc8, 0x1a, 0x25,
+	0x65, 0x7c, 0x0d, 0xd9, 0x83, 0x3b, 0x2e, 0x9e, 0xa1, 0x3f, 0xe8, 0xd9, 0xf5, 0xa4, 0xd4, 0xe0,
+	0x6b, 0x3d, 0xbd, 0x9a, 0x17, 0xbd, 0xab, 0x9f, 0xe4, 0xc1, 0x2f, 0x12, 0x7a, 0x08, 0x8f, 0x8c,
+	0x9c, 0x01, 0x31, 0x46, 0xc9, 0xf5, 0xa4, 0xbd, 0x51, 0x7f, 0x41, 0x8d, 0x40, 0x1c, 0x46, 0x48,
+	0x49, 0x6e, 0xe4, 0x30, 0xbb, 0x93, 0x23, 0xef, 0xe0, 0x2b, 0x1b, 0xf9, 0x7e, 0x6f, 0x93, 0x24,
+	0x1b, 0x71, 0xc9, 0x27, 0x9e, 0x80, 0x8e, 0xdd, 0xeb, 0xcf, 0x51, 0xc8, 0x6f, 0x2a, 0xe5, 0x10,
+	0x16, 0xab, 0x54, 0x20, 0x0d, 0xae, 0x51, 0x18, 0xc7, 0x4b, 0xfb, 0x7f, 0x58, 0x32, 0x2e, 0x26,
+	0xe2, 0xae, 0x65, 0x4d, 0x15, 0x2a, 0xda, 0x1a, 0xed, 0xd9, 0x40, 0x87, 0xba, 0x96, 0xa8, 0xb7,
+	0xf0, 0xc0, 0x98, 0xf2, 0xcb, 0x65, 0xd5, 0xe0, 0x99, 0x1c, 0xa2, 0x95, 0x9c, 0x04, 0xb8, 0x95,
+	0xb3, 0xde, 0x3e, 0xe3, 0x97, 0xcb, 0x4d, 0x0a, 0x4e, 0x66, 0xcc, 0x57, 0x05, 0xfa, 0xb1, 0x1c,
+	0x09, 0xfa, 0x53, 0xc5, 0x88, 0x19, 0xbc, 0xd7, 0x57, 0x31, 0xf2, 0x35, 0xac, 0x48, 0x79, 0xf2,
+	0x53, 0xd5, 0x85, 0x64, 0x79, 0xbf, 0xc1, 0x94, 0x71, 0x06, 0x4f, 0x2e, 0x05, 0xb6, 0xc8, 0x66,
+	0x32, 0x08, 0x5c, 0x11, 0x03, 0x4b, 0xeb, 0x77, 0x02, 0xb1, 0x39, 0x39, 0xd2, 0x06, 0xe7, 0x72,
+	0x04, 0x8a, 0x7c, 0x37, 0x5e, 0xd9, 0x18, 0xb4, 0x6a, 0xb4, 0xb6, 0x37, 0x50, 0x49, 0xea, 0x7b,
+	0x2a, 0xc4, 0xc0, 0x48, 0x64, 0xf8, 0x93, 0xf4, 0xe0, 0x32, 0x50, 0x93, 0xaa, 0xe9, 0x87, 0xb0,
+	0x32, 0x04, 0x1b, 0xa5, 0xf7, 0x66, 0x1c, 0xa2, 0x54, 0xb9, 0x9d, 0xe5, 0xb0, 0xf8, 0x8f, 0xbc,
+	0x34, 0xa5, 0x0c, 0x74, 0x43, 0x92, 0x22, 0x32, 0x8c, 0xe7, 0xa4, 0x7e, 0x25, 0xd0, 0x8c, 0x93,
+	0x23, 0x2f, 0x61, 0x4e, 0x0a, 0x54, 0x28, 0xd2, 0x7c, 0x62, 0xb2, 0x27, 0xbc, 0x4c, 0x18, 0x12,
+	0x9c, 0x28, 0x47, 0x7c, 0x58, 0x1d, 0x85, 0xad, 0x90, 0xa7, 0x49, 0x3d, 0x1b, 0x8f, 0xe9, 0x54,
+	0xbe, 0x9e, 0xcc, 0x94, 0xf4, 0xfb, 0x77, 0xb0, 0x3c, 0x08, 0x4b, 0xa4, 0x23, 0xf4, 0x18, 0xc8,
+	0x65, 0x42, 0x52, 0x36, 0xe0, 0xe1, 0x30, 0xca, 0x51, 0x0b, 0x5b, 0xf6, 0xbd, 0xb5, 0x3e, 0x4e,
+	0xf4, 0x20, 0xe4, 0x32, 0x41, 0x87, 0x0f, 0x8f, 0x86, 0xbf, 0x7e, 0xc7, 0x2e, 0xf0, 0x4b, 0x2a,
+	0x69, 0xc2, 0xa3, 0xb1, 0x70, 0xcd, 0x49, 0x97, 0x53, 0xf2, 0xed, 0x78, 0x25, 0x43, 0xa8, 0xce,
+	0x04, 0x3d, 0x2d, 0xf8, 0x6a, 0xac, 0x00, 0x7d, 0xdc, 0x5f, 0x48, 0xd1, 0xaf, 0x60, 0x59, 0x3e,
+	0x3d, 0xb2, 0xd7, 0x72, 0xd4, 0x55, 0x9a, 0xf4, 0xaa, 0x79, 0x7c, 0x80, 0xc2, 0x5e, 0x64, 0xf3,
+	0x00, 0xef, 0x83, 0x2a, 0x46, 0xc9, 0x5b, 0xb2, 0x24, 0xf3, 0x81, 0x93, 0x23, 0x21, 0x7c, 0x35,
+	0x19, 0x49, 0x22, 0x2f, 0xb3, 0x03, 0xf3, 0xa5, 0x88, 0x53, 0xe5, 0x6e, 0xf6, 0xb2, 0xa5, 0xbc,
+	0x6a, 0xf2, 0x2f, 0x8f, 0x03, 0x83, 0xc8, 0xb3, 0xcc, 0xc3, 0x7b, 0x12, 0x5c, 0x34, 0x79, 0x2a,
+	0xb2, 0x5d, 0xe6, 0x88, 0x6a, 0x09, 0x26, 0xc1, 0x2e, 0x8d, 0xcb, 0x78, 0xa1, 0x47, 0xf0, 0x74,
+	0x6c, 0x0f, 0x9b, 0x2c, 0x73, 0x4c, 0xff, 0x7a, 0xab, 0xe2, 0xad, 0xcd, 0xb3, 0xff, 0xa1, 0xdc,
+	0x54, 0xd6, 0x01, 0xdc, 0x35, 0x28, 0x55, 0xf7, 0xf2, 0xb3, 0x2f, 0x0f, 0x43, 0x1b, 0xc9, 0x74,
+	0xfb, 0x93, 0x9a, 0xd6, 0x86, 0xe1, 0x2e, 0xf2, 0x75, 0xe6, 0xec, 0xc7, 0xa2, 0x61, 0x29, 0x28,
+	0xd0, 0x2f, 0x1a, 0x53, 0x13, 0x15, 0x44, 0x73, 0x23, 0x13, 0xd5, 0x97, 0x4e, 0x8e, 0xec, 0xc2,
+	0x92, 0xc4, 0x6c, 0xd2, 0x9d, 0x78, 0x42, 0x97, 0x1e, 0x12, 0x23, 0x3f, 0x55, 0xd7, 0x6e, 0x51,
+	0x26, 0x71, 0x1a, 0xc4, 0xcb, 0x9f, 0x3b, 0xfd, 0x11, 0xff, 0x75, 0x16, 0xfd, 0x89, 0x90, 0x93,
+	0x3b, 0xa9, 0x17, 0x19, 0x50, 0x68, 0x7c, 0x42, 0xed, 0xbc, 0xfc, 0xed, 0xb7, 0xad, 0x50, 0x9c,
+	0x76, 0x1b, 0x1b, 0x3e, 0xeb, 0xbc, 0xf2, 0xb1, 0x8d, 0xfc, 0x25, 0x45, 0xf1, 0x89, 0xf1, 0xf3,
+	0x57, 0x2d, 0xb6, 0x2b, 0xd7, 0xaf, 0x3e, 0x61, 0xc3, 0x8b, 0xc2, 0x57, 0x3c, 0xf2, 0x1b, 0x33,
+	0x4a, 0xc0, 0xff, 0xfe, 0x77, 0x00, 0x67, 0x37, 0xdf, 0x49, 0x9a, 0x26, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2333,6 +2825,9 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type WebApiClient interface {
 	GetPayHistory(ctx context.Context, in *GetPayHistoryRequest, opts ...grpc.CallOption) (*GetPayHistoryResponse, error)
+	GetLocalPayHistory(ctx context.Context, in *PayHistoryFilter, opts ...grpc.CallOption) (*LocalPayHistory, error)
+	ExportPayHistory(ctx context.Context, in *ExportPayHistoryRequest, opts ...grpc.CallOption) (*ExportedPayHistory, error)
+	SyncPayHistory(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
 	SetDelegation(ctx context.Context, in *SetDelegationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	OpenPaymentChannel(ctx context.Context, in *OpenPaymentChannelRequest, opts ...grpc.CallOption) (*ChannelID, error)
 	Deposit(ctx context.Context, in *DepositOrWithdrawRequest, opts ...grpc.CallOption) (*DepositOrWithdrawJob, error)
@@ -2341,6 +2836,7 @@ type WebApiClient interface {
 	MonitorCooperativeWithdrawJob(ctx context.Context, in *DepositOrWithdrawJob, opts ...grpc.CallOption) (*DepositOrWithdrawJob, error)
 	GetBalance(ctx context.Context, in *TokenInfo, opts ...grpc.CallOption) (*GetBalanceResponse, error)
 	GetPeerFreeBalance(ctx context.Context, in *GetPeerFreeBalanceRequest, opts ...grpc.CallOption) (*FreeBalance, error)
+	GetPayFee(ctx context.Context, in *rpc.GetPayFeeRequest, opts ...grpc.CallOption) (*rpc.GetPayFeeResponse, error)
 	SendConditionalPayment(ctx context.Context, in *SendConditionalPaymentRequest, opts ...grpc.CallOption) (*PaymentID, error)
 	SubscribeIncomingPayments(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (WebApi_SubscribeIncomingPaymentsClient, error)
 	// TODO(mzhou): Refine the outgoing payment API.
@@ -2384,6 +2880,7 @@ type WebApiClient interface {
 	GetStatusForAppSession(ctx context.Context, in *SessionID, opts ...grpc.CallOption) (*AppSessionStatus, error)
 	GetStateForAppSession(ctx context.Context, in *GetStateForAppSessionRequest, opts ...grpc.CallOption) (*AppSessionState, error)
 	GetSeqNumForAppSession(ctx context.Context, in *SessionID, opts ...grpc.CallOption) (*AppSessionSeqNum, error)
+	ListAppSessions(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*AppSessionList, error)
 	GetBlockNumber(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BlockNumber, error)
 	SetMsgDropper(ctx context.Context, in *SetMsgDropReq, opts ...grpc.CallOption) (*empty.Empty, error)
 }
@@ -2405,6 +2902,33 @@ func (c *webApiClient) GetPayHistory(ctx context.Context, in *GetPayHistoryReque
 	return out, nil
 }
 
+func (c *webApiClient) GetLocalPayHistory(ctx context.Context, in *PayHistoryFilter, opts ...grpc.CallOption) (*LocalPayHistory, error) {
+	out := new(LocalPayHistory)
+	err := c.cc.Invoke(ctx, "/webrpc.WebApi/GetLocalPayHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webApiClient) ExportPayHistory(ctx context.Context, in *ExportPayHistoryRequest, opts ...grpc.CallOption) (*ExportedPayHistory, error) {
+	out := new(ExportedPayHistory)
+	err := c.cc.Invoke(ctx, "/webrpc.WebApi/ExportPayHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webApiClient) SyncPayHistory(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/webrpc.WebApi/SyncPayHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *webApiClient) SetDelegation(ctx context.Context, in *SetDelegationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/webrpc.WebApi/SetDelegation", in, out, opts...)
@@ -2477,6 +3001,15 @@ func (c *webApiClient) GetPeerFreeBalance(ctx context.Context, in *GetPeerFreeBa
 	return out, nil
 }
 
+func (c *webApiClient) GetPayFee(ctx context.Context, in *rpc.GetPayFeeRequest, opts ...grpc.CallOption) (*rpc.GetPayFeeResponse, error) {
+	out := new(rpc.GetPayFeeResponse)
+	err := c.cc.Invoke(ctx, "/webrpc.WebApi/GetPayFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *webApiClient) SendConditionalPayment(ctx context.Context, in *SendConditionalPaymentRequest, opts ...grpc.CallOption) (*PaymentID, error) {
 	out := new(PaymentID)
 	err := c.cc.Invoke(ctx, "/webrpc.WebApi/SendConditionalPayment", in, out, opts...)
@@ -2915,6 +3448,15 @@ func (c *webApiClient) GetSeqNumForAppSession(ctx context.Context, in *SessionID
 	return out, nil
 }
 
+func (c *webApiClient) ListAppSessions(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*AppSessionList, error) {
+	out := new(AppSessionList)
+	err := c.cc.Invoke(ctx, "/webrpc.WebApi/ListAppSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *webApiClient) GetBlockNumber(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BlockNumber, error) {
 	out := new(BlockNumber)
 	err := c.cc.Invoke(ctx, "/webrpc.WebApi/GetBlockNumber", in, out, opts...)
@@ -2936,6 +3478,9 @@ func (c *webApiClient) SetMsgDropper(ctx context.Context, in *SetMsgDropReq, opt
 // WebApiServer is the server API for WebApi service.
 type WebApiServer interface {
 	GetPayHistory(context.Context, *GetPayHistoryRequest) (*GetPayHistoryResponse, error)
+	GetLocalPayHistory(context.Context, *PayHistoryFilter) (*LocalPayHistory, error)
+	ExportPayHistory(context.Context, *ExportPayHistoryRequest) (*ExportedPayHistory, error)
+	SyncPayHistory(context.Context, *empty.Empty) (*empty.Empty, error)
 	SetDelegation(context.Context, *SetDelegationRequest) (*empty.Empty, error)
 	OpenPaymentChannel(context.Context, *OpenPaymentChannelRequest) (*ChannelID, error)
 	Deposit(context.Context, *DepositOrWithdrawRequest) (*DepositOrWithdrawJob, error)
@@ -2944,6 +3489,7 @@ type WebApiServer interface {
 	MonitorCooperativeWithdrawJob(context.Context, *DepositOrWithdrawJob) (*DepositOrWithdrawJob, error)
 	GetBalance(context.Context, *TokenInfo) (*GetBalanceResponse, error)
 	GetPeerFreeBalance(context.Context, *GetPeerFreeBalanceRequest) (*FreeBalance, error)
+	GetPayFee(context.Context, *rpc.GetPayFeeRequest) (*rpc.GetPayFeeResponse, error)
 	SendConditionalPayment(context.Context, *SendConditionalPaymentRequest) (*PaymentID, error)
 	SubscribeIncomingPayments(*empty.Empty, WebApi_SubscribeIncomingPaymentsServer) error
 	// TODO(mzhou): Refine the outgoing payment API.
@@ -2987,10 +3533,187 @@ type WebApiServer interface {
 	GetStatusForAppSession(context.Context, *SessionID) (*AppSessionStatus, error)
 	GetStateForAppSession(context.Context, *GetStateForAppSessionRequest) (*AppSessionState, error)
 	GetSeqNumForAppSession(context.Context, *SessionID) (*AppSessionSeqNum, error)
+	ListAppSessions(context.Context, *empty.Empty) (*AppSessionList, error)
 	GetBlockNumber(context.Context, *empty.Empty) (*BlockNumber, error)
 	SetMsgDropper(context.Context, *SetMsgDropReq) (*empty.Empty, error)
 }
 
+// UnimplementedWebApiServer can be embedded to have forward compatible implementations.
+type UnimplementedWebApiServer struct {
+}
+
+func (*UnimplementedWebApiServer) GetPayHistory(ctx context.Context, req *GetPayHistoryRequest) (*GetPayHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayHistory not implemented")
+}
+func (*UnimplementedWebApiServer) GetLocalPayHistory(ctx context.Context, req *PayHistoryFilter) (*LocalPayHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLocalPayHistory not implemented")
+}
+func (*UnimplementedWebApiServer) ExportPayHistory(ctx context.Context, req *ExportPayHistoryRequest) (*ExportedPayHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportPayHistory not implemented")
+}
+func (*UnimplementedWebApiServer) SyncPayHistory(ctx context.Context, req *empty.Empty) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncPayHistory not implemented")
+}
+func (*UnimplementedWebApiServer) SetDelegation(ctx context.Context, req *SetDelegationRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDelegation not implemented")
+}
+func (*UnimplementedWebApiServer) OpenPaymentChannel(ctx context.Context, req *OpenPaymentChannelRequest) (*ChannelID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenPaymentChannel not implemented")
+}
+func (*UnimplementedWebApiServer) Deposit(ctx context.Context, req *DepositOrWithdrawRequest) (*DepositOrWithdrawJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+}
+func (*UnimplementedWebApiServer) MonitorDepositJob(ctx context.Context, req *DepositOrWithdrawJob) (*DepositOrWithdrawJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MonitorDepositJob not implemented")
+}
+func (*UnimplementedWebApiServer) CooperativeWithdraw(ctx context.Context, req *DepositOrWithdrawRequest) (*DepositOrWithdrawJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CooperativeWithdraw not implemented")
+}
+func (*UnimplementedWebApiServer) MonitorCooperativeWithdrawJob(ctx context.Context, req *DepositOrWithdrawJob) (*DepositOrWithdrawJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MonitorCooperativeWithdrawJob not implemented")
+}
+func (*UnimplementedWebApiServer) GetBalance(ctx context.Context, req *TokenInfo) (*GetBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalance not implemented")
+}
+func (*UnimplementedWebApiServer) GetPeerFreeBalance(ctx context.Context, req *GetPeerFreeBalanceRequest) (*FreeBalance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeerFreeBalance not implemented")
+}
+func (*UnimplementedWebApiServer) GetPayFee(ctx context.Context, req *rpc.GetPayFeeRequest) (*rpc.GetPayFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayFee not implemented")
+}
+func (*UnimplementedWebApiServer) SendConditionalPayment(ctx context.Context, req *SendConditionalPaymentRequest) (*PaymentID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendConditionalPayment not implemented")
+}
+func (*UnimplementedWebApiServer) SubscribeIncomingPayments(req *empty.Empty, srv WebApi_SubscribeIncomingPaymentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeIncomingPayments not implemented")
+}
+func (*UnimplementedWebApiServer) SubscribeOutgoingPayments(req *empty.Empty, srv WebApi_SubscribeOutgoingPaymentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeOutgoingPayments not implemented")
+}
+func (*UnimplementedWebApiServer) GetIncomingPaymentStatus(ctx context.Context, req *PaymentID) (*PaymentStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncomingPaymentStatus not implemented")
+}
+func (*UnimplementedWebApiServer) GetOutgoingPaymentStatus(ctx context.Context, req *PaymentID) (*PaymentStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOutgoingPaymentStatus not implemented")
+}
+func (*UnimplementedWebApiServer) ConfirmOutgoingPayment(ctx context.Context, req *PaymentID) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmOutgoingPayment not implemented")
+}
+func (*UnimplementedWebApiServer) RejectIncomingPayment(ctx context.Context, req *PaymentID) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectIncomingPayment not implemented")
+}
+func (*UnimplementedWebApiServer) SettleOnChainResolvedIncomingPayment(ctx context.Context, req *PaymentID) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleOnChainResolvedIncomingPayment not implemented")
+}
+func (*UnimplementedWebApiServer) ResolveIncomingPaymentOnChain(ctx context.Context, req *PaymentID) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveIncomingPaymentOnChain not implemented")
+}
+func (*UnimplementedWebApiServer) GetOnChainPaymentInfo(ctx context.Context, req *PaymentID) (*OnChainPaymentInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOnChainPaymentInfo not implemented")
+}
+func (*UnimplementedWebApiServer) ConfirmOnChainResolvedPayments(ctx context.Context, req *TokenInfo) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmOnChainResolvedPayments not implemented")
+}
+func (*UnimplementedWebApiServer) SettleExpiredPayments(ctx context.Context, req *TokenInfo) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleExpiredPayments not implemented")
+}
+func (*UnimplementedWebApiServer) IntendWithdraw(ctx context.Context, req *DepositOrWithdrawRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntendWithdraw not implemented")
+}
+func (*UnimplementedWebApiServer) ConfirmWithdraw(ctx context.Context, req *TokenInfo) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmWithdraw not implemented")
+}
+func (*UnimplementedWebApiServer) IntendSettlePaymentChannel(ctx context.Context, req *TokenInfo) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntendSettlePaymentChannel not implemented")
+}
+func (*UnimplementedWebApiServer) ConfirmSettlePaymentChannel(ctx context.Context, req *TokenInfo) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmSettlePaymentChannel not implemented")
+}
+func (*UnimplementedWebApiServer) GetSettleFinalizedTimeForPaymentChannel(ctx context.Context, req *TokenInfo) (*BlockNumber, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSettleFinalizedTimeForPaymentChannel not implemented")
+}
+func (*UnimplementedWebApiServer) SyncOnChainPaymentChannelStatus(ctx context.Context, req *TokenInfo) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncOnChainPaymentChannelStatus not implemented")
+}
+func (*UnimplementedWebApiServer) SyncStateWithPeer(ctx context.Context, req *empty.Empty) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncStateWithPeer not implemented")
+}
+func (*UnimplementedWebApiServer) CreateAppSessionOnVirtualContract(ctx context.Context, req *CreateAppSessionOnVirtualContractRequest) (*SessionID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAppSessionOnVirtualContract not implemented")
+}
+func (*UnimplementedWebApiServer) CreateAppSessionOnDeployedContract(ctx context.Context, req *CreateAppSessionOnDeployedContractRequest) (*SessionID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAppSessionOnDeployedContract not implemented")
+}
+func (*UnimplementedWebApiServer) SubscribeAppSessionDispute(req *SessionID, srv WebApi_SubscribeAppSessionDisputeServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeAppSessionDispute not implemented")
+}
+func (*UnimplementedWebApiServer) SignOutgoingState(ctx context.Context, req *SignOutgoingStateRequest) (*SignedState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignOutgoingState not implemented")
+}
+func (*UnimplementedWebApiServer) ValidateAck(ctx context.Context, req *ValidateAckRequest) (*BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateAck not implemented")
+}
+func (*UnimplementedWebApiServer) SignData(ctx context.Context, req *Data) (*Signature, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignData not implemented")
+}
+func (*UnimplementedWebApiServer) ProcessReceivedState(ctx context.Context, req *ProcessReceivedStateRequest) (*ProcessReceivedStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessReceivedState not implemented")
+}
+func (*UnimplementedWebApiServer) SettleAppSession(ctx context.Context, req *SettleAppSessionRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleAppSession not implemented")
+}
+func (*UnimplementedWebApiServer) SettleAppSessionBySigTimeout(ctx context.Context, req *SettleAppSessionByTimeoutRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleAppSessionBySigTimeout not implemented")
+}
+func (*UnimplementedWebApiServer) SettleAppSessionByMoveTimeout(ctx context.Context, req *SettleAppSessionByTimeoutRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleAppSessionByMoveTimeout not implemented")
+}
+func (*UnimplementedWebApiServer) SettleAppSessionByInvalidTurn(ctx context.Context, req *SettleAppSessionByInvalidityRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleAppSessionByInvalidTurn not implemented")
+}
+func (*UnimplementedWebApiServer) SettleAppSessionByInvalidState(ctx context.Context, req *SettleAppSessionByInvalidityRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleAppSessionByInvalidState not implemented")
+}
+func (*UnimplementedWebApiServer) DeleteAppSession(ctx context.Context, req *SessionID) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAppSession not implemented")
+}
+func (*UnimplementedWebApiServer) GetDeployedAddressForAppSession(ctx context.Context, req *SessionID) (*Address, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeployedAddressForAppSession not implemented")
+}
+func (*UnimplementedWebApiServer) GetBooleanOutcomeForAppSession(ctx context.Context, req *GetBooleanOutcomeForAppSessionRequest) (*BooleanOutcome, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBooleanOutcomeForAppSession not implemented")
+}
+func (*UnimplementedWebApiServer) ApplyActionForAppSession(ctx context.Context, req *ApplyActionForAppSessionRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyActionForAppSession not implemented")
+}
+func (*UnimplementedWebApiServer) FinalizeOnActionTimeoutForAppSession(ctx context.Context, req *SessionID) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeOnActionTimeoutForAppSession not implemented")
+}
+func (*UnimplementedWebApiServer) GetSettleFinalizedTimeForAppSession(ctx context.Context, req *SessionID) (*BlockNumber, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSettleFinalizedTimeForAppSession not implemented")
+}
+func (*UnimplementedWebApiServer) GetActionDeadlineForAppSession(ctx context.Context, req *SessionID) (*BlockNumber, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActionDeadlineForAppSession not implemented")
+}
+func (*UnimplementedWebApiServer) GetStatusForAppSession(ctx context.Context, req *SessionID) (*AppSessionStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatusForAppSession not implemented")
+}
+func (*UnimplementedWebApiServer) GetStateForAppSession(ctx context.Context, req *GetStateForAppSessionRequest) (*AppSessionState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStateForAppSession not implemented")
+}
+func (*UnimplementedWebApiServer) GetSeqNumForAppSession(ctx context.Context, req *SessionID) (*AppSessionSeqNum, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeqNumForAppSession not implemented")
+}
+func (*UnimplementedWebApiServer) ListAppSessions(ctx context.Context, req *empty.Empty) (*AppSessionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAppSessions not implemented")
+}
+func (*UnimplementedWebApiServer) GetBlockNumber(ctx context.Context, req *empty.Empty) (*BlockNumber, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockNumber not implemented")
+}
+func (*UnimplementedWebApiServer) SetMsgDropper(ctx context.Context, req *SetMsgDropReq) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMsgDropper not implemented")
+}
+
 func RegisterWebApiServer(s *grpc.Server, srv WebApiServer) {
 	s.RegisterService(&_WebApi_serviceDesc, srv)
 }
@@ -3013,6 +3736,60 @@ func _WebApi_GetPayHistory_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WebApi_GetLocalPayHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayHistoryFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebApiServer).GetLocalPayHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/webrpc.WebApi/GetLocalPayHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebApiServer).GetLocalPayHistory(ctx, req.(*PayHistoryFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebApi_ExportPayHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportPayHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebApiServer).ExportPayHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/webrpc.WebApi/ExportPayHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebApiServer).ExportPayHistory(ctx, req.(*ExportPayHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebApi_SyncPayHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebApiServer).SyncPayHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/webrpc.WebApi/SyncPayHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebApiServer).SyncPayHistory(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WebApi_SetDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetDelegationRequest)
 	if err := dec(in); err != nil {
@@ -3157,6 +3934,24 @@ func _WebApi_GetPeerFreeBalance_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WebApi_GetPayFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(rpc.GetPayFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebApiServer).GetPayFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/webrpc.WebApi/GetPayFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebApiServer).GetPayFee(ctx, req.(*rpc.GetPayFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WebApi_SendConditionalPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SendConditionalPaymentRequest)
 	if err := dec(in); err != nil {
@@ -3904,6 +4699,24 @@ func _WebApi_GetSeqNumForAppSession_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WebApi_ListAppSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebApiServer).ListAppSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/webrpc.WebApi/ListAppSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebApiServer).ListAppSessions(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WebApi_GetBlockNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
@@ -3948,6 +4761,18 @@ var _WebApi_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPayHistory",
 			Handler:    _WebApi_GetPayHistory_Handler,
 		},
+		{
+			MethodName: "GetLocalPayHistory",
+			Handler:    _WebApi_GetLocalPayHistory_Handler,
+		},
+		{
+			MethodName: "ExportPayHistory",
+			Handler:    _WebApi_ExportPayHistory_Handler,
+		},
+		{
+			MethodName: "SyncPayHistory",
+			Handler:    _WebApi_SyncPayHistory_Handler,
+		},
 		{
 			MethodName: "SetDelegation",
 			Handler:    _WebApi_SetDelegation_Handler,
@@ -3980,6 +4805,10 @@ var _WebApi_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPeerFreeBalance",
 			Handler:    _WebApi_GetPeerFreeBalance_Handler,
 		},
+		{
+			MethodName: "GetPayFee",
+			Handler:    _WebApi_GetPayFee_Handler,
+		},
 		{
 			MethodName: "SendConditionalPayment",
 			Handler:    _WebApi_SendConditionalPayment_Handler,
@@ -4132,6 +4961,10 @@ var _WebApi_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSeqNumForAppSession",
 			Handler:    _WebApi_GetSeqNumForAppSession_Handler,
 		},
+		{
+			MethodName: "ListAppSessions",
+			Handler:    _WebApi_ListAppSessions_Handler,
+		},
 		{
 			MethodName: "GetBlockNumber",
 			Handler:    _WebApi_GetBlockNumber_Handler,
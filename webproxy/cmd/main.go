@@ -4,6 +4,7 @@ package main
 
 import (
 	"flag"
+	"time"
 
 	"github.com/celer-network/goCeler/webproxy"
 )
@@ -11,9 +12,21 @@ import (
 var (
 	port                 = flag.Int("port", 29980, "proxy listening port")
 	serverNetworkAddress = flag.String("server", "", "server network address")
+	sessionIdleSec       = flag.Int("sessionidlesec", int(webproxy.DefaultSessionIdleTimeout/time.Second), "seconds before an idle session is closed")
+	sessionRateLimit     = flag.Float64("sessionratelimit", webproxy.DefaultSessionRateLimit, "requests per second allowed for a session, no limit if 0")
+	sessionRateBurst     = flag.Int("sessionrateburst", webproxy.DefaultSessionRateBurst, "max requests allowed in a burst for a session")
+	redisAddr            = flag.String("redisaddr", "", "Redis address to share sessions among proxies, sessions are local if empty")
 )
 
 func main() {
 	flag.Parse()
-	webproxy.NewProxy(*port, *serverNetworkAddress).Start()
+	sessionConfig := &webproxy.SessionConfig{
+		IdleTimeout: time.Duration(*sessionIdleSec) * time.Second,
+		RateLimit:   *sessionRateLimit,
+		RateBurst:   *sessionRateBurst,
+	}
+	if *redisAddr != "" {
+		sessionConfig.Store = webproxy.NewRedisSessionStore(*redisAddr)
+	}
+	webproxy.NewProxyWithSessionConfig(*port, *serverNetworkAddress, sessionConfig).Start()
 }
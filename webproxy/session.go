@@ -0,0 +1,147 @@
+// Copyright 2020 Celer Network
+
+package webproxy
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/ratelimit"
+	"github.com/celer-network/goCeler/rpc"
+	"google.golang.org/grpc"
+)
+
+const (
+	DefaultSessionIdleTimeout = 10 * time.Minute
+	DefaultSessionRateLimit   = 20 // requests per second
+	DefaultSessionRateBurst   = 50
+	sessionReapInterval       = time.Minute
+)
+
+// SessionConfig configures the lifecycle and limits of proxy sessions
+type SessionConfig struct {
+	// IdleTimeout is how long a session without subscription or requests is kept
+	IdleTimeout time.Duration
+	// RateLimit is the number of requests per second allowed for a session, no limit if 0
+	RateLimit float64
+	// RateBurst is the max number of requests allowed in a burst
+	RateBurst int
+	// Store shares sessions among proxy instances, sessions are local to the proxy if nil
+	Store SessionStore
+}
+
+func DefaultSessionConfig() *SessionConfig {
+	return &SessionConfig{
+		IdleTimeout: DefaultSessionIdleTimeout,
+		RateLimit:   DefaultSessionRateLimit,
+		RateBurst:   DefaultSessionRateBurst,
+	}
+}
+
+type clientConnection struct {
+	sessionToken string
+	createTs     int64
+	conn         *grpc.ClientConn
+	rpcClient    rpc.RpcClient
+	limiter      *ratelimit.Limiter
+
+	// sendMu serializes sends on the stream, mu is not held while sending so that
+	// a slow upstream does not block the session reaper
+	sendMu       sync.Mutex
+	mu           sync.Mutex
+	stream       rpc.Rpc_CelerStreamClient
+	cancelStream context.CancelFunc
+	addr         ctype.Addr // address authenticated through this session, zero before auth
+	lastActive   time.Time
+}
+
+func (c *clientConnection) touch() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lastActive = time.Now()
+}
+
+// idleSince returns the time of the last activity, zero if the session has a subscribed stream
+func (c *clientConnection) idleSince() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.stream != nil {
+		return time.Time{}
+	}
+	return c.lastActive
+}
+
+func (c *clientConnection) getAddr() ctype.Addr {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.addr
+}
+
+func (c *clientConnection) sessionInfo(owner string) *SessionInfo {
+	info := &SessionInfo{CreateTs: c.createTs, Owner: owner}
+	if addr := c.getAddr(); addr != ctype.ZeroAddr {
+		info.Addr = ctype.Addr2Hex(addr)
+	}
+	return info
+}
+
+// bind binds the session to the authenticated address, returns false if already bound to another one
+func (c *clientConnection) bind(addr ctype.Addr) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.addr != ctype.ZeroAddr {
+		return c.addr == addr
+	}
+	c.addr = addr
+	return true
+}
+
+// setStream replaces the subscribed stream, closing the previous one
+func (c *clientConnection) setStream(stream rpc.Rpc_CelerStreamClient, cancel context.CancelFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cancelStream != nil {
+		c.cancelStream()
+	}
+	c.stream = stream
+	c.cancelStream = cancel
+	c.lastActive = time.Now()
+}
+
+// clearStream clears the stream if it has not been replaced by a new subscription
+func (c *clientConnection) clearStream(stream rpc.Rpc_CelerStreamClient) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.stream == stream {
+		c.cancelStream()
+		c.stream = nil
+		c.cancelStream = nil
+	}
+	c.lastActive = time.Now()
+}
+
+func (c *clientConnection) send(msg *rpc.CelerMsg) error {
+	c.mu.Lock()
+	stream := c.stream
+	c.lastActive = time.Now()
+	c.mu.Unlock()
+	if stream == nil {
+		return errMissingStream
+	}
+	c.sendMu.Lock()
+	defer c.sendMu.Unlock()
+	return stream.Send(msg)
+}
+
+func (c *clientConnection) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cancelStream != nil {
+		c.cancelStream()
+		c.stream = nil
+		c.cancelStream = nil
+	}
+	c.conn.Close()
+}
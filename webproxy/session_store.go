@@ -0,0 +1,66 @@
+// Copyright 2020 Celer Network
+
+package webproxy
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/go-redis/redis"
+)
+
+// SessionInfo is the session state shared among proxy instances. The upstream connection and
+// message stream are local to a proxy, a client re-subscribes after moving to another proxy.
+type SessionInfo struct {
+	// Addr is the hex address authenticated through the session, empty before auth
+	Addr     string `json:"addr"`
+	CreateTs int64  `json:"create_ts"`
+	// Owner is the ID of the proxy instance serving the session, only the owner deletes it
+	Owner string `json:"owner"`
+}
+
+// SessionStore shares sessions among proxy instances behind a load balancer.
+// Stored sessions expire after ttl unless put again.
+type SessionStore interface {
+	Put(token string, info *SessionInfo, ttl time.Duration) error
+	Get(token string) (*SessionInfo, bool, error)
+	Delete(token string) error
+}
+
+const redisSessionKeyPrefix = "webproxy:session:"
+
+type redisSessionStore struct {
+	client *redis.Client
+}
+
+func NewRedisSessionStore(addr string) SessionStore {
+	return &redisSessionStore{client: redis.NewClient(&redis.Options{Addr: addr})}
+}
+
+func (s *redisSessionStore) Put(token string, info *SessionInfo, ttl time.Duration) error {
+	value, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	return s.client.Set(redisSessionKeyPrefix+token, value, ttl).Err()
+}
+
+func (s *redisSessionStore) Get(token string) (*SessionInfo, bool, error) {
+	value, err := s.client.Get(redisSessionKeyPrefix + token).Bytes()
+	if err == redis.Nil {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	info := &SessionInfo{}
+	err = json.Unmarshal(value, info)
+	if err != nil {
+		return nil, false, err
+	}
+	return info, true, nil
+}
+
+func (s *redisSessionStore) Delete(token string) error {
+	return s.client.Del(redisSessionKeyPrefix + token).Err()
+}
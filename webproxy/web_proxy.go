@@ -10,7 +10,10 @@ import (
 	"sync"
 	"time"
 
+	"github.com/celer-network/goCeler/common"
 	"github.com/celer-network/goCeler/config"
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/ratelimit"
 	"github.com/celer-network/goCeler/rpc"
 	"github.com/celer-network/goCeler/utils"
 	proxyrpc "github.com/celer-network/goCeler/webproxy/rpc"
@@ -20,26 +23,36 @@ import (
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
 	"github.com/rs/cors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errMissingStream   = errors.New("Missing stream")
+	errUnknownSession  = errors.New("Unknown session")
+	errSessionAddrBind = errors.New("Session is bound to another address")
 )
 
 type WebProxy struct {
+	id                           string // proxy instance ID, owner of the sessions it serves
 	port                         int
 	serverNetworkAddress         string
+	sessionConfig                *SessionConfig
 	mu                           sync.Mutex
 	sessionToClientConnectionMap map[string]*clientConnection
 }
 
-type clientConnection struct {
-	sessionToken string
-	rpcClient    rpc.RpcClient
-	stream       rpc.Rpc_CelerStreamClient
+func NewProxy(port int, serverNetworkAddress string) *WebProxy {
+	return NewProxyWithSessionConfig(port, serverNetworkAddress, DefaultSessionConfig())
 }
 
-func NewProxy(port int, serverNetworkAddress string) *WebProxy {
+func NewProxyWithSessionConfig(port int, serverNetworkAddress string, sessionConfig *SessionConfig) *WebProxy {
 	return &WebProxy{
+		id:                           uuid.New().String(),
 		port:                         port,
 		serverNetworkAddress:         serverNetworkAddress,
+		sessionConfig:                sessionConfig,
 		sessionToClientConnectionMap: make(map[string]*clientConnection),
 	}
 }
@@ -63,6 +76,7 @@ func (p *WebProxy) Start() {
 		IdleTimeout:       86400 * time.Second,
 	}
 	log.Infoln("Serving Celer Web Proxy on", addr)
+	go p.reapSessions()
 	go func() {
 		errChan <- httpSvr.ListenAndServe()
 	}()
@@ -73,22 +87,22 @@ func (p *WebProxy) Start() {
 
 func (p *WebProxy) CreateSession(
 	ctx context.Context, _ *empty.Empty) (*proxyrpc.SessionToken, error) {
-	conn, err := grpc.Dial(
-		p.serverNetworkAddress,
-		utils.GetClientTlsOption(),
-		grpc.WithBlock(),
-		grpc.WithTimeout(4*time.Second),
-		grpc.WithKeepaliveParams(config.KeepAliveClientParams))
+	sessionToken := uuid.New().String()
+	c, err := p.newClientConnection(sessionToken, &SessionInfo{CreateTs: time.Now().Unix()})
 	if err != nil {
 		log.Error(err)
 		return nil, err
 	}
-	sessionToken := uuid.New().String()
-	p.mu.Lock()
-	p.sessionToClientConnectionMap[sessionToken] = &clientConnection{
-		sessionToken: sessionToken,
-		rpcClient:    rpc.NewRpcClient(conn),
+	if p.sessionConfig.Store != nil {
+		err = p.storeSession(c)
+		if err != nil {
+			c.close()
+			log.Error(err)
+			return nil, err
+		}
 	}
+	p.mu.Lock()
+	p.sessionToClientConnectionMap[sessionToken] = c
 	p.mu.Unlock()
 	return &proxyrpc.SessionToken{Token: sessionToken}, nil
 }
@@ -110,6 +124,9 @@ func (p *WebProxy) OpenChannel(
 	return response, nil
 }
 
+// SubscribeMessages authenticates with the server through the session and relays messages from
+// the server. The session is bound to the authenticated address after the server acks the auth,
+// and can't be used to authenticate another address afterwards.
 func (p *WebProxy) SubscribeMessages(
 	authReq *rpc.AuthReq, proxyStream proxyrpc.WebProxyRpc_SubscribeMessagesServer) error {
 	c, err := p.getClientConnection(proxyStream.Context())
@@ -117,8 +134,16 @@ func (p *WebProxy) SubscribeMessages(
 		log.Error(err)
 		return err
 	}
-	celerStream, err := c.rpcClient.CelerStream(context.Background())
+	authAddr := ctype.Bytes2Addr(authReq.GetMyAddr())
+	if boundAddr := c.getAddr(); boundAddr != ctype.ZeroAddr && boundAddr != authAddr {
+		log.Errorf("session bound to %x, auth from %x", boundAddr, authAddr)
+		return status.Error(codes.PermissionDenied, errSessionAddrBind.Error())
+	}
+	// close the upstream stream when the proxy stream ends
+	streamCtx, cancel := context.WithCancel(proxyStream.Context())
+	celerStream, err := c.rpcClient.CelerStream(streamCtx)
 	if err != nil {
+		cancel()
 		log.Error(err)
 		return err
 	}
@@ -126,17 +151,30 @@ func (p *WebProxy) SubscribeMessages(
 		Message: &rpc.CelerMsg_AuthReq{AuthReq: authReq},
 	})
 	if sendErr != nil {
+		cancel()
 		log.Error(sendErr)
 		return sendErr
 	}
-	c.stream = celerStream
+	c.setStream(celerStream, cancel)
+	defer c.clearStream(celerStream)
 	for {
 		message, err := celerStream.Recv()
 		if err != nil {
 			log.Error(err)
 			return err
 		}
-		proxyStream.Send(message)
+		if message.GetAuthAck() != nil {
+			err = p.bindSession(c, authAddr)
+			if err != nil {
+				return err
+			}
+		}
+		c.touch()
+		err = proxyStream.Send(message)
+		if err != nil {
+			log.Error(err)
+			return err
+		}
 	}
 }
 
@@ -146,12 +184,7 @@ func (p *WebProxy) SendMessage(ctx context.Context, message *rpc.CelerMsg) (*emp
 		log.Error(err)
 		return nil, err
 	}
-	if c.stream == nil {
-		missingStreamErr := errors.New("Missing stream")
-		log.Error(missingStreamErr)
-		return nil, missingStreamErr
-	}
-	err = c.stream.Send(message)
+	err = c.send(message)
 	if err != nil {
 		log.Error(err)
 		return nil, err
@@ -160,7 +193,7 @@ func (p *WebProxy) SendMessage(ctx context.Context, message *rpc.CelerMsg) (*emp
 }
 
 func (p *WebProxy) getClientConnection(ctx context.Context) (*clientConnection, error) {
-	md, ok := metadata.FromIncomingContext(ctx)
+	md, _ := metadata.FromIncomingContext(ctx)
 	session := md["session"]
 	if len(session) == 0 {
 		missingTokenErr := errors.New("Missing session token")
@@ -172,8 +205,147 @@ func (p *WebProxy) getClientConnection(ctx context.Context) (*clientConnection,
 	clientConnection, ok := p.sessionToClientConnectionMap[sessionToken]
 	p.mu.Unlock()
 	if !ok {
-		unknownSessionErr := errors.New("Unknown session")
-		return nil, unknownSessionErr
+		var err error
+		clientConnection, err = p.adoptSession(sessionToken)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !clientConnection.limiter.Allow() {
+		return nil, status.Error(codes.ResourceExhausted, common.ErrRateLimited.Error())
 	}
+	clientConnection.touch()
 	return clientConnection, nil
 }
+
+// adoptSession takes over a session created by another proxy instance from the shared store
+func (p *WebProxy) adoptSession(sessionToken string) (*clientConnection, error) {
+	store := p.sessionConfig.Store
+	if store == nil {
+		return nil, errUnknownSession
+	}
+	info, found, err := store.Get(sessionToken)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errUnknownSession
+	}
+	c, err := p.newClientConnection(sessionToken, info)
+	if err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if existing, ok := p.sessionToClientConnectionMap[sessionToken]; ok {
+		// adopted concurrently by another request
+		c.close()
+		return existing, nil
+	}
+	p.sessionToClientConnectionMap[sessionToken] = c
+	log.Infoln("adopted session", sessionToken)
+	// take over the ownership so the previous proxy does not delete the session when reaping
+	if err = p.storeSession(c); err != nil {
+		log.Warnln("failed to store adopted session:", err)
+	}
+	return c, nil
+}
+
+func (p *WebProxy) newClientConnection(sessionToken string, info *SessionInfo) (*clientConnection, error) {
+	conn, err := grpc.Dial(
+		p.serverNetworkAddress,
+		utils.GetClientTlsOption(),
+		grpc.WithBlock(),
+		grpc.WithTimeout(4*time.Second),
+		grpc.WithKeepaliveParams(config.KeepAliveClientParams))
+	if err != nil {
+		return nil, err
+	}
+	return &clientConnection{
+		sessionToken: sessionToken,
+		createTs:     info.CreateTs,
+		conn:         conn,
+		rpcClient:    rpc.NewRpcClient(conn),
+		limiter:      ratelimit.NewLimiter(p.sessionConfig.RateLimit, p.sessionConfig.RateBurst),
+		addr:         ctype.Hex2Addr(info.Addr),
+		lastActive:   time.Now(),
+	}, nil
+}
+
+func (p *WebProxy) bindSession(c *clientConnection, addr ctype.Addr) error {
+	if !c.bind(addr) {
+		log.Errorf("session bound to %x, authenticated %x", c.getAddr(), addr)
+		return status.Error(codes.PermissionDenied, errSessionAddrBind.Error())
+	}
+	if p.sessionConfig.Store != nil {
+		if err := p.storeSession(c); err != nil {
+			log.Warnln("failed to store session binding:", err)
+		}
+	}
+	return nil
+}
+
+// storeSession puts the session owned by this proxy to the shared store
+func (p *WebProxy) storeSession(c *clientConnection) error {
+	return p.sessionConfig.Store.Put(c.sessionToken, c.sessionInfo(p.id), p.sessionConfig.IdleTimeout)
+}
+
+// reapSessions periodically closes sessions idle longer than the idle timeout, and refreshes
+// the ttl of sessions active since the last reap in the shared store. Idle sessions are not
+// refreshed, so that a proxy the client has moved away from does not take back the ownership.
+func (p *WebProxy) reapSessions() {
+	ticker := time.NewTicker(sessionReapInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		p.reapIdleSessions(time.Now())
+	}
+}
+
+func (p *WebProxy) reapIdleSessions(now time.Time) {
+	var reaped, active []*clientConnection
+	p.mu.Lock()
+	for token, c := range p.sessionToClientConnectionMap {
+		idleSince := c.idleSince()
+		if !idleSince.IsZero() && now.Sub(idleSince) > p.sessionConfig.IdleTimeout {
+			delete(p.sessionToClientConnectionMap, token)
+			reaped = append(reaped, c)
+		} else if idleSince.IsZero() || now.Sub(idleSince) <= sessionReapInterval {
+			active = append(active, c)
+		}
+	}
+	p.mu.Unlock()
+
+	store := p.sessionConfig.Store
+	for _, c := range reaped {
+		log.Infoln("reap idle session", c.sessionToken)
+		c.close()
+		if store != nil {
+			p.deleteOwnedSession(c.sessionToken)
+		}
+	}
+	if store == nil {
+		return
+	}
+	for _, c := range active {
+		if err := p.storeSession(c); err != nil {
+			log.Warnln("failed to refresh session in store:", err)
+		}
+	}
+}
+
+// deleteOwnedSession deletes the session from the shared store unless it has moved to another
+// proxy, which keeps refreshing it. Sessions idle on all proxies expire with the store ttl.
+func (p *WebProxy) deleteOwnedSession(sessionToken string) {
+	store := p.sessionConfig.Store
+	info, found, err := store.Get(sessionToken)
+	if err != nil {
+		log.Warnln("failed to get session from store:", err)
+		return
+	}
+	if !found || info.Owner != p.id {
+		return
+	}
+	if err = store.Delete(sessionToken); err != nil {
+		log.Warnln("failed to delete session from store:", err)
+	}
+}
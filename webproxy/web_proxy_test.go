@@ -0,0 +1,323 @@
+// Copyright 2020 Celer Network
+
+package webproxy
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/celer-network/goCeler/ctype"
+	"github.com/celer-network/goCeler/ratelimit"
+	"github.com/celer-network/goCeler/rpc"
+	"google.golang.org/grpc"
+)
+
+// fakeRedis serves the GET, SET and DEL commands used by the redis session store
+type fakeRedis struct {
+	mu      sync.Mutex
+	values  map[string]string
+	expires map[string]time.Time
+}
+
+func startFakeRedis(t *testing.T) (string, func()) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &fakeRedis{values: make(map[string]string), expires: make(map[string]time.Time)}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go r.serve(conn)
+		}
+	}()
+	return ln.Addr().String(), func() { ln.Close() }
+}
+
+func (r *fakeRedis) serve(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	for {
+		args, err := readRespArray(reader)
+		if err != nil {
+			return
+		}
+		_, err = conn.Write([]byte(r.exec(args)))
+		if err != nil {
+			return
+		}
+	}
+}
+
+func readRespLine(reader *bufio.Reader) (string, error) {
+	line, err := reader.ReadString('\n')
+	return strings.TrimSuffix(line, "\r\n"), err
+}
+
+func readRespArray(reader *bufio.Reader) ([]string, error) {
+	line, err := readRespLine(reader)
+	if err != nil {
+		return nil, err
+	}
+	n, err := strconv.Atoi(strings.TrimPrefix(line, "*"))
+	if err != nil {
+		return nil, err
+	}
+	args := make([]string, n)
+	for i := range args {
+		if _, err = readRespLine(reader); err != nil {
+			return nil, err
+		}
+		if args[i], err = readRespLine(reader); err != nil {
+			return nil, err
+		}
+	}
+	return args, nil
+}
+
+func (r *fakeRedis) exec(args []string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for key, expire := range r.expires {
+		if time.Now().After(expire) {
+			delete(r.values, key)
+			delete(r.expires, key)
+		}
+	}
+	switch strings.ToLower(args[0]) {
+	case "set":
+		r.values[args[1]] = args[2]
+		delete(r.expires, args[1])
+		if len(args) == 5 {
+			ttl, _ := strconv.Atoi(args[4])
+			unit := time.Second
+			if strings.ToLower(args[3]) == "px" {
+				unit = time.Millisecond
+			}
+			r.expires[args[1]] = time.Now().Add(time.Duration(ttl) * unit)
+		}
+		return "+OK\r\n"
+	case "get":
+		value, ok := r.values[args[1]]
+		if !ok {
+			return "$-1\r\n"
+		}
+		return fmt.Sprintf("$%d\r\n%s\r\n", len(value), value)
+	case "del":
+		_, ok := r.values[args[1]]
+		delete(r.values, args[1])
+		delete(r.expires, args[1])
+		if ok {
+			return ":1\r\n"
+		}
+		return ":0\r\n"
+	}
+	return "-ERR unknown command\r\n"
+}
+
+// testCelerStream blocks sends until released
+type testCelerStream struct {
+	rpc.Rpc_CelerStreamClient
+	release chan bool
+}
+
+func (s *testCelerStream) Send(*rpc.CelerMsg) error {
+	<-s.release
+	return nil
+}
+
+func newTestProxy(store SessionStore) *WebProxy {
+	sessionConfig := DefaultSessionConfig()
+	sessionConfig.Store = store
+	return NewProxyWithSessionConfig(0, "", sessionConfig)
+}
+
+// addTestSession adds a session to the proxy with a lazy upstream connection
+func addTestSession(t *testing.T, p *WebProxy, token string) *clientConnection {
+	conn, err := grpc.Dial("127.0.0.1:1", grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &clientConnection{
+		sessionToken: token,
+		createTs:     time.Now().Unix(),
+		conn:         conn,
+		rpcClient:    rpc.NewRpcClient(conn),
+		limiter:      ratelimit.NewLimiter(0, 0),
+		lastActive:   time.Now(),
+	}
+	p.mu.Lock()
+	p.sessionToClientConnectionMap[token] = c
+	p.mu.Unlock()
+	if p.sessionConfig.Store != nil {
+		if err = p.storeSession(c); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return c
+}
+
+func TestRedisSessionStore(t *testing.T) {
+	addr, stop := startFakeRedis(t)
+	defer stop()
+	store := NewRedisSessionStore(addr)
+
+	_, found, err := store.Get("s1")
+	if err != nil || found {
+		t.Fatalf("unknown session found: %t %v", found, err)
+	}
+	info := &SessionInfo{Addr: "abc1", CreateTs: 100, Owner: "p1"}
+	err = store.Put("s1", info, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, found, err := store.Get("s1")
+	if err != nil || !found || *got != *info {
+		t.Errorf("wrong session: %+v %t %v", got, found, err)
+	}
+	err = store.Delete("s1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, found, err = store.Get("s1")
+	if err != nil || found {
+		t.Errorf("deleted session found: %t %v", found, err)
+	}
+
+	// stored session expires after ttl unless put again
+	err = store.Put("s2", info, 100*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = store.Put("s3", info, 100*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(60 * time.Millisecond)
+	err = store.Put("s3", info, 100*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(60 * time.Millisecond)
+	_, found, err = store.Get("s2")
+	if err != nil || found {
+		t.Errorf("expired session found: %t %v", found, err)
+	}
+	_, found, err = store.Get("s3")
+	if err != nil || !found {
+		t.Errorf("refreshed session not found: %t %v", found, err)
+	}
+}
+
+func TestReapIdleSessions(t *testing.T) {
+	addr, stop := startFakeRedis(t)
+	defer stop()
+	store := NewRedisSessionStore(addr)
+	p1, p2 := newTestProxy(store), newTestProxy(store)
+	idle := addTestSession(t, p1, "idle")
+	moved := addTestSession(t, p1, "moved")
+	subscribed := addTestSession(t, p1, "subscribed")
+	subscribed.setStream(&testCelerStream{release: make(chan bool)}, func() {})
+	// moved session adopted by another proxy
+	addTestSession(t, p2, "moved")
+	idle.lastActive = time.Now().Add(-2 * sessionReapInterval)
+	moved.lastActive = idle.lastActive
+	subscribed.lastActive = idle.lastActive
+
+	// not idle for long enough, idle sessions are not refreshed
+	p1.reapIdleSessions(time.Now())
+	if len(p1.sessionToClientConnectionMap) != 3 {
+		t.Fatalf("sessions reaped before idle timeout: %v", p1.sessionToClientConnectionMap)
+	}
+	info, found, err := store.Get("moved")
+	if err != nil || !found || info.Owner != p2.id {
+		t.Errorf("ownership taken back by idle proxy: %+v %t %v", info, found, err)
+	}
+
+	p1.reapIdleSessions(time.Now().Add(p1.sessionConfig.IdleTimeout))
+	if len(p1.sessionToClientConnectionMap) != 1 || p1.sessionToClientConnectionMap["subscribed"] == nil {
+		t.Fatalf("wrong sessions after reaping: %v", p1.sessionToClientConnectionMap)
+	}
+	_, found, err = store.Get("idle")
+	if err != nil || found {
+		t.Errorf("reaped session still in store: %t %v", found, err)
+	}
+	info, found, err = store.Get("moved")
+	if err != nil || !found || info.Owner != p2.id {
+		t.Errorf("session owned by another proxy deleted: %+v %t %v", info, found, err)
+	}
+	info, found, err = store.Get("subscribed")
+	if err != nil || !found || info.Owner != p1.id {
+		t.Errorf("subscribed session not refreshed: %+v %t %v", info, found, err)
+	}
+}
+
+func TestSessionAddrBind(t *testing.T) {
+	addr, stop := startFakeRedis(t)
+	defer stop()
+	store := NewRedisSessionStore(addr)
+	p := newTestProxy(store)
+	c := addTestSession(t, p, "s1")
+	addr1, addr2 := ctype.Hex2Addr("abc1"), ctype.Hex2Addr("abc2")
+
+	err := p.bindSession(c, addr1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = p.bindSession(c, addr1)
+	if err != nil {
+		t.Errorf("failed to bind the same address again: %v", err)
+	}
+	err = p.bindSession(c, addr2)
+	if err == nil {
+		t.Error("session bound to another address")
+	}
+	if c.getAddr() != addr1 {
+		t.Errorf("wrong bound address %x", c.getAddr())
+	}
+
+	// binding is shared with other proxies
+	info, found, err := store.Get("s1")
+	if err != nil || !found || ctype.Hex2Addr(info.Addr) != addr1 {
+		t.Errorf("wrong stored binding: %+v %t %v", info, found, err)
+	}
+}
+
+func TestSendNotBlockingReaper(t *testing.T) {
+	p := newTestProxy(nil)
+	c := addTestSession(t, p, "s1")
+	err := c.send(&rpc.CelerMsg{})
+	if err != errMissingStream {
+		t.Errorf("wrong error without stream: %v", err)
+	}
+
+	stream := &testCelerStream{release: make(chan bool)}
+	c.setStream(stream, func() {})
+	sent := make(chan error)
+	go func() {
+		sent <- c.send(&rpc.CelerMsg{})
+	}()
+	reaped := make(chan bool)
+	go func() {
+		p.reapIdleSessions(time.Now())
+		reaped <- true
+	}()
+	select {
+	case <-reaped:
+	case <-time.After(time.Second):
+		t.Fatal("reaper blocked by a pending send")
+	}
+	close(stream.release)
+	if err = <-sent; err != nil {
+		t.Error(err)
+	}
+}